@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// applyCmd represents the apply command.
+var applyCmd = newApplyCmd()
+var applyReportsFlag string
+
+const applyLongDescription = `Write a mutation from the reports into the working tree, to run the tests
+against a surviving mutant locally or step through it in a debugger:
+  gooze apply abcd
+  go test ./...
+  gooze revert
+
+The ID is the mutation's ID in the reports, or any prefix of it that only it
+starts with, such as the four characters the results view shows. The
+file's original content is kept in _applied.yaml next to the reports until
+gooze revert restores it, and only one mutation can be applied at a time.
+
+Mutation IDs hash the file they mutate, so a file edited since the run
+cannot have its mutations applied until gooze runs again.
+
+--reports defaults to the value of --output.`
+
+func newApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply <id>",
+		Short: "Write a mutation into the working tree, undone by gooze revert",
+		Long:  applyLongDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reports := applyReportsFlag
+			if reports == "" {
+				reports = reportsOutputDirFlag
+			}
+
+			return workflow.Apply(domain.ApplyArgs{
+				Reports:    m.Path(reports),
+				MutationID: args[0],
+				Out:        cmd.OutOrStdout(),
+			})
+		},
+	}
+	cmd.Flags().StringVar(&applyReportsFlag, "reports", "", "reports directory holding the mutation (default: --output)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyCmd_DefaultsToRootOutput(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newApplyCmd())
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.EXPECT().Apply(domain.ApplyArgs{
+		Reports:    m.Path("./reports-dir"),
+		MutationID: "abcd",
+		Out:        out,
+	}).Return(nil)
+
+	cmd.SetArgs([]string{"--output", "./reports-dir", "apply", "abcd"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestApplyCmd_RequiresID(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.AddCommand(newApplyCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	cmd.SetArgs([]string{"apply"})
+	err := cmd.Execute()
+	require.Error(t, err)
+}
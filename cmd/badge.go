@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// badgeCmd represents the badge command.
+var badgeCmd = newBadgeCmd()
+var (
+	badgeReportsFlag  string
+	badgeOutFlag      string
+	badgeEndpointFlag string
+)
+
+const badgeLongDescription = `Render the mutation score of a reports directory as an SVG shield for a
+README, colored by score: brightgreen from 80%, yellow from 60%, orange from
+40% and red below that.
+
+Next to the SVG, a shields.io endpoint JSON file is written for hosting the
+badge through https://img.shields.io/endpoint?url=... . It defaults to --out
+with a .json extension; pass --endpoint to choose another path.
+
+--reports defaults to the value of --output.`
+
+func newBadgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "badge",
+		Short: "Render the mutation score as a badge",
+		Long:  badgeLongDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			reports := badgeReportsFlag
+			if reports == "" {
+				reports = reportsOutputDirFlag
+			}
+
+			endpoint := badgeEndpointFlag
+			if endpoint == "" {
+				endpoint = badgeEndpointPath(badgeOutFlag)
+			}
+
+			return workflow.Badge(domain.BadgeArgs{
+				Reports:  m.Path(reports),
+				Out:      m.Path(badgeOutFlag),
+				Endpoint: m.Path(endpoint),
+			})
+		},
+	}
+	cmd.Flags().StringVar(&badgeReportsFlag, "reports", "", "reports directory to read the score from (default: --output)")
+	cmd.Flags().StringVar(&badgeOutFlag, "out", "badge.svg", "write the SVG badge to this file")
+	cmd.Flags().StringVar(&badgeEndpointFlag, "endpoint", "", "write the shields.io endpoint JSON to this file (default: --out with a .json extension)")
+
+	return cmd
+}
+
+// badgeEndpointPath places the endpoint JSON next to the SVG badge.
+func badgeEndpointPath(out string) string {
+	if out == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(out, filepath.Ext(out)) + ".json"
+}
+
+func init() {
+	rootCmd.AddCommand(badgeCmd)
+}
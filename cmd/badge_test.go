@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadgeCmd_DefaultsToRootOutputAndSiblingEndpoint(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newBadgeCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Badge", mock.MatchedBy(func(args domain.BadgeArgs) bool {
+		return args.Reports == m.Path("./reports-dir") &&
+			args.Out == m.Path("docs/score.svg") &&
+			args.Endpoint == m.Path("docs/score.json")
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"--output", "./reports-dir", "badge", "--out", "docs/score.svg"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestBadgeCmd_ExplicitFlags(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newBadgeCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Badge", mock.MatchedBy(func(args domain.BadgeArgs) bool {
+		return args.Reports == m.Path("ci-reports") &&
+			args.Out == m.Path("badge.svg") &&
+			args.Endpoint == m.Path("public/endpoint.json")
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"badge", "--reports", "ci-reports", "--endpoint", "public/endpoint.json"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestBadgeEndpointPath(t *testing.T) {
+	assert.Equal(t, "badge.json", badgeEndpointPath("badge.svg"))
+	assert.Equal(t, "out/score.json", badgeEndpointPath("out/score"))
+	assert.Empty(t, badgeEndpointPath(""))
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var cacheExcludeFlags []string
+
+// cacheCmd represents the cache command.
+var cacheCmd = newCacheCmd()
+
+const cacheLongDescription = `Inspect and prune the reports directory, which caches the results of earlier
+runs so unchanged sources are not tested again.`
+
+const cacheStatusLongDescription = `Show the size of the reports directory, how many sources and mutations it
+holds, the stored sources whose code, tests or mutators changed since they
+were tested, and the stale reports of sources that no longer exist on disk.
+
+Sources are listed from the given paths, ./... by default; reports of sources
+outside them count as stale only once the files are gone. Use
+--progress-format json to print the status as a JSON cache event.
+
+` + pathPatternsHelp
+
+const cacheCleanLongDescription = `Remove the stale reports of sources that no longer exist on disk and
+regenerate the report index. Reports of sources that changed are kept: the
+next run replaces them.
+
+` + pathPatternsHelp
+
+func newCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect and prune the reports directory",
+		Long:  cacheLongDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newCacheSubCmd("status", "Show the size and stale reports of the reports directory", cacheStatusLongDescription, false))
+	cmd.AddCommand(newCacheSubCmd("clean", "Remove the reports of deleted sources", cacheCleanLongDescription, true))
+
+	return cmd
+}
+
+func newCacheSubCmd(name, short, long string, clean bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   name + " [paths...]",
+		Short: short,
+		Long:  long,
+		RunE: func(_ *cobra.Command, args []string) error {
+			return workflow.Cache(domain.CacheArgs{
+				Reports: m.Path(reportsOutputDirFlag),
+				Paths:   parsePaths(args),
+				Exclude: cacheExcludeFlags,
+				Clean:   clean,
+			})
+		},
+	}
+	cmd.Flags().StringArrayVarP(&cacheExcludeFlags, "exclude", "x", nil, "exclude files matching regex (can be repeated; --ignore is an alias)")
+	cmd.Flags().SetNormalizeFunc(ignoreAlias)
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheCmd_Subcommands(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		clean bool
+		paths []m.Path
+	}{
+		{"status", []string{"--output", "./reports-dir", "cache", "status"}, false, []m.Path{}},
+		{"clean", []string{"--output", "./reports-dir", "cache", "clean", "./pkg/..."}, true, []m.Path{"./pkg/..."}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newCacheCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Cache", mock.MatchedBy(func(args domain.CacheArgs) bool {
+				return args.Reports == m.Path("./reports-dir") &&
+					args.Clean == tt.clean &&
+					len(args.Paths) == len(tt.paths) &&
+					(len(tt.paths) == 0 || args.Paths[0] == tt.paths[0])
+			})).Return(nil)
+
+			cmd.SetArgs(tt.args)
+			require.NoError(t, cmd.Execute())
+		})
+	}
+}
+
+func TestCacheCmd_RequiresSubcommand(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newCacheCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	cmd.SetArgs([]string{"cache", "prune"})
+	require.Error(t, cmd.Execute())
+	mockWorkflow.AssertNotCalled(t, "Cache", mock.Anything)
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+var corpusReportExcludeFlags []string
+var corpusReportExpectFlag string
+
+// corpusReportCmd represents the corpus-report command.
+var corpusReportCmd = newCorpusReportCmd()
+
+const corpusReportLongDescription = `Generate every mutation type for the given paths without running tests and
+print the number of mutations per file and operator.
+
+With --expect, the counts are checked against a YAML file of minimums keyed by
+file path (relative to the YAML file) and mutation type, and the command fails
+if any count falls short:
+
+  loops/main.go:
+    loop: 10
+    comparison: 4
+
+` + pathPatternsHelp
+
+func newCorpusReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "corpus-report [paths...]",
+		Short: "Report mutation counts per file and operator",
+		Long:  corpusReportLongDescription,
+		RunE: func(_ *cobra.Command, args []string) error {
+			minimums, err := loadCorpusMinimums(corpusReportExpectFlag)
+			if err != nil {
+				return err
+			}
+
+			return workflow.CorpusReport(domain.CorpusArgs{
+				Paths:    parsePaths(args),
+				Exclude:  corpusReportExcludeFlags,
+				Minimums: minimums,
+			})
+		},
+	}
+	cmd.Flags().StringArrayVarP(&corpusReportExcludeFlags, "exclude", "x", nil, "exclude files matching regex (can be repeated; --ignore is an alias)")
+	cmd.Flags().SetNormalizeFunc(ignoreAlias)
+	cmd.Flags().StringVar(&corpusReportExpectFlag, "expect", "", "YAML file with minimum mutation counts per file and mutation type")
+
+	return cmd
+}
+
+func loadCorpusMinimums(path string) (map[m.Path]map[string]int, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read corpus expectations: %w", err)
+	}
+
+	var relative map[string]map[string]int
+	if err := yaml.Unmarshal(data, &relative); err != nil {
+		return nil, fmt.Errorf("parse corpus expectations %s: %w", path, err)
+	}
+
+	baseDir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("resolve corpus expectations directory: %w", err)
+	}
+
+	minimums := make(map[m.Path]map[string]int, len(relative))
+	for file, perType := range relative {
+		minimums[m.Path(filepath.Join(baseDir, filepath.FromSlash(file)))] = perType
+	}
+
+	return minimums, nil
+}
+
+func init() {
+	rootCmd.AddCommand(corpusReportCmd)
+}
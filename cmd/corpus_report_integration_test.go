@@ -0,0 +1,34 @@
+//go:build integration
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCorpusReport_ExamplesMeetMinimums runs real mutation generation over the
+// examples corpus and fails if any operator produces fewer mutations than
+// recorded in examples/corpus.yaml.
+func TestCorpusReport_ExamplesMeetMinimums(t *testing.T) {
+	var out bytes.Buffer
+
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&out)
+	defer func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+	}()
+
+	rootCmd.SetArgs([]string{
+		"corpus-report",
+		"--exclude", "invalid",
+		"--expect", "../examples/corpus.yaml",
+		"../examples/...",
+	})
+
+	err := rootCmd.Execute()
+	require.NoError(t, err, out.String())
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorpusReportCmd_PassesPathsAndExcludes(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newCorpusReportCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("CorpusReport", mock.MatchedBy(func(args domain.CorpusArgs) bool {
+		return len(args.Paths) == 1 && args.Paths[0] == m.Path("./examples/...") &&
+			len(args.Exclude) == 1 && args.Exclude[0] == "invalid" &&
+			args.Minimums == nil
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"corpus-report", "-x", "invalid", "./examples/..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestCorpusReportCmd_ExpectResolvesPathsAgainstFile(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	dir := t.TempDir()
+	expectPath := filepath.Join(dir, "corpus.yaml")
+	require.NoError(t, os.WriteFile(expectPath, []byte("loops/main.go:\n  loop: 3\n"), 0o600))
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newCorpusReportCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	wantPath := m.Path(filepath.Join(dir, "loops", "main.go"))
+	mockWorkflow.On("CorpusReport", mock.MatchedBy(func(args domain.CorpusArgs) bool {
+		return args.Minimums[wantPath]["loop"] == 3
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"corpus-report", "--expect", expectPath, "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestCorpusReportCmd_MissingExpectFile(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.AddCommand(newCorpusReportCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	cmd.SetArgs([]string{"corpus-report", "--expect", filepath.Join(t.TempDir(), "missing.yaml")})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "read corpus expectations")
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// diffCmd represents the diff command.
+var diffCmd = newDiffCmd()
+
+const diffLongDescription = `Compare two reports directories, such as the reports of the base branch and
+of a pull request, and list the mutants that newly survive, the mutants that
+are newly killed and the change in mutation score.
+
+Mutants are matched by ID. A survivor that did not exist in the old reports,
+for example one in new code, is listed as a new mutant. The tables are
+Markdown, so the output can be posted as a pull request comment. Use
+--progress-format json to print the comparison as a JSON diff event.`
+
+func newDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff old-reports new-reports",
+		Short: "Compare two reports directories",
+		Long:  diffLongDescription,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return workflow.CompareReports(domain.CompareArgs{
+				Old: m.Path(args[0]),
+				New: m.Path(args[1]),
+			})
+		},
+	}
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffCmd_PassesBothDirectories(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newDiffCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("CompareReports", domain.CompareArgs{Old: "base-reports", New: "pr-reports"}).Return(nil)
+
+	cmd.SetArgs([]string{"diff", "base-reports", "pr-reports"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestDiffCmd_RequiresTwoDirectories(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newDiffCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	cmd.SetArgs([]string{"diff", "base-reports"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	mockWorkflow.AssertNotCalled(t, "CompareReports", mock.Anything)
+}
+
+func TestNewDiffCmd(t *testing.T) {
+	cmd := newDiffCmd()
+
+	assert.Equal(t, "diff old-reports new-reports", cmd.Use)
+	assert.Equal(t, diffLongDescription, cmd.Long)
+}
@@ -0,0 +1,29 @@
+//go:build integration
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestE2E_FixtureOutcomes runs the whole pipeline over the built-in fixture
+// project and fails if any mutant is not killed or does not survive as
+// expected.
+func TestE2E_FixtureOutcomes(t *testing.T) {
+	var out bytes.Buffer
+
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&out)
+	defer func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+	}()
+
+	rootCmd.SetArgs([]string{"e2e", "--no-tui", "--project", t.TempDir()})
+
+	err := rootCmd.Execute()
+	require.NoError(t, err, out.String())
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestE2ECmd_CreatesProject(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newE2ECmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	project := filepath.Join(t.TempDir(), "nested", "fixture")
+
+	mockWorkflow.EXPECT().E2E(domain.E2EArgs{Project: m.Path(project), Threads: 2}).Return(nil)
+
+	cmd.SetArgs([]string{"e2e", "--project", project, "--parallel", "2"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+	assert.DirExists(t, project)
+}
+
+func TestE2ECmd_DefaultsToTemporaryProject(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newE2ECmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.EXPECT().E2E(domain.E2EArgs{}).Return(nil)
+
+	cmd.SetArgs([]string{"e2e"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
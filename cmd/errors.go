@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+)
+
+// Exit codes. Errors outside the catalog exit with exitFailure.
+const (
+	exitFailure         = 1
+	exitNoSources       = 2
+	exitNoTests         = 3
+	exitBaselineFailing = 4
+	exitToolchain       = 5
+	exitDiskBudget      = 6
+	// exitInterrupted follows the shell's 128+SIGINT.
+	exitInterrupted = 130
+)
+
+// catalogEntry pairs a domain error with its exit code and the message shown
+// in place of the wrapped error chain.
+type catalogEntry struct {
+	err     error
+	code    int
+	message string
+}
+
+var errorCatalog = []catalogEntry{
+	{
+		err:     domain.ErrNoSources,
+		code:    exitNoSources,
+		message: "no Go source files to mutate: check the paths and any --exclude or --tags flags",
+	},
+	{
+		err:     domain.ErrNoTests,
+		code:    exitNoTests,
+		message: "the files to mutate have no tests: add _test.go files, or try --test-scope dependents",
+	},
+	{
+		err:     domain.ErrBaselineFailing,
+		code:    exitBaselineFailing,
+		message: "the tests fail before any mutation: run go test on the mutated packages and fix them first",
+	},
+	{
+		err:     domain.ErrToolchain,
+		code:    exitToolchain,
+		message: "the go command could not be run: install Go and make sure it is on PATH (docker, with --runner docker)",
+	},
+	{
+		err:     domain.ErrDiskBudget,
+		code:    exitDiskBudget,
+		message: "a copy of the project does not fit the disk budget: free up the work directory, point --work-dir elsewhere or raise --disk-budget",
+	},
+	{
+		err:     domain.ErrInterrupted,
+		code:    exitInterrupted,
+		message: "interrupted: the mutations tested so far were saved and the rest reported as not run; run again to test them",
+	},
+}
+
+// describeError returns the exit code and message for err, falling back to
+// exitFailure and the error itself for errors outside the catalog.
+func describeError(err error) (int, string) {
+	for _, entry := range errorCatalog {
+		if errors.Is(err, entry.err) {
+			return entry.code, entry.message
+		}
+	}
+
+	return exitFailure, err.Error()
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/domain"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "no sources", err: fmt.Errorf("generate mutations: %w under ./pkg", domain.ErrNoSources), code: exitNoSources},
+		{name: "no tests", err: fmt.Errorf("%w: none of the 3 mutations has a _test.go file in its package", domain.ErrNoTests), code: exitNoTests},
+		{name: "baseline", err: fmt.Errorf("%w: go test . in /p: exit status 1", domain.ErrBaselineFailing), code: exitBaselineFailing},
+		{name: "toolchain", err: errors.Join(fmt.Errorf("run mutation tests: %w", fmt.Errorf("%w: exec: not found", adapter.ErrGoToolchainNotFound))), code: exitToolchain},
+		{name: "disk budget", err: fmt.Errorf("%w: a copy of the project takes 2.0 GiB, the budget is 1.0 GiB", domain.ErrDiskBudget), code: exitDiskBudget},
+		{name: "interrupted", err: domain.ErrInterrupted, code: exitInterrupted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, message := describeError(tt.err)
+
+			assert.Equal(t, tt.code, code)
+			assert.NotContains(t, message, tt.err.Error())
+		})
+	}
+
+	code, message := describeError(errors.New("bad key"))
+	assert.Equal(t, exitFailure, code)
+	assert.Equal(t, "bad key", message)
+}
+
+func TestExecute_ProcessLevel_CatalogError(t *testing.T) {
+	if os.Getenv("TEST_EXECUTE_SUBPROCESS_CATALOG") == "1" {
+		mockCmd := &cobra.Command{
+			Use: "test",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return fmt.Errorf("generate mutations: get sources: %w under ./empty", domain.ErrNoSources)
+			},
+		}
+		mockCmd.SetOut(os.Stdout)
+		mockCmd.SetErr(os.Stderr)
+		rootCmd = mockCmd
+
+		Execute()
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestExecute_ProcessLevel_CatalogError")
+	cmd.Env = append(os.Environ(), "TEST_EXECUTE_SUBPROCESS_CATALOG=1")
+	output, err := cmd.CombinedOutput()
+
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, exitNoSources, exitErr.ExitCode())
+	assert.Contains(t, string(output), "Error: no Go source files to mutate")
+	assert.NotContains(t, string(output), "get sources")
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// execCmd represents the exec command.
+var execCmd = newExecCmd()
+var execPlanFlag string
+var execShardFlag string
+var execShardStrategyFlag string
+var execParallelFlag int
+var execTimeoutFlag time.Duration
+var execTestScopeFlag string
+var execJUnitOutFlag string
+
+const execLongDescription = `Test the mutations recorded by gooze plan, as gooze run tests the
+mutations it generates. The mutations are generated again from the plan's
+paths and settings and only the planned ones are kept, so every shard splits
+the same list even if the sources regenerate slightly differently:
+  gooze exec --plan plan.yaml --shard 1/4
+
+A planned mutation that its file no longer produces fails the run, since
+no shard could test it; record the plan again after changing the sources.
+Reports are written as gooze run writes them, so sharded executions are
+merged with gooze merge.`
+
+func newExecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Run mutation testing from a plan file",
+		Long:  execLongDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if execPlanFlag == "" {
+				return fmt.Errorf("exec requires --plan")
+			}
+
+			shardIndexes, totalShards := parseShardsFlag(execShardFlag)
+
+			shardIndex := shardIndexes[0]
+			if len(shardIndexes) == 1 {
+				shardIndexes = nil
+			}
+
+			ctx, stop := interruptContext(cmd.Context())
+			defer stop()
+
+			return workflow.Exec(domain.ExecArgs{
+				TestArgs: domain.TestArgs{
+					EstimateArgs: domain.EstimateArgs{
+						UseCache: !noCacheFlag,
+						Reports:  m.Path(reportsOutputDirFlag),
+					},
+					Reports:         m.Path(reportsOutputDirFlag),
+					Threads:         execParallelFlag,
+					ShardIndex:      shardIndex,
+					ShardIndexes:    shardIndexes,
+					TotalShardCount: totalShards,
+					ShardStrategy:   domain.ShardStrategy(execShardStrategyFlag),
+					JUnitOut:        m.Path(execJUnitOutFlag),
+					Timeout:         execTimeoutFlag,
+					TestScope:       domain.TestScope(execTestScopeFlag),
+					ManifestArgs:    manifestArgs(""),
+					Stop:            ctx.Done(),
+				},
+				Plan: m.Path(execPlanFlag),
+			})
+		},
+	}
+	cmd.Flags().StringVar(&execPlanFlag, "plan", "", "plan file written by gooze plan")
+	cmd.Flags().StringVarP(&execShardFlag, "shard", "s", "", "shard index and total shard count in the format INDEX/TOTAL (e.g., 0/3), or several shards as for run (e.g., 0-2/8); --shards is an alias")
+	cmd.Flags().StringVar(&execShardStrategyFlag, "shard-strategy", string(domain.ShardStrategyHash), "how --shard splits the planned mutations: hash (by mutation ID) or balanced (by durations recorded in --output)")
+	cmd.Flags().IntVarP(&execParallelFlag, "parallel", "p", 0, "number of parallel workers for mutation testing (0 picks one from CPUs and available memory)")
+	cmd.Flags().DurationVar(&execTimeoutFlag, "timeout", domain.DefaultTestTimeout, "base time budget for each mutation's tests")
+	cmd.Flags().StringVar(&execTestScopeFlag, "test-scope", string(domain.TestScopeFile), "tests to run per mutant: file, package or dependents, as for run")
+	cmd.Flags().StringVar(&execJUnitOutFlag, "junit-out", "", "also write results as JUnit XML to this file (survived mutants are failures)")
+	cmd.Flags().SetNormalizeFunc(runFlagAliases)
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(execCmd)
+}
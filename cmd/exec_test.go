@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecCmd_ExecutesPlanShard(t *testing.T) {
+	tests := []struct {
+		name        string
+		shard       string
+		wantIndex   int
+		wantIndexes []int
+	}{
+		{name: "single shard", shard: "1/4", wantIndex: 1},
+		{name: "several shards", shard: "2-3/4", wantIndex: 2, wantIndexes: []int{2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newExecCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Exec", mock.MatchedBy(func(args domain.ExecArgs) bool {
+				return args.Plan == "plan.yaml" && args.ShardIndex == tt.wantIndex && args.TotalShardCount == 4 &&
+					assert.ObjectsAreEqual(tt.wantIndexes, args.ShardIndexes) && args.Threads == 2
+			})).Return(nil)
+
+			cmd.SetArgs([]string{"exec", "--plan", "plan.yaml", "--shard", tt.shard, "-p", "2"})
+			err := cmd.Execute()
+			require.NoError(t, err)
+
+			mockWorkflow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExecCmd_RequiresPlan(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.AddCommand(newExecCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	execPlanFlag = ""
+
+	cmd.SetArgs([]string{"exec"})
+	err := cmd.Execute()
+	require.EqualError(t, err, "exec requires --plan")
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+)
+
+// interruptContext returns a context canceled by the first Ctrl+C, which
+// lets a run stop gracefully. The handler is then removed, so a second
+// Ctrl+C ends the process at once.
+func interruptContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(parent, os.Interrupt)
+
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	return ctx, stop
+}
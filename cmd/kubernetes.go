@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// kubernetesCmd represents the kubernetes command.
+var kubernetesCmd = newKubernetesCmd()
+var (
+	kubernetesShardsFlag      int
+	kubernetesImageFlag       string
+	kubernetesNamespaceFlag   string
+	kubernetesCPUFlag         string
+	kubernetesMemoryFlag      string
+	kubernetesRunFlagsFlag    string
+	kubernetesTimeoutFlag     time.Duration
+	kubernetesManifestKeyFlag string
+)
+
+const kubernetesLongDescription = `Run mutation testing as one Kubernetes Job per shard and merge the shard
+reports into --output, e.g. for nightly runs over a large monorepo:
+  gooze kubernetes --shards 20 --image registry.example.com/gooze:latest ./...
+
+Each job receives a copy of the project, without version control
+directories, and runs "gooze run -s INDEX/TOTAL" on it; its reports are
+downloaded when it finishes. A reports directory inside the project is part
+of the copy, so the jobs reuse its cached results. The image must provide
+gooze, go and tar. Jobs are created with the kubectl of the current context.
+
+Use --run-flags to pass further gooze run flags to every shard, e.g.
+  --run-flags "--test-scope package --timeout 2m"`
+
+func newKubernetesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "kubernetes [paths...]",
+		Aliases: []string{"k8s"},
+		Short:   "Run shards of mutation testing as Kubernetes Jobs",
+		Long:    kubernetesLongDescription,
+		RunE: func(_ *cobra.Command, args []string) error {
+			dir, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("get working directory: %w", err)
+			}
+
+			return workflow.Kubernetes(domain.KubernetesArgs{
+				Dir:          m.Path(dir),
+				Paths:        parsePaths(args),
+				Shards:       kubernetesShardsFlag,
+				Image:        kubernetesImageFlag,
+				Namespace:    kubernetesNamespaceFlag,
+				CPU:          kubernetesCPUFlag,
+				Memory:       kubernetesMemoryFlag,
+				RunFlags:     strings.Fields(kubernetesRunFlagsFlag),
+				Timeout:      kubernetesTimeoutFlag,
+				Reports:      m.Path(reportsOutputDirFlag),
+				Runner:       adapter.NewKubectlJobRunner(),
+				ManifestArgs: manifestArgs(kubernetesManifestKeyFlag),
+			})
+		},
+	}
+	cmd.Flags().IntVar(&kubernetesShardsFlag, "shards", 4, "number of jobs to split the mutations across")
+	cmd.Flags().StringVar(&kubernetesImageFlag, "image", "", "container image providing gooze, go and tar (required)")
+	cmd.Flags().StringVar(&kubernetesNamespaceFlag, "namespace", "default", "namespace to create the jobs in")
+	cmd.Flags().StringVar(&kubernetesCPUFlag, "cpu", "", "CPU request and limit of each job, e.g. 4")
+	cmd.Flags().StringVar(&kubernetesMemoryFlag, "memory", "", "memory request and limit of each job, e.g. 8Gi")
+	cmd.Flags().StringVar(&kubernetesRunFlagsFlag, "run-flags", "", "further gooze run flags for every shard, e.g. '--test-scope package'")
+	cmd.Flags().DurationVar(&kubernetesTimeoutFlag, "job-timeout", 6*time.Hour, "time each job may take until its shard's run exits (0 for no limit)")
+	cmd.Flags().StringVar(&kubernetesManifestKeyFlag, "manifest-key", "", "sign the merged reports manifest with this ed25519 private key (PKCS#8 PEM)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(kubernetesCmd)
+}
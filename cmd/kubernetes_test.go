@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesCmd_Flags(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newKubernetesCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+
+	mockWorkflow.On("Kubernetes", mock.MatchedBy(func(args domain.KubernetesArgs) bool {
+		return args.Dir == m.Path(dir) &&
+			slices.Equal(args.Paths, []m.Path{"./pkg/..."}) &&
+			args.Shards == 20 &&
+			args.Image == "registry.example.com/gooze" &&
+			args.Namespace == "ci" &&
+			args.Memory == "8Gi" &&
+			slices.Equal(args.RunFlags, []string{"--test-scope", "package"}) &&
+			args.Reports == m.Path("nightly") &&
+			args.Timeout == 90*time.Minute &&
+			args.Runner != nil
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"k8s", "-o", "nightly", "--shards", "20", "--image", "registry.example.com/gooze",
+		"--namespace", "ci", "--memory", "8Gi", "--run-flags", "--test-scope package", "--job-timeout", "90m", "./pkg/..."})
+	require.NoError(t, cmd.Execute())
+}
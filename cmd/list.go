@@ -3,6 +3,7 @@ package cmd
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/mouse-blink/gooze/internal/controller"
 	"github.com/mouse-blink/gooze/internal/domain"
 	m "github.com/mouse-blink/gooze/internal/model"
 )
@@ -10,25 +11,74 @@ import (
 // listCmd represents the list command.
 var listCmd = newListCmd()
 var listExcludeFlags []string
+var listIncludeTestHelpersFlag bool
+var listFuncFlag string
+var listScopeFlag string
+var listLinesFlag string
+var listTagsFlag []string
+var listNumbersFlag []string
+var listJSONFlag bool
+var listTimeBaselineFlag bool
+var listParallelFlag int
+var listShardFlag string
 
 func newListCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list [paths...]",
-		Short: "List source files and mutation counts",
-		Long:  listLongDescription,
+		Use:     "list [paths...]",
+		Aliases: []string{"estimate"},
+		Short:   "List source files and mutation counts",
+		Long:    listLongDescription,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			if listJSONFlag {
+				useUI(controller.NewJSONUI(cmd.OutOrStdout()))
+			}
+
+			return nil
+		},
 		RunE: func(_ *cobra.Command, args []string) error {
-			paths := parsePaths(args)
+			if err := selectNumberVariants(listNumbersFlag); err != nil {
+				return err
+			}
+
+			paths, lines, err := parseLinePaths(args, listLinesFlag)
+			if err != nil {
+				return err
+			}
+
 			useCache := !noCacheFlag
 
+			var runtime *domain.RuntimeArgs
+			if listTimeBaselineFlag {
+				shardIndex, totalShards := parseShardFlag(listShardFlag)
+				runtime = &domain.RuntimeArgs{Threads: listParallelFlag, ShardIndex: shardIndex, TotalShardCount: totalShards}
+			}
+
 			return workflow.Estimate(domain.EstimateArgs{
-				Paths:    paths,
-				Exclude:  listExcludeFlags,
-				UseCache: useCache,
-				Reports:  m.Path(reportsOutputDirFlag),
+				Paths:              paths,
+				Exclude:            listExcludeFlags,
+				UseCache:           useCache,
+				Reports:            m.Path(reportsOutputDirFlag),
+				IncludeTestHelpers: listIncludeTestHelpersFlag,
+				Func:               listFuncFlag,
+				Scope:              domain.CodeScope(listScopeFlag),
+				Lines:              lines,
+				Tags:               listTagsFlag,
+				Runtime:            runtime,
 			})
 		},
 	}
-	cmd.Flags().StringArrayVarP(&listExcludeFlags, "exclude", "x", nil, "exclude files matching regex (can be repeated)")
+	cmd.Flags().StringArrayVarP(&listExcludeFlags, "exclude", "x", nil, "exclude files matching regex (can be repeated; --ignore is an alias)")
+	cmd.Flags().SetNormalizeFunc(ignoreAlias)
+	cmd.Flags().BoolVar(&listIncludeTestHelpersFlag, "include-test-helpers", false, "also mutate non-test files that import testing or assertion libraries")
+	cmd.Flags().StringVar(&listFuncFlag, "func", "", "only mutate functions whose name (Name or Type.Method) matches this regex")
+	cmd.Flags().StringVar(&listScopeFlag, "scope", "", "only mutate exported functions and methods of exported types (exported) or the rest (unexported)")
+	cmd.Flags().StringVar(&listLinesFlag, "lines", "", "only count lines START-END of the single file given (same as FILE.go:START-END)")
+	cmd.Flags().StringSliceVar(&listTagsFlag, "tags", nil, "comma-separated build tags; files whose //go:build line excludes them are skipped")
+	cmd.Flags().StringSliceVar(&listNumbersFlag, "numbers", nil, "variants of numbers mutations to generate, comma-separated: "+numberVariantNames()+" (default all)")
+	cmd.Flags().BoolVar(&listTimeBaselineFlag, "time-baseline", false, "run each package's tests once, timed, to project the run's duration")
+	cmd.Flags().IntVarP(&listParallelFlag, "parallel", "p", 0, "number of parallel workers to project --time-baseline for (0 picks one from CPUs and available memory)")
+	cmd.Flags().StringVarP(&listShardFlag, "shard", "s", "", "also project --time-baseline for this shard, in the format INDEX/TOTAL as for run (e.g., 0/3)")
+	cmd.Flags().BoolVar(&listJSONFlag, "json", false, "print the estimate as a JSON event with per-file, per-type counts (same as --progress-format json)")
 
 	return cmd
 }
@@ -2,8 +2,12 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/mouse-blink/gooze/internal/controller"
 	"github.com/mouse-blink/gooze/internal/domain"
 	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +38,40 @@ func TestListCmd_UsesCache(t *testing.T) {
 	mockWorkflow.AssertExpectations(t)
 }
 
+func TestListCmd_TimeBaseline(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want *domain.RuntimeArgs
+	}{
+		{name: "off", args: []string{"list", "./..."}},
+		{name: "on", args: []string{"list", "--time-baseline", "./..."}, want: &domain.RuntimeArgs{TotalShardCount: 1}},
+		{name: "sharded", args: []string{"list", "--time-baseline", "-p", "4", "--shard", "1/3", "./..."}, want: &domain.RuntimeArgs{Threads: 4, ShardIndex: 1, TotalShardCount: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newListCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Estimate", mock.MatchedBy(func(args domain.EstimateArgs) bool {
+				return assert.ObjectsAreEqual(tt.want, args.Runtime)
+			})).Return(nil)
+
+			cmd.SetArgs(tt.args)
+			require.NoError(t, cmd.Execute())
+		})
+	}
+}
+
 func TestListCmd_NoCacheFlag_DisablesCache(t *testing.T) {
 	mockWorkflow := domainmocks.NewMockWorkflow(t)
 
@@ -80,6 +118,121 @@ func TestListCmd_WithExcludePatterns(t *testing.T) {
 	mockWorkflow.AssertExpectations(t)
 }
 
+func TestListCmd_IgnoreIsAnAliasOfExclude(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newListCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Estimate", mock.MatchedBy(func(args domain.EstimateArgs) bool {
+		return len(args.Exclude) == 1 && args.Exclude[0] == "mocks/"
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"list", "--ignore", "mocks/", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestListCmd_IncludeTestHelpersFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newListCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Estimate", mock.MatchedBy(func(args domain.EstimateArgs) bool {
+		return args.IncludeTestHelpers
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"list", "--include-test-helpers", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestListCmd_FuncFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newListCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Estimate", mock.MatchedBy(func(args domain.EstimateArgs) bool {
+		return args.Func == "Calc\\.Add"
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"list", "--func", "Calc\\.Add", "./calc.go"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestListCmd_ScopeFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newListCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Estimate", mock.MatchedBy(func(args domain.EstimateArgs) bool {
+		return args.Scope == domain.CodeScopeUnexported
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"list", "--scope", "unexported", "./calc.go"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestListCmd_TagsFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newListCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Estimate", mock.MatchedBy(func(args domain.EstimateArgs) bool {
+		return assert.ObjectsAreEqual([]string{"integration", "slow"}, args.Tags)
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"list", "--tags", "integration,slow", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
 func TestNewListCmd(t *testing.T) {
 	cmd := newListCmd()
 
@@ -91,3 +244,33 @@ func TestNewListCmd(t *testing.T) {
 	excludeFlag := cmd.Flags().Lookup("exclude")
 	assert.NotNil(t, excludeFlag)
 }
+
+func TestListCmd_JSONFlag(t *testing.T) {
+	originalUI, originalWorkflow := ui, workflow
+	defer func() {
+		ui, workflow = originalUI, originalWorkflow
+		listJSONFlag = false
+	}()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "calc.go"),
+		[]byte("package calc\n\nfunc Add(a, b int) int { return a + b }\n"), 0o600))
+
+	var out bytes.Buffer
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newListCmd())
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	cmd.SetArgs([]string{"estimate", "--json", "--no-cache", dir})
+	require.NoError(t, cmd.Execute())
+
+	var event controller.ProgressEvent
+	require.NoError(t, json.Unmarshal(out.Bytes(), &event))
+
+	assert.Equal(t, controller.EventEstimate, event.Event)
+	require.Len(t, event.Files, 1)
+	assert.Equal(t, event.Files[0].Mutations, event.Files[0].ByType["arithmetic"])
+	assert.Positive(t, event.Files[0].Mutations)
+}
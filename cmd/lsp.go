@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// lspCmd represents the lsp command.
+var lspCmd = newLSPCmd()
+var (
+	lspReportsFlag      string
+	lspPollIntervalFlag time.Duration
+)
+
+const lspLongDescription = `Serve survived mutants as diagnostics over the Language Server Protocol on
+stdin and stdout, for editors to mark untested code where it lives. Point
+the editor's generic LSP client at "gooze lsp" for Go files, next to gopls.
+
+The server answers from the reports of earlier runs and does not run tests
+itself: keep them fresh with gooze run, or leave gooze watch running. It
+watches the reports directory and publishes the open documents'
+diagnostics again when a run saves new results. A file edited since it was
+tested shows no diagnostics until it is tested again.
+
+--reports defaults to the value of --output.`
+
+func newLSPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Serve survived mutants to editors over the Language Server Protocol",
+		Long:  lspLongDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			reports := lspReportsFlag
+			if reports == "" {
+				reports = reportsOutputDirFlag
+			}
+
+			return workflow.LSP(domain.LSPArgs{
+				Reports:      m.Path(reports),
+				PollInterval: lspPollIntervalFlag,
+				In:           cmd.InOrStdin(),
+				Out:          cmd.OutOrStdout(),
+			})
+		},
+	}
+	cmd.Flags().StringVar(&lspReportsFlag, "reports", "", "reports directory to serve results from (default: --output)")
+	cmd.Flags().DurationVar(&lspPollIntervalFlag, "poll-interval", domain.DefaultLSPPollInterval, "how often to check the reports directory for new results")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(lspCmd)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLSPCmd_ServesOnStdio(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newLSPCmd())
+
+	in := &bytes.Buffer{}
+	out := &bytes.Buffer{}
+	cmd.SetIn(in)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("LSP", mock.MatchedBy(func(args domain.LSPArgs) bool {
+		return args.Reports == m.Path("ci-reports") && args.PollInterval == 5*time.Second && args.In == in && args.Out == out
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"lsp", "--reports", "ci-reports", "--poll-interval", "5s"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestLSPCmd_DefaultsToOutputDir(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newLSPCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("LSP", mock.MatchedBy(func(args domain.LSPArgs) bool {
+		return args.Reports == m.Path("out") && args.PollInterval == domain.DefaultLSPPollInterval
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"lsp", "-o", "out"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// markEquivalentCmd represents the mark-equivalent command.
+var markEquivalentCmd = newMarkEquivalentCmd()
+var markEquivalentReportsFlag string
+
+const markEquivalentLongDescription = `Mark a survived mutation as equivalent: a mutant that behaves exactly like
+the original code, so no test can ever kill it.
+
+The ID is the mutation's ID in the reports, or any prefix of it that only it
+starts with, such as the four characters the results view shows. Its saved
+result turns into a skipped one, and later runs skip the mutation as well,
+so it no longer counts against the mutation score. The decision is kept in
+_equivalents.yaml next to the reports.
+
+Mutation IDs hash the mutated code, so once the file is edited the mutation
+is a new one and is tested again.
+
+Survivors can also be marked from the results view of gooze run and gooze
+view by selecting them and pressing e.
+
+--reports defaults to the value of --output.`
+
+func newMarkEquivalentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mark-equivalent <id>",
+		Short: "Mark a survived mutation as equivalent to the original code",
+		Long:  markEquivalentLongDescription,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			reports := markEquivalentReportsFlag
+			if reports == "" {
+				reports = reportsOutputDirFlag
+			}
+
+			return workflow.MarkEquivalent(domain.MarkEquivalentArgs{
+				Reports:    m.Path(reports),
+				MutationID: args[0],
+			})
+		},
+	}
+	cmd.Flags().StringVar(&markEquivalentReportsFlag, "reports", "", "reports directory holding the mutation (default: --output)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(markEquivalentCmd)
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkEquivalentCmd_DefaultsToRootOutput(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newMarkEquivalentCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.EXPECT().MarkEquivalent(domain.MarkEquivalentArgs{
+		Reports:    m.Path("./reports-dir"),
+		MutationID: "abcd",
+	}).Return(nil)
+
+	cmd.SetArgs([]string{"--output", "./reports-dir", "mark-equivalent", "abcd"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestMarkEquivalentCmd_RequiresID(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.AddCommand(newMarkEquivalentCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	cmd.SetArgs([]string{"mark-equivalent"})
+	err := cmd.Execute()
+	require.Error(t, err)
+}
+
+func TestMarkEquivalentCmd_ReturnsWorkflowError(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newMarkEquivalentCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	failure := errors.New("mutation abcd killed")
+	mockWorkflow.EXPECT().MarkEquivalent(domain.MarkEquivalentArgs{
+		Reports:    m.Path("elsewhere"),
+		MutationID: "abcd",
+	}).Return(failure)
+
+	cmd.SetArgs([]string{"mark-equivalent", "abcd", "--reports", "elsewhere"})
+	err := cmd.Execute()
+	require.ErrorIs(t, err, failure)
+}
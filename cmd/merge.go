@@ -8,6 +8,7 @@ import (
 
 // mergeCmd represents the merge command.
 var mergeCmd = newMergeCmd()
+var mergeManifestKeyFlag string
 
 func newMergeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -16,9 +17,13 @@ func newMergeCmd() *cobra.Command {
 		Long:  "Merge reports from shard_* subdirectories into a single reports directory.",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(_ *cobra.Command, _ []string) error {
-			return workflow.Merge(domain.MergeArgs{Reports: m.Path(reportsOutputDirFlag)})
+			return workflow.Merge(domain.MergeArgs{
+				Reports:      m.Path(reportsOutputDirFlag),
+				ManifestArgs: manifestArgs(mergeManifestKeyFlag),
+			})
 		},
 	}
+	cmd.Flags().StringVar(&mergeManifestKeyFlag, "manifest-key", "", "sign the reports manifest with this ed25519 private key (PKCS#8 PEM)")
 
 	return cmd
 }
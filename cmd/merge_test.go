@@ -53,6 +53,27 @@ func TestMergeCmd_RootOutputFlagIsPassedThrough(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMergeCmd_ManifestKeyFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newMergeCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Merge", mock.MatchedBy(func(args domain.MergeArgs) bool {
+		return args.ManifestKey == m.Path("ci.pem") && len(args.Command) > 0
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"merge", "--manifest-key", "ci.pem"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
 func TestMergeCmd_RejectsPositionalArg(t *testing.T) {
 	cmd := newRootCmd()
 	cmd.AddCommand(newMergeCmd())
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+)
+
+// mutateCmd represents the mutate command.
+var mutateCmd = newMutateCmd()
+var mutateOperatorFlag string
+var mutateIndexFlag int
+var mutateNumbersFlag []string
+
+const mutateLongDescription = `Read a Go source file on stdin and write it to stdout with one mutation
+applied, for scripts and editor integrations that want raw mutants:
+  gooze mutate --operator arithmetic --index 3 < calc.go > mutated.go
+
+--index selects among the mutations the operator generates, in source
+order, starting at 0; an index past the last one fails and reports how
+many there are. Nothing is tested and no reports are written.`
+
+func newMutateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mutate",
+		Short: "Write one mutant of the Go source on stdin to stdout",
+		Long:  mutateLongDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if mutateOperatorFlag == "" {
+				return fmt.Errorf("mutate requires --operator")
+			}
+
+			if err := selectNumberVariants(mutateNumbersFlag); err != nil {
+				return err
+			}
+
+			return workflow.Mutate(domain.MutateArgs{
+				Operator: mutateOperatorFlag,
+				Index:    mutateIndexFlag,
+				In:       cmd.InOrStdin(),
+				Out:      cmd.OutOrStdout(),
+			})
+		},
+	}
+	cmd.Flags().StringVar(&mutateOperatorFlag, "operator", "", "mutation type to apply, e.g. arithmetic, comparison or branch")
+	cmd.Flags().IntVar(&mutateIndexFlag, "index", 0, "which of the operator's mutations to apply, in source order starting at 0")
+	cmd.Flags().StringSliceVar(&mutateNumbersFlag, "numbers", nil, "variants of numbers mutations to generate, comma-separated: "+numberVariantNames()+" (default all)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(mutateCmd)
+}
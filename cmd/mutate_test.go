@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutateCmd_PipesSource(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newMutateCmd())
+	cmd.SetIn(strings.NewReader("package calc\n"))
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Mutate", mock.MatchedBy(func(args domain.MutateArgs) bool {
+		return args.Operator == "arithmetic" && args.Index == 3 && args.In != nil && args.Out == out
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"mutate", "--operator", "arithmetic", "--index", "3"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestMutateCmd_RequiresOperator(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.AddCommand(newMutateCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	mutateOperatorFlag = ""
+
+	cmd.SetArgs([]string{"mutate"})
+	err := cmd.Execute()
+	require.EqualError(t, err, "mutate requires --operator")
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// notifyCmd represents the notify command.
+var notifyCmd = newNotifyCmd()
+var (
+	notifyGitHubPRFlag  string
+	notifyGitLabMRFlag  string
+	notifyWebhookFlags  []string
+	notifyReportsFlag   string
+	notifyBaseFlag      string
+	notifySurvivorsFlag int
+)
+
+// Environment variables the notify command reads its access from. The API
+// URLs are set by GitHub Actions and GitLab CI.
+const (
+	githubTokenEnv  = "GITHUB_TOKEN"
+	githubAPIURLEnv = "GITHUB_API_URL"
+	gitlabTokenEnv  = "GITLAB_TOKEN"
+	gitlabAPIURLEnv = "CI_API_V4_URL"
+)
+
+const notifyLongDescription = `Push the mutation summary of a reports directory to pull requests, chat or
+dashboards after a run, e.g.
+  GITHUB_TOKEN=... gooze notify --github-pr mouse-blink/gooze#123
+  GITLAB_TOKEN=... gooze notify --gitlab-mr group/project!45
+  gooze notify --webhook https://hooks.example.com/gooze
+
+The summary has the score, the result counts and the first survived
+mutants with their diffs. Pass --base with the reports of the base branch
+to add the score delta and list the survivors new since the base first.
+
+--github-pr and --gitlab-mr post a comment on the pull or merge request,
+and update it when run again. Their tokens are read from GITHUB_TOKEN and
+GITLAB_TOKEN; GITHUB_API_URL and CI_API_V4_URL select self-hosted servers.
+--webhook, which may be repeated, POSTs the summary as JSON with a "text"
+line that chat incoming webhooks display. Every target is tried even when
+one fails.
+
+--reports defaults to the value of --output.`
+
+func newNotifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "notify",
+		Aliases: []string{"comment"},
+		Short:   "Push the mutation summary to pull requests or webhooks",
+		Long:    notifyLongDescription,
+		Args:    cobra.ExactArgs(0),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			notifiers, err := selectNotifiers()
+			if err != nil {
+				return err
+			}
+
+			reports := notifyReportsFlag
+			if reports == "" {
+				reports = reportsOutputDirFlag
+			}
+
+			return workflow.Notify(domain.NotifyArgs{
+				Reports:   m.Path(reports),
+				Base:      m.Path(notifyBaseFlag),
+				Survivors: notifySurvivorsFlag,
+				Notifiers: notifiers,
+			})
+		},
+	}
+	cmd.Flags().StringVar(&notifyGitHubPRFlag, "github-pr", "", "GitHub pull request to comment on, as owner/repo#number")
+	cmd.Flags().StringVar(&notifyGitLabMRFlag, "gitlab-mr", "", "GitLab merge request to comment on, as group/project!iid")
+	cmd.Flags().StringArrayVar(&notifyWebhookFlags, "webhook", nil, "URL to POST the summary to as JSON (repeatable)")
+	cmd.Flags().StringVar(&notifyReportsFlag, "reports", "", "reports directory to summarize (default: --output)")
+	cmd.Flags().StringVar(&notifyBaseFlag, "base", "", "reports directory of the base branch to compare the score with")
+	cmd.Flags().IntVar(&notifySurvivorsFlag, "survivors", domain.DefaultNotifySurvivors, "number of survived mutants to show with their diffs")
+
+	return cmd
+}
+
+// selectNotifiers builds a notifier for each target given on the command
+// line.
+func selectNotifiers() ([]adapter.Notifier, error) {
+	var notifiers []adapter.Notifier
+
+	if notifyGitHubPRFlag != "" {
+		pr, err := parseGitHubPR(notifyGitHubPRFlag)
+		if err != nil {
+			return nil, err
+		}
+
+		token := os.Getenv(githubTokenEnv)
+		if token == "" {
+			return nil, fmt.Errorf("%s is not set: a token is required to comment on %s", githubTokenEnv, pr)
+		}
+
+		notifiers = append(notifiers, adapter.NewGitHubCommenter(token, os.Getenv(githubAPIURLEnv), pr))
+	}
+
+	if notifyGitLabMRFlag != "" {
+		mr, err := parseGitLabMR(notifyGitLabMRFlag)
+		if err != nil {
+			return nil, err
+		}
+
+		token := os.Getenv(gitlabTokenEnv)
+		if token == "" {
+			return nil, fmt.Errorf("%s is not set: a token is required to comment on %s", gitlabTokenEnv, mr)
+		}
+
+		notifiers = append(notifiers, adapter.NewGitLabCommenter(token, os.Getenv(gitlabAPIURLEnv), mr))
+	}
+
+	for _, url := range notifyWebhookFlags {
+		if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+			return nil, fmt.Errorf("invalid --webhook %q (want an http or https URL)", url)
+		}
+
+		notifiers = append(notifiers, adapter.NewWebhookNotifier(url))
+	}
+
+	if len(notifiers) == 0 {
+		return nil, fmt.Errorf("no notification target: pass --github-pr, --gitlab-mr or --webhook")
+	}
+
+	return notifiers, nil
+}
+
+// parseGitHubPR parses a pull request given as owner/repo#number.
+func parseGitHubPR(value string) (m.PullRequest, error) {
+	repo, number, found := strings.Cut(value, "#")
+	owner, name, hasOwner := strings.Cut(repo, "/")
+
+	n, err := strconv.Atoi(number)
+	if !found || !hasOwner || owner == "" || name == "" || strings.Contains(name, "/") || err != nil || n <= 0 {
+		return m.PullRequest{}, fmt.Errorf("invalid --github-pr %q (want owner/repo#number)", value)
+	}
+
+	return m.PullRequest{Owner: owner, Repo: name, Number: n}, nil
+}
+
+// parseGitLabMR parses a merge request given as group/project!iid; the
+// project may sit in nested groups.
+func parseGitLabMR(value string) (m.MergeRequest, error) {
+	project, iid, found := strings.Cut(value, "!")
+
+	n, err := strconv.Atoi(iid)
+	if !found || !strings.Contains(project, "/") || strings.HasPrefix(project, "/") ||
+		strings.HasSuffix(project, "/") || err != nil || n <= 0 {
+		return m.MergeRequest{}, fmt.Errorf("invalid --gitlab-mr %q (want group/project!iid)", value)
+	}
+
+	return m.MergeRequest{Project: project, IID: n}, nil
+}
+
+func init() {
+	rootCmd.AddCommand(notifyCmd)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyCmd_SelectsEveryTarget(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newNotifyCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	t.Setenv(githubTokenEnv, "github-secret")
+	t.Setenv(gitlabTokenEnv, "gitlab-secret")
+
+	mockWorkflow.On("Notify", mock.MatchedBy(func(args domain.NotifyArgs) bool {
+		if args.Reports != "head-reports" || args.Base != "base-reports" || args.Survivors != 3 || len(args.Notifiers) != 4 {
+			return false
+		}
+
+		_, github := args.Notifiers[0].(*adapter.GitHubCommenter)
+		_, gitlab := args.Notifiers[1].(*adapter.GitLabCommenter)
+		_, webhook := args.Notifiers[2].(*adapter.WebhookNotifier)
+
+		return github && gitlab && webhook
+	})).Return(nil)
+
+	cmd.SetArgs([]string{
+		"notify", "--github-pr", "mouse-blink/gooze#123", "--gitlab-mr", "group/sub/project!7",
+		"--webhook", "https://hooks.example.com/a", "--webhook", "https://hooks.example.com/b",
+		"--reports", "head-reports", "--base", "base-reports", "--survivors", "3",
+	})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestNotifyCmd_CommentAlias(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newNotifyCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	t.Setenv(githubTokenEnv, "secret")
+
+	mockWorkflow.On("Notify", mock.MatchedBy(func(args domain.NotifyArgs) bool {
+		return len(args.Notifiers) == 1
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"comment", "--github-pr", "mouse-blink/gooze#123"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestNotifyCmd_RequiresTargetAndToken(t *testing.T) {
+	for name, tc := range map[string]struct {
+		args []string
+		want string
+	}{
+		"no target":    {args: []string{"notify"}, want: "no notification target"},
+		"github token": {args: []string{"notify", "--github-pr", "mouse-blink/gooze#123"}, want: "GITHUB_TOKEN is not set"},
+		"gitlab token": {args: []string{"notify", "--gitlab-mr", "group/project!1"}, want: "GITLAB_TOKEN is not set"},
+		"webhook URL":  {args: []string{"notify", "--webhook", "hooks.example.com"}, want: "invalid --webhook"},
+		"malformed MR": {args: []string{"notify", "--gitlab-mr", "project!1"}, want: "invalid --gitlab-mr"},
+		"malformed PR": {args: []string{"notify", "--github-pr", "gooze#1"}, want: "invalid --github-pr"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newNotifyCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			t.Setenv(githubTokenEnv, "")
+			t.Setenv(gitlabTokenEnv, "")
+
+			cmd.SetArgs(tc.args)
+			err := cmd.Execute()
+			require.ErrorContains(t, err, tc.want)
+			mockWorkflow.AssertNotCalled(t, "Notify", mock.Anything)
+		})
+	}
+}
+
+func TestParseGitHubPR(t *testing.T) {
+	pr, err := parseGitHubPR("mouse-blink/gooze#42")
+	require.NoError(t, err)
+	assert.Equal(t, m.PullRequest{Owner: "mouse-blink", Repo: "gooze", Number: 42}, pr)
+
+	for _, value := range []string{"", "gooze#42", "mouse-blink/gooze", "mouse-blink/gooze#x", "mouse-blink/gooze#0", "/gooze#1", "a/b/c#1"} {
+		_, err := parseGitHubPR(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParseGitLabMR(t *testing.T) {
+	mr, err := parseGitLabMR("group/sub/project!42")
+	require.NoError(t, err)
+	assert.Equal(t, m.MergeRequest{Project: "group/sub/project", IID: 42}, mr)
+
+	for _, value := range []string{"", "project!1", "group/project", "group/project!x", "group/project!0", "/project!1", "group/!1"} {
+		_, err := parseGitLabMR(value)
+		assert.Error(t, err, value)
+	}
+}
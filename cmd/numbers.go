@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// selectNumberVariants rebuilds the mutagen and the workflow with the
+// variants named by a command's --numbers, keeping the defaults when it
+// names none.
+func selectNumberVariants(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	variants, err := domain.ParseNumberVariants(names)
+	if err != nil {
+		return fmt.Errorf("invalid --numbers: %w", err)
+	}
+
+	mutagen = domain.NewMutagen(goFileAdapter, soirceFSAdapter, domain.WithNumberVariants(variants...))
+	workflow = newWorkflow(ui)
+
+	return nil
+}
+
+// numberVariantNames lists the accepted --numbers values for the help.
+func numberVariantNames() string {
+	names := make([]string, 0, len(m.NumberVariants))
+	for _, variant := range m.NumberVariants {
+		names = append(names, string(variant))
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectNumberVariants(t *testing.T) {
+	tests := []struct {
+		name        string
+		names       []string
+		wantMutagen bool
+		wantErr     string
+	}{
+		{"default keeps the mutagen", nil, false, ""},
+		{"variants rebuild the mutagen", []string{"increment", "decrement"}, true, ""},
+		{"unknown variant", []string{"double"}, false, `invalid --numbers: unknown number variant "double"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalMutagen, originalWorkflow := mutagen, workflow
+			defer func() { mutagen, workflow = originalMutagen, originalWorkflow }()
+
+			err := selectNumberVariants(tt.names)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMutagen, mutagen != originalMutagen)
+		})
+	}
+}
+
+func TestNumbersFlag_AppliedByGeneratingCommands(t *testing.T) {
+	tests := []struct {
+		name    string
+		command *cobra.Command
+		args    []string
+	}{
+		{"run", newRunCmd(), []string{"run"}},
+		{"list", newListCmd(), []string{"list"}},
+		{"plan", newPlanCmd(), []string{"plan", "--out", "plan.yaml"}},
+		{"watch", newWatchCmd(), []string{"watch"}},
+		{"mutate", newMutateCmd(), []string{"mutate", "--operator", "numbers"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				runNumbersFlag, listNumbersFlag, planNumbersFlag, watchNumbersFlag, mutateNumbersFlag = nil, nil, nil, nil, nil
+			}()
+
+			cmd := newRootCmd()
+			cmd.AddCommand(tt.command)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			cmd.SetArgs(append(tt.args, "--numbers", "double"))
+			err := cmd.Execute()
+			require.ErrorContains(t, err, "invalid --numbers")
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// planCmd represents the plan command.
+var planCmd = newPlanCmd()
+var planOutFlag string
+var planExcludeFlags []string
+var planIncludeTestHelpersFlag bool
+var planTagsFlag []string
+var planNumbersFlag []string
+
+const planLongDescription = `Generate the mutations of the given paths (default: current module) and
+record them in a plan file, without running any tests, so that every shard
+of a distributed run tests the same mutations:
+  gooze plan --out plan.yaml ./...
+  gooze exec --plan plan.yaml --shard 0/4     # on each runner, 0/4 to 3/4
+
+The plan lists each mutation's ID, file, operator and line, along with the
+paths, --exclude, --tags and --include-test-helpers it was generated with.
+
+` + pathPatternsHelp
+
+func newPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan [paths...]",
+		Short: "Record the mutations to test in a plan file",
+		Long:  planLongDescription,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if planOutFlag == "" {
+				return fmt.Errorf("plan requires --out")
+			}
+
+			if err := selectNumberVariants(planNumbersFlag); err != nil {
+				return err
+			}
+
+			return workflow.Plan(domain.PlanArgs{
+				EstimateArgs: domain.EstimateArgs{
+					Paths:              parsePaths(args),
+					Exclude:            planExcludeFlags,
+					IncludeTestHelpers: planIncludeTestHelpersFlag,
+					Tags:               planTagsFlag,
+				},
+				Out: m.Path(planOutFlag),
+			})
+		},
+	}
+	cmd.Flags().StringVar(&planOutFlag, "out", "", "file to write the plan to")
+	cmd.Flags().StringArrayVarP(&planExcludeFlags, "exclude", "x", nil, "exclude files matching regex (can be repeated; --ignore is an alias)")
+	cmd.Flags().SetNormalizeFunc(ignoreAlias)
+	cmd.Flags().BoolVar(&planIncludeTestHelpersFlag, "include-test-helpers", false, "also mutate non-test files that import testing or assertion libraries")
+	cmd.Flags().StringSliceVar(&planTagsFlag, "tags", nil, "comma-separated build tags; files whose //go:build line excludes them are skipped, and tests run with -tags")
+	cmd.Flags().StringSliceVar(&planNumbersFlag, "numbers", nil, "variants of numbers mutations to generate, comma-separated: "+numberVariantNames()+" (default all)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(planCmd)
+}
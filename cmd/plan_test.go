@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanCmd_RecordsPlan(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newPlanCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Plan", mock.MatchedBy(func(args domain.PlanArgs) bool {
+		return args.Out == "plan.yaml" &&
+			assert.ObjectsAreEqual([]m.Path{"./calc"}, args.Paths) &&
+			assert.ObjectsAreEqual([]string{"_gen"}, args.Exclude) &&
+			assert.ObjectsAreEqual([]string{"integration"}, args.Tags)
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"plan", "--out", "plan.yaml", "--ignore", "_gen", "--tags", "integration", "./calc"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestPlanCmd_RequiresOut(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.AddCommand(newPlanCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	planOutFlag = ""
+
+	cmd.SetArgs([]string{"plan", "./..."})
+	err := cmd.Execute()
+	require.EqualError(t, err, "plan requires --out")
+}
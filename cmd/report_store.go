@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// reportStoreFlag is the object store URL the reports directory is kept in.
+var reportStoreFlag string
+
+// Environment variables the object report store reads. The AWS ones are
+// those the AWS CLI and SDKs use; GOOGLE_OAUTH_ACCESS_TOKEN holds a token
+// such as `gcloud auth print-access-token` prints.
+const (
+	reportStoreEnv        = "GOOZE_REPORT_STORE"
+	awsAccessKeyIDEnv     = "AWS_ACCESS_KEY_ID"
+	awsSecretAccessKeyEnv = "AWS_SECRET_ACCESS_KEY"
+	awsSessionTokenEnv    = "AWS_SESSION_TOKEN"
+	awsRegionEnv          = "AWS_REGION"
+	awsDefaultRegionEnv   = "AWS_DEFAULT_REGION"
+	awsEndpointURLEnv     = "AWS_ENDPOINT_URL"
+	googleAccessTokenEnv  = "GOOGLE_OAUTH_ACCESS_TOKEN"
+)
+
+// reportStoreURL returns --report-store, falling back to $GOOZE_REPORT_STORE;
+// empty keeps the reports directory local only.
+func reportStoreURL(flag string) string {
+	if flag != "" {
+		return flag
+	}
+
+	return os.Getenv(reportStoreEnv)
+}
+
+// objectReportStore wraps local in a store mirroring the --output reports
+// directory to the object store at storeURL, with credentials from the
+// environment.
+func objectReportStore(local adapter.ReportStore, storeURL string) (adapter.ReportStore, error) {
+	scheme, _, prefix, err := adapter.ParseObjectStoreURL(storeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	region := os.Getenv(awsRegionEnv)
+	if region == "" {
+		region = os.Getenv(awsDefaultRegionEnv)
+	}
+
+	// AWS_ENDPOINT_URL points S3 requests at MinIO or another
+	// S3-compatible store.
+	endpoint := ""
+	if scheme == "s3" {
+		endpoint = os.Getenv(awsEndpointURLEnv)
+	}
+
+	objects, err := adapter.NewObjectStore(adapter.ObjectStoreConfig{
+		URL:             storeURL,
+		Endpoint:        endpoint,
+		Region:          region,
+		AccessKeyID:     os.Getenv(awsAccessKeyIDEnv),
+		SecretAccessKey: os.Getenv(awsSecretAccessKeyEnv),
+		SessionToken:    os.Getenv(awsSessionTokenEnv),
+		Token:           os.Getenv(googleAccessTokenEnv),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return adapter.NewObjectReportStore(local, m.Path(reportsOutputDirFlag), objects, prefix), nil
+}
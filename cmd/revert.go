@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// revertCmd represents the revert command.
+var revertCmd = newRevertCmd()
+var revertReportsFlag string
+
+const revertLongDescription = `Restore the file gooze apply wrote a mutation into with its original
+content, and forget the applied mutation. Edits made to the file since
+gooze apply are lost.
+
+--reports defaults to the value of --output.`
+
+func newRevertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revert",
+		Short: "Undo gooze apply, restoring the mutated file",
+		Long:  revertLongDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			reports := revertReportsFlag
+			if reports == "" {
+				reports = reportsOutputDirFlag
+			}
+
+			return workflow.Revert(domain.RevertArgs{
+				Reports: m.Path(reports),
+				Out:     cmd.OutOrStdout(),
+			})
+		},
+	}
+	cmd.Flags().StringVar(&revertReportsFlag, "reports", "", "reports directory holding the applied mutation (default: --output)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(revertCmd)
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevertCmd_UsesReportsFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRevertCmd())
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	revertReportsFlag = ""
+	defer func() { revertReportsFlag = "" }()
+
+	mockWorkflow.EXPECT().Revert(domain.RevertArgs{
+		Reports: m.Path("./other-reports"),
+		Out:     out,
+	}).Return(nil)
+
+	cmd.SetArgs([]string{"revert", "--reports", "./other-reports"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
@@ -4,12 +4,15 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/mouse-blink/gooze/internal/adapter"
 	"github.com/mouse-blink/gooze/internal/controller"
 	"github.com/mouse-blink/gooze/internal/domain"
 	m "github.com/mouse-blink/gooze/internal/model"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var goFileAdapter adapter.GoFileAdapter
@@ -17,6 +20,7 @@ var soirceFSAdapter adapter.SourceFSAdapter
 var reportStore adapter.ReportStore
 var fsAdapter adapter.SourceFSAdapter
 var testAdapter adapter.TestRunnerAdapter
+var workerLauncher adapter.WorkerLauncher
 var orchestrator domain.Orchestrator
 var mutagen domain.Mutagen
 var workflow domain.Workflow
@@ -28,6 +32,32 @@ var reportsOutputDirFlag string
 // noCacheFlag disables incremental caching when set.
 var noCacheFlag bool
 
+// noTUIFlag forces plain-text output even when stdout is a terminal.
+var noTUIFlag bool
+
+// plainFlag selects line-oriented output for screen readers and log capture.
+var plainFlag bool
+
+// progressFormatFlag selects how progress is rendered: auto, text or json.
+var progressFormatFlag string
+
+// reportBackendFlag selects how the reports directory stores reports: yaml
+// or sqlite.
+var reportBackendFlag string
+
+// Accepted --report-backend values.
+const (
+	reportBackendYAML   = "yaml"
+	reportBackendSQLite = "sqlite"
+)
+
+// Accepted --progress-format values.
+const (
+	progressFormatAuto = "auto"
+	progressFormatText = "text"
+	progressFormatJSON = "json"
+)
+
 func init() {
 	ui = controller.NewUI(rootCmd, controller.IsTTY(os.Stdout))
 	goFileAdapter = adapter.NewLocalGoFileAdapter()
@@ -35,21 +65,110 @@ func init() {
 	reportStore = adapter.NewReportStore()
 	fsAdapter = adapter.NewLocalSourceFSAdapter()
 	testAdapter = adapter.NewLocalTestRunnerAdapter()
+	workerLauncher = adapter.NewLocalWorkerLauncher()
 	orchestrator = domain.NewOrchestrator(fsAdapter, testAdapter)
 	mutagen = domain.NewMutagen(goFileAdapter, soirceFSAdapter)
-	workflow = domain.NewWorkflow(
-		soirceFSAdapter,
-		reportStore,
-		ui,
-		orchestrator,
-		mutagen,
+	workflow = newWorkflow(ui)
+}
+
+func newWorkflow(ui controller.UI) domain.Workflow {
+	return domain.NewWorkflow(
+		domain.WithSourceFS(soirceFSAdapter),
+		domain.WithReportStore(reportStore),
+		domain.WithUI(ui),
+		domain.WithOrchestrator(orchestrator),
+		domain.WithMutagen(mutagen),
+		domain.WithMutantChecker(domain.NewMutantChecker(goFileAdapter, soirceFSAdapter)),
 	)
 }
 
+// useUI swaps the UI and rewires the workflow to it.
+func useUI(selected controller.UI) {
+	ui = selected
+	workflow = newWorkflow(ui)
+}
+
+// useReportStore swaps the report store and rewires the workflow to it.
+func useReportStore(store adapter.ReportStore) {
+	reportStore = store
+	workflow = newWorkflow(ui)
+}
+
+// selectReportBackend applies --report-backend and --report-store; yaml
+// without a report store keeps the store built at startup.
+func selectReportBackend() error {
+	store := reportStore
+
+	switch reportBackendFlag {
+	case reportBackendYAML, "":
+	case reportBackendSQLite:
+		store = adapter.NewSQLiteReportStore()
+	default:
+		return fmt.Errorf("invalid --report-backend %q (want %s or %s)",
+			reportBackendFlag, reportBackendYAML, reportBackendSQLite)
+	}
+
+	if storeURL := reportStoreURL(reportStoreFlag); storeURL != "" {
+		remote, err := objectReportStore(store, storeURL)
+		if err != nil {
+			return err
+		}
+
+		store = remote
+	}
+
+	if store != reportStore {
+		useReportStore(store)
+	}
+
+	return nil
+}
+
+// useTestRunner swaps the test runner and rewires the orchestrator and the
+// workflow to it.
+func useTestRunner(runner adapter.TestRunnerAdapter) {
+	testAdapter = runner
+	orchestrator = domain.NewOrchestrator(fsAdapter, testAdapter)
+	workflow = newWorkflow(ui)
+}
+
+// selectUI applies --progress-format, --plain and --no-tui; auto keeps the
+// UI chosen from whether stdout is a terminal.
+func selectUI(root *cobra.Command) error {
+	switch progressFormatFlag {
+	case progressFormatJSON:
+		if plainFlag {
+			return fmt.Errorf("--plain cannot be combined with --progress-format %s", progressFormatJSON)
+		}
+
+		useUI(controller.NewJSONUI(root.OutOrStdout()))
+	case progressFormatText:
+		if plainFlag {
+			useUI(controller.NewPlainUI(root))
+		} else {
+			useUI(controller.NewSimpleUI(root))
+		}
+	case progressFormatAuto, "":
+		switch {
+		case plainFlag:
+			useUI(controller.NewPlainUI(root))
+		case noTUIFlag:
+			useUI(controller.NewSimpleUI(root))
+		}
+	default:
+		return fmt.Errorf("invalid --progress-format %q (want %s, %s or %s)",
+			progressFormatFlag, progressFormatAuto, progressFormatText, progressFormatJSON)
+	}
+
+	return nil
+}
+
 const pathPatternsHelp = `Supports Go-style path patterns:
-  - ./...          recursively scan current directory
-  - ./pkg/...      recursively scan pkg directory
-  - ./cmd ./pkg    scan multiple directories`
+  - ./...                 recursively scan current directory
+  - ./pkg/...             recursively scan pkg directory
+  - ./cmd ./pkg           scan multiple directories
+  - ./pkg/calc.go         a single file, tested against its whole package
+  - ./pkg/calc.go:40-120  only lines 40 to 120 of a single file (or --lines)`
 
 const rootLongDescription = `Gooze is a mutation testing tool for Go that helps you assess the quality
 of your test suite by introducing small changes (mutations) to your code
@@ -59,9 +178,27 @@ and verifying that your tests catch them.
 
 const runLongDescription = `Run mutation testing for the given paths (default: current module).
 
-` + pathPatternsHelp
+` + pathPatternsHelp + `
+
+Use --func to narrow mutations to matching functions, e.g.
+  gooze run ./pkg/calc.go --func 'Add|Calc\.Sub'
+--scope exported or unexported to the API other packages see or the rest,
+a line range to the lines being hardened, e.g.
+  gooze run ./pkg/calc.go:40-120
+or --sample and --max-mutations to test a reproducible subset, e.g.
+  gooze run --sample 0.2 --max-mutations 500 ./...
+Narrowed runs bypass the cache and are not saved to the reports directory.
 
-const listLongDescription = `List source files and the number of applicable mutations.
+Use --dry-run --out DIR to write each mutation's diff to DIR without
+running any tests, e.g. to review operators or tune --exclude.
+
+Use --max-duration to bound a run, e.g. in CI:
+  gooze run --max-duration 30m ./...
+Mutants on covered lines go first; those not started in time are
+reported as not run and the score is marked partial.`
+
+const listLongDescription = `List source files and the number of applicable mutations, broken down
+by mutation type, to budget a run before executing it.
 
 ` + pathPatternsHelp
 
@@ -73,23 +210,49 @@ func newRootCmd() *cobra.Command {
 		Use:   "gooze",
 		Short: "Go mutation testing tool",
 		Long:  rootLongDescription,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			// The arguments parsed, so later errors are not usage errors.
+			cmd.SilenceUsage = true
+
+			if err := selectReportBackend(); err != nil {
+				return err
+			}
+
+			if err := selectTheme(); err != nil {
+				return err
+			}
+
+			return selectUI(cmd.Root())
+		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return cmd.Help()
 		},
 	}
 
 	cmd.PersistentFlags().StringVarP(&reportsOutputDirFlag, "output", "o", ".gooze-reports", "output directory for mutation testing reports")
+	cmd.PersistentFlags().StringVar(&reportBackendFlag, "report-backend", reportBackendYAML, "how the reports directory stores reports: yaml (one file per report) or sqlite (a single _reports.db)")
+	cmd.PersistentFlags().StringVar(&reportStoreFlag, "report-store", "", "keep the reports directory in an object store, s3://bucket/prefix or gs://bucket/prefix (default $"+reportStoreEnv+")")
 	cmd.PersistentFlags().BoolVar(&noCacheFlag, "no-cache", false, "disable cached incremental runs (re-test everything)")
+	cmd.PersistentFlags().BoolVar(&noTUIFlag, "no-tui", false, "print plain-text progress and a summary table instead of the interactive UI")
+	cmd.PersistentFlags().BoolVar(&plainFlag, "plain", false, "print one plain line per update, without box drawing, bars or animation, for screen readers and log capture")
+	cmd.PersistentFlags().StringVar(&progressFormatFlag, "progress-format", progressFormatAuto, "progress output format: auto, text or json (newline-delimited events)")
+	cmd.PersistentFlags().StringVar(&themeFlag, "theme", "", "colors of the interactive UI: "+strings.Join(controller.ThemeNames, ", ")+" (default $"+themeEnv+", none when NO_COLOR is set, else dark)")
 
 	return cmd
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
+// Errors from the catalog are reported by their message and exit code
+// instead of the wrapped chain.
 func Execute() {
+	rootCmd.SilenceErrors = true
+
 	err := rootCmd.Execute()
 	if err != nil {
-		os.Exit(1)
+		code, message := describeError(err)
+		rootCmd.PrintErrln("Error:", message)
+		os.Exit(code)
 	}
 }
 
@@ -108,6 +271,64 @@ func parseShardFlag(shard string) (int, int) {
 	return index, total
 }
 
+// parseShardsFlag parses --shard as parseShardFlag does, also accepting a
+// comma-separated list of indexes and FIRST-LAST ranges before the total,
+// as in 0-2/8 or 0,3,5/8. Like parseShardFlag, it runs unsharded on
+// anything it cannot parse.
+func parseShardsFlag(shard string) ([]int, int) {
+	unsharded := []int{0}
+
+	list, totalText, ok := strings.Cut(shard, "/")
+	if !ok {
+		return unsharded, 1
+	}
+
+	total, err := strconv.Atoi(totalText)
+	if err != nil || total <= 0 {
+		return unsharded, 1
+	}
+
+	var indexes []int
+
+	for _, item := range strings.Split(list, ",") {
+		firstText, lastText, isRange := strings.Cut(item, "-")
+		if !isRange {
+			lastText = firstText
+		}
+
+		first, firstErr := strconv.Atoi(firstText)
+		last, lastErr := strconv.Atoi(lastText)
+
+		if firstErr != nil || lastErr != nil || first < 0 || last >= total || first > last {
+			return unsharded, 1
+		}
+
+		for index := first; index <= last; index++ {
+			indexes = append(indexes, index)
+		}
+	}
+
+	return indexes, total
+}
+
+// manifestArgs describes this invocation for the reports manifest.
+func manifestArgs(keyPath string) domain.ManifestArgs {
+	return domain.ManifestArgs{
+		Command:     os.Args,
+		ManifestKey: m.Path(keyPath),
+	}
+}
+
+// ignoreAlias accepts --ignore for --exclude, so that patterns such as
+// --ignore 'generated\.go$' read like the //gooze:ignore annotations.
+func ignoreAlias(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+	if name == "ignore" {
+		name = "exclude"
+	}
+
+	return pflag.NormalizedName(name)
+}
+
 func parsePaths(args []string) []m.Path {
 	paths := make([]m.Path, 0, len(args))
 	for _, arg := range args {
@@ -116,3 +337,42 @@ func parsePaths(args []string) []m.Path {
 
 	return paths
 }
+
+// parseLinePaths takes a line range from --lines or from a FILE.go:START-END
+// argument, returning the paths without it.
+func parseLinePaths(args []string, lines string) ([]m.Path, domain.LineRange, error) {
+	var lineRange domain.LineRange
+
+	if lines != "" {
+		parsed, err := domain.ParseLineRange(lines)
+		if err != nil {
+			return nil, domain.LineRange{}, err
+		}
+
+		lineRange = parsed
+	}
+
+	paths := make([]m.Path, 0, len(args))
+
+	for _, arg := range args {
+		file, suffix, found := strings.Cut(arg, ".go:")
+		if !found {
+			paths = append(paths, m.Path(arg))
+			continue
+		}
+
+		if lines != "" {
+			return nil, domain.LineRange{}, fmt.Errorf("%s: pass the line range either in the path or with --lines", arg)
+		}
+
+		parsed, err := domain.ParseLineRange(suffix)
+		if err != nil {
+			return nil, domain.LineRange{}, err
+		}
+
+		lineRange = parsed
+		paths = append(paths, m.Path(file+".go"))
+	}
+
+	return paths, lineRange, nil
+}
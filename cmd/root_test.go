@@ -7,6 +7,8 @@ import (
 	"os/exec"
 	"testing"
 
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/controller"
 	m "github.com/mouse-blink/gooze/internal/model"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -41,6 +43,34 @@ func TestParseShardFlag(t *testing.T) {
 	}
 }
 
+func TestParseShardsFlag(t *testing.T) {
+	tests := []struct {
+		name        string
+		shard       string
+		wantIndexes []int
+		wantTotal   int
+	}{
+		{"empty string", "", []int{0}, 1},
+		{"single", "1/3", []int{1}, 3},
+		{"range", "0-2/8", []int{0, 1, 2}, 8},
+		{"list", "0,3,5/8", []int{0, 3, 5}, 8},
+		{"list of ranges", "0-1,6-7/8", []int{0, 1, 6, 7}, 8},
+		{"missing total", "0,3,5", []int{0}, 1},
+		{"reversed range", "3-1/8", []int{0}, 1},
+		{"range past total", "6-8/8", []int{0}, 1},
+		{"negative index", "-1/3", []int{0}, 1},
+		{"empty item", "0,,2/3", []int{0}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotIndexes, gotTotal := parseShardsFlag(tt.shard)
+			assert.Equal(t, tt.wantIndexes, gotIndexes, "indexes")
+			assert.Equal(t, tt.wantTotal, gotTotal, "total")
+		})
+	}
+}
+
 func TestParsePaths(t *testing.T) {
 	tests := []struct {
 		name string
@@ -87,6 +117,201 @@ func TestRootCmd_HelpOutput(t *testing.T) {
 	assert.Contains(t, output.String(), "Supports Go-style path patterns")
 }
 
+func TestRootCmd_NoTUIFlag_SwitchesToSimpleUI(t *testing.T) {
+	originalUI, originalWorkflow := ui, workflow
+	defer func() {
+		ui, workflow = originalUI, originalWorkflow
+		noTUIFlag = false
+	}()
+
+	var seenUI controller.UI
+
+	cmd := newRootCmd()
+	cmd.AddCommand(&cobra.Command{
+		Use: "probe",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			seenUI = ui
+			return nil
+		},
+	})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	cmd.SetArgs([]string{"--no-tui", "probe"})
+	require.NoError(t, cmd.Execute())
+
+	assert.IsType(t, &controller.SimpleUI{}, seenUI)
+	assert.NotSame(t, originalWorkflow, workflow)
+}
+
+func TestRootCmd_WithoutNoTUIFlag_KeepsUI(t *testing.T) {
+	originalUI := ui
+
+	cmd := newRootCmd()
+	cmd.AddCommand(&cobra.Command{Use: "probe", RunE: func(_ *cobra.Command, _ []string) error { return nil }})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	cmd.SetArgs([]string{"probe"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Same(t, originalUI, ui)
+}
+
+func TestRootCmd_ProgressFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		want    controller.UI
+		wantErr bool
+	}{
+		{"json", "json", &controller.JSONUI{}, false},
+		{"text", "text", &controller.SimpleUI{}, false},
+		{"invalid", "yaml", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalUI, originalWorkflow := ui, workflow
+			defer func() {
+				ui, workflow = originalUI, originalWorkflow
+				progressFormatFlag = progressFormatAuto
+			}()
+
+			var seenUI controller.UI
+
+			cmd := newRootCmd()
+			cmd.AddCommand(&cobra.Command{
+				Use: "probe",
+				RunE: func(_ *cobra.Command, _ []string) error {
+					seenUI = ui
+					return nil
+				},
+			})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			cmd.SetArgs([]string{"--progress-format", tt.format, "probe"})
+			err := cmd.Execute()
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid --progress-format")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, seenUI)
+		})
+	}
+}
+
+func TestRootCmd_ReportBackend(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend string
+		wantErr bool
+	}{
+		{"yaml", "yaml", false},
+		{"sqlite", "sqlite", false},
+		{"invalid", "postgres", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalStore, originalWorkflow := reportStore, workflow
+			defer func() {
+				reportStore, workflow = originalStore, originalWorkflow
+				reportBackendFlag = reportBackendYAML
+			}()
+
+			var seenStore adapter.ReportStore
+
+			cmd := newRootCmd()
+			cmd.AddCommand(&cobra.Command{
+				Use: "probe",
+				RunE: func(_ *cobra.Command, _ []string) error {
+					seenStore = reportStore
+					return nil
+				},
+			})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			cmd.SetArgs([]string{"--report-backend", tt.backend, "probe"})
+			err := cmd.Execute()
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid --report-backend")
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.backend == reportBackendYAML {
+				assert.Same(t, originalStore, seenStore)
+			} else {
+				assert.NotSame(t, originalStore, seenStore)
+			}
+		})
+	}
+}
+
+func TestRootCmd_ReportStore(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		env     map[string]string
+		wantErr string
+	}{
+		{name: "flag", args: []string{"--report-store", "s3://ci-cache/gooze"}, env: map[string]string{awsAccessKeyIDEnv: "id", awsSecretAccessKeyEnv: "secret"}},
+		{name: "env", env: map[string]string{reportStoreEnv: "gs://ci-cache/gooze", googleAccessTokenEnv: "token"}},
+		{name: "missing credentials", args: []string{"--report-store", "s3://ci-cache"}, wantErr: "AWS_ACCESS_KEY_ID"},
+		{name: "invalid URL", args: []string{"--report-store", "ftp://ci-cache"}, wantErr: "want s3://bucket/prefix"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{reportStoreEnv, awsAccessKeyIDEnv, awsSecretAccessKeyEnv, googleAccessTokenEnv} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			originalStore, originalWorkflow := reportStore, workflow
+			defer func() {
+				reportStore, workflow = originalStore, originalWorkflow
+				reportStoreFlag = ""
+			}()
+
+			var seenStore adapter.ReportStore
+
+			cmd := newRootCmd()
+			cmd.AddCommand(&cobra.Command{
+				Use: "probe",
+				RunE: func(_ *cobra.Command, _ []string) error {
+					seenStore = reportStore
+					return nil
+				},
+			})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			cmd.SetArgs(append(tt.args, "probe"))
+			err := cmd.Execute()
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, &adapter.ObjectReportStore{}, seenStore)
+		})
+	}
+}
+
 func TestInit(t *testing.T) {
 	// Test that init() created all the necessary instances
 	assert.NotNil(t, ui)
@@ -219,3 +444,84 @@ func TestExecute_ProcessLevel_Failure(t *testing.T) {
 
 	assert.Contains(t, string(output), "error occurred")
 }
+
+func TestThemeName(t *testing.T) {
+	tests := []struct {
+		name string
+		flag string
+		env  map[string]string
+		want string
+	}{
+		{name: "default", want: controller.ThemeDark},
+		{name: "flag", flag: controller.ThemeLight, env: map[string]string{themeEnv: controller.ThemeHighContrast, "NO_COLOR": "1"}, want: controller.ThemeLight},
+		{name: "env", env: map[string]string{themeEnv: controller.ThemeHighContrast, "NO_COLOR": "1"}, want: controller.ThemeHighContrast},
+		{name: "no color", env: map[string]string{"NO_COLOR": "1"}, want: controller.ThemeNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, themeName(tt.flag, func(key string) string { return tt.env[key] }))
+		})
+	}
+}
+
+func TestRootCmd_InvalidTheme(t *testing.T) {
+	defer func() { themeFlag = "" }()
+
+	cmd := newRootCmd()
+	cmd.AddCommand(&cobra.Command{Use: "probe", RunE: func(*cobra.Command, []string) error { return nil }})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--theme", "solarized", "probe"})
+
+	require.ErrorContains(t, cmd.Execute(), `invalid theme "solarized"`)
+}
+
+func TestRootCmd_Plain(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    controller.UI
+		wantErr bool
+	}{
+		{"auto", []string{"--plain"}, &controller.PlainUI{}, false},
+		{"with no-tui", []string{"--plain", "--no-tui"}, &controller.PlainUI{}, false},
+		{"text", []string{"--plain", "--progress-format", "text"}, &controller.PlainUI{}, false},
+		{"json", []string{"--plain", "--progress-format", "json"}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalUI, originalWorkflow := ui, workflow
+			defer func() {
+				ui, workflow = originalUI, originalWorkflow
+				plainFlag, noTUIFlag = false, false
+				progressFormatFlag = progressFormatAuto
+			}()
+
+			var seenUI controller.UI
+
+			cmd := newRootCmd()
+			cmd.AddCommand(&cobra.Command{
+				Use: "probe",
+				RunE: func(_ *cobra.Command, _ []string) error {
+					seenUI = ui
+					return nil
+				},
+			})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			cmd.SetArgs(append(tt.args, "probe"))
+			err := cmd.Execute()
+
+			if tt.wantErr {
+				require.ErrorContains(t, err, "--plain cannot be combined")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, seenUI)
+		})
+	}
+}
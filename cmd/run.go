@@ -1,15 +1,62 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
+	"github.com/mouse-blink/gooze/internal/adapter"
 	"github.com/mouse-blink/gooze/internal/domain"
 	m "github.com/mouse-blink/gooze/internal/model"
 )
 
 var runParallelFlag int
 var runShardFlag string
+var runShardStrategyFlag string
 var runExcludeFlags []string
+var runIncludeTestHelpersFlag bool
+var runFuncFlag string
+var runScopeFlag string
+var runLinesFlag string
+var runTagsFlag []string
+var runJUnitOutFlag string
+var runDiagnosticsOutFlag string
+var runDiagnosticsFormatFlag string
+var runManifestKeyFlag string
+var runTimeoutFlag time.Duration
+var runTimeoutMultiplierFlags map[string]string
+var runTestScopeFlag string
+var runFuncTestsFlag bool
+var runFuncTestTemplateFlags []string
+var runDryRunFlag bool
+var runDryRunOutFlag string
+var runMutatedFilesFlag bool
+var runQuarantineAfterFlag int
+var runMaxDurationFlag time.Duration
+var runDiskBudgetFlag string
+var runWorkDirFlag string
+var runWorkerFlags []string
+var runRunnerFlag string
+var runDockerImageFlag string
+var runDockerCPUsFlag string
+var runDockerMemoryFlag string
+var runDockerNetworkFlag string
+var runDetectFlakyFlag bool
+var runCountCompileErrorsFlag bool
+var runSchemataFlag bool
+var runRedactCodeFlag bool
+var runGoTestArgsFlag string
+var runRaceFlag bool
+var runNumbersFlag []string
+var runSampleFlag float64
+var runMaxMutationsFlag int
+var runSampleSeedFlag string
 
 // runCmd represents the run command.
 var runCmd = newRunCmd()
@@ -19,32 +66,286 @@ func newRunCmd() *cobra.Command {
 		Use:   "run [paths...]",
 		Short: "Run mutation testing",
 		Long:  runLongDescription,
-		RunE: func(_ *cobra.Command, args []string) error {
-			shardIndex, totalShards := parseShardFlag(runShardFlag)
-			paths := parsePaths(args)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := selectNumberVariants(runNumbersFlag); err != nil {
+				return err
+			}
+
+			shardIndexes, totalShards := parseShardsFlag(runShardFlag)
+
+			shardIndex := shardIndexes[0]
+			if len(shardIndexes) == 1 {
+				shardIndexes = nil
+			}
+
+			multipliers, err := parseTimeoutMultipliers(runTimeoutMultiplierFlags)
+			if err != nil {
+				return err
+			}
+
+			paths, lines, err := parseLinePaths(args, runLinesFlag)
+			if err != nil {
+				return err
+			}
+
+			diskBudget, err := parseDiskBudget(runDiskBudgetFlag)
+			if err != nil {
+				return err
+			}
+
+			if err := validateRunner(runRunnerFlag); err != nil {
+				return err
+			}
+
+			if runRunnerFlag == runnerDocker {
+				useTestRunner(adapter.NewDockerTestRunnerAdapter(adapter.DockerOptions{
+					Image:   runDockerImageFlag,
+					CPUs:    runDockerCPUsFlag,
+					Memory:  runDockerMemoryFlag,
+					Network: runDockerNetworkFlag,
+				}))
+			}
+
 			useCache := !noCacheFlag
 
+			estimateArgs := domain.EstimateArgs{
+				Paths:              paths,
+				Exclude:            runExcludeFlags,
+				UseCache:           useCache,
+				Reports:            m.Path(reportsOutputDirFlag),
+				IncludeTestHelpers: runIncludeTestHelpersFlag,
+				Func:               runFuncFlag,
+				Scope:              domain.CodeScope(runScopeFlag),
+				Lines:              lines,
+				Tags:               runTagsFlag,
+				Sample:             runSampleFlag,
+				MaxMutations:       runMaxMutationsFlag,
+				SampleSeed:         runSampleSeedFlag,
+				Race:               runRaceFlag,
+			}
+
+			if runDryRunFlag {
+				if runDryRunOutFlag == "" {
+					return fmt.Errorf("--dry-run requires --out")
+				}
+
+				return workflow.DryRun(domain.DryRunArgs{
+					EstimateArgs: estimateArgs,
+					Out:          m.Path(runDryRunOutFlag),
+					FullFiles:    runMutatedFilesFlag,
+				})
+			}
+
+			ctx, stop := interruptContext(cmd.Context())
+			defer stop()
+
 			return workflow.Test(domain.TestArgs{
-				EstimateArgs: domain.EstimateArgs{
-					Paths:    paths,
-					Exclude:  runExcludeFlags,
-					UseCache: useCache,
-					Reports:  m.Path(reportsOutputDirFlag),
-				},
-				Reports:         m.Path(reportsOutputDirFlag),
-				Threads:         runParallelFlag,
-				ShardIndex:      shardIndex,
-				TotalShardCount: totalShards,
+				EstimateArgs:       estimateArgs,
+				Reports:            m.Path(reportsOutputDirFlag),
+				Threads:            runThreads(runParallelFlag, runWorkerFlags),
+				ShardIndex:         shardIndex,
+				ShardIndexes:       shardIndexes,
+				TotalShardCount:    totalShards,
+				ShardStrategy:      domain.ShardStrategy(runShardStrategyFlag),
+				JUnitOut:           m.Path(runJUnitOutFlag),
+				DiagnosticsOut:     m.Path(runDiagnosticsOutFlag),
+				DiagnosticsFormat:  m.DiagnosticsFormat(runDiagnosticsFormatFlag),
+				Timeout:            runTimeoutFlag,
+				TimeoutMultipliers: multipliers,
+				TestScope:          domain.TestScope(runTestScopeFlag),
+				RunTemplates:       runTemplates(runFuncTestsFlag, runFuncTestTemplateFlags),
+				QuarantineAfter:    runQuarantineAfterFlag,
+				MaxDuration:        runMaxDurationFlag,
+				DiskBudget:         diskBudget,
+				WorkDir:            workDir(runWorkDirFlag),
+				DetectFlaky:        runDetectFlakyFlag,
+				CountCompileErrors: runCountCompileErrorsFlag,
+				Schemata:           runSchemataFlag,
+				RedactCode:         runRedactCodeFlag,
+				GoTestArgs:         strings.Fields(runGoTestArgsFlag),
+				ManifestArgs:       manifestArgs(runManifestKeyFlag),
+				Stop:               ctx.Done(),
+				Backend:            executionBackend(runWorkerFlags),
 			})
 		},
 	}
-	cmd.Flags().IntVarP(&runParallelFlag, "parallel", "p", 1, "number of parallel workers for mutation testing")
-	cmd.Flags().StringVarP(&runShardFlag, "shard", "s", "", "shard index and total shard count in the format INDEX/TOTAL (e.g., 0/3)")
-	cmd.Flags().StringArrayVarP(&runExcludeFlags, "exclude", "x", nil, "exclude files matching regex (can be repeated)")
+	cmd.Flags().IntVarP(&runParallelFlag, "parallel", "p", 0, "number of parallel workers for mutation testing (0 picks one from CPUs and available memory)")
+	cmd.Flags().StringVarP(&runShardFlag, "shard", "s", "", "shard index and total shard count in the format INDEX/TOTAL (e.g., 0/3); list several shards to run them together, e.g. 0-2/8 or 0,3,5/8 (--shards is an alias)")
+	cmd.Flags().StringVar(&runShardStrategyFlag, "shard-strategy", string(domain.ShardStrategyHash), "how --shard splits mutations: hash (by mutation ID) or balanced (by durations recorded in --output, so shards take about as long)")
+	cmd.Flags().StringArrayVarP(&runExcludeFlags, "exclude", "x", nil, "exclude files matching regex (can be repeated; --ignore is an alias)")
+	cmd.Flags().SetNormalizeFunc(runFlagAliases)
+	cmd.Flags().BoolVar(&runIncludeTestHelpersFlag, "include-test-helpers", false, "also mutate non-test files that import testing or assertion libraries")
+	cmd.Flags().StringVar(&runFuncFlag, "func", "", "only mutate functions whose name (Name or Type.Method) matches this regex")
+	cmd.Flags().StringVar(&runScopeFlag, "scope", "", "only mutate exported functions and methods of exported types (exported) or the rest (unexported)")
+	cmd.Flags().StringVar(&runLinesFlag, "lines", "", "only mutate lines START-END of the single file given (same as FILE.go:START-END)")
+	cmd.Flags().Float64Var(&runSampleFlag, "sample", 0, "test only this fraction of the mutations (e.g. 0.2), picked reproducibly by mutation ID")
+	cmd.Flags().IntVar(&runMaxMutationsFlag, "max-mutations", 0, "test at most this many mutations, picked reproducibly by mutation ID (0 means no limit)")
+	cmd.Flags().StringVar(&runSampleSeedFlag, "sample-seed", "", "seed for --sample and --max-mutations; change it to pick a different subset")
+	cmd.Flags().StringSliceVar(&runTagsFlag, "tags", nil, "comma-separated build tags; files whose //go:build line excludes them are skipped, and tests run with -tags")
+	cmd.Flags().StringSliceVar(&runNumbersFlag, "numbers", nil, "variants of numbers mutations to generate, comma-separated: "+numberVariantNames()+" (default all)")
+	cmd.Flags().StringVar(&runJUnitOutFlag, "junit-out", "", "also write results as JUnit XML to this file (survived mutants are failures)")
+	cmd.Flags().StringVar(&runDiagnosticsOutFlag, "diagnostics-out", "", "also write survived mutations with their file, line and column to this file, for editors and reviewdog")
+	cmd.Flags().StringVar(&runDiagnosticsFormatFlag, "diagnostics-format", string(m.DiagnosticsRDJSON), "format of --diagnostics-out: rdjson (reviewdog) or lsp (LSP publishDiagnostics params per file)")
+	cmd.Flags().DurationVar(&runTimeoutFlag, "timeout", domain.DefaultTestTimeout, "base time budget for each mutation's tests")
+	cmd.Flags().StringToStringVar(&runTimeoutMultiplierFlags, "timeout-multiplier", nil, "scale --timeout per mutation type, e.g. loop=3,arithmetic=0.5 (loop defaults to 2)")
+	cmd.Flags().StringVar(&runTestScopeFlag, "test-scope", string(domain.TestScopeFile), "tests to run per mutant: file (the companion test file), package (every test of the package) or dependents (the package and every package importing it)")
+	cmd.Flags().BoolVar(&runFuncTestsFlag, "func-tests", false, "first run only the tests named after the mutated function (TestName, TestType_Method, ...), then the rest if it survives")
+	cmd.Flags().StringArrayVar(&runFuncTestTemplateFlags, "func-test-template", nil, "test name template for --func-tests using {name} and {type}, e.g. 'Test{type}_{name}' (can be repeated; implies --func-tests)")
+	cmd.Flags().IntVar(&runQuarantineAfterFlag, "quarantine-after", domain.DefaultQuarantineAfter, "skip mutations that timed out in this many recorded runs, reporting them as skipped (0 re-tests them)")
+	cmd.Flags().DurationVar(&runMaxDurationFlag, "max-duration", 0, "stop starting mutants after this long (e.g. 30m), testing covered lines first; the rest are reported as not run and the score is partial")
+	cmd.Flags().StringVar(&runGoTestArgsFlag, "go-test-args", "", "extra go test flags for every mutant's tests, e.g. '-count=1 -shuffle=on' (values as -flag=value)")
+	cmd.Flags().StringVar(&runDiskBudgetFlag, "disk-budget", diskBudgetAuto, "disk space the per-worker project copies may take, e.g. 10GB or 512MiB; fewer workers are used to fit it (auto uses the free temp space, 0 disables the check)")
+	cmd.Flags().StringVar(&runWorkDirFlag, "work-dir", "", "directory to create the per-worker project copies in, created if missing (default $"+workDirEnv+", then the system temp directory)")
+	cmd.Flags().StringArrayVar(&runWorkerFlags, "worker", nil, "test mutants on a worker started by this shell command, e.g. \"ssh build-1 'cd src/app && gooze worker'\" (can be repeated; --parallel defaults to the number of workers)")
+	cmd.Flags().StringVar(&runRunnerFlag, "runner", runnerLocal, "where mutants' tests run: local (go on this machine) or docker (a fresh container per go test)")
+	cmd.Flags().StringVar(&runDockerImageFlag, "docker-image", defaultDockerImage, "image providing the go command for --runner docker")
+	cmd.Flags().StringVar(&runDockerCPUsFlag, "docker-cpus", "", "CPU limit of each --runner docker container, e.g. 2 (default: unlimited)")
+	cmd.Flags().StringVar(&runDockerMemoryFlag, "docker-memory", "", "memory limit of each --runner docker container, e.g. 1g (default: unlimited)")
+	cmd.Flags().StringVar(&runDockerNetworkFlag, "docker-network", "", "network of the --runner docker containers (default: none, dependencies come from the module cache)")
+	cmd.Flags().BoolVar(&runRaceFlag, "race", false, "run every mutant's tests with the race detector; kills by a detected data race get the race kill reason")
+	cmd.Flags().BoolVar(&runDetectFlakyFlag, "detect-flaky", false, "re-run the tests without the mutation after each kill and report mutants they fail again as flaky instead of killed")
+	cmd.Flags().BoolVar(&runCountCompileErrorsFlag, "count-compile-errors", false, "record mutants that do not compile as killed by a build failure and count them in the score, instead of leaving them out as compile errors")
+	cmd.Flags().BoolVar(&runSchemataFlag, "schemata", false, "compile the mutants of each file together, switching between them with an environment variable, so the file is built once instead of once per mutant")
+	cmd.Flags().BoolVar(&runRedactCodeFlag, "redact-code", false, "keep code out of the saved reports, --junit-out and --diagnostics-out: survivors are stored without their diffs, keeping IDs, hashes, statuses and line numbers")
+	cmd.Flags().BoolVar(&runDryRunFlag, "dry-run", false, "generate mutations and write their diffs to --out without running any tests")
+	cmd.Flags().StringVar(&runDryRunOutFlag, "out", "", "directory --dry-run writes one .diff per mutation into, laid out like the project")
+	cmd.Flags().BoolVar(&runMutatedFilesFlag, "mutated-files", false, "with --dry-run, also write each complete mutated source file next to its diff")
+	cmd.Flags().StringVar(&runManifestKeyFlag, "manifest-key", "", "sign the reports manifest with this ed25519 private key (PKCS#8 PEM)")
 
 	return cmd
 }
 
+// runFlagAliases accepts --shards for --shard, which reads better with a
+// list of shards, besides the aliases of ignoreAlias.
+func runFlagAliases(flags *pflag.FlagSet, name string) pflag.NormalizedName {
+	if name == "shards" {
+		name = "shard"
+	}
+
+	return ignoreAlias(flags, name)
+}
+
+// runTemplates returns the test name templates selected by --func-tests and
+// --func-test-template, or nil when neither is set.
+func runTemplates(enabled bool, templates []string) []string {
+	if len(templates) > 0 {
+		return templates
+	}
+
+	if enabled {
+		return domain.DefaultRunTemplates
+	}
+
+	return nil
+}
+
+// parseTimeoutMultipliers converts --timeout-multiplier values to factors;
+// the workflow checks the mutation type names.
+func parseTimeoutMultipliers(flags map[string]string) (map[string]float64, error) {
+	if len(flags) == 0 {
+		return nil, nil
+	}
+
+	multipliers := make(map[string]float64, len(flags))
+
+	for name, value := range flags {
+		factor, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --timeout-multiplier %s=%s: %w", name, value, err)
+		}
+
+		multipliers[name] = factor
+	}
+
+	return multipliers, nil
+}
+
+// diskBudgetAuto is the --disk-budget value selecting domain.DiskBudgetAuto.
+const diskBudgetAuto = "auto"
+
+// byteUnits maps size suffixes to their number of bytes.
+var byteUnits = map[string]float64{
+	"":    1,
+	"B":   1,
+	"KB":  1e3,
+	"MB":  1e6,
+	"GB":  1e9,
+	"TB":  1e12,
+	"KIB": 1 << 10,
+	"MIB": 1 << 20,
+	"GIB": 1 << 30,
+	"TIB": 1 << 40,
+}
+
+// parseDiskBudget converts --disk-budget to bytes: "auto", or a size such
+// as 10GB or 512MiB.
+func parseDiskBudget(value string) (int64, error) {
+	if value == diskBudgetAuto {
+		return domain.DiskBudgetAuto, nil
+	}
+
+	number := strings.TrimRightFunc(value, unicode.IsLetter)
+	unit, ok := byteUnits[strings.ToUpper(strings.TrimSpace(value[len(number):]))]
+
+	size, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if !ok || err != nil || size < 0 {
+		return 0, fmt.Errorf("invalid --disk-budget %q: want auto or a size such as 10GB or 512MiB", value)
+	}
+
+	return int64(size * unit), nil
+}
+
+// workDirEnv names the variable read when --work-dir is not given.
+const workDirEnv = "GOOZE_WORK_DIR"
+
+// workDir returns --work-dir, falling back to $GOOZE_WORK_DIR; empty leaves
+// the workspaces in the system temp directory.
+func workDir(flag string) m.Path {
+	if flag != "" {
+		return m.Path(flag)
+	}
+
+	return m.Path(os.Getenv(workDirEnv))
+}
+
+// Accepted --runner values.
+const (
+	runnerLocal  = "local"
+	runnerDocker = "docker"
+)
+
+// defaultDockerImage matches the Go version gooze is developed with.
+const defaultDockerImage = "golang:1.25"
+
+func validateRunner(runner string) error {
+	switch runner {
+	case runnerLocal, runnerDocker:
+		return nil
+	default:
+		return fmt.Errorf("invalid --runner %q (want %s or %s)", runner, runnerLocal, runnerDocker)
+	}
+}
+
+// runThreads returns --parallel; when it is left to gooze and workers test
+// the mutants, one thread per worker keeps them all busy.
+func runThreads(parallel int, workers []string) int {
+	if parallel == 0 && len(workers) > 0 {
+		return len(workers)
+	}
+
+	return parallel
+}
+
+// executionBackend dispatches to the --worker commands, if any; nil tests
+// the mutants locally.
+func executionBackend(workers []string) domain.ExecutionBackend {
+	if len(workers) == 0 {
+		return nil
+	}
+
+	return domain.NewRemoteBackend(fsAdapter, workerLauncher, workers)
+}
+
 func init() {
 	rootCmd.AddCommand(runCmd)
 }
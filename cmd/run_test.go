@@ -3,7 +3,9 @@ package cmd
 import (
 	"bytes"
 	"testing"
+	"time"
 
+	"github.com/mouse-blink/gooze/internal/adapter"
 	"github.com/mouse-blink/gooze/internal/domain"
 	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
 	m "github.com/mouse-blink/gooze/internal/model"
@@ -112,6 +114,32 @@ func TestRunCmd_WithExcludePatterns(t *testing.T) {
 	mockWorkflow.AssertExpectations(t)
 }
 
+func TestRunCmd_IgnoreIsAnAliasOfExclude(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return len(args.Exclude) == 3 &&
+			args.Exclude[0] == "generated\\.go$" &&
+			args.Exclude[1] == "mocks/" &&
+			args.Exclude[2] == "^vendor/"
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--ignore", "generated\\.go$", "--ignore", "mocks/", "-x", "^vendor/", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
 func TestRunCmd_NoCacheFlag_DisablesCache(t *testing.T) {
 	mockWorkflow := domainmocks.NewMockWorkflow(t)
 
@@ -135,6 +163,691 @@ func TestRunCmd_NoCacheFlag_DisablesCache(t *testing.T) {
 	mockWorkflow.AssertExpectations(t)
 }
 
+func TestRunCmd_JUnitOutFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.JUnitOut == m.Path("out/gooze.xml")
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--junit-out", "out/gooze.xml", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_DiagnosticsFlags(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.DiagnosticsOut == m.Path("out/gooze.json") && args.DiagnosticsFormat == m.DiagnosticsLSP
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--diagnostics-out", "out/gooze.json", "--diagnostics-format", "lsp", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_IncludeTestHelpersFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.IncludeTestHelpers
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--include-test-helpers", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_FuncFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.Func == "Calc\\.Add"
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--func", "Calc\\.Add", "./calc.go"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_ScopeFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.Scope == domain.CodeScopeExported && args.Func == "Calculate|ParseShard.*"
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--func", "Calculate|ParseShard.*", "--scope", "exported", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_LineRangeInPath(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return len(args.Paths) == 1 && args.Paths[0] == m.Path("./pkg/calc.go") &&
+			args.Lines == domain.LineRange{Start: 40, End: 120}
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "./pkg/calc.go:40-120"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_LinesFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return len(args.Paths) == 1 && args.Paths[0] == m.Path("./pkg/calc.go") &&
+			args.Lines == domain.LineRange{Start: 12, End: 12}
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--lines", "12", "./pkg/calc.go"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_LineRangeTwiceFails(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	cmd.SetArgs([]string{"run", "--lines", "1-5", "./pkg/calc.go:40-120"})
+	err := cmd.Execute()
+	require.ErrorContains(t, err, "either in the path or with --lines")
+}
+
+func TestRunCmd_TagsFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return assert.ObjectsAreEqual([]string{"integration", "slow"}, args.Tags)
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--tags", "integration,slow", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_TimeoutFlags(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.Timeout == time.Minute &&
+			assert.ObjectsAreEqual(map[string]float64{"loop": 3, "arithmetic": 0.5}, args.TimeoutMultipliers)
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--timeout", "1m", "--timeout-multiplier", "loop=3,arithmetic=0.5", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_TestScopeFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want domain.TestScope
+	}{
+		{name: "default", args: []string{"run", "./..."}, want: domain.TestScopeFile},
+		{name: "package", args: []string{"run", "--test-scope", "package", "./..."}, want: domain.TestScopePackage},
+		{name: "dependents", args: []string{"run", "--test-scope", "dependents", "./..."}, want: domain.TestScopeDependents},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newRunCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+				return args.TestScope == tt.want
+			})).Return(nil)
+
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			require.NoError(t, err)
+
+			mockWorkflow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunCmd_FuncTestsFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{name: "off", args: []string{"run", "./..."}, want: nil},
+		{name: "defaults", args: []string{"run", "--func-tests", "./..."}, want: domain.DefaultRunTemplates},
+		{name: "templates", args: []string{"run", "--func-test-template", "Test{name}", "--func-test-template", "Fuzz{name}", "./..."}, want: []string{"Test{name}", "Fuzz{name}"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newRunCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+				return assert.ObjectsAreEqual(tt.want, args.RunTemplates)
+			})).Return(nil)
+
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			require.NoError(t, err)
+
+			mockWorkflow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunCmd_QuarantineAfterFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "default", args: []string{"run", "./..."}, want: domain.DefaultQuarantineAfter},
+		{name: "explicit", args: []string{"run", "--quarantine-after", "5", "./..."}, want: 5},
+		{name: "disabled", args: []string{"run", "--quarantine-after", "0", "./..."}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newRunCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+				return args.QuarantineAfter == tt.want
+			})).Return(nil)
+
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			require.NoError(t, err)
+
+			mockWorkflow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunCmd_MaxDurationFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.MaxDuration == 30*time.Minute
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--max-duration", "30m", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_DetectFlakyFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.DetectFlaky
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--detect-flaky", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_CountCompileErrorsFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.CountCompileErrors
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--count-compile-errors", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_SchemataFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.Schemata
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--schemata", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_ShardsFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.ShardIndex == 0 && args.TotalShardCount == 8 &&
+			assert.ObjectsAreEqual([]int{0, 3, 4, 5}, args.ShardIndexes)
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--shards", "0,3-5/8", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_GoTestArgsFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return assert.ObjectsAreEqual([]string{"-count=1", "-shuffle=on"}, args.GoTestArgs)
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--go-test-args", " -count=1  -shuffle=on", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_RaceFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.Race
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--race", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_ShardStrategyFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.ShardStrategy == domain.ShardStrategyBalanced && args.ShardIndex == 1 && args.TotalShardCount == 3
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--shard", "1/3", "--shard-strategy", "balanced", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_DiskBudgetFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int64
+	}{
+		{name: "defaults to auto", args: nil, want: domain.DiskBudgetAuto},
+		{name: "decimal unit", args: []string{"--disk-budget", "10GB"}, want: 10_000_000_000},
+		{name: "binary unit", args: []string{"--disk-budget", "1.5GiB"}, want: 3 << 29},
+		{name: "disabled", args: []string{"--disk-budget", "0"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newRunCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+				return args.DiskBudget == tt.want
+			})).Return(nil)
+
+			cmd.SetArgs(append(append([]string{"run"}, tt.args...), "./..."))
+			err := cmd.Execute()
+			require.NoError(t, err)
+
+			mockWorkflow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunCmd_WorkDirFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		env  string
+		args []string
+		want m.Path
+	}{
+		{name: "defaults to the system temp directory", want: ""},
+		{name: "from the environment", env: "/ci/scratch", want: "/ci/scratch"},
+		{name: "flag overrides the environment", env: "/ci/scratch", args: []string{"--work-dir", "/fast/ssd/gooze"}, want: "/fast/ssd/gooze"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(workDirEnv, tt.env)
+
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newRunCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+				return args.WorkDir == tt.want
+			})).Return(nil)
+
+			cmd.SetArgs(append(append([]string{"run"}, tt.args...), "./..."))
+			err := cmd.Execute()
+			require.NoError(t, err)
+
+			mockWorkflow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestParseDiskBudget_Invalid(t *testing.T) {
+	for _, value := range []string{"", "lots", "10XB", "-1GB"} {
+		_, err := parseDiskBudget(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestRunCmd_SampleFlags(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+		return args.Sample == 0.2 && args.MaxMutations == 500 && args.SampleSeed == "nightly"
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--sample", "0.2", "--max-mutations", "500", "--sample-seed", "nightly", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_InvalidTimeoutMultiplier(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	cmd.SetArgs([]string{"run", "--timeout-multiplier", "loop=slow", "./..."})
+	err := cmd.Execute()
+	require.ErrorContains(t, err, "invalid --timeout-multiplier loop=slow")
+}
+
+func TestRunCmd_DryRun(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("DryRun", mock.MatchedBy(func(args domain.DryRunArgs) bool {
+		return args.Out == "mutations" &&
+			args.FullFiles &&
+			len(args.Paths) == 1 &&
+			assert.ObjectsAreEqual([]string{"gen"}, args.Exclude)
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"run", "--dry-run", "--out", "mutations", "--mutated-files", "-x", "gen", "./..."})
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	mockWorkflow.AssertExpectations(t)
+}
+
+func TestRunCmd_DryRunRequiresOut(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	cmd.SetArgs([]string{"run", "--dry-run", "./..."})
+	err := cmd.Execute()
+	require.ErrorContains(t, err, "--dry-run requires --out")
+}
+
 func TestNewRunCmd(t *testing.T) {
 	cmd := newRunCmd()
 
@@ -145,8 +858,77 @@ func TestNewRunCmd(t *testing.T) {
 
 	parallelFlag := cmd.Flags().Lookup("parallel")
 	assert.NotNil(t, parallelFlag)
+	assert.Equal(t, "0", parallelFlag.DefValue)
 	shardFlag := cmd.Flags().Lookup("shard")
 	assert.NotNil(t, shardFlag)
 	excludeFlag := cmd.Flags().Lookup("exclude")
 	assert.NotNil(t, excludeFlag)
+	junitOutFlag := cmd.Flags().Lookup("junit-out")
+	assert.NotNil(t, junitOutFlag)
+}
+
+func TestRunCmd_WorkerFlag(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantThreads int
+		wantBackend bool
+	}{
+		{name: "tests locally by default", wantThreads: 0},
+		{name: "one thread per worker", args: []string{"--worker", "ssh a gooze worker", "--worker", "ssh b gooze worker"}, wantThreads: 2, wantBackend: true},
+		{name: "explicit parallelism", args: []string{"--worker", "ssh a gooze worker", "-p", "4"}, wantThreads: 4, wantBackend: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newRunCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			workflow = mockWorkflow
+			defer func() { workflow = originalWorkflow }()
+
+			mockWorkflow.On("Test", mock.MatchedBy(func(args domain.TestArgs) bool {
+				return args.Threads == tt.wantThreads && (args.Backend != nil) == tt.wantBackend
+			})).Return(nil)
+
+			cmd.SetArgs(append([]string{"run"}, tt.args...))
+			require.NoError(t, cmd.Execute())
+		})
+	}
+}
+
+func TestRunCmd_InvalidRunner(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newRunCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	cmd.SetArgs([]string{"run", "--runner", "podman"})
+	err := cmd.Execute()
+	require.ErrorContains(t, err, `invalid --runner "podman" (want local or docker)`)
+}
+
+func TestUseTestRunner(t *testing.T) {
+	originalTestAdapter, originalOrchestrator, originalWorkflow := testAdapter, orchestrator, workflow
+	defer func() {
+		testAdapter, orchestrator, workflow = originalTestAdapter, originalOrchestrator, originalWorkflow
+	}()
+
+	docker := adapter.NewDockerTestRunnerAdapter(adapter.DockerOptions{Image: defaultDockerImage})
+	useTestRunner(docker)
+
+	require.Same(t, docker, testAdapter)
+	require.NotSame(t, originalOrchestrator, orchestrator)
+	require.NotSame(t, originalWorkflow, workflow)
 }
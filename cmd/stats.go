@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// statsCmd represents the stats command.
+var statsCmd = newStatsCmd()
+
+const statsLongDescription = `Summarize trends across the runs recorded in the reports directory: the
+mutation score of each run, how many new survivors appeared per week and the
+mean time it took to kill a survivor.
+
+Every unsharded run and every merge appends to the history, so it keeps
+growing across cache invalidations and --no-cache runs. Use
+--progress-format json to print the summary as a JSON stats event.`
+
+func newStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Summarize trends across recorded runs",
+		Long:  statsLongDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return workflow.Stats(domain.StatsArgs{Reports: m.Path(reportsOutputDirFlag)})
+		},
+	}
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+}
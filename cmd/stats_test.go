@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsCmd_UsesRootOutputFlag(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newStatsCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Stats", mock.MatchedBy(func(args domain.StatsArgs) bool {
+		return args.Reports == m.Path("./reports-dir")
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"--output", "./reports-dir", "stats"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestStatsCmd_PositionalArgsAreRejected(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newStatsCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	cmd.SetArgs([]string{"stats", "extra"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	mockWorkflow.AssertNotCalled(t, "Stats", mock.Anything)
+}
+
+func TestNewStatsCmd(t *testing.T) {
+	cmd := newStatsCmd()
+
+	assert.Equal(t, "stats", cmd.Use)
+	assert.NotEmpty(t, cmd.Short)
+	assert.Equal(t, statsLongDescription, cmd.Long)
+}
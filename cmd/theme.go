@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/mouse-blink/gooze/internal/controller"
+)
+
+// themeEnv names the environment variable choosing the TUI theme when
+// --theme is not given.
+const themeEnv = "GOOZE_THEME"
+
+// themeFlag selects the colors of the interactive UI.
+var themeFlag string
+
+// themeName returns --theme, falling back to $GOOZE_THEME; without either,
+// a set NO_COLOR turns colors off, as https://no-color.org asks, and the
+// dark theme is used otherwise.
+func themeName(flag string, getenv func(string) string) string {
+	if flag != "" {
+		return flag
+	}
+
+	if name := getenv(themeEnv); name != "" {
+		return name
+	}
+
+	if getenv("NO_COLOR") != "" {
+		return controller.ThemeNone
+	}
+
+	return controller.ThemeDark
+}
+
+// selectTheme applies the chosen theme to the interactive UI.
+func selectTheme() error {
+	return controller.SetTheme(themeName(themeFlag, os.Getenv))
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// trendCmd represents the trend command.
+var trendCmd = newTrendCmd()
+var trendLastFlag int
+
+const trendLongDescription = `Chart the mutation score of the most recent runs recorded in the reports
+directory, oldest first, with the git commit each run was recorded at.
+
+Use --last 0 to chart the whole history and --progress-format json to print
+the charted runs as a JSON trend event.`
+
+func newTrendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trend",
+		Short: "Chart the mutation score over recent runs",
+		Long:  trendLongDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return workflow.Trend(domain.TrendArgs{
+				Reports: m.Path(reportsOutputDirFlag),
+				Last:    trendLastFlag,
+			})
+		},
+	}
+	cmd.Flags().IntVarP(&trendLastFlag, "last", "n", domain.DefaultTrendRuns, "number of most recent runs to chart (0 for all)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(trendCmd)
+}
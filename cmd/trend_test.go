@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrendCmd_PassesLastAndOutput(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newTrendCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("Trend", mock.MatchedBy(func(args domain.TrendArgs) bool {
+		return args.Reports == m.Path("./reports-dir") && args.Last == 5
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"--output", "./reports-dir", "trend", "--last", "5"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestNewTrendCmd(t *testing.T) {
+	cmd := newTrendCmd()
+
+	assert.Equal(t, "trend", cmd.Use)
+	assert.Equal(t, trendLongDescription, cmd.Long)
+
+	last := cmd.Flags().Lookup("last")
+	require.NotNil(t, last)
+	assert.Equal(t, "20", last.DefValue)
+}
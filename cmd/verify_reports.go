@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var verifyReportsFixFlag bool
+
+// verifyReportsCmd represents the verify-reports command.
+var verifyReportsCmd = newVerifyReportsCmd()
+
+const verifyReportsLongDescription = `Check a reports directory, by default the one of --output, for:
+
+  - corrupt report or index files that are not valid YAML
+  - orphaned report files the index does not list
+  - index entries pointing at missing files
+  - hash collisions: report files not named after the hash of their
+    results, or mutation IDs stored in more than one report file
+
+The command fails while problems remain. With --fix, corrupt report files are
+set aside with a .corrupt suffix, misnamed ones are renamed after their hash
+and the index is rebuilt from the report files. The mutations of a report set
+aside are tested again by the next run. Use --progress-format json to print
+the outcome as a JSON verify event.`
+
+func newVerifyReportsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-reports [reports-dir]",
+		Short: "Check the reports directory for corrupt, orphaned and missing files",
+		Long:  verifyReportsLongDescription,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			reports := reportsOutputDirFlag
+			if len(args) == 1 {
+				reports = args[0]
+			}
+
+			return workflow.VerifyReports(domain.VerifyReportsArgs{
+				Reports: m.Path(reports),
+				Fix:     verifyReportsFixFlag,
+			})
+		},
+	}
+	cmd.Flags().BoolVar(&verifyReportsFixFlag, "fix", false, "set corrupt reports aside, rename misnamed ones and rebuild the index")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(verifyReportsCmd)
+}
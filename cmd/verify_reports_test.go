@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyReportsCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		reports m.Path
+		fix     bool
+	}{
+		{"defaults to the output directory", []string{"--output", "./reports-dir", "verify-reports"}, "./reports-dir", false},
+		{"takes a directory and --fix", []string{"verify-reports", "--fix", "./shard_0"}, "./shard_0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+			cmd := newRootCmd()
+			cmd.AddCommand(newVerifyReportsCmd())
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			originalWorkflow := workflow
+			originalFix := verifyReportsFixFlag
+			workflow = mockWorkflow
+			defer func() {
+				workflow = originalWorkflow
+				verifyReportsFixFlag = originalFix
+			}()
+
+			mockWorkflow.On("VerifyReports", mock.MatchedBy(func(args domain.VerifyReportsArgs) bool {
+				return args.Reports == tt.reports && args.Fix == tt.fix
+			})).Return(nil)
+
+			cmd.SetArgs(tt.args)
+			require.NoError(t, cmd.Execute())
+		})
+	}
+}
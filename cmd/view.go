@@ -11,12 +11,20 @@ var viewCmd = newViewCmd()
 
 func newViewCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "view",
-		Short: "View previously generated mutation reports",
-		Long:  "View previously generated mutation reports from a reports directory.",
-		Args:  cobra.ExactArgs(0),
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return workflow.View(domain.ViewArgs{Reports: m.Path(reportsOutputDirFlag)})
+		Use:     "view [dir]",
+		Aliases: []string{"report"},
+		Short:   "View previously generated mutation reports",
+		Long: `View the mutation reports stored in a reports directory without re-running mutations.
+
+The directory defaults to the value of --output.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			reports := m.Path(reportsOutputDirFlag)
+			if len(args) == 1 {
+				reports = m.Path(args[0])
+			}
+
+			return workflow.View(domain.ViewArgs{Reports: reports})
 		},
 	}
 
@@ -53,7 +53,7 @@ func TestViewCmd_RootOutputFlagIsPassedThrough(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func TestViewCmd_PositionalArgsAreRejected(t *testing.T) {
+func TestViewCmd_PositionalDirOverridesOutputFlag(t *testing.T) {
 	mockWorkflow := domainmocks.NewMockWorkflow(t)
 
 	cmd := newRootCmd()
@@ -65,7 +65,49 @@ func TestViewCmd_PositionalArgsAreRejected(t *testing.T) {
 	workflow = mockWorkflow
 	defer func() { workflow = originalWorkflow }()
 
-	cmd.SetArgs([]string{"view", "./custom-reports"})
+	mockWorkflow.On("View", mock.MatchedBy(func(args domain.ViewArgs) bool {
+		return args.Reports == m.Path("./yesterday")
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"--output", "./reports-dir", "view", "./yesterday"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestViewCmd_TooManyArgsAreRejected(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newViewCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	cmd.SetArgs([]string{"view", "./a", "./b"})
 	err := cmd.Execute()
 	require.Error(t, err)
 }
+
+func TestViewCmd_ReportIsAnAlias(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newViewCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.On("View", mock.MatchedBy(func(args domain.ViewArgs) bool {
+		return args.Reports == m.Path(".gooze-reports")
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"report"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
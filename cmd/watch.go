@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// watchCmd represents the watch command.
+var watchCmd = newWatchCmd()
+var (
+	watchParallelFlag           int
+	watchExcludeFlags           []string
+	watchIncludeTestHelpersFlag bool
+	watchTagsFlag               []string
+	watchNumbersFlag            []string
+	watchTimeoutFlag            time.Duration
+	watchTestScopeFlag          string
+	watchFuncTestsFlag          bool
+	watchDebounceFlag           time.Duration
+)
+
+const watchLongDescription = `Run mutation testing for the given paths (default: current module), then
+keep watching their directories and test again whenever a Go file is saved.
+
+Each cycle mutates only the sources whose code or tests changed since their
+reports were saved, and merges the new reports into --output, so editing a
+file and its tests re-tests that file alone. The results view refreshes in
+place: a file's new results replace its old ones. A cycle that fails, for
+example because the code does not build mid-edit, is reported and watching
+goes on.
+
+Use it while hardening tests: leave it running next to the editor, add a
+test, save, and watch the survivor turn into a kill. Quit the view with q,
+or press Ctrl+C.`
+
+func newWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [paths...]",
+		Short: "Re-run mutation testing on the files you save",
+		Long:  watchLongDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := selectNumberVariants(watchNumbersFlag); err != nil {
+				return err
+			}
+
+			watcher, err := adapter.NewFSNotifyWatcher()
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := interruptContext(cmd.Context())
+			defer stop()
+
+			return workflow.Watch(domain.WatchArgs{
+				TestArgs: domain.TestArgs{
+					EstimateArgs: domain.EstimateArgs{
+						Paths:              parsePaths(args),
+						Exclude:            watchExcludeFlags,
+						Reports:            m.Path(reportsOutputDirFlag),
+						IncludeTestHelpers: watchIncludeTestHelpersFlag,
+						Tags:               watchTagsFlag,
+					},
+					Reports:         m.Path(reportsOutputDirFlag),
+					Threads:         watchParallelFlag,
+					Timeout:         watchTimeoutFlag,
+					TestScope:       domain.TestScope(watchTestScopeFlag),
+					RunTemplates:    runTemplates(watchFuncTestsFlag, nil),
+					QuarantineAfter: domain.DefaultQuarantineAfter,
+					Stop:            ctx.Done(),
+				},
+				Watcher:  watcher,
+				Debounce: watchDebounceFlag,
+			})
+		},
+	}
+	cmd.Flags().IntVarP(&watchParallelFlag, "parallel", "p", 0, "number of parallel workers for mutation testing (0 picks one from CPUs and available memory)")
+	cmd.Flags().StringArrayVarP(&watchExcludeFlags, "exclude", "x", nil, "exclude files matching regex (can be repeated; --ignore is an alias)")
+	cmd.Flags().SetNormalizeFunc(ignoreAlias)
+	cmd.Flags().BoolVar(&watchIncludeTestHelpersFlag, "include-test-helpers", false, "also mutate non-test files that import testing or assertion libraries")
+	cmd.Flags().StringSliceVar(&watchTagsFlag, "tags", nil, "comma-separated build tags; files whose //go:build line excludes them are skipped, and tests run with -tags")
+	cmd.Flags().StringSliceVar(&watchNumbersFlag, "numbers", nil, "variants of numbers mutations to generate, comma-separated: "+numberVariantNames()+" (default all)")
+	cmd.Flags().DurationVar(&watchTimeoutFlag, "timeout", domain.DefaultTestTimeout, "base time budget for each mutation's tests")
+	cmd.Flags().StringVar(&watchTestScopeFlag, "test-scope", string(domain.TestScopeFile), "tests to run per mutant: file, package or dependents (see gooze run --help)")
+	cmd.Flags().BoolVar(&watchFuncTestsFlag, "func-tests", false, "first run only the tests named after the mutated function, then the rest if it survives")
+	cmd.Flags().DurationVar(&watchDebounceFlag, "debounce", domain.DefaultWatchDebounce, "how long to wait after a save for further ones before testing")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+}
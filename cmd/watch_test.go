@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchCmd_PassesFlags(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newWatchCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.EXPECT().Watch(mock.MatchedBy(func(args domain.WatchArgs) bool {
+		return len(args.Paths) == 1 && args.Paths[0] == m.Path("./pkg") &&
+			args.Reports == m.Path("out") && args.EstimateArgs.Reports == m.Path("out") &&
+			args.Threads == 3 &&
+			args.TestScope == domain.TestScopePackage &&
+			args.Debounce == time.Second &&
+			args.QuarantineAfter == domain.DefaultQuarantineAfter &&
+			args.Watcher != nil && args.Stop != nil
+	})).Run(func(args domain.WatchArgs) {
+		_ = args.Watcher.Close()
+	}).Return(nil)
+
+	cmd.SetArgs([]string{"watch", "./pkg", "-o", "out", "-p", "3", "--test-scope", "package", "--debounce", "1s"})
+	err := cmd.Execute()
+	require.NoError(t, err)
+}
+
+func TestWatchCmd_ReturnsWorkflowError(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newWatchCmd())
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	mockWorkflow.EXPECT().Watch(mock.Anything).Run(func(args domain.WatchArgs) {
+		_ = args.Watcher.Close()
+	}).Return(domain.ErrNoSources)
+
+	cmd.SetArgs([]string{"watch"})
+	err := cmd.Execute()
+	require.ErrorIs(t, err, domain.ErrNoSources)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// workerCmd represents the worker command.
+var workerCmd = newWorkerCmd()
+var workerRootFlag string
+
+const workerLongDescription = `Test the mutants a "gooze run --worker" sends on stdin and write each
+result to stdout, one JSON line per mutant, until stdin is closed.
+
+gooze run starts the workers itself with the commands given to --worker,
+typically over ssh to spread a large run across machines:
+  gooze run --worker "ssh build-1 'cd src/app && gooze worker'" \
+            --worker "ssh build-2 'cd src/app && gooze worker'" ./...
+
+Each worker needs a checkout of the same revision of the project in --root
+(default: the current directory) and a Go toolchain; it tests one mutant at
+a time in a copy of the checkout.`
+
+func newWorkerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "worker",
+		Short: "Test mutants sent by gooze run --worker",
+		Long:  workerLongDescription,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			root, err := filepath.Abs(workerRootFlag)
+			if err != nil {
+				return fmt.Errorf("resolve --root: %w", err)
+			}
+
+			return workflow.Worker(domain.WorkerArgs{
+				Root: m.Path(root),
+				In:   cmd.InOrStdin(),
+				Out:  cmd.OutOrStdout(),
+			})
+		},
+	}
+	cmd.Flags().StringVar(&workerRootFlag, "root", ".", "the worker's checkout of the project")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(workerCmd)
+}
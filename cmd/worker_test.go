@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerCmd_ServesOnStdio(t *testing.T) {
+	mockWorkflow := domainmocks.NewMockWorkflow(t)
+
+	cmd := newRootCmd()
+	cmd.AddCommand(newWorkerCmd())
+
+	in := &bytes.Buffer{}
+	out := &bytes.Buffer{}
+	cmd.SetIn(in)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	originalWorkflow := workflow
+	workflow = mockWorkflow
+	defer func() { workflow = originalWorkflow }()
+
+	root, err := filepath.Abs("checkout")
+	require.NoError(t, err)
+
+	mockWorkflow.On("Worker", mock.MatchedBy(func(args domain.WorkerArgs) bool {
+		return args.Root == m.Path(root) && args.In == in && args.Out == out
+	})).Return(nil)
+
+	cmd.SetArgs([]string{"worker", "--root", "checkout"})
+	require.NoError(t, cmd.Execute())
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Closure assigned to a local variable
+func scaleAll(items []int, factor int) []int {
+	scale := func(x int) int {
+		if x < 0 {
+			return -x * factor
+		}
+		return x * factor
+	}
+
+	result := make([]int, 0, len(items))
+	for _, item := range items {
+		result = append(result, scale(item))
+	}
+	return result
+}
+
+// Recursive closure
+func factorial(n int) int {
+	var fact func(k int) int
+	fact = func(k int) int {
+		if k <= 1 {
+			return 1
+		}
+		return k * fact(k-1)
+	}
+	return fact(n)
+}
+
+// Goroutine bodies
+func parallelSum(items []int) int {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	total := 0
+
+	for _, item := range items {
+		wg.Add(1)
+		go func(v int) {
+			defer wg.Done()
+			mu.Lock()
+			total += v
+			mu.Unlock()
+		}(item)
+	}
+
+	wg.Wait()
+	return total
+}
+
+// Deferred function
+func safeDivide(a, b int) (result int, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = 0
+			ok = false
+		}
+	}()
+	return a / b, true
+}
+
+// Nested closures returning closures
+func counter(start int) func() int {
+	count := start
+	return func() int {
+		count++
+		return count
+	}
+}
+
+type tree struct {
+	value       int
+	left, right *tree
+}
+
+// Recursive method
+func (t *tree) sum() int {
+	if t == nil {
+		return 0
+	}
+	return t.value + t.left.sum() + t.right.sum()
+}
+
+// Method value passed as a function
+func sumTrees(trees []*tree) int {
+	total := 0
+	for _, t := range trees {
+		sum := t.sum
+		if sum() > 0 && t.left != nil {
+			total += sum()
+		}
+	}
+	return total
+}
+
+func main() {
+	fmt.Println(scaleAll([]int{1, -2, 3}, 2), factorial(5), parallelSum([]int{1, 2, 3}))
+}
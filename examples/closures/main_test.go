@@ -0,0 +1,53 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScaleAll(t *testing.T) {
+	got := scaleAll([]int{1, -2, 3}, 2)
+	want := []int{2, 4, 6}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("scaleAll() = %v; want %v", got, want)
+	}
+}
+
+func TestFactorial(t *testing.T) {
+	if got := factorial(5); got != 120 {
+		t.Errorf("factorial(5) = %d; want 120", got)
+	}
+}
+
+func TestParallelSum(t *testing.T) {
+	if got := parallelSum([]int{1, 2, 3, 4}); got != 10 {
+		t.Errorf("parallelSum() = %d; want 10", got)
+	}
+}
+
+func TestSafeDivide(t *testing.T) {
+	if got, ok := safeDivide(6, 3); got != 2 || !ok {
+		t.Errorf("safeDivide(6, 3) = %d, %v; want 2, true", got, ok)
+	}
+	if got, ok := safeDivide(1, 0); got != 0 || ok {
+		t.Errorf("safeDivide(1, 0) = %d, %v; want 0, false", got, ok)
+	}
+}
+
+func TestCounter(t *testing.T) {
+	next := counter(3)
+	next()
+	if got := next(); got != 5 {
+		t.Errorf("counter(3) second call = %d; want 5", got)
+	}
+}
+
+func TestTreeSum(t *testing.T) {
+	root := &tree{value: 1, left: &tree{value: 2}, right: &tree{value: 3}}
+	if got := root.sum(); got != 6 {
+		t.Errorf("sum() = %d; want 6", got)
+	}
+	if got := sumTrees([]*tree{root, {value: 4}}); got != 6 {
+		t.Errorf("sumTrees() = %d; want 6", got)
+	}
+}
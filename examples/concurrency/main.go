@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Counter counts events from many goroutines.
+type Counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+// Inc adds one to the count.
+func (c *Counter) Inc() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.n++
+}
+
+// Add adds delta to the count, releasing the lock without defer.
+func (c *Counter) Add(delta int) {
+	c.mu.Lock()
+	c.n += delta
+	c.mu.Unlock()
+}
+
+// Value returns the current count.
+func (c *Counter) Value() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.n
+}
+
+// Registry maps names to values and is mostly read.
+type Registry struct {
+	mu     sync.RWMutex
+	values map[string]int
+}
+
+// Get looks a name up under the read lock.
+func (r *Registry) Get(name string) (int, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.values[name]
+
+	return v, ok
+}
+
+// Set stores a value under the write lock.
+func (r *Registry) Set(name string, v int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.values == nil {
+		r.values = make(map[string]int)
+	}
+
+	r.values[name] = v
+}
+
+// lock and unlock split one critical section across two functions, which
+// the concurrency mutations leave alone.
+func (r *Registry) lock() {
+	r.mu.Lock()
+}
+
+func (r *Registry) unlock() {
+	r.mu.Unlock()
+}
+
+func main() {
+	var c Counter
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			c.Inc()
+		}()
+	}
+
+	wg.Wait()
+
+	r := &Registry{}
+	r.lock()
+	r.unlock()
+	r.Set("count", c.Value())
+	fmt.Println(r.Get("count"))
+}
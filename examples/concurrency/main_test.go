@@ -0,0 +1,37 @@
+package main
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCounterConcurrentInc(t *testing.T) {
+	var c Counter
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			c.Inc()
+		}()
+	}
+
+	wg.Wait()
+
+	if got := c.Value(); got != 50 {
+		t.Errorf("Value() = %d; want 50", got)
+	}
+}
+
+func TestRegistrySetGet(t *testing.T) {
+	var r Registry
+
+	r.Set("a", 1)
+
+	if v, ok := r.Get("a"); !ok || v != 1 {
+		t.Errorf("Get(a) = %d, %v; want 1, true", v, ok)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/mouse-blink/gooze/examples/enums
+
+go 1.21
@@ -0,0 +1,55 @@
+package main
+
+import "fmt"
+
+// Color is an iota-based enum.
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+	Yellow
+)
+
+// Weekday starts counting at one and skips the zero value.
+type Weekday int
+
+const (
+	_ Weekday = iota
+	Monday
+	Tuesday
+	Wednesday
+)
+
+// Limits is a plain constant block without iota; it is not an enum.
+const (
+	MinSize = 1
+	MaxSize = 10
+)
+
+func colorName(c Color) string {
+	switch c {
+	case Red:
+		return "red"
+	case Green:
+		return "green"
+	case Blue, Yellow:
+		return "other"
+	default:
+		return "unknown"
+	}
+}
+
+func isWeekStart(d Weekday) bool {
+	switch d {
+	case Monday:
+		return true
+	}
+
+	return false
+}
+
+func main() {
+	fmt.Println(colorName(Blue), isWeekStart(Tuesday), MinSize, MaxSize)
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestColorName(t *testing.T) {
+	if got := colorName(Red); got != "red" {
+		t.Errorf("colorName(Red) = %q; want %q", got, "red")
+	}
+
+	if got := colorName(Blue); got != "other" {
+		t.Errorf("colorName(Blue) = %q; want %q", got, "other")
+	}
+}
+
+func TestIsWeekStart(t *testing.T) {
+	if !isWeekStart(Monday) {
+		t.Error("isWeekStart(Monday) = false; want true")
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid port %q: %w", s, err)
+	}
+
+	if port < 1 || port > 65535 {
+		return 0, fmt.Errorf("port %d out of range", port)
+	}
+
+	return port, nil
+}
+
+func splitHost(addr string) (string, string, error) {
+	host, port, found := strings.Cut(addr, ":")
+	if !found {
+		return "", "", fmt.Errorf("missing port in %q", addr)
+	}
+
+	return host, port, nil
+}
+
+func normalize(addr string) (string, error) {
+	host, rawPort, err := splitHost(addr)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := parsePort(rawPort)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%d", strings.ToLower(host), port), nil
+}
+
+func lookup(env map[string]string, key string) (string, bool) {
+	value, ok := env[key]
+
+	return value, ok && value != ""
+}
+
+func setting(env map[string]string, key, fallback string) string {
+	value, ok := lookup(env, key)
+	if !ok {
+		return fallback
+	}
+
+	return value
+}
+
+func isFlag(s string) bool {
+	_, err := strconv.ParseBool(s)
+	if err != nil {
+		return false
+	}
+
+	return true
+}
+
+func describe(s string) string {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return "text"
+	} else {
+		return fmt.Sprintf("number %d", n)
+	}
+}
+
+func main() {
+	addr, err := normalize("Example.com:8080")
+	fmt.Println(addr, err)
+	fmt.Println(setting(map[string]string{"mode": "fast"}, "mode", "slow"))
+	fmt.Println(isFlag("true"), describe("42"))
+}
@@ -0,0 +1,31 @@
+package main
+
+import "testing"
+
+func TestParsePort(t *testing.T) {
+	if got, err := parsePort("8080"); err != nil || got != 8080 {
+		t.Errorf("parsePort(\"8080\") = %d, %v; want 8080, nil", got, err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if got, err := normalize("Example.com:8080"); err != nil || got != "example.com:8080" {
+		t.Errorf("normalize() = %q, %v; want example.com:8080, nil", got, err)
+	}
+
+	if _, err := normalize("example.com"); err == nil {
+		t.Error("normalize(\"example.com\") succeeded; want error")
+	}
+}
+
+func TestSetting(t *testing.T) {
+	if got := setting(map[string]string{"mode": "fast"}, "mode", "slow"); got != "fast" {
+		t.Errorf("setting() = %q; want fast", got)
+	}
+}
+
+func TestIsFlag(t *testing.T) {
+	if !isFlag("true") {
+		t.Error("isFlag(\"true\") = false; want true")
+	}
+}
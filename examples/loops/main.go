@@ -47,6 +47,16 @@ func whileStyleLoop(x int) int {
 	return count
 }
 
+func infiniteLoopWithExit(limit int) int {
+	steps := 0
+	for {
+		steps++
+		if steps >= limit {
+			return steps
+		}
+	}
+}
+
 func nestedLoops(n int) int {
 	sum := 0
 	for i := 0; i < n; i++ {
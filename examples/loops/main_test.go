@@ -43,6 +43,14 @@ func TestWhileStyleLoop(t *testing.T) {
 	}
 }
 
+func TestInfiniteLoopWithExit(t *testing.T) {
+	result := infiniteLoopWithExit(3)
+	expected := 3
+	if result != expected {
+		t.Errorf("infiniteLoopWithExit(3) = %d; want %d", result, expected)
+	}
+}
+
 func TestNestedLoops(t *testing.T) {
 	result := nestedLoops(4)
 	expected := 10 // Sum of (1 + 2 + 3 + 4)
@@ -0,0 +1,3 @@
+module github.com/mouse-blink/gooze/examples/mathcalls
+
+go 1.21
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+func clamp(value, low, high int) int {
+	return max(low, min(value, high))
+}
+
+func pages(items, perPage float64) float64 {
+	return math.Ceil(items / perPage)
+}
+
+func bucket(value, width float64) float64 {
+	return math.Floor(value/width) * width
+}
+
+func spread(a, b float64) float64 {
+	return math.Max(a, b) - math.Min(a, b)
+}
+
+func shadowed(a, b int) int {
+	max := func(x, y int) int {
+		if x > y {
+			return x
+		}
+
+		return y
+	}
+
+	return max(a, b)
+}
+
+func main() {
+	fmt.Println(clamp(12, 0, 10), pages(21, 10), bucket(17, 5), spread(3, 7), shadowed(3, 1), math.Abs(-1))
+}
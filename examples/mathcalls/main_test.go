@@ -0,0 +1,15 @@
+package main
+
+import "testing"
+
+func TestClamp(t *testing.T) {
+	if got := clamp(12, 0, 10); got != 10 {
+		t.Errorf("clamp(12, 0, 10) = %d; want 10", got)
+	}
+}
+
+func TestPages(t *testing.T) {
+	if got := pages(21, 10); got != 3 {
+		t.Errorf("pages(21, 10) = %v; want 3", got)
+	}
+}
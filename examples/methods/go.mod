@@ -0,0 +1,3 @@
+module github.com/mouse-blink/gooze/examples/methods
+
+go 1.21
@@ -0,0 +1,104 @@
+package main
+
+import "fmt"
+
+// Number is the constraint for the generic helpers below.
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+// Counter has methods with a pointer receiver.
+type Counter struct {
+	count int
+	limit int
+}
+
+func (c *Counter) Increment() bool {
+	if c.count >= c.limit {
+		return false
+	}
+	c.count++
+	return true
+}
+
+func (c Counter) Remaining() int {
+	return c.limit - c.count
+}
+
+// Stack has methods on a generic receiver.
+type Stack[T any] struct {
+	items []T
+}
+
+func (s *Stack[T]) Push(item T) {
+	s.items = append(s.items, item)
+}
+
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	item := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return item, true
+}
+
+// Pair has methods on a receiver with several type parameters.
+type Pair[K comparable, V Number] struct {
+	Key   K
+	Value V
+}
+
+func (p Pair[K, V]) Scaled(factor V) V {
+	return p.Value * factor
+}
+
+// Sum recurses through an explicit instantiation.
+func Sum[T Number](values []T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	return values[0] + Sum[T](values[1:])
+}
+
+// Base provides methods promoted through embedding.
+type Base struct {
+	id int
+}
+
+func (b *Base) ID() int {
+	return b.id + 1
+}
+
+func (b Base) Valid() bool {
+	return b.id > 0 && b.id < 1000
+}
+
+// Derived embeds Base and overrides one promoted method.
+type Derived struct {
+	Base
+	name string
+}
+
+func (d *Derived) ID() int {
+	return d.Base.ID() * 10
+}
+
+func (d Derived) Label() string {
+	if !d.Valid() {
+		return "invalid"
+	}
+	return fmt.Sprintf("%s-%d", d.name, d.ID())
+}
+
+func main() {
+	c := &Counter{limit: 2}
+	c.Increment()
+
+	s := &Stack[int]{}
+	s.Push(1)
+
+	d := Derived{Base: Base{id: 7}, name: "x"}
+	fmt.Println(c.Remaining(), Sum([]int{1, 2}), d.Label(), Pair[string, int]{"k", 2}.Scaled(3))
+}
@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func TestCounter(t *testing.T) {
+	c := &Counter{limit: 2}
+	if !c.Increment() || !c.Increment() {
+		t.Fatal("expected two increments to succeed")
+	}
+	if c.Increment() {
+		t.Error("expected increment past limit to fail")
+	}
+	if got := c.Remaining(); got != 0 {
+		t.Errorf("Remaining() = %d; want 0", got)
+	}
+}
+
+func TestStack(t *testing.T) {
+	s := &Stack[string]{}
+	if _, ok := s.Pop(); ok {
+		t.Fatal("expected pop on empty stack to fail")
+	}
+	s.Push("a")
+	s.Push("b")
+	if got, ok := s.Pop(); !ok || got != "b" {
+		t.Errorf("Pop() = %q, %v; want b, true", got, ok)
+	}
+}
+
+func TestPairScaled(t *testing.T) {
+	p := Pair[string, float64]{Key: "k", Value: 1.5}
+	if got := p.Scaled(2); got != 3 {
+		t.Errorf("Scaled(2) = %v; want 3", got)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if got := Sum([]int{1, 2, 3}); got != 6 {
+		t.Errorf("Sum() = %d; want 6", got)
+	}
+}
+
+func TestDerived(t *testing.T) {
+	d := &Derived{Base: Base{id: 4}, name: "item"}
+	if got := d.ID(); got != 50 {
+		t.Errorf("ID() = %d; want 50", got)
+	}
+	if got := d.Label(); got != "item-50" {
+		t.Errorf("Label() = %q; want item-50", got)
+	}
+	if got := (Derived{}).Label(); got != "invalid" {
+		t.Errorf("Label() on zero value = %q; want invalid", got)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errNoConfig = errors.New("no config")
+
+// Config holds the settings of a server.
+type Config struct {
+	Host string
+	Port int
+}
+
+// Address returns host:port, or an error for a missing config.
+func Address(c *Config) (string, error) {
+	if c == nil {
+		return "", errNoConfig
+	}
+
+	return fmt.Sprintf("%s:%d", c.Host, c.Port), nil
+}
+
+// Name returns the upper-cased host of the config's receiver.
+func (c *Config) Name() string {
+	if c == nil {
+		return ""
+	}
+
+	return strings.ToUpper(c.Host)
+}
+
+// Merge copies the settings of override onto base; both must be set.
+func Merge(base, override *Config) *Config {
+	if base == nil || override == nil {
+		panic("merge of a nil config")
+	}
+
+	if override.Host != "" {
+		base.Host = override.Host
+	}
+
+	return base
+}
+
+// Copy overwrites the settings of dst with those of src.
+func Copy(dst, src *Config) error {
+	if dst == nil {
+		return errNoConfig
+	}
+
+	if nil == src {
+		return errNoConfig
+	}
+
+	*dst = *src
+
+	return nil
+}
+
+// Lookup returns the value of key in values, or fallback when it is unset.
+func Lookup(values map[string]string, key, fallback string) string {
+	value, ok := values[key]
+	if !ok || value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+func main() {
+	fmt.Println(Address(&Config{Host: "localhost", Port: 8080}))
+}
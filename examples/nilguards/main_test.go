@@ -0,0 +1,36 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAddress(t *testing.T) {
+	if got, err := Address(&Config{Host: "example.com", Port: 80}); err != nil || got != "example.com:80" {
+		t.Errorf("Address() = %q, %v; want example.com:80, nil", got, err)
+	}
+
+	if _, err := Address(nil); !errors.Is(err, errNoConfig) {
+		t.Errorf("Address(nil) error = %v; want errNoConfig", err)
+	}
+}
+
+func TestName(t *testing.T) {
+	c := &Config{Host: "example.com"}
+	if got := c.Name(); got != "EXAMPLE.COM" {
+		t.Errorf("Name() = %q; want EXAMPLE.COM", got)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	var dst Config
+	if err := Copy(&dst, &Config{Host: "example.com"}); err != nil || dst.Host != "example.com" {
+		t.Errorf("Copy() = %v, host %q; want nil, example.com", err, dst.Host)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	if got := Lookup(map[string]string{"a": "b"}, "a", "z"); got != "b" {
+		t.Errorf("Lookup() = %q; want b", got)
+	}
+}
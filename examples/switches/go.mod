@@ -0,0 +1,3 @@
+module github.com/mouse-blink/gooze/examples/switches
+
+go 1.21
@@ -0,0 +1,54 @@
+package main
+
+import "fmt"
+
+// grade maps a score to a letter, with the low scores falling to F.
+func grade(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 80:
+		return "B"
+	case score >= 70:
+		return "C"
+	default:
+		return "F"
+	}
+}
+
+// permissions lists what a role may do; each role falls through to the
+// permissions of the one below it.
+func permissions(role string) []string {
+	var perms []string
+
+	switch role {
+	case "admin":
+		perms = append(perms, "delete")
+		fallthrough
+	case "editor":
+		perms = append(perms, "write")
+		fallthrough
+	case "viewer":
+		perms = append(perms, "read")
+	}
+
+	return perms
+}
+
+// describe names the kind of a value.
+func describe(v any) string {
+	switch v.(type) {
+	case int, int64:
+		return "integer"
+	case string:
+		return "text"
+	case nil:
+		return "nothing"
+	}
+
+	return "other"
+}
+
+func main() {
+	fmt.Println(grade(85), permissions("editor"), describe(3))
+}
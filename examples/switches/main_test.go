@@ -0,0 +1,28 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGrade(t *testing.T) {
+	if got := grade(95); got != "A" {
+		t.Errorf("grade(95) = %q; want A", got)
+	}
+
+	if got := grade(10); got != "F" {
+		t.Errorf("grade(10) = %q; want F", got)
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	if got := permissions("admin"); !reflect.DeepEqual(got, []string{"delete", "write", "read"}) {
+		t.Errorf("permissions(admin) = %v", got)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	if got := describe("x"); got != "text" {
+		t.Errorf("describe(x) = %q; want text", got)
+	}
+}
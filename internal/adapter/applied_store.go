@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const appliedFileName = "_applied.yaml"
+
+type appliedYAML struct {
+	MutationID string `yaml:"mutation_id"`
+	Path       string `yaml:"path"`
+	Original   string `yaml:"original"`
+}
+
+// SaveApplied writes `_applied.yaml` recording the mutation applied to the
+// working tree and the original content of its file. Saving nil removes the
+// file. Object stores keep the record in their local copy only, since it
+// describes this checkout.
+func (rs *LocalReportStore) SaveApplied(path m.Path, applied *m.AppliedMutation) error {
+	dirPath := string(path)
+	if dirPath == "" {
+		return fmt.Errorf("reports directory path is required")
+	}
+
+	appliedPath := filepath.Join(dirPath, appliedFileName)
+
+	if applied == nil {
+		if err := os.Remove(appliedPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove applied file %s: %w", appliedPath, err)
+		}
+
+		return nil
+	}
+
+	if err := os.MkdirAll(dirPath, 0o750); err != nil {
+		return fmt.Errorf("create reports directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(appliedYAML{
+		MutationID: applied.MutationID,
+		Path:       string(applied.Path),
+		Original:   string(applied.Original),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal applied YAML: %w", err)
+	}
+
+	return writeFileSynced(appliedPath, data)
+}
+
+// LoadApplied returns the mutation `_applied.yaml` records as applied, nil
+// when there is none.
+func (rs *LocalReportStore) LoadApplied(path m.Path) (*m.AppliedMutation, error) {
+	dirPath := string(path)
+	if dirPath == "" {
+		return nil, fmt.Errorf("reports directory path is required")
+	}
+
+	appliedPath := filepath.Join(dirPath, appliedFileName)
+
+	// #nosec G304 -- appliedPath lies in the reports directory
+	data, err := os.ReadFile(appliedPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read applied file %s: %w", appliedPath, err)
+	}
+
+	var applied appliedYAML
+	if err := yaml.Unmarshal(data, &applied); err != nil {
+		return nil, fmt.Errorf("unmarshal applied file %s: %w", appliedPath, err)
+	}
+
+	return &m.AppliedMutation{
+		MutationID: applied.MutationID,
+		Path:       m.Path(applied.Path),
+		Original:   []byte(applied.Original),
+	}, nil
+}
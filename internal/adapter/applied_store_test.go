@@ -0,0 +1,62 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_SaveApplied_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	applied, err := rs.LoadApplied(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadApplied returned error: %v", err)
+	}
+
+	if applied != nil {
+		t.Fatalf("expected no applied mutation, got %#v", applied)
+	}
+
+	want := m.AppliedMutation{
+		MutationID: "abcd1234",
+		Path:       "/abs/calc.go",
+		Original:   []byte("package calc\n\nfunc Add(a, b int) int {\n\treturn a + b\n}\n"),
+	}
+
+	if err := rs.SaveApplied(m.Path(dir), &want); err != nil {
+		t.Fatalf("SaveApplied returned error: %v", err)
+	}
+
+	applied, err = rs.LoadApplied(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadApplied returned error: %v", err)
+	}
+
+	if applied == nil || applied.MutationID != want.MutationID || applied.Path != want.Path ||
+		string(applied.Original) != string(want.Original) {
+		t.Fatalf("expected %#v, got %#v", want, applied)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(reports) != 0 {
+		t.Fatalf("expected the applied file not to load as a report, got %d reports", len(reports))
+	}
+
+	if err := rs.SaveApplied(m.Path(dir), nil); err != nil {
+		t.Fatalf("SaveApplied(nil) returned error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, appliedFileName)); !os.IsNotExist(err) {
+		t.Fatalf("expected %s to be removed, got %v", appliedFileName, err)
+	}
+}
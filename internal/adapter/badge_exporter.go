@@ -0,0 +1,127 @@
+package adapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// badgeHexColors are the hex values of the shields.io colors badges use.
+var badgeHexColors = map[string]string{
+	"brightgreen": "#4c1",
+	"green":       "#97ca00",
+	"yellow":      "#dfb317",
+	"orange":      "#fe7d37",
+	"red":         "#e05d44",
+	"lightgrey":   "#9f9f9f",
+}
+
+// badgeCharWidth approximates the width of an 11px Verdana character, which
+// is enough to size the two halves of a flat badge.
+const (
+	badgeCharWidth = 7
+	badgePadding   = 10
+)
+
+var badgeTemplate = template.Must(template.New("badge").Parse(
+	`<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="20" role="img" aria-label="{{.Label}}: {{.Message}}">
+<title>{{.Label}}: {{.Message}}</title>
+<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>
+<clipPath id="r"><rect width="{{.Width}}" height="20" rx="3" fill="#fff"/></clipPath>
+<g clip-path="url(#r)"><rect width="{{.LabelWidth}}" height="20" fill="#555"/><rect x="{{.LabelWidth}}" width="{{.MessageWidth}}" height="20" fill="{{.Color}}"/><rect width="{{.Width}}" height="20" fill="url(#s)"/></g>
+<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">
+<text x="{{.LabelX}}" y="15" fill="#010101" fill-opacity=".3">{{.Label}}</text><text x="{{.LabelX}}" y="14">{{.Label}}</text>
+<text x="{{.MessageX}}" y="15" fill="#010101" fill-opacity=".3">{{.Message}}</text><text x="{{.MessageX}}" y="14">{{.Message}}</text>
+</g>
+</svg>
+`))
+
+// badgeEndpoint is the shields.io endpoint schema,
+// https://shields.io/badges/endpoint-badge.
+type badgeEndpoint struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// ExportBadge writes badge as a flat SVG shield to svgPath and as shields.io
+// endpoint JSON to endpointPath. Either path may be empty to skip that file.
+func (rs *LocalReportStore) ExportBadge(svgPath m.Path, endpointPath m.Path, badge m.Badge) error {
+	if svgPath != "" {
+		svg, err := renderBadgeSVG(badge)
+		if err != nil {
+			return err
+		}
+
+		if err := writeBadgeFile(string(svgPath), svg); err != nil {
+			return err
+		}
+	}
+
+	if endpointPath != "" {
+		data, err := json.MarshalIndent(badgeEndpoint{
+			SchemaVersion: 1,
+			Label:         badge.Label,
+			Message:       badge.Message,
+			Color:         badge.Color,
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal badge endpoint: %w", err)
+		}
+
+		if err := writeBadgeFile(string(endpointPath), append(data, '\n')); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renderBadgeSVG(badge m.Badge) ([]byte, error) {
+	color, ok := badgeHexColors[badge.Color]
+	if !ok {
+		color = badgeHexColors["lightgrey"]
+	}
+
+	labelWidth := len([]rune(badge.Label))*badgeCharWidth + badgePadding
+	messageWidth := len([]rune(badge.Message))*badgeCharWidth + badgePadding
+
+	var buf bytes.Buffer
+
+	// text/template does not escape, so the text is escaped up front.
+	err := badgeTemplate.Execute(&buf, map[string]any{
+		"Label":        template.HTMLEscapeString(badge.Label),
+		"Message":      template.HTMLEscapeString(badge.Message),
+		"Color":        color,
+		"Width":        labelWidth + messageWidth,
+		"LabelWidth":   labelWidth,
+		"MessageWidth": messageWidth,
+		"LabelX":       labelWidth / 2,
+		"MessageX":     labelWidth + messageWidth/2,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("render badge: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeBadgeFile(path string, data []byte) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return fmt.Errorf("create badge output directory: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("write badge %s: %w", path, err)
+	}
+
+	return nil
+}
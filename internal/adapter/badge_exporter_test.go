@@ -0,0 +1,77 @@
+package adapter
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_ExportBadge_WritesSVGAndEndpoint(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	svgPath := filepath.Join(dir, "nested", "badge.svg")
+	endpointPath := filepath.Join(dir, "badge.json")
+	rs := &LocalReportStore{}
+
+	badge := m.Badge{Label: "mutation score", Message: "42% <partial>", Color: "orange"}
+	if err := rs.ExportBadge(m.Path(svgPath), m.Path(endpointPath), badge); err != nil {
+		t.Fatalf("ExportBadge() error = %v", err)
+	}
+
+	svg, err := os.ReadFile(svgPath)
+	if err != nil {
+		t.Fatalf("read svg badge: %v", err)
+	}
+
+	if err := xml.Unmarshal(svg, new(struct{})); err != nil {
+		t.Fatalf("badge is not well-formed XML: %v\n%s", err, svg)
+	}
+
+	for _, want := range []string{"mutation score", "42% &lt;partial&gt;", `fill="#fe7d37"`} {
+		if !strings.Contains(string(svg), want) {
+			t.Fatalf("expected svg to contain %q, got:\n%s", want, svg)
+		}
+	}
+
+	data, err := os.ReadFile(endpointPath)
+	if err != nil {
+		t.Fatalf("read endpoint json: %v", err)
+	}
+
+	var got badgeEndpoint
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal endpoint json: %v", err)
+	}
+
+	want := badgeEndpoint{SchemaVersion: 1, Label: "mutation score", Message: "42% <partial>", Color: "orange"}
+	if got != want {
+		t.Fatalf("endpoint = %+v, want %+v", got, want)
+	}
+}
+
+func TestLocalReportStore_ExportBadge_SkipsEmptyEndpoint(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	badge := m.Badge{Label: "mutation score", Message: "0%", Color: "red"}
+	if err := rs.ExportBadge(m.Path(filepath.Join(dir, "badge.svg")), "", badge); err != nil {
+		t.Fatalf("ExportBadge() error = %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read dir: %v", err)
+	}
+
+	if len(entries) != 1 || entries[0].Name() != "badge.svg" {
+		t.Fatalf("expected only badge.svg, got %v", entries)
+	}
+}
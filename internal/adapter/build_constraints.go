@@ -0,0 +1,52 @@
+package adapter
+
+import (
+	"go/ast"
+	"go/build"
+	"go/build/constraint"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// matchesPlatformFileName reports whether a _GOOS, _GOARCH or _GOOS_GOARCH
+// file name suffix, if any, matches the platform gooze builds for. Such files
+// never compile elsewhere, whatever tags are set. //go:build lines are left to
+// the caller, who knows the requested tags.
+func matchesPlatformFileName(path string) bool {
+	ctx := build.Default
+	// MatchFile also reads the file header; a bare package clause keeps it to
+	// the name checks.
+	ctx.OpenFile = func(string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("package p\n")), nil
+	}
+
+	match, err := ctx.MatchFile(filepath.Dir(path), filepath.Base(path))
+
+	return err == nil && match
+}
+
+// goBuildConstraint returns the expression of the file's //go:build line, or
+// an empty string when it has none. The file must be parsed with comments.
+func goBuildConstraint(file *ast.File) string {
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			break
+		}
+
+		for _, comment := range group.List {
+			if !constraint.IsGoBuild(comment.Text) {
+				continue
+			}
+
+			expr, err := constraint.Parse(comment.Text)
+			if err != nil {
+				continue
+			}
+
+			return expr.String()
+		}
+	}
+
+	return ""
+}
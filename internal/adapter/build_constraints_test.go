@@ -0,0 +1,83 @@
+package adapter
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func otherGOOS() string {
+	if runtime.GOOS == "plan9" {
+		return "windows"
+	}
+
+	return "plan9"
+}
+
+func TestMatchesPlatformFileName(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name string
+		file string
+		want bool
+	}{
+		{name: "plain", file: "calc.go", want: true},
+		{name: "current GOOS", file: "calc_" + runtime.GOOS + ".go", want: true},
+		{name: "current GOOS and GOARCH", file: "calc_" + runtime.GOOS + "_" + runtime.GOARCH + ".go", want: true},
+		{name: "other GOOS", file: "calc_" + otherGOOS() + ".go", want: false},
+		{name: "unknown suffix", file: "calc_helpers.go", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesPlatformFileName(filepath.Join(dir, tt.file)))
+		})
+	}
+}
+
+func TestGoBuildConstraint(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{name: "none", src: "package calc\n", want: ""},
+		{name: "go:build line", src: "//go:build integration && !race\n\npackage calc\n", want: "integration && !race"},
+		{name: "after license header", src: "// Copyright.\n\n//go:build linux\n\npackage calc\n", want: "linux"},
+		{name: "in package doc is ignored", src: "package calc\n\n//go:build linux\nvar x = 1\n", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.ParseFile(token.NewFileSet(), "calc.go", tt.src, parser.ParseComments)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, goBuildConstraint(file))
+		})
+	}
+}
+
+func TestLocalSourceFSAdapter_Get_BuildConstraints(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "go.mod"), "module example.com/calc\n\ngo 1.21\n")
+	writeTestFile(t, filepath.Join(dir, "calc.go"), "package calc\n")
+	writeTestFile(t, filepath.Join(dir, "calc_"+otherGOOS()+".go"), "package calc\n")
+	writeTestFile(t, filepath.Join(dir, "slow.go"), "//go:build slow\n\npackage calc\n")
+
+	sources, err := NewLocalSourceFSAdapter().Get([]m.Path{m.Path(dir)})
+	require.NoError(t, err)
+	require.Len(t, sources, 2)
+
+	constraints := map[string]string{}
+	for _, source := range sources {
+		constraints[filepath.Base(string(source.Origin.FullPath))] = source.Constraint
+	}
+
+	assert.Equal(t, map[string]string{"calc.go": "", "slow.go": "slow"}, constraints)
+}
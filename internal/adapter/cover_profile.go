@@ -0,0 +1,107 @@
+package adapter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// ParseCoverProfile reads a go test -coverprofile file and returns the lines
+// executed at least once. Profile entries name files by import path;
+// packageDirs maps package import paths to their directories so entries can
+// be resolved to files on disk. Entries of unknown packages are skipped.
+func ParseCoverProfile(r io.Reader, packageDirs map[string]m.Path) (m.Coverage, error) {
+	coverage := m.Coverage{}
+	scanner := bufio.NewScanner(r)
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+
+		block, err := parseCoverBlock(line)
+		if err != nil {
+			return nil, fmt.Errorf("cover profile line %d: %w", lineNo, err)
+		}
+
+		if block.count == 0 {
+			continue
+		}
+
+		dir, ok := packageDirs[path.Dir(block.file)]
+		if !ok {
+			continue
+		}
+
+		file := m.Path(filepath.Join(string(dir), path.Base(block.file)))
+		coverage.Add(file, block.startLine, block.endLine)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read cover profile: %w", err)
+	}
+
+	return coverage, nil
+}
+
+type coverBlock struct {
+	file      string
+	startLine int
+	endLine   int
+	count     int
+}
+
+// parseCoverBlock parses "import/path/file.go:12.5,14.2 3 1", a block's
+// position, statement count and execution count.
+func parseCoverBlock(line string) (coverBlock, error) {
+	colon := strings.LastIndex(line, ":")
+	if colon < 0 {
+		return coverBlock{}, fmt.Errorf("missing file in %q", line)
+	}
+
+	fields := strings.Fields(line[colon+1:])
+	if len(fields) != 3 {
+		return coverBlock{}, fmt.Errorf("malformed block %q", line)
+	}
+
+	start, end, ok := strings.Cut(fields[0], ",")
+	if !ok {
+		return coverBlock{}, fmt.Errorf("malformed range in %q", line)
+	}
+
+	startLine, err := coverLine(start)
+	if err != nil {
+		return coverBlock{}, err
+	}
+
+	endLine, err := coverLine(end)
+	if err != nil {
+		return coverBlock{}, err
+	}
+
+	count, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return coverBlock{}, fmt.Errorf("malformed count in %q: %w", line, err)
+	}
+
+	return coverBlock{file: line[:colon], startLine: startLine, endLine: endLine, count: count}, nil
+}
+
+// coverLine returns the line of a "line.column" position.
+func coverLine(pos string) (int, error) {
+	lineText, _, _ := strings.Cut(pos, ".")
+
+	line, err := strconv.Atoi(lineText)
+	if err != nil {
+		return 0, fmt.Errorf("malformed position %q: %w", pos, err)
+	}
+
+	return line, nil
+}
@@ -0,0 +1,93 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestParseCoverProfile(t *testing.T) {
+	profile := `mode: set
+example.com/calc/calc.go:3.24,5.2 1 1
+example.com/calc/calc.go:7.24,9.2 1 0
+example.com/calc/sub/sub.go:3.16,3.30 1 1
+example.com/other/other.go:1.1,2.2 1 1
+`
+	dirs := map[string]m.Path{
+		"example.com/calc":     "/src/calc",
+		"example.com/calc/sub": "/src/calc/sub",
+	}
+
+	coverage, err := ParseCoverProfile(strings.NewReader(profile), dirs)
+	if err != nil {
+		t.Fatalf("ParseCoverProfile() error = %v", err)
+	}
+
+	calc := m.Path(filepath.Join("/src/calc", "calc.go"))
+	for _, line := range []int{3, 4, 5} {
+		if !coverage.Covers(calc, line) {
+			t.Fatalf("line %d of calc.go not covered: %v", line, coverage)
+		}
+	}
+
+	if coverage.Covers(calc, 8) {
+		t.Fatalf("line 8 of calc.go covered, but its block never ran")
+	}
+
+	if !coverage.Covers(m.Path(filepath.Join("/src/calc/sub", "sub.go")), 3) {
+		t.Fatalf("sub.go not covered: %v", coverage)
+	}
+
+	if len(coverage) != 2 {
+		t.Fatalf("coverage has %d files, want 2 (unknown packages skipped): %v", len(coverage), coverage)
+	}
+}
+
+func TestParseCoverProfile_Malformed(t *testing.T) {
+	for _, line := range []string{
+		"example.com/calc/calc.go 1 1",
+		"example.com/calc/calc.go:3.24 1 1",
+		"example.com/calc/calc.go:x.1,5.2 1 1",
+		"example.com/calc/calc.go:3.24,5.2 1",
+		"example.com/calc/calc.go:3.24,5.2 1 many",
+	} {
+		if _, err := ParseCoverProfile(strings.NewReader("mode: set\n"+line+"\n"), nil); err == nil {
+			t.Fatalf("ParseCoverProfile(%q) error = nil, want an error", line)
+		}
+	}
+}
+
+func TestLocalTestRunnerAdapter_RunGoTest_CoverProfile(t *testing.T) {
+	workDir := t.TempDir()
+	writeTestFile(t, filepath.Join(workDir, "go.mod"), "module example.com/covered\n\ngo 1.21\n")
+	writeTestFile(t, filepath.Join(workDir, "covered.go"),
+		"package covered\n\nfunc Used() int {\n\treturn 1\n}\n\nfunc Unused() int {\n\treturn 2\n}\n")
+	writeTestFile(t, filepath.Join(workDir, "covered_test.go"),
+		"package covered\n\nimport \"testing\"\n\nfunc TestUsed(t *testing.T) { _ = Used() }\n")
+
+	profile := filepath.Join(t.TempDir(), "cover.out")
+
+	adapter := NewLocalTestRunnerAdapter()
+	if out, err := adapter.RunGoTest(workDir, "./...", GoTestOptions{CoverProfile: profile}); err != nil {
+		t.Fatalf("RunGoTest() error = %v, output = %s", err, out)
+	}
+
+	file, err := os.Open(profile)
+	if err != nil {
+		t.Fatalf("open cover profile: %v", err)
+	}
+	defer file.Close()
+
+	coverage, err := ParseCoverProfile(file, map[string]m.Path{"example.com/covered": m.Path(workDir)})
+	if err != nil {
+		t.Fatalf("ParseCoverProfile() error = %v", err)
+	}
+
+	source := m.Path(filepath.Join(workDir, "covered.go"))
+	if !coverage.Covers(source, 4) || coverage.Covers(source, 8) {
+		t.Fatalf("coverage = %v, want line 4 covered and line 8 not", coverage)
+	}
+}
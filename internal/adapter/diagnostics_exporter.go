@@ -0,0 +1,202 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const (
+	diagnosticsSource = "gooze"
+	// lspSeverityWarning is DiagnosticSeverity.Warning in the LSP spec.
+	lspSeverityWarning = 2
+)
+
+type rdjsonResult struct {
+	Source      rdjsonSource       `json:"source"`
+	Severity    string             `json:"severity"`
+	Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+}
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+}
+
+type rdjsonDiagnostic struct {
+	Message        string         `json:"message"`
+	Location       rdjsonLocation `json:"location"`
+	Severity       string         `json:"severity"`
+	Code           rdjsonCode     `json:"code"`
+	OriginalOutput string         `json:"original_output,omitempty"`
+}
+
+type rdjsonLocation struct {
+	Path  string      `json:"path"`
+	Range rdjsonRange `json:"range"`
+}
+
+type rdjsonRange struct {
+	Start rdjsonPosition `json:"start"`
+}
+
+type rdjsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+}
+
+type lspPublishDiagnostics struct {
+	URI         string          `json:"uri"`
+	Diagnostics []lspDiagnostic `json:"diagnostics"`
+}
+
+type lspDiagnostic struct {
+	Range    lspRange `json:"range"`
+	Severity int      `json:"severity"`
+	Code     string   `json:"code"`
+	Source   string   `json:"source"`
+	Message  string   `json:"message"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// ExportDiagnostics writes diagnostics to path in format, ordered by file and
+// position, so editors and reviewdog can mark survived mutations where they
+// live.
+func (rs *LocalReportStore) ExportDiagnostics(path m.Path, format m.DiagnosticsFormat, diagnostics []m.Diagnostic) error {
+	filePath := string(path)
+	if filePath == "" {
+		return fmt.Errorf("diagnostics output path is required")
+	}
+
+	sorted := make([]m.Diagnostic, len(diagnostics))
+	copy(sorted, diagnostics)
+	sortDiagnostics(sorted)
+
+	var document any
+
+	switch format {
+	case m.DiagnosticsRDJSON, "":
+		document = buildRDJSON(sorted)
+	case m.DiagnosticsLSP:
+		document = buildLSPDiagnostics(sorted)
+	default:
+		return fmt.Errorf("unknown diagnostics format %q", format)
+	}
+
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal diagnostics: %w", err)
+	}
+
+	if dir := filepath.Dir(filePath); dir != "." {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return fmt.Errorf("create diagnostics output directory: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(filePath, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write diagnostics %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+func sortDiagnostics(diagnostics []m.Diagnostic) {
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		a, b := diagnostics[i], diagnostics[j]
+		if a.File.FullPath != b.File.FullPath {
+			return a.File.FullPath < b.File.FullPath
+		}
+
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+
+		return a.MutationID < b.MutationID
+	})
+}
+
+func buildRDJSON(diagnostics []m.Diagnostic) rdjsonResult {
+	result := rdjsonResult{
+		Source:      rdjsonSource{Name: diagnosticsSource},
+		Severity:    "WARNING",
+		Diagnostics: make([]rdjsonDiagnostic, 0, len(diagnostics)),
+	}
+
+	for _, diagnostic := range diagnostics {
+		result.Diagnostics = append(result.Diagnostics, rdjsonDiagnostic{
+			Message: diagnostic.Message(),
+			Location: rdjsonLocation{
+				Path:  filepath.ToSlash(string(diagnostic.File.DisplayPath())),
+				Range: rdjsonRange{Start: rdjsonPosition{Line: diagnostic.Line, Column: diagnostic.Column}},
+			},
+			Severity:       "WARNING",
+			Code:           rdjsonCode{Value: diagnostic.Type.Name},
+			OriginalOutput: string(diagnostic.Diff),
+		})
+	}
+
+	return result
+}
+
+// buildLSPDiagnostics groups diagnostics per file. LSP positions are 0-based
+// and count UTF-16 code units; the byte column is used as is, which only
+// differs on lines with non-ASCII text before the mutation. The range is
+// empty, at the start of the mutated expression.
+func buildLSPDiagnostics(diagnostics []m.Diagnostic) []lspPublishDiagnostics {
+	files := make([]lspPublishDiagnostics, 0)
+
+	for _, diagnostic := range diagnostics {
+		uri := fileURI(diagnostic.File.FullPath)
+		if len(files) == 0 || files[len(files)-1].URI != uri {
+			files = append(files, lspPublishDiagnostics{URI: uri})
+		}
+
+		last := &files[len(files)-1]
+		last.Diagnostics = append(last.Diagnostics, newLSPDiagnostic(diagnostic))
+	}
+
+	return files
+}
+
+func newLSPDiagnostic(diagnostic m.Diagnostic) lspDiagnostic {
+	position := lspPosition{Line: max(diagnostic.Line-1, 0), Character: max(diagnostic.Column-1, 0)}
+
+	return lspDiagnostic{
+		Range:    lspRange{Start: position, End: position},
+		Severity: lspSeverityWarning,
+		Code:     diagnostic.Type.Name,
+		Source:   diagnosticsSource,
+		Message:  diagnostic.Message(),
+	}
+}
+
+func fileURI(path m.Path) string {
+	absolute, err := filepath.Abs(string(path))
+	if err != nil {
+		absolute = string(path)
+	}
+
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(absolute)}).String()
+}
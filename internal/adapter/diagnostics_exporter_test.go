@@ -0,0 +1,156 @@
+package adapter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func testDiagnostics() []m.Diagnostic {
+	diff := []byte("--- original\n+++ mutated\n@@ -4,3 +4,3 @@\n func Add(a, b int) int {\n-\treturn a + b\n+\treturn a - b\n }\n")
+
+	return []m.Diagnostic{
+		{
+			MutationID: "m2",
+			Type:       m.MutationBoolean,
+			File:       m.File{ShortPath: "pkg/b.go", FullPath: "/abs/pkg/b.go"},
+			Line:       9,
+			Column:     2,
+		},
+		{
+			MutationID: "m1",
+			Type:       m.MutationArithmetic,
+			File:       m.File{ShortPath: "pkg/a.go", FullPath: "/abs/pkg/a.go"},
+			Line:       5,
+			Column:     11,
+			Func:       "Add",
+			Diff:       diff,
+		},
+		{
+			MutationID: "m3",
+			Type:       m.MutationNumbers,
+			File:       m.File{ShortPath: "pkg/a.go", FullPath: "/abs/pkg/a.go"},
+			Line:       2,
+			Column:     7,
+		},
+	}
+}
+
+func TestLocalReportStore_ExportDiagnostics_RDJSON(t *testing.T) {
+	t.Parallel()
+
+	outPath := filepath.Join(t.TempDir(), "nested", "gooze.rdjson")
+	rs := &LocalReportStore{}
+
+	if err := rs.ExportDiagnostics(m.Path(outPath), m.DiagnosticsRDJSON, testDiagnostics()); err != nil {
+		t.Fatalf("ExportDiagnostics() error = %v", err)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read diagnostics: %v", err)
+	}
+
+	var got rdjsonResult
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal diagnostics: %v", err)
+	}
+
+	if got.Source.Name != "gooze" || len(got.Diagnostics) != 3 {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+
+	first := got.Diagnostics[0]
+	if first.Location.Path != "pkg/a.go" || first.Location.Range.Start != (rdjsonPosition{Line: 2, Column: 7}) {
+		t.Fatalf("expected diagnostics ordered by file and line, got first %+v", first)
+	}
+
+	second := got.Diagnostics[1]
+	if second.Code.Value != "arithmetic" || second.Severity != "WARNING" {
+		t.Fatalf("unexpected diagnostic: %+v", second)
+	}
+
+	wantMessage := "arithmetic mutation survived in Add (m1): no test fails with this change\n@@ -4,3 +4,3 @@"
+	if !strings.HasPrefix(second.Message, wantMessage) || strings.Contains(second.Message, "+++ mutated") {
+		t.Fatalf("unexpected message:\n%s", second.Message)
+	}
+
+	if !strings.Contains(second.OriginalOutput, "+\treturn a - b") {
+		t.Fatalf("expected the diff in original_output, got %q", second.OriginalOutput)
+	}
+
+	if got.Diagnostics[2].Location.Path != "pkg/b.go" {
+		t.Fatalf("expected pkg/b.go last, got %+v", got.Diagnostics[2])
+	}
+}
+
+func TestLocalReportStore_ExportDiagnostics_LSP(t *testing.T) {
+	t.Parallel()
+
+	outPath := filepath.Join(t.TempDir(), "gooze.lsp.json")
+	rs := &LocalReportStore{}
+
+	if err := rs.ExportDiagnostics(m.Path(outPath), m.DiagnosticsLSP, testDiagnostics()); err != nil {
+		t.Fatalf("ExportDiagnostics() error = %v", err)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read diagnostics: %v", err)
+	}
+
+	var got []lspPublishDiagnostics
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal diagnostics: %v", err)
+	}
+
+	if len(got) != 2 || got[0].URI != "file:///abs/pkg/a.go" || got[1].URI != "file:///abs/pkg/b.go" {
+		t.Fatalf("expected one entry per file, got %+v", got)
+	}
+
+	if len(got[0].Diagnostics) != 2 {
+		t.Fatalf("expected two diagnostics for a.go, got %+v", got[0].Diagnostics)
+	}
+
+	arithmetic := got[0].Diagnostics[1]
+	want := lspRange{Start: lspPosition{Line: 4, Character: 10}, End: lspPosition{Line: 4, Character: 10}}
+
+	if arithmetic.Range != want || arithmetic.Severity != lspSeverityWarning || arithmetic.Code != "arithmetic" || arithmetic.Source != "gooze" {
+		t.Fatalf("unexpected diagnostic: %+v", arithmetic)
+	}
+}
+
+func TestLocalReportStore_ExportDiagnostics_EmptyIsValid(t *testing.T) {
+	t.Parallel()
+
+	outPath := filepath.Join(t.TempDir(), "gooze.rdjson")
+	rs := &LocalReportStore{}
+
+	if err := rs.ExportDiagnostics(m.Path(outPath), "", nil); err != nil {
+		t.Fatalf("ExportDiagnostics() error = %v", err)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read diagnostics: %v", err)
+	}
+
+	if !strings.Contains(string(data), `"diagnostics": []`) {
+		t.Fatalf("expected an empty diagnostics list, got:\n%s", data)
+	}
+}
+
+func TestLocalReportStore_ExportDiagnostics_UnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	rs := &LocalReportStore{}
+
+	err := rs.ExportDiagnostics(m.Path(filepath.Join(t.TempDir(), "out.json")), "sarif", nil)
+	if err == nil || !strings.Contains(err.Error(), "unknown diagnostics format") {
+		t.Fatalf("expected unknown format error, got %v", err)
+	}
+}
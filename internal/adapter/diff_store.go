@@ -0,0 +1,59 @@
+package adapter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// SaveMutationDiffs writes each mutation's diff to
+// `<path>/<source path>/<mutation id>.<type>.diff`, mirroring the project
+// layout so mutations can be reviewed file by file. With fullFiles the
+// complete mutated source is written next to it as `<mutation id>.<type>.go`.
+func (rs *LocalReportStore) SaveMutationDiffs(path m.Path, mutations []m.Mutation, fullFiles bool) error {
+	dirPath := string(path)
+	if dirPath == "" {
+		return fmt.Errorf("diff output directory path is required")
+	}
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil {
+			continue
+		}
+
+		sourceDir := filepath.Join(dirPath, diffSourceDir(mutation.Source.Origin))
+		if err := os.MkdirAll(sourceDir, 0o750); err != nil {
+			return fmt.Errorf("create diff directory: %w", err)
+		}
+
+		base := filepath.Join(sourceDir, mutation.ID+"."+mutation.Type.Name)
+
+		if err := os.WriteFile(base+".diff", mutation.DiffCode, 0o600); err != nil {
+			return fmt.Errorf("write diff for mutation %s: %w", mutation.ID, err)
+		}
+
+		if fullFiles {
+			if err := os.WriteFile(base+".go", mutation.MutatedCode, 0o600); err != nil {
+				return fmt.Errorf("write mutated file for mutation %s: %w", mutation.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// diffSourceDir is the source's project-relative path, used as a directory
+// name. Files without one, or whose relative path leaves the project, fall
+// back to their name in their package so nothing is written outside the
+// output directory.
+func diffSourceDir(file *m.File) string {
+	short := filepath.Clean(filepath.FromSlash(string(file.ShortPath)))
+	if file.ShortPath == "" || filepath.IsAbs(short) || short == ".." || strings.HasPrefix(short, ".."+string(filepath.Separator)) {
+		return string(file.PackageRelative())
+	}
+
+	return short
+}
@@ -0,0 +1,100 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_SaveMutationDiffs_MirrorsSourceLayout(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	source := m.Source{Origin: &m.File{FullPath: "/abs/pkg/calc.go", ShortPath: "pkg/calc.go"}}
+	mutations := []m.Mutation{
+		{ID: "a1", Type: m.MutationArithmetic, Source: source, DiffCode: []byte("-a + b\n+a - b\n"), MutatedCode: []byte("package pkg\n")},
+		{ID: "a2", Type: m.MutationBoolean, Source: source, DiffCode: []byte("-true\n+false\n")},
+	}
+
+	if err := rs.SaveMutationDiffs(m.Path(dir), mutations, false); err != nil {
+		t.Fatalf("SaveMutationDiffs returned error: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "pkg", "calc.go", "a1.arithmetic.diff"))
+	if err != nil {
+		t.Fatalf("read diff: %v", err)
+	}
+
+	if string(got) != "-a + b\n+a - b\n" {
+		t.Fatalf("diff = %q", got)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "pkg", "calc.go", "a2.boolean.diff")); err != nil {
+		t.Fatalf("second diff missing: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "pkg", "calc.go", "a1.arithmetic.go")); !os.IsNotExist(err) {
+		t.Fatalf("mutated file written without fullFiles: %v", err)
+	}
+}
+
+func TestLocalReportStore_SaveMutationDiffs_FullFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	mutations := []m.Mutation{{
+		ID:          "b1",
+		Type:        m.MutationComparison,
+		Source:      m.Source{Origin: &m.File{FullPath: "/abs/main.go"}},
+		DiffCode:    []byte("diff"),
+		MutatedCode: []byte("package main\n"),
+	}}
+
+	if err := rs.SaveMutationDiffs(m.Path(dir), mutations, true); err != nil {
+		t.Fatalf("SaveMutationDiffs returned error: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "main.go", "b1.comparison.go"))
+	if err != nil {
+		t.Fatalf("read mutated file: %v", err)
+	}
+
+	if string(got) != "package main\n" {
+		t.Fatalf("mutated file = %q", got)
+	}
+}
+
+func TestLocalReportStore_SaveMutationDiffs_RequiresPath(t *testing.T) {
+	t.Parallel()
+
+	rs := &LocalReportStore{}
+
+	if err := rs.SaveMutationDiffs("", nil, false); err == nil {
+		t.Fatal("expected error for empty path")
+	}
+}
+
+func TestDiffSourceDir_StaysInsideOutput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		file m.File
+		want string
+	}{
+		{file: m.File{FullPath: "/abs/pkg/a.go", ShortPath: "pkg/a.go"}, want: filepath.Join("pkg", "a.go")},
+		{file: m.File{FullPath: "/abs/a.go"}, want: "a.go"},
+		{file: m.File{FullPath: "/other/b.go", ShortPath: "../other/b.go"}, want: "b.go"},
+	}
+
+	for _, tt := range tests {
+		if got := diffSourceDir(&tt.file); got != tt.want {
+			t.Errorf("diffSourceDir(%+v) = %q, want %q", tt.file, got, tt.want)
+		}
+	}
+}
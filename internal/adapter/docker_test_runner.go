@@ -0,0 +1,252 @@
+package adapter
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DockerOptions configures the containers DockerTestRunnerAdapter runs go
+// test in.
+type DockerOptions struct {
+	// Image provides the go command, e.g. golang:1.25.
+	Image string
+	// CPUs and Memory are passed to docker run as --cpus and --memory when
+	// set, e.g. "2" and "1g".
+	CPUs   string
+	Memory string
+	// Network is the network the containers join; empty means none, so
+	// tests cannot reach the network and dependencies come from the
+	// module cache.
+	Network string
+}
+
+// DockerTestRunnerAdapter runs every go test in a fresh container. The
+// project, the cover profile's directory and the host's module and build
+// caches are mounted at their own paths, so paths mean the same inside and
+// outside the container.
+type DockerTestRunnerAdapter struct {
+	timeout time.Duration
+	opts    DockerOptions
+
+	cachesOnce sync.Once
+	caches     []string
+}
+
+// NewDockerTestRunnerAdapter constructs a DockerTestRunnerAdapter with the
+// same default timeout as LocalTestRunnerAdapter.
+func NewDockerTestRunnerAdapter(opts DockerOptions) *DockerTestRunnerAdapter {
+	return &DockerTestRunnerAdapter{
+		timeout: 30 * time.Second,
+		opts:    opts,
+	}
+}
+
+// RunGoTest runs 'go test' on a specific test file in the given directory,
+// inside a container that is killed when the timeout expires.
+func (a *DockerTestRunnerAdapter) RunGoTest(workDir, testFile string, opts GoTestOptions) (string, error) {
+	// docker run binds absolute paths only.
+	workDir = absolutePath(workDir)
+
+	goArgs, err := goTestArgs(workDir, testFile, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return a.run(workDir, workDir, opts, append([]string{"go"}, goArgs...))
+}
+
+// BuildTestBinary compiles the test binary of testFile's package with
+// 'go test -c' inside a container. The binary is written in workDir's
+// project, which the container shares with the host.
+func (a *DockerTestRunnerAdapter) BuildTestBinary(workDir, testFile, binary string, opts GoTestOptions) (string, error) {
+	workDir = absolutePath(workDir)
+
+	goArgs, err := testBinaryBuildArgs(workDir, testFile, absolutePath(binary), opts)
+	if err != nil {
+		return "", err
+	}
+
+	return a.run(workDir, workDir, opts, append([]string{"go"}, goArgs...))
+}
+
+// RunTestBinary runs a test binary built by BuildTestBinary inside a
+// container.
+func (a *DockerTestRunnerAdapter) RunTestBinary(binary, workDir, testFile string, opts GoTestOptions) (string, error) {
+	workDir = absolutePath(workDir)
+
+	dir, args, err := testBinaryRunArgs(workDir, testFile, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return a.run(workDir, dir, opts, append([]string{absolutePath(binary)}, args...))
+}
+
+// run runs command in dir inside a container mounting workDir's project,
+// with the environment and timeout of opts.
+func (a *DockerTestRunnerAdapter) run(workDir, dir string, opts GoTestOptions, command []string) (string, error) {
+	timeout := a.timeout
+	if opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	name, err := containerName()
+	if err != nil {
+		return "", err
+	}
+
+	mounts := []string{mountRoot(workDir)}
+	if opts.CoverProfile != "" {
+		mounts = append(mounts, filepath.Dir(opts.CoverProfile))
+	}
+
+	mounts = append(mounts, a.hostCaches()...)
+
+	cmd := exec.CommandContext(ctx, "docker", a.runArgs(name, dir, mounts, opts.Env, command)...)
+	// Killing the docker client would leave the container running.
+	cmd.Cancel = func() error {
+		_ = exec.Command("docker", "kill", name).Run()
+		return cmd.Process.Kill()
+	}
+	detachFromTerminal(cmd)
+
+	output, err := runTestCommand(ctx, cmd, timeout)
+	if errors.Is(err, exec.ErrNotFound) {
+		return output, fmt.Errorf("%w: docker: %w", ErrGoToolchainNotFound, err)
+	}
+
+	return output, err
+}
+
+// runArgs returns the docker run arguments of a container called name that
+// runs command with the variables of env in workDir, with every path in
+// mounts bound to itself.
+func (a *DockerTestRunnerAdapter) runArgs(name, workDir string, mounts, env, command []string) []string {
+	network := a.opts.Network
+	if network == "" {
+		network = "none"
+	}
+
+	args := []string{"run", "--rm", "--name", name, "--network", network, "-w", workDir, "-e", "HOME=/tmp"}
+
+	// Files the tests create in the mounted project belong to us, not root.
+	if uid, gid := os.Getuid(), os.Getgid(); uid >= 0 {
+		args = append(args, "--user", strconv.Itoa(uid)+":"+strconv.Itoa(gid))
+	}
+
+	if flags := os.Getenv("GOFLAGS"); flags != "" {
+		args = append(args, "-e", "GOFLAGS="+flags)
+	}
+
+	for _, kv := range env {
+		args = append(args, "-e", kv)
+	}
+
+	if a.opts.CPUs != "" {
+		args = append(args, "--cpus", a.opts.CPUs)
+	}
+
+	if a.opts.Memory != "" {
+		args = append(args, "--memory", a.opts.Memory)
+	}
+
+	seen := make(map[string]bool, len(mounts))
+
+	for _, mount := range mounts {
+		if seen[mount] {
+			continue
+		}
+
+		seen[mount] = true
+		args = append(args, "-v", mount+":"+mount)
+	}
+
+	args = append(args, a.cacheEnv()...)
+	args = append(args, a.opts.Image)
+
+	return append(args, command...)
+}
+
+// absolutePath returns path made absolute, or path itself when it cannot be.
+func absolutePath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+
+	return path
+}
+
+// hostCaches returns the host's GOMODCACHE and GOCACHE, which containers
+// share so that each does not download and compile everything again. They
+// are looked up once; without a go command on the host there are none.
+func (a *DockerTestRunnerAdapter) hostCaches() []string {
+	a.cachesOnce.Do(func() {
+		out, err := exec.Command("go", "env", "GOMODCACHE", "GOCACHE").Output()
+		if err != nil {
+			return
+		}
+
+		for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+			if line != "" && line != "off" {
+				a.caches = append(a.caches, line)
+			}
+		}
+	})
+
+	return a.caches
+}
+
+// cacheEnv points the container's go command at the mounted host caches.
+func (a *DockerTestRunnerAdapter) cacheEnv() []string {
+	caches := a.hostCaches()
+	if len(caches) != 2 {
+		return nil
+	}
+
+	return []string{"-e", "GOMODCACHE=" + caches[0], "-e", "GOCACHE=" + caches[1]}
+}
+
+// mountRoot returns the outermost directory above workDir, or workDir
+// itself, that holds a go.mod or go.work: the project the tests may need
+// beyond their own module, such as the other modules of a go.work.
+func mountRoot(workDir string) string {
+	root := workDir
+
+	for dir := workDir; ; dir = filepath.Dir(dir) {
+		if fileExists(filepath.Join(dir, "go.mod")) || fileExists(filepath.Join(dir, "go.work")) {
+			root = dir
+		}
+
+		if filepath.Dir(dir) == dir {
+			return root
+		}
+	}
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// containerName returns a unique name to kill the container by.
+func containerName() (string, error) {
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("name container: %w", err)
+	}
+
+	return "gooze-" + hex.EncodeToString(suffix), nil
+}
@@ -0,0 +1,60 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMountRoot(t *testing.T) {
+	root := t.TempDir()
+	module := filepath.Join(root, "services", "api")
+	pkg := filepath.Join(module, "internal", "calc")
+
+	if err := os.MkdirAll(pkg, 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(module, "go.mod"), []byte("module example.com/api\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := mountRoot(pkg); got != module {
+		t.Fatalf("mountRoot() = %s, want the module %s", got, module)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "go.work"), []byte("go 1.25\n\nuse ./services/api\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := mountRoot(pkg); got != root {
+		t.Fatalf("mountRoot() = %s, want the workspace %s", got, root)
+	}
+}
+
+func TestDockerTestRunnerAdapter_RunArgs(t *testing.T) {
+	t.Setenv("GOFLAGS", "-mod=mod")
+
+	runner := NewDockerTestRunnerAdapter(DockerOptions{Image: "golang:1.25", CPUs: "2", Memory: "1g"})
+	runner.cachesOnce.Do(func() { runner.caches = []string{"/go/mod", "/go/build"} })
+
+	args := runner.runArgs("gooze-1", "/tmp/ws/pkg", []string{"/tmp/ws", "/tmp/ws", "/go/mod", "/go/build"}, []string{"GOOZE_MUTANT=abc"}, []string{"go", "test", "-v", "."})
+	joined := strings.Join(args, " ")
+
+	for _, want := range []string{
+		"run --rm --name gooze-1 --network none -w /tmp/ws/pkg",
+		"-e GOFLAGS=-mod=mod -e GOOZE_MUTANT=abc",
+		"--cpus 2 --memory 1g",
+		"-v /tmp/ws:/tmp/ws -v /go/mod:/go/mod -v /go/build:/go/build",
+		"-e GOMODCACHE=/go/mod -e GOCACHE=/go/build",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected %q in docker run arguments: %s", want, joined)
+		}
+	}
+
+	if !strings.HasSuffix(joined, "golang:1.25 go test -v .") {
+		t.Errorf("expected the image to run go test last: %s", joined)
+	}
+}
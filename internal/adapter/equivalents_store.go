@@ -0,0 +1,126 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const equivalentsFileName = "_equivalents.yaml"
+
+type equivalentsYAML struct {
+	Mutations []string `yaml:"mutations"`
+}
+
+// MarkEquivalent records the mutation as equivalent to the original code in
+// path, so that later runs skip it, and turns its saved result into a
+// skipped one. Like the history, the record is never cleaned.
+func (rs *LocalReportStore) MarkEquivalent(path m.Path, mutationID string) error {
+	dirPath := string(path)
+	if dirPath == "" {
+		return fmt.Errorf("reports directory path is required")
+	}
+
+	if err := rs.validateReportsDir(dirPath); err != nil {
+		return err
+	}
+
+	equivalents, err := rs.readEquivalents(dirPath)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(equivalents.Mutations, mutationID) {
+		equivalents.Mutations = append(equivalents.Mutations, mutationID)
+		slices.Sort(equivalents.Mutations)
+
+		data, err := yaml.Marshal(equivalents)
+		if err != nil {
+			return fmt.Errorf("marshal equivalents YAML: %w", err)
+		}
+
+		equivalentsPath := filepath.Join(dirPath, equivalentsFileName)
+		if err := os.WriteFile(equivalentsPath, data, 0o600); err != nil {
+			return fmt.Errorf("write equivalents file %s: %w", equivalentsPath, err)
+		}
+	}
+
+	return rs.markResultEquivalent(dirPath, mutationID)
+}
+
+// LoadEquivalents returns the IDs of the mutations marked as equivalent in
+// path. A missing record yields none.
+func (rs *LocalReportStore) LoadEquivalents(path m.Path) ([]string, error) {
+	dirPath := string(path)
+	if dirPath == "" {
+		return nil, fmt.Errorf("reports directory path is required")
+	}
+
+	equivalents, err := rs.readEquivalents(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return equivalents.Mutations, nil
+}
+
+func (rs *LocalReportStore) readEquivalents(dirPath string) (equivalentsYAML, error) {
+	equivalentsPath := filepath.Join(dirPath, equivalentsFileName)
+
+	// #nosec G304 -- equivalentsPath is built from the trusted reports directory
+	data, err := os.ReadFile(equivalentsPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return equivalentsYAML{}, nil
+		}
+
+		return equivalentsYAML{}, fmt.Errorf("read equivalents file %s: %w", equivalentsPath, err)
+	}
+
+	var equivalents equivalentsYAML
+	if err := yaml.Unmarshal(data, &equivalents); err != nil {
+		return equivalentsYAML{}, fmt.Errorf("unmarshal equivalents file %s: %w", equivalentsPath, err)
+	}
+
+	return equivalents, nil
+}
+
+// markResultEquivalent rewrites the report holding the mutation's result.
+// Reports are named after their mutation IDs, so the report keeps its name.
+func (rs *LocalReportStore) markResultEquivalent(dirPath string, mutationID string) error {
+	stored, err := rs.reports().read(dirPath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range stored {
+		if entry.err != nil {
+			return fmt.Errorf("unmarshal report %s: %w", entry.name, entry.err)
+		}
+
+		report := entry.report
+
+		index := slices.IndexFunc(report.Result, func(result m.MutationResult) bool {
+			return result.MutationID == mutationID
+		})
+		if index < 0 {
+			continue
+		}
+
+		result := &report.Result[index]
+		result.Status = m.Skipped
+		result.KillReason = ""
+		result.Note = m.EquivalentNote
+		result.Equivalent = true
+
+		return rs.reports().write(dirPath, []storedReport{{name: entry.name, report: report}})
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package adapter
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_MarkEquivalent_SkipsSavedResult(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: "/abs/a.go", Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "m1", Type: m.MutationArithmetic, Status: m.Killed},
+			{MutationID: "m2", Type: m.MutationArithmetic, Status: m.Survived},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	for range 2 {
+		if err := rs.MarkEquivalent(m.Path(dir), "m2"); err != nil {
+			t.Fatalf("MarkEquivalent returned error: %v", err)
+		}
+	}
+
+	equivalents, err := rs.LoadEquivalents(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadEquivalents returned error: %v", err)
+	}
+
+	if len(equivalents) != 1 || equivalents[0] != "m2" {
+		t.Fatalf("expected m2 recorded once, got %v", equivalents)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(reports) != 1 {
+		t.Fatalf("expected the report to be rewritten in place, got %d reports", len(reports))
+	}
+
+	results := map[string]m.MutationResult{}
+	for _, result := range reports[0].Result {
+		results[result.MutationID] = result
+	}
+
+	if got := results["m2"]; got.Status != m.Skipped || !got.Equivalent || got.Note != m.EquivalentNote {
+		t.Fatalf("unexpected equivalent result: %+v", got)
+	}
+
+	if got := results["m1"]; got.Status != m.Killed || got.Equivalent {
+		t.Fatalf("expected m1 untouched, got %+v", got)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	index, err := rs.LoadIndex(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadIndex returned error: %v", err)
+	}
+
+	if index.Equivalent != 1 || index.Survived != 0 {
+		t.Fatalf("expected 1 equivalent and no survivors in the index, got %+v", index.IndexCounts)
+	}
+}
+
+func TestLocalReportStore_LoadEquivalents_MissingRecord(t *testing.T) {
+	t.Parallel()
+
+	rs := &LocalReportStore{}
+
+	equivalents, err := rs.LoadEquivalents(m.Path(t.TempDir()))
+	if err != nil {
+		t.Fatalf("LoadEquivalents returned error: %v", err)
+	}
+
+	if len(equivalents) != 0 {
+		t.Fatalf("expected no equivalents, got %v", equivalents)
+	}
+}
+
+func TestLocalReportStore_MarkEquivalent_RequiresReportsDir(t *testing.T) {
+	t.Parallel()
+
+	rs := &LocalReportStore{}
+
+	if err := rs.MarkEquivalent(m.Path(""), "m1"); err == nil {
+		t.Fatalf("expected error for empty reports path")
+	}
+}
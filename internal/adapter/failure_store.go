@@ -0,0 +1,102 @@
+package adapter
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const failureFileName = "_error.yaml"
+
+type failureYAML struct {
+	Time        time.Time          `yaml:"time"`
+	Phase       string             `yaml:"phase"`
+	Error       string             `yaml:"error"`
+	MutationID  string             `yaml:"mutation_id,omitempty"`
+	Path        string             `yaml:"path,omitempty"`
+	Environment failureEnvironment `yaml:"environment"`
+}
+
+type failureEnvironment struct {
+	GOOS       string `yaml:"goos"`
+	GOARCH     string `yaml:"goarch"`
+	GoozeGo    string `yaml:"gooze_go_version"`
+	GoBinary   string `yaml:"go_binary"`
+	GOROOT     string `yaml:"goroot,omitempty"`
+	GOFLAGS    string `yaml:"goflags,omitempty"`
+	WorkingDir string `yaml:"working_dir"`
+	TempDir    string `yaml:"temp_dir"`
+	NumCPU     int    `yaml:"num_cpu"`
+}
+
+// SaveFailure writes `_error.yaml` describing an aborted run together with
+// the environment it ran in, so CI can tell a broken setup from weak tests.
+func (rs *LocalReportStore) SaveFailure(path m.Path, failure m.RunFailure) error {
+	dirPath := string(path)
+	if dirPath == "" {
+		return fmt.Errorf("reports directory path is required")
+	}
+
+	if err := os.MkdirAll(dirPath, 0o750); err != nil {
+		return fmt.Errorf("create reports directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(failureYAML{
+		Time:        failure.Time.UTC(),
+		Phase:       failure.Phase,
+		Error:       failure.Error,
+		MutationID:  failure.MutationID,
+		Path:        string(failure.Path),
+		Environment: currentFailureEnvironment(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal failure YAML: %w", err)
+	}
+
+	failurePath := filepath.Join(dirPath, failureFileName)
+	if err := os.WriteFile(failurePath, data, 0o600); err != nil {
+		return fmt.Errorf("write failure file %s: %w", failurePath, err)
+	}
+
+	return nil
+}
+
+func (rs *LocalReportStore) clearFailure(dirPath string) error {
+	failurePath := filepath.Join(dirPath, failureFileName)
+	if err := os.Remove(failurePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove failure file %s: %w", failurePath, err)
+	}
+
+	return nil
+}
+
+func currentFailureEnvironment() failureEnvironment {
+	goBinary, err := exec.LookPath("go")
+	if err != nil {
+		goBinary = ""
+	}
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		workingDir = ""
+	}
+
+	return failureEnvironment{
+		GOOS:       runtime.GOOS,
+		GOARCH:     runtime.GOARCH,
+		GoozeGo:    runtime.Version(),
+		GoBinary:   goBinary,
+		GOROOT:     os.Getenv("GOROOT"),
+		GOFLAGS:    os.Getenv("GOFLAGS"),
+		WorkingDir: workingDir,
+		TempDir:    os.TempDir(),
+		NumCPU:     runtime.NumCPU(),
+	}
+}
@@ -0,0 +1,103 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_SaveFailure_WritesErrorFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	failure := m.RunFailure{
+		Time:       time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+		Phase:      "copy_project",
+		Error:      "failed to copy project: disk full",
+		MutationID: "m1",
+		Path:       "/abs/a.go",
+	}
+
+	if err := rs.SaveFailure(m.Path(dir), failure); err != nil {
+		t.Fatalf("SaveFailure returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, failureFileName))
+	if err != nil {
+		t.Fatalf("read %s: %v", failureFileName, err)
+	}
+
+	var got failureYAML
+	if err := yaml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal %s: %v", failureFileName, err)
+	}
+
+	if got.Phase != failure.Phase || got.Error != failure.Error {
+		t.Fatalf("unexpected phase/error: %q / %q", got.Phase, got.Error)
+	}
+
+	if got.MutationID != "m1" || got.Path != "/abs/a.go" {
+		t.Fatalf("unexpected mutation: %q at %q", got.MutationID, got.Path)
+	}
+
+	if !got.Time.Equal(failure.Time) {
+		t.Fatalf("expected time %v, got %v", failure.Time, got.Time)
+	}
+
+	if got.Environment.GOOS != runtime.GOOS || got.Environment.GoozeGo != runtime.Version() {
+		t.Fatalf("unexpected environment: %+v", got.Environment)
+	}
+}
+
+func TestLocalReportStore_SaveReports_RemovesStaleFailure(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	if err := rs.SaveFailure(m.Path(dir), m.RunFailure{Phase: "toolchain", Error: "go not found"}); err != nil {
+		t.Fatalf("SaveFailure returned error: %v", err)
+	}
+
+	reports := []m.Report{
+		{
+			Source: m.Source{Origin: &m.File{FullPath: "/abs/a.go", Hash: "sourceA"}},
+			Result: m.Result{{MutationID: "m1", Type: m.MutationArithmetic, Status: m.Killed}},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), reports); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, failureFileName)); !os.IsNotExist(err) {
+		t.Fatalf("expected %s to be removed, stat err = %v", failureFileName, err)
+	}
+
+	loaded, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(loaded) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(loaded))
+	}
+}
+
+func TestLocalReportStore_SaveFailure_EmptyPath_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	rs := &LocalReportStore{}
+
+	if err := rs.SaveFailure("", m.RunFailure{}); err == nil {
+		t.Fatalf("expected error for empty path")
+	}
+}
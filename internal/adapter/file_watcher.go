@@ -0,0 +1,137 @@
+package adapter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// FileWatcher reports Go files that change on disk in the directories it was
+// given.
+type FileWatcher interface {
+	// Add starts watching the files directly in dir.
+	Add(dir m.Path) error
+	// Changes yields the path of each Go file written, created, renamed or
+	// removed. It is closed by Close.
+	Changes() <-chan m.Path
+	// Errors yields failures of the underlying watch. It is closed by Close.
+	Errors() <-chan error
+	Close() error
+}
+
+// FSNotifyWatcher implements FileWatcher with fsnotify. Directories created
+// inside watched ones are watched as well, so new packages are picked up.
+type FSNotifyWatcher struct {
+	watcher *fsnotify.Watcher
+	changes chan m.Path
+	errors  chan error
+	done    chan struct{}
+	once    sync.Once
+}
+
+// NewFSNotifyWatcher constructs an FSNotifyWatcher watching nothing yet.
+func NewFSNotifyWatcher() (*FSNotifyWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("create file watcher: %w", err)
+	}
+
+	w := &FSNotifyWatcher{
+		watcher: watcher,
+		changes: make(chan m.Path),
+		errors:  make(chan error),
+		done:    make(chan struct{}),
+	}
+
+	go w.run()
+
+	return w, nil
+}
+
+// Add starts watching dir.
+func (w *FSNotifyWatcher) Add(dir m.Path) error {
+	if err := w.watcher.Add(string(dir)); err != nil {
+		return fmt.Errorf("watch %s: %w", dir, err)
+	}
+
+	return nil
+}
+
+// Changes yields the changed Go files.
+func (w *FSNotifyWatcher) Changes() <-chan m.Path {
+	return w.changes
+}
+
+// Errors yields watch failures.
+func (w *FSNotifyWatcher) Errors() <-chan error {
+	return w.errors
+}
+
+// Close stops watching and closes Changes and Errors.
+func (w *FSNotifyWatcher) Close() error {
+	var err error
+
+	w.once.Do(func() {
+		close(w.done)
+		err = w.watcher.Close()
+	})
+
+	return err
+}
+
+func (w *FSNotifyWatcher) run() {
+	defer close(w.changes)
+	defer close(w.errors)
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			w.handle(event)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			select {
+			case w.errors <- err:
+			case <-w.done:
+				return
+			}
+		}
+	}
+}
+
+func (w *FSNotifyWatcher) handle(event fsnotify.Event) {
+	if event.Has(fsnotify.Create) {
+		if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+			// A directory that vanished again is simply not watched.
+			_ = w.watcher.Add(event.Name)
+			return
+		}
+	}
+
+	if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) &&
+		!event.Has(fsnotify.Rename) && !event.Has(fsnotify.Remove) {
+		return
+	}
+
+	if !strings.HasSuffix(event.Name, ".go") {
+		return
+	}
+
+	select {
+	case w.changes <- m.Path(filepath.Clean(event.Name)):
+	case <-w.done:
+	}
+}
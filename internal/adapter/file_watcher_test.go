@@ -0,0 +1,131 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// nextChange waits for the watcher to report path, skipping other changes.
+func nextChange(t *testing.T, watcher FileWatcher, path string) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+
+	for {
+		select {
+		case changed, ok := <-watcher.Changes():
+			if !ok {
+				t.Fatalf("changes closed before %s was reported", path)
+			}
+
+			if changed == m.Path(path) {
+				return
+			}
+		case err := <-watcher.Errors():
+			t.Fatalf("watch error: %v", err)
+		case <-timeout:
+			t.Fatalf("no change reported for %s", path)
+		}
+	}
+}
+
+func TestFSNotifyWatcher_ReportsGoFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	watcher, err := NewFSNotifyWatcher()
+	if err != nil {
+		t.Fatalf("NewFSNotifyWatcher: %v", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(m.Path(dir)); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("write notes: %v", err)
+	}
+
+	source := filepath.Join(dir, "main.go")
+	if err := os.WriteFile(source, []byte("package main\n"), 0o644); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+
+	nextChange(t, watcher, source)
+}
+
+func TestFSNotifyWatcher_WatchesNewDirectories(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	watcher, err := NewFSNotifyWatcher()
+	if err != nil {
+		t.Fatalf("NewFSNotifyWatcher: %v", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(m.Path(dir)); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	sub := filepath.Join(dir, "sub")
+	if err := os.Mkdir(sub, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	source := filepath.Join(sub, "sub.go")
+
+	// The new directory is added asynchronously; write until the file is
+	// seen so the test does not depend on that timing.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if err := os.WriteFile(source, []byte("package sub\n"), 0o644); err != nil {
+			t.Fatalf("write source: %v", err)
+		}
+
+		select {
+		case changed := <-watcher.Changes():
+			if changed == m.Path(source) {
+				return
+			}
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("no change reported for %s", source)
+		}
+	}
+}
+
+func TestFSNotifyWatcher_CloseClosesChannels(t *testing.T) {
+	t.Parallel()
+
+	watcher, err := NewFSNotifyWatcher()
+	if err != nil {
+		t.Fatalf("NewFSNotifyWatcher: %v", err)
+	}
+
+	if err := watcher.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if err := watcher.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+
+	select {
+	case _, ok := <-watcher.Changes():
+		if ok {
+			t.Fatal("expected changes to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("changes not closed")
+	}
+}
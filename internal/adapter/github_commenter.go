@@ -0,0 +1,114 @@
+package adapter
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultGitHubAPIURL is the REST API root of github.com.
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// githubCommentsPerPage is the largest page the comments API serves.
+const githubCommentsPerPage = 100
+
+// GitHubCommenter is a Notifier commenting on a GitHub pull request.
+type GitHubCommenter struct {
+	token  string
+	apiURL string
+	pr     m.PullRequest
+	client *http.Client
+}
+
+// NewGitHubCommenter constructs a GitHubCommenter for pr authenticating
+// with token against apiURL, or DefaultGitHubAPIURL when empty.
+func NewGitHubCommenter(token, apiURL string, pr m.PullRequest) *GitHubCommenter {
+	if apiURL == "" {
+		apiURL = DefaultGitHubAPIURL
+	}
+
+	return &GitHubCommenter{
+		token:  token,
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+		pr:     pr,
+		client: &http.Client{Timeout: notifierTimeout},
+	}
+}
+
+type githubComment struct {
+	ID   int64  `json:"id,omitempty"`
+	Body string `json:"body"`
+}
+
+// Notify renders summary as Markdown and creates or updates the pull
+// request's gooze comment.
+func (g *GitHubCommenter) Notify(summary m.RunSummary) error {
+	comment := githubComment{Body: renderSummaryMarkdown(summary)}
+
+	existing, err := g.findComment()
+	if err != nil {
+		return fmt.Errorf("github: %w", err)
+	}
+
+	req := jsonRequest{
+		method:  http.MethodPost,
+		url:     fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments", g.apiURL, g.pr.Owner, g.pr.Repo, g.pr.Number),
+		headers: g.headers(),
+		payload: comment,
+	}
+
+	if existing != 0 {
+		req.method = http.MethodPatch
+		req.url = fmt.Sprintf("%s/repos/%s/%s/issues/comments/%d", g.apiURL, g.pr.Owner, g.pr.Repo, existing)
+	}
+
+	if err := sendJSON(g.client, req); err != nil {
+		return fmt.Errorf("github: %w", err)
+	}
+
+	return nil
+}
+
+// findComment returns the ID of the pull request's gooze comment, or zero
+// when there is none yet.
+func (g *GitHubCommenter) findComment() (int64, error) {
+	for page := 1; ; page++ {
+		var comments []githubComment
+
+		err := sendJSON(g.client, jsonRequest{
+			method: http.MethodGet,
+			url: fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments?per_page=%d&page=%d",
+				g.apiURL, g.pr.Owner, g.pr.Repo, g.pr.Number, githubCommentsPerPage, page),
+			headers: g.headers(),
+			out:     &comments,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for _, comment := range comments {
+			if strings.HasPrefix(comment.Body, summaryCommentMarker) {
+				return comment.ID, nil
+			}
+		}
+
+		if len(comments) < githubCommentsPerPage {
+			return 0, nil
+		}
+	}
+}
+
+func (g *GitHubCommenter) headers() map[string]string {
+	headers := map[string]string{
+		"Accept":               "application/vnd.github+json",
+		"X-GitHub-Api-Version": "2022-11-28",
+	}
+
+	if g.token != "" {
+		headers["Authorization"] = "Bearer " + g.token
+	}
+
+	return headers
+}
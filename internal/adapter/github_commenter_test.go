@@ -0,0 +1,117 @@
+package adapter
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// fakeGitHub serves the issue comments API for owner/repo#7 from memory.
+type fakeGitHub struct {
+	mu       sync.Mutex
+	comments []githubComment
+	requests []string
+	auth     string
+}
+
+func (f *fakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+	f.auth = r.Header.Get("Authorization")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/issues/7/comments":
+		_ = json.NewEncoder(w).Encode(f.comments)
+	case r.Method == http.MethodPost && r.URL.Path == "/repos/owner/repo/issues/7/comments":
+		var comment githubComment
+		_ = json.NewDecoder(r.Body).Decode(&comment)
+		comment.ID = int64(len(f.comments) + 100)
+		f.comments = append(f.comments, comment)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(comment)
+	case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/repos/owner/repo/issues/comments/"):
+		var comment githubComment
+		_ = json.NewDecoder(r.Body).Decode(&comment)
+
+		for i := range f.comments {
+			if r.URL.Path == "/repos/owner/repo/issues/comments/"+strconv.FormatInt(f.comments[i].ID, 10) {
+				f.comments[i].Body = comment.Body
+			}
+		}
+
+		_ = json.NewEncoder(w).Encode(comment)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+	}
+}
+
+func TestGitHubCommenter_Notify_CreatesThenUpdates(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeGitHub{comments: []githubComment{{ID: 1, Body: "LGTM"}}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	commenter := NewGitHubCommenter("secret", server.URL, m.PullRequest{Owner: "owner", Repo: "repo", Number: 7})
+
+	if err := commenter.Notify(m.RunSummary{Score: 0.5, Killed: 1, Survived: 1}); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	if err := commenter.Notify(m.RunSummary{Score: 1, Killed: 2}); err != nil {
+		t.Fatalf("second Notify() error = %v", err)
+	}
+
+	if len(fake.comments) != 2 {
+		t.Fatalf("expected the gooze comment to be updated in place, got %d comments", len(fake.comments))
+	}
+
+	if fake.comments[0].Body != "LGTM" {
+		t.Fatalf("expected other comments to be left alone, got %q", fake.comments[0].Body)
+	}
+
+	if !strings.Contains(fake.comments[1].Body, "Mutation score: 100.00%") {
+		t.Fatalf("expected the updated summary, got:\n%s", fake.comments[1].Body)
+	}
+
+	if fake.auth != "Bearer secret" {
+		t.Fatalf("expected bearer token authorization, got %q", fake.auth)
+	}
+
+	want := []string{
+		"GET /repos/owner/repo/issues/7/comments",
+		"POST /repos/owner/repo/issues/7/comments",
+		"GET /repos/owner/repo/issues/7/comments",
+		"PATCH /repos/owner/repo/issues/comments/101",
+	}
+	if strings.Join(fake.requests, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(fake.requests, "\n"))
+	}
+}
+
+func TestGitHubCommenter_Notify_ReportsAPIErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(&fakeGitHub{})
+	defer server.Close()
+
+	commenter := NewGitHubCommenter("", server.URL, m.PullRequest{Owner: "owner", Repo: "other", Number: 7})
+
+	err := commenter.Notify(m.RunSummary{})
+	if err == nil {
+		t.Fatal("expected an error for a missing repository")
+	}
+
+	if !strings.Contains(err.Error(), "404") || !strings.Contains(err.Error(), "Not Found") {
+		t.Fatalf("expected the status and API message in the error, got %v", err)
+	}
+}
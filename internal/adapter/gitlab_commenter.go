@@ -0,0 +1,119 @@
+package adapter
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultGitLabAPIURL is the REST API root of gitlab.com.
+const DefaultGitLabAPIURL = "https://gitlab.com/api/v4"
+
+// gitlabNotesPerPage is the largest page the notes API serves.
+const gitlabNotesPerPage = 100
+
+// GitLabCommenter is a Notifier commenting on a GitLab merge request.
+type GitLabCommenter struct {
+	token  string
+	apiURL string
+	mr     m.MergeRequest
+	client *http.Client
+}
+
+// NewGitLabCommenter constructs a GitLabCommenter for mr authenticating
+// with token against apiURL, or DefaultGitLabAPIURL when empty.
+func NewGitLabCommenter(token, apiURL string, mr m.MergeRequest) *GitLabCommenter {
+	if apiURL == "" {
+		apiURL = DefaultGitLabAPIURL
+	}
+
+	return &GitLabCommenter{
+		token:  token,
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+		mr:     mr,
+		client: &http.Client{Timeout: notifierTimeout},
+	}
+}
+
+type gitlabNote struct {
+	ID     int64  `json:"id,omitempty"`
+	Body   string `json:"body"`
+	System bool   `json:"system,omitempty"`
+}
+
+// Notify renders summary as Markdown and creates or updates the merge
+// request's gooze note.
+func (g *GitLabCommenter) Notify(summary m.RunSummary) error {
+	note := gitlabNote{Body: renderSummaryMarkdown(summary)}
+
+	existing, err := g.findNote()
+	if err != nil {
+		return fmt.Errorf("gitlab: %w", err)
+	}
+
+	req := jsonRequest{
+		method:  http.MethodPost,
+		url:     g.notesURL(),
+		headers: g.headers(),
+		payload: note,
+	}
+
+	if existing != 0 {
+		req.method = http.MethodPut
+		req.url = fmt.Sprintf("%s/%d", g.notesURL(), existing)
+	}
+
+	if err := sendJSON(g.client, req); err != nil {
+		return fmt.Errorf("gitlab: %w", err)
+	}
+
+	return nil
+}
+
+// findNote returns the ID of the merge request's gooze note, or zero when
+// there is none yet.
+func (g *GitLabCommenter) findNote() (int64, error) {
+	for page := 1; ; page++ {
+		var notes []gitlabNote
+
+		err := sendJSON(g.client, jsonRequest{
+			method:  http.MethodGet,
+			url:     fmt.Sprintf("%s?per_page=%d&page=%d", g.notesURL(), gitlabNotesPerPage, page),
+			headers: g.headers(),
+			out:     &notes,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for _, note := range notes {
+			if !note.System && strings.HasPrefix(note.Body, summaryCommentMarker) {
+				return note.ID, nil
+			}
+		}
+
+		if len(notes) < gitlabNotesPerPage {
+			return 0, nil
+		}
+	}
+}
+
+// notesURL addresses the project by its URL-encoded full path, which the
+// API accepts in place of the numeric ID.
+func (g *GitLabCommenter) notesURL() string {
+	return fmt.Sprintf("%s/projects/%s/merge_requests/%d/notes",
+		g.apiURL, url.PathEscape(g.mr.Project), g.mr.IID)
+}
+
+func (g *GitLabCommenter) headers() map[string]string {
+	headers := map[string]string{}
+
+	if g.token != "" {
+		headers["PRIVATE-TOKEN"] = g.token
+	}
+
+	return headers
+}
@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestGitLabCommenter_Notify_CreatesThenUpdates(t *testing.T) {
+	t.Parallel()
+
+	const notesPath = "/api/v4/projects/group%2Fsub%2Fproject/merge_requests/7/notes"
+
+	notes := []gitlabNote{{ID: 1, Body: summaryCommentMarker + " from a system note", System: true}}
+	var requests []string
+	var token string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		token = r.Header.Get("PRIVATE-TOKEN")
+
+		var note gitlabNote
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == notesPath:
+			_ = json.NewEncoder(w).Encode(notes)
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == notesPath:
+			_ = json.NewDecoder(r.Body).Decode(&note)
+			note.ID = 42
+			notes = append(notes, note)
+			_ = json.NewEncoder(w).Encode(note)
+		case r.Method == http.MethodPut && r.URL.EscapedPath() == notesPath+"/42":
+			_ = json.NewDecoder(r.Body).Decode(&note)
+			notes[1].Body = note.Body
+			_ = json.NewEncoder(w).Encode(notes[1])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"404 Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	commenter := NewGitLabCommenter("secret", server.URL+"/api/v4/", m.MergeRequest{Project: "group/sub/project", IID: 7})
+
+	if err := commenter.Notify(m.RunSummary{Score: 0.5, Killed: 1, Survived: 1}); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	if err := commenter.Notify(m.RunSummary{Score: 1, Killed: 2}); err != nil {
+		t.Fatalf("second Notify() error = %v", err)
+	}
+
+	if len(notes) != 2 || !strings.Contains(notes[1].Body, "Mutation score: 100.00%") {
+		t.Fatalf("expected the gooze note to be updated in place, got %+v", notes)
+	}
+
+	if token != "secret" {
+		t.Fatalf("expected the token in PRIVATE-TOKEN, got %q", token)
+	}
+
+	want := []string{
+		"GET " + notesPath,
+		"POST " + notesPath,
+		"GET " + notesPath,
+		"PUT " + notesPath + "/42",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(requests, "\n"))
+	}
+}
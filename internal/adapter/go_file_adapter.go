@@ -1,9 +1,13 @@
 package adapter
 
 import (
+	"errors"
 	"go/ast"
+	"go/importer"
 	"go/parser"
 	"go/token"
+	"go/types"
+	"sync"
 )
 
 // GoFileAdapter encapsulates Go-specific parsing and scope-detection logic so
@@ -12,10 +16,21 @@ import (
 type GoFileAdapter interface {
 	// Parse builds an AST using the provided file set and optional source bytes.
 	Parse(fileSet *token.FileSet, filename string, src []byte) (*ast.File, error)
+
+	// Check type-checks files, parsed with fileSet, as one package and
+	// returns the types of their expressions, the objects their identifiers
+	// denote and their selections, with the type errors found joined. Type
+	// errors do not stop it: the info covers whatever could be resolved.
+	Check(fileSet *token.FileSet, files []*ast.File) (*types.Info, error)
 }
 
 // LocalGoFileAdapter provides a concrete GoFileAdapter backed by go/parser.
-type LocalGoFileAdapter struct{}
+type LocalGoFileAdapter struct {
+	// mu guards importer, which imports packages from source and keeps
+	// them for later checks.
+	mu       sync.Mutex
+	importer types.Importer
+}
 
 // NewLocalGoFileAdapter constructs a LocalGoFileAdapter.
 func NewLocalGoFileAdapter() *LocalGoFileAdapter {
@@ -26,3 +41,39 @@ func NewLocalGoFileAdapter() *LocalGoFileAdapter {
 func (a *LocalGoFileAdapter) Parse(fileSet *token.FileSet, filename string, src []byte) (*ast.File, error) {
 	return parser.ParseFile(fileSet, filename, src, parser.ParseComments)
 }
+
+// Check type-checks files with go/types, importing their dependencies from
+// source. Imported packages are kept, so later checks importing them again
+// are quick.
+func (a *LocalGoFileAdapter) Check(fileSet *token.FileSet, files []*ast.File) (*types.Info, error) {
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+	}
+
+	if len(files) == 0 {
+		return info, nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.importer == nil {
+		a.importer = importer.ForCompiler(token.NewFileSet(), "source", nil)
+	}
+
+	var errs []error
+
+	config := types.Config{
+		Importer: a.importer,
+		Error:    func(err error) { errs = append(errs, err) },
+	}
+
+	// Every error is also reported to config.Error, which keeps the check
+	// going.
+	_, _ = config.Check(files[0].Name.Name, fileSet, files, info)
+
+	return info, errors.Join(errs...)
+}
@@ -1,10 +1,12 @@
 package adapter
 
 import (
+	"go/ast"
+	"go/token"
+	"go/types"
 	"path/filepath"
+	"strings"
 	"testing"
-
-	"go/token"
 )
 
 func TestLocalGoFileAdapter_Parse(t *testing.T) {
@@ -31,3 +33,42 @@ func TestLocalGoFileAdapter_Parse_InvalidSource(t *testing.T) {
 		t.Fatalf("Parse() expected error for invalid source")
 	}
 }
+
+func TestLocalGoFileAdapter_Check(t *testing.T) {
+	adapter := NewLocalGoFileAdapter()
+	fset := token.NewFileSet()
+
+	src := []byte("package p\n\nimport \"sync\"\n\nvar mu sync.Mutex\n\nfunc f() {\n\tmu.Lock()\n\tmissing()\n}\n")
+	file, err := adapter.Parse(fset, "p.go", src)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	info, err := adapter.Check(fset, []*ast.File{file})
+	if err == nil || !strings.Contains(err.Error(), "undefined: missing") {
+		t.Fatalf("Check() error = %v, want undefined: missing", err)
+	}
+
+	var lock *types.Selection
+	for sel, selection := range info.Selections {
+		if sel.Sel.Name == "Lock" {
+			lock = selection
+		}
+	}
+
+	// The undefined call does not stop the check.
+	if lock == nil {
+		t.Fatalf("Check() has no selection for mu.Lock")
+	}
+
+	if got := lock.Recv().String(); got != "sync.Mutex" {
+		t.Fatalf("Check() receiver = %s, want sync.Mutex", got)
+	}
+}
+
+func TestLocalGoFileAdapter_Check_NoFiles(t *testing.T) {
+	info, err := NewLocalGoFileAdapter().Check(token.NewFileSet(), nil)
+	if err != nil || info == nil || len(info.Types) != 0 {
+		t.Fatalf("Check() = %v, %v, want empty info", info, err)
+	}
+}
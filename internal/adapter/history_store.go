@@ -0,0 +1,200 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const historyFileName = "_history.yaml"
+
+type historyYAML struct {
+	Runs []runRecordYAML `yaml:"runs"`
+}
+
+type runRecordYAML struct {
+	Time          time.Time     `yaml:"time"`
+	Commit        string        `yaml:"commit,omitempty"`
+	Total         int           `yaml:"total_mutations"`
+	Killed        int           `yaml:"killed_mutations"`
+	Survived      int           `yaml:"survived_mutations"`
+	Skipped       int           `yaml:"ignored_mutations"`
+	Errors        int           `yaml:"failed_mutations"`
+	NotRun        int           `yaml:"not_run_mutations,omitempty"`
+	Flaky         int           `yaml:"flaky_mutations,omitempty"`
+	CompileErrors int           `yaml:"compile_error_mutations,omitempty"`
+	Score         float64       `yaml:"score"`
+	Duration      time.Duration `yaml:"duration"`
+	Survivors     []string      `yaml:"survivors,omitempty"`
+	TimedOut      []string      `yaml:"timed_out,omitempty"`
+}
+
+// RecordRun appends a summary of the reports currently in path to the run
+// history, along with the git commit checked out in the working directory.
+// Unlike report files and the index, the history is never cleaned, so trends
+// survive cache invalidation.
+func (rs *LocalReportStore) RecordRun(path m.Path, at time.Time) error {
+	dirPath := string(path)
+	if dirPath == "" {
+		return fmt.Errorf("reports directory path is required")
+	}
+
+	exists, err := rs.reportsDirExists(dirPath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	reports, err := rs.loadReportsFromDir(dirPath)
+	if err != nil {
+		return err
+	}
+
+	history, err := rs.readHistory(dirPath)
+	if err != nil {
+		return err
+	}
+
+	record := buildRunRecord(reports, at)
+	record.Commit = headCommit()
+
+	history.Runs = append(history.Runs, record)
+
+	data, err := yaml.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("marshal history YAML: %w", err)
+	}
+
+	historyPath := filepath.Join(dirPath, historyFileName)
+	if err := os.WriteFile(historyPath, data, 0o600); err != nil {
+		return fmt.Errorf("write history file %s: %w", historyPath, err)
+	}
+
+	return nil
+}
+
+// LoadHistory returns the recorded runs in path, oldest first. A missing
+// history yields no runs.
+func (rs *LocalReportStore) LoadHistory(path m.Path) ([]m.RunRecord, error) {
+	dirPath := string(path)
+	if dirPath == "" {
+		return nil, fmt.Errorf("reports directory path is required")
+	}
+
+	history, err := rs.readHistory(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]m.RunRecord, 0, len(history.Runs))
+	for _, run := range history.Runs {
+		records = append(records, m.RunRecord{
+			Time:          run.Time,
+			Commit:        run.Commit,
+			Total:         run.Total,
+			Killed:        run.Killed,
+			Survived:      run.Survived,
+			Skipped:       run.Skipped,
+			Errors:        run.Errors,
+			NotRun:        run.NotRun,
+			Flaky:         run.Flaky,
+			CompileErrors: run.CompileErrors,
+			Score:         run.Score,
+			Duration:      run.Duration,
+			Survivors:     run.Survivors,
+			TimedOut:      run.TimedOut,
+		})
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+
+	return records, nil
+}
+
+func (rs *LocalReportStore) readHistory(dirPath string) (historyYAML, error) {
+	historyPath := filepath.Join(dirPath, historyFileName)
+
+	// #nosec G304 -- historyPath is built from the trusted reports directory
+	data, err := os.ReadFile(historyPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return historyYAML{}, nil
+		}
+
+		return historyYAML{}, fmt.Errorf("read history file %s: %w", historyPath, err)
+	}
+
+	var history historyYAML
+	if err := yaml.Unmarshal(data, &history); err != nil {
+		return historyYAML{}, fmt.Errorf("unmarshal history file %s: %w", historyPath, err)
+	}
+
+	return history, nil
+}
+
+func buildRunRecord(reports []m.Report, at time.Time) runRecordYAML {
+	record := runRecordYAML{Time: at.UTC()}
+
+	for _, report := range reports {
+		for _, result := range report.Result {
+			record.Total++
+			record.Duration += result.Duration
+
+			switch result.Status {
+			case m.Killed:
+				record.Killed++
+
+				if result.KillReason == m.KillTimeout {
+					record.TimedOut = append(record.TimedOut, result.MutationID)
+				}
+			case m.Survived:
+				record.Survived++
+				record.Survivors = append(record.Survivors, result.MutationID)
+			case m.Skipped:
+				record.Skipped++
+			case m.Error:
+				record.Errors++
+			case m.NotRun:
+				record.NotRun++
+			case m.Flaky:
+				record.Flaky++
+			case m.CompileError:
+				record.CompileErrors++
+			}
+		}
+	}
+
+	sort.Strings(record.Survivors)
+	sort.Strings(record.TimedOut)
+
+	if tested := record.Killed + record.Survived; tested > 0 {
+		record.Score = float64(record.Killed) / float64(tested)
+	}
+
+	return record
+}
+
+// headCommit returns the SHA of the git commit checked out in the working
+// directory, or "" outside a git work tree or without git installed.
+func headCommit() string {
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}
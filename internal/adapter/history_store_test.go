@@ -0,0 +1,171 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_RecordRun_AppendsToHistory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	reports := []m.Report{
+		{
+			Source: m.Source{Origin: &m.File{FullPath: "/abs/a.go", Hash: "sourceA"}},
+			Result: m.Result{
+				{MutationID: "m1", Type: m.MutationArithmetic, Status: m.Killed, Duration: time.Second},
+				{MutationID: "m3", Type: m.MutationArithmetic, Status: m.Survived, Duration: 2 * time.Second},
+			},
+		},
+		{
+			Source: m.Source{Origin: &m.File{FullPath: "/abs/b.go", Hash: "sourceB"}},
+			Result: m.Result{
+				{MutationID: "m2", Type: m.MutationBoolean, Status: m.Survived},
+				{MutationID: "m4", Type: m.MutationBoolean, Status: m.Skipped},
+			},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), reports); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	first := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	second := first.Add(24 * time.Hour)
+
+	if err := rs.RecordRun(m.Path(dir), first); err != nil {
+		t.Fatalf("RecordRun returned error: %v", err)
+	}
+
+	if err := rs.RecordRun(m.Path(dir), second); err != nil {
+		t.Fatalf("RecordRun returned error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, historyFileName)); err != nil {
+		t.Fatalf("expected %s to exist: %v", historyFileName, err)
+	}
+
+	runs, err := rs.LoadHistory(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadHistory returned error: %v", err)
+	}
+
+	if len(runs) != 2 {
+		t.Fatalf("expected 2 runs, got %d", len(runs))
+	}
+
+	run := runs[0]
+	if !run.Time.Equal(first) || !runs[1].Time.Equal(second) {
+		t.Fatalf("unexpected run times: %v, %v", run.Time, runs[1].Time)
+	}
+
+	if run.Total != 4 || run.Killed != 1 || run.Survived != 2 || run.Skipped != 1 || run.Errors != 0 {
+		t.Fatalf("unexpected run counts: %+v", run)
+	}
+
+	if run.Score < 0.333 || run.Score > 0.334 {
+		t.Fatalf("expected score 1/3, got %v", run.Score)
+	}
+
+	if run.Duration != 3*time.Second {
+		t.Fatalf("expected duration 3s, got %v", run.Duration)
+	}
+
+	if len(run.Survivors) != 2 || run.Survivors[0] != "m2" || run.Survivors[1] != "m3" {
+		t.Fatalf("expected sorted survivors [m2 m3], got %v", run.Survivors)
+	}
+
+	if run.Commit != headCommit() {
+		t.Fatalf("expected commit %q, got %q", headCommit(), run.Commit)
+	}
+
+	// The history file must not be mistaken for a report.
+	loaded, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(loaded) != 2 {
+		t.Fatalf("expected 2 reports, got %d", len(loaded))
+	}
+}
+
+func TestLocalReportStore_RecordRun_RecordsTimedOutMutations(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	reports := []m.Report{{
+		Source: m.Source{Origin: &m.File{FullPath: "/abs/a.go", Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "t2", Type: m.MutationLoop, Status: m.Killed, KillReason: m.KillTimeout},
+			{MutationID: "k1", Type: m.MutationLoop, Status: m.Killed, KillReason: m.KillAssertion},
+			{MutationID: "t1", Type: m.MutationLoop, Status: m.Killed, KillReason: m.KillTimeout},
+			{MutationID: "q1", Type: m.MutationLoop, Status: m.Skipped, Note: "quarantined"},
+		},
+	}}
+
+	if err := rs.SaveReports(m.Path(dir), reports); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if err := rs.RecordRun(m.Path(dir), time.Now()); err != nil {
+		t.Fatalf("RecordRun returned error: %v", err)
+	}
+
+	runs, err := rs.LoadHistory(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadHistory returned error: %v", err)
+	}
+
+	if len(runs) != 1 || len(runs[0].TimedOut) != 2 || runs[0].TimedOut[0] != "t1" || runs[0].TimedOut[1] != "t2" {
+		t.Fatalf("expected sorted timed out mutations [t1 t2], got %+v", runs)
+	}
+}
+
+func TestLocalReportStore_RecordRun_NoReportsDir_NoError(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "missing")
+	rs := &LocalReportStore{}
+
+	if err := rs.RecordRun(m.Path(dir), time.Now()); err != nil {
+		t.Fatalf("RecordRun returned error: %v", err)
+	}
+
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("expected reports directory to stay absent, stat err = %v", err)
+	}
+}
+
+func TestLocalReportStore_LoadHistory_MissingFileReturnsNoRuns(t *testing.T) {
+	t.Parallel()
+
+	rs := &LocalReportStore{}
+
+	runs, err := rs.LoadHistory(m.Path(t.TempDir()))
+	if err != nil {
+		t.Fatalf("LoadHistory returned error: %v", err)
+	}
+
+	if len(runs) != 0 {
+		t.Fatalf("expected no runs, got %d", len(runs))
+	}
+}
+
+func TestLocalReportStore_LoadHistory_EmptyPath_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	rs := &LocalReportStore{}
+
+	if _, err := rs.LoadHistory(""); err == nil {
+		t.Fatalf("expected error for empty path")
+	}
+}
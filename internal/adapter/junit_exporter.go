@@ -0,0 +1,175 @@
+package adapter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const (
+	survivedFailureMessage = "mutant survived"
+	notRunSkipMessage      = "not run: time budget exhausted"
+	flakySkipMessage       = "flaky: tests also fail without the mutation"
+	compileErrorMessage    = "mutant does not compile"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// ExportJUnit writes the reports as a JUnit XML document to path. Each source
+// file becomes a test suite and each mutation a test case; survived mutants
+// are reported as failures so CI systems surface them natively.
+func (rs *LocalReportStore) ExportJUnit(path m.Path, reports []m.Report) error {
+	filePath := string(path)
+	if filePath == "" {
+		return fmt.Errorf("junit output path is required")
+	}
+
+	data, err := xml.MarshalIndent(buildJUnitTestSuites(reports), "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal junit report: %w", err)
+	}
+
+	if dir := filepath.Dir(filePath); dir != "." {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return fmt.Errorf("create junit output directory: %w", err)
+		}
+	}
+
+	data = append([]byte(xml.Header), data...)
+	if err := os.WriteFile(filePath, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write junit report %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+func buildJUnitTestSuites(reports []m.Report) junitTestSuites {
+	suitesByName := make(map[string]*junitTestSuite)
+	secondsByName := make(map[string]float64)
+
+	for _, report := range reports {
+		name := string(report.Source.Origin.DisplayPath())
+
+		suite, ok := suitesByName[name]
+		if !ok {
+			suite = &junitTestSuite{Name: name}
+			suitesByName[name] = suite
+		}
+
+		for _, result := range report.Result {
+			suite.TestCases = append(suite.TestCases, junitTestCaseFor(name, result, report.Diff))
+			suite.Tests++
+			secondsByName[name] += result.Duration.Seconds()
+
+			switch result.Status {
+			case m.Survived:
+				suite.Failures++
+			case m.Error:
+				suite.Errors++
+			case m.Skipped, m.NotRun, m.Flaky, m.CompileError:
+				suite.Skipped++
+			case m.Killed:
+			}
+		}
+	}
+
+	names := make([]string, 0, len(suitesByName))
+	for name := range suitesByName {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	root := junitTestSuites{Name: "gooze"}
+	totalSeconds := 0.0
+
+	for _, name := range names {
+		suite := suitesByName[name]
+		suite.Time = formatJUnitSeconds(secondsByName[name])
+
+		root.Tests += suite.Tests
+		root.Failures += suite.Failures
+		root.Errors += suite.Errors
+		root.Skipped += suite.Skipped
+		totalSeconds += secondsByName[name]
+		root.Suites = append(root.Suites, *suite)
+	}
+
+	root.Time = formatJUnitSeconds(totalSeconds)
+
+	return root
+}
+
+func junitTestCaseFor(suiteName string, result m.MutationResult, diff *[]byte) junitTestCase {
+	testCase := junitTestCase{
+		Name:      fmt.Sprintf("%s/%s", result.Type.Name, result.MutationID),
+		ClassName: suiteName,
+		Time:      formatJUnitSeconds(result.Duration.Seconds()),
+	}
+
+	switch result.Status {
+	case m.Survived:
+		testCase.Failure = &junitMessage{Message: survivedFailureMessage, Type: result.Status.String()}
+		if diff != nil {
+			testCase.Failure.Body = string(*diff)
+		}
+	case m.Error:
+		testCase.Error = &junitMessage{Type: result.Status.String()}
+		if result.Err != nil {
+			testCase.Error.Message = result.Err.Error()
+		}
+	case m.Skipped:
+		testCase.Skipped = &junitMessage{}
+	case m.NotRun:
+		testCase.Skipped = &junitMessage{Message: notRunSkipMessage}
+	case m.Flaky:
+		testCase.Skipped = &junitMessage{Message: flakySkipMessage}
+	case m.CompileError:
+		testCase.Skipped = &junitMessage{Message: compileErrorMessage}
+	case m.Killed:
+	}
+
+	return testCase
+}
+
+func formatJUnitSeconds(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
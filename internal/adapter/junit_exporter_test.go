@@ -0,0 +1,101 @@
+package adapter
+
+import (
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_ExportJUnit_WritesSuitePerFile(t *testing.T) {
+	t.Parallel()
+
+	outPath := filepath.Join(t.TempDir(), "nested", "junit.xml")
+	rs := &LocalReportStore{}
+
+	diff := []byte("-\treturn a + b\n+\treturn a - b\n")
+	reports := []m.Report{
+		{
+			Source: m.Source{Origin: &m.File{ShortPath: "pkg/b.go", FullPath: "/abs/pkg/b.go"}},
+			Result: m.Result{{MutationID: "m1", Type: m.MutationArithmetic, Status: m.Survived, Duration: 1500 * time.Millisecond}},
+			Diff:   &diff,
+		},
+		{
+			Source: m.Source{Origin: &m.File{ShortPath: "pkg/b.go", FullPath: "/abs/pkg/b.go"}},
+			Result: m.Result{{MutationID: "m2", Type: m.MutationBoolean, Status: m.Killed, Duration: 500 * time.Millisecond}},
+		},
+		{
+			Source: m.Source{Origin: &m.File{ShortPath: "pkg/a.go", FullPath: "/abs/pkg/a.go"}},
+			Result: m.Result{
+				{MutationID: "m3", Type: m.MutationNumbers, Status: m.Error, Err: errors.New("build failed")},
+				{MutationID: "m4", Type: m.MutationNumbers, Status: m.Skipped},
+			},
+		},
+	}
+
+	if err := rs.ExportJUnit(m.Path(outPath), reports); err != nil {
+		t.Fatalf("ExportJUnit() error = %v", err)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read junit output: %v", err)
+	}
+
+	if !strings.HasPrefix(string(data), xml.Header) {
+		t.Fatalf("expected XML header, got:\n%s", data)
+	}
+
+	var got junitTestSuites
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal junit output: %v", err)
+	}
+
+	if got.Tests != 4 || got.Failures != 1 || got.Errors != 1 || got.Skipped != 1 {
+		t.Fatalf("unexpected totals: %+v", got)
+	}
+
+	if got.Time != "2.000" {
+		t.Fatalf("expected total time 2.000, got %s", got.Time)
+	}
+
+	if len(got.Suites) != 2 || got.Suites[0].Name != "pkg/a.go" || got.Suites[1].Name != "pkg/b.go" {
+		t.Fatalf("expected suites sorted by file, got %+v", got.Suites)
+	}
+
+	survived := got.Suites[1].TestCases[0]
+	if survived.Name != "arithmetic/m1" || survived.ClassName != "pkg/b.go" || survived.Time != "1.500" {
+		t.Fatalf("unexpected survived test case: %+v", survived)
+	}
+
+	if survived.Failure == nil || survived.Failure.Message != survivedFailureMessage || !strings.Contains(survived.Failure.Body, "return a - b") {
+		t.Fatalf("expected failure with diff for survived mutant, got %+v", survived.Failure)
+	}
+
+	if killed := got.Suites[1].TestCases[1]; killed.Failure != nil || killed.Error != nil || killed.Skipped != nil {
+		t.Fatalf("expected killed mutant to pass, got %+v", killed)
+	}
+
+	errored := got.Suites[0].TestCases[0]
+	if errored.Error == nil || errored.Error.Message != "build failed" {
+		t.Fatalf("expected error element, got %+v", errored.Error)
+	}
+
+	if got.Suites[0].TestCases[1].Skipped == nil {
+		t.Fatalf("expected skipped element for skipped mutation")
+	}
+}
+
+func TestLocalReportStore_ExportJUnit_EmptyPath_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	rs := &LocalReportStore{}
+	if err := rs.ExportJUnit("", nil); err == nil {
+		t.Fatalf("expected error for empty output path")
+	}
+}
@@ -0,0 +1,450 @@
+package adapter
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// KubernetesJob describes one shard of a run executed as a Kubernetes Job.
+type KubernetesJob struct {
+	Name      string
+	Namespace string
+	// Image provides gooze and the go command.
+	Image string
+	// CPU and Memory, when set, are the container's resource requests and
+	// limits, e.g. "4" and "8Gi".
+	CPU    string
+	Memory string
+	// Workspace is the local project directory uploaded into the pod's
+	// working directory; version control directories are left out.
+	Workspace m.Path
+	// Command runs in the uploaded workspace.
+	Command []string
+	// Results is the directory, relative to the workspace, downloaded into
+	// Out once Command exits.
+	Results string
+	Out     m.Path
+	// Timeout, when set, bounds the time from the job's creation until
+	// Command exits. Kubernetes stops the job once Timeout and the time
+	// left for collecting the results have passed.
+	Timeout time.Duration
+}
+
+// JobRunner runs shard jobs on a cluster.
+type JobRunner interface {
+	// RunJob creates the job, uploads its workspace, waits for its command
+	// and downloads its results; the job is deleted afterwards. A failing
+	// command is reported as an error once its results were downloaded.
+	RunJob(job KubernetesJob) error
+}
+
+// podWorkspace is where the job's pod receives the workspace.
+const podWorkspace = "/workspace"
+
+// collectTimeout is how long a job's pod waits for its results to be
+// collected after its command exited.
+const collectTimeout = 10 * time.Minute
+
+// jobScript waits for the upload, runs the command given as its arguments
+// and keeps the pod alive until the results were collected, so they can be
+// downloaded from the finished run, or until the number of seconds it is
+// formatted with have passed.
+const jobScript = `while [ ! -f .gooze-ready ]; do sleep 1; done
+"$@"
+echo $? > .gooze-exit.tmp && mv .gooze-exit.tmp .gooze-exit
+waited=0
+while [ ! -f .gooze-collected ] && [ $waited -lt %d ]; do sleep 1; waited=$((waited + 1)); done`
+
+// KubectlJobRunner runs jobs with the kubectl of the current context.
+type KubectlJobRunner struct {
+	pollInterval time.Duration
+	readyTimeout time.Duration
+}
+
+// NewKubectlJobRunner constructs a KubectlJobRunner.
+func NewKubectlJobRunner() *KubectlJobRunner {
+	return &KubectlJobRunner{
+		pollInterval: 5 * time.Second,
+		readyTimeout: 30 * time.Minute,
+	}
+}
+
+// RunJob runs job as described by JobRunner.
+func (k *KubectlJobRunner) RunJob(job KubernetesJob) error {
+	var deadline time.Time
+	if job.Timeout > 0 {
+		deadline = time.Now().Add(job.Timeout)
+	}
+
+	manifest, err := json.Marshal(jobManifest(job))
+	if err != nil {
+		return fmt.Errorf("encode job %s: %w", job.Name, err)
+	}
+
+	if err := k.kubectl(bytes.NewReader(manifest), nil, "apply", "-f", "-"); err != nil {
+		return fmt.Errorf("create job %s: %w", job.Name, err)
+	}
+
+	defer k.deleteJob(job)
+
+	pod, err := k.jobPod(job)
+	if err != nil {
+		return err
+	}
+
+	if err := k.upload(job, pod); err != nil {
+		return err
+	}
+
+	code, err := k.waitForExit(job, pod, deadline)
+	if err != nil {
+		return err
+	}
+
+	if err := k.download(job, pod); err != nil {
+		return err
+	}
+
+	_ = k.kubectl(nil, nil, "exec", "-n", job.Namespace, pod, "--", "touch", podWorkspace+"/.gooze-collected")
+
+	if code != "0" {
+		return fmt.Errorf("job %s: %s exited with status %s", job.Name, job.Command[0], code)
+	}
+
+	return nil
+}
+
+// jobPod waits for the job's pod to be scheduled and ready and returns its
+// name.
+func (k *KubectlJobRunner) jobPod(job KubernetesJob) (string, error) {
+	var pod bytes.Buffer
+
+	deadline := time.Now().Add(k.readyTimeout)
+
+	for pod.Len() == 0 {
+		err := k.kubectl(nil, &pod, "get", "pods", "-n", job.Namespace, "-l", "job-name="+job.Name,
+			"-o", "jsonpath={.items[0].metadata.name}")
+		if err != nil {
+			pod.Reset()
+		}
+
+		if pod.Len() > 0 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("job %s: no pod created within %s", job.Name, k.readyTimeout)
+		}
+
+		time.Sleep(k.pollInterval)
+	}
+
+	name := "pod/" + strings.TrimSpace(pod.String())
+
+	err := k.kubectl(nil, nil, "wait", "-n", job.Namespace, "--for=condition=Ready", name, "--timeout="+k.readyTimeout.String())
+	if err != nil {
+		return "", fmt.Errorf("job %s: pod not ready: %w", job.Name, err)
+	}
+
+	return name, nil
+}
+
+func (k *KubectlJobRunner) upload(job KubernetesJob, pod string) error {
+	archive, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(writeTarGz(writer, string(job.Workspace)))
+	}()
+
+	err := k.kubectl(archive, nil, "exec", "-i", "-n", job.Namespace, pod, "--", "tar", "xzf", "-", "-C", podWorkspace)
+	archive.Close()
+
+	if err != nil {
+		return fmt.Errorf("job %s: upload workspace: %w", job.Name, err)
+	}
+
+	if err := k.kubectl(nil, nil, "exec", "-n", job.Namespace, pod, "--", "touch", podWorkspace+"/.gooze-ready"); err != nil {
+		return fmt.Errorf("job %s: start command: %w", job.Name, err)
+	}
+
+	return nil
+}
+
+// waitForExit polls for the exit status the job script records until
+// deadline, if not zero.
+func (k *KubectlJobRunner) waitForExit(job KubernetesJob, pod string, deadline time.Time) (string, error) {
+	for {
+		var status bytes.Buffer
+
+		err := k.kubectl(nil, &status, "exec", "-n", job.Namespace, pod, "--", "cat", podWorkspace+"/.gooze-exit")
+		if err == nil {
+			return strings.TrimSpace(status.String()), nil
+		}
+
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", err
+		}
+
+		// The script outlives the command, so a pod that stopped was
+		// killed, e.g. for exceeding its memory limit.
+		var phase bytes.Buffer
+		if k.kubectl(nil, &phase, "get", "-n", job.Namespace, pod, "-o", "jsonpath={.status.phase}") == nil {
+			if p := strings.TrimSpace(phase.String()); p == "Failed" || p == "Succeeded" {
+				return "", fmt.Errorf("job %s: pod stopped before %s exited", job.Name, job.Command[0])
+			}
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return "", fmt.Errorf("job %s: %s did not exit within %s", job.Name, job.Command[0], job.Timeout)
+		}
+
+		time.Sleep(k.pollInterval)
+	}
+}
+
+func (k *KubectlJobRunner) download(job KubernetesJob, pod string) error {
+	var archive bytes.Buffer
+
+	err := k.kubectl(nil, &archive, "exec", "-n", job.Namespace, pod, "--",
+		"tar", "czf", "-", "-C", podWorkspace+"/"+job.Results, ".")
+	if err != nil {
+		return fmt.Errorf("job %s: download results: %w", job.Name, err)
+	}
+
+	if err := extractTarGz(&archive, string(job.Out)); err != nil {
+		return fmt.Errorf("job %s: extract results: %w", job.Name, err)
+	}
+
+	return nil
+}
+
+func (k *KubectlJobRunner) deleteJob(job KubernetesJob) {
+	_ = k.kubectl(nil, nil, "delete", "job", job.Name, "-n", job.Namespace, "--wait=false")
+}
+
+// kubectl runs kubectl with args, reading stdin and writing stdout when they
+// are set. Its stderr is returned as part of the error.
+func (k *KubectlJobRunner) kubectl(stdin io.Reader, stdout io.Writer, args ...string) error {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("kubectl", args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return fmt.Errorf("kubectl %s: %w: %s", args[0], err, message)
+		}
+
+		return fmt.Errorf("kubectl %s: %w", args[0], err)
+	}
+
+	return nil
+}
+
+// jobManifest returns the Job running job's command through jobScript in
+// an emptyDir workspace. It is not retried, is stopped once its timeout and
+// collectTimeout have passed, and is removed an hour after it finishes
+// should the runner fail to delete it.
+func jobManifest(job KubernetesJob) map[string]any {
+	script := fmt.Sprintf(jobScript, int(collectTimeout.Seconds()))
+
+	container := map[string]any{
+		"name":       "gooze",
+		"image":      job.Image,
+		"workingDir": podWorkspace,
+		"command":    append([]string{"sh", "-c", script, "sh"}, job.Command...),
+		"volumeMounts": []map[string]any{
+			{"name": "workspace", "mountPath": podWorkspace},
+		},
+	}
+
+	resources := map[string]string{}
+	if job.CPU != "" {
+		resources["cpu"] = job.CPU
+	}
+
+	if job.Memory != "" {
+		resources["memory"] = job.Memory
+	}
+
+	if len(resources) > 0 {
+		container["resources"] = map[string]any{"requests": resources, "limits": resources}
+	}
+
+	spec := map[string]any{
+		"backoffLimit":            0,
+		"ttlSecondsAfterFinished": 3600,
+		"template": map[string]any{
+			"spec": map[string]any{
+				"restartPolicy": "Never",
+				"containers":    []any{container},
+				"volumes": []map[string]any{
+					{"name": "workspace", "emptyDir": map[string]any{}},
+				},
+			},
+		},
+	}
+
+	if job.Timeout > 0 {
+		spec["activeDeadlineSeconds"] = int64((job.Timeout + collectTimeout).Seconds())
+	}
+
+	return map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata": map[string]any{
+			"name":      job.Name,
+			"namespace": job.Namespace,
+			"labels":    map[string]string{"app.kubernetes.io/name": "gooze"},
+		},
+		"spec": spec,
+	}
+}
+
+// writeTarGz writes the regular files and directories under root to w as a
+// gzipped tar, leaving out version control directories.
+func writeTarGz(w io.Writer, root string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() && (entry.Name() == ".git" || entry.Name() == ".hg" || entry.Name() == ".svn") {
+			return filepath.SkipDir
+		}
+
+		if !entry.IsDir() && !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		return addToTar(tw, path, filepath.ToSlash(rel), entry)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+func addToTar(tw *tar.Writer, path, name string, entry fs.DirEntry) error {
+	info, err := entry.Info()
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+
+	header.Name = name
+	if entry.IsDir() {
+		header.Name += "/"
+	}
+
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	if entry.IsDir() {
+		return nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(tw, file)
+
+	return err
+}
+
+// extractTarGz writes the directories and regular files of the gzipped tar
+// in r below dir. Entries that would land outside dir are rejected.
+func extractTarGz(r io.Reader, dir string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		name := filepath.FromSlash(strings.TrimPrefix(header.Name, "./"))
+		if name == "" || name == "." {
+			continue
+		}
+
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("archive entry %s is outside the target directory", header.Name)
+		}
+
+		if err := extractEntry(tr, header, filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+}
+
+func extractEntry(tr *tar.Reader, header *tar.Header, path string) error {
+	switch header.Typeflag {
+	case tar.TypeDir:
+		return os.MkdirAll(path, 0o750)
+	case tar.TypeReg:
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			return err
+		}
+
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.Copy(file, tr); err != nil {
+			file.Close()
+			return err
+		}
+
+		return file.Close()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+package adapter
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJobManifest(t *testing.T) {
+	manifest, err := json.Marshal(jobManifest(KubernetesJob{
+		Name:      "gooze-1-0",
+		Namespace: "ci",
+		Image:     "registry.example.com/gooze:1.25",
+		Memory:    "8Gi",
+		Command:   []string{"gooze", "run", "-s", "0/4", "./..."},
+		Timeout:   2 * time.Hour,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`"kind":"Job"`,
+		`"name":"gooze-1-0","namespace":"ci"`,
+		`"backoffLimit":0`,
+		`"restartPolicy":"Never"`,
+		`"image":"registry.example.com/gooze:1.25"`,
+		`"sh","gooze","run","-s","0/4","./..."]`,
+		`"limits":{"memory":"8Gi"}`,
+		`"emptyDir":{}`,
+		`"activeDeadlineSeconds":7800`,
+		`-lt 600 ]`,
+	} {
+		if !strings.Contains(string(manifest), want) {
+			t.Errorf("expected %s in the job manifest: %s", want, manifest)
+		}
+	}
+}
+
+func TestTarGzRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	files := map[string]string{
+		"go.mod":                   "module example.com/calc\n",
+		"calc/calc.go":             "package calc\n",
+		".gooze-reports/index.yml": "files: 1\n",
+		".git/HEAD":                "ref: refs/heads/main\n",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(src, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var archive bytes.Buffer
+	if err := writeTarGz(&archive, src); err != nil {
+		t.Fatalf("writeTarGz() error = %v", err)
+	}
+
+	dst := t.TempDir()
+	if err := extractTarGz(&archive, dst); err != nil {
+		t.Fatalf("extractTarGz() error = %v", err)
+	}
+
+	for _, name := range []string{"go.mod", "calc/calc.go", ".gooze-reports/index.yml"} {
+		content, err := os.ReadFile(filepath.Join(dst, filepath.FromSlash(name)))
+		if err != nil || string(content) != files[name] {
+			t.Errorf("expected %s to be extracted with its content, got %q, %v", name, content, err)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dst, ".git")); !os.IsNotExist(err) {
+		t.Errorf("expected .git to be left out, got %v", err)
+	}
+}
+
+func TestExtractTarGz_RejectsEntriesOutsideTheDirectory(t *testing.T) {
+	var archive bytes.Buffer
+
+	gz := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gz)
+
+	content := []byte("oops")
+	if err := tw.WriteHeader(&tar.Header{Name: "../escaped", Mode: 0o600, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+
+	tw.Close()
+	gz.Close()
+
+	if err := extractTarGz(&archive, t.TempDir()); err == nil {
+		t.Fatal("expected an entry outside the target directory to be rejected")
+	}
+}
@@ -0,0 +1,327 @@
+package adapter
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// JSON-RPC error codes the server answers with.
+const (
+	lspParseError     = -32700
+	lspInvalidRequest = -32600
+	lspMethodNotFound = -32601
+)
+
+const (
+	// lspSyncNone tells the client not to send document changes: diagnostics
+	// describe the files on disk, which are read again on save.
+	lspSyncNone = 0
+	// lspMessageError is MessageType.Error for window/logMessage.
+	lspMessageError = 1
+)
+
+// ErrLSPExitWithoutShutdown is returned by Serve when the client sends exit
+// without shutdown first, which the protocol treats as an abnormal end.
+var ErrLSPExitWithoutShutdown = errors.New("lsp: exit without shutdown")
+
+// LSPDiagnosticsFunc returns the diagnostics to show for the file at path.
+type LSPDiagnosticsFunc func(path m.Path) ([]m.Diagnostic, error)
+
+// LSPServer speaks the Language Server Protocol over a byte stream, usually
+// stdin and stdout, and publishes the diagnostics of the documents the client
+// has open.
+type LSPServer struct {
+	reader      *bufio.Reader
+	out         io.Writer
+	diagnostics LSPDiagnosticsFunc
+
+	// writeMu keeps messages whole when Refresh publishes while Serve
+	// answers a request.
+	writeMu sync.Mutex
+
+	mu       sync.Mutex
+	open     map[string]bool
+	shutdown bool
+}
+
+// NewLSPServer constructs an LSPServer reading from in and writing to out,
+// looking up diagnostics with the given function.
+func NewLSPServer(in io.Reader, out io.Writer, diagnostics LSPDiagnosticsFunc) *LSPServer {
+	return &LSPServer{
+		reader:      bufio.NewReader(in),
+		out:         out,
+		diagnostics: diagnostics,
+		open:        make(map[string]bool),
+	}
+}
+
+type lspRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+type lspResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result"`
+}
+
+type lspErrorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   lspError        `json:"error"`
+}
+
+type lspError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type lspNotification struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+type lspTextDocumentParams struct {
+	TextDocument struct {
+		URI string `json:"uri"`
+	} `json:"textDocument"`
+}
+
+type lspLogMessage struct {
+	Type    int    `json:"type"`
+	Message string `json:"message"`
+}
+
+// Serve handles messages until the client sends exit or closes the stream.
+func (s *LSPServer) Serve() error {
+	for {
+		body, err := s.readMessage()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		var request lspRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			if err := s.respondError(json.RawMessage("null"), lspParseError, err.Error()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if request.Method == "exit" {
+			if !s.isShutdown() {
+				return ErrLSPExitWithoutShutdown
+			}
+
+			return nil
+		}
+
+		if err := s.handle(request); err != nil {
+			return err
+		}
+	}
+}
+
+// Refresh publishes the diagnostics of every open document again, after the
+// results they come from changed.
+func (s *LSPServer) Refresh() error {
+	s.mu.Lock()
+	uris := make([]string, 0, len(s.open))
+
+	for uri := range s.open {
+		uris = append(uris, uri)
+	}
+	s.mu.Unlock()
+
+	for _, uri := range uris {
+		if err := s.publish(uri); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *LSPServer) handle(request lspRequest) error {
+	isRequest := len(request.ID) > 0
+
+	if s.isShutdown() && isRequest {
+		return s.respondError(request.ID, lspInvalidRequest, "server is shut down")
+	}
+
+	switch request.Method {
+	case "initialize":
+		return s.respond(request.ID, map[string]any{
+			"capabilities": map[string]any{
+				"textDocumentSync": map[string]any{
+					"openClose": true,
+					"change":    lspSyncNone,
+					"save":      map[string]any{"includeText": false},
+				},
+			},
+			"serverInfo": map[string]any{"name": diagnosticsSource},
+		})
+	case "shutdown":
+		s.mu.Lock()
+		s.shutdown = true
+		s.mu.Unlock()
+
+		return s.respond(request.ID, nil)
+	case "textDocument/didOpen", "textDocument/didSave":
+		uri, err := documentURI(request.Params)
+		if err != nil {
+			return s.logError(err)
+		}
+
+		s.mu.Lock()
+		s.open[uri] = true
+		s.mu.Unlock()
+
+		return s.publish(uri)
+	case "textDocument/didClose":
+		uri, err := documentURI(request.Params)
+		if err != nil {
+			return s.logError(err)
+		}
+
+		s.mu.Lock()
+		delete(s.open, uri)
+		s.mu.Unlock()
+
+		return s.notify("textDocument/publishDiagnostics", lspPublishDiagnostics{URI: uri, Diagnostics: []lspDiagnostic{}})
+	}
+
+	if isRequest {
+		return s.respondError(request.ID, lspMethodNotFound, "method not supported: "+request.Method)
+	}
+
+	// Other notifications, such as initialized and $/cancelRequest, need no
+	// action.
+	return nil
+}
+
+// publish sends the diagnostics of the document at uri. A failed lookup is
+// logged to the client rather than ending the session.
+func (s *LSPServer) publish(uri string) error {
+	path, ok := uriPath(uri)
+	if !ok {
+		return nil
+	}
+
+	diagnostics, err := s.diagnostics(path)
+	if err != nil {
+		return s.logError(fmt.Errorf("diagnostics for %s: %w", path, err))
+	}
+
+	sorted := make([]m.Diagnostic, len(diagnostics))
+	copy(sorted, diagnostics)
+	sortDiagnostics(sorted)
+
+	params := lspPublishDiagnostics{URI: uri, Diagnostics: make([]lspDiagnostic, 0, len(sorted))}
+	for _, diagnostic := range sorted {
+		params.Diagnostics = append(params.Diagnostics, newLSPDiagnostic(diagnostic))
+	}
+
+	return s.notify("textDocument/publishDiagnostics", params)
+}
+
+func (s *LSPServer) isShutdown() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.shutdown
+}
+
+func (s *LSPServer) respond(id json.RawMessage, result any) error {
+	return s.write(lspResponse{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+func (s *LSPServer) respondError(id json.RawMessage, code int, message string) error {
+	return s.write(lspErrorResponse{JSONRPC: "2.0", ID: id, Error: lspError{Code: code, Message: message}})
+}
+
+func (s *LSPServer) notify(method string, params any) error {
+	return s.write(lspNotification{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+func (s *LSPServer) logError(err error) error {
+	return s.notify("window/logMessage", lspLogMessage{Type: lspMessageError, Message: "gooze: " + err.Error()})
+}
+
+// readMessage reads one message body framed by a Content-Length header.
+func (s *LSPServer) readMessage() ([]byte, error) {
+	headers, err := textproto.NewReader(s.reader).ReadMIMEHeader()
+	if err != nil {
+		if errors.Is(err, io.EOF) && len(headers) == 0 {
+			return nil, io.EOF
+		}
+
+		return nil, fmt.Errorf("lsp: read header: %w", err)
+	}
+
+	length, err := strconv.Atoi(headers.Get("Content-Length"))
+	if err != nil || length < 0 {
+		return nil, fmt.Errorf("lsp: invalid Content-Length %q", headers.Get("Content-Length"))
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(s.reader, body); err != nil {
+		return nil, fmt.Errorf("lsp: read body: %w", err)
+	}
+
+	return body, nil
+}
+
+func (s *LSPServer) write(message any) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("lsp: marshal message: %w", err)
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if _, err := fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(body), body); err != nil {
+		return fmt.Errorf("lsp: write message: %w", err)
+	}
+
+	return nil
+}
+
+func documentURI(params json.RawMessage) (string, error) {
+	var document lspTextDocumentParams
+	if err := json.Unmarshal(params, &document); err != nil || document.TextDocument.URI == "" {
+		return "", fmt.Errorf("missing textDocument.uri in %s", strings.TrimSpace(string(params)))
+	}
+
+	return document.TextDocument.URI, nil
+}
+
+// uriPath converts a file URI to a path; other schemes have no diagnostics.
+func uriPath(uri string) (m.Path, bool) {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme != "file" {
+		return "", false
+	}
+
+	return m.Path(filepath.FromSlash(parsed.Path)), true
+}
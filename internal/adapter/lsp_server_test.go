@@ -0,0 +1,208 @@
+package adapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func lspInput(t *testing.T, messages ...string) *bytes.Buffer {
+	t.Helper()
+
+	var in bytes.Buffer
+	for _, message := range messages {
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(message), message)
+	}
+
+	return &in
+}
+
+// lspOutput splits the server's output into its message bodies.
+func lspOutput(t *testing.T, out *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	server := NewLSPServer(out, io.Discard, nil)
+
+	var messages []map[string]any
+
+	for {
+		body, err := server.readMessage()
+		if errors.Is(err, io.EOF) {
+			return messages
+		}
+
+		if err != nil {
+			t.Fatalf("read output: %v", err)
+		}
+
+		var message map[string]any
+		if err := json.Unmarshal(body, &message); err != nil {
+			t.Fatalf("unmarshal output %s: %v", body, err)
+		}
+
+		messages = append(messages, message)
+	}
+}
+
+func TestLSPServer_Session(t *testing.T) {
+	t.Parallel()
+
+	in := lspInput(t,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		`{"jsonrpc":"2.0","method":"initialized","params":{}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///abs/pkg/a.go"}}}`,
+		`{"jsonrpc":"2.0","id":"x","method":"textDocument/hover","params":{}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":"file:///abs/pkg/a.go"}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+	)
+
+	var requested []m.Path
+
+	var out bytes.Buffer
+	server := NewLSPServer(in, &out, func(path m.Path) ([]m.Diagnostic, error) {
+		requested = append(requested, path)
+
+		return testDiagnostics()[1:], nil
+	})
+
+	if err := server.Serve(); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	if len(requested) != 1 || requested[0] != "/abs/pkg/a.go" {
+		t.Fatalf("expected diagnostics for /abs/pkg/a.go only, got %v", requested)
+	}
+
+	messages := lspOutput(t, &out)
+	if len(messages) != 5 {
+		t.Fatalf("expected 5 messages, got %d: %v", len(messages), messages)
+	}
+
+	capabilities := messages[0]["result"].(map[string]any)["capabilities"].(map[string]any)
+	if sync := capabilities["textDocumentSync"].(map[string]any); sync["openClose"] != true {
+		t.Fatalf("unexpected capabilities: %v", capabilities)
+	}
+
+	published := messages[1]["params"].(map[string]any)
+	diagnostics := published["diagnostics"].([]any)
+	if messages[1]["method"] != "textDocument/publishDiagnostics" || published["uri"] != "file:///abs/pkg/a.go" || len(diagnostics) != 2 {
+		t.Fatalf("unexpected publish: %v", messages[1])
+	}
+
+	if first := diagnostics[0].(map[string]any); first["code"] != "numbers" {
+		t.Fatalf("expected diagnostics ordered by position, got %v", diagnostics)
+	}
+
+	if code := messages[2]["error"].(map[string]any)["code"]; code != float64(lspMethodNotFound) || messages[2]["id"] != "x" {
+		t.Fatalf("expected method not found for hover, got %v", messages[2])
+	}
+
+	if cleared := messages[3]["params"].(map[string]any)["diagnostics"].([]any); len(cleared) != 0 {
+		t.Fatalf("expected diagnostics cleared on close, got %v", messages[3])
+	}
+
+	if result, ok := messages[4]["result"]; !ok || result != nil || messages[4]["id"] != float64(2) {
+		t.Fatalf("expected null shutdown result, got %v", messages[4])
+	}
+}
+
+func TestLSPServer_ExitWithoutShutdown(t *testing.T) {
+	t.Parallel()
+
+	in := lspInput(t, `{"jsonrpc":"2.0","method":"exit"}`)
+	server := NewLSPServer(in, io.Discard, nil)
+
+	if err := server.Serve(); !errors.Is(err, ErrLSPExitWithoutShutdown) {
+		t.Fatalf("expected ErrLSPExitWithoutShutdown, got %v", err)
+	}
+}
+
+func TestLSPServer_EndOfInput(t *testing.T) {
+	t.Parallel()
+
+	server := NewLSPServer(strings.NewReader(""), io.Discard, nil)
+
+	if err := server.Serve(); err != nil {
+		t.Fatalf("expected end of input to stop cleanly, got %v", err)
+	}
+}
+
+func TestLSPServer_InvalidContentLength(t *testing.T) {
+	t.Parallel()
+
+	server := NewLSPServer(strings.NewReader("Content-Length: x\r\n\r\n{}"), io.Discard, nil)
+
+	if err := server.Serve(); err == nil || !strings.Contains(err.Error(), "invalid Content-Length") {
+		t.Fatalf("expected invalid Content-Length error, got %v", err)
+	}
+}
+
+func TestLSPServer_ReportsErrorsToClient(t *testing.T) {
+	t.Parallel()
+
+	in := lspInput(t,
+		`not json`,
+		`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///abs/a.go"}}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"untitled:Untitled-1"}}}`,
+	)
+
+	var out bytes.Buffer
+	server := NewLSPServer(in, &out, func(m.Path) ([]m.Diagnostic, error) {
+		return nil, errors.New("reports unreadable")
+	})
+
+	if err := server.Serve(); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	messages := lspOutput(t, &out)
+	if len(messages) != 2 {
+		t.Fatalf("expected a parse error and a log message, got %v", messages)
+	}
+
+	if code := messages[0]["error"].(map[string]any)["code"]; code != float64(lspParseError) {
+		t.Fatalf("expected parse error, got %v", messages[0])
+	}
+
+	logged := messages[1]["params"].(map[string]any)["message"].(string)
+	if messages[1]["method"] != "window/logMessage" || !strings.Contains(logged, "reports unreadable") {
+		t.Fatalf("expected the lookup error logged, got %v", messages[1])
+	}
+}
+
+func TestLSPServer_RefreshPublishesOpenDocuments(t *testing.T) {
+	t.Parallel()
+
+	in := lspInput(t,
+		`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///abs/a.go"}}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///abs/b.go"}}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":"file:///abs/b.go"}}}`,
+	)
+
+	var out bytes.Buffer
+	server := NewLSPServer(in, &out, func(m.Path) ([]m.Diagnostic, error) {
+		return nil, nil
+	})
+
+	if err := server.Serve(); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	out.Reset()
+
+	if err := server.Refresh(); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	messages := lspOutput(t, &out)
+	if len(messages) != 1 || messages[0]["params"].(map[string]any)["uri"] != "file:///abs/a.go" {
+		t.Fatalf("expected only a.go republished, got %v", messages)
+	}
+}
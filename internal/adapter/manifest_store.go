@@ -0,0 +1,168 @@
+package adapter
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const (
+	manifestFileName      = "_manifest.yaml"
+	manifestSignatureName = manifestFileName + ".sig"
+	manifestVersion       = 1
+)
+
+type manifestYAML struct {
+	Version int                 `yaml:"version"`
+	Time    time.Time           `yaml:"time"`
+	GoozeGo string              `yaml:"gooze_go_version"`
+	Command []string            `yaml:"command"`
+	Config  map[string]string   `yaml:"config,omitempty"`
+	Files   []manifestFileEntry `yaml:"files"`
+}
+
+type manifestFileEntry struct {
+	Path   string `yaml:"path"`
+	SHA256 string `yaml:"sha256"`
+	Size   int64  `yaml:"size"`
+}
+
+// SaveManifest writes `_manifest.yaml` listing every file in the reports
+// directory with its SHA-256, along with the command line and config of the
+// run. With a signing key (a PEM-encoded PKCS#8 ed25519 private key) the raw
+// signature of the manifest bytes is written to `_manifest.yaml.sig`;
+// otherwise a stale signature is removed.
+func (rs *LocalReportStore) SaveManifest(path m.Path, manifest m.RunManifest, signingKey m.Path) error {
+	dirPath := string(path)
+	if dirPath == "" {
+		return fmt.Errorf("reports directory path is required")
+	}
+
+	var key ed25519.PrivateKey
+
+	if signingKey != "" {
+		var err error
+
+		key, err = loadSigningKey(string(signingKey))
+		if err != nil {
+			return err
+		}
+	}
+
+	files, err := manifestFiles(dirPath)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(manifestYAML{
+		Version: manifestVersion,
+		Time:    manifest.Time.UTC(),
+		GoozeGo: runtime.Version(),
+		Command: manifest.Command,
+		Config:  manifest.Config,
+		Files:   files,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal manifest YAML: %w", err)
+	}
+
+	manifestPath := filepath.Join(dirPath, manifestFileName)
+	if err := os.WriteFile(manifestPath, data, 0o600); err != nil {
+		return fmt.Errorf("write manifest %s: %w", manifestPath, err)
+	}
+
+	signaturePath := filepath.Join(dirPath, manifestSignatureName)
+
+	if key == nil {
+		if err := os.Remove(signaturePath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove manifest signature %s: %w", signaturePath, err)
+		}
+
+		return nil
+	}
+
+	if err := os.WriteFile(signaturePath, ed25519.Sign(key, data), 0o600); err != nil {
+		return fmt.Errorf("write manifest signature %s: %w", signaturePath, err)
+	}
+
+	return nil
+}
+
+// manifestFiles hashes every regular file under dirPath except the manifest
+// and its signature, in lexical order of their slash-separated paths.
+func manifestFiles(dirPath string) ([]manifestFileEntry, error) {
+	var files []manifestFileEntry
+
+	err := filepath.WalkDir(dirPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dirPath, path)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+		if rel == manifestFileName || rel == manifestSignatureName {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, manifestFileEntry{
+			Path:   rel,
+			SHA256: fmt.Sprintf("%x", sha256.Sum256(data)),
+			Size:   int64(len(data)),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list report files: %w", err)
+	}
+
+	return files, nil
+}
+
+func loadSigningKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest signing key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("manifest signing key %s: no PEM block found", path)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("manifest signing key %s: %w", path, err)
+	}
+
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("manifest signing key %s: want an ed25519 key, got %T", path, parsed)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,131 @@
+package adapter
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func writeSigningKey(t *testing.T, key any) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	return path
+}
+
+func readManifest(t *testing.T, dir string) ([]byte, manifestYAML) {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	require.NoError(t, err)
+
+	var manifest manifestYAML
+	require.NoError(t, yaml.Unmarshal(data, &manifest))
+
+	return data, manifest
+}
+
+func TestSaveManifest_ListsReportFiles(t *testing.T) {
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := []byte("source: {}\n")
+	writeTestFile(t, filepath.Join(dir, "abc.yaml"), string(report))
+	writeTestFile(t, filepath.Join(dir, indexFileName), "total_mutations: 1\n")
+	mustMkdir(t, filepath.Join(dir, "shard_0"))
+	writeTestFile(t, filepath.Join(dir, "shard_0", "def.yaml"), "source: {}\n")
+
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	err := rs.SaveManifest(m.Path(dir), m.RunManifest{
+		Time:    at,
+		Command: []string{"gooze", "run", "./..."},
+		Config:  map[string]string{"parallel": "4"},
+	}, "")
+	require.NoError(t, err)
+
+	_, manifest := readManifest(t, dir)
+	assert.Equal(t, manifestVersion, manifest.Version)
+	assert.True(t, at.Equal(manifest.Time))
+	assert.Equal(t, []string{"gooze", "run", "./..."}, manifest.Command)
+	assert.Equal(t, map[string]string{"parallel": "4"}, manifest.Config)
+
+	paths := make([]string, 0, len(manifest.Files))
+	for _, file := range manifest.Files {
+		paths = append(paths, file.Path)
+	}
+
+	assert.Equal(t, []string{indexFileName, "abc.yaml", "shard_0/def.yaml"}, paths)
+	assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256(report)), manifest.Files[1].SHA256)
+	assert.Equal(t, int64(len(report)), manifest.Files[1].Size)
+	assert.NoFileExists(t, filepath.Join(dir, manifestSignatureName))
+
+	// Rewriting must not list the previous manifest.
+	require.NoError(t, rs.SaveManifest(m.Path(dir), m.RunManifest{Time: at}, ""))
+	_, manifest = readManifest(t, dir)
+	assert.Len(t, manifest.Files, 3)
+}
+
+func TestSaveManifest_Signs(t *testing.T) {
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+	writeTestFile(t, filepath.Join(dir, "abc.yaml"), "source: {}\n")
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keyPath := writeSigningKey(t, private)
+
+	require.NoError(t, rs.SaveManifest(m.Path(dir), m.RunManifest{Time: time.Now()}, m.Path(keyPath)))
+
+	data, _ := readManifest(t, dir)
+	signature, err := os.ReadFile(filepath.Join(dir, manifestSignatureName))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(public, data, signature), "signature should verify against the manifest bytes")
+
+	// An unsigned rewrite drops the now stale signature.
+	require.NoError(t, rs.SaveManifest(m.Path(dir), m.RunManifest{Time: time.Now()}, ""))
+	assert.NoFileExists(t, filepath.Join(dir, manifestSignatureName))
+}
+
+func TestSaveManifest_RejectsUnusableKeys(t *testing.T) {
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	notPEM := filepath.Join(t.TempDir(), "key.txt")
+	writeTestFile(t, notPEM, "not a key")
+
+	for name, keyPath := range map[string]string{
+		"missing": filepath.Join(t.TempDir(), "missing.pem"),
+		"not PEM": notPEM,
+		"ECDSA":   writeSigningKey(t, ecKey),
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := rs.SaveManifest(m.Path(dir), m.RunManifest{Time: time.Now()}, m.Path(keyPath))
+			require.Error(t, err)
+			assert.NoFileExists(t, filepath.Join(dir, manifestFileName), "no manifest should be written without a usable key")
+		})
+	}
+}
@@ -5,6 +5,7 @@ package mocks
 import (
 	ast "go/ast"
 	token "go/token"
+	types "go/types"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -22,6 +23,65 @@ func (_m *MockGoFileAdapter) EXPECT() *MockGoFileAdapter_Expecter {
 	return &MockGoFileAdapter_Expecter{mock: &_m.Mock}
 }
 
+// Check provides a mock function with given fields: fileSet, files
+func (_m *MockGoFileAdapter) Check(fileSet *token.FileSet, files []*ast.File) (*types.Info, error) {
+	ret := _m.Called(fileSet, files)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 *types.Info
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*token.FileSet, []*ast.File) (*types.Info, error)); ok {
+		return rf(fileSet, files)
+	}
+	if rf, ok := ret.Get(0).(func(*token.FileSet, []*ast.File) *types.Info); ok {
+		r0 = rf(fileSet, files)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Info)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*token.FileSet, []*ast.File) error); ok {
+		r1 = rf(fileSet, files)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGoFileAdapter_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockGoFileAdapter_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - fileSet *token.FileSet
+//   - files []*ast.File
+func (_e *MockGoFileAdapter_Expecter) Check(fileSet interface{}, files interface{}) *MockGoFileAdapter_Check_Call {
+	return &MockGoFileAdapter_Check_Call{Call: _e.mock.On("Check", fileSet, files)}
+}
+
+func (_c *MockGoFileAdapter_Check_Call) Run(run func(fileSet *token.FileSet, files []*ast.File)) *MockGoFileAdapter_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*token.FileSet), args[1].([]*ast.File))
+	})
+	return _c
+}
+
+func (_c *MockGoFileAdapter_Check_Call) Return(_a0 *types.Info, _a1 error) *MockGoFileAdapter_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGoFileAdapter_Check_Call) RunAndReturn(run func(*token.FileSet, []*ast.File) (*types.Info, error)) *MockGoFileAdapter_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Parse provides a mock function with given fields: fileSet, filename, src
 func (_m *MockGoFileAdapter) Parse(fileSet *token.FileSet, filename string, src []byte) (*ast.File, error) {
 	ret := _m.Called(fileSet, filename, src)
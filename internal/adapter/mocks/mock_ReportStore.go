@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	time "time"
+
 	model "github.com/mouse-blink/gooze/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,6 +22,62 @@ func (_m *MockReportStore) EXPECT() *MockReportStore_Expecter {
 	return &MockReportStore_Expecter{mock: &_m.Mock}
 }
 
+// CheckIntegrity provides a mock function with given fields: path
+func (_m *MockReportStore) CheckIntegrity(path model.Path) (model.IntegrityReport, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckIntegrity")
+	}
+
+	var r0 model.IntegrityReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path) (model.IntegrityReport, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path) model.IntegrityReport); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(model.IntegrityReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Path) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_CheckIntegrity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckIntegrity'
+type MockReportStore_CheckIntegrity_Call struct {
+	*mock.Call
+}
+
+// CheckIntegrity is a helper method to define mock.On call
+//   - path model.Path
+func (_e *MockReportStore_Expecter) CheckIntegrity(path interface{}) *MockReportStore_CheckIntegrity_Call {
+	return &MockReportStore_CheckIntegrity_Call{Call: _e.mock.On("CheckIntegrity", path)}
+}
+
+func (_c *MockReportStore_CheckIntegrity_Call) Run(run func(path model.Path)) *MockReportStore_CheckIntegrity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_CheckIntegrity_Call) Return(_a0 model.IntegrityReport, _a1 error) *MockReportStore_CheckIntegrity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportStore_CheckIntegrity_Call) RunAndReturn(run func(model.Path) (model.IntegrityReport, error)) *MockReportStore_CheckIntegrity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckUpdates provides a mock function with given fields: path, sources
 func (_m *MockReportStore) CheckUpdates(path model.Path, sources []model.Source) ([]model.Source, error) {
 	ret := _m.Called(path, sources)
@@ -126,24 +184,167 @@ func (_c *MockReportStore_CleanReports_Call) RunAndReturn(run func(model.Path, [
 	return _c
 }
 
-// LoadReports provides a mock function with given fields: path
-func (_m *MockReportStore) LoadReports(path model.Path) ([]model.Report, error) {
+// ExportBadge provides a mock function with given fields: svgPath, endpointPath, badge
+func (_m *MockReportStore) ExportBadge(svgPath model.Path, endpointPath model.Path, badge model.Badge) error {
+	ret := _m.Called(svgPath, endpointPath, badge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportBadge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, model.Path, model.Badge) error); ok {
+		r0 = rf(svgPath, endpointPath, badge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_ExportBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportBadge'
+type MockReportStore_ExportBadge_Call struct {
+	*mock.Call
+}
+
+// ExportBadge is a helper method to define mock.On call
+//   - svgPath model.Path
+//   - endpointPath model.Path
+//   - badge model.Badge
+func (_e *MockReportStore_Expecter) ExportBadge(svgPath interface{}, endpointPath interface{}, badge interface{}) *MockReportStore_ExportBadge_Call {
+	return &MockReportStore_ExportBadge_Call{Call: _e.mock.On("ExportBadge", svgPath, endpointPath, badge)}
+}
+
+func (_c *MockReportStore_ExportBadge_Call) Run(run func(svgPath model.Path, endpointPath model.Path, badge model.Badge)) *MockReportStore_ExportBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(model.Path), args[2].(model.Badge))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_ExportBadge_Call) Return(_a0 error) *MockReportStore_ExportBadge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_ExportBadge_Call) RunAndReturn(run func(model.Path, model.Path, model.Badge) error) *MockReportStore_ExportBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportDiagnostics provides a mock function with given fields: path, format, diagnostics
+func (_m *MockReportStore) ExportDiagnostics(path model.Path, format model.DiagnosticsFormat, diagnostics []model.Diagnostic) error {
+	ret := _m.Called(path, format, diagnostics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportDiagnostics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, model.DiagnosticsFormat, []model.Diagnostic) error); ok {
+		r0 = rf(path, format, diagnostics)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_ExportDiagnostics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportDiagnostics'
+type MockReportStore_ExportDiagnostics_Call struct {
+	*mock.Call
+}
+
+// ExportDiagnostics is a helper method to define mock.On call
+//   - path model.Path
+//   - format model.DiagnosticsFormat
+//   - diagnostics []model.Diagnostic
+func (_e *MockReportStore_Expecter) ExportDiagnostics(path interface{}, format interface{}, diagnostics interface{}) *MockReportStore_ExportDiagnostics_Call {
+	return &MockReportStore_ExportDiagnostics_Call{Call: _e.mock.On("ExportDiagnostics", path, format, diagnostics)}
+}
+
+func (_c *MockReportStore_ExportDiagnostics_Call) Run(run func(path model.Path, format model.DiagnosticsFormat, diagnostics []model.Diagnostic)) *MockReportStore_ExportDiagnostics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(model.DiagnosticsFormat), args[2].([]model.Diagnostic))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_ExportDiagnostics_Call) Return(_a0 error) *MockReportStore_ExportDiagnostics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_ExportDiagnostics_Call) RunAndReturn(run func(model.Path, model.DiagnosticsFormat, []model.Diagnostic) error) *MockReportStore_ExportDiagnostics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportJUnit provides a mock function with given fields: path, reports
+func (_m *MockReportStore) ExportJUnit(path model.Path, reports []model.Report) error {
+	ret := _m.Called(path, reports)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportJUnit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, []model.Report) error); ok {
+		r0 = rf(path, reports)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_ExportJUnit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportJUnit'
+type MockReportStore_ExportJUnit_Call struct {
+	*mock.Call
+}
+
+// ExportJUnit is a helper method to define mock.On call
+//   - path model.Path
+//   - reports []model.Report
+func (_e *MockReportStore_Expecter) ExportJUnit(path interface{}, reports interface{}) *MockReportStore_ExportJUnit_Call {
+	return &MockReportStore_ExportJUnit_Call{Call: _e.mock.On("ExportJUnit", path, reports)}
+}
+
+func (_c *MockReportStore_ExportJUnit_Call) Run(run func(path model.Path, reports []model.Report)) *MockReportStore_ExportJUnit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].([]model.Report))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_ExportJUnit_Call) Return(_a0 error) *MockReportStore_ExportJUnit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_ExportJUnit_Call) RunAndReturn(run func(model.Path, []model.Report) error) *MockReportStore_ExportJUnit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadApplied provides a mock function with given fields: path
+func (_m *MockReportStore) LoadApplied(path model.Path) (*model.AppliedMutation, error) {
 	ret := _m.Called(path)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LoadReports")
+		panic("no return value specified for LoadApplied")
 	}
 
-	var r0 []model.Report
+	var r0 *model.AppliedMutation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Path) ([]model.Report, error)); ok {
+	if rf, ok := ret.Get(0).(func(model.Path) (*model.AppliedMutation, error)); ok {
 		return rf(path)
 	}
-	if rf, ok := ret.Get(0).(func(model.Path) []model.Report); ok {
+	if rf, ok := ret.Get(0).(func(model.Path) *model.AppliedMutation); ok {
 		r0 = rf(path)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Report)
+			r0 = ret.Get(0).(*model.AppliedMutation)
 		}
 	}
 
@@ -156,76 +357,843 @@ func (_m *MockReportStore) LoadReports(path model.Path) ([]model.Report, error)
 	return r0, r1
 }
 
-// MockReportStore_LoadReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadReports'
-type MockReportStore_LoadReports_Call struct {
+// MockReportStore_LoadApplied_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadApplied'
+type MockReportStore_LoadApplied_Call struct {
 	*mock.Call
 }
 
-// LoadReports is a helper method to define mock.On call
+// LoadApplied is a helper method to define mock.On call
 //   - path model.Path
-func (_e *MockReportStore_Expecter) LoadReports(path interface{}) *MockReportStore_LoadReports_Call {
-	return &MockReportStore_LoadReports_Call{Call: _e.mock.On("LoadReports", path)}
+func (_e *MockReportStore_Expecter) LoadApplied(path interface{}) *MockReportStore_LoadApplied_Call {
+	return &MockReportStore_LoadApplied_Call{Call: _e.mock.On("LoadApplied", path)}
 }
 
-func (_c *MockReportStore_LoadReports_Call) Run(run func(path model.Path)) *MockReportStore_LoadReports_Call {
+func (_c *MockReportStore_LoadApplied_Call) Run(run func(path model.Path)) *MockReportStore_LoadApplied_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(model.Path))
 	})
 	return _c
 }
 
-func (_c *MockReportStore_LoadReports_Call) Return(_a0 []model.Report, _a1 error) *MockReportStore_LoadReports_Call {
+func (_c *MockReportStore_LoadApplied_Call) Return(_a0 *model.AppliedMutation, _a1 error) *MockReportStore_LoadApplied_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockReportStore_LoadReports_Call) RunAndReturn(run func(model.Path) ([]model.Report, error)) *MockReportStore_LoadReports_Call {
+func (_c *MockReportStore_LoadApplied_Call) RunAndReturn(run func(model.Path) (*model.AppliedMutation, error)) *MockReportStore_LoadApplied_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegenerateIndex provides a mock function with given fields: path
-func (_m *MockReportStore) RegenerateIndex(path model.Path) error {
+// LoadEquivalents provides a mock function with given fields: path
+func (_m *MockReportStore) LoadEquivalents(path model.Path) ([]string, error) {
 	ret := _m.Called(path)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegenerateIndex")
+		panic("no return value specified for LoadEquivalents")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(model.Path) error); ok {
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path) ([]string, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path) []string); ok {
 		r0 = rf(path)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(model.Path) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockReportStore_RegenerateIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateIndex'
-type MockReportStore_RegenerateIndex_Call struct {
+// MockReportStore_LoadEquivalents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadEquivalents'
+type MockReportStore_LoadEquivalents_Call struct {
 	*mock.Call
 }
 
-// RegenerateIndex is a helper method to define mock.On call
+// LoadEquivalents is a helper method to define mock.On call
 //   - path model.Path
-func (_e *MockReportStore_Expecter) RegenerateIndex(path interface{}) *MockReportStore_RegenerateIndex_Call {
-	return &MockReportStore_RegenerateIndex_Call{Call: _e.mock.On("RegenerateIndex", path)}
+func (_e *MockReportStore_Expecter) LoadEquivalents(path interface{}) *MockReportStore_LoadEquivalents_Call {
+	return &MockReportStore_LoadEquivalents_Call{Call: _e.mock.On("LoadEquivalents", path)}
 }
 
-func (_c *MockReportStore_RegenerateIndex_Call) Run(run func(path model.Path)) *MockReportStore_RegenerateIndex_Call {
+func (_c *MockReportStore_LoadEquivalents_Call) Run(run func(path model.Path)) *MockReportStore_LoadEquivalents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(model.Path))
 	})
 	return _c
 }
 
-func (_c *MockReportStore_RegenerateIndex_Call) Return(_a0 error) *MockReportStore_RegenerateIndex_Call {
-	_c.Call.Return(_a0)
+func (_c *MockReportStore_LoadEquivalents_Call) Return(_a0 []string, _a1 error) *MockReportStore_LoadEquivalents_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockReportStore_RegenerateIndex_Call) RunAndReturn(run func(model.Path) error) *MockReportStore_RegenerateIndex_Call {
+func (_c *MockReportStore_LoadEquivalents_Call) RunAndReturn(run func(model.Path) ([]string, error)) *MockReportStore_LoadEquivalents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadHistory provides a mock function with given fields: path
+func (_m *MockReportStore) LoadHistory(path model.Path) ([]model.RunRecord, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadHistory")
+	}
+
+	var r0 []model.RunRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path) ([]model.RunRecord, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path) []model.RunRecord); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.RunRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Path) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_LoadHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadHistory'
+type MockReportStore_LoadHistory_Call struct {
+	*mock.Call
+}
+
+// LoadHistory is a helper method to define mock.On call
+//   - path model.Path
+func (_e *MockReportStore_Expecter) LoadHistory(path interface{}) *MockReportStore_LoadHistory_Call {
+	return &MockReportStore_LoadHistory_Call{Call: _e.mock.On("LoadHistory", path)}
+}
+
+func (_c *MockReportStore_LoadHistory_Call) Run(run func(path model.Path)) *MockReportStore_LoadHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_LoadHistory_Call) Return(_a0 []model.RunRecord, _a1 error) *MockReportStore_LoadHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportStore_LoadHistory_Call) RunAndReturn(run func(model.Path) ([]model.RunRecord, error)) *MockReportStore_LoadHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadIndex provides a mock function with given fields: path
+func (_m *MockReportStore) LoadIndex(path model.Path) (model.ReportIndex, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadIndex")
+	}
+
+	var r0 model.ReportIndex
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path) (model.ReportIndex, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path) model.ReportIndex); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(model.ReportIndex)
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Path) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_LoadIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadIndex'
+type MockReportStore_LoadIndex_Call struct {
+	*mock.Call
+}
+
+// LoadIndex is a helper method to define mock.On call
+//   - path model.Path
+func (_e *MockReportStore_Expecter) LoadIndex(path interface{}) *MockReportStore_LoadIndex_Call {
+	return &MockReportStore_LoadIndex_Call{Call: _e.mock.On("LoadIndex", path)}
+}
+
+func (_c *MockReportStore_LoadIndex_Call) Run(run func(path model.Path)) *MockReportStore_LoadIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_LoadIndex_Call) Return(_a0 model.ReportIndex, _a1 error) *MockReportStore_LoadIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportStore_LoadIndex_Call) RunAndReturn(run func(model.Path) (model.ReportIndex, error)) *MockReportStore_LoadIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadPackageIndex provides a mock function with given fields: path, pkg
+func (_m *MockReportStore) LoadPackageIndex(path model.Path, pkg string) (model.PackageIndex, error) {
+	ret := _m.Called(path, pkg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadPackageIndex")
+	}
+
+	var r0 model.PackageIndex
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path, string) (model.PackageIndex, error)); ok {
+		return rf(path, pkg)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path, string) model.PackageIndex); ok {
+		r0 = rf(path, pkg)
+	} else {
+		r0 = ret.Get(0).(model.PackageIndex)
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Path, string) error); ok {
+		r1 = rf(path, pkg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_LoadPackageIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPackageIndex'
+type MockReportStore_LoadPackageIndex_Call struct {
+	*mock.Call
+}
+
+// LoadPackageIndex is a helper method to define mock.On call
+//   - path model.Path
+//   - pkg string
+func (_e *MockReportStore_Expecter) LoadPackageIndex(path interface{}, pkg interface{}) *MockReportStore_LoadPackageIndex_Call {
+	return &MockReportStore_LoadPackageIndex_Call{Call: _e.mock.On("LoadPackageIndex", path, pkg)}
+}
+
+func (_c *MockReportStore_LoadPackageIndex_Call) Run(run func(path model.Path, pkg string)) *MockReportStore_LoadPackageIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_LoadPackageIndex_Call) Return(_a0 model.PackageIndex, _a1 error) *MockReportStore_LoadPackageIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportStore_LoadPackageIndex_Call) RunAndReturn(run func(model.Path, string) (model.PackageIndex, error)) *MockReportStore_LoadPackageIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadPlan provides a mock function with given fields: path
+func (_m *MockReportStore) LoadPlan(path model.Path) (model.MutationPlan, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadPlan")
+	}
+
+	var r0 model.MutationPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path) (model.MutationPlan, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path) model.MutationPlan); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(model.MutationPlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Path) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_LoadPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPlan'
+type MockReportStore_LoadPlan_Call struct {
+	*mock.Call
+}
+
+// LoadPlan is a helper method to define mock.On call
+//   - path model.Path
+func (_e *MockReportStore_Expecter) LoadPlan(path interface{}) *MockReportStore_LoadPlan_Call {
+	return &MockReportStore_LoadPlan_Call{Call: _e.mock.On("LoadPlan", path)}
+}
+
+func (_c *MockReportStore_LoadPlan_Call) Run(run func(path model.Path)) *MockReportStore_LoadPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_LoadPlan_Call) Return(_a0 model.MutationPlan, _a1 error) *MockReportStore_LoadPlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportStore_LoadPlan_Call) RunAndReturn(run func(model.Path) (model.MutationPlan, error)) *MockReportStore_LoadPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadReports provides a mock function with given fields: path
+func (_m *MockReportStore) LoadReports(path model.Path) ([]model.Report, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadReports")
+	}
+
+	var r0 []model.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path) ([]model.Report, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path) []model.Report); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Path) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReportStore_LoadReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadReports'
+type MockReportStore_LoadReports_Call struct {
+	*mock.Call
+}
+
+// LoadReports is a helper method to define mock.On call
+//   - path model.Path
+func (_e *MockReportStore_Expecter) LoadReports(path interface{}) *MockReportStore_LoadReports_Call {
+	return &MockReportStore_LoadReports_Call{Call: _e.mock.On("LoadReports", path)}
+}
+
+func (_c *MockReportStore_LoadReports_Call) Run(run func(path model.Path)) *MockReportStore_LoadReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_LoadReports_Call) Return(_a0 []model.Report, _a1 error) *MockReportStore_LoadReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReportStore_LoadReports_Call) RunAndReturn(run func(model.Path) ([]model.Report, error)) *MockReportStore_LoadReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEquivalent provides a mock function with given fields: path, mutationID
+func (_m *MockReportStore) MarkEquivalent(path model.Path, mutationID string) error {
+	ret := _m.Called(path, mutationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEquivalent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, string) error); ok {
+		r0 = rf(path, mutationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_MarkEquivalent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEquivalent'
+type MockReportStore_MarkEquivalent_Call struct {
+	*mock.Call
+}
+
+// MarkEquivalent is a helper method to define mock.On call
+//   - path model.Path
+//   - mutationID string
+func (_e *MockReportStore_Expecter) MarkEquivalent(path interface{}, mutationID interface{}) *MockReportStore_MarkEquivalent_Call {
+	return &MockReportStore_MarkEquivalent_Call{Call: _e.mock.On("MarkEquivalent", path, mutationID)}
+}
+
+func (_c *MockReportStore_MarkEquivalent_Call) Run(run func(path model.Path, mutationID string)) *MockReportStore_MarkEquivalent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_MarkEquivalent_Call) Return(_a0 error) *MockReportStore_MarkEquivalent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_MarkEquivalent_Call) RunAndReturn(run func(model.Path, string) error) *MockReportStore_MarkEquivalent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordRun provides a mock function with given fields: path, at
+func (_m *MockReportStore) RecordRun(path model.Path, at time.Time) error {
+	ret := _m.Called(path, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, time.Time) error); ok {
+		r0 = rf(path, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_RecordRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordRun'
+type MockReportStore_RecordRun_Call struct {
+	*mock.Call
+}
+
+// RecordRun is a helper method to define mock.On call
+//   - path model.Path
+//   - at time.Time
+func (_e *MockReportStore_Expecter) RecordRun(path interface{}, at interface{}) *MockReportStore_RecordRun_Call {
+	return &MockReportStore_RecordRun_Call{Call: _e.mock.On("RecordRun", path, at)}
+}
+
+func (_c *MockReportStore_RecordRun_Call) Run(run func(path model.Path, at time.Time)) *MockReportStore_RecordRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_RecordRun_Call) Return(_a0 error) *MockReportStore_RecordRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_RecordRun_Call) RunAndReturn(run func(model.Path, time.Time) error) *MockReportStore_RecordRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegenerateIndex provides a mock function with given fields: path
+func (_m *MockReportStore) RegenerateIndex(path model.Path) error {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateIndex")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_RegenerateIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateIndex'
+type MockReportStore_RegenerateIndex_Call struct {
+	*mock.Call
+}
+
+// RegenerateIndex is a helper method to define mock.On call
+//   - path model.Path
+func (_e *MockReportStore_Expecter) RegenerateIndex(path interface{}) *MockReportStore_RegenerateIndex_Call {
+	return &MockReportStore_RegenerateIndex_Call{Call: _e.mock.On("RegenerateIndex", path)}
+}
+
+func (_c *MockReportStore_RegenerateIndex_Call) Run(run func(path model.Path)) *MockReportStore_RegenerateIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_RegenerateIndex_Call) Return(_a0 error) *MockReportStore_RegenerateIndex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_RegenerateIndex_Call) RunAndReturn(run func(model.Path) error) *MockReportStore_RegenerateIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RepairIntegrity provides a mock function with given fields: path
+func (_m *MockReportStore) RepairIntegrity(path model.Path) error {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepairIntegrity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_RepairIntegrity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairIntegrity'
+type MockReportStore_RepairIntegrity_Call struct {
+	*mock.Call
+}
+
+// RepairIntegrity is a helper method to define mock.On call
+//   - path model.Path
+func (_e *MockReportStore_Expecter) RepairIntegrity(path interface{}) *MockReportStore_RepairIntegrity_Call {
+	return &MockReportStore_RepairIntegrity_Call{Call: _e.mock.On("RepairIntegrity", path)}
+}
+
+func (_c *MockReportStore_RepairIntegrity_Call) Run(run func(path model.Path)) *MockReportStore_RepairIntegrity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_RepairIntegrity_Call) Return(_a0 error) *MockReportStore_RepairIntegrity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_RepairIntegrity_Call) RunAndReturn(run func(model.Path) error) *MockReportStore_RepairIntegrity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveApplied provides a mock function with given fields: path, applied
+func (_m *MockReportStore) SaveApplied(path model.Path, applied *model.AppliedMutation) error {
+	ret := _m.Called(path, applied)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveApplied")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, *model.AppliedMutation) error); ok {
+		r0 = rf(path, applied)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_SaveApplied_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveApplied'
+type MockReportStore_SaveApplied_Call struct {
+	*mock.Call
+}
+
+// SaveApplied is a helper method to define mock.On call
+//   - path model.Path
+//   - applied *model.AppliedMutation
+func (_e *MockReportStore_Expecter) SaveApplied(path interface{}, applied interface{}) *MockReportStore_SaveApplied_Call {
+	return &MockReportStore_SaveApplied_Call{Call: _e.mock.On("SaveApplied", path, applied)}
+}
+
+func (_c *MockReportStore_SaveApplied_Call) Run(run func(path model.Path, applied *model.AppliedMutation)) *MockReportStore_SaveApplied_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(*model.AppliedMutation))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_SaveApplied_Call) Return(_a0 error) *MockReportStore_SaveApplied_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_SaveApplied_Call) RunAndReturn(run func(model.Path, *model.AppliedMutation) error) *MockReportStore_SaveApplied_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveFailure provides a mock function with given fields: path, failure
+func (_m *MockReportStore) SaveFailure(path model.Path, failure model.RunFailure) error {
+	ret := _m.Called(path, failure)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveFailure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, model.RunFailure) error); ok {
+		r0 = rf(path, failure)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_SaveFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveFailure'
+type MockReportStore_SaveFailure_Call struct {
+	*mock.Call
+}
+
+// SaveFailure is a helper method to define mock.On call
+//   - path model.Path
+//   - failure model.RunFailure
+func (_e *MockReportStore_Expecter) SaveFailure(path interface{}, failure interface{}) *MockReportStore_SaveFailure_Call {
+	return &MockReportStore_SaveFailure_Call{Call: _e.mock.On("SaveFailure", path, failure)}
+}
+
+func (_c *MockReportStore_SaveFailure_Call) Run(run func(path model.Path, failure model.RunFailure)) *MockReportStore_SaveFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(model.RunFailure))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_SaveFailure_Call) Return(_a0 error) *MockReportStore_SaveFailure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_SaveFailure_Call) RunAndReturn(run func(model.Path, model.RunFailure) error) *MockReportStore_SaveFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveManifest provides a mock function with given fields: path, manifest, signingKey
+func (_m *MockReportStore) SaveManifest(path model.Path, manifest model.RunManifest, signingKey model.Path) error {
+	ret := _m.Called(path, manifest, signingKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveManifest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, model.RunManifest, model.Path) error); ok {
+		r0 = rf(path, manifest, signingKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_SaveManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveManifest'
+type MockReportStore_SaveManifest_Call struct {
+	*mock.Call
+}
+
+// SaveManifest is a helper method to define mock.On call
+//   - path model.Path
+//   - manifest model.RunManifest
+//   - signingKey model.Path
+func (_e *MockReportStore_Expecter) SaveManifest(path interface{}, manifest interface{}, signingKey interface{}) *MockReportStore_SaveManifest_Call {
+	return &MockReportStore_SaveManifest_Call{Call: _e.mock.On("SaveManifest", path, manifest, signingKey)}
+}
+
+func (_c *MockReportStore_SaveManifest_Call) Run(run func(path model.Path, manifest model.RunManifest, signingKey model.Path)) *MockReportStore_SaveManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(model.RunManifest), args[2].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_SaveManifest_Call) Return(_a0 error) *MockReportStore_SaveManifest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_SaveManifest_Call) RunAndReturn(run func(model.Path, model.RunManifest, model.Path) error) *MockReportStore_SaveManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMutationDiffs provides a mock function with given fields: path, mutations, fullFiles
+func (_m *MockReportStore) SaveMutationDiffs(path model.Path, mutations []model.Mutation, fullFiles bool) error {
+	ret := _m.Called(path, mutations, fullFiles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMutationDiffs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, []model.Mutation, bool) error); ok {
+		r0 = rf(path, mutations, fullFiles)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_SaveMutationDiffs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMutationDiffs'
+type MockReportStore_SaveMutationDiffs_Call struct {
+	*mock.Call
+}
+
+// SaveMutationDiffs is a helper method to define mock.On call
+//   - path model.Path
+//   - mutations []model.Mutation
+//   - fullFiles bool
+func (_e *MockReportStore_Expecter) SaveMutationDiffs(path interface{}, mutations interface{}, fullFiles interface{}) *MockReportStore_SaveMutationDiffs_Call {
+	return &MockReportStore_SaveMutationDiffs_Call{Call: _e.mock.On("SaveMutationDiffs", path, mutations, fullFiles)}
+}
+
+func (_c *MockReportStore_SaveMutationDiffs_Call) Run(run func(path model.Path, mutations []model.Mutation, fullFiles bool)) *MockReportStore_SaveMutationDiffs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].([]model.Mutation), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_SaveMutationDiffs_Call) Return(_a0 error) *MockReportStore_SaveMutationDiffs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_SaveMutationDiffs_Call) RunAndReturn(run func(model.Path, []model.Mutation, bool) error) *MockReportStore_SaveMutationDiffs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePending provides a mock function with given fields: path, sources
+func (_m *MockReportStore) SavePending(path model.Path, sources []model.Path) error {
+	ret := _m.Called(path, sources)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePending")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, []model.Path) error); ok {
+		r0 = rf(path, sources)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_SavePending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePending'
+type MockReportStore_SavePending_Call struct {
+	*mock.Call
+}
+
+// SavePending is a helper method to define mock.On call
+//   - path model.Path
+//   - sources []model.Path
+func (_e *MockReportStore_Expecter) SavePending(path interface{}, sources interface{}) *MockReportStore_SavePending_Call {
+	return &MockReportStore_SavePending_Call{Call: _e.mock.On("SavePending", path, sources)}
+}
+
+func (_c *MockReportStore_SavePending_Call) Run(run func(path model.Path, sources []model.Path)) *MockReportStore_SavePending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].([]model.Path))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_SavePending_Call) Return(_a0 error) *MockReportStore_SavePending_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_SavePending_Call) RunAndReturn(run func(model.Path, []model.Path) error) *MockReportStore_SavePending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePlan provides a mock function with given fields: path, plan
+func (_m *MockReportStore) SavePlan(path model.Path, plan model.MutationPlan) error {
+	ret := _m.Called(path, plan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePlan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Path, model.MutationPlan) error); ok {
+		r0 = rf(path, plan)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReportStore_SavePlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePlan'
+type MockReportStore_SavePlan_Call struct {
+	*mock.Call
+}
+
+// SavePlan is a helper method to define mock.On call
+//   - path model.Path
+//   - plan model.MutationPlan
+func (_e *MockReportStore_Expecter) SavePlan(path interface{}, plan interface{}) *MockReportStore_SavePlan_Call {
+	return &MockReportStore_SavePlan_Call{Call: _e.mock.On("SavePlan", path, plan)}
+}
+
+func (_c *MockReportStore_SavePlan_Call) Run(run func(path model.Path, plan model.MutationPlan)) *MockReportStore_SavePlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].(model.MutationPlan))
+	})
+	return _c
+}
+
+func (_c *MockReportStore_SavePlan_Call) Return(_a0 error) *MockReportStore_SavePlan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReportStore_SavePlan_Call) RunAndReturn(run func(model.Path, model.MutationPlan) error) *MockReportStore_SavePlan_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -72,9 +72,65 @@ func (_c *MockSourceFSAdapter_CopyDir_Call) RunAndReturn(run func(model.Path, mo
 	return _c
 }
 
-// CreateTempDir provides a mock function with given fields: pattern
-func (_m *MockSourceFSAdapter) CreateTempDir(pattern string) (model.Path, error) {
-	ret := _m.Called(pattern)
+// CopySize provides a mock function with given fields: src
+func (_m *MockSourceFSAdapter) CopySize(src model.Path) (int64, error) {
+	ret := _m.Called(src)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopySize")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path) (int64, error)); ok {
+		return rf(src)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path) int64); ok {
+		r0 = rf(src)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Path) error); ok {
+		r1 = rf(src)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSourceFSAdapter_CopySize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopySize'
+type MockSourceFSAdapter_CopySize_Call struct {
+	*mock.Call
+}
+
+// CopySize is a helper method to define mock.On call
+//   - src model.Path
+func (_e *MockSourceFSAdapter_Expecter) CopySize(src interface{}) *MockSourceFSAdapter_CopySize_Call {
+	return &MockSourceFSAdapter_CopySize_Call{Call: _e.mock.On("CopySize", src)}
+}
+
+func (_c *MockSourceFSAdapter_CopySize_Call) Run(run func(src model.Path)) *MockSourceFSAdapter_CopySize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path))
+	})
+	return _c
+}
+
+func (_c *MockSourceFSAdapter_CopySize_Call) Return(_a0 int64, _a1 error) *MockSourceFSAdapter_CopySize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSourceFSAdapter_CopySize_Call) RunAndReturn(run func(model.Path) (int64, error)) *MockSourceFSAdapter_CopySize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTempDir provides a mock function with given fields: dir, pattern
+func (_m *MockSourceFSAdapter) CreateTempDir(dir model.Path, pattern string) (model.Path, error) {
+	ret := _m.Called(dir, pattern)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateTempDir")
@@ -82,17 +138,17 @@ func (_m *MockSourceFSAdapter) CreateTempDir(pattern string) (model.Path, error)
 
 	var r0 model.Path
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (model.Path, error)); ok {
-		return rf(pattern)
+	if rf, ok := ret.Get(0).(func(model.Path, string) (model.Path, error)); ok {
+		return rf(dir, pattern)
 	}
-	if rf, ok := ret.Get(0).(func(string) model.Path); ok {
-		r0 = rf(pattern)
+	if rf, ok := ret.Get(0).(func(model.Path, string) model.Path); ok {
+		r0 = rf(dir, pattern)
 	} else {
 		r0 = ret.Get(0).(model.Path)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(pattern)
+	if rf, ok := ret.Get(1).(func(model.Path, string) error); ok {
+		r1 = rf(dir, pattern)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,14 +162,15 @@ type MockSourceFSAdapter_CreateTempDir_Call struct {
 }
 
 // CreateTempDir is a helper method to define mock.On call
+//   - dir model.Path
 //   - pattern string
-func (_e *MockSourceFSAdapter_Expecter) CreateTempDir(pattern interface{}) *MockSourceFSAdapter_CreateTempDir_Call {
-	return &MockSourceFSAdapter_CreateTempDir_Call{Call: _e.mock.On("CreateTempDir", pattern)}
+func (_e *MockSourceFSAdapter_Expecter) CreateTempDir(dir interface{}, pattern interface{}) *MockSourceFSAdapter_CreateTempDir_Call {
+	return &MockSourceFSAdapter_CreateTempDir_Call{Call: _e.mock.On("CreateTempDir", dir, pattern)}
 }
 
-func (_c *MockSourceFSAdapter_CreateTempDir_Call) Run(run func(pattern string)) *MockSourceFSAdapter_CreateTempDir_Call {
+func (_c *MockSourceFSAdapter_CreateTempDir_Call) Run(run func(dir model.Path, pattern string)) *MockSourceFSAdapter_CreateTempDir_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(model.Path), args[1].(string))
 	})
 	return _c
 }
@@ -123,7 +180,7 @@ func (_c *MockSourceFSAdapter_CreateTempDir_Call) Return(_a0 model.Path, _a1 err
 	return _c
 }
 
-func (_c *MockSourceFSAdapter_CreateTempDir_Call) RunAndReturn(run func(string) (model.Path, error)) *MockSourceFSAdapter_CreateTempDir_Call {
+func (_c *MockSourceFSAdapter_CreateTempDir_Call) RunAndReturn(run func(model.Path, string) (model.Path, error)) *MockSourceFSAdapter_CreateTempDir_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -486,6 +543,65 @@ func (_c *MockSourceFSAdapter_JoinPath_Call) RunAndReturn(run func(...string) mo
 	return _c
 }
 
+// ListPackages provides a mock function with given fields: root, tags
+func (_m *MockSourceFSAdapter) ListPackages(root model.Path, tags []string) ([]model.Package, error) {
+	ret := _m.Called(root, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPackages")
+	}
+
+	var r0 []model.Package
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Path, []string) ([]model.Package, error)); ok {
+		return rf(root, tags)
+	}
+	if rf, ok := ret.Get(0).(func(model.Path, []string) []model.Package); ok {
+		r0 = rf(root, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Package)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Path, []string) error); ok {
+		r1 = rf(root, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSourceFSAdapter_ListPackages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPackages'
+type MockSourceFSAdapter_ListPackages_Call struct {
+	*mock.Call
+}
+
+// ListPackages is a helper method to define mock.On call
+//   - root model.Path
+//   - tags []string
+func (_e *MockSourceFSAdapter_Expecter) ListPackages(root interface{}, tags interface{}) *MockSourceFSAdapter_ListPackages_Call {
+	return &MockSourceFSAdapter_ListPackages_Call{Call: _e.mock.On("ListPackages", root, tags)}
+}
+
+func (_c *MockSourceFSAdapter_ListPackages_Call) Run(run func(root model.Path, tags []string)) *MockSourceFSAdapter_ListPackages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Path), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockSourceFSAdapter_ListPackages_Call) Return(_a0 []model.Package, _a1 error) *MockSourceFSAdapter_ListPackages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSourceFSAdapter_ListPackages_Call) RunAndReturn(run func(model.Path, []string) ([]model.Package, error)) *MockSourceFSAdapter_ListPackages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadFile provides a mock function with given fields: path
 func (_m *MockSourceFSAdapter) ReadFile(path model.Path) ([]byte, error) {
 	ret := _m.Called(path)
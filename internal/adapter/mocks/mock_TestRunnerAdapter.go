@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	adapter "github.com/mouse-blink/gooze/internal/adapter"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockTestRunnerAdapter is an autogenerated mock type for the TestRunnerAdapter type
 type MockTestRunnerAdapter struct {
@@ -17,9 +20,68 @@ func (_m *MockTestRunnerAdapter) EXPECT() *MockTestRunnerAdapter_Expecter {
 	return &MockTestRunnerAdapter_Expecter{mock: &_m.Mock}
 }
 
-// RunGoTest provides a mock function with given fields: workDir, testFile
-func (_m *MockTestRunnerAdapter) RunGoTest(workDir string, testFile string) (string, error) {
-	ret := _m.Called(workDir, testFile)
+// BuildTestBinary provides a mock function with given fields: workDir, testFile, binary, opts
+func (_m *MockTestRunnerAdapter) BuildTestBinary(workDir string, testFile string, binary string, opts adapter.GoTestOptions) (string, error) {
+	ret := _m.Called(workDir, testFile, binary, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildTestBinary")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, adapter.GoTestOptions) (string, error)); ok {
+		return rf(workDir, testFile, binary, opts)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, adapter.GoTestOptions) string); ok {
+		r0 = rf(workDir, testFile, binary, opts)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, adapter.GoTestOptions) error); ok {
+		r1 = rf(workDir, testFile, binary, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTestRunnerAdapter_BuildTestBinary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildTestBinary'
+type MockTestRunnerAdapter_BuildTestBinary_Call struct {
+	*mock.Call
+}
+
+// BuildTestBinary is a helper method to define mock.On call
+//   - workDir string
+//   - testFile string
+//   - binary string
+//   - opts adapter.GoTestOptions
+func (_e *MockTestRunnerAdapter_Expecter) BuildTestBinary(workDir interface{}, testFile interface{}, binary interface{}, opts interface{}) *MockTestRunnerAdapter_BuildTestBinary_Call {
+	return &MockTestRunnerAdapter_BuildTestBinary_Call{Call: _e.mock.On("BuildTestBinary", workDir, testFile, binary, opts)}
+}
+
+func (_c *MockTestRunnerAdapter_BuildTestBinary_Call) Run(run func(workDir string, testFile string, binary string, opts adapter.GoTestOptions)) *MockTestRunnerAdapter_BuildTestBinary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(adapter.GoTestOptions))
+	})
+	return _c
+}
+
+func (_c *MockTestRunnerAdapter_BuildTestBinary_Call) Return(output string, err error) *MockTestRunnerAdapter_BuildTestBinary_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *MockTestRunnerAdapter_BuildTestBinary_Call) RunAndReturn(run func(string, string, string, adapter.GoTestOptions) (string, error)) *MockTestRunnerAdapter_BuildTestBinary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunGoTest provides a mock function with given fields: workDir, testFile, opts
+func (_m *MockTestRunnerAdapter) RunGoTest(workDir string, testFile string, opts adapter.GoTestOptions) (string, error) {
+	ret := _m.Called(workDir, testFile, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RunGoTest")
@@ -27,17 +89,17 @@ func (_m *MockTestRunnerAdapter) RunGoTest(workDir string, testFile string) (str
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
-		return rf(workDir, testFile)
+	if rf, ok := ret.Get(0).(func(string, string, adapter.GoTestOptions) (string, error)); ok {
+		return rf(workDir, testFile, opts)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = rf(workDir, testFile)
+	if rf, ok := ret.Get(0).(func(string, string, adapter.GoTestOptions) string); ok {
+		r0 = rf(workDir, testFile, opts)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(workDir, testFile)
+	if rf, ok := ret.Get(1).(func(string, string, adapter.GoTestOptions) error); ok {
+		r1 = rf(workDir, testFile, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -53,13 +115,14 @@ type MockTestRunnerAdapter_RunGoTest_Call struct {
 // RunGoTest is a helper method to define mock.On call
 //   - workDir string
 //   - testFile string
-func (_e *MockTestRunnerAdapter_Expecter) RunGoTest(workDir interface{}, testFile interface{}) *MockTestRunnerAdapter_RunGoTest_Call {
-	return &MockTestRunnerAdapter_RunGoTest_Call{Call: _e.mock.On("RunGoTest", workDir, testFile)}
+//   - opts adapter.GoTestOptions
+func (_e *MockTestRunnerAdapter_Expecter) RunGoTest(workDir interface{}, testFile interface{}, opts interface{}) *MockTestRunnerAdapter_RunGoTest_Call {
+	return &MockTestRunnerAdapter_RunGoTest_Call{Call: _e.mock.On("RunGoTest", workDir, testFile, opts)}
 }
 
-func (_c *MockTestRunnerAdapter_RunGoTest_Call) Run(run func(workDir string, testFile string)) *MockTestRunnerAdapter_RunGoTest_Call {
+func (_c *MockTestRunnerAdapter_RunGoTest_Call) Run(run func(workDir string, testFile string, opts adapter.GoTestOptions)) *MockTestRunnerAdapter_RunGoTest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string), args[1].(string), args[2].(adapter.GoTestOptions))
 	})
 	return _c
 }
@@ -69,7 +132,66 @@ func (_c *MockTestRunnerAdapter_RunGoTest_Call) Return(output string, err error)
 	return _c
 }
 
-func (_c *MockTestRunnerAdapter_RunGoTest_Call) RunAndReturn(run func(string, string) (string, error)) *MockTestRunnerAdapter_RunGoTest_Call {
+func (_c *MockTestRunnerAdapter_RunGoTest_Call) RunAndReturn(run func(string, string, adapter.GoTestOptions) (string, error)) *MockTestRunnerAdapter_RunGoTest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunTestBinary provides a mock function with given fields: binary, workDir, testFile, opts
+func (_m *MockTestRunnerAdapter) RunTestBinary(binary string, workDir string, testFile string, opts adapter.GoTestOptions) (string, error) {
+	ret := _m.Called(binary, workDir, testFile, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunTestBinary")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, adapter.GoTestOptions) (string, error)); ok {
+		return rf(binary, workDir, testFile, opts)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, adapter.GoTestOptions) string); ok {
+		r0 = rf(binary, workDir, testFile, opts)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, adapter.GoTestOptions) error); ok {
+		r1 = rf(binary, workDir, testFile, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTestRunnerAdapter_RunTestBinary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunTestBinary'
+type MockTestRunnerAdapter_RunTestBinary_Call struct {
+	*mock.Call
+}
+
+// RunTestBinary is a helper method to define mock.On call
+//   - binary string
+//   - workDir string
+//   - testFile string
+//   - opts adapter.GoTestOptions
+func (_e *MockTestRunnerAdapter_Expecter) RunTestBinary(binary interface{}, workDir interface{}, testFile interface{}, opts interface{}) *MockTestRunnerAdapter_RunTestBinary_Call {
+	return &MockTestRunnerAdapter_RunTestBinary_Call{Call: _e.mock.On("RunTestBinary", binary, workDir, testFile, opts)}
+}
+
+func (_c *MockTestRunnerAdapter_RunTestBinary_Call) Run(run func(binary string, workDir string, testFile string, opts adapter.GoTestOptions)) *MockTestRunnerAdapter_RunTestBinary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(adapter.GoTestOptions))
+	})
+	return _c
+}
+
+func (_c *MockTestRunnerAdapter_RunTestBinary_Call) Return(output string, err error) *MockTestRunnerAdapter_RunTestBinary_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *MockTestRunnerAdapter_RunTestBinary_Call) RunAndReturn(run func(string, string, string, adapter.GoTestOptions) (string, error)) *MockTestRunnerAdapter_RunTestBinary_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,150 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// isModuleFile reports whether name is a file whose relative paths the go
+// command resolves against its own directory.
+func isModuleFile(name string) bool {
+	return name == "go.mod" || name == "go.work"
+}
+
+// copyModuleFile copies a go.mod or go.work file from src to dst, rewriting
+// relative replace and use paths that point outside root to absolute paths.
+// Paths inside root are kept relative since the copy brings their targets.
+func copyModuleFile(src, dst, root string, mode os.FileMode) error {
+	// #nosec G304 - src is internal project file path, not user input
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o750); err != nil {
+		return err
+	}
+
+	anchored := anchorModulePaths(string(content), filepath.Dir(src), root)
+
+	return os.WriteFile(dst, []byte(anchored), mode)
+}
+
+// anchorModulePaths rewrites the local paths of replace directives and, in
+// go.work files, of use directives. Both the single-line and block forms are
+// handled; everything else is left untouched.
+func anchorModulePaths(content, dir, root string) string {
+	lines := strings.Split(content, "\n")
+	block := ""
+
+	for i, line := range lines {
+		code, comment := splitLineComment(line)
+		fields := strings.Fields(code)
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case block != "" && fields[0] == ")":
+			block = ""
+			continue
+		case block == "" && len(fields) == 2 && fields[1] == "(" && (fields[0] == "replace" || fields[0] == "use"):
+			block = fields[0]
+			continue
+		}
+
+		directive := block
+		if directive == "" {
+			directive = fields[0]
+			fields = fields[1:]
+		}
+
+		var anchored []string
+
+		switch directive {
+		case "replace":
+			anchored = anchorReplace(fields, dir, root)
+		case "use":
+			anchored = anchorUse(fields, dir, root)
+		default:
+			continue
+		}
+
+		if anchored == nil {
+			continue
+		}
+
+		prefix := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if block == "" {
+			anchored = append([]string{directive}, anchored...)
+		}
+
+		lines[i] = prefix + strings.Join(anchored, " ") + comment
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// anchorReplace handles "old [version] => new [version]", returning nil when
+// nothing changes.
+func anchorReplace(fields []string, dir, root string) []string {
+	for i, field := range fields {
+		if field != "=>" || i+1 >= len(fields) {
+			continue
+		}
+
+		target, ok := anchorPath(fields[i+1], dir, root)
+		if !ok {
+			return nil
+		}
+
+		out := append([]string{}, fields...)
+		out[i+1] = target
+
+		return out
+	}
+
+	return nil
+}
+
+func anchorUse(fields []string, dir, root string) []string {
+	if len(fields) != 1 {
+		return nil
+	}
+
+	target, ok := anchorPath(fields[0], dir, root)
+	if !ok {
+		return nil
+	}
+
+	return []string{target}
+}
+
+// anchorPath returns the absolute, quoted form of a relative path that
+// escapes root. Module paths and paths inside root report false.
+func anchorPath(path, dir, root string) (string, bool) {
+	unquoted := unquoteWorkPath(path)
+	if !strings.HasPrefix(unquoted, "./") && !strings.HasPrefix(unquoted, "../") && unquoted != "." && unquoted != ".." {
+		return "", false
+	}
+
+	abs := filepath.Join(dir, unquoted)
+
+	rel, err := filepath.Rel(root, abs)
+	if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+
+	return strconv.Quote(abs), true
+}
+
+func splitLineComment(line string) (string, string) {
+	if i := strings.Index(line, "//"); i >= 0 {
+		return line[:i], " " + line[i:]
+	}
+
+	return line, ""
+}
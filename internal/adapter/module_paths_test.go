@@ -0,0 +1,85 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnchorModulePaths(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "src", "app")
+	sibling := filepath.Join(string(filepath.Separator), "src", "lib")
+
+	tests := []struct {
+		name    string
+		dir     string
+		content string
+		want    string
+	}{
+		{
+			name:    "replace outside root becomes absolute",
+			dir:     root,
+			content: "module example.com/app\n\nreplace example.com/lib => ../lib\n",
+			want:    "module example.com/app\n\nreplace example.com/lib => \"" + sibling + "\"\n",
+		},
+		{
+			name:    "replace with versions and comment",
+			dir:     root,
+			content: "replace example.com/lib v1.0.0 => ../lib // local checkout\n",
+			want:    "replace example.com/lib v1.0.0 => \"" + sibling + "\" // local checkout\n",
+		},
+		{
+			name:    "replace block",
+			dir:     root,
+			content: "replace (\n\texample.com/lib => ../lib\n\texample.com/inner => ./inner\n\texample.com/fork => example.com/fork v1.2.0\n)\n",
+			want:    "replace (\n\texample.com/lib => \"" + sibling + "\"\n\texample.com/inner => ./inner\n\texample.com/fork => example.com/fork v1.2.0\n)\n",
+		},
+		{
+			name:    "use outside root in go.work",
+			dir:     root,
+			content: "go 1.25\n\nuse (\n\t.\n\t../lib\n)\n",
+			want:    "go 1.25\n\nuse (\n\t.\n\t\"" + sibling + "\"\n)\n",
+		},
+		{
+			name:    "nested go.mod resolves from its own directory",
+			dir:     filepath.Join(root, "tools"),
+			content: "replace example.com/app => ../\nreplace example.com/lib => ../../lib\n",
+			want:    "replace example.com/app => ../\nreplace example.com/lib => \"" + sibling + "\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, anchorModulePaths(tt.content, tt.dir, root))
+		})
+	}
+}
+
+func TestLocalSourceFSAdapter_CopyDir_AnchorsSiblingReplace(t *testing.T) {
+	adapter := NewLocalSourceFSAdapter()
+
+	parent := t.TempDir()
+	appDir := filepath.Join(parent, "app")
+	libDir := filepath.Join(parent, "lib")
+	mustMkdir(t, appDir)
+	mustMkdir(t, libDir)
+	writeTestFile(t, filepath.Join(appDir, "go.mod"), "module example.com/app\n\nrequire example.com/lib v0.0.0\n\nreplace example.com/lib => ../lib\n")
+	writeTestFile(t, filepath.Join(appDir, "main.go"), "package main\n")
+
+	dst := t.TempDir()
+	require.NoError(t, adapter.CopyDir(m.Path(appDir), m.Path(dst)))
+
+	copied, err := os.ReadFile(filepath.Join(dst, "go.mod"))
+	require.NoError(t, err)
+	assert.Contains(t, string(copied), "replace example.com/lib => \""+libDir+"\"")
+	assert.True(t, strings.HasPrefix(string(copied), "module example.com/app\n"))
+
+	original, err := os.ReadFile(filepath.Join(appDir, "go.mod"))
+	require.NoError(t, err)
+	assert.Contains(t, string(original), "=> ../lib", "the original go.mod must not change")
+}
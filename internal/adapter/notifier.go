@@ -0,0 +1,166 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// Notifier pushes the summary of a run to a destination such as a pull
+// request comment, chat or a dashboard.
+type Notifier interface {
+	Notify(summary m.RunSummary) error
+}
+
+// summaryCommentMarker starts every comment gooze posts, so a later run
+// finds and updates its comment instead of adding another.
+const summaryCommentMarker = "<!-- gooze:mutation-summary -->"
+
+// notifierTimeout bounds each HTTP request a notifier makes.
+const notifierTimeout = 30 * time.Second
+
+// jsonRequest describes an HTTP call of a notifier with a JSON body.
+type jsonRequest struct {
+	method  string
+	url     string
+	headers map[string]string
+	// payload, when not nil, is sent as the JSON body; the response is
+	// decoded into out, when not nil.
+	payload any
+	out     any
+}
+
+// sendJSON makes req with client and reports a non-2xx status as an error
+// carrying the response's message field, which GitHub and GitLab both use.
+func sendJSON(client *http.Client, req jsonRequest) error {
+	var body io.Reader
+
+	if req.payload != nil {
+		data, err := json.Marshal(req.payload)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+
+		body = bytes.NewReader(data)
+	}
+
+	httpReq, err := http.NewRequestWithContext(context.Background(), req.method, req.url, body)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	if req.payload != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	for name, value := range req.headers {
+		httpReq.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", req.method, redactURL(httpReq), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message any `json:"message"`
+		}
+
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+
+		if apiErr.Message == nil {
+			return fmt.Errorf("%s %s: %s", req.method, redactURL(httpReq), resp.Status)
+		}
+
+		return fmt.Errorf("%s %s: %s: %v", req.method, redactURL(httpReq), resp.Status, apiErr.Message)
+	}
+
+	if req.out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(req.out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	return nil
+}
+
+// redactURL leaves out the query of the request URL, where webhook secrets
+// are often passed, when it appears in an error.
+func redactURL(req *http.Request) string {
+	u := *req.URL
+	u.RawQuery = ""
+	u.User = nil
+
+	return u.String()
+}
+
+// renderSummaryMarkdown renders summary as the Markdown body of a pull or
+// merge request comment, starting with summaryCommentMarker.
+func renderSummaryMarkdown(summary m.RunSummary) string {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, summaryCommentMarker)
+	fmt.Fprintln(&b, "## Mutation testing")
+	fmt.Fprintln(&b)
+	fmt.Fprintf(&b, "**%s**", scoreLine(summary))
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "| Killed | Survived | Failed | Skipped | Not run |")
+	fmt.Fprintln(&b, "|-------:|---------:|-------:|--------:|--------:|")
+	fmt.Fprintf(&b, "| %d | %d | %d | %d | %d |\n",
+		summary.Killed, summary.Survived, summary.Failed, summary.Skipped, summary.NotRun)
+
+	if len(summary.TopSurvived) == 0 {
+		return b.String()
+	}
+
+	fmt.Fprintln(&b)
+
+	if len(summary.TopSurvived) < summary.Survived {
+		fmt.Fprintf(&b, "### Top %d of %d survived mutants\n", len(summary.TopSurvived), summary.Survived)
+	} else {
+		fmt.Fprintln(&b, "### Survived mutants")
+	}
+
+	for _, survivor := range summary.TopSurvived {
+		fmt.Fprintln(&b)
+		fmt.Fprintf(&b, "<details><summary><code>%s</code> %s <code>%s</code>", survivor.Path, survivor.Type.Name, survivor.ID)
+
+		if survivor.Added {
+			fmt.Fprint(&b, " (new)")
+		}
+
+		fmt.Fprintln(&b, "</summary>")
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, "```diff")
+		fmt.Fprint(&b, strings.TrimRight(string(survivor.Diff), "\n")+"\n")
+		fmt.Fprintln(&b, "```")
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, "</details>")
+	}
+
+	return b.String()
+}
+
+// scoreLine states the score and, with base reports, its change.
+func scoreLine(summary m.RunSummary) string {
+	line := fmt.Sprintf("Mutation score: %.2f%%", summary.Score*100)
+
+	if summary.HasBase {
+		line += fmt.Sprintf(" (%+.2f points vs. %.2f%% on the base branch)",
+			(summary.Score-summary.BaseScore)*100, summary.BaseScore*100)
+	}
+
+	return line
+}
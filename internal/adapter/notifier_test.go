@@ -0,0 +1,63 @@
+package adapter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestRenderSummaryMarkdown(t *testing.T) {
+	t.Parallel()
+
+	body := renderSummaryMarkdown(m.RunSummary{
+		Score:     0.75,
+		Killed:    3,
+		Survived:  2,
+		BaseScore: 0.8,
+		HasBase:   true,
+		TopSurvived: []m.SurvivedMutation{{
+			ID:    "abc123",
+			Path:  "pkg/calc.go",
+			Type:  m.MutationArithmetic,
+			Diff:  []byte("-\treturn a + b\n+\treturn a - b\n"),
+			Added: true,
+		}},
+	})
+
+	if !strings.HasPrefix(body, summaryCommentMarker+"\n") {
+		t.Fatalf("expected the body to start with the marker, got:\n%s", body)
+	}
+
+	for _, want := range []string{
+		"**Mutation score: 75.00% (-5.00 points vs. 80.00% on the base branch)**",
+		"| 3 | 2 | 0 | 0 | 0 |",
+		"### Top 1 of 2 survived mutants",
+		"<code>pkg/calc.go</code> arithmetic <code>abc123</code> (new)</summary>",
+		"```diff\n-\treturn a + b\n+\treturn a - b\n```",
+	} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected body to contain %q, got:\n%s", want, body)
+		}
+	}
+}
+
+func TestSendJSON_RedactsQueryInErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	err := sendJSON(server.Client(), jsonRequest{method: http.MethodPost, url: server.URL + "/hook?token=secret", payload: struct{}{}})
+	if err == nil {
+		t.Fatal("expected an error for a 502 response")
+	}
+
+	if strings.Contains(err.Error(), "secret") || !strings.Contains(err.Error(), "502") {
+		t.Fatalf("expected the status without the query in the error, got %v", err)
+	}
+}
@@ -0,0 +1,345 @@
+package adapter
+
+import (
+	"crypto/md5" // #nosec G501 -- compared with object ETags, not used for security
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// ObjectReportStore keeps a reports directory in an object store. The
+// reports directory is restored from the bucket on first use and every write
+// uploads the files it changed, so shards on separate machines can share one
+// prefix and the run history outlives ephemeral CI runners. Paths outside
+// the reports directory, such as JUnit exports, stay local.
+type ObjectReportStore struct {
+	// ReportStore works on the local copy of the reports directory.
+	ReportStore
+
+	root    string
+	prefix  string
+	objects ObjectStore
+
+	mu       sync.Mutex
+	restored bool
+	// remote maps the keys known to be stored to the MD5 of their content,
+	// so unchanged files are neither downloaded nor uploaded again.
+	remote map[string]string
+}
+
+// NewObjectReportStore constructs an ObjectReportStore mirroring the
+// reports directory root to the keys below prefix in objects, and storing
+// it locally with local.
+func NewObjectReportStore(local ReportStore, root m.Path, objects ObjectStore, prefix string) ReportStore {
+	return &ObjectReportStore{
+		ReportStore: local,
+		root:        filepath.Clean(string(root)),
+		prefix:      strings.Trim(prefix, "/"),
+		objects:     objects,
+		remote:      make(map[string]string),
+	}
+}
+
+func (s *ObjectReportStore) LoadReports(path m.Path) ([]m.Report, error) {
+	if err := s.restore(path); err != nil {
+		return nil, err
+	}
+
+	return s.ReportStore.LoadReports(path)
+}
+
+func (s *ObjectReportStore) CheckUpdates(path m.Path, sources []m.Source) ([]m.Source, error) {
+	if err := s.restore(path); err != nil {
+		return nil, err
+	}
+
+	return s.ReportStore.CheckUpdates(path, sources)
+}
+
+func (s *ObjectReportStore) CheckIntegrity(path m.Path) (m.IntegrityReport, error) {
+	if err := s.restore(path); err != nil {
+		return m.IntegrityReport{}, err
+	}
+
+	return s.ReportStore.CheckIntegrity(path)
+}
+
+func (s *ObjectReportStore) LoadIndex(path m.Path) (m.ReportIndex, error) {
+	if err := s.restore(path); err != nil {
+		return m.ReportIndex{}, err
+	}
+
+	return s.ReportStore.LoadIndex(path)
+}
+
+func (s *ObjectReportStore) LoadPackageIndex(path m.Path, pkg string) (m.PackageIndex, error) {
+	if err := s.restore(path); err != nil {
+		return m.PackageIndex{}, err
+	}
+
+	return s.ReportStore.LoadPackageIndex(path, pkg)
+}
+
+func (s *ObjectReportStore) LoadHistory(path m.Path) ([]m.RunRecord, error) {
+	if err := s.restore(path); err != nil {
+		return nil, err
+	}
+
+	return s.ReportStore.LoadHistory(path)
+}
+
+func (s *ObjectReportStore) LoadEquivalents(path m.Path) ([]string, error) {
+	if err := s.restore(path); err != nil {
+		return nil, err
+	}
+
+	return s.ReportStore.LoadEquivalents(path)
+}
+
+func (s *ObjectReportStore) SaveReports(path m.Path, reports []m.Report) error {
+	return s.write(path, func() error { return s.ReportStore.SaveReports(path, reports) })
+}
+
+func (s *ObjectReportStore) RegenerateIndex(path m.Path) error {
+	return s.write(path, func() error { return s.ReportStore.RegenerateIndex(path) })
+}
+
+func (s *ObjectReportStore) CleanReports(path m.Path, sources []m.Source) error {
+	return s.write(path, func() error { return s.ReportStore.CleanReports(path, sources) })
+}
+
+func (s *ObjectReportStore) RepairIntegrity(path m.Path) error {
+	return s.write(path, func() error { return s.ReportStore.RepairIntegrity(path) })
+}
+
+func (s *ObjectReportStore) RecordRun(path m.Path, at time.Time) error {
+	return s.write(path, func() error { return s.ReportStore.RecordRun(path, at) })
+}
+
+func (s *ObjectReportStore) MarkEquivalent(path m.Path, mutationID string) error {
+	return s.write(path, func() error { return s.ReportStore.MarkEquivalent(path, mutationID) })
+}
+
+func (s *ObjectReportStore) SaveFailure(path m.Path, failure m.RunFailure) error {
+	return s.write(path, func() error { return s.ReportStore.SaveFailure(path, failure) })
+}
+
+func (s *ObjectReportStore) SavePending(path m.Path, sources []m.Path) error {
+	return s.write(path, func() error { return s.ReportStore.SavePending(path, sources) })
+}
+
+func (s *ObjectReportStore) SaveManifest(path m.Path, manifest m.RunManifest, signingKey m.Path) error {
+	return s.write(path, func() error { return s.ReportStore.SaveManifest(path, manifest, signingKey) })
+}
+
+// write restores the reports directory, runs fn on the local copy and
+// uploads the changes below path.
+func (s *ObjectReportStore) write(path m.Path, fn func() error) error {
+	if err := s.restore(path); err != nil {
+		return err
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	return s.upload(path)
+}
+
+// relative returns the slash-separated path of p below the reports
+// directory, "." for the directory itself, and false for paths outside it.
+func (s *ObjectReportStore) relative(p m.Path) (string, bool) {
+	rel, err := filepath.Rel(s.root, filepath.Clean(string(p)))
+	if err != nil || (rel != "." && !filepath.IsLocal(rel)) {
+		return "", false
+	}
+
+	return filepath.ToSlash(rel), true
+}
+
+// key returns the object key of the slash-separated path rel.
+func (s *ObjectReportStore) key(rel string) string {
+	if s.prefix == "" {
+		return rel
+	}
+
+	return s.prefix + "/" + rel
+}
+
+// keyPrefix returns the prefix of the keys below the slash-separated
+// directory rel.
+func (s *ObjectReportStore) keyPrefix(rel string) string {
+	if rel == "." {
+		rel = ""
+	}
+
+	return strings.TrimPrefix(path.Join(s.prefix, rel)+"/", "/")
+}
+
+// restore downloads the objects of the reports directory missing or
+// different locally, once per store.
+func (s *ObjectReportStore) restore(p m.Path) error {
+	if _, ok := s.relative(p); !ok {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.restored {
+		return nil
+	}
+
+	objects, err := s.objects.List(s.keyPrefix("."))
+	if err != nil {
+		return fmt.Errorf("list stored reports: %w", err)
+	}
+
+	for _, object := range objects {
+		rel := strings.TrimPrefix(object.Key, s.keyPrefix("."))
+		if !filepath.IsLocal(filepath.FromSlash(rel)) || strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+
+		local := filepath.Join(s.root, filepath.FromSlash(rel))
+		if sum, err := fileMD5(local); err != nil || sum != object.ETag {
+			if err := s.download(object.Key, local); err != nil {
+				return err
+			}
+		}
+
+		s.remote[object.Key] = object.ETag
+	}
+
+	s.restored = true
+
+	return nil
+}
+
+func (s *ObjectReportStore) download(key, local string) error {
+	data, err := s.objects.Get(key)
+	if err != nil {
+		return fmt.Errorf("download stored report file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(local), 0o750); err != nil {
+		return fmt.Errorf("create reports directory: %w", err)
+	}
+
+	if err := os.WriteFile(local, data, 0o600); err != nil {
+		return fmt.Errorf("write report file %s: %w", local, err)
+	}
+
+	return nil
+}
+
+// upload stores the files below p that changed since they were restored or
+// last uploaded, and deletes the objects of files removed locally. Objects
+// this store never saw, such as another shard's, are left alone.
+func (s *ObjectReportStore) upload(p m.Path) error {
+	rel, ok := s.relative(p)
+	if !ok {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir := filepath.Join(s.root, filepath.FromSlash(rel))
+	seen := make(map[string]bool)
+
+	err := filepath.WalkDir(dir, func(local string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		fileRel, err := filepath.Rel(s.root, local)
+		if err != nil {
+			return err
+		}
+
+		key := s.key(filepath.ToSlash(fileRel))
+		seen[key] = true
+
+		sum, err := fileMD5(local)
+		if err != nil {
+			return err
+		}
+
+		if s.remote[key] == sum {
+			return nil
+		}
+
+		// #nosec G304 -- local is found walking the reports directory
+		data, err := os.ReadFile(local)
+		if err != nil {
+			return fmt.Errorf("read report file %s: %w", local, err)
+		}
+
+		if err := s.objects.Put(key, data); err != nil {
+			return fmt.Errorf("upload report file: %w", err)
+		}
+
+		s.remote[key] = sum
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.deleteRemoved(s.keyPrefix(rel), seen)
+}
+
+func (s *ObjectReportStore) deleteRemoved(keyPrefix string, seen map[string]bool) error {
+	removed := make([]string, 0)
+
+	for key := range s.remote {
+		if strings.HasPrefix(key, keyPrefix) && !seen[key] {
+			removed = append(removed, key)
+		}
+	}
+
+	sort.Strings(removed)
+
+	for _, key := range removed {
+		if err := s.objects.Delete(key); err != nil {
+			return fmt.Errorf("delete stored report file: %w", err)
+		}
+
+		delete(s.remote, key)
+	}
+
+	return nil
+}
+
+// fileMD5 returns the hex MD5 of the file at path, the ETag object stores
+// give it.
+func fileMD5(path string) (string, error) {
+	// #nosec G304 -- path lies in the reports directory
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	sum := md5.Sum(data) // #nosec G401 -- matches object ETags
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,172 @@
+package adapter
+
+import (
+	"crypto/md5" // #nosec G501 -- the fake computes ETags like S3
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// memoryObjects is an ObjectStore holding objects in memory, counting the
+// uploads and downloads.
+type memoryObjects struct {
+	objects map[string][]byte
+	puts    []string
+	gets    []string
+}
+
+func (o *memoryObjects) List(prefix string) ([]ObjectInfo, error) {
+	infos := make([]ObjectInfo, 0)
+
+	for key, data := range o.objects {
+		if strings.HasPrefix(key, prefix) {
+			sum := md5.Sum(data) // #nosec G401 -- ETag
+			infos = append(infos, ObjectInfo{Key: key, ETag: hex.EncodeToString(sum[:]), Size: int64(len(data))})
+		}
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Key < infos[j].Key })
+
+	return infos, nil
+}
+
+func (o *memoryObjects) Get(key string) ([]byte, error) {
+	o.gets = append(o.gets, key)
+	return o.objects[key], nil
+}
+
+func (o *memoryObjects) Put(key string, data []byte) error {
+	o.puts = append(o.puts, key)
+	o.objects[key] = data
+
+	return nil
+}
+
+func (o *memoryObjects) Delete(key string) error {
+	delete(o.objects, key)
+	return nil
+}
+
+func objectTestReport(id string) m.Report {
+	return m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/project/" + id + ".go"), ShortPath: m.Path(id + ".go"), Hash: id}},
+		Result: m.Result{{MutationID: id, Type: m.MutationArithmetic, Status: m.Killed}},
+	}
+}
+
+func TestObjectReportStore_SharesReportsThroughTheBucket(t *testing.T) {
+	objects := &memoryObjects{objects: make(map[string][]byte)}
+
+	// A first runner saves its reports and history.
+	first := filepath.Join(t.TempDir(), "reports")
+	store := NewObjectReportStore(&LocalReportStore{}, m.Path(first), objects, "gooze/main")
+
+	if err := store.SaveReports(m.Path(first), []m.Report{objectTestReport("add")}); err != nil {
+		t.Fatalf("SaveReports() error = %v", err)
+	}
+
+	if err := store.RegenerateIndex(m.Path(first)); err != nil {
+		t.Fatalf("RegenerateIndex() error = %v", err)
+	}
+
+	if err := store.RecordRun(m.Path(first), time.Now()); err != nil {
+		t.Fatalf("RecordRun() error = %v", err)
+	}
+
+	if _, ok := objects.objects["gooze/main/"+indexFileName]; !ok {
+		t.Fatalf("expected the index to be uploaded, got %v", objects.puts)
+	}
+
+	// A fresh runner starts from an empty directory.
+	second := filepath.Join(t.TempDir(), "reports")
+	store = NewObjectReportStore(&LocalReportStore{}, m.Path(second), objects, "gooze/main")
+
+	loaded, err := store.LoadReports(m.Path(second))
+	if err != nil {
+		t.Fatalf("LoadReports() error = %v", err)
+	}
+
+	if len(loaded) != 1 || loaded[0].Source.Origin.Hash != "add" {
+		t.Fatalf("expected the report of the first runner, got %+v", loaded)
+	}
+
+	history, err := store.LoadHistory(m.Path(second))
+	if err != nil || len(history) != 1 {
+		t.Fatalf("expected the history of the first runner, got %+v, %v", history, err)
+	}
+
+	// Rewriting the index uploads nothing new; cleaning deletes the
+	// report's object.
+	objects.puts = nil
+
+	if err := store.RegenerateIndex(m.Path(second)); err != nil {
+		t.Fatalf("RegenerateIndex() error = %v", err)
+	}
+
+	if len(objects.puts) != 0 {
+		t.Fatalf("expected unchanged files not to be uploaded, got %v", objects.puts)
+	}
+
+	if err := store.CleanReports(m.Path(second), []m.Source{objectTestReport("add").Source}); err != nil {
+		t.Fatalf("CleanReports() error = %v", err)
+	}
+
+	for key := range objects.objects {
+		if strings.HasSuffix(key, ".yaml") && !strings.HasPrefix(key, "gooze/main/_") {
+			t.Fatalf("expected the cleaned report to be deleted, found %s", key)
+		}
+	}
+}
+
+func TestObjectReportStore_ShardUploadsLeaveOtherShardsAlone(t *testing.T) {
+	objects := &memoryObjects{objects: map[string][]byte{"ci/shard_1/other.yaml": []byte("shard 1")}}
+
+	root := filepath.Join(t.TempDir(), "reports")
+	store := NewObjectReportStore(&LocalReportStore{}, m.Path(root), objects, "ci")
+
+	shard := filepath.Join(root, "shard_0")
+	if err := store.SaveReports(m.Path(shard), []m.Report{objectTestReport("sub")}); err != nil {
+		t.Fatalf("SaveReports() error = %v", err)
+	}
+
+	// The other shard saves a report after this store restored the bucket.
+	objects.objects["ci/shard_1/late.yaml"] = []byte("late")
+
+	if err := os.RemoveAll(filepath.Join(root, "shard_1")); err != nil {
+		t.Fatalf("remove shard_1: %v", err)
+	}
+
+	if err := store.RegenerateIndex(m.Path(shard)); err != nil {
+		t.Fatalf("RegenerateIndex() error = %v", err)
+	}
+
+	for _, key := range []string{"ci/shard_1/other.yaml", "ci/shard_1/late.yaml"} {
+		if _, ok := objects.objects[key]; !ok {
+			t.Fatalf("expected %s to be left alone", key)
+		}
+	}
+
+	if _, ok := objects.objects["ci/shard_0/"+indexFileName]; !ok {
+		t.Fatalf("expected the shard index to be uploaded, got %v", objects.puts)
+	}
+}
+
+func TestObjectReportStore_PathsOutsideTheReportsDirectoryStayLocal(t *testing.T) {
+	objects := &memoryObjects{objects: make(map[string][]byte)}
+	dir := t.TempDir()
+	store := NewObjectReportStore(&LocalReportStore{}, m.Path(filepath.Join(dir, "reports")), objects, "")
+
+	if err := store.SaveReports(m.Path(filepath.Join(dir, "elsewhere")), []m.Report{objectTestReport("mul")}); err != nil {
+		t.Fatalf("SaveReports() error = %v", err)
+	}
+
+	if len(objects.puts) != 0 || len(objects.objects) != 0 {
+		t.Fatalf("expected nothing to be uploaded, got %v", objects.puts)
+	}
+}
@@ -0,0 +1,354 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// objectStoreTimeout bounds each request to an object store.
+const objectStoreTimeout = 2 * time.Minute
+
+// Object store URL schemes.
+const (
+	objectSchemeS3  = "s3"
+	objectSchemeGCS = "gs"
+)
+
+// defaultGCSEndpoint serves the XML API of Google Cloud Storage, which
+// lists objects like S3 does.
+const defaultGCSEndpoint = "https://storage.googleapis.com"
+
+// ObjectInfo describes a stored object. ETag is the hex MD5 of objects
+// uploaded in one request, which is how Put uploads them.
+type ObjectInfo struct {
+	Key  string
+	ETag string
+	Size int64
+}
+
+// ObjectStore reads and writes the objects of a bucket.
+type ObjectStore interface {
+	List(prefix string) ([]ObjectInfo, error)
+	Get(key string) ([]byte, error)
+	Put(key string, data []byte) error
+	Delete(key string) error
+}
+
+// ObjectStoreConfig selects a bucket and the credentials to access it.
+type ObjectStoreConfig struct {
+	// URL is s3://bucket/prefix or gs://bucket/prefix.
+	URL string
+	// Endpoint overrides the service URL, e.g. for MinIO or another
+	// S3-compatible store, which are then addressed path-style.
+	Endpoint string
+	// Region, AccessKeyID, SecretAccessKey and SessionToken sign S3
+	// requests; Region defaults to us-east-1.
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Token is the OAuth access token sent to Google Cloud Storage.
+	Token string
+}
+
+// httpObjectStore talks to S3 or to the S3-compatible XML API of Google
+// Cloud Storage.
+type httpObjectStore struct {
+	config ObjectStoreConfig
+	bucket string
+	// base is the URL objects are addressed below, the bucket included.
+	base   *url.URL
+	now    func() time.Time
+	client *http.Client
+}
+
+// ParseObjectStoreURL splits an s3:// or gs:// URL into its scheme, bucket
+// and key prefix, the latter without leading or trailing slashes.
+func ParseObjectStoreURL(raw string) (scheme, bucket, prefix string, err error) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", "", "", fmt.Errorf("parse report store URL %q: %w", raw, err)
+	}
+
+	if parsed.Scheme != objectSchemeS3 && parsed.Scheme != objectSchemeGCS {
+		return "", "", "", fmt.Errorf("report store URL %q: want s3://bucket/prefix or gs://bucket/prefix", raw)
+	}
+
+	if parsed.Host == "" {
+		return "", "", "", fmt.Errorf("report store URL %q has no bucket", raw)
+	}
+
+	return parsed.Scheme, parsed.Host, strings.Trim(parsed.Path, "/"), nil
+}
+
+// NewObjectStore constructs the ObjectStore of config.URL, failing when the
+// credentials its scheme needs are missing.
+func NewObjectStore(config ObjectStoreConfig) (ObjectStore, error) {
+	scheme, bucket, _, err := ParseObjectStoreURL(config.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	endpoint := config.Endpoint
+	pathStyle := endpoint != ""
+
+	switch scheme {
+	case objectSchemeS3:
+		if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+			return nil, fmt.Errorf("s3 report store: AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are required")
+		}
+
+		config.Token = ""
+
+		if endpoint == "" {
+			endpoint = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucket, config.Region)
+		}
+	case objectSchemeGCS:
+		if config.Token == "" {
+			return nil, fmt.Errorf("gs report store: GOOGLE_OAUTH_ACCESS_TOKEN is required")
+		}
+
+		if endpoint == "" {
+			endpoint = defaultGCSEndpoint
+			pathStyle = true
+		}
+	}
+
+	base, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parse object store endpoint %q: %w", endpoint, err)
+	}
+
+	if pathStyle {
+		base.Path += "/" + bucket
+	}
+
+	return &httpObjectStore{
+		config: config,
+		bucket: bucket,
+		base:   base,
+		now:    time.Now,
+		client: &http.Client{Timeout: objectStoreTimeout},
+	}, nil
+}
+
+// listBucketResult is the ListObjectsV2 response of S3 and Cloud Storage.
+type listBucketResult struct {
+	Contents []struct {
+		Key  string `xml:"Key"`
+		ETag string `xml:"ETag"`
+		Size int64  `xml:"Size"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *httpObjectStore) List(prefix string) ([]ObjectInfo, error) {
+	objects := make([]ObjectInfo, 0)
+	token := ""
+
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		data, err := s.do(http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page listBucketResult
+		if err := xml.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("decode object listing of %s: %w", s.bucket, err)
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, ObjectInfo{Key: object.Key, ETag: strings.Trim(object.ETag, `"`), Size: object.Size})
+		}
+
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return objects, nil
+		}
+
+		token = page.NextContinuationToken
+	}
+}
+
+func (s *httpObjectStore) Get(key string) ([]byte, error) {
+	return s.do(http.MethodGet, key, nil, nil)
+}
+
+func (s *httpObjectStore) Put(key string, data []byte) error {
+	_, err := s.do(http.MethodPut, key, nil, data)
+	return err
+}
+
+func (s *httpObjectStore) Delete(key string) error {
+	_, err := s.do(http.MethodDelete, key, nil, nil)
+	return err
+}
+
+// do sends a request for key, or for the bucket when key is empty, and
+// returns the response body of a 2xx response.
+func (s *httpObjectStore) do(method, key string, query url.Values, body []byte) ([]byte, error) {
+	target := *s.base
+	if key != "" {
+		target.Path += "/" + key
+	} else if target.Path == "" {
+		target.Path = "/"
+	}
+
+	target.RawPath = uriEncodePath(target.Path)
+	target.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(context.Background(), method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.ContentLength = int64(len(body))
+
+	if s.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.config.Token)
+	} else {
+		s.sign(req, body)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, objectName(s.bucket, key), err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: read response: %w", method, objectName(s.bucket, key), err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Code    string `xml:"Code"`
+			Message string `xml:"Message"`
+		}
+
+		if xml.Unmarshal(data, &apiErr) == nil && apiErr.Code != "" {
+			return nil, fmt.Errorf("%s %s: %s: %s: %s", method, objectName(s.bucket, key), resp.Status, apiErr.Code, apiErr.Message)
+		}
+
+		return nil, fmt.Errorf("%s %s: %s", method, objectName(s.bucket, key), resp.Status)
+	}
+
+	return data, nil
+}
+
+func objectName(bucket, key string) string {
+	return bucket + "/" + key
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req.
+func (s *httpObjectStore) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	if s.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.config.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, hex.EncodeToString(hmacSHA256(key, stringToSign))))
+}
+
+// canonicalQuery encodes query sorted by key with spaces as %20, as
+// signing requires.
+func canonicalQuery(query url.Values) string {
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+// uriEncodePath escapes every byte of path but unreserved characters and
+// slashes, which is the encoding signatures are computed over.
+func uriEncodePath(path string) string {
+	var out strings.Builder
+
+	for i := range len(path) {
+		c := path[i]
+		if c == '/' || c == '-' || c == '_' || c == '.' || c == '~' ||
+			('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') {
+			out.WriteByte(c)
+			continue
+		}
+
+		fmt.Fprintf(&out, "%%%02X", c)
+	}
+
+	return out.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
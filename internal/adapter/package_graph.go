@@ -0,0 +1,79 @@
+package adapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// goListPackage holds the go list -json fields ListPackages reads.
+type goListPackage struct {
+	ImportPath   string
+	Dir          string
+	Imports      []string
+	TestImports  []string
+	XTestImports []string
+	TestGoFiles  []string
+	XTestGoFiles []string
+}
+
+// ListPackages runs go list over every package under root. Packages that
+// fail to load are still listed with whatever go list could resolve.
+func (a *LocalSourceFSAdapter) ListPackages(root m.Path, tags []string) ([]m.Package, error) {
+	args := []string{"list", "-e", "-json"}
+	if len(tags) > 0 {
+		args = append(args, "-tags", strings.Join(tags, ","))
+	}
+
+	cmd := exec.Command("go", append(args, "./...")...)
+	cmd.Dir = string(root)
+
+	var stdout, stderr bytes.Buffer
+
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrGoToolchainNotFound, err)
+		}
+
+		return nil, fmt.Errorf("go list in %s: %w: %s", root, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return decodeGoList(&stdout)
+}
+
+// decodeGoList reads the stream of JSON objects go list -json prints.
+func decodeGoList(r io.Reader) ([]m.Package, error) {
+	var packages []m.Package
+
+	decoder := json.NewDecoder(r)
+
+	for {
+		var pkg goListPackage
+
+		err := decoder.Decode(&pkg)
+		if errors.Is(err, io.EOF) {
+			return packages, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("decode go list output: %w", err)
+		}
+
+		packages = append(packages, m.Package{
+			ImportPath:  pkg.ImportPath,
+			Dir:         m.Path(pkg.Dir),
+			Imports:     pkg.Imports,
+			TestImports: append(pkg.TestImports, pkg.XTestImports...),
+			HasTests:    len(pkg.TestGoFiles) > 0 || len(pkg.XTestGoFiles) > 0,
+		})
+	}
+}
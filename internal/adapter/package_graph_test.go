@@ -0,0 +1,54 @@
+package adapter
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalSourceFSAdapter_ListPackages(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "go.mod"), "module example.com/graph\n\ngo 1.21\n")
+
+	for _, dir := range []string{"store", "api", "e2e"} {
+		mustMkdir(t, filepath.Join(root, dir))
+	}
+
+	writeTestFile(t, filepath.Join(root, "store", "store.go"), "package store\n\nfunc Get() int { return 1 }\n")
+	writeTestFile(t, filepath.Join(root, "api", "api.go"), "package api\n\nimport \"example.com/graph/store\"\n\nfunc Handle() int { return store.Get() }\n")
+	writeTestFile(t, filepath.Join(root, "e2e", "e2e.go"), "package e2e\n")
+	writeTestFile(t, filepath.Join(root, "e2e", "e2e_test.go"),
+		"package e2e_test\n\nimport (\n\t\"testing\"\n\n\t\"example.com/graph/api\"\n)\n\nfunc TestHandle(t *testing.T) { _ = api.Handle() }\n")
+
+	adapter := NewLocalSourceFSAdapter()
+
+	packages, err := adapter.ListPackages(m.Path(root), nil)
+	if err != nil {
+		t.Fatalf("ListPackages() error = %v", err)
+	}
+
+	byPath := map[string]m.Package{}
+	for _, pkg := range packages {
+		byPath[pkg.ImportPath] = pkg
+	}
+
+	if len(byPath) != 3 {
+		t.Fatalf("ListPackages() = %+v, want 3 packages", packages)
+	}
+
+	api := byPath["example.com/graph/api"]
+	if len(api.Imports) != 1 || api.Imports[0] != "example.com/graph/store" || api.HasTests {
+		t.Fatalf("api package = %+v, want it to import store and have no tests", api)
+	}
+
+	e2e := byPath["example.com/graph/e2e"]
+	if !e2e.HasTests || !slices.Contains(e2e.TestImports, "example.com/graph/api") {
+		t.Fatalf("e2e package = %+v, want external test imports to include api", e2e)
+	}
+
+	if dir := byPath["example.com/graph/store"].Dir; dir != m.Path(filepath.Join(root, "store")) {
+		t.Fatalf("store dir = %q, want it under %q", dir, root)
+	}
+}
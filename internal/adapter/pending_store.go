@@ -0,0 +1,109 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const pendingFileName = "_pending.yaml"
+
+type pendingYAML struct {
+	Sources []string `yaml:"sources"`
+}
+
+// SavePending writes `_pending.yaml` listing the sources a run is still
+// testing. Reports are saved as mutations complete, so after a crash the
+// list tells CheckUpdates which sources the saved reports cover only in
+// part. Saving no sources removes the file.
+func (rs *LocalReportStore) SavePending(path m.Path, sources []m.Path) error {
+	dirPath := string(path)
+	if dirPath == "" {
+		return fmt.Errorf("reports directory path is required")
+	}
+
+	pendingPath := filepath.Join(dirPath, pendingFileName)
+
+	if len(sources) == 0 {
+		if err := os.Remove(pendingPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove pending file %s: %w", pendingPath, err)
+		}
+
+		return nil
+	}
+
+	if err := os.MkdirAll(dirPath, 0o750); err != nil {
+		return fmt.Errorf("create reports directory: %w", err)
+	}
+
+	pending := pendingYAML{Sources: make([]string, 0, len(sources))}
+	for _, source := range sources {
+		pending.Sources = append(pending.Sources, string(source))
+	}
+
+	data, err := yaml.Marshal(pending)
+	if err != nil {
+		return fmt.Errorf("marshal pending YAML: %w", err)
+	}
+
+	return writeFileSynced(pendingPath, data)
+}
+
+// readPending returns the sources `_pending.yaml` lists, none when it does
+// not exist.
+func (rs *LocalReportStore) readPending(dirPath string) (map[string]bool, error) {
+	pendingPath := filepath.Join(dirPath, pendingFileName)
+
+	// #nosec G304 -- pendingPath lies in the reports directory
+	data, err := os.ReadFile(pendingPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]bool{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read pending file %s: %w", pendingPath, err)
+	}
+
+	var pending pendingYAML
+	if err := yaml.Unmarshal(data, &pending); err != nil {
+		return nil, fmt.Errorf("unmarshal pending file %s: %w", pendingPath, err)
+	}
+
+	sources := make(map[string]bool, len(pending.Sources))
+	for _, source := range pending.Sources {
+		sources[source] = true
+	}
+
+	return sources, nil
+}
+
+// writeFileSynced writes data to filePath and flushes it to disk before
+// returning, so the file survives a crash of the machine.
+func writeFileSynced(filePath string, data []byte) error {
+	// #nosec G304 -- filePath lies in the reports directory
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("write file %s: %w", filePath, err)
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("write file %s: %w", filePath, err)
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("sync file %s: %w", filePath, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close file %s: %w", filePath, err)
+	}
+
+	return nil
+}
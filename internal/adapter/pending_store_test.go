@@ -0,0 +1,76 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_CheckUpdates_PendingSources_ReturnsSource(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	done := m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "a"}}
+	partial := m.Source{Origin: &m.File{FullPath: m.Path("/abs/b.go"), Hash: "b"}}
+	reports := []m.Report{
+		{Source: done, Result: m.Result{{MutationID: "a1", Type: m.MutationBoolean, Status: m.Killed}}},
+		{Source: partial, Result: m.Result{{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed}}},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), reports); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if err := rs.SavePending(m.Path(dir), []m.Path{"/abs/b.go"}); err != nil {
+		t.Fatalf("SavePending returned error: %v", err)
+	}
+
+	changed, err := rs.CheckUpdates(m.Path(dir), []m.Source{done, partial})
+	if err != nil {
+		t.Fatalf("CheckUpdates returned error: %v", err)
+	}
+
+	if len(changed) != 1 || changed[0].Origin.FullPath != "/abs/b.go" {
+		t.Fatalf("expected only the pending source to be returned, got %#v", changed)
+	}
+
+	loaded, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(loaded) != 2 {
+		t.Fatalf("expected the pending file not to load as a report, got %d reports", len(loaded))
+	}
+}
+
+func TestLocalReportStore_SavePending_NoSourcesRemovesFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	if err := rs.SavePending(m.Path(dir), []m.Path{"/abs/a.go"}); err != nil {
+		t.Fatalf("SavePending returned error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, pendingFileName)); err != nil {
+		t.Fatalf("expected %s: %v", pendingFileName, err)
+	}
+
+	if err := rs.SavePending(m.Path(dir), nil); err != nil {
+		t.Fatalf("SavePending returned error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, pendingFileName)); !os.IsNotExist(err) {
+		t.Fatalf("expected %s to be removed, got %v", pendingFileName, err)
+	}
+
+	if err := rs.SavePending(m.Path(dir), nil); err != nil {
+		t.Fatalf("clearing again returned error: %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+package adapter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const planVersion = 1
+
+type planYAML struct {
+	Version            int                   `yaml:"version"`
+	Time               time.Time             `yaml:"time"`
+	Paths              []string              `yaml:"paths"`
+	Exclude            []string              `yaml:"exclude,omitempty"`
+	Tags               []string              `yaml:"tags,omitempty"`
+	IncludeTestHelpers bool                  `yaml:"include_test_helpers,omitempty"`
+	Mutations          []plannedMutationYAML `yaml:"mutations"`
+}
+
+type plannedMutationYAML struct {
+	ID   string `yaml:"id"`
+	File string `yaml:"file"`
+	Type string `yaml:"type"`
+	Line int    `yaml:"line,omitempty"`
+}
+
+// SavePlan writes plan as YAML to the file at path, creating its directory.
+func (rs *LocalReportStore) SavePlan(path m.Path, plan m.MutationPlan) error {
+	filePath := string(path)
+	if filePath == "" {
+		return fmt.Errorf("plan file path is required")
+	}
+
+	out := planYAML{
+		Version:            planVersion,
+		Time:               plan.Time.UTC(),
+		Exclude:            plan.Exclude,
+		Tags:               plan.Tags,
+		IncludeTestHelpers: plan.IncludeTestHelpers,
+		Mutations:          make([]plannedMutationYAML, 0, len(plan.Mutations)),
+	}
+
+	for _, p := range plan.Paths {
+		out.Paths = append(out.Paths, string(p))
+	}
+
+	for _, mutation := range plan.Mutations {
+		out.Mutations = append(out.Mutations, plannedMutationYAML{
+			ID:   mutation.ID,
+			File: string(mutation.File),
+			Type: mutation.Type,
+			Line: mutation.Line,
+		})
+	}
+
+	data, err := yaml.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("marshal plan YAML: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+		return fmt.Errorf("create plan directory: %w", err)
+	}
+
+	if err := os.WriteFile(filePath, data, 0o600); err != nil {
+		return fmt.Errorf("write plan %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+// LoadPlan reads the plan SavePlan wrote to the file at path.
+func (rs *LocalReportStore) LoadPlan(path m.Path) (m.MutationPlan, error) {
+	filePath := string(path)
+
+	// #nosec G304 -- the plan file is named by the user
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return m.MutationPlan{}, fmt.Errorf("read plan %s: %w", filePath, err)
+	}
+
+	var in planYAML
+	if err := yaml.Unmarshal(data, &in); err != nil {
+		return m.MutationPlan{}, fmt.Errorf("parse plan %s: %w", filePath, err)
+	}
+
+	if in.Version != planVersion {
+		return m.MutationPlan{}, fmt.Errorf("plan %s has version %d, want %d", filePath, in.Version, planVersion)
+	}
+
+	plan := m.MutationPlan{
+		Time:               in.Time,
+		Exclude:            in.Exclude,
+		Tags:               in.Tags,
+		IncludeTestHelpers: in.IncludeTestHelpers,
+		Mutations:          make([]m.PlannedMutation, 0, len(in.Mutations)),
+	}
+
+	for _, p := range in.Paths {
+		plan.Paths = append(plan.Paths, m.Path(p))
+	}
+
+	for _, mutation := range in.Mutations {
+		plan.Mutations = append(plan.Mutations, m.PlannedMutation{
+			ID:   mutation.ID,
+			File: m.Path(mutation.File),
+			Type: mutation.Type,
+			Line: mutation.Line,
+		})
+	}
+
+	return plan, nil
+}
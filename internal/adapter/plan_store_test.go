@@ -0,0 +1,57 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestLocalReportStore_SavePlan_RoundTrips(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "ci", "plan.yaml")
+	rs := &LocalReportStore{}
+
+	plan := m.MutationPlan{
+		Time:    time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+		Paths:   []m.Path{"./..."},
+		Exclude: []string{`_gen\.go$`},
+		Tags:    []string{"integration"},
+		Mutations: []m.PlannedMutation{
+			{ID: "a1", File: "calc/add.go", Type: "arithmetic", Line: 3},
+			{ID: "b2", File: "calc/add.go", Type: "boolean", Line: 7},
+		},
+	}
+
+	if err := rs.SavePlan(m.Path(path), plan); err != nil {
+		t.Fatalf("SavePlan returned error: %v", err)
+	}
+
+	got, err := rs.LoadPlan(m.Path(path))
+	if err != nil {
+		t.Fatalf("LoadPlan returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, plan) {
+		t.Fatalf("LoadPlan = %+v, want %+v", got, plan)
+	}
+}
+
+func TestLocalReportStore_LoadPlan_RejectsOtherVersions(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "plan.yaml")
+	if err := os.WriteFile(path, []byte("version: 2\nmutations: []\n"), 0o600); err != nil {
+		t.Fatalf("write plan: %v", err)
+	}
+
+	_, err := (&LocalReportStore{}).LoadPlan(m.Path(path))
+	if err == nil || !strings.Contains(err.Error(), "has version 2, want 1") {
+		t.Fatalf("LoadPlan error = %v, want a version error", err)
+	}
+}
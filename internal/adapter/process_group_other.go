@@ -0,0 +1,9 @@
+//go:build !unix
+
+package adapter
+
+import "os/exec"
+
+// detachFromTerminal leaves cmd in gooze's process group where process
+// groups are not available.
+func detachFromTerminal(*exec.Cmd) {}
@@ -0,0 +1,15 @@
+//go:build unix
+
+package adapter
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detachFromTerminal starts cmd in its own process group, so the SIGINT a
+// terminal sends on Ctrl+C reaches gooze but not the tests it is waiting
+// for; an interrupted run lets them finish.
+func detachFromTerminal(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
@@ -0,0 +1,146 @@
+package adapter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// reportBackend persists the reports of a reports directory. The index, the
+// history and the other `_*.yaml` files stay plain files whichever backend
+// holds the reports.
+type reportBackend interface {
+	// read returns every stored report. A report that cannot be decoded is
+	// returned with its error rather than failing the whole read.
+	read(dirPath string) ([]storedReport, error)
+	// write stores reports under their names, replacing reports of the same
+	// name.
+	write(dirPath string, reports []storedReport) error
+	remove(dirPath string, names []string) error
+	rename(dirPath, from, to string) error
+	// setAside keeps a report that cannot be decoded out of later reads.
+	setAside(dirPath, name string) error
+}
+
+// storedReport is a report with the name it is stored under: the hash of
+// its results with a .yaml suffix, for the index to refer to it by.
+type storedReport struct {
+	name   string
+	report m.Report
+	err    error
+}
+
+// reportName is the name a report with result is stored under, empty for a
+// report without results.
+func (rs *LocalReportStore) reportName(result m.Result) string {
+	hash := rs.computeReportHash(result)
+	if hash == "" {
+		return ""
+	}
+
+	return hash + ".yaml"
+}
+
+// reports returns the backend holding the reports, one YAML file per report
+// unless the store was built for another.
+func (rs *LocalReportStore) reports() reportBackend {
+	if rs.backend == nil {
+		return yamlReportBackend{rs: rs}
+	}
+
+	return rs.backend
+}
+
+// decodedReports returns the reports of storedReports, failing on the first
+// one that could not be decoded.
+func decodedReports(stored []storedReport) ([]m.Report, error) {
+	reports := make([]m.Report, 0, len(stored))
+
+	for _, entry := range stored {
+		if entry.err != nil {
+			return nil, fmt.Errorf("unmarshal report %s: %w", entry.name, entry.err)
+		}
+
+		reports = append(reports, entry.report)
+	}
+
+	return reports, nil
+}
+
+// yamlReportBackend stores each report as a YAML file named after it.
+type yamlReportBackend struct {
+	rs *LocalReportStore
+}
+
+func (b yamlReportBackend) read(dirPath string) ([]storedReport, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("read reports directory: %w", err)
+	}
+
+	stored := make([]storedReport, 0)
+
+	for _, entry := range entries {
+		if !b.rs.shouldLoadReportEntry(entry) {
+			continue
+		}
+
+		filePath := filepath.Join(dirPath, entry.Name())
+		// #nosec G304 -- filePath is built from a trusted reports directory listing
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("read report file %s: %w", filePath, err)
+		}
+
+		report, err := b.rs.unmarshalReport(data)
+		stored = append(stored, storedReport{name: entry.Name(), report: report, err: err})
+	}
+
+	return stored, nil
+}
+
+func (b yamlReportBackend) write(dirPath string, reports []storedReport) error {
+	for _, entry := range reports {
+		data, err := b.rs.marshalReport(entry.report)
+		if err != nil {
+			return fmt.Errorf("marshal report to YAML: %w", err)
+		}
+
+		if err := writeFileSynced(filepath.Join(dirPath, entry.name), data); err != nil {
+			return fmt.Errorf("write report: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (b yamlReportBackend) remove(dirPath string, names []string) error {
+	for _, name := range names {
+		filePath := filepath.Join(dirPath, name)
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove report file %s: %w", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+func (b yamlReportBackend) rename(dirPath, from, to string) error {
+	if err := os.Rename(filepath.Join(dirPath, from), filepath.Join(dirPath, to)); err != nil {
+		return fmt.Errorf("rename report %s: %w", from, err)
+	}
+
+	return nil
+}
+
+// setAside renames the file with a .corrupt suffix, which loading skips.
+func (b yamlReportBackend) setAside(dirPath, name string) error {
+	from := filepath.Join(dirPath, name)
+	if err := os.Rename(from, from+corruptSuffix); err != nil {
+		return fmt.Errorf("set aside corrupt report %s: %w", from, err)
+	}
+
+	return nil
+}
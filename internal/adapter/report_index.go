@@ -0,0 +1,259 @@
+package adapter
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// indexShardDirName is the subdirectory of the reports directory holding one
+// index shard per package, so readers interested in a single package do not
+// have to parse the index of the whole project.
+const indexShardDirName = "_index"
+
+// indexPackageEntry lists a package in `_index.yaml` with its totals and the
+// slash-separated path of its shard, relative to the reports directory.
+type indexPackageEntry struct {
+	Package     string `yaml:"package"`
+	Shard       string `yaml:"shard"`
+	indexCounts `yaml:",inline"`
+}
+
+// indexShard is the index of the reports of one package.
+type indexShard struct {
+	Package     string `yaml:"package"`
+	indexCounts `yaml:",inline"`
+	Result      []resultEntry `yaml:"result"`
+}
+
+func (c *indexCounts) add(other indexCounts) {
+	c.TotalMutations += other.TotalMutations
+	c.KilledMutations += other.KilledMutations
+	c.SurvivedMutations += other.SurvivedMutations
+	c.FailedMutations += other.FailedMutations
+	c.IgnoredMutations += other.IgnoredMutations
+	c.SuppressedMutations += other.SuppressedMutations
+	c.EquivalentMutations += other.EquivalentMutations
+	c.NotRunMutations += other.NotRunMutations
+	c.FlakyMutations += other.FlakyMutations
+	c.CachedMutations += other.CachedMutations
+	c.CompileErrorMutations += other.CompileErrorMutations
+	c.TotalDuration += other.TotalDuration
+}
+
+func (c indexCounts) model() m.IndexCounts {
+	return m.IndexCounts{
+		Total:         c.TotalMutations,
+		Killed:        c.KilledMutations,
+		Survived:      c.SurvivedMutations,
+		Failed:        c.FailedMutations,
+		Ignored:       c.IgnoredMutations,
+		Suppressed:    c.SuppressedMutations,
+		Equivalent:    c.EquivalentMutations,
+		NotRun:        c.NotRunMutations,
+		Flaky:         c.FlakyMutations,
+		Cached:        c.CachedMutations,
+		CompileErrors: c.CompileErrorMutations,
+		Duration:      c.TotalDuration,
+	}
+}
+
+// writeIndexForReports writes `_index.yaml` and the package shards for
+// reports, replacing any previous shards. Without reports both are removed.
+func (rs *LocalReportStore) writeIndexForReports(dirPath string, reports []m.Report) error {
+	indexPath := filepath.Join(dirPath, indexFileName)
+
+	shardDir := filepath.Join(dirPath, indexShardDirName)
+	if err := os.RemoveAll(shardDir); err != nil {
+		return fmt.Errorf("remove index shards %s: %w", shardDir, err)
+	}
+
+	if len(reports) == 0 {
+		_ = os.Remove(indexPath)
+		return nil
+	}
+
+	if err := os.MkdirAll(shardDir, 0o750); err != nil {
+		return fmt.Errorf("create index shard directory: %w", err)
+	}
+
+	byPackage := make(map[string][]m.Report)
+	for _, report := range reports {
+		pkg := reportPackage(report.Source)
+		byPackage[pkg] = append(byPackage[pkg], report)
+	}
+
+	packages := make([]string, 0, len(byPackage))
+	for pkg := range byPackage {
+		packages = append(packages, pkg)
+	}
+
+	sort.Strings(packages)
+
+	index := indexEntry{Packages: make([]indexPackageEntry, 0, len(packages))}
+
+	for _, pkg := range packages {
+		shard := rs.buildIndexFromReports(byPackage[pkg])
+		shard.Package = pkg
+
+		shardPath := path.Join(indexShardDirName, shardFileName(pkg))
+		if err := writeIndexYAML(filepath.Join(dirPath, filepath.FromSlash(shardPath)), shard); err != nil {
+			return err
+		}
+
+		index.add(shard.indexCounts)
+		index.Packages = append(index.Packages, indexPackageEntry{
+			Package:     pkg,
+			Shard:       shardPath,
+			indexCounts: shard.indexCounts,
+		})
+	}
+
+	index.Partial = index.NotRunMutations > 0
+
+	return writeIndexYAML(indexPath, index)
+}
+
+func writeIndexYAML(filePath string, value any) error {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshal index YAML: %w", err)
+	}
+
+	if err := os.WriteFile(filePath, data, 0o600); err != nil {
+		return fmt.Errorf("write index file %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+// reportPackage is the slash-separated directory a report's source lives
+// in, preferring its project-relative path.
+func reportPackage(source m.Source) string {
+	if source.Origin == nil {
+		return "."
+	}
+
+	return path.Dir(filepath.ToSlash(string(source.Origin.DisplayPath())))
+}
+
+// shardFileName names a package shard after a hash of the package, which
+// keeps the name flat and free of characters special to file systems.
+func shardFileName(pkg string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(pkg)))[:16] + ".yaml"
+}
+
+// IndexPath returns the path of the `_index.yaml` summary of the reports
+// directory dir. It is rewritten every time reports are saved there.
+func IndexPath(dir m.Path) m.Path {
+	return m.Path(filepath.Join(string(dir), indexFileName))
+}
+
+// LoadIndex reads the `_index.yaml` summary of the reports in path: the
+// totals and the totals of each package, without their report lists.
+func (rs *LocalReportStore) LoadIndex(path m.Path) (m.ReportIndex, error) {
+	index, err := rs.readIndex(string(path))
+	if err != nil {
+		return m.ReportIndex{}, err
+	}
+
+	out := m.ReportIndex{
+		IndexCounts: index.model(),
+		Partial:     index.Partial,
+		Packages:    make([]m.PackageIndex, 0, len(index.Packages)),
+	}
+
+	for _, pkg := range index.Packages {
+		out.Packages = append(out.Packages, m.PackageIndex{Package: pkg.Package, IndexCounts: pkg.model()})
+	}
+
+	return out, nil
+}
+
+// LoadPackageIndex reads the index shard of package pkg, as listed by
+// LoadIndex, including the report files holding its results.
+func (rs *LocalReportStore) LoadPackageIndex(path m.Path, pkg string) (m.PackageIndex, error) {
+	dirPath := string(path)
+
+	index, err := rs.readIndex(dirPath)
+	if err != nil {
+		return m.PackageIndex{}, err
+	}
+
+	for _, entry := range index.Packages {
+		if entry.Package != pkg {
+			continue
+		}
+
+		shardPath := filepath.Join(dirPath, filepath.FromSlash(entry.Shard))
+		// #nosec G304 -- shardPath comes from the index of a trusted reports directory
+		data, err := os.ReadFile(shardPath)
+		if err != nil {
+			return m.PackageIndex{}, fmt.Errorf("read index shard %s: %w", shardPath, err)
+		}
+
+		var shard indexShard
+		if err := yaml.Unmarshal(data, &shard); err != nil {
+			return m.PackageIndex{}, fmt.Errorf("unmarshal index shard %s: %w", shardPath, err)
+		}
+
+		return m.PackageIndex{
+			Package:     shard.Package,
+			IndexCounts: shard.model(),
+			Reports:     shardReports(shard),
+		}, nil
+	}
+
+	return m.PackageIndex{}, fmt.Errorf("package %s not found in %s", pkg, filepath.Join(dirPath, indexFileName))
+}
+
+func (rs *LocalReportStore) readIndex(dirPath string) (indexEntry, error) {
+	if dirPath == "" {
+		return indexEntry{}, fmt.Errorf("reports directory path is required")
+	}
+
+	indexPath := filepath.Join(dirPath, indexFileName)
+
+	// #nosec G304 -- indexPath is built from the reports directory
+	data, err := os.ReadFile(indexPath)
+	if err != nil {
+		return indexEntry{}, fmt.Errorf("read index %s: %w", indexPath, err)
+	}
+
+	var index indexEntry
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return indexEntry{}, fmt.Errorf("unmarshal index %s: %w", indexPath, err)
+	}
+
+	return index, nil
+}
+
+// shardReports lists the distinct report files a shard refers to, sorted.
+func shardReports(shard indexShard) []m.Path {
+	seen := make(map[string]bool)
+	reports := make([]m.Path, 0)
+
+	for _, result := range shard.Result {
+		for _, mutation := range result.Mutations {
+			for _, report := range mutation.MutationReports {
+				if seen[report] {
+					continue
+				}
+
+				seen[report] = true
+				reports = append(reports, m.Path(report))
+			}
+		}
+	}
+
+	sort.Slice(reports, func(i, j int) bool { return reports[i] < reports[j] })
+
+	return reports
+}
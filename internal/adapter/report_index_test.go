@@ -0,0 +1,152 @@
+package adapter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func readIndexShard(t *testing.T, dir string, entry indexPackageEntry) indexShard {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(entry.Shard)))
+	if err != nil {
+		t.Fatalf("read index shard %s: %v", entry.Shard, err)
+	}
+
+	var shard indexShard
+	if err := yaml.Unmarshal(data, &shard); err != nil {
+		t.Fatalf("unmarshal index shard %s: %v", entry.Shard, err)
+	}
+
+	return shard
+}
+
+func TestLocalReportStore_RegenerateIndex_ShardsByPackage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	calc := m.Report{
+		Source: m.Source{Origin: &m.File{ShortPath: "pkg/calc/calc.go", FullPath: "/abs/pkg/calc/calc.go", Hash: "calc"}},
+		Result: m.Result{
+			{MutationID: "c1", Type: m.MutationArithmetic, Status: m.Killed},
+			{MutationID: "c2", Type: m.MutationArithmetic, Status: m.Survived},
+		},
+	}
+	main := m.Report{
+		Source: m.Source{Origin: &m.File{ShortPath: "main.go", FullPath: "/abs/main.go", Hash: "main"}},
+		Result: m.Result{{MutationID: "m1", Type: m.MutationBoolean, Status: m.Killed}},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{calc, main}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	index, err := rs.LoadIndex(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadIndex returned error: %v", err)
+	}
+
+	if index.Total != 3 || index.Killed != 2 || index.Survived != 1 {
+		t.Fatalf("unexpected totals: %+v", index.IndexCounts)
+	}
+
+	want := []m.PackageIndex{
+		{Package: ".", IndexCounts: m.IndexCounts{Total: 1, Killed: 1}},
+		{Package: "pkg/calc", IndexCounts: m.IndexCounts{Total: 2, Killed: 1, Survived: 1}},
+	}
+	if !reflect.DeepEqual(index.Packages, want) {
+		t.Fatalf("packages = %+v, want %+v", index.Packages, want)
+	}
+
+	pkg, err := rs.LoadPackageIndex(m.Path(dir), "pkg/calc")
+	if err != nil {
+		t.Fatalf("LoadPackageIndex returned error: %v", err)
+	}
+
+	if pkg.Score() != 0.5 {
+		t.Fatalf("expected pkg/calc score 0.5, got %v", pkg.Score())
+	}
+
+	wantReports := []m.Path{m.Path(rs.computeReportHash(calc.Result) + ".yaml")}
+	if !reflect.DeepEqual(pkg.Reports, wantReports) {
+		t.Fatalf("reports = %v, want %v", pkg.Reports, wantReports)
+	}
+}
+
+func TestLocalReportStore_RegenerateIndex_RemovesStaleShards(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	stale := filepath.Join(dir, indexShardDirName, "stale.yaml")
+	if err := os.MkdirAll(filepath.Dir(stale), 0o750); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(stale, []byte("package: gone\n"), 0o600); err != nil {
+		t.Fatalf("write stale shard: %v", err)
+	}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{ShortPath: "a.go", FullPath: "/abs/a.go", Hash: "a"}},
+		Result: m.Result{{MutationID: "a1", Type: m.MutationBoolean, Status: m.Killed}},
+	}
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	if _, err := os.Stat(stale); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected stale shard to be removed, got %v", err)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected shards to be ignored when loading reports, got %d reports", len(reports))
+	}
+}
+
+func TestLocalReportStore_LoadPackageIndex_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	if _, err := rs.LoadPackageIndex(m.Path(dir), "pkg"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected a missing index to wrap os.ErrNotExist, got %v", err)
+	}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{ShortPath: "a.go", FullPath: "/abs/a.go", Hash: "a"}},
+		Result: m.Result{{MutationID: "a1", Type: m.MutationBoolean, Status: m.Killed}},
+	}
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	if _, err := rs.LoadPackageIndex(m.Path(dir), "pkg"); err == nil {
+		t.Fatalf("expected an error for a package missing from the index")
+	}
+}
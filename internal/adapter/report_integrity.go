@@ -0,0 +1,242 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// corruptSuffix is appended to report files RepairIntegrity sets aside, so
+// loading the reports no longer picks them up.
+const corruptSuffix = ".corrupt"
+
+// integrityScan is what CheckIntegrity learns about the report files.
+type integrityScan struct {
+	report m.IntegrityReport
+	// files holds every report file, corrupt ones included.
+	files map[string]bool
+	// corrupt and misnamed hold the report files RepairIntegrity acts on,
+	// misnamed ones mapped to the name their results hash to.
+	corrupt  []string
+	misnamed map[string]string
+}
+
+// CheckIntegrity looks for report files that are corrupt, not listed in the
+// index or misnamed, for index entries pointing at missing files and for
+// mutation IDs stored in more than one report file.
+func (rs *LocalReportStore) CheckIntegrity(path m.Path) (m.IntegrityReport, error) {
+	scan, err := rs.scanIntegrity(string(path))
+	if err != nil {
+		return m.IntegrityReport{}, err
+	}
+
+	return scan.report, nil
+}
+
+// RepairIntegrity sets corrupt report files aside with a .corrupt suffix,
+// renames misnamed ones after the hash of their results unless another file
+// holds that name, and regenerates the index.
+func (rs *LocalReportStore) RepairIntegrity(path m.Path) error {
+	dirPath := string(path)
+
+	scan, err := rs.scanIntegrity(dirPath)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range scan.corrupt {
+		if err := rs.reports().setAside(dirPath, name); err != nil {
+			return err
+		}
+	}
+
+	for name, want := range scan.misnamed {
+		if scan.files[want] {
+			continue
+		}
+
+		if err := rs.reports().rename(dirPath, name, want); err != nil {
+			return err
+		}
+	}
+
+	return rs.RegenerateIndex(path)
+}
+
+func (rs *LocalReportStore) scanIntegrity(dirPath string) (integrityScan, error) {
+	if dirPath == "" {
+		return integrityScan{}, fmt.Errorf("reports directory path is required")
+	}
+
+	if err := rs.validateReportsDir(dirPath); err != nil {
+		return integrityScan{}, err
+	}
+
+	scan := integrityScan{
+		report:   m.IntegrityReport{Dir: m.Path(dirPath), Problems: make([]m.IntegrityProblem, 0)},
+		files:    make(map[string]bool),
+		misnamed: make(map[string]string),
+	}
+
+	if err := rs.scanReportFiles(dirPath, &scan); err != nil {
+		return integrityScan{}, err
+	}
+
+	rs.scanIndex(dirPath, &scan)
+
+	sort.Slice(scan.report.Problems, func(i, j int) bool {
+		a, b := scan.report.Problems[i], scan.report.Problems[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+
+		if a.File != b.File {
+			return a.File < b.File
+		}
+
+		return a.Detail < b.Detail
+	})
+
+	return scan, nil
+}
+
+func (rs *LocalReportStore) scanReportFiles(dirPath string, scan *integrityScan) error {
+	stored, err := rs.reports().read(dirPath)
+	if err != nil {
+		return err
+	}
+
+	owners := make(map[string]string)
+
+	for _, entry := range stored {
+		name, report, err := entry.name, entry.report, entry.err
+		scan.files[name] = true
+		scan.report.Reports++
+
+		if err == nil && len(report.Result) == 0 {
+			// SaveReports never writes a report without results, and the
+			// index cannot list one.
+			err = errors.New("holds no mutation results")
+		}
+
+		if err != nil {
+			scan.corrupt = append(scan.corrupt, name)
+			scan.report.Problems = append(scan.report.Problems, m.IntegrityProblem{
+				Kind: m.IntegrityCorrupt, File: name, Detail: err.Error(),
+			})
+
+			continue
+		}
+
+		if want := rs.reportName(report.Result); want != name {
+			scan.misnamed[name] = want
+			scan.report.Problems = append(scan.report.Problems, m.IntegrityProblem{
+				Kind: m.IntegrityCollision, File: name, Detail: "its results hash to " + want,
+			})
+		}
+
+		for _, result := range report.Result {
+			if owner, ok := owners[result.MutationID]; ok && owner != name {
+				scan.report.Problems = append(scan.report.Problems, m.IntegrityProblem{
+					Kind: m.IntegrityCollision, File: name,
+					Detail: fmt.Sprintf("mutation %s is also stored in %s", result.MutationID, owner),
+				})
+
+				continue
+			}
+
+			owners[result.MutationID] = name
+		}
+	}
+
+	return nil
+}
+
+// scanIndex checks `_index.yaml` and its package shards against the report
+// files found.
+func (rs *LocalReportStore) scanIndex(dirPath string, scan *integrityScan) {
+	data, err := os.ReadFile(filepath.Join(dirPath, indexFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		if scan.report.Reports > 0 {
+			scan.report.Problems = append(scan.report.Problems, m.IntegrityProblem{
+				Kind: m.IntegrityMissing, File: indexFileName, Detail: "the report files are not indexed",
+			})
+		}
+
+		return
+	}
+
+	var index indexEntry
+	if err == nil {
+		err = yaml.Unmarshal(data, &index)
+	}
+
+	if err != nil {
+		scan.report.Problems = append(scan.report.Problems, m.IntegrityProblem{
+			Kind: m.IntegrityCorrupt, File: indexFileName, Detail: err.Error(),
+		})
+
+		return
+	}
+
+	listed := make(map[string]string)
+
+	for _, pkg := range index.Packages {
+		shard, problem := checkIndexShard(dirPath, pkg.Shard)
+		if problem != nil {
+			scan.report.Problems = append(scan.report.Problems, *problem)
+			continue
+		}
+
+		for _, report := range shardReports(shard) {
+			listed[string(report)] = pkg.Shard
+		}
+	}
+
+	for name, shard := range listed {
+		if !scan.files[name] {
+			scan.report.Problems = append(scan.report.Problems, m.IntegrityProblem{
+				Kind: m.IntegrityMissing, File: name, Detail: "listed in " + shard,
+			})
+		}
+	}
+
+	for name := range scan.files {
+		if _, ok := listed[name]; !ok {
+			scan.report.Problems = append(scan.report.Problems, m.IntegrityProblem{
+				Kind: m.IntegrityOrphaned, File: name, Detail: "not listed in " + indexFileName,
+			})
+		}
+	}
+}
+
+// checkIndexShard reads the package shard at the slash-separated path shard,
+// returning the problem that keeps it from being read instead of an error.
+func checkIndexShard(dirPath, shard string) (indexShard, *m.IntegrityProblem) {
+	if !filepath.IsLocal(filepath.FromSlash(shard)) {
+		return indexShard{}, &m.IntegrityProblem{Kind: m.IntegrityCorrupt, File: indexFileName, Detail: "shard " + shard + " lies outside the reports directory"}
+	}
+
+	// #nosec G304 -- the shard path comes from the index of the reports directory
+	data, err := os.ReadFile(filepath.Join(dirPath, filepath.FromSlash(shard)))
+	if errors.Is(err, os.ErrNotExist) {
+		return indexShard{}, &m.IntegrityProblem{Kind: m.IntegrityMissing, File: shard, Detail: "listed in " + indexFileName}
+	}
+
+	var out indexShard
+	if err == nil {
+		err = yaml.Unmarshal(data, &out)
+	}
+
+	if err != nil {
+		return indexShard{}, &m.IntegrityProblem{Kind: m.IntegrityCorrupt, File: shard, Detail: err.Error()}
+	}
+
+	return out, nil
+}
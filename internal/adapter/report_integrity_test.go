@@ -0,0 +1,169 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// integrityFixture saves two indexed reports and returns their directory
+// and file names.
+func integrityFixture(t *testing.T) (string, []string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	reports := []m.Report{
+		{
+			Source: m.Source{Origin: &m.File{ShortPath: "calc/add.go", FullPath: "/abs/calc/add.go", Hash: "add"}},
+			Result: m.Result{{MutationID: "a1", Type: m.MutationArithmetic, Status: m.Killed}},
+		},
+		{
+			Source: m.Source{Origin: &m.File{ShortPath: "calc/sub.go", FullPath: "/abs/calc/sub.go", Hash: "sub"}},
+			Result: m.Result{{MutationID: "s1", Type: m.MutationArithmetic, Status: m.Survived}},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), reports); err != nil {
+		t.Fatalf("SaveReports() error = %v", err)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex() error = %v", err)
+	}
+
+	names := make([]string, 0, len(reports))
+	for _, report := range reports {
+		names = append(names, rs.computeReportHash(report.Result)+".yaml")
+	}
+
+	return dir, names
+}
+
+func problemKinds(report m.IntegrityReport) map[string]m.IntegrityProblemKind {
+	kinds := make(map[string]m.IntegrityProblemKind, len(report.Problems))
+	for _, problem := range report.Problems {
+		kinds[problem.File] = problem.Kind
+	}
+
+	return kinds
+}
+
+func TestLocalReportStore_CheckIntegrity_Consistent(t *testing.T) {
+	dir, _ := integrityFixture(t)
+
+	report, err := (&LocalReportStore{}).CheckIntegrity(m.Path(dir))
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error = %v", err)
+	}
+
+	if report.Reports != 2 || len(report.Problems) != 0 {
+		t.Fatalf("expected 2 reports and no problems, got %+v", report)
+	}
+}
+
+func TestLocalReportStore_CheckIntegrity_FindsProblems(t *testing.T) {
+	dir, names := integrityFixture(t)
+	rs := &LocalReportStore{}
+
+	// A corrupt report, a missing one, and a copy of an indexed report
+	// under another name, which is orphaned and collides with the original.
+	if err := os.WriteFile(filepath.Join(dir, "0000000000000000.yaml"), []byte("source: [unclosed"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Remove(filepath.Join(dir, names[1])); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, names[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "copy.yaml"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := rs.CheckIntegrity(m.Path(dir))
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error = %v", err)
+	}
+
+	want := map[string]m.IntegrityProblemKind{
+		"0000000000000000.yaml": m.IntegrityOrphaned,
+		"copy.yaml":             m.IntegrityOrphaned,
+		names[1]:                m.IntegrityMissing,
+	}
+
+	got := problemKinds(report)
+	for file, kind := range want {
+		if got[file] != kind {
+			t.Errorf("expected %s to be %s, got problems %+v", file, kind, report.Problems)
+		}
+	}
+
+	counts := make(map[m.IntegrityProblemKind]int)
+	for _, problem := range report.Problems {
+		counts[problem.Kind]++
+	}
+
+	if counts[m.IntegrityCorrupt] != 1 || counts[m.IntegrityCollision] != 2 {
+		t.Errorf("expected one corrupt report and two collisions for copy.yaml, got %+v", report.Problems)
+	}
+}
+
+func TestLocalReportStore_RepairIntegrity(t *testing.T) {
+	dir, names := integrityFixture(t)
+	rs := &LocalReportStore{}
+
+	corrupt := filepath.Join(dir, "0000000000000000.yaml")
+	if err := os.WriteFile(corrupt, []byte("source: [unclosed"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Rename(filepath.Join(dir, names[1]), filepath.Join(dir, "renamed.yaml")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rs.RepairIntegrity(m.Path(dir)); err != nil {
+		t.Fatalf("RepairIntegrity() error = %v", err)
+	}
+
+	report, err := rs.CheckIntegrity(m.Path(dir))
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error = %v", err)
+	}
+
+	if len(report.Problems) != 0 {
+		t.Fatalf("expected no problems after repairing, got %+v", report.Problems)
+	}
+
+	if _, err := os.Stat(corrupt + corruptSuffix); err != nil {
+		t.Errorf("expected the corrupt report to be set aside: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, names[1])); err != nil {
+		t.Errorf("expected the misnamed report to get its name back: %v", err)
+	}
+}
+
+func TestLocalReportStore_CheckIntegrity_ReportWithoutResultsIsCorrupt(t *testing.T) {
+	dir, _ := integrityFixture(t)
+
+	if err := os.WriteFile(filepath.Join(dir, "stray.yaml"), []byte("a: 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := (&LocalReportStore{}).CheckIntegrity(m.Path(dir))
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error = %v", err)
+	}
+
+	if len(report.Problems) != 2 || report.Problems[0].Kind != m.IntegrityCorrupt || report.Problems[1].Kind != m.IntegrityOrphaned {
+		t.Fatalf("expected stray.yaml to be corrupt and orphaned, got %+v", report.Problems)
+	}
+}
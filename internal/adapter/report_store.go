@@ -6,9 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -24,12 +24,34 @@ type ReportStore interface {
 	LoadReports(path m.Path) ([]m.Report, error)
 	CheckUpdates(path m.Path, sources []m.Source) ([]m.Source, error)
 	CleanReports(path m.Path, sources []m.Source) error
+	CheckIntegrity(path m.Path) (m.IntegrityReport, error)
+	RepairIntegrity(path m.Path) error
+	ExportJUnit(path m.Path, reports []m.Report) error
+	ExportDiagnostics(path m.Path, format m.DiagnosticsFormat, diagnostics []m.Diagnostic) error
+	ExportBadge(svgPath m.Path, endpointPath m.Path, badge m.Badge) error
+	LoadIndex(path m.Path) (m.ReportIndex, error)
+	LoadPackageIndex(path m.Path, pkg string) (m.PackageIndex, error)
+	RecordRun(path m.Path, at time.Time) error
+	LoadHistory(path m.Path) ([]m.RunRecord, error)
+	MarkEquivalent(path m.Path, mutationID string) error
+	LoadEquivalents(path m.Path) ([]string, error)
+	SaveFailure(path m.Path, failure m.RunFailure) error
+	SavePending(path m.Path, sources []m.Path) error
+	SaveApplied(path m.Path, applied *m.AppliedMutation) error
+	LoadApplied(path m.Path) (*m.AppliedMutation, error)
+	SaveManifest(path m.Path, manifest m.RunManifest, signingKey m.Path) error
+	SaveMutationDiffs(path m.Path, mutations []m.Mutation, fullFiles bool) error
+	SavePlan(path m.Path, plan m.MutationPlan) error
+	LoadPlan(path m.Path) (m.MutationPlan, error)
 }
 
 // LocalReportStore is the concrete implementation that will back the
 // ReportStore interface. It currently returns nil for LoadReports so tests
 // can drive the actual logic.
-type LocalReportStore struct{}
+type LocalReportStore struct {
+	// backend holds the reports; nil stores one YAML file per report.
+	backend reportBackend
+}
 
 // NewReportStore constructs a LocalReportStore instance ready to
 // be wired into the workflow.
@@ -50,9 +72,19 @@ type resultEntryYAML struct {
 }
 
 type mutationResultYAML struct {
-	MutationID string       `yaml:"mutationid"`
-	Status     m.TestStatus `yaml:"status"`
-	Err        string       `yaml:"err,omitempty"`
+	MutationID string        `yaml:"mutationid"`
+	Status     m.TestStatus  `yaml:"status"`
+	Line       int           `yaml:"line,omitempty"`
+	Err        string        `yaml:"err,omitempty"`
+	Duration   time.Duration `yaml:"duration,omitempty"`
+	KillReason m.KillReason  `yaml:"kill_reason,omitempty"`
+	Note       string        `yaml:"note,omitempty"`
+	Suppressed bool          `yaml:"suppressed,omitempty"`
+	Equivalent bool          `yaml:"equivalent,omitempty"`
+	Cached     bool          `yaml:"cached,omitempty"`
+	MergedFrom []string      `yaml:"merged_from,omitempty"`
+	Hint       string        `yaml:"hint,omitempty"`
+	TestOutput string        `yaml:"test_output,omitempty"`
 }
 
 type mutationEntry struct {
@@ -65,16 +97,41 @@ type resultEntry struct {
 	Mutations []mutationEntry `yaml:"mutations"`
 }
 
+// indexCounts are the status totals of `_index.yaml`, of each package
+// listed there and of each package shard. NotRunMutations counts mutations a
+// time budget cut off, SuppressedMutations the ignored ones that
+// //gooze:ignore annotations suppressed, EquivalentMutations those marked as
+// equivalent, FlakyMutations the kills flaky tests made untrustworthy,
+// CachedMutations the results reused from earlier runs and
+// CompileErrorMutations the mutants that did not compile.
+type indexCounts struct {
+	TotalMutations      int `yaml:"total_mutations"`
+	KilledMutations     int `yaml:"killed_mutations"`
+	SurvivedMutations   int `yaml:"survived_mutations"`
+	FailedMutations     int `yaml:"failed_mutations"`
+	IgnoredMutations    int `yaml:"ignored_mutations"`
+	SuppressedMutations int `yaml:"suppressed_mutations,omitempty"`
+	EquivalentMutations int `yaml:"equivalent_mutations,omitempty"`
+	NotRunMutations     int `yaml:"not_run_mutations,omitempty"`
+	FlakyMutations      int `yaml:"flaky_mutations,omitempty"`
+	CachedMutations     int `yaml:"cached_mutations,omitempty"`
+	// CompileErrorMutations is left out of the score like FailedMutations.
+	CompileErrorMutations int           `yaml:"compile_error_mutations,omitempty"`
+	TotalDuration         time.Duration `yaml:"total_duration"`
+}
+
+// indexEntry is the `_index.yaml` summary. Partial flags that the counts
+// cover only part of the mutations; the per-source results live in the
+// package shards listed under Packages.
 type indexEntry struct {
-	TotalMutations    int           `yaml:"total_mutations"`
-	KilledMutations   int           `yaml:"killed_mutations"`
-	SurvivedMutations int           `yaml:"survived_mutations"`
-	FailedMutations   int           `yaml:"failed_mutations"`
-	IgnoredMutations  int           `yaml:"ignored_mutations"`
-	Result            []resultEntry `yaml:"result"`
+	indexCounts `yaml:",inline"`
+	Partial     bool                `yaml:"partial,omitempty"`
+	Packages    []indexPackageEntry `yaml:"packages"`
 }
 
-// SaveReports writes one YAML file per report into the provided directory.
+// SaveReports stores the reports in the provided directory, one YAML file per
+// report unless the store was built for another backend.
+// Saving also removes the failure record of a previously aborted run.
 func (rs *LocalReportStore) SaveReports(path m.Path, reports []m.Report) error {
 	dirPath := string(path)
 	if dirPath == "" {
@@ -85,33 +142,25 @@ func (rs *LocalReportStore) SaveReports(path m.Path, reports []m.Report) error {
 		return fmt.Errorf("create reports directory: %w", err)
 	}
 
-	writtenReports := make([]m.Report, 0, len(reports))
+	if err := rs.clearFailure(dirPath); err != nil {
+		return err
+	}
+
+	stored := make([]storedReport, 0, len(reports))
 	for _, report := range reports {
-		reportHash := rs.computeReportHash(report.Result)
-		if reportHash == "" {
+		name := rs.reportName(report.Result)
+		if name == "" {
 			continue
 		}
 
-		data, err := rs.marshalReport(report)
-		if err != nil {
-			return fmt.Errorf("marshal report to YAML: %w", err)
-		}
-
-		name := reportHash + ".yaml"
-
-		fullPath := filepath.Join(dirPath, name)
-		if err := os.WriteFile(fullPath, data, 0o600); err != nil {
-			return fmt.Errorf("write report file %s: %w", fullPath, err)
-		}
-
-		writtenReports = append(writtenReports, report)
+		stored = append(stored, storedReport{name: name, report: report})
 	}
 
-	if len(writtenReports) == 0 {
+	if len(stored) == 0 {
 		return nil
 	}
 
-	return nil
+	return rs.reports().write(dirPath, stored)
 }
 
 // RegenerateIndex rebuilds and writes `_index.yaml` from the report files in `path`.
@@ -151,55 +200,12 @@ func (rs *LocalReportStore) reportsDirExists(dirPath string) (bool, error) {
 }
 
 func (rs *LocalReportStore) loadReportsFromDir(dirPath string) ([]m.Report, error) {
-	entries, err := os.ReadDir(dirPath)
-	if err != nil {
-		return nil, fmt.Errorf("read reports directory: %w", err)
-	}
-
-	reports := make([]m.Report, 0)
-
-	for _, entry := range entries {
-		if !rs.shouldLoadReportEntry(entry) {
-			continue
-		}
-
-		filePath := filepath.Join(dirPath, entry.Name())
-		// #nosec G304 -- filePath is built from a trusted reports directory listing
-		data, err := os.ReadFile(filePath)
-		if err != nil {
-			return nil, fmt.Errorf("read report file %s: %w", filePath, err)
-		}
-
-		report, err := rs.unmarshalReport(data)
-		if err != nil {
-			return nil, fmt.Errorf("unmarshal report file %s: %w", filePath, err)
-		}
-
-		reports = append(reports, report)
-	}
-
-	return reports, nil
-}
-
-func (rs *LocalReportStore) writeIndexForReports(dirPath string, reports []m.Report) error {
-	indexPath := filepath.Join(dirPath, indexFileName)
-	if len(reports) == 0 {
-		_ = os.Remove(indexPath)
-		return nil
-	}
-
-	index := rs.buildIndexFromReports(reports)
-
-	indexData, err := yaml.Marshal(index)
+	stored, err := rs.reports().read(dirPath)
 	if err != nil {
-		return fmt.Errorf("marshal index YAML: %w", err)
-	}
-
-	if err := os.WriteFile(indexPath, indexData, 0o600); err != nil {
-		return fmt.Errorf("write index file %s: %w", indexPath, err)
+		return nil, err
 	}
 
-	return nil
+	return decodedReports(stored)
 }
 
 // LoadReports retrieves previously saved reports from disk.
@@ -226,6 +232,8 @@ func (rs *LocalReportStore) LoadReports(path m.Path) ([]m.Report, error) {
 type storedSourceState struct {
 	source  m.Source
 	mutator map[string]int
+	// notRun marks a source with mutations a time budget left untested.
+	notRun bool
 }
 
 // CleanReports deletes stored report files that belong to the provided sources.
@@ -250,46 +258,28 @@ func (rs *LocalReportStore) CleanReports(path m.Path, sources []m.Source) error
 		return nil
 	}
 
-	entries, err := os.ReadDir(dirPath)
+	stored, err := rs.reports().read(dirPath)
 	if err != nil {
-		return fmt.Errorf("read reports directory: %w", err)
-	}
-
-	for _, entry := range entries {
-		if err := rs.cleanReportFile(dirPath, entry, toCleanPaths, toCleanHashes); err != nil {
-			return err
-		}
-	}
-
-	return rs.RegenerateIndex(path)
-}
-
-func (rs *LocalReportStore) cleanReportFile(dirPath string, entry os.DirEntry, toCleanPaths map[string]bool, toCleanHashes map[string]bool) error {
-	if !rs.shouldLoadReportEntry(entry) {
-		return nil
+		return err
 	}
 
-	filePath := filepath.Join(dirPath, entry.Name())
-	// #nosec G304 -- filePath is built from a trusted reports directory listing
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return fmt.Errorf("read report file %s: %w", filePath, err)
-	}
+	toRemove := make([]string, 0)
 
-	report, err := rs.unmarshalReport(data)
-	if err != nil {
-		return fmt.Errorf("unmarshal report file %s: %w", filePath, err)
-	}
+	for _, entry := range stored {
+		if entry.err != nil {
+			return fmt.Errorf("unmarshal report %s: %w", entry.name, entry.err)
+		}
 
-	if !rs.shouldCleanReport(report.Source, toCleanPaths, toCleanHashes) {
-		return nil
+		if rs.shouldCleanReport(entry.report.Source, toCleanPaths, toCleanHashes) {
+			toRemove = append(toRemove, entry.name)
+		}
 	}
 
-	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
-		return fmt.Errorf("remove report file %s: %w", filePath, err)
+	if err := rs.reports().remove(dirPath, toRemove); err != nil {
+		return err
 	}
 
-	return nil
+	return rs.RegenerateIndex(path)
 }
 
 func (rs *LocalReportStore) buildCleanMatchers(sources []m.Source) (map[string]bool, map[string]bool) {
@@ -336,6 +326,7 @@ func (rs *LocalReportStore) shouldCleanReport(source m.Source, toCleanPaths map[
 // CheckUpdates returns sources that should be re-tested because:
 // - the source file is deleted (present in stored reports but not in current `sources`)
 // - source/test content hash changed
+// - a run that did not finish left the source pending
 // - the current mutator set or versions differ from what was used to generate stored reports.
 func (rs *LocalReportStore) CheckUpdates(path m.Path, sources []m.Source) ([]m.Source, error) {
 	dirPath := string(path)
@@ -359,6 +350,21 @@ func (rs *LocalReportStore) CheckUpdates(path m.Path, sources []m.Source) ([]m.S
 	}
 
 	stored := rs.buildStoredSourceState(reports)
+
+	pending, err := rs.readPending(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// A run that did not finish saved only part of the results of its
+	// pending sources.
+	for pathStr := range pending {
+		if st, ok := stored[pathStr]; ok {
+			st.notRun = true
+			stored[pathStr] = st
+		}
+	}
+
 	currentByPath := rs.buildCurrentSourceMap(sources)
 	changed := rs.findChangedSources(stored, currentByPath)
 
@@ -406,7 +412,7 @@ func (rs *LocalReportStore) isSourceChanged(stored map[string]storedSourceState,
 		return true
 	}
 
-	if rs.sourceHashChanged(st.source, current) {
+	if st.notRun || rs.sourceHashChanged(st.source, current) {
 		return true
 	}
 
@@ -431,7 +437,12 @@ func (rs *LocalReportStore) buildStoredSourceState(reports []m.Report) map[strin
 		// Keep the most recently seen Source metadata (hashes), but they should be consistent.
 		st.source = report.Source
 
-		for mt := range report.Result {
+		for _, entry := range report.Result {
+			if entry.Status == m.NotRun {
+				st.notRun = true
+			}
+
+			mt := entry.Type
 			if existing, ok := st.mutator[mt.Name]; ok && existing != mt.Version {
 				// Version mismatch across reports - mark as needing update
 				// Use -1 as a sentinel to indicate inconsistency
@@ -478,7 +489,7 @@ func (rs *LocalReportStore) sourceHashChanged(stored m.Source, current m.Source)
 		return true
 	}
 
-	return false
+	return stored.TestsHash != current.TestsHash
 }
 
 func (rs *LocalReportStore) mutatorsChanged(stored map[string]int) bool {
@@ -505,18 +516,8 @@ func (rs *LocalReportStore) mutatorsChanged(stored map[string]int) bool {
 }
 
 func currentMutationVersions() map[string]int {
-	// Keep in sync with supported mutation types.
-	mutations := []m.MutationType{
-		m.MutationArithmetic,
-		m.MutationBoolean,
-		m.MutationNumbers,
-		m.MutationComparison,
-		m.MutationLogical,
-		m.MutationUnary,
-	}
-
-	out := make(map[string]int, len(mutations))
-	for _, mt := range mutations {
+	out := make(map[string]int, len(m.MutationTypes))
+	for _, mt := range m.MutationTypes {
 		out[mt.Name] = mt.Version
 	}
 
@@ -551,9 +552,15 @@ func encodeResult(result m.Result) []resultEntryYAML {
 		return []resultEntryYAML{}
 	}
 
-	keys := make([]m.MutationType, 0, len(result))
-	for mutationType := range result {
-		keys = append(keys, mutationType)
+	byType := make(map[m.MutationType][]m.MutationResult)
+	keys := make([]m.MutationType, 0)
+
+	for _, res := range result {
+		if _, ok := byType[res.Type]; !ok {
+			keys = append(keys, res.Type)
+		}
+
+		byType[res.Type] = append(byType[res.Type], res)
 	}
 
 	sort.Slice(keys, func(i, j int) bool {
@@ -566,7 +573,7 @@ func encodeResult(result m.Result) []resultEntryYAML {
 
 	entries := make([]resultEntryYAML, 0, len(keys))
 	for _, mutationType := range keys {
-		results := result[mutationType]
+		results := byType[mutationType]
 		entry := resultEntryYAML{
 			Name:      mutationType.Name,
 			Version:   mutationType.Version,
@@ -582,7 +589,17 @@ func encodeResult(result m.Result) []resultEntryYAML {
 			entry.Mutations = append(entry.Mutations, mutationResultYAML{
 				MutationID: res.MutationID,
 				Status:     res.Status,
+				Line:       res.Line,
 				Err:        errString,
+				Duration:   res.Duration,
+				KillReason: res.KillReason,
+				Note:       res.Note,
+				Suppressed: res.Suppressed,
+				Equivalent: res.Equivalent,
+				Cached:     res.Cached,
+				MergedFrom: res.MergedFrom,
+				Hint:       res.Hint,
+				TestOutput: res.TestOutput,
 			})
 		}
 
@@ -601,21 +618,22 @@ func decodeResult(entries []resultEntryYAML) m.Result {
 
 	for _, entry := range entries {
 		mutationType := m.MutationType{Name: entry.Name, Version: entry.Version}
-		result[mutationType] = make([]struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}, 0, len(entry.Mutations))
 
 		for _, mut := range entry.Mutations {
-			result[mutationType] = append(result[mutationType], struct {
-				MutationID string
-				Status     m.TestStatus
-				Err        error
-			}{
+			result = append(result, m.MutationResult{
 				MutationID: mut.MutationID,
+				Type:       mutationType,
 				Status:     mut.Status,
-				Err:        nil,
+				Line:       mut.Line,
+				Duration:   mut.Duration,
+				KillReason: mut.KillReason,
+				Note:       mut.Note,
+				Suppressed: mut.Suppressed,
+				Equivalent: mut.Equivalent,
+				Cached:     mut.Cached,
+				MergedFrom: mut.MergedFrom,
+				Hint:       mut.Hint,
+				TestOutput: mut.TestOutput,
 			})
 		}
 	}
@@ -623,12 +641,12 @@ func decodeResult(entries []resultEntryYAML) m.Result {
 	return result
 }
 
-func (rs *LocalReportStore) buildIndexFromReports(reports []m.Report) indexEntry {
-	index := indexEntry{Result: make([]resultEntry, 0)}
-	state := rs.collectIndexState(reports, &index)
-	index.Result = rs.buildIndexResults(state)
+func (rs *LocalReportStore) buildIndexFromReports(reports []m.Report) indexShard {
+	shard := indexShard{Result: make([]resultEntry, 0)}
+	state := rs.collectIndexState(reports, &shard.indexCounts)
+	shard.Result = rs.buildIndexResults(state)
 
-	return index
+	return shard
 }
 
 type indexState struct {
@@ -636,7 +654,7 @@ type indexState struct {
 	sourceToMutations map[string]map[string]bool
 }
 
-func (rs *LocalReportStore) collectIndexState(reports []m.Report, index *indexEntry) indexState {
+func (rs *LocalReportStore) collectIndexState(reports []m.Report, counts *indexCounts) indexState {
 	state := indexState{
 		globalMutationMap: make(map[string]*mutationEntry),
 		sourceToMutations: make(map[string]map[string]bool),
@@ -648,20 +666,29 @@ func (rs *LocalReportStore) collectIndexState(reports []m.Report, index *indexEn
 			state.sourceToMutations[sourceHex] = make(map[string]bool)
 		}
 
-		reportHash := rs.computeReportHash(report.Result)
-		if reportHash == "" {
+		reportFile := rs.reportName(report.Result)
+		if reportFile == "" {
 			continue
 		}
 
-		reportFile := reportHash + ".yaml"
+		for _, result := range report.Result {
+			counts.TotalMutations++
+			counts.TotalDuration += result.Duration
+			rs.incrementStatusCount(counts, result.Status)
+
+			if result.Suppressed {
+				counts.SuppressedMutations++
+			}
 
-		for mutationType, results := range report.Result {
-			for _, result := range results {
-				index.TotalMutations++
-				rs.incrementStatusCount(index, result.Status)
+			if result.Equivalent {
+				counts.EquivalentMutations++
 			}
 
-			rs.trackMutationForIndex(&state, sourceHex, mutationType.Name, reportFile)
+			if result.Cached {
+				counts.CachedMutations++
+			}
+
+			rs.trackMutationForIndex(&state, sourceHex, result.Type.Name, reportFile)
 		}
 	}
 
@@ -735,16 +762,22 @@ func (rs *LocalReportStore) sourceHex(source m.Source) string {
 	return source.Origin.Hash
 }
 
-func (rs *LocalReportStore) incrementStatusCount(index *indexEntry, status m.TestStatus) {
+func (rs *LocalReportStore) incrementStatusCount(counts *indexCounts, status m.TestStatus) {
 	switch status {
 	case m.Killed:
-		index.KilledMutations++
+		counts.KilledMutations++
 	case m.Survived:
-		index.SurvivedMutations++
+		counts.SurvivedMutations++
 	case m.Error:
-		index.FailedMutations++
+		counts.FailedMutations++
 	case m.Skipped:
-		index.IgnoredMutations++
+		counts.IgnoredMutations++
+	case m.NotRun:
+		counts.NotRunMutations++
+	case m.Flaky:
+		counts.FlakyMutations++
+	case m.CompileError:
+		counts.CompileErrorMutations++
 	}
 }
 
@@ -781,7 +814,8 @@ func (rs *LocalReportStore) shouldLoadReportEntry(entry os.DirEntry) bool {
 	}
 
 	name := entry.Name()
-	if name == indexFileName {
+	if name == indexFileName || name == historyFileName || name == failureFileName || name == manifestFileName ||
+		name == equivalentsFileName || name == pendingFileName || name == appliedFileName {
 		return false
 	}
 
@@ -796,10 +830,8 @@ func (rs *LocalReportStore) computeReportHash(result m.Result) string {
 
 	parts := make([]string, 0)
 
-	for mutationType, results := range result {
-		for _, res := range results {
-			parts = append(parts, res.MutationID+"|"+mutationType.Name)
-		}
+	for _, res := range result {
+		parts = append(parts, res.MutationID+"|"+res.Type.Name)
 	}
 
 	sort.Strings(parts)
@@ -6,8 +6,10 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -27,13 +29,9 @@ func TestLocalReportStore_SaveReports_WritesHashedYAMLPerReport(t *testing.T) {
 			Test:   &m.File{FullPath: m.Path("/abs/path/file_test.go"), Hash: "def456"},
 		},
 		Result: m.Result{
-			m.MutationBoolean: {
-				{MutationID: "m1", Status: m.Killed, Err: nil},
-				{MutationID: "m2", Status: m.Error, Err: errBoom},
-			},
-			m.MutationArithmetic: {
-				{MutationID: "m3", Status: m.Survived, Err: nil},
-			},
+			{MutationID: "m1", Type: m.MutationBoolean, Status: m.Killed, Err: nil},
+			{MutationID: "m2", Type: m.MutationBoolean, Status: m.Error, Err: errBoom},
+			{MutationID: "m3", Type: m.MutationArithmetic, Status: m.Survived, Err: nil},
 		},
 		Diff: nil,
 	}
@@ -111,6 +109,324 @@ func TestLocalReportStore_SaveReports_WritesHashedYAMLPerReport(t *testing.T) {
 	}
 }
 
+func TestLocalReportStore_SaveReports_RecordsKillReasons(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed, KillReason: m.KillPanic},
+			{MutationID: "b2", Type: m.MutationBoolean, Status: m.Survived},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, rs.computeReportHash(report.Result)+".yaml"))
+	if err != nil {
+		t.Fatalf("read report file: %v", err)
+	}
+
+	if strings.Count(string(data), "kill_reason:") != 1 || !strings.Contains(string(data), "kill_reason: panic") {
+		t.Fatalf("expected one kill_reason entry in report, got:\n%s", data)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	reasons := map[string]m.KillReason{}
+	for _, res := range reports[0].Result {
+		reasons[res.MutationID] = res.KillReason
+	}
+
+	if reasons["b1"] != m.KillPanic || reasons["b2"] != "" {
+		t.Fatalf("unexpected loaded kill reasons: %v", reasons)
+	}
+}
+
+func TestLocalReportStore_SaveReports_RecordsTestOutput(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	output := "# example/a\n./a.go:4:2: declared and not used: x\nFAIL\texample/a [build failed]\n"
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "b1", Type: m.MutationBlank, Status: m.Killed, KillReason: m.KillBuild, TestOutput: output},
+			{MutationID: "b2", Type: m.MutationBlank, Status: m.Survived},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, rs.computeReportHash(report.Result)+".yaml"))
+	if err != nil {
+		t.Fatalf("read report file: %v", err)
+	}
+
+	if strings.Count(string(data), "test_output:") != 1 || !strings.Contains(string(data), "declared and not used: x") {
+		t.Fatalf("expected one test_output entry in report, got:\n%s", data)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	for _, res := range reports[0].Result {
+		want := ""
+		if res.MutationID == "b1" {
+			want = output
+		}
+
+		if res.TestOutput != want {
+			t.Fatalf("mutation %s loaded test output %q, want %q", res.MutationID, res.TestOutput, want)
+		}
+	}
+}
+
+func TestLocalReportStore_SaveReports_RecordsMergedFrom(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "c1", Type: m.MutationComparison, Status: m.Killed, MergedFrom: []string{"loop"}},
+			{MutationID: "c2", Type: m.MutationComparison, Status: m.Survived},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, rs.computeReportHash(report.Result)+".yaml"))
+	if err != nil {
+		t.Fatalf("read report file: %v", err)
+	}
+
+	if strings.Count(string(data), "merged_from:") != 1 {
+		t.Fatalf("expected one merged_from entry in report, got:\n%s", data)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	for _, res := range reports[0].Result {
+		var want []string
+		if res.MutationID == "c1" {
+			want = []string{"loop"}
+		}
+
+		if !slices.Equal(res.MergedFrom, want) {
+			t.Fatalf("mutation %s loaded merged types %v, want %v", res.MutationID, res.MergedFrom, want)
+		}
+	}
+}
+
+func TestLocalReportStore_SaveReports_RecordsHints(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	hint := "no test asserts the return value of Less for inputs where a == b, on the boundary of a < b"
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "c1", Type: m.MutationComparison, Status: m.Survived, Hint: hint},
+			{MutationID: "c2", Type: m.MutationComparison, Status: m.Killed},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, rs.computeReportHash(report.Result)+".yaml"))
+	if err != nil {
+		t.Fatalf("read report file: %v", err)
+	}
+
+	if strings.Count(string(data), "hint:") != 1 {
+		t.Fatalf("expected one hint entry in report, got:\n%s", data)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	for _, res := range reports[0].Result {
+		want := ""
+		if res.MutationID == "c1" {
+			want = hint
+		}
+
+		if res.Hint != want {
+			t.Fatalf("mutation %s loaded hint %q, want %q", res.MutationID, res.Hint, want)
+		}
+	}
+}
+
+func TestLocalReportStore_SaveReports_RecordsNotes(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "l1", Type: m.MutationLoop, Status: m.Skipped, Note: "quarantined: timed out in 3 recorded runs"},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if got := reports[0].Result[0].Note; got != "quarantined: timed out in 3 recorded runs" {
+		t.Fatalf("unexpected loaded note: %q", got)
+	}
+}
+
+func TestLocalReportStore_SaveReports_RecordsSuppressedMutations(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "a1", Type: m.MutationArithmetic, Status: m.Skipped, Note: "suppressed by //gooze:ignore", Suppressed: true},
+			{MutationID: "a2", Type: m.MutationArithmetic, Status: m.Killed},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	suppressed := map[string]bool{}
+	for _, res := range reports[0].Result {
+		suppressed[res.MutationID] = res.Suppressed
+	}
+
+	if !suppressed["a1"] || suppressed["a2"] {
+		t.Fatalf("unexpected loaded suppressed flags: %v", suppressed)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	indexData, err := os.ReadFile(filepath.Join(dir, "_index.yaml"))
+	if err != nil {
+		t.Fatalf("expected _index.yaml to exist: %v", err)
+	}
+
+	var idx indexEntry
+	if err := yaml.Unmarshal(indexData, &idx); err != nil {
+		t.Fatalf("unmarshal _index.yaml: %v", err)
+	}
+
+	if idx.SuppressedMutations != 1 || idx.IgnoredMutations != 1 {
+		t.Fatalf("expected 1 suppressed and 1 ignored mutation, got %d and %d", idx.SuppressedMutations, idx.IgnoredMutations)
+	}
+}
+
+func TestLocalReportStore_SaveReports_RecordsMutationDurations(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed, Duration: 1500 * time.Millisecond},
+			{MutationID: "b2", Type: m.MutationBoolean, Status: m.Survived, Duration: 250 * time.Millisecond},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, rs.computeReportHash(report.Result)+".yaml"))
+	if err != nil {
+		t.Fatalf("read report file: %v", err)
+	}
+
+	if !strings.Contains(string(data), "duration: 1.5s") {
+		t.Fatalf("expected human-readable duration in report, got:\n%s", data)
+	}
+
+	reports, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(reports) != 1 || len(reports[0].Result) != 2 {
+		t.Fatalf("unexpected loaded reports: %+v", reports)
+	}
+
+	durations := map[string]time.Duration{}
+	for _, res := range reports[0].Result {
+		durations[res.MutationID] = res.Duration
+	}
+
+	if durations["b1"] != 1500*time.Millisecond || durations["b2"] != 250*time.Millisecond {
+		t.Fatalf("unexpected loaded durations: %v", durations)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	indexData, err := os.ReadFile(filepath.Join(dir, "_index.yaml"))
+	if err != nil {
+		t.Fatalf("expected _index.yaml to exist: %v", err)
+	}
+
+	var idx indexEntry
+	if err := yaml.Unmarshal(indexData, &idx); err != nil {
+		t.Fatalf("unmarshal _index.yaml: %v", err)
+	}
+
+	if idx.TotalDuration != 1750*time.Millisecond {
+		t.Fatalf("expected total_duration=1.75s, got %v", idx.TotalDuration)
+	}
+}
+
 func TestLocalReportStore_SaveReports_SkipsReportsWithNoMutations(t *testing.T) {
 	t.Parallel()
 
@@ -145,19 +461,15 @@ func TestLocalReportStore_SaveReports_WritesIndexYAML(t *testing.T) {
 	report1 := m.Report{
 		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
 		Result: m.Result{
-			m.MutationBoolean: {
-				{MutationID: "b1", Status: m.Killed, Err: nil},
-				{MutationID: "b2", Status: m.Skipped, Err: nil},
-			},
+			{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed, Err: nil},
+			{MutationID: "b2", Type: m.MutationBoolean, Status: m.Skipped, Err: nil},
 		},
 	}
 
 	report2 := m.Report{
 		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/b.go"), Hash: "sourceB"}},
 		Result: m.Result{
-			m.MutationArithmetic: {
-				{MutationID: "a1", Status: m.Error, Err: errors.New("nope")},
-			},
+			{MutationID: "a1", Type: m.MutationArithmetic, Status: m.Error, Err: errors.New("nope")},
 		},
 	}
 
@@ -199,8 +511,13 @@ func TestLocalReportStore_SaveReports_WritesIndexYAML(t *testing.T) {
 		t.Fatalf("expected survived_mutations=0, got %d", idx.SurvivedMutations)
 	}
 
-	if len(idx.Result) != 2 {
-		t.Fatalf("expected 2 result entries, got %d", len(idx.Result))
+	if len(idx.Packages) != 1 || idx.Packages[0].Package != "/abs" || idx.Packages[0].TotalMutations != 3 {
+		t.Fatalf("expected one /abs package with 3 mutations, got %+v", idx.Packages)
+	}
+
+	shard := readIndexShard(t, dir, idx.Packages[0])
+	if len(shard.Result) != 2 {
+		t.Fatalf("expected 2 result entries, got %d", len(shard.Result))
 	}
 
 	hash1 := rs.computeReportHash(report1.Result)
@@ -212,7 +529,7 @@ func TestLocalReportStore_SaveReports_WritesIndexYAML(t *testing.T) {
 	file2 := hash2 + ".yaml"
 
 	bySource := map[string]resultEntry{}
-	for _, re := range idx.Result {
+	for _, re := range shard.Result {
 		bySource[re.SourceHex] = re
 	}
 
@@ -245,6 +562,138 @@ func TestLocalReportStore_SaveReports_WritesIndexYAML(t *testing.T) {
 	}
 }
 
+func TestLocalReportStore_RegenerateIndex_MarksNotRunAsPartial(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed},
+			{MutationID: "b2", Type: m.MutationBoolean, Status: m.NotRun, Note: "time budget exhausted"},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "_index.yaml"))
+	if err != nil {
+		t.Fatalf("read _index.yaml: %v", err)
+	}
+
+	var idx indexEntry
+	if err := yaml.Unmarshal(data, &idx); err != nil {
+		t.Fatalf("unmarshal _index.yaml: %v", err)
+	}
+
+	if idx.NotRunMutations != 1 || !idx.Partial {
+		t.Fatalf("expected not_run_mutations=1 and partial=true, got %d and %v", idx.NotRunMutations, idx.Partial)
+	}
+	if idx.KilledMutations != 1 || idx.TotalMutations != 2 {
+		t.Fatalf("expected killed=1 of total=2, got %d of %d", idx.KilledMutations, idx.TotalMutations)
+	}
+}
+
+func TestLocalReportStore_RegenerateIndex_CountsFlaky(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed},
+			{MutationID: "b2", Type: m.MutationBoolean, Status: m.Flaky},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	loaded, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(loaded) != 1 || loaded[0].Result[1].Status != m.Flaky {
+		t.Fatalf("expected the flaky status to round-trip, got %+v", loaded)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "_index.yaml"))
+	if err != nil {
+		t.Fatalf("read _index.yaml: %v", err)
+	}
+
+	var idx indexEntry
+	if err := yaml.Unmarshal(data, &idx); err != nil {
+		t.Fatalf("unmarshal _index.yaml: %v", err)
+	}
+
+	if idx.FlakyMutations != 1 || idx.KilledMutations != 1 || idx.TotalMutations != 2 {
+		t.Fatalf("expected flaky=1 killed=1 of total=2, got %d, %d of %d", idx.FlakyMutations, idx.KilledMutations, idx.TotalMutations)
+	}
+}
+
+func TestLocalReportStore_RegenerateIndex_CountsCompileErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	report := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}},
+		Result: m.Result{
+			{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed},
+			{MutationID: "b2", Type: m.MutationBoolean, Status: m.CompileError},
+		},
+	}
+
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if err := rs.RegenerateIndex(m.Path(dir)); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	loaded, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(loaded) != 1 || loaded[0].Result[1].Status != m.CompileError {
+		t.Fatalf("expected the compile error status to round-trip, got %+v", loaded)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "_index.yaml"))
+	if err != nil {
+		t.Fatalf("read _index.yaml: %v", err)
+	}
+
+	var idx indexEntry
+	if err := yaml.Unmarshal(data, &idx); err != nil {
+		t.Fatalf("unmarshal _index.yaml: %v", err)
+	}
+
+	if idx.CompileErrorMutations != 1 || idx.KilledMutations != 1 || idx.TotalMutations != 2 {
+		t.Fatalf("expected compile errors=1 killed=1 of total=2, got %d, %d of %d", idx.CompileErrorMutations, idx.KilledMutations, idx.TotalMutations)
+	}
+}
+
 func TestLocalReportStore_CheckUpdates_NoReportsDir_ReturnsAllSources(t *testing.T) {
 	t.Parallel()
 
@@ -322,7 +771,7 @@ func TestLocalReportStore_CheckUpdates_SourceDeleted_ReturnsMissingSource(t *tes
 	deleted := m.Source{Origin: &m.File{FullPath: m.Path("/abs/deleted.go"), Hash: "old-hash"}}
 	report := m.Report{
 		Source: deleted,
-		Result: m.Result{m.MutationBoolean: {{MutationID: "m1", Status: m.Killed, Err: nil}}},
+		Result: m.Result{{MutationID: "m1", Type: m.MutationBoolean, Status: m.Killed, Err: nil}},
 	}
 	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
 		t.Fatalf("SaveReports returned error: %v", err)
@@ -352,7 +801,7 @@ func TestLocalReportStore_CheckUpdates_CodeOrTestChanged_ReturnsSource(t *testin
 	}
 	report := m.Report{
 		Source: old,
-		Result: m.Result{m.MutationBoolean: {{MutationID: "m1", Status: m.Killed, Err: nil}}},
+		Result: m.Result{{MutationID: "m1", Type: m.MutationBoolean, Status: m.Killed, Err: nil}},
 	}
 	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
 		t.Fatalf("SaveReports returned error: %v", err)
@@ -375,6 +824,30 @@ func TestLocalReportStore_CheckUpdates_CodeOrTestChanged_ReturnsSource(t *testin
 	}
 }
 
+func TestLocalReportStore_CheckUpdates_NotRunMutations_ReturnsSource(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	source := m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "code"}}
+	report := m.Report{
+		Source: source,
+		Result: m.Result{{MutationID: "m1", Type: m.MutationBoolean, Status: m.NotRun}},
+	}
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	changed, err := rs.CheckUpdates(m.Path(dir), []m.Source{source})
+	if err != nil {
+		t.Fatalf("CheckUpdates returned error: %v", err)
+	}
+	if len(changed) != 1 {
+		t.Fatalf("expected the unchanged source with not-run mutations to be returned, got %d", len(changed))
+	}
+}
+
 func TestLocalReportStore_CheckUpdates_TestFileAddedOrRemoved_ReturnsSource(t *testing.T) {
 	t.Parallel()
 
@@ -388,7 +861,7 @@ func TestLocalReportStore_CheckUpdates_TestFileAddedOrRemoved_ReturnsSource(t *t
 	}
 	report := m.Report{
 		Source: stored,
-		Result: m.Result{m.MutationBoolean: {{MutationID: "m1", Status: m.Killed, Err: nil}}},
+		Result: m.Result{{MutationID: "m1", Type: m.MutationBoolean, Status: m.Killed, Err: nil}},
 	}
 	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
 		t.Fatalf("SaveReports returned error: %v", err)
@@ -405,6 +878,45 @@ func TestLocalReportStore_CheckUpdates_TestFileAddedOrRemoved_ReturnsSource(t *t
 	}
 }
 
+func TestLocalReportStore_CheckUpdates_PackageTestsChanged_ReturnsSource(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := &LocalReportStore{}
+
+	old := m.Source{
+		Origin:       &m.File{FullPath: m.Path("/abs/a.go"), Hash: "code"},
+		TestsHash:    "old-tests",
+		PackageTests: true,
+	}
+	report := m.Report{
+		Source: old,
+		Result: m.Result{{MutationID: "m1", Type: m.MutationBoolean, Status: m.Killed, Err: nil}},
+	}
+	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	unchanged, err := rs.CheckUpdates(m.Path(dir), []m.Source{old})
+	if err != nil {
+		t.Fatalf("CheckUpdates returned error: %v", err)
+	}
+	if len(unchanged) != 0 {
+		t.Fatalf("expected no changed sources, got %d", len(unchanged))
+	}
+
+	current := old
+	current.TestsHash = "new-tests"
+
+	changed, err := rs.CheckUpdates(m.Path(dir), []m.Source{current})
+	if err != nil {
+		t.Fatalf("CheckUpdates returned error: %v", err)
+	}
+	if len(changed) != 1 {
+		t.Fatalf("expected 1 changed source, got %d", len(changed))
+	}
+}
+
 func TestLocalReportStore_CheckUpdates_NewMutator_ReturnsSource(t *testing.T) {
 	t.Parallel()
 
@@ -416,7 +928,7 @@ func TestLocalReportStore_CheckUpdates_NewMutator_ReturnsSource(t *testing.T) {
 	// Previous run only had boolean mutations recorded.
 	report := m.Report{
 		Source: old,
-		Result: m.Result{m.MutationBoolean: {{MutationID: "m1", Status: m.Killed, Err: nil}}},
+		Result: m.Result{{MutationID: "m1", Type: m.MutationBoolean, Status: m.Killed, Err: nil}},
 	}
 	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
 		t.Fatalf("SaveReports returned error: %v", err)
@@ -442,7 +954,7 @@ func TestLocalReportStore_CheckUpdates_StoredHasUnknownMutator_ReturnsSource(t *
 	unknown := m.MutationType{Name: "custom-mut", Version: 1}
 	report := m.Report{
 		Source: old,
-		Result: m.Result{unknown: {{MutationID: "m1", Status: m.Killed, Err: nil}}},
+		Result: m.Result{{MutationID: "m1", Type: unknown, Status: m.Killed, Err: nil}},
 	}
 	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
 		t.Fatalf("SaveReports returned error: %v", err)
@@ -483,8 +995,8 @@ func TestLocalReportStore_CleanReports_DeletesOnlySelectedAndRegeneratesIndex(t
 	sourceA := m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}}
 	sourceB := m.Source{Origin: &m.File{FullPath: m.Path("/abs/b.go"), Hash: "sourceB"}}
 
-	reportA := m.Report{Source: sourceA, Result: m.Result{m.MutationBoolean: {{MutationID: "b1", Status: m.Killed, Err: nil}}}}
-	reportB := m.Report{Source: sourceB, Result: m.Result{m.MutationArithmetic: {{MutationID: "a1", Status: m.Survived, Err: nil}}}}
+	reportA := m.Report{Source: sourceA, Result: m.Result{{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed, Err: nil}}}
+	reportB := m.Report{Source: sourceB, Result: m.Result{{MutationID: "a1", Type: m.MutationArithmetic, Status: m.Survived, Err: nil}}}
 
 	if err := rs.SaveReports(m.Path(dir), []m.Report{reportA, reportB}); err != nil {
 		t.Fatalf("SaveReports returned error: %v", err)
@@ -528,11 +1040,15 @@ func TestLocalReportStore_CleanReports_DeletesOnlySelectedAndRegeneratesIndex(t
 	if err := yaml.Unmarshal(data, &idx); err != nil {
 		t.Fatalf("unmarshal _index.yaml: %v", err)
 	}
-	if len(idx.Result) != 1 {
-		t.Fatalf("expected 1 result entry after cleaning, got %d", len(idx.Result))
+	if len(idx.Packages) != 1 {
+		t.Fatalf("expected 1 package after cleaning, got %d", len(idx.Packages))
 	}
-	if idx.Result[0].SourceHex != "sourceB" {
-		t.Fatalf("expected remaining source to be sourceB, got %q", idx.Result[0].SourceHex)
+	shard := readIndexShard(t, dir, idx.Packages[0])
+	if len(shard.Result) != 1 {
+		t.Fatalf("expected 1 result entry after cleaning, got %d", len(shard.Result))
+	}
+	if shard.Result[0].SourceHex != "sourceB" {
+		t.Fatalf("expected remaining source to be sourceB, got %q", shard.Result[0].SourceHex)
 	}
 }
 
@@ -543,7 +1059,7 @@ func TestLocalReportStore_CleanReports_DeleteAll_RemovesIndex(t *testing.T) {
 	rs := &LocalReportStore{}
 
 	sourceA := m.Source{Origin: &m.File{FullPath: m.Path("/abs/a.go"), Hash: "sourceA"}}
-	reportA := m.Report{Source: sourceA, Result: m.Result{m.MutationBoolean: {{MutationID: "b1", Status: m.Killed, Err: nil}}}}
+	reportA := m.Report{Source: sourceA, Result: m.Result{{MutationID: "b1", Type: m.MutationBoolean, Status: m.Killed, Err: nil}}}
 
 	if err := rs.SaveReports(m.Path(dir), []m.Report{reportA}); err != nil {
 		t.Fatalf("SaveReports returned error: %v", err)
@@ -572,6 +1088,9 @@ func TestLocalReportStore_CleanReports_DeleteAll_RemovesIndex(t *testing.T) {
 	if _, err := os.Stat(indexPath); err == nil {
 		t.Fatalf("expected _index.yaml to be deleted")
 	}
+	if _, err := os.Stat(filepath.Join(dir, indexShardDirName)); err == nil {
+		t.Fatalf("expected index shards to be deleted")
+	}
 }
 
 func TestLocalReportStore_CleanReports_NoReportsDir_NoError(t *testing.T) {
@@ -606,7 +1125,7 @@ func TestLocalReportStore_CheckUpdates_MutatorVersionDiff_ReturnsSource(t *testi
 	oldBool := m.MutationType{Name: m.MutationBoolean.Name, Version: 0}
 	report := m.Report{
 		Source: old,
-		Result: m.Result{oldBool: {{MutationID: "m1", Status: m.Killed, Err: nil}}},
+		Result: m.Result{{MutationID: "m1", Type: oldBool, Status: m.Killed, Err: nil}},
 	}
 	if err := rs.SaveReports(m.Path(dir), []m.Report{report}); err != nil {
 		t.Fatalf("SaveReports returned error: %v", err)
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	m "github.com/mouse-blink/gooze/internal/model"
@@ -23,6 +24,9 @@ import (
 //
 //nolint:interfacebloat // A richer interface keeps workflow logic decoupled from os/fs.
 type SourceFSAdapter interface {
+	// Get collects the Go sources under root. Files whose name restricts them
+	// to another GOOS or GOARCH are skipped; //go:build lines are recorded on
+	// the source for the caller to evaluate.
 	Get(root []m.Path, ignore ...string) ([]m.Source, error)
 
 	// Walk traverses the provided root path. When recursive is false the
@@ -44,17 +48,29 @@ type SourceFSAdapter interface {
 	FileInfo(path m.Path) (os.FileInfo, error)
 
 	// FindProjectRoot searches for go.mod file walking up the directory tree.
+	// When a go.work above that module uses it, the workspace directory is
+	// returned instead so sibling modules are copied along.
 	FindProjectRoot(startPath m.Path) (m.Path, error)
 
-	// CreateTempDir creates a temporary directory for mutation testing.
-	CreateTempDir(pattern string) (m.Path, error)
+	// ListPackages lists the packages under root with their imports, as built
+	// with the given tags.
+	ListPackages(root m.Path, tags []string) ([]m.Package, error)
+
+	// CreateTempDir creates a temporary directory for mutation testing inside
+	// dir, or inside the system temp directory when dir is empty.
+	CreateTempDir(dir m.Path, pattern string) (m.Path, error)
 
 	// RemoveAll removes a directory and all its contents.
 	RemoveAll(path m.Path) error
 
-	// CopyDir recursively copies a directory tree.
+	// CopyDir recursively copies a directory tree. Relative replace and use
+	// paths in go.mod and go.work files that point outside src are made
+	// absolute so the copy still builds.
 	CopyDir(src, dst m.Path) error
 
+	// CopySize returns the number of bytes CopyDir copies from src.
+	CopySize(src m.Path) (int64, error)
+
 	// WriteFile writes content to a file with the given permissions.
 	WriteFile(path m.Path, content []byte, perm os.FileMode) error
 
@@ -81,7 +97,7 @@ func NewLocalSourceFSAdapter() *LocalSourceFSAdapter {
 	return &LocalSourceFSAdapter{}
 }
 
-// Get collects Go source files for the provided roots and returns SourceV2 entries.
+// Get collects Go source files for the provided roots and returns Source entries.
 func (a *LocalSourceFSAdapter) Get(roots []m.Path, ignore ...string) ([]m.Source, error) {
 	if len(roots) == 0 {
 		return []m.Source{}, nil
@@ -116,7 +132,7 @@ func (a *LocalSourceFSAdapter) collectSourcesFromRoot(root m.Path, ignoreRegexps
 	}
 
 	if !info.IsDir() {
-		source, ok, err := a.processFilePath(rootPath, ignoreRegexps)
+		source, ok, err := a.processFilePath(rootPath, filepath.Dir(rootPath), ignoreRegexps)
 		if err != nil {
 			if isInvalidSourceErr(err) {
 				return nil
@@ -126,6 +142,7 @@ func (a *LocalSourceFSAdapter) collectSourcesFromRoot(root m.Path, ignoreRegexps
 		}
 
 		if ok {
+			source.PackageTests = true
 			addSourceIfNew(sources, seen, source)
 		}
 
@@ -207,13 +224,16 @@ func (a *LocalSourceFSAdapter) FileInfo(path m.Path) (os.FileInfo, error) {
 }
 
 // FindProjectRoot searches for go.mod file walking up the directory tree.
+// If the module is used by a go.work found further up, or by the file GOWORK
+// names, the directory holding go.work is the project root. GOWORK=off
+// disables workspace detection, as it does for the go command.
 func (a *LocalSourceFSAdapter) FindProjectRoot(startPath m.Path) (m.Path, error) {
 	dir := filepath.Dir(string(startPath))
 
 	for {
 		goModPath := filepath.Join(dir, "go.mod")
 		if _, err := os.Stat(goModPath); err == nil {
-			return m.Path(dir), nil
+			return m.Path(a.workspaceRoot(dir)), nil
 		}
 
 		parent := filepath.Dir(dir)
@@ -225,9 +245,109 @@ func (a *LocalSourceFSAdapter) FindProjectRoot(startPath m.Path) (m.Path, error)
 	}
 }
 
-// CreateTempDir creates a temporary directory for mutation testing.
-func (a *LocalSourceFSAdapter) CreateTempDir(pattern string) (m.Path, error) {
-	tmpDir, err := os.MkdirTemp("", pattern)
+// workspaceRoot returns the directory of the go.work that uses moduleDir, or
+// moduleDir itself when there is none. An explicit GOWORK file takes the
+// place of searching parent directories, as it does for the go command.
+func (a *LocalSourceFSAdapter) workspaceRoot(moduleDir string) string {
+	switch gowork := os.Getenv("GOWORK"); gowork {
+	case "off":
+		return moduleDir
+	case "", "auto":
+	default:
+		return usingWorkspace(gowork, moduleDir)
+	}
+
+	dir := moduleDir
+
+	for {
+		workFile := filepath.Join(dir, "go.work")
+		if _, err := os.Stat(workFile); err == nil {
+			// Like the go command, only the nearest go.work is considered.
+			return usingWorkspace(workFile, moduleDir)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return moduleDir
+		}
+
+		dir = parent
+	}
+}
+
+// usingWorkspace returns the directory of workFile when it uses moduleDir and
+// moduleDir lies below it, so copying that directory brings the module along.
+func usingWorkspace(workFile, moduleDir string) string {
+	// #nosec G304 - go.work path comes from the project or GOWORK
+	data, err := os.ReadFile(workFile)
+	if err != nil {
+		return moduleDir
+	}
+
+	dir := filepath.Dir(workFile)
+
+	rel, err := filepath.Rel(dir, moduleDir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return moduleDir
+	}
+
+	for _, use := range workspaceUses(data) {
+		if filepath.Clean(filepath.Join(dir, use)) == moduleDir {
+			return dir
+		}
+	}
+
+	return moduleDir
+}
+
+// workspaceUses returns the module directories listed by the use directives
+// of a go.work file, in either the single-line or the block form.
+func workspaceUses(data []byte) []string {
+	var uses []string
+
+	inBlock := false
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+
+		line = strings.TrimSpace(line)
+
+		switch {
+		case inBlock && line == ")":
+			inBlock = false
+		case inBlock && line != "":
+			uses = append(uses, unquoteWorkPath(line))
+		case line == "use (" || line == "use(":
+			inBlock = true
+		case strings.HasPrefix(line, "use "):
+			uses = append(uses, unquoteWorkPath(strings.TrimSpace(strings.TrimPrefix(line, "use "))))
+		}
+	}
+
+	return uses
+}
+
+func unquoteWorkPath(path string) string {
+	if unquoted, err := strconv.Unquote(path); err == nil {
+		return unquoted
+	}
+
+	return path
+}
+
+// CreateTempDir creates a temporary directory for mutation testing inside
+// dir, or inside the system temp directory when dir is empty. A missing dir
+// is created.
+func (a *LocalSourceFSAdapter) CreateTempDir(dir m.Path, pattern string) (m.Path, error) {
+	if dir != "" {
+		if err := os.MkdirAll(string(dir), 0o750); err != nil {
+			return "", err
+		}
+	}
+
+	tmpDir, err := os.MkdirTemp(string(dir), pattern)
 	if err != nil {
 		return "", err
 	}
@@ -240,7 +360,9 @@ func (a *LocalSourceFSAdapter) RemoveAll(path m.Path) error {
 	return os.RemoveAll(string(path))
 }
 
-// CopyDir recursively copies a directory tree.
+// CopyDir recursively copies a directory tree. go.mod and go.work files are
+// copied with relative paths that leave src anchored to the originals, so a
+// `replace => ../sibling` keeps resolving from the temporary copy.
 func (a *LocalSourceFSAdapter) CopyDir(src, dst m.Path) error {
 	return filepath.Walk(string(src), func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -253,11 +375,8 @@ func (a *LocalSourceFSAdapter) CopyDir(src, dst m.Path) error {
 		}
 
 		// Skip common directories that don't need to be copied
-		if info.IsDir() {
-			baseName := filepath.Base(path)
-			if baseName == ".git" || baseName == "vendor" || baseName == "node_modules" {
-				return filepath.SkipDir
-			}
+		if info.IsDir() && skipCopyDir(path) {
+			return filepath.SkipDir
 		}
 
 		targetPath := filepath.Join(string(dst), relPath)
@@ -266,10 +385,49 @@ func (a *LocalSourceFSAdapter) CopyDir(src, dst m.Path) error {
 			return os.MkdirAll(targetPath, info.Mode())
 		}
 
+		if isModuleFile(info.Name()) {
+			return copyModuleFile(path, targetPath, string(src), info.Mode())
+		}
+
 		return a.copyFile(path, targetPath, info.Mode())
 	})
 }
 
+// CopySize sums the sizes of the files CopyDir copies from src.
+func (a *LocalSourceFSAdapter) CopySize(src m.Path) (int64, error) {
+	var size int64
+
+	err := filepath.Walk(string(src), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if skipCopyDir(path) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		size += info.Size()
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// skipCopyDir reports whether CopyDir leaves out the directory at path.
+func skipCopyDir(path string) bool {
+	baseName := filepath.Base(path)
+
+	return baseName == ".git" || baseName == "vendor" || baseName == "node_modules"
+}
+
 // copyFile copies a single file.
 func (a *LocalSourceFSAdapter) copyFile(src, dst string, mode os.FileMode) error {
 	// #nosec G304 - src is internal project file path, not user input
@@ -342,7 +500,7 @@ func (a *LocalSourceFSAdapter) collectSourcesFromDir(rootPath string, recursive
 			return nil
 		}
 
-		source, ok, err := a.processFilePath(path, ignoreRegexps)
+		source, ok, err := a.processFilePath(path, rootPath, ignoreRegexps)
 		if err != nil {
 			if isInvalidSourceErr(err) {
 				return nil
@@ -395,12 +553,14 @@ func parseRootPath(rootStr string) (path string, recursive bool) {
 	return rootStr, false
 }
 
-func (a *LocalSourceFSAdapter) processFilePath(path string, ignoreRegexps []*regexp.Regexp) (m.Source, bool, error) {
+// processFilePath builds the source of the file at path, found under the
+// directory base given on the command line.
+func (a *LocalSourceFSAdapter) processFilePath(path, base string, ignoreRegexps []*regexp.Regexp) (m.Source, bool, error) {
 	if !isCandidateSourcePath(path, ignoreRegexps) {
 		return m.Source{}, false, nil
 	}
 
-	return a.buildSourceFromPath(path, ignoreRegexps)
+	return a.buildSourceFromPath(path, base, ignoreRegexps)
 }
 
 func isCandidateSourcePath(path string, ignoreRegexps []*regexp.Regexp) bool {
@@ -412,23 +572,33 @@ func isCandidateSourcePath(path string, ignoreRegexps []*regexp.Regexp) bool {
 		return false
 	}
 
+	if !matchesPlatformFileName(path) {
+		return false
+	}
+
 	return !shouldIgnorePath(path, ignoreRegexps)
 }
 
-func (a *LocalSourceFSAdapter) buildSourceFromPath(path string, ignoreRegexps []*regexp.Regexp) (m.Source, bool, error) {
+func (a *LocalSourceFSAdapter) buildSourceFromPath(path, base string, ignoreRegexps []*regexp.Regexp) (m.Source, bool, error) {
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return m.Source{}, false, err
 	}
 
-	projectRoot, rootErr := a.FindProjectRoot(m.Path(absPath))
+	// Outside a module the files are named relative to the path given on the
+	// command line instead, which keeps their short paths, and the IDs of
+	// their mutations, the same in every checkout.
+	projectRoot, err := a.FindProjectRoot(m.Path(absPath))
+	if err != nil {
+		projectRoot = m.Path(base)
+	}
 
 	file, err := a.readAndParseSource(absPath)
 	if err != nil {
 		return m.Source{}, false, err
 	}
 
-	origin, err := a.buildOriginFile(absPath, projectRoot, rootErr)
+	origin, err := a.buildOriginFile(absPath, projectRoot)
 	if err != nil {
 		return m.Source{}, false, err
 	}
@@ -437,11 +607,77 @@ func (a *LocalSourceFSAdapter) buildSourceFromPath(path string, ignoreRegexps []
 
 	packageName := file.Name.Name
 
-	return m.Source{
-		Origin:  origin,
-		Test:    testFile,
-		Package: &packageName,
-	}, true, nil
+	source := m.Source{
+		Origin:     origin,
+		Test:       testFile,
+		Package:    &packageName,
+		TestHelper: isTestHelperFile(file),
+		Constraint: goBuildConstraint(file),
+	}
+
+	if testFile == nil {
+		if err := a.usePackageTests(&source, filepath.Dir(absPath), ignoreRegexps); err != nil {
+			return m.Source{}, false, err
+		}
+	}
+
+	return source, true, nil
+}
+
+// usePackageTests points a source without a companion test file at the other
+// test files of its package, such as foo_integration_test.go or an external
+// package foo_test, so that the whole package's tests run against it.
+func (a *LocalSourceFSAdapter) usePackageTests(source *m.Source, dir string, ignoreRegexps []*regexp.Regexp) error {
+	testFiles, err := packageTestFiles(dir, ignoreRegexps)
+	if err != nil {
+		return err
+	}
+
+	if len(testFiles) == 0 {
+		return nil
+	}
+
+	h := sha256.New()
+
+	for _, testFile := range testFiles {
+		hash, err := a.HashFile(m.Path(testFile))
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(h, "%s %s\n", filepath.Base(testFile), hash)
+	}
+
+	source.PackageTests = true
+	source.TestsHash = fmt.Sprintf("%x", h.Sum(nil))
+
+	return nil
+}
+
+// packageTestFiles lists the *_test.go files in dir that build on this
+// platform and are not ignored, sorted by name.
+func packageTestFiles(dir string, ignoreRegexps []*regexp.Regexp) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var testFiles []string
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if entry.IsDir() || !strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		if !matchesPlatformFileName(path) || shouldIgnorePath(path, ignoreRegexps) {
+			continue
+		}
+
+		testFiles = append(testFiles, path)
+	}
+
+	return testFiles, nil
 }
 
 func (a *LocalSourceFSAdapter) readAndParseSource(absPath string) (*ast.File, error) {
@@ -452,7 +688,7 @@ func (a *LocalSourceFSAdapter) readAndParseSource(absPath string) (*ast.File, er
 
 	fset := token.NewFileSet()
 
-	file, err := parser.ParseFile(fset, absPath, src, parser.AllErrors)
+	file, err := parser.ParseFile(fset, absPath, src, parser.AllErrors|parser.ParseComments)
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse source file: %w", errInvalidSource, err)
 	}
@@ -464,14 +700,14 @@ func (a *LocalSourceFSAdapter) readAndParseSource(absPath string) (*ast.File, er
 	return file, nil
 }
 
-func (a *LocalSourceFSAdapter) buildOriginFile(absPath string, projectRoot m.Path, rootErr error) (*m.File, error) {
+func (a *LocalSourceFSAdapter) buildOriginFile(absPath string, projectRoot m.Path) (*m.File, error) {
 	originHash, err := a.HashFile(m.Path(absPath))
 	if err != nil {
 		return nil, err
 	}
 
 	origin := &m.File{FullPath: m.Path(absPath), Hash: originHash}
-	if rootErr == nil {
+	if projectRoot != "" {
 		if relPath, err := a.RelPath(projectRoot, m.Path(absPath)); err == nil {
 			origin.ShortPath = relPath
 		}
@@ -549,6 +785,39 @@ func (a *LocalSourceFSAdapter) validateGoFile(path m.Path) error {
 
 var errInvalidSource = errors.New("invalid source file")
 
+// testHelperImports lists packages whose import marks a non-test file as test
+// support code. Mutating such files yields many trivially killed mutants.
+var testHelperImports = map[string]struct{}{
+	"testing":                             {},
+	"github.com/stretchr/testify/assert":  {},
+	"github.com/stretchr/testify/require": {},
+	"github.com/stretchr/testify/mock":    {},
+	"github.com/stretchr/testify/suite":   {},
+	"gotest.tools/assert":                 {},
+	"gotest.tools/v3/assert":              {},
+	"github.com/matryer/is":               {},
+	"github.com/onsi/gomega":              {},
+}
+
+func isTestHelperFile(file *ast.File) bool {
+	for _, spec := range file.Imports {
+		if spec.Path == nil {
+			continue
+		}
+
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := testHelperImports[path]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 func compileIgnoreRegexps(patterns []string) ([]*regexp.Regexp, error) {
 	if len(patterns) == 0 {
 		return nil, nil
@@ -157,10 +157,73 @@ func TestLocalSourceFSAdapter_FindProjectRoot(t *testing.T) {
 	assert.Equal(t, m.Path(goModDir), got)
 }
 
+func TestLocalSourceFSAdapter_FindProjectRoot_Workspace(t *testing.T) {
+	adapter := NewLocalSourceFSAdapter()
+
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "go.work"), "go 1.25\n\nuse (\n\t./api // service API\n\t\"./lib\"\n)\n")
+
+	for _, module := range []string{"api", "lib", "tools"} {
+		moduleDir := filepath.Join(root, module)
+		mustMkdir(t, moduleDir)
+		writeTestFile(t, filepath.Join(moduleDir, "go.mod"), "module example.com/"+module+"\n")
+	}
+
+	t.Run("module used by go.work", func(t *testing.T) {
+		got, err := adapter.FindProjectRoot(m.Path(filepath.Join(root, "api", "handler.go")))
+		require.NoError(t, err)
+		assert.Equal(t, m.Path(root), got)
+
+		got, err = adapter.FindProjectRoot(m.Path(filepath.Join(root, "lib", "lib.go")))
+		require.NoError(t, err)
+		assert.Equal(t, m.Path(root), got)
+	})
+
+	t.Run("module not listed in go.work", func(t *testing.T) {
+		got, err := adapter.FindProjectRoot(m.Path(filepath.Join(root, "tools", "main.go")))
+		require.NoError(t, err)
+		assert.Equal(t, m.Path(filepath.Join(root, "tools")), got)
+	})
+
+	t.Run("GOWORK names the workspace file", func(t *testing.T) {
+		other := t.TempDir()
+		moduleDir := filepath.Join(other, "svc")
+		mustMkdir(t, moduleDir)
+		writeTestFile(t, filepath.Join(moduleDir, "go.mod"), "module example.com/svc\n")
+		workFile := filepath.Join(other, "dev.work")
+		writeTestFile(t, workFile, "go 1.25\n\nuse ./svc\n")
+
+		t.Setenv("GOWORK", workFile)
+
+		got, err := adapter.FindProjectRoot(m.Path(filepath.Join(moduleDir, "svc.go")))
+		require.NoError(t, err)
+		assert.Equal(t, m.Path(other), got)
+
+		// The go.work next to api is ignored when GOWORK names another file.
+		got, err = adapter.FindProjectRoot(m.Path(filepath.Join(root, "api", "handler.go")))
+		require.NoError(t, err)
+		assert.Equal(t, m.Path(filepath.Join(root, "api")), got)
+	})
+
+	t.Run("GOWORK=off", func(t *testing.T) {
+		t.Setenv("GOWORK", "off")
+
+		got, err := adapter.FindProjectRoot(m.Path(filepath.Join(root, "api", "handler.go")))
+		require.NoError(t, err)
+		assert.Equal(t, m.Path(filepath.Join(root, "api")), got)
+	})
+}
+
+func TestWorkspaceUses(t *testing.T) {
+	data := []byte("go 1.25\n\nuse ./single\nuse \"./quoted\" // trailing\n\nuse (\n\t./a\n\n\t../b\n)\n\nreplace x => ./y\n")
+
+	assert.Equal(t, []string{"./single", "./quoted", "./a", "../b"}, workspaceUses(data))
+}
+
 func TestLocalSourceFSAdapter_CreateTempDirAndRemoveAll(t *testing.T) {
 	adapter := NewLocalSourceFSAdapter()
 
-	tmp, err := adapter.CreateTempDir("gooze-test-*")
+	tmp, err := adapter.CreateTempDir("", "gooze-test-*")
 	require.NoError(t, err)
 
 	if fi, err := os.Stat(string(tmp)); err != nil || !fi.IsDir() {
@@ -179,6 +242,17 @@ func TestLocalSourceFSAdapter_CreateTempDirAndRemoveAll(t *testing.T) {
 	assert.True(t, os.IsNotExist(err))
 }
 
+func TestLocalSourceFSAdapter_CreateTempDirInWorkDir(t *testing.T) {
+	adapter := NewLocalSourceFSAdapter()
+	workDir := filepath.Join(t.TempDir(), "fast", "gooze")
+
+	tmp, err := adapter.CreateTempDir(m.Path(workDir), "gooze-test-*")
+	require.NoError(t, err)
+
+	assert.Equal(t, workDir, filepath.Dir(string(tmp)))
+	assert.DirExists(t, string(tmp))
+}
+
 func TestLocalSourceFSAdapter_CopyDirAndWriteFile(t *testing.T) {
 	adapter := NewLocalSourceFSAdapter()
 
@@ -209,6 +283,21 @@ func TestLocalSourceFSAdapter_CopyDirAndWriteFile(t *testing.T) {
 	}
 }
 
+func TestLocalSourceFSAdapter_CopySize(t *testing.T) {
+	adapter := NewLocalSourceFSAdapter()
+
+	src := t.TempDir()
+	mustMkdir(t, filepath.Join(src, "sub"))
+	mustMkdir(t, filepath.Join(src, ".git"))
+	writeTestFile(t, filepath.Join(src, "main.go"), "package main\n")
+	writeTestFile(t, filepath.Join(src, "sub", "sub.go"), "package sub\n")
+	writeTestFile(t, filepath.Join(src, ".git", "HEAD"), "ref: refs/heads/main\n")
+
+	size, err := adapter.CopySize(m.Path(src))
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("package main\n")+len("package sub\n")), size, ".git is not copied")
+}
+
 func TestLocalSourceFSAdapter_PathHelpers(t *testing.T) {
 	adapter := NewLocalSourceFSAdapter()
 
@@ -253,14 +342,14 @@ func TestLocalSourceFSAdapter_Get(t *testing.T) {
 
 		require.Len(t, sources, 1)
 
-		source := findSourceV2ByOrigin(sources, mainPath)
+		source := findSourceByOrigin(sources, mainPath)
 		require.NotNilf(t, source, "Get() did not include %s", mainPath)
 
-		assertSourceV2(t, source, mainPath, "main.go", mainContent, "main", testPath, "main_test.go", testContent)
+		assertSource(t, source, mainPath, "main.go", mainContent, "main", testPath, "main_test.go", testContent)
 
-		assert.Nil(t, findSourceV2ByOrigin(sources, nestedPath), "Get() unexpectedly included nested file for '.'")
+		assert.Nil(t, findSourceByOrigin(sources, nestedPath), "Get() unexpectedly included nested file for '.'")
 
-		assert.Nil(t, findSourceV2ByOrigin(sources, testPath), "Get() should not include test files as origins")
+		assert.Nil(t, findSourceByOrigin(sources, testPath), "Get() should not include test files as origins")
 	})
 
 	t.Run("tilde expands home directory", func(t *testing.T) {
@@ -274,10 +363,10 @@ func TestLocalSourceFSAdapter_Get(t *testing.T) {
 		sources, err := adapter.Get([]m.Path{"~"})
 		require.NoError(t, err)
 
-		source := findSourceV2ByOrigin(sources, mainPath)
+		source := findSourceByOrigin(sources, mainPath)
 		require.NotNilf(t, source, "Get() did not include %s", mainPath)
 
-		assertSourceV2(t, source, mainPath, "", mainContent, "main", "", "", nil)
+		assertSource(t, source, mainPath, "home.go", mainContent, "main", "", "", nil)
 	})
 
 	t.Run("parent directory path resolves", func(t *testing.T) {
@@ -297,10 +386,10 @@ func TestLocalSourceFSAdapter_Get(t *testing.T) {
 		sources, err := adapter.Get([]m.Path{"./../"})
 		require.NoError(t, err)
 
-		source := findSourceV2ByOrigin(sources, parentPath)
+		source := findSourceByOrigin(sources, parentPath)
 		require.NotNilf(t, source, "Get() did not include %s", parentPath)
 
-		assertSourceV2(t, source, parentPath, "", parentContent, "main", "", "", nil)
+		assertSource(t, source, parentPath, "main.go", parentContent, "main", "", "", nil)
 	})
 
 	t.Run("go style recursive path includes nested", func(t *testing.T) {
@@ -322,14 +411,15 @@ func TestLocalSourceFSAdapter_Get(t *testing.T) {
 
 		sources, err := adapter.Get([]m.Path{"./..."})
 		require.NoError(t, err)
-		mainSource := findSourceV2ByOrigin(sources, mainPath)
+		mainSource := findSourceByOrigin(sources, mainPath)
 		require.NotNilf(t, mainSource, "Get() did not include %s", mainPath)
-		assertSourceV2(t, mainSource, mainPath, "", mainContent, "main", "", "", nil)
+		assertSource(t, mainSource, mainPath, "main.go", mainContent, "main", "", "", nil)
 
-		nestedSource := findSourceV2ByOrigin(sources, nestedPath)
+		nestedSource := findSourceByOrigin(sources, nestedPath)
 		require.NotNil(t, nestedSource, "Get() did not include nested file for ./...")
 
-		assertSourceV2(t, nestedSource, nestedPath, "", nestedContent, "sub", "", "", nil)
+		assertSource(t, nestedSource, nestedPath, "nested/child.go", nestedContent, "sub", "", "", nil)
+		assert.False(t, nestedSource.PackageTests, "files found by scanning should run their matching test file")
 	})
 
 	t.Run("explicit nested path includes child file", func(t *testing.T) {
@@ -348,9 +438,27 @@ func TestLocalSourceFSAdapter_Get(t *testing.T) {
 		sources, err := adapter.Get([]m.Path{"./nested/..."})
 		require.NoError(t, err)
 
-		childSource := findSourceV2ByOrigin(sources, childPath)
+		childSource := findSourceByOrigin(sources, childPath)
 		require.NotNil(t, childSource, "Get() did not include nested child for ./nested/...")
-		assertSourceV2(t, childSource, childPath, "", childContent, "sub", "", "", nil)
+		assertSource(t, childSource, childPath, "child.go", childContent, "sub", "", "", nil)
+	})
+
+	t.Run("sources outside a module are named relative to the given path", func(t *testing.T) {
+		var shortPaths []m.Path
+
+		for range 2 {
+			root := t.TempDir()
+			mustMkdir(t, filepath.Join(root, "nested"))
+			copyExampleFile(t, filepath.Join(examplePath(t, "nested", "sub"), "child.go"), filepath.Join(root, "nested", "child.go"))
+
+			sources, err := adapter.Get([]m.Path{m.Path(root + "/...")})
+			require.NoError(t, err)
+			require.Len(t, sources, 1)
+
+			shortPaths = append(shortPaths, sources[0].Origin.ShortPath)
+		}
+
+		assert.Equal(t, []m.Path{"nested/child.go", "nested/child.go"}, shortPaths, "checkouts in different directories name their files alike")
 	})
 
 	t.Run("returns error for missing root", func(t *testing.T) {
@@ -371,7 +479,49 @@ func TestLocalSourceFSAdapter_Get(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, sources, 1)
 
-		assertSourceV2(t, &sources[0], mainPath, "", mainContent, "main", testPath, "", testContent)
+		assertSource(t, &sources[0], mainPath, "main.go", mainContent, "main", testPath, "main_test.go", testContent)
+		assert.True(t, sources[0].PackageTests, "files passed directly should run their package's tests")
+	})
+
+	t.Run("sources without a companion test use the package tests", func(t *testing.T) {
+		root := t.TempDir()
+		writeTestFile(t, filepath.Join(root, "calc.go"), "package calc\n\nfunc Add(a, b int) int { return a + b }\n")
+		writeTestFile(t, filepath.Join(root, "calc_test.go"), "package calc\n")
+		writeTestFile(t, filepath.Join(root, "util.go"), "package calc\n\nfunc Double(a int) int { return Add(a, a) }\n")
+		writeTestFile(t, filepath.Join(root, "util_integration_test.go"), "package calc_test\n")
+
+		noTests := filepath.Join(root, "notests")
+		mustMkdir(t, noTests)
+		writeTestFile(t, filepath.Join(noTests, "lone.go"), "package notests\n")
+
+		sources, err := adapter.Get([]m.Path{m.Path(root + "/...")})
+		require.NoError(t, err)
+
+		calc := findSourceByOrigin(sources, filepath.Join(root, "calc.go"))
+		require.NotNil(t, calc)
+		assert.NotNil(t, calc.Test)
+		assert.False(t, calc.PackageTests)
+		assert.Empty(t, calc.TestsHash)
+
+		util := findSourceByOrigin(sources, filepath.Join(root, "util.go"))
+		require.NotNil(t, util)
+		assert.Nil(t, util.Test)
+		assert.True(t, util.PackageTests, "tests in other files of the package should cover util.go")
+		assert.NotEmpty(t, util.TestsHash)
+
+		lone := findSourceByOrigin(sources, filepath.Join(noTests, "lone.go"))
+		require.NotNil(t, lone)
+		assert.False(t, lone.PackageTests)
+		assert.Empty(t, lone.TestsHash)
+
+		writeTestFile(t, filepath.Join(root, "util_integration_test.go"), "package calc_test\n\n// changed\n")
+
+		sources, err = adapter.Get([]m.Path{m.Path(root + "/...")})
+		require.NoError(t, err)
+
+		changed := findSourceByOrigin(sources, filepath.Join(root, "util.go"))
+		require.NotNil(t, changed)
+		assert.NotEqual(t, util.TestsHash, changed.TestsHash, "editing a package test file should change TestsHash")
 	})
 
 	t.Run("test file input yields no sources", func(t *testing.T) {
@@ -404,7 +554,7 @@ func TestLocalSourceFSAdapter_Get(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, sources, 1)
 
-		assertSourceV2(t, &sources[0], mainPath, "", mainContent, "main", "", "", nil)
+		assertSource(t, &sources[0], mainPath, "main.go", mainContent, "main", "", "", nil)
 	})
 
 	t.Run("ignore regex excludes matching files", func(t *testing.T) {
@@ -422,6 +572,25 @@ func TestLocalSourceFSAdapter_Get(t *testing.T) {
 		assert.Equal(t, m.Path(keptPath), sources[0].Origin.FullPath)
 	})
 
+	t.Run("test helper files are flagged", func(t *testing.T) {
+		root := t.TempDir()
+		writeTestFile(t, filepath.Join(root, "go.mod"), "module example.com/project\n")
+		helperPath := filepath.Join(root, "asserts.go")
+		mockPath := filepath.Join(root, "mock_store.go")
+		plainPath := filepath.Join(root, "calc.go")
+		writeTestFile(t, helperPath, "package calc\n\nimport \"testing\"\n\nfunc AssertSum(t testing.TB, got, want int) {\n\tif got != want {\n\t\tt.Fatalf(\"got %d\", got)\n\t}\n}\n")
+		writeTestFile(t, mockPath, "package calc\n\nimport mock \"github.com/stretchr/testify/mock\"\n\ntype MockStore struct{ mock.Mock }\n")
+		writeTestFile(t, plainPath, "package calc\n\nimport \"fmt\"\n\nfunc Sum(a, b int) string { return fmt.Sprint(a + b) }\n")
+
+		sources, err := adapter.Get([]m.Path{m.Path(root)})
+		require.NoError(t, err)
+		require.Len(t, sources, 3)
+
+		assert.True(t, findSourceByOrigin(sources, helperPath).TestHelper)
+		assert.True(t, findSourceByOrigin(sources, mockPath).TestHelper)
+		assert.False(t, findSourceByOrigin(sources, plainPath).TestHelper)
+	})
+
 	t.Run("broken source files are skipped", func(t *testing.T) {
 		root := t.TempDir()
 		brokenPath := filepath.Join(root, "broken.go")
@@ -479,7 +648,7 @@ func containsPath(paths []string, target string) bool {
 	return false
 }
 
-func findSourceV2ByOrigin(sources []m.Source, origin string) *m.Source {
+func findSourceByOrigin(sources []m.Source, origin string) *m.Source {
 	for i := range sources {
 		if sources[i].Origin == nil {
 			continue
@@ -492,7 +661,7 @@ func findSourceV2ByOrigin(sources []m.Source, origin string) *m.Source {
 	return nil
 }
 
-func assertSourceV2(t *testing.T, source *m.Source, originPath string, originShort string, originContent []byte, pkg string, testPath string, testShort string, testContent []byte) {
+func assertSource(t *testing.T, source *m.Source, originPath string, originShort string, originContent []byte, pkg string, testPath string, testShort string, testContent []byte) {
 	t.Helper()
 
 	if source == nil {
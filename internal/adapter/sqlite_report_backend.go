@@ -0,0 +1,422 @@
+package adapter
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+
+	// Registers the pure Go "sqlite" database/sql driver.
+	_ "modernc.org/sqlite"
+)
+
+// reportsDBFileName is the SQLite database the sqlite backend keeps the
+// reports of a reports directory in.
+const reportsDBFileName = "_reports.db"
+
+// reportsSchema holds one row per report and one per mutation result, so
+// the database can be queried directly, e.g. for the survivors of a file.
+const reportsSchema = `
+CREATE TABLE IF NOT EXISTS reports (
+	name              TEXT PRIMARY KEY,
+	origin_path       TEXT,
+	origin_short_path TEXT,
+	origin_hash       TEXT,
+	test_path         TEXT,
+	test_short_path   TEXT,
+	test_hash         TEXT,
+	package           TEXT,
+	tests_hash        TEXT NOT NULL DEFAULT '',
+	diff              BLOB
+);
+CREATE TABLE IF NOT EXISTS mutations (
+	report      TEXT NOT NULL REFERENCES reports(name) ON DELETE CASCADE ON UPDATE CASCADE,
+	position    INTEGER NOT NULL,
+	mutation_id TEXT NOT NULL,
+	type        TEXT NOT NULL,
+	version     INTEGER NOT NULL,
+	status      TEXT NOT NULL,
+	line        INTEGER NOT NULL DEFAULT 0,
+	err         TEXT NOT NULL DEFAULT '',
+	duration    INTEGER NOT NULL DEFAULT 0,
+	kill_reason TEXT NOT NULL DEFAULT '',
+	note        TEXT NOT NULL DEFAULT '',
+	suppressed  INTEGER NOT NULL DEFAULT 0,
+	equivalent  INTEGER NOT NULL DEFAULT 0,
+	cached      INTEGER NOT NULL DEFAULT 0,
+	test_output TEXT NOT NULL DEFAULT '',
+	merged_from TEXT NOT NULL DEFAULT '',
+	hint        TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (report, position)
+);
+CREATE INDEX IF NOT EXISTS mutations_mutation_id ON mutations (mutation_id);
+CREATE INDEX IF NOT EXISTS mutations_status ON mutations (status);
+CREATE INDEX IF NOT EXISTS reports_origin_path ON reports (origin_path);
+`
+
+// statusByName maps the status names stored in the database, which keep it
+// readable to queries, back to statuses.
+var statusByName = map[string]m.TestStatus{
+	m.Killed.String():       m.Killed,
+	m.Survived.String():     m.Survived,
+	m.Skipped.String():      m.Skipped,
+	m.Error.String():        m.Error,
+	m.NotRun.String():       m.NotRun,
+	m.Flaky.String():        m.Flaky,
+	m.CompileError.String(): m.CompileError,
+}
+
+// addedMutationColumns are the columns of the mutations table that
+// databases written by earlier versions lack, with their definitions.
+var addedMutationColumns = [][2]string{
+	{"cached", "INTEGER NOT NULL DEFAULT 0"},
+	{"test_output", "TEXT NOT NULL DEFAULT ''"},
+	{"merged_from", "TEXT NOT NULL DEFAULT ''"},
+	{"hint", "TEXT NOT NULL DEFAULT ''"},
+}
+
+// NewSQLiteReportStore constructs a store keeping the reports of a reports
+// directory in a single SQLite database, `_reports.db`, instead of one YAML
+// file each. Loading and checking for updates then reads one file however
+// many mutations the project has.
+func NewSQLiteReportStore() ReportStore {
+	return &LocalReportStore{backend: sqliteReportBackend{}}
+}
+
+// sqliteReportBackend stores reports in the `_reports.db` database of the
+// reports directory. Rows keep the names YAML files would have, so the index
+// reads the same for both backends.
+type sqliteReportBackend struct{}
+
+// open opens the database of dirPath. Without create, a missing database
+// yields a nil *sql.DB rather than an empty database on disk.
+func (sqliteReportBackend) open(dirPath string, create bool) (*sql.DB, error) {
+	dbPath := filepath.Join(dirPath, reportsDBFileName)
+
+	if !create {
+		if _, err := os.Stat(dbPath); errors.Is(err, os.ErrNotExist) {
+			return nil, nil //nolint:nilnil // a missing database holds no reports
+		}
+	}
+
+	db, err := sql.Open("sqlite", "file:"+filepath.ToSlash(dbPath)+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("open reports database %s: %w", dbPath, err)
+	}
+
+	if _, err := db.Exec(reportsSchema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("create reports database schema in %s: %w", dbPath, err)
+	}
+
+	if err := migrateReportsSchema(db); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("migrate reports database schema in %s: %w", dbPath, err)
+	}
+
+	return db, nil
+}
+
+// migrateReportsSchema adds the columns that CREATE TABLE IF NOT EXISTS does
+// not add to the tables of an existing database.
+func migrateReportsSchema(db *sql.DB) error {
+	rows, err := db.Query(`SELECT name FROM pragma_table_info('mutations')`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	existing := make(map[string]bool)
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+
+		existing[name] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, column := range addedMutationColumns {
+		if existing[column[0]] {
+			continue
+		}
+
+		if _, err := db.Exec(`ALTER TABLE mutations ADD COLUMN ` + column[0] + ` ` + column[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b sqliteReportBackend) read(dirPath string) ([]storedReport, error) {
+	db, err := b.open(dirPath, false)
+	if err != nil || db == nil {
+		return []storedReport{}, err
+	}
+	defer db.Close()
+
+	stored, err := readReportRows(db)
+	if err != nil {
+		return nil, fmt.Errorf("read reports database: %w", err)
+	}
+
+	return stored, nil
+}
+
+func readReportRows(db *sql.DB) ([]storedReport, error) {
+	rows, err := db.Query(`SELECT name, origin_path, origin_short_path, origin_hash,
+		test_path, test_short_path, test_hash, package, tests_hash, diff
+		FROM reports ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stored := make([]storedReport, 0)
+	byName := make(map[string]int)
+
+	for rows.Next() {
+		var (
+			name                               string
+			originPath, originShort, originHex sql.NullString
+			testPath, testShort, testHex       sql.NullString
+			pkg                                sql.NullString
+			testsHash                          string
+			diff                               []byte
+		)
+
+		if err := rows.Scan(&name, &originPath, &originShort, &originHex,
+			&testPath, &testShort, &testHex, &pkg, &testsHash, &diff); err != nil {
+			return nil, err
+		}
+
+		report := m.Report{
+			Source: m.Source{
+				Origin:    fileFromColumns(originPath, originShort, originHex),
+				Test:      fileFromColumns(testPath, testShort, testHex),
+				TestsHash: testsHash,
+			},
+			Result: m.Result{},
+		}
+
+		if pkg.Valid {
+			report.Source.Package = &pkg.String
+		}
+
+		if diff != nil {
+			report.Diff = &diff
+		}
+
+		byName[name] = len(stored)
+		stored = append(stored, storedReport{name: name, report: report})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := readMutationRows(db, stored, byName); err != nil {
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+// readMutationRows appends the mutation results to the reports they belong
+// to. Like the YAML files, the database keeps the error text of a result
+// for its readers but does not restore it.
+func readMutationRows(db *sql.DB, stored []storedReport, byName map[string]int) error {
+	rows, err := db.Query(`SELECT report, mutation_id, type, version, status, line,
+		duration, kill_reason, note, suppressed, equivalent, cached, test_output, merged_from, hint
+		FROM mutations ORDER BY report, position`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			name, status, merged string
+			result               m.MutationResult
+			duration             int64
+		)
+
+		if err := rows.Scan(&name, &result.MutationID, &result.Type.Name, &result.Type.Version, &status,
+			&result.Line, &duration, &result.KillReason, &result.Note, &result.Suppressed, &result.Equivalent, &result.Cached,
+			&result.TestOutput, &merged, &result.Hint); err != nil {
+			return err
+		}
+
+		parsed, ok := statusByName[status]
+		if !ok {
+			return fmt.Errorf("mutation %s has unknown status %q", result.MutationID, status)
+		}
+
+		result.Status = parsed
+		result.Duration = time.Duration(duration)
+
+		if merged != "" {
+			result.MergedFrom = strings.Split(merged, ",")
+		}
+
+		if index, ok := byName[name]; ok {
+			stored[index].report.Result = append(stored[index].report.Result, result)
+		}
+	}
+
+	return rows.Err()
+}
+
+func fileFromColumns(fullPath, shortPath, hash sql.NullString) *m.File {
+	if !fullPath.Valid {
+		return nil
+	}
+
+	return &m.File{FullPath: m.Path(fullPath.String), ShortPath: m.Path(shortPath.String), Hash: hash.String}
+}
+
+// fileColumns returns the path, short path and hash columns of file, all
+// NULL for a nil file.
+func fileColumns(file *m.File) (fullPath, shortPath, hash any) {
+	if file == nil {
+		return nil, nil, nil
+	}
+
+	return string(file.FullPath), string(file.ShortPath), file.Hash
+}
+
+// write stores reports in one transaction, so an interrupted save leaves
+// the database as it was.
+func (b sqliteReportBackend) write(dirPath string, reports []storedReport) error {
+	db, err := b.open(dirPath, true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return inTransaction(db, func(tx *sql.Tx) error {
+		for _, entry := range reports {
+			if err := writeReportRow(tx, entry); err != nil {
+				return fmt.Errorf("write report %s: %w", entry.name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func writeReportRow(tx *sql.Tx, entry storedReport) error {
+	source := entry.report.Source
+	originPath, originShort, originHex := fileColumns(source.Origin)
+	testPath, testShort, testHex := fileColumns(source.Test)
+
+	var pkg, diff any
+	if source.Package != nil {
+		pkg = *source.Package
+	}
+
+	if entry.report.Diff != nil {
+		diff = *entry.report.Diff
+	}
+
+	if _, err := tx.Exec(`DELETE FROM reports WHERE name = ?`, entry.name); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`INSERT INTO reports (name, origin_path, origin_short_path, origin_hash,
+		test_path, test_short_path, test_hash, package, tests_hash, diff)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		entry.name, originPath, originShort, originHex, testPath, testShort, testHex, pkg, source.TestsHash, diff); err != nil {
+		return err
+	}
+
+	for position, result := range entry.report.Result {
+		errString := ""
+		if result.Err != nil {
+			errString = result.Err.Error()
+		}
+
+		if _, err := tx.Exec(`INSERT INTO mutations (report, position, mutation_id, type, version, status,
+			line, err, duration, kill_reason, note, suppressed, equivalent, cached, test_output, merged_from, hint)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			entry.name, position, result.MutationID, result.Type.Name, result.Type.Version, result.Status.String(),
+			result.Line, errString, int64(result.Duration), string(result.KillReason), result.Note,
+			result.Suppressed, result.Equivalent, result.Cached, result.TestOutput,
+			strings.Join(result.MergedFrom, ","), result.Hint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b sqliteReportBackend) remove(dirPath string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	db, err := b.open(dirPath, false)
+	if err != nil || db == nil {
+		return err
+	}
+	defer db.Close()
+
+	return inTransaction(db, func(tx *sql.Tx) error {
+		for _, name := range names {
+			if _, err := tx.Exec(`DELETE FROM reports WHERE name = ?`, name); err != nil {
+				return fmt.Errorf("remove report %s: %w", name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (b sqliteReportBackend) rename(dirPath, from, to string) error {
+	db, err := b.open(dirPath, false)
+	if err != nil || db == nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`UPDATE reports SET name = ? WHERE name = ?`, to, from); err != nil {
+		return fmt.Errorf("rename report %s: %w", from, err)
+	}
+
+	return nil
+}
+
+// setAside removes the report: rows always decode, so only a report without
+// results is ever found corrupt.
+func (b sqliteReportBackend) setAside(dirPath, name string) error {
+	return b.remove(dirPath, []string{name})
+}
+
+func inTransaction(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("begin reports database transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit reports database transaction: %w", err)
+	}
+
+	return nil
+}
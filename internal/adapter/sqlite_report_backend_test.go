@@ -0,0 +1,292 @@
+package adapter
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func sqliteTestReports() []m.Report {
+	pkg := "calc"
+	diff := []byte("-\treturn a + b\n+\treturn a - b\n")
+
+	return []m.Report{
+		{
+			Source: m.Source{
+				Origin:  &m.File{FullPath: "/project/calc/add.go", ShortPath: "calc/add.go", Hash: "add"},
+				Test:    &m.File{FullPath: "/project/calc/add_test.go", ShortPath: "calc/add_test.go", Hash: "add_test"},
+				Package: &pkg,
+			},
+			Result: m.Result{
+				{MutationID: "add-1", Type: m.MutationArithmetic, Status: m.Survived, Line: 7, Duration: 2 * time.Second, MergedFrom: []string{"math"},
+					Hint: "no test asserts the return value of Add for operands of a + b other than 0 and 1"},
+				{MutationID: "add-2", Type: m.MutationBoolean, Status: m.Killed, Line: 9, KillReason: m.KillAssertion, Cached: true},
+				{MutationID: "add-3", Type: m.MutationBlank, Status: m.Killed, Line: 11, KillReason: m.KillBuild, TestOutput: "./add.go:11:2: declared and not used: x\n"},
+			},
+			Diff: &diff,
+		},
+		{
+			Source: m.Source{
+				Origin:    &m.File{FullPath: "/project/calc/sub.go", ShortPath: "calc/sub.go", Hash: "sub"},
+				TestsHash: "tests",
+			},
+			Result: m.Result{
+				{MutationID: "sub-1", Type: m.MutationArithmetic, Status: m.Skipped, Note: "quarantined", Suppressed: true},
+			},
+		},
+	}
+}
+
+func TestSQLiteReportStore_SaveAndLoadRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dir := m.Path(t.TempDir())
+	rs := NewSQLiteReportStore()
+	reports := sqliteTestReports()
+
+	if err := rs.SaveReports(dir, reports); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(string(dir), reportsDBFileName)); err != nil {
+		t.Fatalf("expected the reports database: %v", err)
+	}
+
+	entries, err := os.ReadDir(string(dir))
+	if err != nil {
+		t.Fatalf("read reports directory: %v", err)
+	}
+
+	for _, entry := range entries {
+		if filepath.Ext(entry.Name()) == ".yaml" {
+			t.Fatalf("expected no YAML report files, found %s", entry.Name())
+		}
+	}
+
+	loaded, err := rs.LoadReports(dir)
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	byOrigin := make(map[m.Path]m.Report)
+	for _, report := range loaded {
+		byOrigin[report.Source.Origin.FullPath] = report
+	}
+
+	for _, want := range reports {
+		got, ok := byOrigin[want.Source.Origin.FullPath]
+		if !ok {
+			t.Fatalf("report of %s not loaded", want.Source.Origin.FullPath)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("loaded report differs:\n got %+v\nwant %+v", got, want)
+		}
+	}
+}
+
+func TestSQLiteReportStore_SavingAgainReplacesReports(t *testing.T) {
+	t.Parallel()
+
+	dir := m.Path(t.TempDir())
+	rs := NewSQLiteReportStore()
+	reports := sqliteTestReports()
+
+	if err := rs.SaveReports(dir, reports); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	reports[0].Result[0].Status = m.Killed
+	if err := rs.SaveReports(dir, reports[:1]); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	loaded, err := rs.LoadReports(dir)
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(loaded) != 2 {
+		t.Fatalf("expected 2 reports, got %d", len(loaded))
+	}
+
+	for _, report := range loaded {
+		if report.Source.Origin.Hash == "add" && report.Result[0].Status != m.Killed {
+			t.Fatalf("expected the saved report to replace the stored one, got %v", report.Result[0].Status)
+		}
+	}
+}
+
+func TestSQLiteReportStore_MissingDatabaseHoldsNoReports(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rs := NewSQLiteReportStore()
+
+	loaded, err := rs.LoadReports(m.Path(dir))
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(loaded) != 0 {
+		t.Fatalf("expected no reports, got %d", len(loaded))
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, reportsDBFileName)); !os.IsNotExist(err) {
+		t.Fatalf("expected loading not to create the database, got %v", err)
+	}
+}
+
+func TestSQLiteReportStore_MigratesDatabasesWithoutNewColumns(t *testing.T) {
+	t.Parallel()
+
+	dir := m.Path(t.TempDir())
+
+	db, err := sql.Open("sqlite", filepath.Join(string(dir), reportsDBFileName))
+	if err != nil {
+		t.Fatalf("open reports database: %v", err)
+	}
+
+	// The mutations table as databases written before results were cached
+	// have it.
+	if _, err := db.Exec(`CREATE TABLE mutations (
+		report TEXT NOT NULL, position INTEGER NOT NULL, mutation_id TEXT NOT NULL,
+		type TEXT NOT NULL, version INTEGER NOT NULL, status TEXT NOT NULL,
+		line INTEGER NOT NULL DEFAULT 0, err TEXT NOT NULL DEFAULT '', duration INTEGER NOT NULL DEFAULT 0,
+		kill_reason TEXT NOT NULL DEFAULT '', note TEXT NOT NULL DEFAULT '',
+		suppressed INTEGER NOT NULL DEFAULT 0, equivalent INTEGER NOT NULL DEFAULT 0,
+		PRIMARY KEY (report, position))`); err != nil {
+		t.Fatalf("create old mutations table: %v", err)
+	}
+
+	_ = db.Close()
+
+	rs := NewSQLiteReportStore()
+	if err := rs.SaveReports(dir, sqliteTestReports()); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	loaded, err := rs.LoadReports(dir)
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	cached := 0
+
+	for _, report := range loaded {
+		for _, result := range report.Result {
+			if result.Cached {
+				cached++
+			}
+		}
+	}
+
+	if cached != 1 {
+		t.Fatalf("expected 1 cached result after migrating, got %d", cached)
+	}
+}
+
+func TestSQLiteReportStore_CleanIndexAndEquivalents(t *testing.T) {
+	t.Parallel()
+
+	dir := m.Path(t.TempDir())
+	rs := NewSQLiteReportStore()
+
+	if err := rs.SaveReports(dir, sqliteTestReports()); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	if err := rs.RegenerateIndex(dir); err != nil {
+		t.Fatalf("RegenerateIndex returned error: %v", err)
+	}
+
+	index, err := rs.LoadIndex(dir)
+	if err != nil {
+		t.Fatalf("LoadIndex returned error: %v", err)
+	}
+
+	if index.Total != 4 || index.Survived != 1 {
+		t.Fatalf("unexpected index counts: %+v", index.IndexCounts)
+	}
+
+	if err := rs.MarkEquivalent(dir, "add-1"); err != nil {
+		t.Fatalf("MarkEquivalent returned error: %v", err)
+	}
+
+	if err := rs.CleanReports(dir, []m.Source{{Origin: &m.File{FullPath: "/project/calc/sub.go"}}}); err != nil {
+		t.Fatalf("CleanReports returned error: %v", err)
+	}
+
+	loaded, err := rs.LoadReports(dir)
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	if len(loaded) != 1 || loaded[0].Source.Origin.Hash != "add" {
+		t.Fatalf("expected only the report of add.go to remain, got %+v", loaded)
+	}
+
+	if result := loaded[0].Result[0]; !result.Equivalent || result.Status != m.Skipped {
+		t.Fatalf("expected add-1 to be marked equivalent, got %+v", result)
+	}
+}
+
+func TestSQLiteReportStore_RepairIntegrityRenamesMisnamedReports(t *testing.T) {
+	t.Parallel()
+
+	dir := m.Path(t.TempDir())
+	rs := NewSQLiteReportStore()
+
+	if err := rs.SaveReports(dir, sqliteTestReports()); err != nil {
+		t.Fatalf("SaveReports returned error: %v", err)
+	}
+
+	db, err := sql.Open("sqlite", filepath.Join(string(dir), reportsDBFileName))
+	if err != nil {
+		t.Fatalf("open reports database: %v", err)
+	}
+
+	if _, err := db.Exec(`PRAGMA foreign_keys = ON; UPDATE reports SET name = 'stray.yaml' WHERE origin_hash = 'sub'`); err != nil {
+		t.Fatalf("rename report row: %v", err)
+	}
+
+	_ = db.Close()
+
+	report, err := rs.CheckIntegrity(dir)
+	if err != nil {
+		t.Fatalf("CheckIntegrity returned error: %v", err)
+	}
+
+	if report.Reports != 2 || problemKinds(report)["stray.yaml"] != m.IntegrityCollision {
+		t.Fatalf("expected stray.yaml to be reported as misnamed, got %+v", report)
+	}
+
+	if err := rs.RepairIntegrity(dir); err != nil {
+		t.Fatalf("RepairIntegrity returned error: %v", err)
+	}
+
+	report, err = rs.CheckIntegrity(dir)
+	if err != nil {
+		t.Fatalf("CheckIntegrity returned error: %v", err)
+	}
+
+	if len(report.Problems) != 0 {
+		t.Fatalf("expected no problems after repair, got %+v", report.Problems)
+	}
+
+	loaded, err := rs.LoadReports(dir)
+	if err != nil {
+		t.Fatalf("LoadReports returned error: %v", err)
+	}
+
+	for _, stored := range loaded {
+		if len(stored.Result) == 0 {
+			t.Fatalf("expected the renamed report to keep its results, got %+v", stored)
+		}
+	}
+}
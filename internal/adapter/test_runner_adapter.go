@@ -3,15 +3,66 @@ package adapter
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
+	"unicode"
 )
 
+// ErrGoToolchainNotFound is returned by RunGoTest when the go command cannot
+// be started, so callers can tell a broken environment from failing tests.
+var ErrGoToolchainNotFound = errors.New("go toolchain not found")
+
+// ErrTestTimeout is returned by RunGoTest when the run was stopped for
+// exceeding its timeout.
+var ErrTestTimeout = errors.New("go test timed out")
+
+// GoTestOptions tunes a single go test invocation.
+type GoTestOptions struct {
+	// Tags are passed to go test as -tags.
+	Tags []string
+	// Timeout bounds the run; zero uses the runner's default.
+	Timeout time.Duration
+	// Packages are further package patterns tested along with testFile.
+	Packages []string
+	// Run, when set, is passed to go test as -run.
+	Run string
+	// CoverProfile, when set, is passed to go test as -coverprofile.
+	CoverProfile string
+	// Race runs the tests with the race detector.
+	Race bool
+	// Args are further go test flags, such as -count=1 or -shuffle=on,
+	// passed before the packages.
+	Args []string
+	// Env holds further environment variables of the tests, as KEY=value.
+	Env []string
+}
+
 // TestRunnerAdapter abstracts test execution operations for mutation testing.
 type TestRunnerAdapter interface {
 	// RunGoTest runs 'go test' on a specific test file in the given directory.
+	// testFile may also be a package pattern such as "." to run every test of
+	// the package in workDir. A test file is run as its package narrowed to
+	// the tests it declares, so it builds against the rest of the package.
 	// Returns the combined stdout/stderr output and any error.
-	RunGoTest(workDir, testFile string) (output string, err error)
+	RunGoTest(workDir, testFile string, opts GoTestOptions) (output string, err error)
+	// BuildTestBinary compiles the test binary of the package RunGoTest
+	// would test for testFile to binary, as 'go test -c' does, with the
+	// tags and race detector of opts. It returns the build output.
+	BuildTestBinary(workDir, testFile, binary string, opts GoTestOptions) (output string, err error)
+	// RunTestBinary runs binary, built by BuildTestBinary with the same
+	// workDir and testFile, as RunGoTest would run the tests: from the
+	// package directory, narrowed to the tests of testFile and opts.Run.
+	// Options that change the build are ignored.
+	RunTestBinary(binary, workDir, testFile string, opts GoTestOptions) (output string, err error)
 }
 
 // LocalTestRunnerAdapter provides a concrete implementation using os/exec.
@@ -27,13 +78,151 @@ func NewLocalTestRunnerAdapter() *LocalTestRunnerAdapter {
 }
 
 // RunGoTest runs 'go test' on a specific test file in the given directory.
-func (a *LocalTestRunnerAdapter) RunGoTest(workDir, testFile string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+func (a *LocalTestRunnerAdapter) RunGoTest(workDir, testFile string, opts GoTestOptions) (string, error) {
+	args, err := goTestArgs(workDir, testFile, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return a.run(workDir, opts, "go", args...)
+}
+
+// BuildTestBinary compiles the test binary of testFile's package with
+// 'go test -c'.
+func (a *LocalTestRunnerAdapter) BuildTestBinary(workDir, testFile, binary string, opts GoTestOptions) (string, error) {
+	args, err := testBinaryBuildArgs(workDir, testFile, binary, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return a.run(workDir, opts, "go", args...)
+}
+
+// RunTestBinary runs a test binary built by BuildTestBinary.
+func (a *LocalTestRunnerAdapter) RunTestBinary(binary, workDir, testFile string, opts GoTestOptions) (string, error) {
+	dir, args, err := testBinaryRunArgs(workDir, testFile, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return a.run(dir, opts, binary, args...)
+}
+
+// run runs name with args in dir, with the environment and timeout of opts.
+func (a *LocalTestRunnerAdapter) run(dir string, opts GoTestOptions, name string, args ...string) (string, error) {
+	timeout := a.timeout
+	if opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "go", "test", "-v", testFile)
-	cmd.Dir = workDir
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Env = append(sandboxEnv(os.Environ()), opts.Env...)
+	detachFromTerminal(cmd)
+
+	output, err := runTestCommand(ctx, cmd, timeout)
+	if errors.Is(err, exec.ErrNotFound) {
+		return output, fmt.Errorf("%w: %w", ErrGoToolchainNotFound, err)
+	}
+
+	return output, err
+}
+
+// goTestArgs returns the arguments of the go command testing testFile, or
+// the package pattern passed instead, in workDir.
+func goTestArgs(workDir, testFile string, opts GoTestOptions) ([]string, error) {
+	target, run := testFile, opts.Run
+
+	if strings.HasSuffix(testFile, "_test.go") {
+		var err error
+
+		target, run, err = testFileTarget(workDir, testFile, opts.Run)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	args := []string{"test", "-v"}
+	if len(opts.Tags) > 0 {
+		args = append(args, "-tags", strings.Join(opts.Tags, ","))
+	}
+
+	if run != "" {
+		args = append(args, "-run", run)
+	}
+
+	if opts.CoverProfile != "" {
+		args = append(args, "-coverprofile", opts.CoverProfile)
+	}
+
+	if opts.Race {
+		args = append(args, "-race")
+	}
+
+	args = append(args, opts.Args...)
+
+	args = append(args, target)
+	args = append(args, opts.Packages...)
+
+	return args, nil
+}
 
+// testBinaryBuildArgs returns the arguments of the go command compiling the
+// test binary of testFile's package, or of the package pattern passed
+// instead, to binary.
+func testBinaryBuildArgs(workDir, testFile, binary string, opts GoTestOptions) ([]string, error) {
+	target := testFile
+
+	if strings.HasSuffix(testFile, "_test.go") {
+		var err error
+
+		target, _, err = testFileTarget(workDir, testFile, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	args := []string{"test", "-c", "-o", binary}
+	if len(opts.Tags) > 0 {
+		args = append(args, "-tags", strings.Join(opts.Tags, ","))
+	}
+
+	if opts.Race {
+		args = append(args, "-race")
+	}
+
+	return append(args, target), nil
+}
+
+// testBinaryRunArgs returns the package directory a test binary built for
+// testFile runs in, as go test runs it, and the arguments go test would
+// pass it.
+func testBinaryRunArgs(workDir, testFile string, opts GoTestOptions) (string, []string, error) {
+	target, run := testFile, opts.Run
+
+	if strings.HasSuffix(testFile, "_test.go") {
+		var err error
+
+		target, run, err = testFileTarget(workDir, testFile, opts.Run)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	args := []string{"-test.v", "-test.paniconexit0"}
+	if run != "" {
+		args = append(args, "-test.run", run)
+	}
+
+	return filepath.Join(workDir, target), args, nil
+}
+
+// runTestCommand runs cmd, started with ctx, and returns its combined output.
+// A run stopped by ctx's deadline fails with ErrTestTimeout.
+func runTestCommand(ctx context.Context, cmd *exec.Cmd, timeout time.Duration) (string, error) {
 	var stdout, stderr bytes.Buffer
 
 	cmd.Stdout = &stdout
@@ -43,5 +232,94 @@ func (a *LocalTestRunnerAdapter) RunGoTest(workDir, testFile string) (string, er
 
 	output := stdout.String() + stderr.String()
 
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return output, fmt.Errorf("%w after %s: %w", ErrTestTimeout, timeout, err)
+	}
+
 	return output, err
 }
+
+// testFileTarget returns the package of testFile, relative to workDir, and a
+// -run expression selecting the tests, examples and fuzz targets the file
+// declares. Passing the file itself to go test would compile it without the
+// rest of its package. When run is set, only the declared tests it matches
+// are kept.
+func testFileTarget(workDir, testFile, run string) (string, string, error) {
+	path := testFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workDir, path)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return "", "", fmt.Errorf("parse test file %s: %w", testFile, err)
+	}
+
+	var filter *regexp.Regexp
+
+	if run != "" {
+		filter, err = regexp.Compile(run)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid -run expression %q: %w", run, err)
+		}
+	}
+
+	var names []string
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !isTestFuncName(fn.Name.Name) {
+			continue
+		}
+
+		if filter == nil || filter.MatchString(fn.Name.Name) {
+			names = append(names, regexp.QuoteMeta(fn.Name.Name))
+		}
+	}
+
+	dir, err := filepath.Rel(workDir, filepath.Dir(path))
+	if err != nil {
+		return "", "", fmt.Errorf("resolve package of %s: %w", testFile, err)
+	}
+
+	target := "."
+	if dir != "." {
+		target = "./" + filepath.ToSlash(dir)
+	}
+
+	return target, "^(" + strings.Join(names, "|") + ")$", nil
+}
+
+// isTestFuncName reports whether name is one go test runs with -run.
+func isTestFuncName(name string) bool {
+	for _, prefix := range []string{"Test", "Example", "Fuzz"} {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+
+		if rest == "" || !unicode.IsLower([]rune(rest)[0]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sandboxEnv drops a GOWORK naming a go.work file: it points at the original
+// project, so tests would build the unmutated modules. Without it the go
+// command finds the go.work copied into workDir's project. GOWORK=off and
+// GOWORK=auto are kept.
+func sandboxEnv(environ []string) []string {
+	env := make([]string, 0, len(environ))
+
+	for _, kv := range environ {
+		if value, ok := strings.CutPrefix(kv, "GOWORK="); ok && value != "off" && value != "auto" && value != "" {
+			continue
+		}
+
+		env = append(env, kv)
+	}
+
+	return env
+}
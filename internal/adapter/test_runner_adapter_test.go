@@ -1,9 +1,12 @@
 package adapter
 
 import (
+	"errors"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 // These tests exercise LocalTestRunnerAdapter against the real example
@@ -16,7 +19,7 @@ func TestLocalTestRunnerAdapter_RunGoTest_Success(t *testing.T) {
 	workDir := filepath.Join("..", "..", "examples", "basic")
 	testTarget := "./..."
 
-	out, err := adapter.RunGoTest(workDir, testTarget)
+	out, err := adapter.RunGoTest(workDir, testTarget, GoTestOptions{})
 	if err != nil {
 		t.Fatalf("RunGoTest() error = %v, output = %s", err, out)
 	}
@@ -34,7 +37,7 @@ func TestLocalTestRunnerAdapter_RunGoTest_Failure(t *testing.T) {
 	workDir := filepath.Join("..", "..", "examples", "basic")
 	testTarget := "./does_not_exist"
 
-	out, err := adapter.RunGoTest(workDir, testTarget)
+	out, err := adapter.RunGoTest(workDir, testTarget, GoTestOptions{})
 	if err == nil {
 		t.Fatalf("RunGoTest() expected error for missing test target, got nil (output=%s)", out)
 	}
@@ -43,3 +46,240 @@ func TestLocalTestRunnerAdapter_RunGoTest_Failure(t *testing.T) {
 		t.Fatalf("RunGoTest() expected some diagnostic output for failure, got empty string")
 	}
 }
+
+func TestLocalTestRunnerAdapter_RunGoTest_MissingToolchain(t *testing.T) {
+	t.Setenv("PATH", "")
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	workDir := filepath.Join("..", "..", "examples", "basic")
+
+	_, err := adapter.RunGoTest(workDir, "./...", GoTestOptions{})
+	if !errors.Is(err, ErrGoToolchainNotFound) {
+		t.Fatalf("RunGoTest() error = %v, want ErrGoToolchainNotFound", err)
+	}
+}
+
+func TestLocalTestRunnerAdapter_RunGoTest_Tags(t *testing.T) {
+	workDir := t.TempDir()
+	writeTestFile(t, filepath.Join(workDir, "go.mod"), "module example.com/tagged\n\ngo 1.21\n")
+	writeTestFile(t, filepath.Join(workDir, "tagged.go"), "package tagged\n")
+	writeTestFile(t, filepath.Join(workDir, "tagged_test.go"),
+		"//go:build gooze_tagged\n\npackage tagged\n\nimport \"testing\"\n\nfunc TestTagged(t *testing.T) { t.Fatal(\"tagged\") }\n")
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	if out, err := adapter.RunGoTest(workDir, ".", GoTestOptions{}); err != nil {
+		t.Fatalf("RunGoTest() without tags error = %v, output = %s", err, out)
+	}
+
+	out, err := adapter.RunGoTest(workDir, ".", GoTestOptions{Tags: []string{"gooze_tagged"}})
+	if err == nil || !strings.Contains(out, "TestTagged") {
+		t.Fatalf("RunGoTest() with tags error = %v, output = %s, want TestTagged to run and fail", err, out)
+	}
+}
+
+func TestLocalTestRunnerAdapter_RunGoTest_Run(t *testing.T) {
+	workDir := t.TempDir()
+	writeTestFile(t, filepath.Join(workDir, "go.mod"), "module example.com/narrow\n\ngo 1.21\n")
+	writeTestFile(t, filepath.Join(workDir, "narrow_test.go"),
+		"package narrow\n\nimport \"testing\"\n\nfunc TestPass(t *testing.T) {}\n\nfunc TestFail(t *testing.T) { t.Fatal(\"fail\") }\n")
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	if out, err := adapter.RunGoTest(workDir, ".", GoTestOptions{Run: "^TestPass$"}); err != nil {
+		t.Fatalf("RunGoTest() with -run error = %v, output = %s", err, out)
+	}
+
+	if out, err := adapter.RunGoTest(workDir, ".", GoTestOptions{}); err == nil {
+		t.Fatalf("RunGoTest() without -run expected TestFail to fail, output = %s", out)
+	}
+}
+
+func TestLocalTestRunnerAdapter_RunGoTest_Args(t *testing.T) {
+	workDir := t.TempDir()
+	writeTestFile(t, filepath.Join(workDir, "go.mod"), "module example.com/shuffled\n\ngo 1.21\n")
+	writeTestFile(t, filepath.Join(workDir, "shuffled_test.go"),
+		"package shuffled\n\nimport \"testing\"\n\nfunc TestOne(t *testing.T) {}\n\nfunc TestTwo(t *testing.T) {}\n")
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	out, err := adapter.RunGoTest(workDir, ".", GoTestOptions{Args: []string{"-count=1", "-shuffle=42"}})
+	if err != nil {
+		t.Fatalf("RunGoTest() with args error = %v, output = %s", err, out)
+	}
+
+	if !strings.Contains(out, "-test.shuffle 42") || strings.Contains(out, "(cached)") {
+		t.Fatalf("RunGoTest() output = %s, want an uncached run shuffled with seed 42", out)
+	}
+}
+
+func TestLocalTestRunnerAdapter_RunGoTest_Race(t *testing.T) {
+	workDir := t.TempDir()
+	writeTestFile(t, filepath.Join(workDir, "go.mod"), "module example.com/racy\n\ngo 1.22\n")
+	writeTestFile(t, filepath.Join(workDir, "racy_test.go"),
+		"package racy\n\nimport (\n\t\"sync\"\n\t\"testing\"\n)\n\nfunc TestRacy(t *testing.T) {\n\tn := 0\n\n\tvar wg sync.WaitGroup\n\n\tfor range 2 {\n\t\twg.Add(1)\n\n\t\tgo func() {\n\t\t\tdefer wg.Done()\n\t\t\tn++\n\t\t}()\n\t}\n\n\twg.Wait()\n}\n")
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	if out, err := adapter.RunGoTest(workDir, ".", GoTestOptions{}); err != nil {
+		t.Fatalf("RunGoTest() without -race error = %v, output = %s", err, out)
+	}
+
+	out, err := adapter.RunGoTest(workDir, ".", GoTestOptions{Race: true, Timeout: 2 * time.Minute})
+	if err == nil || !strings.Contains(out, "WARNING: DATA RACE") {
+		t.Fatalf("RunGoTest() with -race error = %v, output = %s, want the race reported", err, out)
+	}
+}
+
+func TestLocalTestRunnerAdapter_RunGoTest_Timeout(t *testing.T) {
+	workDir := t.TempDir()
+	writeTestFile(t, filepath.Join(workDir, "go.mod"), "module example.com/slow\n\ngo 1.21\n")
+	writeTestFile(t, filepath.Join(workDir, "slow_test.go"),
+		"package slow\n\nimport (\n\t\"testing\"\n\t\"time\"\n)\n\nfunc TestSlow(t *testing.T) { time.Sleep(time.Minute) }\n")
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	start := time.Now()
+
+	_, err := adapter.RunGoTest(workDir, ".", GoTestOptions{Timeout: 3 * time.Second})
+	if !errors.Is(err, ErrTestTimeout) {
+		t.Fatalf("RunGoTest() error = %v, want ErrTestTimeout", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 20*time.Second {
+		t.Fatalf("RunGoTest() took %v, want it bounded by the 3s timeout", elapsed)
+	}
+}
+
+func TestSandboxEnv_DropsGoworkFile(t *testing.T) {
+	env := sandboxEnv([]string{"HOME=/home/dev", "GOWORK=/src/go.work", "GOFLAGS=-race"})
+	if strings.Join(env, " ") != "HOME=/home/dev GOFLAGS=-race" {
+		t.Fatalf("sandboxEnv() = %v, want GOWORK dropped", env)
+	}
+
+	for _, keep := range []string{"GOWORK=off", "GOWORK=auto"} {
+		if env := sandboxEnv([]string{keep}); len(env) != 1 || env[0] != keep {
+			t.Fatalf("sandboxEnv() = %v, want %s kept", env, keep)
+		}
+	}
+}
+
+func TestLocalTestRunnerAdapter_RunGoTest_TestFile(t *testing.T) {
+	workDir := t.TempDir()
+	writeTestFile(t, filepath.Join(workDir, "go.mod"), "module example.com/calc\n\ngo 1.21\n")
+	writeTestFile(t, filepath.Join(workDir, "calc.go"), "package calc\n\nfunc Add(a, b int) int { return a + b }\n")
+	writeTestFile(t, filepath.Join(workDir, "calc_test.go"),
+		"package calc\n\nimport \"testing\"\n\nfunc TestAdd(t *testing.T) {\n\tif Add(2, 3) != 5 {\n\t\tt.Fatal(\"add\")\n\t}\n}\n\nfunc TestAddFails(t *testing.T) { t.Fatal(\"fail\") }\n\nfunc Testing() {}\n")
+	writeTestFile(t, filepath.Join(workDir, "other_test.go"),
+		"package calc\n\nimport \"testing\"\n\nfunc TestOther(t *testing.T) { t.Fatal(\"other\") }\n")
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	// The file builds against calc.go and only its own tests run.
+	out, err := adapter.RunGoTest(workDir, filepath.Join(workDir, "calc_test.go"), GoTestOptions{Run: "^TestAdd$"})
+	if err != nil {
+		t.Fatalf("RunGoTest() on a test file error = %v, output = %s", err, out)
+	}
+
+	if strings.Contains(out, "TestOther") || strings.Contains(out, "TestAddFails") {
+		t.Fatalf("RunGoTest() ran tests outside the file or -run, output = %s", out)
+	}
+
+	out, err = adapter.RunGoTest(workDir, "calc_test.go", GoTestOptions{})
+	if err == nil || !strings.Contains(out, "TestAddFails") || strings.Contains(out, "TestOther") {
+		t.Fatalf("RunGoTest() error = %v, output = %s, want only the file's tests to run", err, out)
+	}
+}
+
+func TestIsTestFuncName(t *testing.T) {
+	for name, want := range map[string]bool{
+		"Test": true, "TestAdd": true, "Test_add": true, "ExampleAdd": true, "FuzzAdd": true,
+		"Testing": false, "Examples": false, "BenchmarkAdd": false, "helper": false,
+	} {
+		if got := isTestFuncName(name); got != want {
+			t.Errorf("isTestFuncName(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+// writeEnvModule writes a module whose test passes only with GOOZE_TEST_ENV
+// set, and returns its directory.
+func writeEnvModule(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":      "module example.com/env\n\ngo 1.21\n",
+		"env_test.go": "package env\n\nimport (\n\t\"os\"\n\t\"testing\"\n)\n\nfunc TestEnv(t *testing.T) {\n\tif os.Getenv(\"GOOZE_TEST_ENV\") != \"set\" {\n\t\tt.Fatal(\"GOOZE_TEST_ENV not set\")\n\t}\n}\n",
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	return dir
+}
+
+func TestLocalTestRunnerAdapter_RunGoTest_Env(t *testing.T) {
+	dir := writeEnvModule(t)
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	if out, err := adapter.RunGoTest(dir, ".", GoTestOptions{Env: []string{"GOOZE_TEST_ENV=set"}}); err != nil {
+		t.Fatalf("RunGoTest() error = %v, output = %s", err, out)
+	}
+
+	if _, err := adapter.RunGoTest(dir, ".", GoTestOptions{Args: []string{"-count=1"}}); err == nil {
+		t.Fatalf("RunGoTest() expected the test to fail without the variable")
+	}
+}
+
+func TestLocalTestRunnerAdapter_TestBinary(t *testing.T) {
+	dir := writeEnvModule(t)
+	testFile := filepath.Join(dir, "env_test.go")
+	binary := filepath.Join(t.TempDir(), "env.test")
+
+	adapter := NewLocalTestRunnerAdapter()
+
+	if out, err := adapter.BuildTestBinary(dir, testFile, binary, GoTestOptions{}); err != nil {
+		t.Fatalf("BuildTestBinary() error = %v, output = %s", err, out)
+	}
+
+	out, err := adapter.RunTestBinary(binary, dir, testFile, GoTestOptions{Env: []string{"GOOZE_TEST_ENV=set"}})
+	if err != nil {
+		t.Fatalf("RunTestBinary() error = %v, output = %s", err, out)
+	}
+
+	if !strings.Contains(out, "=== RUN   TestEnv") {
+		t.Fatalf("RunTestBinary() output does not look like verbose test output: %q", out)
+	}
+
+	if out, err := adapter.RunTestBinary(binary, dir, testFile, GoTestOptions{}); err == nil || !strings.Contains(out, "--- FAIL: TestEnv") {
+		t.Fatalf("RunTestBinary() expected TestEnv to fail without the variable, error = %v, output = %s", err, out)
+	}
+}
+
+func TestTestBinaryArgs(t *testing.T) {
+	dir := writeEnvModule(t)
+
+	build, err := testBinaryBuildArgs(dir, filepath.Join(dir, "env_test.go"), "/tmp/env.test", GoTestOptions{Tags: []string{"a", "b"}, Race: true, Args: []string{"-count=1"}})
+	if err != nil {
+		t.Fatalf("testBinaryBuildArgs() error = %v", err)
+	}
+
+	if got := strings.Join(build, " "); got != "test -c -o /tmp/env.test -tags a,b -race ." {
+		t.Fatalf("testBinaryBuildArgs() = %q", got)
+	}
+
+	runDir, run, err := testBinaryRunArgs(dir, filepath.Join(dir, "env_test.go"), GoTestOptions{Run: "Env$"})
+	if err != nil {
+		t.Fatalf("testBinaryRunArgs() error = %v", err)
+	}
+
+	if runDir != dir || strings.Join(run, " ") != "-test.v -test.paniconexit0 -test.run ^(TestEnv)$" {
+		t.Fatalf("testBinaryRunArgs() = %s, %q", runDir, run)
+	}
+}
@@ -0,0 +1,89 @@
+package adapter
+
+import (
+	"fmt"
+	"net/http"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// WebhookNotifier is a Notifier posting the summary as JSON to a URL, for
+// chat integrations and dashboards.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier constructs a WebhookNotifier posting to url.
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: notifierTimeout},
+	}
+}
+
+// webhookPayload is the JSON body of a webhook. Text is a one-line summary,
+// which Slack and Mattermost incoming webhooks display as the message.
+type webhookPayload struct {
+	Text        string            `json:"text"`
+	Score       float64           `json:"score"`
+	BaseScore   *float64          `json:"base_score,omitempty"`
+	Killed      int               `json:"killed"`
+	Survived    int               `json:"survived"`
+	Failed      int               `json:"failed"`
+	Skipped     int               `json:"skipped"`
+	NotRun      int               `json:"not_run"`
+	TopSurvived []webhookSurvivor `json:"top_survived"`
+}
+
+type webhookSurvivor struct {
+	ID    string `json:"id"`
+	Path  string `json:"path"`
+	Type  string `json:"type"`
+	Diff  string `json:"diff,omitempty"`
+	Added bool   `json:"added,omitempty"`
+}
+
+// Notify posts summary to the webhook URL.
+func (w *WebhookNotifier) Notify(summary m.RunSummary) error {
+	err := sendJSON(w.client, jsonRequest{
+		method:  http.MethodPost,
+		url:     w.url,
+		payload: newWebhookPayload(summary),
+	})
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+
+	return nil
+}
+
+func newWebhookPayload(summary m.RunSummary) webhookPayload {
+	payload := webhookPayload{
+		Text:        fmt.Sprintf("%s: %d killed, %d survived", scoreLine(summary), summary.Killed, summary.Survived),
+		Score:       summary.Score,
+		Killed:      summary.Killed,
+		Survived:    summary.Survived,
+		Failed:      summary.Failed,
+		Skipped:     summary.Skipped,
+		NotRun:      summary.NotRun,
+		TopSurvived: make([]webhookSurvivor, 0, len(summary.TopSurvived)),
+	}
+
+	if summary.HasBase {
+		baseScore := summary.BaseScore
+		payload.BaseScore = &baseScore
+	}
+
+	for _, survivor := range summary.TopSurvived {
+		payload.TopSurvived = append(payload.TopSurvived, webhookSurvivor{
+			ID:    survivor.ID,
+			Path:  string(survivor.Path),
+			Type:  survivor.Type.Name,
+			Diff:  string(survivor.Diff),
+			Added: survivor.Added,
+		})
+	}
+
+	return payload
+}
@@ -0,0 +1,77 @@
+package adapter
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestWebhookNotifier_Notify_PostsJSONSummary(t *testing.T) {
+	t.Parallel()
+
+	var payload map[string]any
+	var contentType string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	summary := m.RunSummary{
+		Score:     0.5,
+		Killed:    1,
+		Survived:  1,
+		BaseScore: 1,
+		HasBase:   true,
+		TopSurvived: []m.SurvivedMutation{
+			{ID: "abc123", Path: "pkg/calc.go", Type: m.MutationArithmetic, Diff: []byte("-a\n+b\n"), Added: true},
+		},
+	}
+
+	if err := NewWebhookNotifier(server.URL).Notify(summary); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	if contentType != "application/json" {
+		t.Fatalf("expected a JSON body, got Content-Type %q", contentType)
+	}
+
+	text, _ := payload["text"].(string)
+	if !strings.Contains(text, "Mutation score: 50.00% (-50.00 points") || !strings.Contains(text, "1 survived") {
+		t.Fatalf("unexpected text %q", text)
+	}
+
+	if payload["score"] != 0.5 || payload["base_score"] != 1.0 || payload["survived"] != 1.0 {
+		t.Fatalf("unexpected payload %v", payload)
+	}
+
+	survivors, _ := payload["top_survived"].([]any)
+	if len(survivors) != 1 || survivors[0].(map[string]any)["path"] != "pkg/calc.go" {
+		t.Fatalf("unexpected top_survived %v", payload["top_survived"])
+	}
+}
+
+func TestWebhookNotifier_Notify_OmitsBaseScoreWithoutBase(t *testing.T) {
+	t.Parallel()
+
+	payload := newWebhookPayload(m.RunSummary{Score: 1, Killed: 2})
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+
+	if strings.Contains(string(data), "base_score") {
+		t.Fatalf("expected no base_score, got %s", data)
+	}
+
+	if !strings.Contains(string(data), `"top_survived":[]`) {
+		t.Fatalf("expected an empty top_survived list, got %s", data)
+	}
+}
@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+)
+
+// WorkerLauncher starts the worker processes that test mutations for a run,
+// typically on other machines.
+type WorkerLauncher interface {
+	// Launch runs command through the shell, for example
+	// "ssh build-1 'cd src/project && gooze worker'", and returns a
+	// connection writing to its stdin and reading from its stdout. Closing
+	// the connection closes stdin and waits for the process to exit.
+	Launch(command string) (io.ReadWriteCloser, error)
+}
+
+// LocalWorkerLauncher starts worker commands with /bin/sh.
+type LocalWorkerLauncher struct{}
+
+// NewLocalWorkerLauncher constructs a LocalWorkerLauncher.
+func NewLocalWorkerLauncher() *LocalWorkerLauncher {
+	return &LocalWorkerLauncher{}
+}
+
+// Launch starts command; its stderr is passed through to ours so that ssh
+// and worker errors reach the user.
+func (l *LocalWorkerLauncher) Launch(command string) (io.ReadWriteCloser, error) {
+	cmd := exec.Command("/bin/sh", "-c", command)
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("connect to worker %q: %w", command, err)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("connect to worker %q: %w", command, err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("start worker %q: %w", command, err)
+	}
+
+	return &workerProcess{cmd: cmd, stdin: stdin, stdout: stdout}, nil
+}
+
+// workerProcess is a started worker command.
+type workerProcess struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.ReadCloser
+}
+
+func (p *workerProcess) Read(b []byte) (int, error) {
+	return p.stdout.Read(b)
+}
+
+func (p *workerProcess) Write(b []byte) (int, error) {
+	return p.stdin.Write(b)
+}
+
+func (p *workerProcess) Close() error {
+	closeErr := p.stdin.Close()
+	if err := p.cmd.Wait(); err != nil {
+		return errors.Join(closeErr, fmt.Errorf("worker %q: %w", p.cmd.Args[len(p.cmd.Args)-1], err))
+	}
+
+	return closeErr
+}
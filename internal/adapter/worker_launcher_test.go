@@ -0,0 +1,41 @@
+package adapter
+
+import (
+	"bufio"
+	"testing"
+)
+
+func TestLocalWorkerLauncher_Launch(t *testing.T) {
+	conn, err := NewLocalWorkerLauncher().Launch("cat")
+	if err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+
+	if _, err := conn.Write([]byte("job\n")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		t.Fatalf("ReadString() error = %v", err)
+	}
+
+	if line != "job\n" {
+		t.Fatalf("expected the worker to echo the job, got %q", line)
+	}
+
+	if err := conn.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+}
+
+func TestLocalWorkerLauncher_CloseReportsFailedWorker(t *testing.T) {
+	conn, err := NewLocalWorkerLauncher().Launch("exit 3")
+	if err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+
+	if err := conn.Close(); err == nil {
+		t.Fatal("expected Close() to report the worker's exit status")
+	}
+}
@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// renderCacheStatus renders the size and contents of the reports directory
+// and the stored sources that are stale or outdated.
+func renderCacheStatus(status m.CacheStatus) string {
+	var out bytes.Buffer
+
+	if status.Sources == 0 && len(status.Stale) == 0 {
+		fmt.Fprintf(&out, "No reports stored in %s yet.\n", status.Dir)
+		return out.String()
+	}
+
+	fmt.Fprintf(&out, "Reports directory: %s (%s)\n", status.Dir, m.FormatBytes(uint64(max(status.Size, 0))))
+	fmt.Fprintf(&out, "Stored sources: %d (%d mutations)\n", status.Sources, status.Mutations)
+
+	renderCachePaths(&out, "Outdated sources, tested again by the next run", status.Outdated)
+
+	if status.Cleaned {
+		renderCachePaths(&out, "Removed stale reports", status.Stale)
+		return out.String()
+	}
+
+	renderCachePaths(&out, "Stale reports, of sources no longer on disk", status.Stale)
+
+	if len(status.Stale) > 0 {
+		out.WriteString("\nRun `gooze cache clean` to remove the stale reports.\n")
+	}
+
+	return out.String()
+}
+
+func renderCachePaths(out *bytes.Buffer, title string, paths []m.Path) {
+	fmt.Fprintf(out, "\n%s: %d\n", title, len(paths))
+
+	for _, path := range paths {
+		fmt.Fprintf(out, "  %s\n", relativeToWorkDir(path))
+	}
+}
+
+// relativeToWorkDir shortens path for display when it lies below the working
+// directory.
+func relativeToWorkDir(path m.Path) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return string(path)
+	}
+
+	rel, err := filepath.Rel(wd, string(path))
+	if err != nil || !filepath.IsLocal(rel) {
+		return string(path)
+	}
+
+	return rel
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func TestSimpleUI_DisplayCacheStatus(t *testing.T) {
+	status := m.CacheStatus{
+		Dir:       ".gooze-reports",
+		Size:      1536,
+		Sources:   3,
+		Mutations: 12,
+		Stale:     []m.Path{"/elsewhere/gone.go"},
+		Outdated:  []m.Path{"/elsewhere/changed.go"},
+	}
+
+	tests := []struct {
+		name    string
+		cleaned bool
+		want    []string
+	}{
+		{"status", false, []string{
+			"Reports directory: .gooze-reports (1.5 KiB)",
+			"Stored sources: 3 (12 mutations)",
+			"Outdated sources, tested again by the next run: 1\n  /elsewhere/changed.go",
+			"Stale reports, of sources no longer on disk: 1\n  /elsewhere/gone.go",
+			"gooze cache clean",
+		}},
+		{"cleaned", true, []string{"Removed stale reports: 1\n  /elsewhere/gone.go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetOut(&buf)
+
+			status.Cleaned = tt.cleaned
+			if err := NewSimpleUI(cmd).DisplayCacheStatus(status, nil); err != nil {
+				t.Fatalf("DisplayCacheStatus() error = %v", err)
+			}
+
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Fatalf("output missing %q\noutput:\n%s", want, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRenderCacheStatus_Empty(t *testing.T) {
+	got := renderCacheStatus(m.CacheStatus{Dir: ".gooze-reports"})
+	if got != "No reports stored in .gooze-reports yet.\n" {
+		t.Fatalf("renderCacheStatus() = %q", got)
+	}
+}
+
+func TestJSONUI_DisplayCacheStatus(t *testing.T) {
+	var buf bytes.Buffer
+
+	ui := NewJSONUI(&buf)
+	if err := ui.DisplayCacheStatus(m.CacheStatus{Dir: ".gooze-reports", Size: 10, Sources: 1, Stale: []m.Path{"/p/gone.go"}}, nil); err != nil {
+		t.Fatalf("DisplayCacheStatus() error = %v", err)
+	}
+
+	var event ProgressEvent
+	if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+		t.Fatalf("decode event: %v", err)
+	}
+
+	if event.Event != EventCache || event.Cache == nil {
+		t.Fatalf("expected a cache event, got %+v", event)
+	}
+
+	if event.Cache.SizeBytes != 10 || len(event.Cache.Stale) != 1 || event.Cache.Outdated == nil {
+		t.Fatalf("unexpected cache payload: %+v", event.Cache)
+	}
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/olekukonko/tablewriter"
+)
+
+// renderComparison renders the score change and the mutants whose outcome
+// changed. Tables use Markdown pipes so the output can be pasted into a pull
+// request comment as is.
+func renderComparison(comparison m.ReportComparison) string {
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "Mutation score: %.2f%% -> %.2f%% (%+.2f points)\n",
+		comparison.OldScore*100, comparison.NewScore*100, (comparison.NewScore-comparison.OldScore)*100)
+
+	renderComparedMutations(&out, "Newly survived mutants", comparison.NewlySurvived)
+	renderComparedMutations(&out, "Newly killed mutants", comparison.NewlyKilled)
+
+	return out.String()
+}
+
+func renderComparedMutations(out *bytes.Buffer, title string, mutations []m.ComparedMutation) {
+	fmt.Fprintf(out, "\n%s: %d\n", title, len(mutations))
+
+	if len(mutations) == 0 {
+		return
+	}
+
+	out.WriteString("\n")
+
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"Path", "Type", "Mutation", "Change"})
+	table.SetAutoWrapText(false)
+	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+	table.SetCenterSeparator("|")
+
+	for _, mutation := range mutations {
+		change := "status changed"
+		if mutation.Added {
+			change = "new mutant"
+		}
+
+		table.Append([]string{string(mutation.Path), mutation.Type.Name, mutation.ID, change})
+	}
+
+	table.Render()
+}
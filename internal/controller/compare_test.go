@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func TestSimpleUI_DisplayComparison_RendersMarkdownTables(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	comparison := m.ReportComparison{
+		OldScore: 0.8,
+		NewScore: 0.75,
+		NewlySurvived: []m.ComparedMutation{
+			{ID: "a1", Path: "pkg/calc.go", Type: m.MutationArithmetic, Added: true},
+		},
+		NewlyKilled: []m.ComparedMutation{},
+	}
+
+	ui := NewSimpleUI(cmd)
+	if err := ui.DisplayComparison(comparison, nil); err != nil {
+		t.Fatalf("DisplayComparison() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"Mutation score: 80.00% -> 75.00% (-5.00 points)",
+		"Newly survived mutants: 1",
+		"| pkg/calc.go | arithmetic | a1       | new mutant |",
+		"Newly killed mutants: 0",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("output missing %q\noutput:\n%s", want, output)
+		}
+	}
+}
+
+func TestJSONUI_DisplayComparison(t *testing.T) {
+	var buf bytes.Buffer
+	ui := NewJSONUI(&buf)
+
+	comparison := m.ReportComparison{
+		OldScore:    0.5,
+		NewScore:    1,
+		NewlyKilled: []m.ComparedMutation{{ID: "a1", Path: "calc.go", Type: m.MutationBoolean}},
+	}
+
+	if err := ui.DisplayComparison(comparison, nil); err != nil {
+		t.Fatalf("DisplayComparison() error = %v", err)
+	}
+
+	events := decodeEvents(t, buf.String())
+	if len(events) != 1 || events[0].Event != EventDiff || events[0].Diff == nil {
+		t.Fatalf("expected a single diff event, got %+v", events)
+	}
+
+	got := events[0].Diff
+	if got.OldScore != 0.5 || got.NewScore != 1 || len(got.NewlySurvived) != 0 {
+		t.Fatalf("unexpected diff: %+v", got)
+	}
+
+	if len(got.NewlyKilled) != 1 || got.NewlyKilled[0].MutationID != "a1" || got.NewlyKilled[0].Type != "boolean" {
+		t.Fatalf("unexpected newly killed: %+v", got.NewlyKilled)
+	}
+}
@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/olekukonko/tablewriter"
+)
+
+// renderCorpusTable renders a path by mutation type matrix of mutation counts.
+// Rows are keyed by full path because a corpus may span several modules whose
+// short paths collide. A mutation counts for every mutator that generated
+// it, including those merged into it as duplicates.
+func renderCorpusTable(mutations []m.Mutation) string {
+	counts := make(map[string]map[string]int)
+	totals := make(map[string]int)
+	wd, _ := os.Getwd()
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil {
+			continue
+		}
+
+		path := corpusDisplayPath(wd, mutation.Source.Origin)
+		if counts[path] == nil {
+			counts[path] = make(map[string]int)
+		}
+
+		for _, name := range mutation.TypeNames() {
+			counts[path][name]++
+			totals[name]++
+		}
+	}
+
+	paths := make([]string, 0, len(counts))
+	for path := range counts {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	header := []string{"Path"}
+	footer := []string{fmt.Sprintf("Total Files %d", len(paths))}
+
+	for _, mutationType := range m.MutationTypes {
+		header = append(header, mutationType.Name)
+		footer = append(footer, fmt.Sprintf("%d", totals[mutationType.Name]))
+	}
+
+	var tableBuffer bytes.Buffer
+
+	table := tablewriter.NewWriter(&tableBuffer)
+	table.SetHeader(header)
+	table.SetBorder(false)
+	table.SetCenterSeparator("")
+
+	for _, path := range paths {
+		row := []string{path}
+		for _, mutationType := range m.MutationTypes {
+			row = append(row, fmt.Sprintf("%d", counts[path][mutationType.Name]))
+		}
+
+		table.Append(row)
+	}
+
+	table.SetFooter(footer)
+	table.Render()
+
+	return tableBuffer.String()
+}
+
+func corpusDisplayPath(wd string, file *m.File) string {
+	if wd != "" && file.FullPath != "" {
+		if rel, err := filepath.Rel(wd, string(file.FullPath)); err == nil {
+			return rel
+		}
+	}
+
+	return string(file.DisplayPath())
+}
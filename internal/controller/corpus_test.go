@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func TestSimpleUI_DisplayCorpusReport_CountsPerFileAndType(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd() error = %v", err)
+	}
+
+	// Both files share a short path; rows must still be separate.
+	first := &m.File{ShortPath: "main.go", FullPath: m.Path(filepath.Join(wd, "loops", "main.go"))}
+	second := &m.File{ShortPath: "main.go", FullPath: m.Path(filepath.Join(wd, "basic", "main.go"))}
+
+	mutations := []m.Mutation{
+		{Source: m.Source{Origin: first}, Type: m.MutationLoop},
+		{Source: m.Source{Origin: first}, Type: m.MutationLoop},
+		{Source: m.Source{Origin: second}, Type: m.MutationArithmetic},
+		{Source: m.Source{Origin: nil}, Type: m.MutationBoolean},
+	}
+
+	ui := NewSimpleUI(cmd)
+	if err := ui.DisplayCorpusReport(mutations, nil); err != nil {
+		t.Fatalf("DisplayCorpusReport() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		filepath.Join("basic", "main.go"),
+		filepath.Join("loops", "main.go"),
+		"LOOP",
+		"TOTAL FILES 2",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("output missing %q\noutput:\n%s", want, output)
+		}
+	}
+}
+
+func TestSimpleUI_DisplayCorpusReport_Error(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	ui := NewSimpleUI(cmd)
+	if err := ui.DisplayCorpusReport(nil, errors.New("boom")); err == nil {
+		t.Fatalf("DisplayCorpusReport() expected error")
+	}
+
+	if !strings.Contains(buf.String(), "corpus report error: boom") {
+		t.Fatalf("output missing error message\noutput:\n%s", buf.String())
+	}
+}
+
+func TestTUI_DisplayCorpusReport_WritesWithoutProgram(t *testing.T) {
+	var buf bytes.Buffer
+	tui := NewTUI(&buf)
+
+	mutations := []m.Mutation{{Source: m.Source{Origin: &m.File{ShortPath: "a.go"}}, Type: m.MutationUnary}}
+	if err := tui.DisplayCorpusReport(mutations, nil); err != nil {
+		t.Fatalf("DisplayCorpusReport() error = %v", err)
+	}
+
+	if tui.started {
+		t.Fatalf("expected corpus report not to start the interactive program")
+	}
+
+	if !strings.Contains(buf.String(), "a.go") {
+		t.Fatalf("output missing path\noutput:\n%s", buf.String())
+	}
+}
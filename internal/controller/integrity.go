@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// renderIntegrity renders the problems found in a reports directory and,
+// after a repair, those it fixed.
+func renderIntegrity(report m.IntegrityReport) string {
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "Checked %d report files in %s.\n", report.Reports, report.Dir)
+
+	if report.Repaired {
+		renderIntegrityProblems(&out, "Fixed", report.Fixed)
+	}
+
+	if len(report.Problems) == 0 {
+		out.WriteString("\nNo problems found.\n")
+		return out.String()
+	}
+
+	renderIntegrityProblems(&out, "Problems", report.Problems)
+
+	if !report.Repaired {
+		out.WriteString("\nRun `gooze verify-reports --fix` to set corrupt reports aside and rebuild the index.\n")
+	}
+
+	return out.String()
+}
+
+func renderIntegrityProblems(out *bytes.Buffer, title string, problems []m.IntegrityProblem) {
+	fmt.Fprintf(out, "\n%s: %d\n", title, len(problems))
+
+	for _, problem := range problems {
+		fmt.Fprintf(out, "  %-9s %s: %s\n", problem.Kind, problem.File, problem.Detail)
+	}
+}
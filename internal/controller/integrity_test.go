@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestRenderIntegrity(t *testing.T) {
+	missing := m.IntegrityProblem{Kind: m.IntegrityMissing, File: "b.yaml", Detail: "listed in _index/x.yaml"}
+	corrupt := m.IntegrityProblem{Kind: m.IntegrityCorrupt, File: "a.yaml", Detail: "yaml: line 1"}
+
+	tests := []struct {
+		name   string
+		report m.IntegrityReport
+		want   []string
+	}{
+		{"clean", m.IntegrityReport{Dir: ".gooze-reports", Reports: 3}, []string{
+			"Checked 3 report files in .gooze-reports.",
+			"No problems found.",
+		}},
+		{"problems", m.IntegrityReport{Dir: ".gooze-reports", Reports: 3, Problems: []m.IntegrityProblem{missing}}, []string{
+			"Problems: 1\n  missing   b.yaml: listed in _index/x.yaml",
+			"gooze verify-reports --fix",
+		}},
+		{"repaired", m.IntegrityReport{Dir: ".gooze-reports", Reports: 2, Repaired: true, Fixed: []m.IntegrityProblem{corrupt}}, []string{
+			"Fixed: 1\n  corrupt   a.yaml: yaml: line 1",
+			"No problems found.",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderIntegrity(tt.report)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Fatalf("output missing %q\noutput:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
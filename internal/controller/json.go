@@ -0,0 +1,516 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// Progress event names written by JSONUI.
+const (
+	EventEstimate    = "estimate"
+	EventRuntime     = "runtime"
+	EventCorpus      = "corpus"
+	EventRun         = "run"
+	EventUpcoming    = "upcoming"
+	EventStarted     = "started"
+	EventCompleted   = "completed"
+	EventScore       = "score"
+	EventStats       = "stats"
+	EventTrend       = "trend"
+	EventDiff        = "diff"
+	EventCache       = "cache"
+	EventVerify      = "verify"
+	EventBudget      = "budget"
+	EventInterrupted = "interrupted"
+	EventCached      = "cached"
+	EventWatch       = "watch"
+	EventError       = "error"
+)
+
+// ProgressEvent is one line of the newline-delimited JSON progress stream.
+// Fields irrelevant to an event are omitted.
+type ProgressEvent struct {
+	Event      string           `json:"event"`
+	Time       time.Time        `json:"time"`
+	MutationID string           `json:"mutation_id,omitempty"`
+	Type       string           `json:"type,omitempty"`
+	Path       string           `json:"path,omitempty"`
+	Line       int              `json:"line,omitempty"`
+	Thread     *int             `json:"thread,omitempty"`
+	Status     string           `json:"status,omitempty"`
+	KillReason string           `json:"kill_reason,omitempty"`
+	DurationMS *int64           `json:"duration_ms,omitempty"`
+	Error      string           `json:"error,omitempty"`
+	Count      *int             `json:"count,omitempty"`
+	Threads    int              `json:"threads,omitempty"`
+	ShardIndex *int             `json:"shard_index,omitempty"`
+	ShardCount int              `json:"shard_count,omitempty"`
+	Score      *float64         `json:"score,omitempty"`
+	Files      []ProgressFile   `json:"files,omitempty"`
+	Stats      *ProgressStats   `json:"stats,omitempty"`
+	Runs       []ProgressRun    `json:"runs,omitempty"`
+	Diff       *ProgressDiff    `json:"diff,omitempty"`
+	Cache      *ProgressCache   `json:"cache,omitempty"`
+	Verify     *ProgressVerify  `json:"verify,omitempty"`
+	Runtime    *ProgressRuntime `json:"runtime,omitempty"`
+	Changed    []string         `json:"changed,omitempty"`
+}
+
+// ProgressFile carries per-file mutation counts for estimate and corpus events.
+type ProgressFile struct {
+	Path      string         `json:"path"`
+	Mutations int            `json:"mutations"`
+	ByType    map[string]int `json:"by_type,omitempty"`
+}
+
+// ProgressStats carries run history trends for stats events.
+type ProgressStats struct {
+	Runs                  []ProgressRun  `json:"runs"`
+	NewSurvivorsByWeek    []ProgressWeek `json:"new_survivors_by_week"`
+	MeanTimeToKillSeconds *float64       `json:"mean_time_to_kill_seconds,omitempty"`
+	KilledSurvivors       int            `json:"killed_survivors"`
+	OpenSurvivors         int            `json:"open_survivors"`
+}
+
+// ProgressRun is one recorded run in stats and trend events.
+type ProgressRun struct {
+	Time       time.Time `json:"time"`
+	Commit     string    `json:"commit,omitempty"`
+	Total      int       `json:"total"`
+	Killed     int       `json:"killed"`
+	Survived   int       `json:"survived"`
+	Skipped    int       `json:"skipped"`
+	Errors     int       `json:"errors"`
+	Score      float64   `json:"score"`
+	DurationMS int64     `json:"duration_ms"`
+}
+
+// ProgressDiff carries the comparison of two report sets for diff events.
+type ProgressDiff struct {
+	OldScore      float64             `json:"old_score"`
+	NewScore      float64             `json:"new_score"`
+	NewlySurvived []ProgressDiffEntry `json:"newly_survived"`
+	NewlyKilled   []ProgressDiffEntry `json:"newly_killed"`
+}
+
+// ProgressDiffEntry is a mutant whose outcome changed in a diff event.
+type ProgressDiffEntry struct {
+	MutationID string `json:"mutation_id"`
+	Path       string `json:"path"`
+	Type       string `json:"type"`
+	Added      bool   `json:"added,omitempty"`
+}
+
+// ProgressCache describes the reports directory for cache events.
+type ProgressCache struct {
+	Dir       string   `json:"dir"`
+	SizeBytes int64    `json:"size_bytes"`
+	Sources   int      `json:"sources"`
+	Mutations int      `json:"mutations"`
+	Stale     []string `json:"stale"`
+	Outdated  []string `json:"outdated"`
+	Cleaned   bool     `json:"cleaned,omitempty"`
+}
+
+// ProgressRuntime carries the projected duration of a run for runtime
+// events.
+type ProgressRuntime struct {
+	Packages   int   `json:"packages"`
+	Threads    int   `json:"threads"`
+	TotalMS    int64 `json:"total_ms"`
+	ShardIndex int   `json:"shard_index"`
+	ShardCount int   `json:"shard_count"`
+	ShardMS    int64 `json:"shard_ms"`
+}
+
+// ProgressVerify carries the outcome of checking a reports directory for
+// verify events.
+type ProgressVerify struct {
+	Dir      string            `json:"dir"`
+	Reports  int               `json:"reports"`
+	Problems []ProgressProblem `json:"problems"`
+	Repaired bool              `json:"repaired,omitempty"`
+	Fixed    []ProgressProblem `json:"fixed,omitempty"`
+}
+
+// ProgressProblem is one problem found in a reports directory.
+type ProgressProblem struct {
+	Kind   string `json:"kind"`
+	File   string `json:"file"`
+	Detail string `json:"detail"`
+}
+
+// ProgressWeek counts newly introduced survivors in an ISO week.
+type ProgressWeek struct {
+	Week  string `json:"week"`
+	Count int    `json:"count"`
+}
+
+// JSONUI implements UI by writing one JSON event per line, for wrappers such
+// as IDE plugins and CI dashboards that track progress programmatically.
+type JSONUI struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	now     func() time.Time
+}
+
+// NewJSONUI creates a JSONUI writing events to output.
+func NewJSONUI(output io.Writer) *JSONUI {
+	return &JSONUI{encoder: json.NewEncoder(output), now: time.Now}
+}
+
+// Start initializes the UI (no-op for JSONUI).
+func (j *JSONUI) Start(_ ...StartOption) error {
+	return nil
+}
+
+// Close finalizes the UI (no-op for JSONUI).
+func (j *JSONUI) Close() {}
+
+// Wait returns immediately; JSONUI never waits for user input.
+func (j *JSONUI) Wait() {}
+
+// Done returns nil: JSONUI cannot be closed by the user.
+func (j *JSONUI) Done() <-chan struct{} {
+	return nil
+}
+
+// DisplayEstimation emits an estimate event with per-file, per-type mutation counts.
+func (j *JSONUI) DisplayEstimation(mutations []m.Mutation, err error) error {
+	if err != nil {
+		j.emit(ProgressEvent{Event: EventError, Error: err.Error()})
+		return err
+	}
+
+	total := len(mutations)
+	j.emit(ProgressEvent{Event: EventEstimate, Count: &total, Files: progressFiles(mutations)})
+
+	return nil
+}
+
+// DisplayRuntimeEstimate emits a runtime event with the projected duration
+// of the run and of the chosen shard.
+func (j *JSONUI) DisplayRuntimeEstimate(estimate m.RuntimeEstimate, err error) error {
+	if err != nil {
+		j.emit(ProgressEvent{Event: EventError, Error: err.Error()})
+		return err
+	}
+
+	j.emit(ProgressEvent{Event: EventRuntime, Runtime: &ProgressRuntime{
+		Packages:   estimate.Packages,
+		Threads:    estimate.Threads,
+		TotalMS:    estimate.Total.Milliseconds(),
+		ShardIndex: estimate.ShardIndex,
+		ShardCount: estimate.ShardCount,
+		ShardMS:    estimate.Shard.Milliseconds(),
+	}})
+
+	return nil
+}
+
+// DisplayCorpusReport emits a corpus event with per-file, per-type counts.
+func (j *JSONUI) DisplayCorpusReport(mutations []m.Mutation, err error) error {
+	if err != nil {
+		j.emit(ProgressEvent{Event: EventError, Error: err.Error()})
+		return err
+	}
+
+	total := len(mutations)
+	j.emit(ProgressEvent{Event: EventCorpus, Count: &total, Files: progressFiles(mutations)})
+
+	return nil
+}
+
+// DisplayStats emits a stats event with run history trends.
+func (j *JSONUI) DisplayStats(stats m.HistoryStats, err error) error {
+	if err != nil {
+		j.emit(ProgressEvent{Event: EventError, Error: err.Error()})
+		return err
+	}
+
+	j.emit(ProgressEvent{Event: EventStats, Stats: progressStats(stats)})
+
+	return nil
+}
+
+// DisplayTrend emits a trend event listing the charted runs.
+func (j *JSONUI) DisplayTrend(runs []m.RunRecord, err error) error {
+	if err != nil {
+		j.emit(ProgressEvent{Event: EventError, Error: err.Error()})
+		return err
+	}
+
+	out := make([]ProgressRun, 0, len(runs))
+	for _, run := range runs {
+		out = append(out, progressRun(run))
+	}
+
+	j.emit(ProgressEvent{Event: EventTrend, Runs: out})
+
+	return nil
+}
+
+// DisplayComparison emits a diff event comparing two report sets.
+func (j *JSONUI) DisplayComparison(comparison m.ReportComparison, err error) error {
+	if err != nil {
+		j.emit(ProgressEvent{Event: EventError, Error: err.Error()})
+		return err
+	}
+
+	j.emit(ProgressEvent{Event: EventDiff, Diff: &ProgressDiff{
+		OldScore:      comparison.OldScore,
+		NewScore:      comparison.NewScore,
+		NewlySurvived: progressDiffEntries(comparison.NewlySurvived),
+		NewlyKilled:   progressDiffEntries(comparison.NewlyKilled),
+	}})
+
+	return nil
+}
+
+// DisplayCacheStatus emits a cache event describing the reports directory.
+func (j *JSONUI) DisplayCacheStatus(status m.CacheStatus, err error) error {
+	if err != nil {
+		j.emit(ProgressEvent{Event: EventError, Error: err.Error()})
+		return err
+	}
+
+	j.emit(ProgressEvent{Event: EventCache, Cache: &ProgressCache{
+		Dir:       string(status.Dir),
+		SizeBytes: status.Size,
+		Sources:   status.Sources,
+		Mutations: status.Mutations,
+		Stale:     pathStrings(status.Stale),
+		Outdated:  pathStrings(status.Outdated),
+		Cleaned:   status.Cleaned,
+	}})
+
+	return nil
+}
+
+// DisplayIntegrity emits a verify event listing the problems found in a
+// reports directory.
+func (j *JSONUI) DisplayIntegrity(report m.IntegrityReport, err error) error {
+	if err != nil {
+		j.emit(ProgressEvent{Event: EventError, Error: err.Error()})
+		return err
+	}
+
+	verify := &ProgressVerify{
+		Dir:      string(report.Dir),
+		Reports:  report.Reports,
+		Problems: progressProblems(report.Problems),
+		Repaired: report.Repaired,
+	}
+
+	if report.Repaired {
+		verify.Fixed = progressProblems(report.Fixed)
+	}
+
+	j.emit(ProgressEvent{Event: EventVerify, Verify: verify})
+
+	return nil
+}
+
+// DisplayConcurrencyInfo emits a run event describing workers and sharding.
+func (j *JSONUI) DisplayConcurrencyInfo(threads int, shardIndex int, count int) {
+	j.emit(ProgressEvent{Event: EventRun, Threads: threads, ShardIndex: &shardIndex, ShardCount: count})
+}
+
+// DisplayUpcomingTestsInfo emits the number of mutations about to be tested.
+func (j *JSONUI) DisplayUpcomingTestsInfo(i int) {
+	j.emit(ProgressEvent{Event: EventUpcoming, Count: &i})
+}
+
+// DisplayStartingTestInfo emits a started event for a mutation.
+func (j *JSONUI) DisplayStartingTestInfo(currentMutation m.Mutation, threadID int) {
+	event := mutationEvent(EventStarted, currentMutation)
+	event.Thread = &threadID
+
+	j.emit(event)
+}
+
+// DisplayCompletedTestInfo emits a completed event with status and duration.
+func (j *JSONUI) DisplayCompletedTestInfo(currentMutation m.Mutation, mutationResult m.MutationResult) {
+	event := mutationEvent(EventCompleted, currentMutation)
+	event.Status = formatTestStatus(mutationResult.Status)
+	event.KillReason = string(mutationResult.KillReason)
+
+	durationMS := mutationResult.Duration.Milliseconds()
+	event.DurationMS = &durationMS
+
+	if mutationResult.Err != nil {
+		event.Error = mutationResult.Err.Error()
+	}
+
+	j.emit(event)
+}
+
+// DisplayMutationScore emits the final mutation score as a fraction in [0, 1].
+func (j *JSONUI) DisplayMutationScore(score float64) {
+	j.emit(ProgressEvent{Event: EventScore, Score: &score})
+}
+
+// DisplayBudgetExhausted emits a budget event with the budget in duration_ms
+// and the untested mutations in count.
+func (j *JSONUI) DisplayBudgetExhausted(budget time.Duration, notRun int) {
+	ms := budget.Milliseconds()
+	j.emit(ProgressEvent{Event: EventBudget, DurationMS: &ms, Count: &notRun})
+}
+
+// DisplayInterrupted emits an interrupted event with the untested mutations
+// in count.
+func (j *JSONUI) DisplayInterrupted(notRun int) {
+	j.emit(ProgressEvent{Event: EventInterrupted, Count: &notRun})
+}
+
+// DisplayCachedResults emits a cached event with the mutations whose
+// verdicts were reused in count.
+func (j *JSONUI) DisplayCachedResults(cached int) {
+	j.emit(ProgressEvent{Event: EventCached, Count: &cached})
+}
+
+// DisplayWatchCycle emits a watch event listing the changed files that
+// started the cycle, with the error if it failed.
+func (j *JSONUI) DisplayWatchCycle(changed []m.Path, err error) {
+	event := ProgressEvent{Event: EventWatch}
+
+	for _, path := range changed {
+		event.Changed = append(event.Changed, string(path))
+	}
+
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	j.emit(event)
+}
+
+func (j *JSONUI) emit(event ProgressEvent) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	event.Time = j.now().UTC()
+	_ = j.encoder.Encode(event)
+}
+
+func mutationEvent(name string, mutation m.Mutation) ProgressEvent {
+	return ProgressEvent{
+		Event:      name,
+		MutationID: mutation.ID,
+		Type:       mutation.Type.Name,
+		Path:       string(mutation.Source.Origin.DisplayPath()),
+		Line:       mutation.Position.Line,
+	}
+}
+
+func pathStrings(paths []m.Path) []string {
+	out := make([]string, 0, len(paths))
+	for _, path := range paths {
+		out = append(out, string(path))
+	}
+
+	return out
+}
+
+func progressProblems(problems []m.IntegrityProblem) []ProgressProblem {
+	out := make([]ProgressProblem, 0, len(problems))
+	for _, problem := range problems {
+		out = append(out, ProgressProblem{Kind: string(problem.Kind), File: problem.File, Detail: problem.Detail})
+	}
+
+	return out
+}
+
+func progressStats(stats m.HistoryStats) *ProgressStats {
+	out := &ProgressStats{
+		Runs:               make([]ProgressRun, 0, len(stats.Runs)),
+		NewSurvivorsByWeek: make([]ProgressWeek, 0, len(stats.NewSurvivorsByWeek)),
+		KilledSurvivors:    stats.KilledSurvivors,
+		OpenSurvivors:      stats.OpenSurvivors,
+	}
+
+	for _, run := range stats.Runs {
+		out.Runs = append(out.Runs, progressRun(run))
+	}
+
+	for _, week := range stats.NewSurvivorsByWeek {
+		out.NewSurvivorsByWeek = append(out.NewSurvivorsByWeek, ProgressWeek{Week: week.Week, Count: week.Count})
+	}
+
+	if stats.KilledSurvivors > 0 {
+		seconds := stats.MeanTimeToKill.Seconds()
+		out.MeanTimeToKillSeconds = &seconds
+	}
+
+	return out
+}
+
+func progressRun(run m.RunRecord) ProgressRun {
+	return ProgressRun{
+		Time:       run.Time.UTC(),
+		Commit:     run.Commit,
+		Total:      run.Total,
+		Killed:     run.Killed,
+		Survived:   run.Survived,
+		Skipped:    run.Skipped,
+		Errors:     run.Errors,
+		Score:      run.Score,
+		DurationMS: run.Duration.Milliseconds(),
+	}
+}
+
+func progressDiffEntries(mutations []m.ComparedMutation) []ProgressDiffEntry {
+	out := make([]ProgressDiffEntry, 0, len(mutations))
+	for _, mutation := range mutations {
+		out = append(out, ProgressDiffEntry{
+			MutationID: mutation.ID,
+			Path:       string(mutation.Path),
+			Type:       mutation.Type.Name,
+			Added:      mutation.Added,
+		})
+	}
+
+	return out
+}
+
+func progressFiles(mutations []m.Mutation) []ProgressFile {
+	filesByPath := make(map[string]*ProgressFile)
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil {
+			continue
+		}
+
+		path := string(mutation.Source.Origin.DisplayPath())
+
+		file, ok := filesByPath[path]
+		if !ok {
+			file = &ProgressFile{Path: path}
+			filesByPath[path] = file
+		}
+
+		file.Mutations++
+
+		if file.ByType == nil {
+			file.ByType = make(map[string]int)
+		}
+
+		file.ByType[mutation.Type.Name]++
+	}
+
+	files := make([]ProgressFile, 0, len(filesByPath))
+	for _, file := range filesByPath {
+		files = append(files, *file)
+	}
+
+	sort.Slice(files, func(i, k int) bool {
+		return files[i].Path < files[k].Path
+	})
+
+	return files
+}
@@ -0,0 +1,266 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func decodeEvents(t *testing.T, output string) []ProgressEvent {
+	t.Helper()
+
+	var events []ProgressEvent
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		var event ProgressEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("line is not valid JSON: %v\nline: %s", err, scanner.Text())
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func TestJSONUI_RunEmitsOneEventPerLine(t *testing.T) {
+	var buf bytes.Buffer
+	ui := NewJSONUI(&buf)
+	ui.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	if err := ui.Start(WithTestMode()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	mutation := m.Mutation{
+		ID:       "abc123",
+		Type:     m.MutationArithmetic,
+		Source:   m.Source{Origin: &m.File{ShortPath: "pkg/a.go", FullPath: "/abs/pkg/a.go"}},
+		Position: m.Position{Line: 7},
+	}
+
+	ui.DisplayConcurrencyInfo(2, 0, 1)
+	ui.DisplayUpcomingTestsInfo(1)
+	ui.DisplayStartingTestInfo(mutation, 1)
+	ui.DisplayCompletedTestInfo(mutation, m.MutationResult{MutationID: "abc123", Status: m.Error, Duration: 1500 * time.Millisecond, Err: errors.New("build failed")})
+	ui.DisplayBudgetExhausted(time.Minute, 3)
+	ui.DisplayInterrupted(2)
+	ui.DisplayCachedResults(4)
+	ui.DisplayMutationScore(0)
+	ui.Wait()
+	ui.Close()
+
+	events := decodeEvents(t, buf.String())
+	if len(events) != 8 {
+		t.Fatalf("expected 8 events, got %d\noutput:\n%s", len(events), buf.String())
+	}
+
+	wantNames := []string{EventRun, EventUpcoming, EventStarted, EventCompleted, EventBudget, EventInterrupted, EventCached, EventScore}
+	for i, want := range wantNames {
+		if events[i].Event != want {
+			t.Fatalf("event %d = %q, want %q", i, events[i].Event, want)
+		}
+
+		if !events[i].Time.Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)) {
+			t.Fatalf("event %d has unexpected time %v", i, events[i].Time)
+		}
+	}
+
+	started := events[2]
+	if started.MutationID != "abc123" || started.Path != "pkg/a.go" || started.Line != 7 || started.Thread == nil || *started.Thread != 1 {
+		t.Fatalf("unexpected started event: %+v", started)
+	}
+
+	completed := events[3]
+	if completed.Status != "error" || completed.DurationMS == nil || *completed.DurationMS != 1500 || completed.Error != "build failed" {
+		t.Fatalf("unexpected completed event: %+v", completed)
+	}
+
+	budget := events[4]
+	if budget.DurationMS == nil || *budget.DurationMS != 60000 || budget.Count == nil || *budget.Count != 3 {
+		t.Fatalf("unexpected budget event: %+v", budget)
+	}
+
+	if interrupted := events[5]; interrupted.Count == nil || *interrupted.Count != 2 {
+		t.Fatalf("unexpected interrupted event: %+v", interrupted)
+	}
+
+	if cached := events[6]; cached.Count == nil || *cached.Count != 4 {
+		t.Fatalf("unexpected cached event: %+v", cached)
+	}
+
+	if events[7].Score == nil || *events[7].Score != 0 {
+		t.Fatalf("expected explicit zero score, got %+v", events[7])
+	}
+}
+
+func TestJSONUI_EstimationAndCorpusCounts(t *testing.T) {
+	var buf bytes.Buffer
+	ui := NewJSONUI(&buf)
+
+	mutations := []m.Mutation{
+		{Type: m.MutationLoop, Source: m.Source{Origin: &m.File{ShortPath: "b.go"}}},
+		{Type: m.MutationLoop, Source: m.Source{Origin: &m.File{ShortPath: "a.go"}}},
+		{Type: m.MutationUnary, Source: m.Source{Origin: &m.File{ShortPath: "a.go"}}},
+		{Type: m.MutationUnary},
+	}
+
+	if err := ui.DisplayEstimation(mutations, nil); err != nil {
+		t.Fatalf("DisplayEstimation() error = %v", err)
+	}
+
+	if err := ui.DisplayCorpusReport(mutations, nil); err != nil {
+		t.Fatalf("DisplayCorpusReport() error = %v", err)
+	}
+
+	events := decodeEvents(t, buf.String())
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	estimate := events[0]
+	if estimate.Event != EventEstimate || *estimate.Count != 4 || len(estimate.Files) != 2 ||
+		estimate.Files[0].Path != "a.go" || estimate.Files[0].Mutations != 2 || estimate.Files[0].ByType["unary"] != 1 {
+		t.Fatalf("unexpected estimate event: %+v", estimate)
+	}
+
+	corpus := events[1]
+	if corpus.Event != EventCorpus || corpus.Files[0].ByType["loop"] != 1 || corpus.Files[0].ByType["unary"] != 1 {
+		t.Fatalf("unexpected corpus event: %+v", corpus)
+	}
+}
+
+func TestJSONUI_ErrorsEmitErrorEvent(t *testing.T) {
+	var buf bytes.Buffer
+	ui := NewJSONUI(&buf)
+
+	if err := ui.DisplayEstimation(nil, errors.New("boom")); err == nil {
+		t.Fatalf("DisplayEstimation() expected error")
+	}
+
+	if err := ui.DisplayCorpusReport(nil, errors.New("bang")); err == nil {
+		t.Fatalf("DisplayCorpusReport() expected error")
+	}
+
+	events := decodeEvents(t, buf.String())
+	if len(events) != 2 || events[0].Event != EventError || events[0].Error != "boom" || events[1].Error != "bang" {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+}
+
+func TestJSONUI_DisplayStats(t *testing.T) {
+	var buf bytes.Buffer
+	ui := NewJSONUI(&buf)
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	stats := m.HistoryStats{
+		Runs:               []m.RunRecord{{Time: start, Total: 4, Killed: 3, Survived: 1, Score: 0.75, Duration: 2 * time.Second}},
+		NewSurvivorsByWeek: []m.WeekCount{{Week: "2026-W10", Count: 1}},
+		MeanTimeToKill:     90 * time.Minute,
+		KilledSurvivors:    2,
+		OpenSurvivors:      1,
+	}
+
+	if err := ui.DisplayStats(stats, nil); err != nil {
+		t.Fatalf("DisplayStats() error = %v", err)
+	}
+
+	events := decodeEvents(t, buf.String())
+	if len(events) != 1 || events[0].Event != EventStats || events[0].Stats == nil {
+		t.Fatalf("expected a single stats event, got %+v", events)
+	}
+
+	got := events[0].Stats
+	if len(got.Runs) != 1 || got.Runs[0].Score != 0.75 || got.Runs[0].DurationMS != 2000 || !got.Runs[0].Time.Equal(start) {
+		t.Fatalf("unexpected runs: %+v", got.Runs)
+	}
+
+	if len(got.NewSurvivorsByWeek) != 1 || got.NewSurvivorsByWeek[0].Week != "2026-W10" {
+		t.Fatalf("unexpected weeks: %+v", got.NewSurvivorsByWeek)
+	}
+
+	if got.MeanTimeToKillSeconds == nil || *got.MeanTimeToKillSeconds != 5400 {
+		t.Fatalf("unexpected mean time-to-kill: %v", got.MeanTimeToKillSeconds)
+	}
+
+	if got.KilledSurvivors != 2 || got.OpenSurvivors != 1 {
+		t.Fatalf("unexpected survivor counts: %+v", got)
+	}
+}
+
+func TestJSONUI_DisplayTrend(t *testing.T) {
+	var buf bytes.Buffer
+	ui := NewJSONUI(&buf)
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	runs := []m.RunRecord{
+		{Time: start, Commit: "1a2b3c4d5e", Killed: 1, Survived: 1, Score: 0.5},
+		{Time: start.Add(time.Hour), Killed: 2, Score: 1},
+	}
+
+	if err := ui.DisplayTrend(runs, nil); err != nil {
+		t.Fatalf("DisplayTrend() error = %v", err)
+	}
+
+	events := decodeEvents(t, buf.String())
+	if len(events) != 1 || events[0].Event != EventTrend {
+		t.Fatalf("expected a single trend event, got %+v", events)
+	}
+
+	got := events[0].Runs
+	if len(got) != 2 || got[0].Commit != "1a2b3c4d5e" || got[0].Score != 0.5 || got[1].Score != 1 {
+		t.Fatalf("unexpected runs: %+v", got)
+	}
+}
+
+func TestJSONUI_DisplayWatchCycle(t *testing.T) {
+	var buf bytes.Buffer
+	ui := NewJSONUI(&buf)
+
+	if ui.Done() != nil {
+		t.Fatal("expected JSONUI to have no done channel")
+	}
+
+	ui.DisplayWatchCycle(nil, nil)
+	ui.DisplayWatchCycle([]m.Path{"/abs/pkg/a.go", "/abs/pkg/a_test.go"}, errors.New("build failed"))
+
+	events := decodeEvents(t, buf.String())
+	if len(events) != 2 || events[0].Event != EventWatch || events[1].Event != EventWatch {
+		t.Fatalf("expected two watch events, got %+v", events)
+	}
+
+	if len(events[0].Changed) != 0 || events[0].Error != "" {
+		t.Fatalf("unexpected first watch event: %+v", events[0])
+	}
+
+	if len(events[1].Changed) != 2 || events[1].Changed[1] != "/abs/pkg/a_test.go" || events[1].Error != "build failed" {
+		t.Fatalf("unexpected second watch event: %+v", events[1])
+	}
+}
+
+func TestJSONUI_DisplayRuntimeEstimate(t *testing.T) {
+	var buf bytes.Buffer
+	ui := NewJSONUI(&buf)
+
+	estimate := m.RuntimeEstimate{Packages: 2, Threads: 4, Total: 90 * time.Second, ShardIndex: 1, ShardCount: 3, Shard: 30 * time.Second}
+	if err := ui.DisplayRuntimeEstimate(estimate, nil); err != nil {
+		t.Fatalf("DisplayRuntimeEstimate() error = %v", err)
+	}
+
+	events := decodeEvents(t, buf.String())
+	if len(events) != 1 || events[0].Event != EventRuntime || events[0].Runtime == nil {
+		t.Fatalf("expected one runtime event, got %+v", events)
+	}
+
+	want := ProgressRuntime{Packages: 2, Threads: 4, TotalMS: 90000, ShardIndex: 1, ShardCount: 3, ShardMS: 30000}
+	if *events[0].Runtime != want {
+		t.Fatalf("runtime = %+v, want %+v", *events[0].Runtime, want)
+	}
+}
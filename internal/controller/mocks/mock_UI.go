@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/mouse-blink/gooze/internal/model"
+
+	time "time"
 )
 
 // MockUI is an autogenerated mock type for the UI type
@@ -54,8 +56,88 @@ func (_c *MockUI_Close_Call) RunAndReturn(run func()) *MockUI_Close_Call {
 	return _c
 }
 
+// DisplayCacheStatus provides a mock function with given fields: status, err
+func (_m *MockUI) DisplayCacheStatus(status model.CacheStatus, err error) error {
+	ret := _m.Called(status, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisplayCacheStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.CacheStatus, error) error); ok {
+		r0 = rf(status, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUI_DisplayCacheStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayCacheStatus'
+type MockUI_DisplayCacheStatus_Call struct {
+	*mock.Call
+}
+
+// DisplayCacheStatus is a helper method to define mock.On call
+//   - status model.CacheStatus
+//   - err error
+func (_e *MockUI_Expecter) DisplayCacheStatus(status interface{}, err interface{}) *MockUI_DisplayCacheStatus_Call {
+	return &MockUI_DisplayCacheStatus_Call{Call: _e.mock.On("DisplayCacheStatus", status, err)}
+}
+
+func (_c *MockUI_DisplayCacheStatus_Call) Run(run func(status model.CacheStatus, err error)) *MockUI_DisplayCacheStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.CacheStatus), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayCacheStatus_Call) Return(_a0 error) *MockUI_DisplayCacheStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUI_DisplayCacheStatus_Call) RunAndReturn(run func(model.CacheStatus, error) error) *MockUI_DisplayCacheStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisplayCachedResults provides a mock function with given fields: cached
+func (_m *MockUI) DisplayCachedResults(cached int) {
+	_m.Called(cached)
+}
+
+// MockUI_DisplayCachedResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayCachedResults'
+type MockUI_DisplayCachedResults_Call struct {
+	*mock.Call
+}
+
+// DisplayCachedResults is a helper method to define mock.On call
+//   - cached int
+func (_e *MockUI_Expecter) DisplayCachedResults(cached interface{}) *MockUI_DisplayCachedResults_Call {
+	return &MockUI_DisplayCachedResults_Call{Call: _e.mock.On("DisplayCachedResults", cached)}
+}
+
+func (_c *MockUI_DisplayCachedResults_Call) Run(run func(cached int)) *MockUI_DisplayCachedResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayCachedResults_Call) Return() *MockUI_DisplayCachedResults_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUI_DisplayCachedResults_Call) RunAndReturn(run func(int)) *MockUI_DisplayCachedResults_Call {
+	_c.Run(run)
+	return _c
+}
+
 // DisplayCompletedTestInfo provides a mock function with given fields: currentMutation, mutationResult
-func (_m *MockUI) DisplayCompletedTestInfo(currentMutation model.Mutation, mutationResult model.Result) {
+func (_m *MockUI) DisplayCompletedTestInfo(currentMutation model.Mutation, mutationResult model.MutationResult) {
 	_m.Called(currentMutation, mutationResult)
 }
 
@@ -66,14 +148,14 @@ type MockUI_DisplayCompletedTestInfo_Call struct {
 
 // DisplayCompletedTestInfo is a helper method to define mock.On call
 //   - currentMutation model.Mutation
-//   - mutationResult model.Result
+//   - mutationResult model.MutationResult
 func (_e *MockUI_Expecter) DisplayCompletedTestInfo(currentMutation interface{}, mutationResult interface{}) *MockUI_DisplayCompletedTestInfo_Call {
 	return &MockUI_DisplayCompletedTestInfo_Call{Call: _e.mock.On("DisplayCompletedTestInfo", currentMutation, mutationResult)}
 }
 
-func (_c *MockUI_DisplayCompletedTestInfo_Call) Run(run func(currentMutation model.Mutation, mutationResult model.Result)) *MockUI_DisplayCompletedTestInfo_Call {
+func (_c *MockUI_DisplayCompletedTestInfo_Call) Run(run func(currentMutation model.Mutation, mutationResult model.MutationResult)) *MockUI_DisplayCompletedTestInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(model.Mutation), args[1].(model.Result))
+		run(args[0].(model.Mutation), args[1].(model.MutationResult))
 	})
 	return _c
 }
@@ -83,11 +165,92 @@ func (_c *MockUI_DisplayCompletedTestInfo_Call) Return() *MockUI_DisplayComplete
 	return _c
 }
 
-func (_c *MockUI_DisplayCompletedTestInfo_Call) RunAndReturn(run func(model.Mutation, model.Result)) *MockUI_DisplayCompletedTestInfo_Call {
+func (_c *MockUI_DisplayCompletedTestInfo_Call) RunAndReturn(run func(model.Mutation, model.MutationResult)) *MockUI_DisplayCompletedTestInfo_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DisplayBudgetExhausted provides a mock function with given fields: budget, notRun
+func (_m *MockUI) DisplayBudgetExhausted(budget time.Duration, notRun int) {
+	_m.Called(budget, notRun)
+}
+
+// MockUI_DisplayBudgetExhausted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayBudgetExhausted'
+type MockUI_DisplayBudgetExhausted_Call struct {
+	*mock.Call
+}
+
+// DisplayBudgetExhausted is a helper method to define mock.On call
+//   - budget time.Duration
+//   - notRun int
+func (_e *MockUI_Expecter) DisplayBudgetExhausted(budget interface{}, notRun interface{}) *MockUI_DisplayBudgetExhausted_Call {
+	return &MockUI_DisplayBudgetExhausted_Call{Call: _e.mock.On("DisplayBudgetExhausted", budget, notRun)}
+}
+
+func (_c *MockUI_DisplayBudgetExhausted_Call) Run(run func(budget time.Duration, notRun int)) *MockUI_DisplayBudgetExhausted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayBudgetExhausted_Call) Return() *MockUI_DisplayBudgetExhausted_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUI_DisplayBudgetExhausted_Call) RunAndReturn(run func(time.Duration, int)) *MockUI_DisplayBudgetExhausted_Call {
 	_c.Run(run)
 	return _c
 }
 
+// DisplayComparison provides a mock function with given fields: comparison, err
+func (_m *MockUI) DisplayComparison(comparison model.ReportComparison, err error) error {
+	ret := _m.Called(comparison, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisplayComparison")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.ReportComparison, error) error); ok {
+		r0 = rf(comparison, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUI_DisplayComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayComparison'
+type MockUI_DisplayComparison_Call struct {
+	*mock.Call
+}
+
+// DisplayComparison is a helper method to define mock.On call
+//   - comparison model.ReportComparison
+//   - err error
+func (_e *MockUI_Expecter) DisplayComparison(comparison interface{}, err interface{}) *MockUI_DisplayComparison_Call {
+	return &MockUI_DisplayComparison_Call{Call: _e.mock.On("DisplayComparison", comparison, err)}
+}
+
+func (_c *MockUI_DisplayComparison_Call) Run(run func(comparison model.ReportComparison, err error)) *MockUI_DisplayComparison_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.ReportComparison), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayComparison_Call) Return(_a0 error) *MockUI_DisplayComparison_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUI_DisplayComparison_Call) RunAndReturn(run func(model.ReportComparison, error) error) *MockUI_DisplayComparison_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisplayConcurrencyInfo provides a mock function with given fields: threads, shardIndex, shardCount
 func (_m *MockUI) DisplayConcurrencyInfo(threads int, shardIndex int, shardCount int) {
 	_m.Called(threads, shardIndex, shardCount)
@@ -123,6 +286,53 @@ func (_c *MockUI_DisplayConcurrencyInfo_Call) RunAndReturn(run func(int, int, in
 	return _c
 }
 
+// DisplayCorpusReport provides a mock function with given fields: mutations, err
+func (_m *MockUI) DisplayCorpusReport(mutations []model.Mutation, err error) error {
+	ret := _m.Called(mutations, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisplayCorpusReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]model.Mutation, error) error); ok {
+		r0 = rf(mutations, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUI_DisplayCorpusReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayCorpusReport'
+type MockUI_DisplayCorpusReport_Call struct {
+	*mock.Call
+}
+
+// DisplayCorpusReport is a helper method to define mock.On call
+//   - mutations []model.Mutation
+//   - err error
+func (_e *MockUI_Expecter) DisplayCorpusReport(mutations interface{}, err interface{}) *MockUI_DisplayCorpusReport_Call {
+	return &MockUI_DisplayCorpusReport_Call{Call: _e.mock.On("DisplayCorpusReport", mutations, err)}
+}
+
+func (_c *MockUI_DisplayCorpusReport_Call) Run(run func(mutations []model.Mutation, err error)) *MockUI_DisplayCorpusReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.Mutation), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayCorpusReport_Call) Return(_a0 error) *MockUI_DisplayCorpusReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUI_DisplayCorpusReport_Call) RunAndReturn(run func([]model.Mutation, error) error) *MockUI_DisplayCorpusReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisplayEstimation provides a mock function with given fields: mutations, err
 func (_m *MockUI) DisplayEstimation(mutations []model.Mutation, err error) error {
 	ret := _m.Called(mutations, err)
@@ -170,6 +380,86 @@ func (_c *MockUI_DisplayEstimation_Call) RunAndReturn(run func([]model.Mutation,
 	return _c
 }
 
+// DisplayIntegrity provides a mock function with given fields: report, err
+func (_m *MockUI) DisplayIntegrity(report model.IntegrityReport, err error) error {
+	ret := _m.Called(report, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisplayIntegrity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.IntegrityReport, error) error); ok {
+		r0 = rf(report, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUI_DisplayIntegrity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayIntegrity'
+type MockUI_DisplayIntegrity_Call struct {
+	*mock.Call
+}
+
+// DisplayIntegrity is a helper method to define mock.On call
+//   - report model.IntegrityReport
+//   - err error
+func (_e *MockUI_Expecter) DisplayIntegrity(report interface{}, err interface{}) *MockUI_DisplayIntegrity_Call {
+	return &MockUI_DisplayIntegrity_Call{Call: _e.mock.On("DisplayIntegrity", report, err)}
+}
+
+func (_c *MockUI_DisplayIntegrity_Call) Run(run func(report model.IntegrityReport, err error)) *MockUI_DisplayIntegrity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.IntegrityReport), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayIntegrity_Call) Return(_a0 error) *MockUI_DisplayIntegrity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUI_DisplayIntegrity_Call) RunAndReturn(run func(model.IntegrityReport, error) error) *MockUI_DisplayIntegrity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisplayInterrupted provides a mock function with given fields: notRun
+func (_m *MockUI) DisplayInterrupted(notRun int) {
+	_m.Called(notRun)
+}
+
+// MockUI_DisplayInterrupted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayInterrupted'
+type MockUI_DisplayInterrupted_Call struct {
+	*mock.Call
+}
+
+// DisplayInterrupted is a helper method to define mock.On call
+//   - notRun int
+func (_e *MockUI_Expecter) DisplayInterrupted(notRun interface{}) *MockUI_DisplayInterrupted_Call {
+	return &MockUI_DisplayInterrupted_Call{Call: _e.mock.On("DisplayInterrupted", notRun)}
+}
+
+func (_c *MockUI_DisplayInterrupted_Call) Run(run func(notRun int)) *MockUI_DisplayInterrupted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayInterrupted_Call) Return() *MockUI_DisplayInterrupted_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUI_DisplayInterrupted_Call) RunAndReturn(run func(int)) *MockUI_DisplayInterrupted_Call {
+	_c.Run(run)
+	return _c
+}
+
 // DisplayMutationScore provides a mock function with given fields: score
 func (_m *MockUI) DisplayMutationScore(score float64) {
 	_m.Called(score)
@@ -203,6 +493,100 @@ func (_c *MockUI_DisplayMutationScore_Call) RunAndReturn(run func(float64)) *Moc
 	return _c
 }
 
+// DisplayRuntimeEstimate provides a mock function with given fields: estimate, err
+func (_m *MockUI) DisplayRuntimeEstimate(estimate model.RuntimeEstimate, err error) error {
+	ret := _m.Called(estimate, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisplayRuntimeEstimate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.RuntimeEstimate, error) error); ok {
+		r0 = rf(estimate, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUI_DisplayRuntimeEstimate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayRuntimeEstimate'
+type MockUI_DisplayRuntimeEstimate_Call struct {
+	*mock.Call
+}
+
+// DisplayRuntimeEstimate is a helper method to define mock.On call
+//   - estimate model.RuntimeEstimate
+//   - err error
+func (_e *MockUI_Expecter) DisplayRuntimeEstimate(estimate interface{}, err interface{}) *MockUI_DisplayRuntimeEstimate_Call {
+	return &MockUI_DisplayRuntimeEstimate_Call{Call: _e.mock.On("DisplayRuntimeEstimate", estimate, err)}
+}
+
+func (_c *MockUI_DisplayRuntimeEstimate_Call) Run(run func(estimate model.RuntimeEstimate, err error)) *MockUI_DisplayRuntimeEstimate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.RuntimeEstimate), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayRuntimeEstimate_Call) Return(_a0 error) *MockUI_DisplayRuntimeEstimate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUI_DisplayRuntimeEstimate_Call) RunAndReturn(run func(model.RuntimeEstimate, error) error) *MockUI_DisplayRuntimeEstimate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisplayStats provides a mock function with given fields: stats, err
+func (_m *MockUI) DisplayStats(stats model.HistoryStats, err error) error {
+	ret := _m.Called(stats, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisplayStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.HistoryStats, error) error); ok {
+		r0 = rf(stats, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUI_DisplayStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayStats'
+type MockUI_DisplayStats_Call struct {
+	*mock.Call
+}
+
+// DisplayStats is a helper method to define mock.On call
+//   - stats model.HistoryStats
+//   - err error
+func (_e *MockUI_Expecter) DisplayStats(stats interface{}, err interface{}) *MockUI_DisplayStats_Call {
+	return &MockUI_DisplayStats_Call{Call: _e.mock.On("DisplayStats", stats, err)}
+}
+
+func (_c *MockUI_DisplayStats_Call) Run(run func(stats model.HistoryStats, err error)) *MockUI_DisplayStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.HistoryStats), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayStats_Call) Return(_a0 error) *MockUI_DisplayStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUI_DisplayStats_Call) RunAndReturn(run func(model.HistoryStats, error) error) *MockUI_DisplayStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisplayStartingTestInfo provides a mock function with given fields: currentMutation, threadID
 func (_m *MockUI) DisplayStartingTestInfo(currentMutation model.Mutation, threadID int) {
 	_m.Called(currentMutation, threadID)
@@ -237,6 +621,53 @@ func (_c *MockUI_DisplayStartingTestInfo_Call) RunAndReturn(run func(model.Mutat
 	return _c
 }
 
+// DisplayTrend provides a mock function with given fields: runs, err
+func (_m *MockUI) DisplayTrend(runs []model.RunRecord, err error) error {
+	ret := _m.Called(runs, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisplayTrend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]model.RunRecord, error) error); ok {
+		r0 = rf(runs, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUI_DisplayTrend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayTrend'
+type MockUI_DisplayTrend_Call struct {
+	*mock.Call
+}
+
+// DisplayTrend is a helper method to define mock.On call
+//   - runs []model.RunRecord
+//   - err error
+func (_e *MockUI_Expecter) DisplayTrend(runs interface{}, err interface{}) *MockUI_DisplayTrend_Call {
+	return &MockUI_DisplayTrend_Call{Call: _e.mock.On("DisplayTrend", runs, err)}
+}
+
+func (_c *MockUI_DisplayTrend_Call) Run(run func(runs []model.RunRecord, err error)) *MockUI_DisplayTrend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.RunRecord), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayTrend_Call) Return(_a0 error) *MockUI_DisplayTrend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUI_DisplayTrend_Call) RunAndReturn(run func([]model.RunRecord, error) error) *MockUI_DisplayTrend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisplayUpcomingTestsInfo provides a mock function with given fields: i
 func (_m *MockUI) DisplayUpcomingTestsInfo(i int) {
 	_m.Called(i)
@@ -270,6 +701,91 @@ func (_c *MockUI_DisplayUpcomingTestsInfo_Call) RunAndReturn(run func(int)) *Moc
 	return _c
 }
 
+// DisplayWatchCycle provides a mock function with given fields: changed, err
+func (_m *MockUI) DisplayWatchCycle(changed []model.Path, err error) {
+	_m.Called(changed, err)
+}
+
+// MockUI_DisplayWatchCycle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisplayWatchCycle'
+type MockUI_DisplayWatchCycle_Call struct {
+	*mock.Call
+}
+
+// DisplayWatchCycle is a helper method to define mock.On call
+//   - changed []model.Path
+//   - err error
+func (_e *MockUI_Expecter) DisplayWatchCycle(changed interface{}, err interface{}) *MockUI_DisplayWatchCycle_Call {
+	return &MockUI_DisplayWatchCycle_Call{Call: _e.mock.On("DisplayWatchCycle", changed, err)}
+}
+
+func (_c *MockUI_DisplayWatchCycle_Call) Run(run func(changed []model.Path, err error)) *MockUI_DisplayWatchCycle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg1 error
+		if args[1] != nil {
+			arg1 = args[1].(error)
+		}
+		run(args[0].([]model.Path), arg1)
+	})
+	return _c
+}
+
+func (_c *MockUI_DisplayWatchCycle_Call) Return() *MockUI_DisplayWatchCycle_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUI_DisplayWatchCycle_Call) RunAndReturn(run func([]model.Path, error)) *MockUI_DisplayWatchCycle_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Done provides a mock function with no fields
+func (_m *MockUI) Done() <-chan struct{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Done")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// MockUI_Done_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Done'
+type MockUI_Done_Call struct {
+	*mock.Call
+}
+
+// Done is a helper method to define mock.On call
+func (_e *MockUI_Expecter) Done() *MockUI_Done_Call {
+	return &MockUI_Done_Call{Call: _e.mock.On("Done")}
+}
+
+func (_c *MockUI_Done_Call) Run(run func()) *MockUI_Done_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUI_Done_Call) Return(_a0 <-chan struct{}) *MockUI_Done_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUI_Done_Call) RunAndReturn(run func() <-chan struct{}) *MockUI_Done_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: options
 func (_m *MockUI) Start(options ...controller.StartOption) error {
 	_va := make([]interface{}, len(options))
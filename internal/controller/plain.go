@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// PlainUI implements UI as plain sentences, one per line, for screen
+// readers and log capture: no box drawing, bars or animation, and every
+// mutation's outcome is numbered against the run's total. Reports it does
+// not override print as SimpleUI prints them, in ASCII.
+type PlainUI struct {
+	*SimpleUI
+
+	mu        sync.Mutex
+	upcoming  int
+	completed int
+}
+
+// NewPlainUI creates a new PlainUI.
+func NewPlainUI(cmd *cobra.Command) *PlainUI {
+	return &PlainUI{SimpleUI: NewSimpleUI(cmd)}
+}
+
+// Start initializes the UI and clears any counts left from a previous run.
+func (p *PlainUI) Start(options ...StartOption) error {
+	p.mu.Lock()
+	p.upcoming, p.completed = 0, 0
+	p.mu.Unlock()
+
+	return p.SimpleUI.Start(options...)
+}
+
+// DisplayEstimation prints one line per file with its mutation counts by
+// type, followed by the totals.
+func (p *PlainUI) DisplayEstimation(mutations []m.Mutation, err error) error {
+	if err != nil {
+		p.printf("estimation error: %v\n", err)
+		return err
+	}
+
+	info := collectFileStats(mutations)
+	types := presentTypes(info)
+
+	statsList := make([]fileStat, 0, len(info))
+	for _, stat := range info {
+		statsList = append(statsList, stat)
+	}
+
+	sort.Slice(statsList, func(i, j int) bool {
+		return statsList[i].path < statsList[j].path
+	})
+
+	for _, stat := range statsList {
+		byType := make([]string, 0, len(types))
+		for _, name := range types {
+			if count := stat.byType[name]; count > 0 {
+				byType = append(byType, fmt.Sprintf("%d %s", count, name))
+			}
+		}
+
+		p.printf("%s: %d mutations (%s)\n", stat.path, stat.count, strings.Join(byType, ", "))
+	}
+
+	p.printf("Total: %d mutations in %d files\n", len(mutations), len(statsList))
+
+	return nil
+}
+
+// DisplayConcurrencyInfo shows concurrency settings.
+func (p *PlainUI) DisplayConcurrencyInfo(threads int, shardIndex int, count int) {
+	p.printf("Running with %d workers, shard %d/%d\n", threads, shardIndex, count)
+}
+
+// DisplayUpcomingTestsInfo records and shows the number of mutations to be tested.
+func (p *PlainUI) DisplayUpcomingTestsInfo(i int) {
+	p.mu.Lock()
+	p.upcoming = i
+	p.mu.Unlock()
+
+	p.printf("Testing %d mutations\n", i)
+}
+
+// DisplayStartingTestInfo prints nothing; only outcomes are reported, so
+// the output does not interleave lines from parallel workers.
+func (p *PlainUI) DisplayStartingTestInfo(_ m.Mutation, _ int) {}
+
+// DisplayCompletedTestInfo prints the mutation's outcome, numbered against
+// the run's total, and the diff of a survivor.
+func (p *PlainUI) DisplayCompletedTestInfo(currentMutation m.Mutation, mutationResult m.MutationResult) {
+	p.mu.Lock()
+	p.completed++
+	completed, upcoming := p.completed, p.upcoming
+	p.mu.Unlock()
+
+	path := ""
+	if currentMutation.Source.Origin != nil {
+		path = string(currentMutation.Source.Origin.DisplayPath())
+	}
+
+	p.summary.add(path, mutationResult.Status)
+
+	line := fmt.Sprintf("Mutation %d of %d: %s %s", completed, upcoming, currentMutation.ID[:4], currentMutation.Type.Name)
+	if path != "" {
+		line += fmt.Sprintf(" in %s line %d", path, currentMutation.Position.Line)
+	}
+
+	line += " " + formatTestStatus(mutationResult.Status)
+
+	switch {
+	case mutationResult.KillReason != "":
+		line += fmt.Sprintf(" (%s)", mutationResult.KillReason)
+	case mutationResult.Note != "":
+		line += fmt.Sprintf(" (%s)", mutationResult.Note)
+	}
+
+	p.printf("%s\n", line)
+
+	if mutationResult.Status == m.Survived && len(currentMutation.DiffCode) > 0 {
+		p.printf("%s\n", strings.TrimRight(string(currentMutation.DiffCode), "\n"))
+	}
+}
+
+// DisplayMutationScore prints the per-file summary, one line per file,
+// followed by the final mutation score.
+func (p *PlainUI) DisplayMutationScore(score float64) {
+	if lines := p.summary.renderLines(); lines != "" {
+		p.printf("%s", lines)
+	}
+
+	p.printf("Mutation score: %.2f%%\n", score*100)
+}
+
+// DisplayBudgetExhausted prints a line marking the score as partial.
+func (p *PlainUI) DisplayBudgetExhausted(budget time.Duration, notRun int) {
+	p.printf("Time budget of %s exhausted: %d mutations not run, the score is partial\n", budget, notRun)
+}
+
+// DisplayInterrupted prints a line marking the score as partial.
+func (p *PlainUI) DisplayInterrupted(notRun int) {
+	p.printf("Interrupted: %d mutations not run, the score is partial\n", notRun)
+}
+
+// DisplayWatchCycle prints the outcome of a watch cycle and clears the
+// summary and counts for the next cycle.
+func (p *PlainUI) DisplayWatchCycle(changed []m.Path, err error) {
+	p.mu.Lock()
+	p.upcoming, p.completed = 0, 0
+	p.mu.Unlock()
+
+	p.SimpleUI.DisplayWatchCycle(changed, err)
+}
+
+// DisplayTrend prints the score of each run, oldest first, one line per
+// run, instead of a bar chart.
+func (p *PlainUI) DisplayTrend(runs []m.RunRecord, err error) error {
+	if err != nil {
+		p.printf("trend error: %v\n", err)
+		return err
+	}
+
+	if len(runs) == 0 {
+		p.printf("No run history recorded yet; run `gooze run` to start one.\n")
+		return nil
+	}
+
+	for _, run := range runs {
+		p.printf("Run %s, commit %s: score %.2f%%\n",
+			run.Time.Local().Format(statsTimeLayout), shortCommit(run.Commit), run.Score*100)
+	}
+
+	if len(runs) > 1 {
+		first, last := runs[0], runs[len(runs)-1]
+		p.printf("Change: %+.2f points since %s\n",
+			(last.Score-first.Score)*100, first.Time.Local().Format(statsTimeLayout))
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func newTestPlainUI() (*PlainUI, *bytes.Buffer) {
+	var buf bytes.Buffer
+
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	return NewPlainUI(cmd), &buf
+}
+
+func TestPlainUI_Run_PrintsNumberedLines(t *testing.T) {
+	ui, buf := newTestPlainUI()
+
+	if err := ui.Start(WithTestMode()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	file := &m.File{ShortPath: "pkg/a.go", FullPath: "/abs/pkg/a.go"}
+	killed := m.Mutation{ID: "abcd1234", Type: m.MutationArithmetic, Source: m.Source{Origin: file}, Position: m.Position{Line: 7}}
+	survived := m.Mutation{ID: "ef561234", Type: m.MutationBoolean, Source: m.Source{Origin: file}, Position: m.Position{Line: 9}, DiffCode: []byte("-a\n+b\n")}
+
+	ui.DisplayConcurrencyInfo(2, 0, 1)
+	ui.DisplayUpcomingTestsInfo(2)
+	ui.DisplayStartingTestInfo(killed, 0)
+	ui.DisplayCompletedTestInfo(killed, m.MutationResult{Status: m.Killed, KillReason: "assertion"})
+	ui.DisplayCompletedTestInfo(survived, m.MutationResult{Status: m.Survived})
+	ui.DisplayInterrupted(1)
+	ui.DisplayMutationScore(0.5)
+
+	want := strings.Join([]string{
+		"Running with 2 workers, shard 0/1",
+		"Testing 2 mutations",
+		"Mutation 1 of 2: abcd arithmetic in pkg/a.go line 7 killed (assertion)",
+		"Mutation 2 of 2: ef56 boolean in pkg/a.go line 9 survived",
+		"-a",
+		"+b",
+		"Interrupted: 1 mutations not run, the score is partial",
+		"pkg/a.go: 1 killed, 1 survived, 0 skipped, 0 errors, score 50.00%",
+		"Total, 1 files: 1 killed, 1 survived, 0 skipped, 0 errors, score 50.00%",
+		"Mutation score: 50.00%",
+	}, "\n") + "\n"
+
+	if got := buf.String(); got != want {
+		t.Fatalf("unexpected output\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPlainUI_Start_ResetsCounts(t *testing.T) {
+	ui, buf := newTestPlainUI()
+	mutation := m.Mutation{ID: "abcd1234", Type: m.MutationArithmetic}
+
+	_ = ui.Start(WithTestMode())
+	ui.DisplayUpcomingTestsInfo(1)
+	ui.DisplayCompletedTestInfo(mutation, m.MutationResult{Status: m.Killed})
+
+	_ = ui.Start(WithTestMode())
+	buf.Reset()
+	ui.DisplayUpcomingTestsInfo(3)
+	ui.DisplayCompletedTestInfo(mutation, m.MutationResult{Status: m.Killed})
+
+	if !strings.Contains(buf.String(), "Mutation 1 of 3: abcd arithmetic killed\n") {
+		t.Fatalf("counts were not reset\noutput:\n%s", buf.String())
+	}
+}
+
+func TestPlainUI_DisplayEstimation_PrintsLinePerFile(t *testing.T) {
+	ui, buf := newTestPlainUI()
+
+	mutations := []m.Mutation{
+		{Type: m.MutationArithmetic, Source: m.Source{Origin: &m.File{ShortPath: "a.go"}}},
+		{Type: m.MutationArithmetic, Source: m.Source{Origin: &m.File{ShortPath: "a.go"}}},
+		{Type: m.MutationLoop, Source: m.Source{Origin: &m.File{ShortPath: "a.go"}}},
+		{Type: m.MutationLoop, Source: m.Source{Origin: &m.File{ShortPath: "b.go"}}},
+	}
+
+	if err := ui.DisplayEstimation(mutations, nil); err != nil {
+		t.Fatalf("DisplayEstimation() error = %v", err)
+	}
+
+	want := "a.go: 3 mutations (2 arithmetic, 1 loop)\nb.go: 1 mutations (1 loop)\nTotal: 4 mutations in 2 files\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("unexpected output\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPlainUI_DisplayTrend_PrintsLinePerRun(t *testing.T) {
+	ui, buf := newTestPlainUI()
+
+	at := time.Date(2025, 1, 2, 3, 4, 0, 0, time.Local)
+	runs := []m.RunRecord{
+		{Time: at, Commit: "0123456789", Score: 0.5},
+		{Time: at.Add(time.Hour), Score: 0.75},
+	}
+
+	if err := ui.DisplayTrend(runs, nil); err != nil {
+		t.Fatalf("DisplayTrend() error = %v", err)
+	}
+
+	want := "Run 2025-01-02 03:04, commit 0123456: score 50.00%\n" +
+		"Run 2025-01-02 04:04, commit -: score 75.00%\n" +
+		"Change: +25.00 points since 2025-01-02 03:04\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("unexpected output\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	if strings.ContainsAny(buf.String(), "█░") {
+		t.Fatalf("trend drew bars:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// renderRuntimeEstimate renders the projected duration of the run and, for
+// sharded runs, of the chosen shard.
+func renderRuntimeEstimate(estimate m.RuntimeEstimate) string {
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "Projected runtime: %s with %d worker(s), from timing %d package(s)\n",
+		formatEstimate(estimate.Total), estimate.Threads, estimate.Packages)
+
+	if estimate.ShardCount > 1 {
+		fmt.Fprintf(&out, "Projected runtime of shard %d/%d: %s\n",
+			estimate.ShardIndex, estimate.ShardCount, formatEstimate(estimate.Shard))
+	}
+
+	return out.String()
+}
+
+// formatEstimate rounds a projected duration to the second; projections are
+// no more precise than that.
+func formatEstimate(d time.Duration) string {
+	if d < time.Second {
+		return formatResultDuration(d)
+	}
+
+	return d.Round(time.Second).String()
+}
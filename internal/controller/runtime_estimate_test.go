@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestRenderRuntimeEstimate(t *testing.T) {
+	estimate := m.RuntimeEstimate{Packages: 3, Threads: 4, Total: 754300 * time.Millisecond, ShardIndex: 0, ShardCount: 1}
+
+	want := "Projected runtime: 12m34s with 4 worker(s), from timing 3 package(s)\n"
+	if got := renderRuntimeEstimate(estimate); got != want {
+		t.Fatalf("renderRuntimeEstimate() = %q, want %q", got, want)
+	}
+
+	estimate.ShardIndex, estimate.ShardCount, estimate.Shard = 1, 3, 250*time.Millisecond
+
+	want += "Projected runtime of shard 1/3: 250ms\n"
+	if got := renderRuntimeEstimate(estimate); got != want {
+		t.Fatalf("renderRuntimeEstimate() sharded = %q, want %q", got, want)
+	}
+}
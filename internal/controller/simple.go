@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	m "github.com/mouse-blink/gooze/internal/model"
 	"github.com/olekukonko/tablewriter"
@@ -12,7 +14,8 @@ import (
 
 // SimpleUI implements UI using cobra Command's Println.
 type SimpleUI struct {
-	cmd *cobra.Command
+	cmd     *cobra.Command
+	summary resultSummary
 }
 
 // NewSimpleUI creates a new SimpleUI.
@@ -20,8 +23,9 @@ func NewSimpleUI(cmd *cobra.Command) *SimpleUI {
 	return &SimpleUI{cmd: cmd}
 }
 
-// Start initializes the UI.
+// Start initializes the UI and clears any summary left from a previous run.
 func (s *SimpleUI) Start(_ ...StartOption) error {
+	s.summary.reset()
 	return nil
 }
 
@@ -35,6 +39,11 @@ func (s *SimpleUI) Wait() {
 	// SimpleUI doesn't block - it just prints and continues
 }
 
+// Done returns nil: SimpleUI cannot be closed by the user.
+func (s *SimpleUI) Done() <-chan struct{} {
+	return nil
+}
+
 // DisplayEstimation prints the estimation results or error.
 func (s *SimpleUI) DisplayEstimation(mutations []m.Mutation, err error) error {
 	if err != nil {
@@ -42,23 +51,8 @@ func (s *SimpleUI) DisplayEstimation(mutations []m.Mutation, err error) error {
 		return err
 	}
 
-	info := make(map[string]fileStat)
-
-	for _, mutation := range mutations {
-		if mutation.Source.Origin == nil {
-			continue
-		}
-
-		fileHash := mutation.Source.Origin.Hash
-		if fileHash == "" {
-			fileHash = string(mutation.Source.Origin.ShortPath)
-		}
-
-		stat := info[fileHash]
-		stat.path = string(mutation.Source.Origin.ShortPath)
-		stat.count++
-		info[fileHash] = stat
-	}
+	info := collectFileStats(mutations)
+	types := presentTypes(info)
 
 	statsList := make([]fileStat, 0, len(info))
 	for _, stat := range info {
@@ -72,23 +66,37 @@ func (s *SimpleUI) DisplayEstimation(mutations []m.Mutation, err error) error {
 	var tableBuffer bytes.Buffer
 
 	table := tablewriter.NewWriter(&tableBuffer)
-	table.SetHeader([]string{"Path", "Mutations"})
+	table.SetHeader(append([]string{"Path", "Mutations"}, types...))
 	table.SetBorder(false)
 	table.SetCenterSeparator("")
-	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_CENTER})
+	alignment := []int{tablewriter.ALIGN_LEFT}
+	for range len(types) + 1 {
+		alignment = append(alignment, tablewriter.ALIGN_CENTER)
+	}
 
-	pathsCount := 0
+	table.SetColumnAlignment(alignment)
+
+	totals := make(map[string]int, len(types))
 
 	for _, stat := range statsList {
-		table.Append([]string{stat.path, fmt.Sprintf("%d", stat.count)})
+		row := []string{stat.path, fmt.Sprintf("%d", stat.count)}
+		for _, name := range types {
+			row = append(row, fmt.Sprintf("%d", stat.byType[name]))
+			totals[name] += stat.byType[name]
+		}
 
-		pathsCount++
+		table.Append(row)
 	}
 
-	table.SetFooter([]string{
-		fmt.Sprintf("Total Files %d", pathsCount),
+	footer := []string{
+		fmt.Sprintf("Total Files %d", len(statsList)),
 		fmt.Sprintf("%d", len(mutations)),
-	})
+	}
+	for _, name := range types {
+		footer = append(footer, fmt.Sprintf("%d", totals[name]))
+	}
+
+	table.SetFooter(footer)
 
 	table.Render()
 	s.printf("\n%s", tableBuffer.String())
@@ -96,6 +104,19 @@ func (s *SimpleUI) DisplayEstimation(mutations []m.Mutation, err error) error {
 	return nil
 }
 
+// DisplayRuntimeEstimate prints the projected duration of the run and of
+// the chosen shard.
+func (s *SimpleUI) DisplayRuntimeEstimate(estimate m.RuntimeEstimate, err error) error {
+	if err != nil {
+		s.printf("runtime estimate error: %v\n", err)
+		return err
+	}
+
+	s.printf("\n%s", renderRuntimeEstimate(estimate))
+
+	return nil
+}
+
 // DisplayConcurrencyInfo shows concurrency settings.
 func (s *SimpleUI) DisplayConcurrencyInfo(threads int, shardIndex int, count int) {
 	s.printf("Running %d mutations with %d worker(s) (Shard %d/%d)\n", count, threads, shardIndex, count)
@@ -110,27 +131,33 @@ func (s *SimpleUI) DisplayUpcomingTestsInfo(i int) {
 func (s *SimpleUI) DisplayStartingTestInfo(currentMutation m.Mutation, _ int) {
 	path := ""
 	if currentMutation.Source.Origin != nil {
-		path = string(currentMutation.Source.Origin.ShortPath)
+		path = string(currentMutation.Source.Origin.DisplayPath())
 	}
 
 	s.printf("Starting mutation %s (%s) %s\n", currentMutation.ID[:4], currentMutation.Type.Name, path)
 }
 
 // DisplayCompletedTestInfo shows info about the mutation test completion.
-func (s *SimpleUI) DisplayCompletedTestInfo(currentMutation m.Mutation, mutationResult m.Result) {
-	status := unknownStatusLabel
-	if results, ok := mutationResult[currentMutation.Type]; ok && len(results) > 0 {
-		status = formatTestStatus(results[0].Status)
+func (s *SimpleUI) DisplayCompletedTestInfo(currentMutation m.Mutation, mutationResult m.MutationResult) {
+	status := formatTestStatus(mutationResult.Status)
+
+	switch {
+	case mutationResult.KillReason != "":
+		s.printf("Completed mutation %s (%s) -> %s (%s)\n", currentMutation.ID[:4], currentMutation.Type.Name, status, mutationResult.KillReason)
+	case mutationResult.Note != "":
+		s.printf("Completed mutation %s (%s) -> %s (%s)\n", currentMutation.ID[:4], currentMutation.Type.Name, status, mutationResult.Note)
+	default:
+		s.printf("Completed mutation %s (%s) -> %s\n", currentMutation.ID[:4], currentMutation.Type.Name, status)
 	}
 
-	s.printf("Completed mutation %s (%s) -> %s\n", currentMutation.ID[:4], currentMutation.Type.Name, status)
+	path := ""
+	if currentMutation.Source.Origin != nil {
+		path = string(currentMutation.Source.Origin.DisplayPath())
+	}
 
-	if status == formatTestStatus(m.Survived) && len(currentMutation.DiffCode) > 0 {
-		path := ""
-		if currentMutation.Source.Origin != nil {
-			path = string(currentMutation.Source.Origin.FullPath)
-		}
+	s.summary.add(path, mutationResult.Status)
 
+	if status == formatTestStatus(m.Survived) && len(currentMutation.DiffCode) > 0 {
 		if path != "" {
 			s.printf("File: %s\n", path)
 		}
@@ -139,11 +166,127 @@ func (s *SimpleUI) DisplayCompletedTestInfo(currentMutation m.Mutation, mutation
 	}
 }
 
-// DisplayMutationScore prints the final mutation score.
+// DisplayMutationScore prints the per-file summary table followed by the final mutation score.
 func (s *SimpleUI) DisplayMutationScore(score float64) {
+	if table := s.summary.render(); table != "" {
+		s.printf("\n%s\n", table)
+	}
+
 	s.printf("Mutation score: %.2f%%\n", score*100)
 }
 
+// DisplayBudgetExhausted prints a banner marking the score as partial.
+func (s *SimpleUI) DisplayBudgetExhausted(budget time.Duration, notRun int) {
+	s.printf("\n*** Time budget of %s exhausted: %d mutations not run, the score below is partial ***\n", budget, notRun)
+}
+
+// DisplayInterrupted prints a banner marking the score as partial.
+func (s *SimpleUI) DisplayInterrupted(notRun int) {
+	s.printf("\n*** Interrupted: %d mutations not run, the score below is partial ***\n", notRun)
+}
+
+// DisplayCachedResults prints how many verdicts were reused, and that they
+// keep their original status.
+func (s *SimpleUI) DisplayCachedResults(cached int) {
+	s.printf("%s\n", cachedResultsNote(cached))
+}
+
+// cachedResultsNote tells that cached mutations reused their verdicts,
+// which keep the status the earlier run gave them.
+func cachedResultsNote(cached int) string {
+	return fmt.Sprintf("Cached: %d mutations not tested again; they keep the status of the run that tested them, marked cached: true in the reports", cached)
+}
+
+// DisplayWatchCycle prints the outcome of a watch cycle and clears the
+// summary, so the next cycle's table lists only the files it tested.
+func (s *SimpleUI) DisplayWatchCycle(changed []m.Path, err error) {
+	s.summary.reset()
+
+	if err != nil {
+		s.printf("Watch cycle failed: %v\n", err)
+	}
+
+	if len(changed) > 0 {
+		s.printf("Tested changes to %s\n", joinPaths(changed))
+	}
+
+	s.printf("Watching for changes, press Ctrl+C to stop\n")
+}
+
+// DisplayCorpusReport prints mutation counts per file and mutation type.
+func (s *SimpleUI) DisplayCorpusReport(mutations []m.Mutation, err error) error {
+	if err != nil {
+		s.printf("corpus report error: %v\n", err)
+		return err
+	}
+
+	s.printf("\n%s", renderCorpusTable(mutations))
+
+	return nil
+}
+
+// DisplayStats prints run history trends.
+func (s *SimpleUI) DisplayStats(stats m.HistoryStats, err error) error {
+	if err != nil {
+		s.printf("stats error: %v\n", err)
+		return err
+	}
+
+	s.printf("\n%s", renderStatsTables(stats))
+
+	return nil
+}
+
+// DisplayTrend prints a chart of the score of the given runs.
+func (s *SimpleUI) DisplayTrend(runs []m.RunRecord, err error) error {
+	if err != nil {
+		s.printf("trend error: %v\n", err)
+		return err
+	}
+
+	s.printf("\n%s", renderTrendChart(runs))
+
+	return nil
+}
+
+// DisplayComparison prints the score change and changed mutants between two
+// report sets.
+func (s *SimpleUI) DisplayComparison(comparison m.ReportComparison, err error) error {
+	if err != nil {
+		s.printf("diff error: %v\n", err)
+		return err
+	}
+
+	s.printf("\n%s", renderComparison(comparison))
+
+	return nil
+}
+
+// DisplayCacheStatus prints the size of the reports directory and its stale
+// and outdated reports.
+func (s *SimpleUI) DisplayCacheStatus(status m.CacheStatus, err error) error {
+	if err != nil {
+		s.printf("cache error: %v\n", err)
+		return err
+	}
+
+	s.printf("\n%s", renderCacheStatus(status))
+
+	return nil
+}
+
+// DisplayIntegrity prints the problems found in a reports directory.
+func (s *SimpleUI) DisplayIntegrity(report m.IntegrityReport, err error) error {
+	if err != nil {
+		s.printf("verify error: %v\n", err)
+		return err
+	}
+
+	s.printf("\n%s", renderIntegrity(report))
+
+	return nil
+}
+
 func (s *SimpleUI) printf(format string, args ...interface{}) {
 	_, _ = fmt.Fprintf(s.cmd.OutOrStdout(), format, args...)
 }
@@ -158,9 +301,25 @@ func formatTestStatus(status m.TestStatus) string {
 		return "skipped"
 	case m.Error:
 		return "error"
+	case m.NotRun:
+		return "not run"
+	case m.Flaky:
+		return "flaky"
+	case m.CompileError:
+		return "uncompiled"
 	default:
 		return unknownStatusLabel
 	}
 }
 
 const unknownStatusLabel = "unknown"
+
+// joinPaths lists paths, comma-separated, for a one-line message.
+func joinPaths(paths []m.Path) string {
+	parts := make([]string, 0, len(paths))
+	for _, path := range paths {
+		parts = append(parts, string(path))
+	}
+
+	return strings.Join(parts, ", ")
+}
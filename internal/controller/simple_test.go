@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	m "github.com/mouse-blink/gooze/internal/model"
 	"github.com/spf13/cobra"
@@ -44,6 +45,42 @@ func TestSimpleUI_DisplayEstimation_PrintsTable(t *testing.T) {
 	}
 }
 
+func TestSimpleUI_DisplayEstimation_BreaksDownByType(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	ui := NewSimpleUI(cmd)
+
+	mutations := []m.Mutation{
+		{Type: m.MutationArithmetic, Source: m.Source{Origin: &m.File{ShortPath: "a.go"}}},
+		{Type: m.MutationArithmetic, Source: m.Source{Origin: &m.File{ShortPath: "a.go"}}},
+		{Type: m.MutationLoop, Source: m.Source{Origin: &m.File{ShortPath: "b.go"}}},
+	}
+
+	if err := ui.DisplayEstimation(mutations, nil); err != nil {
+		t.Fatalf("DisplayEstimation() error = %v", err)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+
+	var header, rowA string
+
+	for _, line := range lines {
+		fields := strings.Fields(strings.ReplaceAll(line, "|", " "))
+		switch {
+		case len(fields) > 0 && fields[0] == "PATH":
+			header = strings.Join(fields, " ")
+		case len(fields) > 0 && fields[0] == "a.go":
+			rowA = strings.Join(fields, " ")
+		}
+	}
+
+	if header != "PATH MUTATIONS ARITHMETIC LOOP" || rowA != "a.go 2 2 0" {
+		t.Fatalf("header = %q, a.go row = %q, want only present types as columns\noutput:\n%s", header, rowA, buf.String())
+	}
+}
+
 func TestSimpleUI_DisplayEstimation_Error(t *testing.T) {
 	var buf bytes.Buffer
 	cmd := &cobra.Command{}
@@ -82,20 +119,12 @@ func TestSimpleUI_OtherDisplays(t *testing.T) {
 	ui.DisplayStartingTestInfo(m.Mutation{ID: "abcd1234567890", Type: m.MutationArithmetic}, 0)
 	ui.DisplayStartingTestInfo(m.Mutation{ID: "efgh5678901234", Type: m.MutationBoolean, Source: m.Source{Origin: &m.File{ShortPath: "a.go", FullPath: "path/a.go"}}}, 0)
 
-	result := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{{MutationID: "abcd1234567890", Status: m.Killed}},
-		m.MutationBoolean: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{{MutationID: "efgh5678901234", Status: m.Survived}},
-	}
-	ui.DisplayCompletedTestInfo(m.Mutation{ID: "abcd1234567890", Type: m.MutationArithmetic}, result)
-	ui.DisplayCompletedTestInfo(m.Mutation{ID: "efgh5678901234", Type: m.MutationBoolean, Source: m.Source{Origin: &m.File{FullPath: "path/a.go"}}, DiffCode: []byte("--- original\n+++ mutated\n@@\n")}, result)
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "abcd1234567890", Type: m.MutationArithmetic}, m.MutationResult{MutationID: "abcd1234567890", Type: m.MutationArithmetic, Status: m.Killed, KillReason: m.KillPanic})
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "efgh5678901234", Type: m.MutationBoolean, Source: m.Source{Origin: &m.File{FullPath: "path/a.go"}}, DiffCode: []byte("--- original\n+++ mutated\n@@\n")}, m.MutationResult{MutationID: "efgh5678901234", Type: m.MutationBoolean, Status: m.Survived})
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "ijkl9012345678", Type: m.MutationLoop}, m.MutationResult{MutationID: "ijkl9012345678", Type: m.MutationLoop, Status: m.Skipped, Note: "quarantined: timed out in 3 recorded runs"})
+	ui.DisplayBudgetExhausted(30*time.Minute, 4)
+	ui.DisplayInterrupted(2)
+	ui.DisplayCachedResults(3)
 	ui.DisplayMutationScore(0.75)
 
 	output := buf.String()
@@ -104,10 +133,14 @@ func TestSimpleUI_OtherDisplays(t *testing.T) {
 		"Upcoming mutations: 7",
 		"Starting mutation abcd (arithmetic)",
 		"Starting mutation efgh (boolean) a.go",
-		"Completed mutation abcd (arithmetic) -> killed",
+		"Completed mutation abcd (arithmetic) -> killed (panic)",
+		"Completed mutation ijkl (loop) -> skipped (quarantined: timed out in 3 recorded runs)",
 		"Completed mutation efgh (boolean) -> survived",
 		"File: path/a.go",
 		"--- original",
+		"Time budget of 30m0s exhausted: 4 mutations not run, the score below is partial",
+		"Cached: 3 mutations not tested again; they keep the status of the run that tested them, marked cached: true in the reports",
+		"Interrupted: 2 mutations not run, the score below is partial",
 		"Mutation score: 75.00%",
 	} {
 		if !strings.Contains(output, want) {
@@ -122,6 +155,7 @@ func TestFormatTestStatus(t *testing.T) {
 		m.Survived:       "survived",
 		m.Skipped:        "skipped",
 		m.Error:          "error",
+		m.NotRun:         "not run",
 		m.TestStatus(99): unknownStatusLabel,
 	}
 
@@ -131,3 +165,116 @@ func TestFormatTestStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestSimpleUI_DisplayCompletedTestInfo_PrefersShortPath(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	ui := NewSimpleUI(cmd)
+	mutation := m.Mutation{
+		ID:       "abcd1234567890",
+		Type:     m.MutationBoolean,
+		Source:   m.Source{Origin: &m.File{ShortPath: "pkg/a.go", FullPath: "/tmp/gooze-mutation-1/pkg/a.go"}},
+		DiffCode: []byte("--- original\n+++ mutated\n@@\n"),
+	}
+	ui.DisplayCompletedTestInfo(mutation, m.MutationResult{MutationID: mutation.ID, Type: mutation.Type, Status: m.Survived})
+
+	output := buf.String()
+	if !strings.Contains(output, "File: pkg/a.go") {
+		t.Fatalf("expected short path in output\noutput:\n%s", output)
+	}
+
+	if strings.Contains(output, "/tmp/gooze-mutation-1") {
+		t.Fatalf("expected absolute path not to leak into output\noutput:\n%s", output)
+	}
+}
+
+func TestSimpleUI_DisplayMutationScore_PrintsPerFileSummary(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	ui := NewSimpleUI(cmd)
+	if err := ui.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	a := m.Source{Origin: &m.File{ShortPath: "pkg/a.go"}}
+	b := m.Source{Origin: &m.File{ShortPath: "pkg/b.go"}}
+
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "aaaa0001", Source: a}, m.MutationResult{Status: m.Killed})
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "aaaa0002", Source: a}, m.MutationResult{Status: m.Survived})
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "bbbb0001", Source: b}, m.MutationResult{Status: m.Killed})
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "bbbb0002", Source: b}, m.MutationResult{Status: m.Error})
+	ui.DisplayMutationScore(2.0 / 3.0)
+
+	output := buf.String()
+	for _, want := range []string{
+		"KILLED",
+		"SURVIVED",
+		"pkg/a.go",
+		"50.00%",
+		"pkg/b.go",
+		"100.00%",
+		"TOTAL FILES 2",
+		"66.67%",
+		"Mutation score: 66.67%",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("output missing %q\noutput:\n%s", want, output)
+		}
+	}
+
+	if strings.Index(output, "TOTAL FILES 2") > strings.Index(output, "Mutation score:") {
+		t.Fatalf("expected summary table before the score line\noutput:\n%s", output)
+	}
+}
+
+func TestSimpleUI_Start_ResetsSummary(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	ui := NewSimpleUI(cmd)
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "aaaa0001", Source: m.Source{Origin: &m.File{ShortPath: "stale.go"}}}, m.MutationResult{Status: m.Killed})
+
+	if err := ui.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	buf.Reset()
+	ui.DisplayMutationScore(0)
+
+	if strings.Contains(buf.String(), "stale.go") {
+		t.Fatalf("expected summary from previous run to be cleared\noutput:\n%s", buf.String())
+	}
+}
+
+func TestSimpleUI_DisplayWatchCycle_ResetsSummary(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	ui := NewSimpleUI(cmd)
+	if err := ui.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	ui.DisplayCompletedTestInfo(m.Mutation{ID: "aaaa0001", Source: m.Source{Origin: &m.File{ShortPath: "first.go"}}}, m.MutationResult{Status: m.Killed})
+	ui.DisplayWatchCycle([]m.Path{"/abs/first.go"}, errors.New("build failed"))
+
+	output := buf.String()
+	for _, want := range []string{"Watch cycle failed: build failed", "Tested changes to /abs/first.go", "Watching for changes"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("output missing %q\noutput:\n%s", want, output)
+		}
+	}
+
+	buf.Reset()
+	ui.DisplayMutationScore(0)
+
+	if strings.Contains(buf.String(), "first.go") {
+		t.Fatalf("expected summary from previous cycle to be cleared\noutput:\n%s", buf.String())
+	}
+}
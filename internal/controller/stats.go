@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/olekukonko/tablewriter"
+)
+
+const statsTimeLayout = "2006-01-02 15:04"
+
+// renderStatsTables renders the score trend, new survivors per week and the
+// time-to-kill summary.
+func renderStatsTables(stats m.HistoryStats) string {
+	if len(stats.Runs) == 0 {
+		return "No run history recorded yet; run `gooze run` to start one.\n"
+	}
+
+	var out bytes.Buffer
+
+	runs := tablewriter.NewWriter(&out)
+	runs.SetHeader([]string{"Run", "Mutations", "Killed", "Survived", "Score"})
+	runs.SetBorder(false)
+	runs.SetCenterSeparator("")
+
+	for _, run := range stats.Runs {
+		runs.Append([]string{
+			run.Time.Local().Format(statsTimeLayout),
+			fmt.Sprintf("%d", run.Total),
+			fmt.Sprintf("%d", run.Killed),
+			fmt.Sprintf("%d", run.Survived),
+			fmt.Sprintf("%.2f%%", run.Score*100),
+		})
+	}
+
+	runs.SetFooter([]string{fmt.Sprintf("Total Runs %d", len(stats.Runs)), "", "", "", ""})
+	runs.Render()
+
+	if len(stats.NewSurvivorsByWeek) > 0 {
+		out.WriteString("\n")
+
+		weeks := tablewriter.NewWriter(&out)
+		weeks.SetHeader([]string{"Week", "New Survivors"})
+		weeks.SetBorder(false)
+		weeks.SetCenterSeparator("")
+
+		for _, week := range stats.NewSurvivorsByWeek {
+			weeks.Append([]string{week.Week, fmt.Sprintf("%d", week.Count)})
+		}
+
+		weeks.Render()
+	}
+
+	out.WriteString("\n")
+
+	if stats.KilledSurvivors == 0 {
+		fmt.Fprintf(&out, "Mean time-to-kill: - (no survivor killed yet, %d still surviving)\n", stats.OpenSurvivors)
+	} else {
+		fmt.Fprintf(&out, "Mean time-to-kill: %s (%d survivors killed, %d still surviving)\n",
+			formatTimeToKill(stats.MeanTimeToKill), stats.KilledSurvivors, stats.OpenSurvivors)
+	}
+
+	return out.String()
+}
+
+// formatTimeToKill renders durations measured in days or hours without the
+// noise of minutes and seconds.
+func formatTimeToKill(d time.Duration) string {
+	const day = 24 * time.Hour
+
+	switch {
+	case d >= day:
+		days := d / day
+		hours := (d % day).Round(time.Hour) / time.Hour
+
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case d >= time.Hour:
+		return d.Round(time.Hour).String()
+	default:
+		return d.Round(time.Minute).String()
+	}
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func TestSimpleUI_DisplayStats_RendersTrends(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	stats := m.HistoryStats{
+		Runs: []m.RunRecord{
+			{Time: start, Total: 10, Killed: 6, Survived: 4, Score: 0.6},
+			{Time: start.Add(50 * time.Hour), Total: 10, Killed: 9, Survived: 1, Score: 0.9},
+		},
+		NewSurvivorsByWeek: []m.WeekCount{{Week: "2026-W10", Count: 4}},
+		MeanTimeToKill:     50 * time.Hour,
+		KilledSurvivors:    3,
+		OpenSurvivors:      1,
+	}
+
+	ui := NewSimpleUI(cmd)
+	if err := ui.DisplayStats(stats, nil); err != nil {
+		t.Fatalf("DisplayStats() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"60.00%",
+		"90.00%",
+		"TOTAL RUNS 2",
+		"2026-W10",
+		"NEW SURVIVORS",
+		"Mean time-to-kill: 2d 2h (3 survivors killed, 1 still surviving)",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("output missing %q\noutput:\n%s", want, output)
+		}
+	}
+}
+
+func TestSimpleUI_DisplayStats_NoHistory(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	ui := NewSimpleUI(cmd)
+	if err := ui.DisplayStats(m.HistoryStats{}, nil); err != nil {
+		t.Fatalf("DisplayStats() error = %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "No run history recorded yet") {
+		t.Fatalf("unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestFormatTimeToKill(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{in: 26*time.Hour + 40*time.Minute, want: "1d 3h"},
+		{in: 5*time.Hour + 10*time.Minute, want: "5h0m0s"},
+		{in: 90 * time.Second, want: "2m0s"},
+	}
+
+	for _, tt := range tests {
+		if got := formatTimeToKill(tt.in); got != tt.want {
+			t.Fatalf("formatTimeToKill(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/olekukonko/tablewriter"
+)
+
+// fileSummary tallies mutation outcomes for one source file.
+type fileSummary struct {
+	killed   int
+	survived int
+	skipped  int
+	errored  int
+}
+
+func (f *fileSummary) add(status m.TestStatus) {
+	switch status {
+	case m.Killed:
+		f.killed++
+	case m.Survived:
+		f.survived++
+	case m.Skipped, m.NotRun, m.Flaky, m.CompileError:
+		f.skipped++
+	case m.Error:
+		f.errored++
+	}
+}
+
+// score mirrors the workflow's mutation score: skipped and errored
+// mutations are left out of the denominator.
+func (f *fileSummary) score() string {
+	total := f.killed + f.survived
+	if total == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.2f%%", float64(f.killed)/float64(total)*100)
+}
+
+// resultSummary collects per-file outcomes as results stream in. It is safe
+// for concurrent use because workers report completions in parallel.
+type resultSummary struct {
+	mu    sync.Mutex
+	files map[string]*fileSummary
+}
+
+func (r *resultSummary) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.files = nil
+}
+
+func (r *resultSummary) add(path string, status m.TestStatus) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.files == nil {
+		r.files = make(map[string]*fileSummary)
+	}
+
+	summary, ok := r.files[path]
+	if !ok {
+		summary = &fileSummary{}
+		r.files[path] = summary
+	}
+
+	summary.add(status)
+}
+
+// sortedPaths returns the recorded paths in order; callers hold r.mu.
+func (r *resultSummary) sortedPaths() []string {
+	paths := make([]string, 0, len(r.files))
+	for path := range r.files {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+// render returns the summary table, or an empty string when nothing was recorded.
+func (r *resultSummary) render() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.files) == 0 {
+		return ""
+	}
+
+	paths := r.sortedPaths()
+
+	var tableBuffer bytes.Buffer
+
+	table := tablewriter.NewWriter(&tableBuffer)
+	table.SetHeader([]string{"Path", "Killed", "Survived", "Skipped", "Errors", "Score"})
+	table.SetBorder(false)
+	table.SetCenterSeparator("")
+
+	totals := fileSummary{}
+
+	for _, path := range paths {
+		summary := r.files[path]
+		table.Append(summaryRow(path, summary))
+
+		totals.killed += summary.killed
+		totals.survived += summary.survived
+		totals.skipped += summary.skipped
+		totals.errored += summary.errored
+	}
+
+	table.SetFooter(summaryRow(fmt.Sprintf("Total Files %d", len(paths)), &totals))
+	table.Render()
+
+	return tableBuffer.String()
+}
+
+func summaryRow(label string, summary *fileSummary) []string {
+	return []string{
+		label,
+		fmt.Sprintf("%d", summary.killed),
+		fmt.Sprintf("%d", summary.survived),
+		fmt.Sprintf("%d", summary.skipped),
+		fmt.Sprintf("%d", summary.errored),
+		summary.score(),
+	}
+}
+
+// renderLines returns the summary as one sentence per file followed by the
+// totals, or an empty string when nothing was recorded.
+func (r *resultSummary) renderLines() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.files) == 0 {
+		return ""
+	}
+
+	var out bytes.Buffer
+
+	totals := fileSummary{}
+	paths := r.sortedPaths()
+
+	for _, path := range paths {
+		summary := r.files[path]
+		fmt.Fprintf(&out, "%s: %s\n", path, summaryLine(summary))
+
+		totals.killed += summary.killed
+		totals.survived += summary.survived
+		totals.skipped += summary.skipped
+		totals.errored += summary.errored
+	}
+
+	fmt.Fprintf(&out, "Total, %d files: %s\n", len(paths), summaryLine(&totals))
+
+	return out.String()
+}
+
+func summaryLine(summary *fileSummary) string {
+	return fmt.Sprintf("%d killed, %d survived, %d skipped, %d errors, score %s",
+		summary.killed, summary.survived, summary.skipped, summary.errored, summary.score())
+}
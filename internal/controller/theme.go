@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Theme names accepted by SetTheme.
+const (
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+	// ThemeNone renders the TUI without colors, as NO_COLOR asks.
+	ThemeNone = "none"
+)
+
+// ThemeNames lists the themes in the order help texts show them.
+var ThemeNames = []string{ThemeDark, ThemeLight, ThemeHighContrast, ThemeNone}
+
+// theme holds the colors the TUI draws with, by role. The none theme leaves
+// them all unset and marks the selected row in reverse video instead.
+type theme struct {
+	accent   lipgloss.TerminalColor // borders, headers and counts
+	title    lipgloss.TerminalColor
+	text     lipgloss.TerminalColor
+	muted    lipgloss.TerminalColor // footers, hints and secondary text
+	emphasis lipgloss.TerminalColor // IDs, banners and Go literals
+	file     lipgloss.TerminalColor
+	kind     lipgloss.TerminalColor // mutation types
+	keyword  lipgloss.TerminalColor // Go keywords
+	added    lipgloss.TerminalColor // added diff lines
+	removed  lipgloss.TerminalColor // removed diff lines and errors
+	killed   lipgloss.TerminalColor
+	survived lipgloss.TerminalColor
+	warning  lipgloss.TerminalColor // mutants that did not compile
+	// selectedText and selectedBackground color the selected row; search
+	// matches are drawn in selectedText on emphasis.
+	selectedText       lipgloss.TerminalColor
+	selectedBackground lipgloss.TerminalColor
+	// progress holds the two ends of the progress bar's gradient.
+	progress [2]string
+}
+
+var themes = map[string]theme{
+	ThemeDark: {
+		accent:             lipgloss.Color("6"),
+		title:              lipgloss.Color("205"),
+		text:               lipgloss.Color("252"),
+		muted:              lipgloss.Color("8"),
+		emphasis:           lipgloss.Color("11"),
+		file:               lipgloss.Color("14"),
+		kind:               lipgloss.Color("5"),
+		keyword:            lipgloss.Color("13"),
+		added:              lipgloss.Color("10"),
+		removed:            lipgloss.Color("9"),
+		killed:             lipgloss.Color("2"),
+		survived:           lipgloss.Color("1"),
+		warning:            lipgloss.Color("3"),
+		selectedText:       lipgloss.Color("0"),
+		selectedBackground: lipgloss.Color("6"),
+		progress:           [2]string{"#5A56E0", "#EE6FF8"},
+	},
+	ThemeLight: {
+		accent:             lipgloss.Color("25"),
+		title:              lipgloss.Color("161"),
+		text:               lipgloss.Color("235"),
+		muted:              lipgloss.Color("243"),
+		emphasis:           lipgloss.Color("130"),
+		file:               lipgloss.Color("24"),
+		kind:               lipgloss.Color("90"),
+		keyword:            lipgloss.Color("127"),
+		added:              lipgloss.Color("28"),
+		removed:            lipgloss.Color("160"),
+		killed:             lipgloss.Color("28"),
+		survived:           lipgloss.Color("160"),
+		warning:            lipgloss.Color("136"),
+		selectedText:       lipgloss.Color("231"),
+		selectedBackground: lipgloss.Color("25"),
+		progress:           [2]string{"#3B38B8", "#B0309C"},
+	},
+	ThemeHighContrast: {
+		accent:             lipgloss.Color("15"),
+		title:              lipgloss.Color("15"),
+		text:               lipgloss.Color("15"),
+		muted:              lipgloss.Color("7"),
+		emphasis:           lipgloss.Color("11"),
+		file:               lipgloss.Color("14"),
+		kind:               lipgloss.Color("13"),
+		keyword:            lipgloss.Color("13"),
+		added:              lipgloss.Color("10"),
+		removed:            lipgloss.Color("9"),
+		killed:             lipgloss.Color("10"),
+		survived:           lipgloss.Color("9"),
+		warning:            lipgloss.Color("11"),
+		selectedText:       lipgloss.Color("0"),
+		selectedBackground: lipgloss.Color("15"),
+		progress:           [2]string{"#FFFFFF", "#FFFFFF"},
+	},
+	ThemeNone: {
+		accent:             lipgloss.NoColor{},
+		title:              lipgloss.NoColor{},
+		text:               lipgloss.NoColor{},
+		muted:              lipgloss.NoColor{},
+		emphasis:           lipgloss.NoColor{},
+		file:               lipgloss.NoColor{},
+		kind:               lipgloss.NoColor{},
+		keyword:            lipgloss.NoColor{},
+		added:              lipgloss.NoColor{},
+		removed:            lipgloss.NoColor{},
+		killed:             lipgloss.NoColor{},
+		survived:           lipgloss.NoColor{},
+		warning:            lipgloss.NoColor{},
+		selectedText:       lipgloss.NoColor{},
+		selectedBackground: lipgloss.NoColor{},
+	},
+}
+
+// activeTheme is the theme the TUI draws with; SetTheme changes it before
+// the TUI starts.
+var activeTheme = themes[ThemeDark]
+
+// SetTheme makes the TUI draw with the named theme.
+func SetTheme(name string) error {
+	t, ok := themes[name]
+	if !ok {
+		return fmt.Errorf("invalid theme %q (want %s)", name, strings.Join(ThemeNames, ", "))
+	}
+
+	activeTheme = t
+
+	return nil
+}
+
+// selected returns the style of the selected row, in reverse video when the
+// theme has no colors.
+func (t theme) selected() lipgloss.Style {
+	if _, ok := t.selectedBackground.(lipgloss.NoColor); ok {
+		return lipgloss.NewStyle().Reverse(true).Bold(true)
+	}
+
+	return lipgloss.NewStyle().Foreground(t.selectedText).Background(t.selectedBackground).Bold(true)
+}
+
+// match returns the style of a search match.
+func (t theme) match() lipgloss.Style {
+	if _, ok := t.emphasis.(lipgloss.NoColor); ok {
+		return lipgloss.NewStyle().Reverse(true)
+	}
+
+	return lipgloss.NewStyle().Foreground(t.selectedText).Background(t.emphasis)
+}
+
+// progressOption colors the progress bar with the theme's gradient, or
+// leaves it uncolored.
+func (t theme) progressOption() progress.Option {
+	if t.progress[0] == "" {
+		return progress.WithColorProfile(termenv.Ascii)
+	}
+
+	return progress.WithGradient(t.progress[0], t.progress[1])
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSetTheme(t *testing.T) {
+	t.Cleanup(func() { activeTheme = themes[ThemeDark] })
+
+	for _, name := range ThemeNames {
+		if err := SetTheme(name); err != nil {
+			t.Fatalf("SetTheme(%q) returned error: %v", name, err)
+		}
+	}
+
+	if err := SetTheme("solarized"); err == nil {
+		t.Fatalf("expected an unknown theme to be rejected")
+	}
+
+	if err := SetTheme(ThemeLight); err != nil || activeTheme.accent != lipgloss.Color("25") {
+		t.Fatalf("expected the light theme to be active, got accent %v (%v)", activeTheme.accent, err)
+	}
+}
+
+func TestTheme_NoneMarksSelectionWithoutColors(t *testing.T) {
+	none := themes[ThemeNone]
+
+	if selected := none.selected(); !selected.GetReverse() || selected.GetBackground() != (lipgloss.NoColor{}) {
+		t.Fatalf("expected the selected row in reverse video")
+	}
+
+	if !none.match().GetReverse() {
+		t.Fatalf("expected search matches in reverse video")
+	}
+
+	if dark := themes[ThemeDark].selected(); dark.GetReverse() || dark.GetBackground() != lipgloss.Color("6") {
+		t.Fatalf("expected the dark theme to keep its cyan selection")
+	}
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+const (
+	trendBarWidth    = 30
+	trendCommitWidth = 7
+)
+
+// renderTrendChart charts the score of each run, oldest first, as a
+// horizontal bar next to its time and commit, followed by the change in
+// score across the charted runs.
+func renderTrendChart(runs []m.RunRecord) string {
+	if len(runs) == 0 {
+		return "No run history recorded yet; run `gooze run` to start one.\n"
+	}
+
+	var out bytes.Buffer
+
+	if len(runs) == 1 {
+		out.WriteString("Mutation score of the last run\n\n")
+	} else {
+		fmt.Fprintf(&out, "Mutation score over the last %d runs\n\n", len(runs))
+	}
+
+	for _, run := range runs {
+		filled := int(math.Round(run.Score * trendBarWidth))
+
+		fmt.Fprintf(&out, "%s  %-*s  %s%s %7.2f%%\n",
+			run.Time.Local().Format(statsTimeLayout),
+			trendCommitWidth, shortCommit(run.Commit),
+			strings.Repeat("█", filled),
+			strings.Repeat("░", trendBarWidth-filled),
+			run.Score*100,
+		)
+	}
+
+	if len(runs) > 1 {
+		first, last := runs[0], runs[len(runs)-1]
+		fmt.Fprintf(&out, "\nChange: %+.2f points since %s\n",
+			(last.Score-first.Score)*100, first.Time.Local().Format(statsTimeLayout))
+	}
+
+	return out.String()
+}
+
+// shortCommit abbreviates a git SHA the way `git log --oneline` does; runs
+// recorded outside a git work tree show a dash.
+func shortCommit(commit string) string {
+	if commit == "" {
+		return "-"
+	}
+
+	if len(commit) > trendCommitWidth {
+		return commit[:trendCommitWidth]
+	}
+
+	return commit
+}
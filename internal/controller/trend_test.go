@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func TestSimpleUI_DisplayTrend_ChartsScores(t *testing.T) {
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	runs := []m.RunRecord{
+		{Time: start, Commit: "1a2b3c4d5e6f", Score: 0.5},
+		{Time: start.Add(time.Hour), Score: 0.8},
+	}
+
+	ui := NewSimpleUI(cmd)
+	if err := ui.DisplayTrend(runs, nil); err != nil {
+		t.Fatalf("DisplayTrend() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"Mutation score over the last 2 runs",
+		"1a2b3c4  " + strings.Repeat("█", 15) + strings.Repeat("░", 15) + "   50.00%",
+		"-        " + strings.Repeat("█", 24) + strings.Repeat("░", 6) + "   80.00%",
+		"Change: +30.00 points since",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("output missing %q\noutput:\n%s", want, output)
+		}
+	}
+}
+
+func TestRenderTrendChart_NoHistory(t *testing.T) {
+	got := renderTrendChart(nil)
+	if !strings.Contains(got, "No run history recorded yet") {
+		t.Fatalf("unexpected output for empty history: %q", got)
+	}
+}
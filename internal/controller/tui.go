@@ -1,8 +1,10 @@
 package controller
 
 import (
+	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	m "github.com/mouse-blink/gooze/internal/model"
@@ -10,12 +12,14 @@ import (
 
 // TUI implements UI using Bubble Tea for interactive display.
 type TUI struct {
-	output  io.Writer
-	program *tea.Program
-	mu      sync.Mutex
-	started bool
-	done    chan struct{}
-	closed  bool
+	output        io.Writer
+	program       *tea.Program
+	bridge        *tuiBridge
+	mu            sync.Mutex
+	started       bool
+	done          chan struct{}
+	closed        bool
+	detailsLoader DetailsLoader
 }
 
 // NewTUI creates a new TUI.
@@ -32,7 +36,20 @@ func (t *TUI) Start(options ...StartOption) error {
 
 	var model tea.Model
 	if config.mode == ModeTest {
-		model = newTestExecutionModel()
+		testModel := newTestExecutionModel()
+		testModel.detailsLoader = config.detailsLoader
+		testModel.sourceLoader = config.sourceLoader
+		testModel.equivalentMarker = config.equivalentMarker
+		testModel.pauser = config.pauser
+		testModel.aborter = config.aborter
+		testModel.clipboard = osc52Clipboard(t.output)
+		model = testModel
+
+		t.mu.Lock()
+		if !t.started {
+			t.detailsLoader = config.detailsLoader
+		}
+		t.mu.Unlock()
 	} else {
 		model = newEstimateModel()
 	}
@@ -56,6 +73,7 @@ func (t *TUI) startWithModel(model tea.Model) error {
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
+	t.bridge = newTUIBridge(t.program.Send, tuiBridgeCapacity)
 	t.done = make(chan struct{})
 	t.started = true
 
@@ -78,9 +96,12 @@ func (t *TUI) Close() {
 
 	t.closed = true
 	program := t.program
+	bridge := t.bridge
 	done := t.done
 	t.mu.Unlock()
 
+	// Results still queued are shown before the program quits.
+	bridge.close()
 	program.Send(tea.Quit())
 	<-done
 }
@@ -100,6 +121,18 @@ func (t *TUI) Wait() {
 	<-done
 }
 
+// Done is closed once the user quits the UI; it is nil before Start.
+func (t *TUI) Done() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.started {
+		return nil
+	}
+
+	return t.done
+}
+
 // DisplayEstimation prints the estimation results or error.
 func (t *TUI) DisplayEstimation(mutations []m.Mutation, err error) error {
 	t.ensureStarted()
@@ -109,23 +142,7 @@ func (t *TUI) DisplayEstimation(mutations []m.Mutation, err error) error {
 		return err
 	}
 
-	fileStats := make(map[string]fileStat)
-
-	for _, mutation := range mutations {
-		if mutation.Source.Origin == nil {
-			continue
-		}
-
-		fileHash := mutation.Source.Origin.Hash
-		if fileHash == "" {
-			fileHash = string(mutation.Source.Origin.ShortPath)
-		}
-
-		stat := fileStats[fileHash]
-		stat.path = string(mutation.Source.Origin.ShortPath)
-		stat.count++
-		fileStats[fileHash] = stat
-	}
+	fileStats := collectFileStats(mutations)
 
 	t.send(estimationMsg{
 		total:     len(mutations),
@@ -138,6 +155,15 @@ func (t *TUI) DisplayEstimation(mutations []m.Mutation, err error) error {
 	return nil
 }
 
+// DisplayRuntimeEstimate shows the projected duration of the run under the
+// estimate.
+func (t *TUI) DisplayRuntimeEstimate(estimate m.RuntimeEstimate, err error) error {
+	t.ensureStarted()
+	t.send(runtimeEstimateMsg{estimate: estimate, err: err})
+
+	return err
+}
+
 // DisplayConcurrencyInfo shows concurrency settings.
 func (t *TUI) DisplayConcurrencyInfo(threads int, shardIndex int, count int) {
 	t.ensureStarted()
@@ -158,7 +184,7 @@ func (t *TUI) DisplayStartingTestInfo(currentMutation m.Mutation, threadID int)
 	fileHash := ""
 
 	if currentMutation.Source.Origin != nil {
-		path = string(currentMutation.Source.Origin.ShortPath)
+		path = string(currentMutation.Source.Origin.DisplayPath())
 		fileHash = currentMutation.Source.Origin.Hash
 	}
 
@@ -172,34 +198,60 @@ func (t *TUI) DisplayStartingTestInfo(currentMutation m.Mutation, threadID int)
 }
 
 // DisplayCompletedTestInfo shows info about the completed mutation test.
-func (t *TUI) DisplayCompletedTestInfo(currentMutation m.Mutation, mutationResult m.Result) {
+func (t *TUI) DisplayCompletedTestInfo(currentMutation m.Mutation, mutationResult m.MutationResult) {
 	t.ensureStarted()
 
-	status := "unknown"
-	if results, ok := mutationResult[currentMutation.Type]; ok && len(results) > 0 {
-		status = formatTestStatus(results[0].Status)
-	}
+	status := formatTestStatus(mutationResult.Status)
 
 	path := ""
+	fullPath := ""
 	fileHash := ""
 	diff := []byte(nil)
+	output := ""
 
 	if currentMutation.Source.Origin != nil {
-		path = string(currentMutation.Source.Origin.ShortPath)
+		path = string(currentMutation.Source.Origin.DisplayPath())
+		fullPath = string(currentMutation.Source.Origin.FullPath)
 		fileHash = currentMutation.Source.Origin.Hash
 	}
 
-	if status == formatTestStatus(m.Survived) && len(currentMutation.DiffCode) > 0 {
-		diff = currentMutation.DiffCode
+	line := mutationResult.Line
+	if line == 0 {
+		line = currentMutation.Position.Line
+	}
+
+	t.mu.Lock()
+	lazy := t.detailsLoader != nil
+	t.mu.Unlock()
+
+	// With a loader the results view fetches diffs and test output on
+	// selection, so only whether there is one is sent.
+	hasDiff := status == formatTestStatus(m.Survived) && len(currentMutation.DiffCode) > 0
+	hasOutput := mutationResult.TestOutput != ""
+
+	if !lazy {
+		if hasDiff {
+			diff = currentMutation.DiffCode
+		}
+
+		output = mutationResult.TestOutput
 	}
 
 	t.send(completedMutationMsg{
-		id:          currentMutation.ID[:4],
+		id:          currentMutation.ID,
 		kind:        currentMutation.Type.Name,
+		version:     currentMutation.Type.Version,
 		fileHash:    fileHash,
 		displayPath: path,
+		path:        fullPath,
+		line:        line,
 		status:      status,
 		diff:        diff,
+		hasDiff:     hasDiff && lazy,
+		duration:    mutationResult.Duration,
+		output:      output,
+		hasOutput:   hasOutput && lazy,
+		hint:        mutationResult.Hint,
 	})
 }
 
@@ -209,19 +261,127 @@ func (t *TUI) DisplayMutationScore(score float64) {
 	t.send(mutationScoreMsg{score: score})
 }
 
+// DisplayBudgetExhausted marks the results as partial.
+func (t *TUI) DisplayBudgetExhausted(budget time.Duration, notRun int) {
+	t.ensureStarted()
+	t.send(budgetExhaustedMsg{budget: budget, notRun: notRun})
+}
+
+// DisplayCachedResults shows how many verdicts were reused in the summary.
+func (t *TUI) DisplayCachedResults(cached int) {
+	t.ensureStarted()
+	t.send(cachedResultsMsg{cached: cached})
+}
+
+// DisplayInterrupted marks the results as partial.
+func (t *TUI) DisplayInterrupted(notRun int) {
+	t.ensureStarted()
+	t.send(interruptedMsg{notRun: notRun})
+}
+
+// DisplayWatchCycle shows which changes the results were last refreshed
+// for, or why that failed.
+func (t *TUI) DisplayWatchCycle(changed []m.Path, err error) {
+	t.ensureStarted()
+
+	msg := watchCycleMsg{err: err}
+	for _, path := range changed {
+		msg.changed = append(msg.changed, string(path))
+	}
+
+	t.send(msg)
+}
+
+// DisplayCorpusReport writes the corpus table straight to the output; the
+// report is static, so no interactive program is started for it.
+func (t *TUI) DisplayCorpusReport(mutations []m.Mutation, err error) error {
+	if err != nil {
+		_, _ = fmt.Fprintf(t.output, "corpus report error: %v\n", err)
+		return err
+	}
+
+	_, _ = fmt.Fprintf(t.output, "\n%s", renderCorpusTable(mutations))
+
+	return nil
+}
+
+// DisplayStats writes run history trends straight to the output.
+func (t *TUI) DisplayStats(stats m.HistoryStats, err error) error {
+	if err != nil {
+		_, _ = fmt.Fprintf(t.output, "stats error: %v\n", err)
+		return err
+	}
+
+	_, _ = fmt.Fprintf(t.output, "\n%s", renderStatsTables(stats))
+
+	return nil
+}
+
+// DisplayTrend writes the score chart straight to the output.
+func (t *TUI) DisplayTrend(runs []m.RunRecord, err error) error {
+	if err != nil {
+		_, _ = fmt.Fprintf(t.output, "trend error: %v\n", err)
+		return err
+	}
+
+	_, _ = fmt.Fprintf(t.output, "\n%s", renderTrendChart(runs))
+
+	return nil
+}
+
+// DisplayComparison writes the report comparison straight to the output.
+func (t *TUI) DisplayComparison(comparison m.ReportComparison, err error) error {
+	if err != nil {
+		_, _ = fmt.Fprintf(t.output, "diff error: %v\n", err)
+		return err
+	}
+
+	_, _ = fmt.Fprintf(t.output, "\n%s", renderComparison(comparison))
+
+	return nil
+}
+
+// DisplayCacheStatus writes the reports directory status straight to the
+// output.
+func (t *TUI) DisplayCacheStatus(status m.CacheStatus, err error) error {
+	if err != nil {
+		_, _ = fmt.Fprintf(t.output, "cache error: %v\n", err)
+		return err
+	}
+
+	_, _ = fmt.Fprintf(t.output, "\n%s", renderCacheStatus(status))
+
+	return nil
+}
+
+// DisplayIntegrity writes the reports directory check straight to the
+// output.
+func (t *TUI) DisplayIntegrity(report m.IntegrityReport, err error) error {
+	if err != nil {
+		_, _ = fmt.Fprintf(t.output, "verify error: %v\n", err)
+		return err
+	}
+
+	_, _ = fmt.Fprintf(t.output, "\n%s", renderIntegrity(report))
+
+	return nil
+}
+
 func (t *TUI) ensureStarted() {
 	_ = t.Start()
 }
 
+// send hands msg to the bridge, so the calling worker does not wait for
+// the program to render.
 func (t *TUI) send(msg tea.Msg) {
 	t.mu.Lock()
-	program := t.program
+	bridge := t.bridge
 	started := t.started
 	t.mu.Unlock()
 
-	if !started || program == nil {
+	if !started || bridge == nil {
 		return
 	}
 
-	program.Send(msg)
+	bridge.post(msg)
 }
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// tuiBridgeCapacity bounds the messages queued for the Bubble Tea program.
+const tuiBridgeCapacity = 1024
+
+// tuiBridge carries messages from workflow goroutines to a Bubble Tea
+// program. Program.Send blocks until the event loop takes the message, so
+// with many workers each one would wait on rendering; the bridge queues
+// messages instead and a single goroutine forwards them in order.
+//
+// Guarantees:
+//   - Messages are delivered in the order they were posted.
+//   - Messages are never dropped, except a startMutationMsg superseded by a
+//     later one for the same thread, which only the latest matters for.
+//   - When the queue holds capacity messages, post blocks until the program
+//     catches up, so a flooded UI slows the workers down instead of growing
+//     without bound.
+//   - close returns once every queued message was delivered.
+type tuiBridge struct {
+	deliver  func(tea.Msg)
+	capacity int
+
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	queue    []tea.Msg
+	closed   bool
+	done     chan struct{}
+}
+
+// newTUIBridge starts a bridge forwarding to deliver, normally the
+// program's Send.
+func newTUIBridge(deliver func(tea.Msg), capacity int) *tuiBridge {
+	b := &tuiBridge{
+		deliver:  deliver,
+		capacity: capacity,
+		queue:    make([]tea.Msg, 0, capacity),
+		done:     make(chan struct{}),
+	}
+	b.notEmpty = sync.NewCond(&b.mu)
+	b.notFull = sync.NewCond(&b.mu)
+
+	go b.pump()
+
+	return b
+}
+
+// post queues msg for delivery; messages posted after close are dropped.
+func (b *tuiBridge) post(msg tea.Msg) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if start, ok := msg.(startMutationMsg); ok {
+		b.dropStart(start.thread)
+	}
+
+	for len(b.queue) >= b.capacity && !b.closed {
+		b.notFull.Wait()
+	}
+
+	if b.closed {
+		return
+	}
+
+	b.queue = append(b.queue, msg)
+	b.notEmpty.Signal()
+}
+
+// dropStart removes a queued startMutationMsg of thread, if any. Only one
+// can be queued per thread, since each post of one replaces the last.
+func (b *tuiBridge) dropStart(thread int) {
+	for i, queued := range b.queue {
+		if start, ok := queued.(startMutationMsg); ok && start.thread == thread {
+			b.queue = append(b.queue[:i], b.queue[i+1:]...)
+			b.notFull.Signal()
+
+			return
+		}
+	}
+}
+
+// close stops accepting messages and waits until the queued ones were
+// delivered.
+func (b *tuiBridge) close() {
+	b.mu.Lock()
+	b.closed = true
+	b.notEmpty.Broadcast()
+	b.notFull.Broadcast()
+	b.mu.Unlock()
+
+	<-b.done
+}
+
+func (b *tuiBridge) pump() {
+	defer close(b.done)
+
+	for {
+		b.mu.Lock()
+
+		for len(b.queue) == 0 && !b.closed {
+			b.notEmpty.Wait()
+		}
+
+		if len(b.queue) == 0 {
+			b.mu.Unlock()
+			return
+		}
+
+		msg := b.queue[0]
+		b.queue[0] = nil
+		b.queue = b.queue[1:]
+		b.notFull.Signal()
+		b.mu.Unlock()
+
+		b.deliver(msg)
+	}
+}
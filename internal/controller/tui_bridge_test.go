@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// recordingProgram collects delivered messages. With a gate, each delivery
+// waits until the test releases it.
+type recordingProgram struct {
+	mu   sync.Mutex
+	msgs []tea.Msg
+	gate chan struct{}
+}
+
+func (p *recordingProgram) send(msg tea.Msg) {
+	if p.gate != nil {
+		<-p.gate
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.msgs = append(p.msgs, msg)
+}
+
+func (p *recordingProgram) delivered() []tea.Msg {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]tea.Msg(nil), p.msgs...)
+}
+
+func TestTUIBridge_DeliversInOrderAndFlushesOnClose(t *testing.T) {
+	program := &recordingProgram{}
+	bridge := newTUIBridge(program.send, 4)
+
+	for i := 0; i < 20; i++ {
+		bridge.post(upcomingMsg{count: i})
+	}
+
+	bridge.close()
+
+	msgs := program.delivered()
+	if len(msgs) != 20 {
+		t.Fatalf("expected 20 delivered messages, got %d", len(msgs))
+	}
+
+	for i, msg := range msgs {
+		if got := msg.(upcomingMsg).count; got != i {
+			t.Fatalf("message %d out of order: got count %d", i, got)
+		}
+	}
+
+	bridge.post(upcomingMsg{count: 99})
+
+	if len(program.delivered()) != 20 {
+		t.Fatal("expected messages posted after close to be dropped")
+	}
+}
+
+func TestTUIBridge_CoalescesStartsPerThread(t *testing.T) {
+	program := &recordingProgram{gate: make(chan struct{})}
+	bridge := newTUIBridge(program.send, 8)
+
+	// The first message is taken by the pump and held at the gate, so the
+	// rest stay queued.
+	bridge.post(upcomingMsg{count: 3})
+	waitQueued(t, bridge, 0)
+
+	bridge.post(startMutationMsg{id: "a", thread: 1})
+	bridge.post(startMutationMsg{id: "b", thread: 2})
+	bridge.post(completedMutationMsg{id: "a"})
+	bridge.post(startMutationMsg{id: "c", thread: 1})
+
+	close(program.gate)
+	bridge.close()
+
+	var got []string
+
+	for _, msg := range program.delivered() {
+		switch msg := msg.(type) {
+		case startMutationMsg:
+			got = append(got, "start "+msg.id)
+		case completedMutationMsg:
+			got = append(got, "done "+msg.id)
+		}
+	}
+
+	want := []string{"start b", "done a", "start c"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestTUIBridge_BlocksWhenFull(t *testing.T) {
+	program := &recordingProgram{gate: make(chan struct{})}
+	bridge := newTUIBridge(program.send, 2)
+
+	bridge.post(upcomingMsg{count: 0})
+	waitQueued(t, bridge, 0)
+	bridge.post(upcomingMsg{count: 1})
+	bridge.post(upcomingMsg{count: 2})
+
+	posted := make(chan struct{})
+	go func() {
+		bridge.post(upcomingMsg{count: 3})
+		close(posted)
+	}()
+
+	select {
+	case <-posted:
+		t.Fatal("expected post to block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	program.gate <- struct{}{}
+
+	select {
+	case <-posted:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected post to resume once the program caught up")
+	}
+
+	close(program.gate)
+	bridge.close()
+
+	if len(program.delivered()) != 4 {
+		t.Fatalf("expected 4 delivered messages, got %d", len(program.delivered()))
+	}
+}
+
+// waitQueued waits until the bridge's queue holds n messages.
+func waitQueued(t *testing.T, bridge *tuiBridge, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+
+	for time.Now().Before(deadline) {
+		bridge.mu.Lock()
+		queued := len(bridge.queue)
+		bridge.mu.Unlock()
+
+		if queued == n {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("expected %d queued messages", n)
+}
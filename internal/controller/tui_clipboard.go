@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var errNothingToCopy = errors.New("nothing to copy")
+
+// copiedMsg reports what was copied to the clipboard for the mutation with
+// the short id, or why it could not be.
+type copiedMsg struct {
+	what string
+	id   string
+	err  error
+}
+
+// osc52Clipboard returns a clipboard writing OSC52 sequences to out. The
+// terminal sets the system clipboard from them, which also works over SSH;
+// inside tmux or screen the sequence is wrapped so it reaches the terminal.
+func osc52Clipboard(out io.Writer) func(string) error {
+	return func(text string) error {
+		seq := osc52.New(text)
+
+		switch {
+		case os.Getenv("TMUX") != "":
+			seq = seq.Tmux()
+		case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+			seq = seq.Screen()
+		}
+
+		_, err := seq.WriteTo(out)
+
+		return err
+	}
+}
+
+// copySelectedDiff copies the selected mutation's diff, or its test output
+// when it has none, through the returned command. Details left to the
+// loader are loaded first.
+func (m testExecutionModel) copySelectedDiff() tea.Cmd {
+	result, ok := m.resultsList.SelectedItem().(testResult)
+	if !ok || m.clipboard == nil {
+		return nil
+	}
+
+	clipboard, loader := m.clipboard, m.detailsLoader
+
+	return func() tea.Msg {
+		what, text, output := "diff", strings.TrimSpace(result.diff), result.output
+
+		if (result.hasDiff || result.hasOutput) && loader != nil {
+			details, err := loader(result.mutationID)
+			if err != nil {
+				return copiedMsg{what: what, id: result.id, err: err}
+			}
+
+			text, output = strings.TrimSpace(string(details.Diff)), details.Output
+		}
+
+		if text == "" {
+			what, text = "test output", strings.TrimSpace(output)
+		}
+
+		if text == "" {
+			return copiedMsg{what: "diff", id: result.id, err: errNothingToCopy}
+		}
+
+		return copiedMsg{what: what, id: result.id, err: clipboard(text + "\n")}
+	}
+}
+
+// copySelectedID copies the selected mutation's full ID through the
+// returned command.
+func (m testExecutionModel) copySelectedID() tea.Cmd {
+	result, ok := m.resultsList.SelectedItem().(testResult)
+	if !ok || m.clipboard == nil {
+		return nil
+	}
+
+	clipboard := m.clipboard
+
+	return func() tea.Msg {
+		return copiedMsg{what: "ID", id: result.id, err: clipboard(result.mutationID)}
+	}
+}
+
+// copyStatus tells what the last copy put on the clipboard, or why it
+// failed.
+func (m testExecutionModel) copyStatus() string {
+	if m.copied == nil {
+		return ""
+	}
+
+	if m.copied.err != nil {
+		firstLine, _, _ := strings.Cut(m.copied.err.Error(), "\n")
+		return "Could not copy " + m.copied.what + ": " + firstLine
+	}
+
+	return "Copied " + m.copied.what + " of " + m.copied.id + " to the clipboard"
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestOSC52Clipboard(t *testing.T) {
+	t.Setenv("TMUX", "")
+	t.Setenv("TERM", "xterm-256color")
+
+	var buf bytes.Buffer
+
+	if err := osc52Clipboard(&buf)("abcd1234"); err != nil {
+		t.Fatalf("osc52Clipboard returned error: %v", err)
+	}
+
+	if got, want := buf.String(), "\x1b]52;c;YWJjZDEyMzQ=\x07"; got != want {
+		t.Fatalf("osc52Clipboard wrote %q, want %q", got, want)
+	}
+}
+
+func TestTestExecutionModel_CopiesSelectedMutation(t *testing.T) {
+	var copied []string
+
+	m := newTestExecutionModel()
+	m.clipboard = func(text string) error {
+		copied = append(copied, text)
+		return nil
+	}
+	m.detailsLoader = func(mutationID string) (ResultDetails, error) {
+		return ResultDetails{Diff: []byte("--- a/calc.go\n+++ b/calc.go\n")}, nil
+	}
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "bool", displayPath: "calc.go", status: "survived", hasDiff: true})
+	m.resultsList.Select(0)
+
+	updated, cmd := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd == nil {
+		t.Fatalf("expected y to copy the diff")
+	}
+
+	model, _ := updated.Update(cmd())
+	updated = model.(testExecutionModel)
+
+	if len(copied) != 1 || !strings.HasPrefix(copied[0], "--- a/calc.go") {
+		t.Fatalf("expected the loaded diff on the clipboard, got %q", copied)
+	}
+
+	if status := updated.copyStatus(); status != "Copied diff of hash to the clipboard" {
+		t.Fatalf("copyStatus() = %q", status)
+	}
+
+	_, cmd = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	if cmd == nil {
+		t.Fatalf("expected Y to copy the mutation ID")
+	}
+
+	cmd()
+
+	if len(copied) != 2 || copied[1] != "hash1234" {
+		t.Fatalf("expected the full mutation ID on the clipboard, got %q", copied)
+	}
+}
+
+func TestTestExecutionModel_CopyStatusReportsFailures(t *testing.T) {
+	m := newTestExecutionModel()
+	m.clipboard = func(string) error { return errors.New("clipboard unavailable") }
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "bool", displayPath: "calc.go", status: "killed"})
+	m.resultsList.Select(0)
+
+	_, cmd := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+
+	model, _ := m.Update(cmd())
+	if status := model.(testExecutionModel).copyStatus(); status != "Could not copy diff: nothing to copy" {
+		t.Fatalf("copyStatus() = %q", status)
+	}
+
+	_, cmd = m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+
+	model, _ = m.Update(cmd())
+	if status := model.(testExecutionModel).copyStatus(); status != "Could not copy ID: clipboard unavailable" {
+		t.Fatalf("copyStatus() = %q", status)
+	}
+}
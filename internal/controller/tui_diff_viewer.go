@@ -0,0 +1,340 @@
+package controller
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// diffViewer shows a whole diff, or test output, full screen. It scrolls
+// and pans through a viewport, colors Go code in diff lines and searches
+// the text for a query.
+type diffViewer struct {
+	title    string
+	lines    []string
+	output   bool
+	viewport viewport.Model
+	search   textinput.Model
+	// searching is set while the query is typed; matches holds the
+	// indexes of the lines containing the committed query and match the
+	// one scrolled to.
+	searching bool
+	query     string
+	matches   []int
+	match     int
+}
+
+func newDiffViewer(title, text string, output bool, width, height int) diffViewer {
+	search := textinput.New()
+	search.Prompt = "/"
+	search.Placeholder = "search"
+
+	v := diffViewer{
+		title:    title,
+		output:   output,
+		viewport: viewport.New(0, 0),
+		search:   search,
+	}
+	v.viewport.SetHorizontalStep(8)
+	v.setSize(width, height)
+	v.setText(text)
+
+	return v
+}
+
+// setSize fits the viewport between the header and footer lines.
+func (v *diffViewer) setSize(width, height int) {
+	v.viewport.Width = max(width, 10)
+	v.viewport.Height = max(height-2, 3)
+	v.search.Width = max(width-4, 10)
+}
+
+// setText replaces the text shown, as when a diff finishes loading.
+func (v *diffViewer) setText(text string) {
+	v.lines = strings.Split(strings.TrimSpace(text), "\n")
+	v.findMatches()
+	v.render()
+}
+
+func (v *diffViewer) render() {
+	matched := make(map[int]bool, len(v.matches))
+	for _, i := range v.matches {
+		matched[i] = true
+	}
+
+	rendered := make([]string, len(v.lines))
+
+	for i, line := range v.lines {
+		switch {
+		case matched[i]:
+			rendered[i] = highlightMatches(line, v.query, v.lineStyle(line))
+		case v.output:
+			rendered[i] = outputLineStyle(line).Render(line)
+		default:
+			rendered[i] = highlightDiffLine(line)
+		}
+	}
+
+	v.viewport.SetContent(strings.Join(rendered, "\n"))
+}
+
+func (v diffViewer) lineStyle(line string) lipgloss.Style {
+	if v.output {
+		return outputLineStyle(line)
+	}
+
+	return diffLineStyle(line)
+}
+
+// findMatches lists the lines containing the query, ignoring case.
+func (v *diffViewer) findMatches() {
+	v.matches = v.matches[:0]
+	v.match = 0
+
+	if v.query == "" {
+		return
+	}
+
+	query := strings.ToLower(v.query)
+
+	for i, line := range v.lines {
+		if strings.Contains(strings.ToLower(line), query) {
+			v.matches = append(v.matches, i)
+		}
+	}
+}
+
+// jump scrolls to the match step matches away from the current one,
+// wrapping around at either end.
+func (v *diffViewer) jump(step int) {
+	if len(v.matches) == 0 {
+		return
+	}
+
+	v.match = (v.match + step + len(v.matches)) % len(v.matches)
+	v.viewport.SetYOffset(v.matches[v.match])
+}
+
+// Update handles a key in the viewer, reporting whether it closed the
+// viewer.
+func (v diffViewer) Update(msg tea.KeyMsg) (diffViewer, bool, tea.Cmd) {
+	if v.searching {
+		switch msg.String() {
+		case "enter":
+			v.searching = false
+			v.query = strings.TrimSpace(v.search.Value())
+			v.search.Blur()
+			v.findMatches()
+			v.render()
+
+			// Start from the first match at or below the top of the view.
+			for i, line := range v.matches {
+				if line >= v.viewport.YOffset {
+					v.match = i
+					break
+				}
+			}
+
+			v.jump(0)
+
+			return v, false, nil
+		case "esc":
+			v.searching = false
+			v.search.Blur()
+
+			return v, false, nil
+		}
+
+		var cmd tea.Cmd
+
+		v.search, cmd = v.search.Update(msg)
+
+		return v, false, cmd
+	}
+
+	switch msg.String() {
+	case "esc", "q", "enter":
+		return v, true, nil
+	case "/":
+		v.searching = true
+		v.search.SetValue(v.query)
+		v.search.CursorEnd()
+
+		return v, false, v.search.Focus()
+	case "n":
+		v.jump(1)
+		return v, false, nil
+	case "N":
+		v.jump(-1)
+		return v, false, nil
+	case "g", "home":
+		v.viewport.GotoTop()
+		return v, false, nil
+	case "G", "end":
+		v.viewport.GotoBottom()
+		return v, false, nil
+	}
+
+	var cmd tea.Cmd
+
+	v.viewport, cmd = v.viewport.Update(msg)
+
+	return v, false, cmd
+}
+
+// View renders the title with the scroll position, the text and the keys,
+// or the search prompt while a query is typed.
+func (v diffViewer) View() string {
+	headerStyle := lipgloss.NewStyle().Foreground(activeTheme.accent).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(activeTheme.muted)
+
+	position := fmt.Sprintf("%d lines • %3.0f%%", len(v.lines), v.viewport.ScrollPercent()*100)
+
+	switch {
+	case v.query != "" && len(v.matches) == 0:
+		position = fmt.Sprintf("no match for %q • %s", v.query, position)
+	case v.query != "":
+		position = fmt.Sprintf("match %d/%d for %q • %s", v.match+1, len(v.matches), v.query, position)
+	}
+
+	titleWidth := v.viewport.Width - lipgloss.Width(position) - 2
+	header := headerStyle.Render(truncateFile(v.title, max(titleWidth, 10))) + "  " + dimStyle.Render(position)
+
+	footer := dimStyle.Render(truncateFile("↑/k ↓/j scroll • pgup/pgdn page • ←/h →/l pan • g/G top/bottom • / search • n/N next/prev • esc back", v.viewport.Width))
+	if v.searching {
+		footer = v.search.View()
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, v.viewport.View(), footer)
+}
+
+// highlightDiffLine colors a unified diff line: file and hunk headers as in
+// the diff box, and the Go code of added, removed and context lines by
+// token, in green or red for changed lines.
+func highlightDiffLine(line string) string {
+	if line == "" || strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "---") || strings.HasPrefix(line, "@@") {
+		return diffLineStyle(line).Render(line)
+	}
+
+	palette := goPalette{
+		plain:   lipgloss.NewStyle().Foreground(activeTheme.text),
+		keyword: lipgloss.NewStyle().Foreground(activeTheme.keyword),
+		literal: lipgloss.NewStyle().Foreground(activeTheme.emphasis),
+		comment: lipgloss.NewStyle().Foreground(activeTheme.muted),
+	}
+
+	switch line[0] {
+	case '+', '-':
+		base := diffLineStyle(line)
+		palette = goPalette{plain: base, keyword: base.Bold(true), literal: base, comment: base.Faint(true)}
+	case ' ':
+	default:
+		return diffLineStyle(line).Render(line)
+	}
+
+	return palette.plain.Render(line[:1]) + palette.highlight(line[1:])
+}
+
+// goPalette holds the styles Go tokens are rendered with.
+type goPalette struct {
+	plain   lipgloss.Style
+	keyword lipgloss.Style
+	literal lipgloss.Style
+	comment lipgloss.Style
+}
+
+// highlight renders a line of Go code token by token. The line is scanned
+// on its own, so a token spanning lines, such as a raw string, is only
+// colored on its first line.
+func (p goPalette) highlight(code string) string {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(code))
+
+	var s scanner.Scanner
+
+	s.Init(file, []byte(code), nil, scanner.ScanComments)
+
+	var out strings.Builder
+
+	last := 0
+
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+
+		// Skip the semicolons the scanner inserts at the end of the line.
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+
+		start := file.Offset(pos)
+		if start < last {
+			continue
+		}
+
+		text := lit
+		if text == "" {
+			text = tok.String()
+		}
+
+		end := min(start+len(text), len(code))
+
+		style := p.plain
+
+		switch {
+		case tok.IsKeyword():
+			style = p.keyword
+		case tok == token.COMMENT:
+			style = p.comment
+		case tok == token.STRING || tok == token.CHAR || tok == token.INT || tok == token.FLOAT || tok == token.IMAG:
+			style = p.literal
+		}
+
+		out.WriteString(p.plain.Render(code[last:start]))
+		out.WriteString(style.Render(code[start:end]))
+
+		last = end
+	}
+
+	out.WriteString(p.plain.Render(code[last:]))
+
+	return out.String()
+}
+
+// highlightMatches renders line in style with each occurrence of query,
+// ignoring case, highlighted.
+func highlightMatches(line, query string, style lipgloss.Style) string {
+	matchStyle := activeTheme.match()
+
+	lower, query := strings.ToLower(line), strings.ToLower(query)
+	if len(lower) != len(line) {
+		// Lowercasing changed byte offsets; show the line unhighlighted.
+		return style.Render(line)
+	}
+
+	var out strings.Builder
+
+	for {
+		i := strings.Index(lower, query)
+		if i < 0 || query == "" {
+			break
+		}
+
+		out.WriteString(style.Render(line[:i]))
+		out.WriteString(matchStyle.Render(line[i : i+len(query)]))
+
+		line, lower = line[i+len(query):], lower[i+len(query):]
+	}
+
+	out.WriteString(style.Render(line))
+
+	return out.String()
+}
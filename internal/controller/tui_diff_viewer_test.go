@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func longDiff() string {
+	lines := []string{"--- a/calc.go", "+++ b/calc.go", "@@ -1,60 +1,60 @@"}
+	for i := range 60 {
+		line := fmt.Sprintf(" \tx%d := %d", i, i)
+		if i%20 == 5 {
+			line = fmt.Sprintf("-\treturn Total(x%d) // sum", i)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func typeKeys(v diffViewer, keys ...tea.KeyMsg) diffViewer {
+	for _, key := range keys {
+		v, _, _ = v.Update(key)
+	}
+
+	return v
+}
+
+func TestDiffViewer_SearchJumpsBetweenMatches(t *testing.T) {
+	v := newDiffViewer("Diff • calc.go", longDiff(), false, 80, 12)
+
+	v = typeKeys(v,
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("total")},
+		tea.KeyMsg{Type: tea.KeyEnter},
+	)
+
+	if v.searching || v.query != "total" || len(v.matches) != 3 {
+		t.Fatalf("expected 3 matches for %q, got %v", v.query, v.matches)
+	}
+
+	if v.viewport.YOffset != v.matches[0] {
+		t.Fatalf("expected the first match at the top, got offset %d", v.viewport.YOffset)
+	}
+
+	v = typeKeys(v, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if v.match != 1 || v.viewport.YOffset != v.matches[1] {
+		t.Fatalf("expected n to move to the second match, got match %d offset %d", v.match, v.viewport.YOffset)
+	}
+
+	v = typeKeys(v, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("N")}, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("N")})
+	if v.match != 2 {
+		t.Fatalf("expected N to wrap to the last match, got match %d", v.match)
+	}
+
+	if header := ansi.Strip(v.View()); !strings.Contains(header, `match 3/3 for "total"`) {
+		t.Fatalf("expected the match position in the header, got %q", header)
+	}
+
+	_, closed, _ := v.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !closed {
+		t.Fatalf("expected esc to close the viewer")
+	}
+}
+
+func TestDiffViewer_ScrollsPastTheDiffBox(t *testing.T) {
+	v := newDiffViewer("Diff • calc.go", longDiff(), false, 80, 12)
+
+	v = typeKeys(v, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	if !v.viewport.AtBottom() {
+		t.Fatalf("expected G to scroll to the end of the diff")
+	}
+
+	if view := ansi.Strip(v.View()); !strings.Contains(view, "x59 := 59") {
+		t.Fatalf("expected the last line of the diff, got %q", view)
+	}
+}
+
+func TestHighlightDiffLine_KeepsText(t *testing.T) {
+	for _, line := range []string{
+		"--- a/calc.go",
+		"@@ -1,3 +1,3 @@",
+		"-\tif a > b { // compare",
+		"+\tif a <= b { // compare",
+		" \treturn fmt.Sprintf(\"%d\", 'x')",
+		" \ts := `raw",
+		"\\ No newline at end of file",
+		"",
+	} {
+		// Rendering expands tabs, as in the diff box.
+		if got := ansi.Strip(highlightDiffLine(line)); got != strings.ReplaceAll(line, "\t", "    ") {
+			t.Fatalf("highlightDiffLine(%q) rendered %q", line, got)
+		}
+	}
+
+	if got := ansi.Strip(highlightMatches("return Total(x)", "total", diffLineStyle(" "))); got != "return Total(x)" {
+		t.Fatalf("highlightMatches rendered %q", got)
+	}
+}
+
+func TestTestExecutionModel_FullScreenDiffFollowsLazyLoad(t *testing.T) {
+	m := newTestExecutionModel()
+	m.width, m.height = 100, 30
+	m.detailsLoader = func(string) (ResultDetails, error) { return ResultDetails{Diff: []byte(longDiff())}, nil }
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "bool", displayPath: "calc.go", status: "survived", hasDiff: true})
+	m.resultsList.Select(0)
+
+	updated, load := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !updated.viewingDiff || !strings.Contains(ansi.Strip(updated.View()), "Loading diff…") {
+		t.Fatalf("expected the full screen view of the pending diff")
+	}
+
+	updated = updated.handleDetailsLoaded(load().(detailsLoadedMsg))
+	if len(updated.viewer.lines) != 63 || !strings.Contains(ansi.Strip(updated.View()), "Diff • calc.go") {
+		t.Fatalf("expected the loaded diff full screen, got %d lines", len(updated.viewer.lines))
+	}
+}
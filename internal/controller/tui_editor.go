@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// editorEnv names the environment variable holding the command template the
+// results view opens mutations with, such as "code -g {file}:{line}".
+const editorEnv = "GOOZE_EDITOR"
+
+var errNoEditor = errors.New("set $EDITOR or $" + editorEnv + " to open mutations")
+
+// editorClosedMsg reports that the editor opened from the results view
+// exited, with its error if it failed.
+type editorClosedMsg struct {
+	err error
+}
+
+// editorCommand returns the command opening file at line. $GOOZE_EDITOR is
+// split into arguments whose {file} and {line} placeholders are replaced;
+// the file is appended when the template does not mention it. Otherwise
+// $VISUAL or $EDITOR is started with +line and the file, which vi, vim,
+// nano, emacs and most terminal editors understand.
+func editorCommand(file string, line int, getenv func(string) string) (*exec.Cmd, error) {
+	if template := strings.Fields(getenv(editorEnv)); len(template) > 0 {
+		args := make([]string, 0, len(template)+1)
+		hasFile := false
+
+		for _, arg := range template {
+			hasFile = hasFile || strings.Contains(arg, "{file}")
+			arg = strings.ReplaceAll(arg, "{file}", file)
+			args = append(args, strings.ReplaceAll(arg, "{line}", strconv.Itoa(max(line, 1))))
+		}
+
+		if !hasFile {
+			args = append(args, file)
+		}
+
+		// #nosec G204 -- the user configures the editor command
+		return exec.Command(args[0], args[1:]...), nil
+	}
+
+	editor := getenv("VISUAL")
+	if editor == "" {
+		editor = getenv("EDITOR")
+	}
+
+	args := strings.Fields(editor)
+	if len(args) == 0 {
+		return nil, errNoEditor
+	}
+
+	if line > 0 {
+		args = append(args, "+"+strconv.Itoa(line))
+	}
+
+	args = append(args, file)
+
+	// #nosec G204 -- the user configures the editor command
+	return exec.Command(args[0], args[1:]...), nil
+}
+
+// openSelectedInEditor suspends the results view and opens the selected
+// mutation's file at its line, returning to the view when the editor exits.
+func (m *testExecutionModel) openSelectedInEditor() tea.Cmd {
+	result, ok := m.resultsList.SelectedItem().(testResult)
+	if !ok || result.path == "" {
+		return nil
+	}
+
+	cmd, err := editorCommand(result.path, result.line, os.Getenv)
+	if err != nil {
+		m.editorErr = err
+		return nil
+	}
+
+	m.editorErr = nil
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return editorClosedMsg{err: err}
+	})
+}
+
+// editorStatus tells why the last mutation could not be opened.
+func (m testExecutionModel) editorStatus() string {
+	if m.editorErr == nil {
+		return ""
+	}
+
+	firstLine, _, _ := strings.Cut(m.editorErr.Error(), "\n")
+
+	return "Could not open editor: " + firstLine
+}
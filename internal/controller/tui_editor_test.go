@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestEditorCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		line int
+		want []string
+	}{
+		{name: "template", env: map[string]string{editorEnv: "code -g {file}:{line}", "EDITOR": "vim"}, line: 12, want: []string{"code", "-g", "/project/calc.go:12"}},
+		{name: "template without file", env: map[string]string{editorEnv: "subl --wait"}, line: 12, want: []string{"subl", "--wait", "/project/calc.go"}},
+		{name: "visual before editor", env: map[string]string{"VISUAL": "emacs -nw", "EDITOR": "vi"}, line: 7, want: []string{"emacs", "-nw", "+7", "/project/calc.go"}},
+		{name: "editor", env: map[string]string{"EDITOR": "nano"}, line: 7, want: []string{"nano", "+7", "/project/calc.go"}},
+		{name: "unknown line", env: map[string]string{"EDITOR": "vim"}, want: []string{"vim", "/project/calc.go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := editorCommand("/project/calc.go", tt.line, func(key string) string { return tt.env[key] })
+			if err != nil {
+				t.Fatalf("editorCommand returned error: %v", err)
+			}
+
+			if !reflect.DeepEqual(cmd.Args, tt.want) {
+				t.Fatalf("editorCommand args = %q, want %q", cmd.Args, tt.want)
+			}
+		})
+	}
+
+	if _, err := editorCommand("/project/calc.go", 7, func(string) string { return "" }); !errors.Is(err, errNoEditor) {
+		t.Fatalf("expected errNoEditor without an editor, got %v", err)
+	}
+}
+
+func TestTestExecutionModel_OpenSelectedInEditor(t *testing.T) {
+	t.Setenv(editorEnv, "")
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "")
+
+	m := newTestExecutionModel()
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "bool", displayPath: "calc.go", path: "/project/calc.go", line: 7, status: "survived"})
+	m.resultsList.Select(0)
+
+	updated, cmd := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if cmd != nil || !strings.Contains(updated.editorStatus(), "$EDITOR") {
+		t.Fatalf("expected a hint to set $EDITOR, got cmd=%v status=%q", cmd != nil, updated.editorStatus())
+	}
+
+	t.Setenv("EDITOR", "true")
+
+	updated, cmd = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if cmd == nil || updated.editorStatus() != "" {
+		t.Fatalf("expected the editor to be started, got cmd=%v status=%q", cmd != nil, updated.editorStatus())
+	}
+
+	model, _ := updated.Update(editorClosedMsg{err: errors.New("exit status 1")})
+	if status := model.(testExecutionModel).editorStatus(); status != "Could not open editor: exit status 1" {
+		t.Fatalf("editorStatus() = %q", status)
+	}
+}
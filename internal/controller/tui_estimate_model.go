@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	model "github.com/mouse-blink/gooze/internal/model"
 )
 
 type tickMsg time.Time
@@ -39,22 +42,16 @@ func (d estimateDelegate) Render(w io.Writer, m list.Model, index int, item list
 	width := m.Width() - 8 // Subtract count width (6) + spacing (2)
 
 	if isSelected {
-		pathStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("0")).
-			Background(lipgloss.Color("6")).
-			Bold(true)
-		countStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("0")).
-			Background(lipgloss.Color("6")).
-			Bold(true).
+		pathStyle = activeTheme.selected()
+		countStyle = activeTheme.selected().
 			Width(6).
 			Align(lipgloss.Right)
 
 		displayPath = animateScroll(file.path, width, d.offset)
 	} else {
-		pathStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("14"))
+		pathStyle = lipgloss.NewStyle().Foreground(activeTheme.file)
 		countStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("11")).
+			Foreground(activeTheme.emphasis).
 			Bold(true).
 			Width(6).
 			Align(lipgloss.Right)
@@ -66,9 +63,30 @@ func (d estimateDelegate) Render(w io.Writer, m list.Model, index int, item list
 		countStyle.Render(fmt.Sprintf("%d", file.count)),
 		pathStyle.Render(displayPath),
 	)
+
+	// The per-type breakdown follows the path only when both fit.
+	if breakdown := formatBreakdown(file.byType); breakdown != "" &&
+		lipgloss.Width(file.path)+2+lipgloss.Width(breakdown) <= width {
+		line += "  " + lipgloss.NewStyle().Foreground(activeTheme.muted).Render(breakdown)
+	}
+
 	_, _ = fmt.Fprint(w, line)
 }
 
+// formatBreakdown lists per-type counts as "arithmetic 12 · comparison 8",
+// in m.MutationTypes order.
+func formatBreakdown(byType map[string]int) string {
+	parts := make([]string, 0, len(byType))
+
+	for _, mutationType := range model.MutationTypes {
+		if count := byType[mutationType.Name]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", mutationType.Name, count))
+		}
+	}
+
+	return strings.Join(parts, " · ")
+}
+
 func animateScroll(text string, width int, offset int) string {
 	if width <= 0 {
 		return ""
@@ -159,8 +177,24 @@ type estimateModel struct {
 	rendered     bool
 	animOffset   int
 	lastSelected int
+	// stats are the listed files; types the mutation types present in them.
+	// sortColumn picks the sort key: the path, the total count, or the count
+	// of types[sortColumn-2].
+	stats      []fileStat
+	types      []string
+	sortColumn int
+	sortDesc   bool
+	// runtime is the projected duration of the run, once timed, or why it
+	// could not be.
+	runtime    *model.RuntimeEstimate
+	runtimeErr error
 }
 
+const (
+	sortByPath = iota
+	sortByCount
+)
+
 func newEstimateModel() estimateModel {
 	delegate := estimateDelegate{}
 	fileList := list.New([]list.Item{}, delegate, 80, 20)
@@ -208,9 +242,22 @@ func (m estimateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "q", "ctrl+c":
+		filtering := m.fileList.FilterState() == list.Filtering
+
+		switch {
+		case !filtering && (msg.String() == "q" || msg.String() == "ctrl+c"):
 			return m, tea.Quit
+		case !filtering && msg.String() == "s":
+			m.sortColumn = (m.sortColumn + 1) % (len(m.types) + 2)
+			m.sortDesc = m.sortColumn != sortByPath
+			m.applySort()
+
+			return m, nil
+		case !filtering && msg.String() == "r":
+			m.sortDesc = !m.sortDesc
+			m.applySort()
+
+			return m, nil
 		default:
 			// Pass all key events to the list
 			var newList list.Model
@@ -231,6 +278,9 @@ func (m estimateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case estimationMsg:
 		m = m.handleEstimationMsg(msg)
+
+	case runtimeEstimateMsg:
+		m.runtime, m.runtimeErr = &msg.estimate, msg.err
 	}
 
 	return m, cmd
@@ -239,34 +289,82 @@ func (m estimateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m estimateModel) handleEstimationMsg(msg estimationMsg) estimateModel {
 	m.total = msg.total
 	m.totalFiles = msg.paths
+	m.types = presentTypes(msg.fileStats)
 
-	// Create sorted file items
-	statsList := make([]fileStat, 0, len(msg.fileStats))
+	m.stats = make([]fileStat, 0, len(msg.fileStats))
 	for _, stat := range msg.fileStats {
-		statsList = append(statsList, stat)
+		m.stats = append(m.stats, stat)
 	}
 
-	sort.Slice(statsList, func(i, j int) bool {
-		return statsList[i].path < statsList[j].path
-	})
-
-	items := make([]list.Item, 0, len(statsList))
-	for _, stat := range statsList {
-		items = append(items, fileItem(stat))
+	if m.sortColumn >= len(m.types)+2 {
+		m.sortColumn, m.sortDesc = sortByPath, false
 	}
 
-	m.fileList.SetItems(items)
+	m.applySort()
 	m.rendered = true
 
 	// Start animation loop if not started (Init calls it, but just in case)
 	// Or ensure selection is tracked
-	if len(items) > 0 && m.lastSelected == -1 {
+	if len(m.stats) > 0 && m.lastSelected == -1 {
 		m.lastSelected = 0
 	}
 
 	return m
 }
 
+// applySort orders the file list by the current sort column, breaking ties
+// by path.
+func (m *estimateModel) applySort() {
+	key := func(stat fileStat) int {
+		switch m.sortColumn {
+		case sortByPath:
+			return 0
+		case sortByCount:
+			return stat.count
+		default:
+			return stat.byType[m.types[m.sortColumn-2]]
+		}
+	}
+
+	sort.SliceStable(m.stats, func(i, j int) bool {
+		a, b := m.stats[i], m.stats[j]
+		if m.sortDesc {
+			a, b = b, a
+		}
+
+		if ka, kb := key(a), key(b); ka != kb {
+			return ka < kb
+		}
+
+		return a.path < b.path
+	})
+
+	items := make([]list.Item, 0, len(m.stats))
+	for _, stat := range m.stats {
+		items = append(items, fileItem(stat))
+	}
+
+	m.fileList.SetItems(items)
+}
+
+// sortLabel names the sort column and direction for the summary line.
+func (m estimateModel) sortLabel() string {
+	name := "path"
+
+	switch {
+	case m.sortColumn == sortByCount:
+		name = "count"
+	case m.sortColumn > sortByCount:
+		name = m.types[m.sortColumn-2]
+	}
+
+	if m.sortDesc {
+		return name + " ↓"
+	}
+
+	return name + " ↑"
+}
+
 func (m estimateModel) View() string {
 	if !m.rendered {
 		return "Loading mutation list…\n"
@@ -274,36 +372,43 @@ func (m estimateModel) View() string {
 
 	// Styles
 	titleStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("205")).
+		Foreground(activeTheme.title).
 		Bold(true).
 		Padding(1, 0, 0, 2)
 
 	summaryStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("252")).
+		Foreground(activeTheme.text).
 		Padding(0, 0, 1, 2)
 
-	accentStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("6")) // Cyan
+	accentStyle := lipgloss.NewStyle().Foreground(activeTheme.accent) // Cyan
 
 	// 1. Title
 	title := titleStyle.Render("🧬 Gooze Mutation Estimate")
 
 	// 2. Summary
-	summary := summaryStyle.Render(fmt.Sprintf(
-		"Total Mutations: %s   Files: %s",
+	summaryText := fmt.Sprintf(
+		"Total Mutations: %s   Files: %s   Sorted by: %s",
 		accentStyle.Render(fmt.Sprintf("%d", m.total)),
 		accentStyle.Render(fmt.Sprintf("%d", m.totalFiles)),
-	))
+		accentStyle.Render(m.sortLabel()),
+	)
+
+	if runtime := m.runtimeSummary(accentStyle); runtime != "" {
+		summaryText += "\n" + runtime
+	}
+
+	summary := summaryStyle.Render(summaryText)
 
 	// 3. Table with border
 	table := m.renderTable()
 
 	// 4. Footer
 	footerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("8")).
+		Foreground(activeTheme.muted).
 		Align(lipgloss.Center).
 		Width(m.width)
 
-	footer := footerStyle.Render("↑/k up • ↓/j down • g/G top/bottom • / filter • q quit")
+	footer := footerStyle.Render("↑/k up • ↓/j down • g/G top/bottom • / filter • s sort • r reverse • q quit")
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		title,
@@ -313,6 +418,28 @@ func (m estimateModel) View() string {
 	)
 }
 
+// runtimeSummary projects the run's duration on one line, or tells why it
+// could not be projected.
+func (m estimateModel) runtimeSummary(accentStyle lipgloss.Style) string {
+	switch {
+	case m.runtimeErr != nil:
+		return lipgloss.NewStyle().Foreground(activeTheme.removed).Render("Runtime estimate failed: " + m.runtimeErr.Error())
+	case m.runtime == nil:
+		return ""
+	}
+
+	text := fmt.Sprintf("Projected runtime: %s with %s workers",
+		accentStyle.Render(formatEstimate(m.runtime.Total)),
+		accentStyle.Render(fmt.Sprintf("%d", m.runtime.Threads)))
+
+	if m.runtime.ShardCount > 1 {
+		text += fmt.Sprintf("   Shard %d/%d: %s",
+			m.runtime.ShardIndex, m.runtime.ShardCount, accentStyle.Render(formatEstimate(m.runtime.Shard)))
+	}
+
+	return text
+}
+
 func (m estimateModel) renderTable() string {
 	// List sizing
 	// Display calculations:
@@ -324,6 +451,11 @@ func (m estimateModel) renderTable() string {
 	// - Padding/Headers (2)
 	// = Left for list
 	listHeight := m.height - 9
+	if m.runtime != nil || m.runtimeErr != nil {
+		// The projected runtime adds a summary line.
+		listHeight--
+	}
+
 	if listHeight < 5 {
 		listHeight = 5
 	}
@@ -341,17 +473,17 @@ func (m estimateModel) renderTable() string {
 
 	// Column Headers inside the table area
 	headerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("8")).
+		Foreground(activeTheme.muted).
 		Bold(true).
 		Border(lipgloss.NormalBorder(), false, false, true, false).
-		BorderForeground(lipgloss.Color("8")).
+		BorderForeground(activeTheme.muted).
 		Width(listWidth)
 
 	headers := headerStyle.Render(fmt.Sprintf("%6s  %s", "Count", "File Path"))
 
 	tableContainer := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("6")).
+		BorderForeground(activeTheme.accent).
 		Margin(0, 1). // Outer margin
 		Padding(0, 1) // Inner padding
 
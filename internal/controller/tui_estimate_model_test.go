@@ -2,12 +2,14 @@ package controller
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	model "github.com/mouse-blink/gooze/internal/model"
 )
 
 func TestAnimateScroll_Edges(t *testing.T) {
@@ -93,6 +95,30 @@ func TestEstimateModel_HandleEstimationMsgAndView(t *testing.T) {
 	_ = m.renderTable()
 }
 
+func TestEstimateModel_RuntimeEstimate(t *testing.T) {
+	m := newEstimateModel()
+	m = m.handleEstimationMsg(estimationMsg{total: 1, paths: 1, fileStats: map[string]fileStat{"a": {path: "a.go", count: 1}}})
+	m.width, m.height = 120, 25
+
+	if strings.Contains(m.View(), "Projected runtime") {
+		t.Fatalf("View() projected a runtime before one was timed")
+	}
+
+	updated, _ := m.Update(runtimeEstimateMsg{estimate: model.RuntimeEstimate{Threads: 4, Total: 90 * time.Second, ShardIndex: 1, ShardCount: 3, Shard: 30 * time.Second}})
+	view := updated.(estimateModel).View()
+
+	for _, want := range []string{"Projected runtime: 1m30s with 4 workers", "Shard 1/3: 30s"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("View() missing %q\n%s", want, view)
+		}
+	}
+
+	updated, _ = m.Update(runtimeEstimateMsg{err: errors.New("baseline tests failing")})
+	if view := updated.(estimateModel).View(); !strings.Contains(view, "Runtime estimate failed: baseline tests failing") {
+		t.Fatalf("View() missing the runtime error\n%s", view)
+	}
+}
+
 func TestEstimateModel_UpdateBranches(t *testing.T) {
 	m := newEstimateModel()
 	m.rendered = true
@@ -174,3 +200,60 @@ func TestEstimateDelegate_Render(t *testing.T) {
 		t.Fatalf("Update() returned cmd")
 	}
 }
+
+func TestEstimateModel_SortColumns(t *testing.T) {
+	m := newEstimateModel()
+	m = m.handleEstimationMsg(estimationMsg{
+		total: 6,
+		paths: 3,
+		fileStats: map[string]fileStat{
+			"a": {path: "a.go", count: 1, byType: map[string]int{"loop": 1}},
+			"b": {path: "b.go", count: 3, byType: map[string]int{"arithmetic": 1, "loop": 2}},
+			"c": {path: "c.go", count: 2, byType: map[string]int{"arithmetic": 2}},
+		},
+	})
+
+	paths := func(m estimateModel) string {
+		var names []string
+		for _, item := range m.fileList.Items() {
+			names = append(names, item.(fileItem).path)
+		}
+
+		return strings.Join(names, " ")
+	}
+
+	press := func(m estimateModel, key string) estimateModel {
+		model, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		return model.(estimateModel)
+	}
+
+	if got := paths(m); got != "a.go b.go c.go" {
+		t.Fatalf("initial order = %q, want by path", got)
+	}
+
+	steps := []struct {
+		key   string
+		order string
+		label string
+	}{
+		{"s", "b.go c.go a.go", "count ↓"},
+		{"s", "c.go b.go a.go", "arithmetic ↓"},
+		{"s", "b.go a.go c.go", "loop ↓"},
+		{"r", "c.go a.go b.go", "loop ↑"},
+		{"s", "a.go b.go c.go", "path ↑"},
+	}
+
+	for _, step := range steps {
+		m = press(m, step.key)
+		if got := paths(m); got != step.order || m.sortLabel() != step.label {
+			t.Fatalf("after %q order = %q sorted by %q, want %q by %q", step.key, got, m.sortLabel(), step.order, step.label)
+		}
+	}
+}
+
+func TestFormatBreakdown(t *testing.T) {
+	got := formatBreakdown(map[string]int{"loop": 2, "arithmetic": 12, "unary": 0})
+	if got != "arithmetic 12 · loop 2" {
+		t.Fatalf("formatBreakdown() = %q", got)
+	}
+}
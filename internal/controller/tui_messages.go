@@ -1,5 +1,11 @@
 package controller
 
+import (
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
 // Message types.
 type estimationMsg struct {
 	total     int
@@ -8,6 +14,12 @@ type estimationMsg struct {
 	err       error
 }
 
+// runtimeEstimateMsg carries the projected duration of the estimated run.
+type runtimeEstimateMsg struct {
+	estimate m.RuntimeEstimate
+	err      error
+}
+
 type upcomingMsg struct {
 	count int
 }
@@ -21,17 +33,95 @@ type startMutationMsg struct {
 }
 
 type completedMutationMsg struct {
-	id          string
-	kind        interface{}
+	id   string
+	kind interface{}
+	// version is the operator's version.
+	version     int
 	fileHash    string
 	displayPath string
-	status      string
-	diff        []byte
+	// path and line locate the mutation for an editor.
+	path   string
+	line   int
+	status string
+	diff   []byte
+	// hasDiff marks a survivor whose diff is left to the DetailsLoader.
+	hasDiff  bool
+	duration time.Duration
+	// output is the go test output of a mutant that errored or did not
+	// compile; hasOutput marks one whose output is left to the
+	// DetailsLoader.
+	output    string
+	hasOutput bool
+	// hint suggests the test that would kill a survivor.
+	hint string
+}
+
+// detailsLoadedMsg carries a diff or test output loaded on demand for the
+// results view.
+type detailsLoadedMsg struct {
+	mutationID string
+	details    ResultDetails
+	err        error
+}
+
+// equivalentMarkedMsg tells whether marking a survivor as equivalent from
+// the results view worked.
+type equivalentMarkedMsg struct {
+	mutationID string
+	err        error
 }
 
 type fileStat struct {
-	path  string
-	count int
+	path   string
+	count  int
+	byType map[string]int
+}
+
+// collectFileStats counts mutations per source file and per mutation type,
+// keyed by file hash, or by display path for files without one.
+func collectFileStats(mutations []m.Mutation) map[string]fileStat {
+	stats := make(map[string]fileStat)
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil {
+			continue
+		}
+
+		fileHash := mutation.Source.Origin.Hash
+		if fileHash == "" {
+			fileHash = string(mutation.Source.Origin.DisplayPath())
+		}
+
+		stat := stats[fileHash]
+		stat.path = string(mutation.Source.Origin.DisplayPath())
+		stat.count++
+
+		if stat.byType == nil {
+			stat.byType = make(map[string]int)
+		}
+
+		stat.byType[mutation.Type.Name]++
+		stats[fileHash] = stat
+	}
+
+	return stats
+}
+
+// presentTypes returns the names of the mutation types with at least one
+// mutation in stats, in m.MutationTypes order.
+func presentTypes(stats map[string]fileStat) []string {
+	var names []string
+
+	for _, mutationType := range m.MutationTypes {
+		for _, stat := range stats {
+			if stat.byType[mutationType.Name] > 0 {
+				names = append(names, mutationType.Name)
+				break
+			}
+		}
+	}
+
+	return names
 }
 
 type concurrencyMsg struct {
@@ -44,10 +134,29 @@ type mutationScoreMsg struct {
 	score float64
 }
 
+type budgetExhaustedMsg struct {
+	budget time.Duration
+	notRun int
+}
+
+type interruptedMsg struct {
+	notRun int
+}
+
+type cachedResultsMsg struct {
+	cached int
+}
+
+type watchCycleMsg struct {
+	changed []string
+	err     error
+}
+
 // List item types.
 type fileItem struct {
-	path  string
-	count int
+	path   string
+	count  int
+	byType map[string]int
 }
 
 func (f fileItem) FilterValue() string {
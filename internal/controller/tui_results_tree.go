@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// fileGroup is the row of a source file in the grouped results view, with
+// the counts of its results.
+type fileGroup struct {
+	file      string
+	killed    int
+	survived  int
+	total     int
+	collapsed bool
+}
+
+// FilterValue matches a file row by its path, like its results.
+func (g fileGroup) FilterValue() string {
+	return g.file
+}
+
+// groupResults lists each file, in the order its first result completed,
+// followed by its results unless the file is collapsed.
+func groupResults(results []testResult, collapsed map[string]bool) []list.Item {
+	groups := make(map[string]*fileGroup)
+	byFile := make(map[string][]testResult)
+
+	var order []string
+
+	for _, result := range results {
+		group, ok := groups[result.file]
+		if !ok {
+			group = &fileGroup{file: result.file, collapsed: collapsed[result.file]}
+			groups[result.file] = group
+			order = append(order, result.file)
+		}
+
+		group.total++
+
+		switch result.status {
+		case "killed":
+			group.killed++
+		case "survived":
+			group.survived++
+		}
+
+		byFile[result.file] = append(byFile[result.file], result)
+	}
+
+	items := make([]list.Item, 0, len(order)+len(results))
+
+	for _, file := range order {
+		group := groups[file]
+		items = append(items, *group)
+
+		if group.collapsed {
+			continue
+		}
+
+		for _, result := range byFile[file] {
+			items = append(items, result)
+		}
+	}
+
+	return items
+}
+
+// resultListItems returns the rows of the results list: one per result,
+// or the per-file tree in grouped mode.
+func (m testExecutionModel) resultListItems() []list.Item {
+	if m.grouped {
+		return groupResults(m.results, m.collapsed)
+	}
+
+	return resultItems(m.results)
+}
+
+// toggleGrouped switches between the flat results list and the per-file
+// tree, keeping the selection on the same file.
+func (m *testExecutionModel) toggleGrouped() {
+	file := selectedFile(m.resultsList.SelectedItem())
+
+	m.grouped = !m.grouped
+	m.delegate.grouped = m.grouped
+	m.resultsList.SetDelegate(m.delegate)
+	m.hideDiff()
+	m.refreshResults(file)
+}
+
+// toggleSelectedGroup collapses or expands the selected file row, reporting
+// whether a file row was selected.
+func (m *testExecutionModel) toggleSelectedGroup() bool {
+	group, ok := m.resultsList.SelectedItem().(fileGroup)
+	if !ok {
+		return false
+	}
+
+	if m.collapsed == nil {
+		m.collapsed = make(map[string]bool)
+	}
+
+	m.collapsed[group.file] = !group.collapsed
+	m.hideDiff()
+	m.refreshResults(group.file)
+
+	return true
+}
+
+// refreshResults rebuilds the results list and selects the first row of
+// file, if it is listed.
+func (m *testExecutionModel) refreshResults(file string) {
+	items := m.resultListItems()
+	m.resultsList.SetItems(items)
+
+	for i, item := range items {
+		if file != "" && selectedFile(item) == file {
+			m.resultsList.Select(i)
+			break
+		}
+	}
+
+	m.lastSelected = m.resultsList.Index()
+}
+
+func selectedFile(item list.Item) string {
+	switch item := item.(type) {
+	case fileGroup:
+		return item.file
+	case testResult:
+		return item.file
+	default:
+		return ""
+	}
+}
+
+// renderGroup renders a file row: a fold marker, the path and the counts of
+// its results.
+func (d testResultDelegate) renderGroup(w io.Writer, width int, group fileGroup, isSelected bool) {
+	marker := "▾"
+	if group.collapsed {
+		marker = "▸"
+	}
+
+	counts := fmt.Sprintf("%d killed · %d survived · %d total", group.killed, group.survived, group.total)
+
+	fileWidth := width - lipgloss.Width(counts) - 6
+	if fileWidth < 10 {
+		fileWidth = 10
+	}
+
+	fileStyle := lipgloss.NewStyle().Foreground(activeTheme.file).Bold(true)
+	countStyle := lipgloss.NewStyle().Foreground(activeTheme.muted)
+
+	if isSelected {
+		fileStyle = activeTheme.selected()
+		countStyle = fileStyle
+	}
+
+	_, _ = fmt.Fprintf(w, "%s %s  %s",
+		fileStyle.Render(marker),
+		fileStyle.Render(truncateFile(group.file, fileWidth)),
+		countStyle.Render(counts),
+	)
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func groupedTestModel() testExecutionModel {
+	m := newTestExecutionModel()
+	m.width, m.height = 120, 40
+	m = m.handleUpcoming(upcomingMsg{count: 4})
+
+	for _, msg := range []completedMutationMsg{
+		{id: "aaaa0001", kind: "arithmetic", displayPath: "calc/add.go", status: "killed"},
+		{id: "bbbb0001", kind: "boolean", displayPath: "calc/sub.go", status: "survived"},
+		{id: "aaaa0002", kind: "comparison", displayPath: "calc/add.go", status: "survived"},
+		{id: "aaaa0003", kind: "logical", displayPath: "calc/add.go", status: "killed"},
+	} {
+		m = m.handleCompletedMutation(msg)
+	}
+
+	return m
+}
+
+func TestGroupResults_NestsResultsUnderFiles(t *testing.T) {
+	m := groupedTestModel()
+
+	items := groupResults(m.results, map[string]bool{"calc/sub.go": true})
+	if len(items) != 5 {
+		t.Fatalf("expected 2 file rows and 3 results, got %d items", len(items))
+	}
+
+	add, ok := items[0].(fileGroup)
+	if !ok || add.file != "calc/add.go" || add.killed != 2 || add.survived != 1 || add.total != 3 || add.collapsed {
+		t.Fatalf("unexpected first file row: %+v", items[0])
+	}
+
+	for i, id := range []string{"aaaa0001", "aaaa0002", "aaaa0003"} {
+		if result, ok := items[i+1].(testResult); !ok || result.mutationID != id {
+			t.Fatalf("item %d = %+v, want result %s", i+1, items[i+1], id)
+		}
+	}
+
+	sub, ok := items[4].(fileGroup)
+	if !ok || sub.file != "calc/sub.go" || !sub.collapsed || sub.survived != 1 {
+		t.Fatalf("unexpected collapsed file row: %+v", items[4])
+	}
+}
+
+func TestTestExecutionModel_GroupedModeCollapsesFiles(t *testing.T) {
+	m := groupedTestModel()
+	m.resultsList.Select(1)
+
+	updated, _ := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	if !updated.grouped || len(updated.resultsList.Items()) != 6 {
+		t.Fatalf("expected the per-file tree, got %d items", len(updated.resultsList.Items()))
+	}
+
+	group, ok := updated.resultsList.SelectedItem().(fileGroup)
+	if !ok || group.file != "calc/sub.go" {
+		t.Fatalf("expected the selected result's file to stay selected, got %+v", updated.resultsList.SelectedItem())
+	}
+
+	updated, _ = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	if !updated.collapsed["calc/sub.go"] || len(updated.resultsList.Items()) != 5 {
+		t.Fatalf("expected calc/sub.go to collapse, got %d items", len(updated.resultsList.Items()))
+	}
+
+	if updated.showDiff {
+		t.Fatalf("folding a file should not open the detail pane")
+	}
+
+	// Results completing while grouped land under their file.
+	updated = updated.handleCompletedMutation(completedMutationMsg{id: "bbbb0002", kind: "boolean", displayPath: "calc/sub.go", status: "killed"})
+	if len(updated.resultsList.Items()) != 5 {
+		t.Fatalf("expected the new result to stay folded, got %d items", len(updated.resultsList.Items()))
+	}
+
+	updated, _ = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	if updated.collapsed["calc/sub.go"] || len(updated.resultsList.Items()) != 7 {
+		t.Fatalf("expected calc/sub.go to expand, got %d items", len(updated.resultsList.Items()))
+	}
+
+	updated, _ = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	if updated.grouped || len(updated.resultsList.Items()) != 5 {
+		t.Fatalf("expected the flat list back, got %d items", len(updated.resultsList.Items()))
+	}
+}
+
+func TestTestResultDelegate_RenderGroup(t *testing.T) {
+	var buf bytes.Buffer
+
+	testResultDelegate{}.renderGroup(&buf, 80, fileGroup{file: "calc/add.go", killed: 2, survived: 1, total: 3, collapsed: true}, false)
+
+	got := buf.String()
+	for _, want := range []string{"▸", "calc/add.go", "2 killed · 1 survived · 3 total"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("renderGroup() = %q, missing %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// sourceContextLines is how many lines of original source the detail pane
+// shows on each side of a survivor's mutated line.
+const sourceContextLines = 3
+
+// sourceLoadedMsg carries the original source of a selected survivor's file.
+type sourceLoadedMsg struct {
+	mutationID string
+	source     []byte
+	err        error
+}
+
+func loadSource(loader SourceLoader, mutationID, path string) tea.Cmd {
+	return func() tea.Msg {
+		source, err := loader(path)
+		return sourceLoadedMsg{mutationID: mutationID, source: source, err: err}
+	}
+}
+
+// handleSourceLoaded shows the mutated line in context if its result is
+// still selected. A file that cannot be read only loses the context.
+func (m testExecutionModel) handleSourceLoaded(msg sourceLoadedMsg) testExecutionModel {
+	if !m.showDiff || m.selectedDiffID != msg.mutationID || msg.err != nil {
+		return m
+	}
+
+	result, ok := m.resultsList.SelectedItem().(testResult)
+	if !ok || result.mutationID != msg.mutationID {
+		return m
+	}
+
+	m.selectedSource = sourceContext(msg.source, result.line, sourceContextLines)
+
+	if m.viewingDiff {
+		m.viewer.setText(m.detailText())
+	}
+
+	return m
+}
+
+// sourceContext returns line of source with context lines on each side,
+// numbered, and the mutated line marked with >. It is empty when line is
+// not in source, as when the file changed since the run.
+func sourceContext(source []byte, line, context int) string {
+	lines := strings.Split(strings.TrimRight(string(source), "\n"), "\n")
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+
+	first := max(line-context, 1)
+	last := min(line+context, len(lines))
+	width := len(fmt.Sprint(last))
+
+	out := []string{fmt.Sprintf("Source, lines %d-%d:", first, last)}
+
+	for n := first; n <= last; n++ {
+		marker := " "
+		if n == line {
+			marker = ">"
+		}
+
+		out = append(out, fmt.Sprintf("%s %*d │ %s", marker, width, n, strings.TrimRight(lines[n-1], "\r")))
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// operatorLabel names a mutation's operator with its version, as reports
+// record it.
+func operatorLabel(typ string, version int) string {
+	if version == 0 {
+		return typ
+	}
+
+	return fmt.Sprintf("%s v%d", typ, version)
+}
+
+// detailText is what the detail pane shows: the selected diff or test
+// output, followed by the hint of a survivor and the mutated line in
+// context once it is loaded.
+func (m testExecutionModel) detailText() string {
+	text := m.selectedDiff
+
+	if m.selectedHint != "" {
+		text += "\n\nHint: " + m.selectedHint
+	}
+
+	if m.selectedSource != "" {
+		text += "\n\n" + m.selectedSource
+	}
+
+	return text
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSourceContext(t *testing.T) {
+	source := []byte("package calc\n\nfunc Add(a, b int) int {\n\treturn a + b\n}\n")
+
+	tests := []struct {
+		name    string
+		line    int
+		context int
+		want    string
+	}{
+		{
+			name:    "middle",
+			line:    4,
+			context: 1,
+			want:    "Source, lines 3-5:\n  3 │ func Add(a, b int) int {\n> 4 │ \treturn a + b\n  5 │ }",
+		},
+		{
+			name:    "clipped at the start",
+			line:    1,
+			context: 1,
+			want:    "Source, lines 1-2:\n> 1 │ package calc\n  2 │ ",
+		},
+		{name: "past the end", line: 9, context: 3},
+		{name: "unknown line", context: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sourceContext(source, tt.line, tt.context); got != tt.want {
+				t.Fatalf("sourceContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorLabel(t *testing.T) {
+	if got := operatorLabel("loop", 4); got != "loop v4" {
+		t.Fatalf("operatorLabel() = %q, want %q", got, "loop v4")
+	}
+
+	if got := operatorLabel("loop", 0); got != "loop" {
+		t.Fatalf("operatorLabel() without a version = %q, want %q", got, "loop")
+	}
+}
+
+func TestTestExecutionModel_SourceContext(t *testing.T) {
+	var read []string
+
+	m := newTestExecutionModel()
+	m.sourceLoader = func(path string) ([]byte, error) {
+		read = append(read, path)
+		if path == "/project/gone.go" {
+			return nil, errors.New("no such file")
+		}
+
+		return []byte("a := 1\nb := a + 2\nc := b\n"), nil
+	}
+	m = m.handleUpcoming(upcomingMsg{count: 2})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "arithmetic", version: 1, displayPath: "calc.go", path: "/project/calc.go", line: 2, status: "survived", diff: []byte("-b := a + 2\n+b := a - 2\n")})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "gone1234", kind: "arithmetic", version: 1, displayPath: "gone.go", path: "/project/gone.go", line: 2, status: "survived", diff: []byte("-x\n+y\n")})
+	m.resultsList.Select(0)
+
+	updated, cmd := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || !strings.Contains(updated.detailTitle(), "calc.go • arithmetic v1") {
+		t.Fatalf("expected a pending source load and the operator in the title, cmd=%v title=%q", cmd != nil, updated.detailTitle())
+	}
+
+	model, _ := updated.Update(cmd())
+	updated = model.(testExecutionModel)
+
+	want := "-b := a + 2\n+b := a - 2\n\nSource, lines 1-3:\n  1 │ a := 1\n> 2 │ b := a + 2\n  3 │ c := b"
+	if got := updated.detailText(); got != want {
+		t.Fatalf("detailText() = %q, want %q", got, want)
+	}
+
+	// A file that cannot be read shows the diff alone.
+	updated.resultsList.Select(1)
+	updated.hideDiff()
+
+	updated, cmd = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	updated = updated.handleSourceLoaded(cmd().(sourceLoadedMsg))
+
+	if got := updated.detailText(); got != "-x\n+y" {
+		t.Fatalf("detailText() without source = %q", got)
+	}
+
+	if len(read) != 2 || read[0] != "/project/calc.go" {
+		t.Fatalf("unexpected reads %v", read)
+	}
+}
+
+func TestTestExecutionModel_DetailTextShowsHint(t *testing.T) {
+	m := newTestExecutionModel()
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	m = m.handleCompletedMutation(completedMutationMsg{
+		id: "hash1234", kind: "comparison", version: 1, displayPath: "calc.go", line: 2, status: "survived",
+		diff: []byte("-a < b\n+a <= b\n"),
+		hint: "no test asserts the return value of Less for inputs where a == b, on the boundary of a < b",
+	})
+	m.resultsList.Select(0)
+
+	updated, _ := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+
+	want := "-a < b\n+a <= b\n\nHint: no test asserts the return value of Less for inputs where a == b, on the boundary of a < b"
+	if got := updated.detailText(); got != want {
+		t.Fatalf("detailText() = %q, want %q", got, want)
+	}
+
+	updated.hideDiff()
+
+	if updated.selectedHint != "" {
+		t.Fatalf("expected hideDiff to clear the hint, got %q", updated.selectedHint)
+	}
+}
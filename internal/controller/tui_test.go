@@ -26,7 +26,7 @@ func TestTUI_StartWithModel_WaitAndClose(t *testing.T) {
 		t.Fatalf("startWithModel error = %v", err)
 	}
 
-	// send while running should go through program.Send
+	// send while running should go through the bridge to program.Send
 	tui.send(upcomingMsg{count: 2})
 
 	waitDone := make(chan struct{})
@@ -54,6 +54,26 @@ func TestTUI_StartWithModel_WaitAndClose(t *testing.T) {
 	}
 }
 
+func TestTUI_Done_ClosedWhenProgramEnds(t *testing.T) {
+	var buf bytes.Buffer
+	tui := NewTUI(&buf)
+
+	if tui.Done() != nil {
+		t.Fatal("expected no done channel before Start")
+	}
+
+	if err := tui.startWithModel(quitModel{}); err != nil {
+		t.Fatalf("startWithModel error = %v", err)
+	}
+	defer tui.Close()
+
+	select {
+	case <-tui.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("Done() not closed after the program quit")
+	}
+}
+
 func TestTUI_Send_And_EnsureStarted_NoPanic(t *testing.T) {
 	var buf bytes.Buffer
 	tui := NewTUI(&buf)
@@ -79,13 +99,7 @@ func TestTUI_DisplayCompletedTestInfo_WithDiff(t *testing.T) {
 		DiffCode: diffCode,
 	}
 
-	survivedResult := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{{MutationID: "hash-10", Status: m.Survived}},
-	}
+	survivedResult := m.MutationResult{MutationID: "hash-10", Type: m.MutationArithmetic, Status: m.Survived}
 
 	// Start TUI in test mode
 	if err := tui.Start(WithTestMode()); err != nil {
@@ -110,13 +124,7 @@ func TestTUI_DisplayCompletedTestInfo_WithoutDiff(t *testing.T) {
 		// No DiffCode
 	}
 
-	killedResult := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{{MutationID: "hash-10", Status: m.Killed}},
-	}
+	killedResult := m.MutationResult{MutationID: "hash-10", Type: m.MutationArithmetic, Status: m.Killed}
 
 	// Start TUI in test mode
 	if err := tui.Start(WithTestMode()); err != nil {
@@ -198,16 +206,10 @@ func mutationWithoutOrigin() m.Mutation {
 	return m.Mutation{ID: "hash-2", Type: m.MutationBoolean}
 }
 
-func completedResult() m.Result {
-	return m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{{MutationID: "hash-1", Status: m.Killed}},
-	}
+func completedResult() m.MutationResult {
+	return m.MutationResult{MutationID: "hash-1", Type: m.MutationArithmetic, Status: m.Killed}
 }
 
-func mResultEmpty() m.Result {
-	return m.Result{}
+func mResultEmpty() m.MutationResult {
+	return m.MutationResult{}
 }
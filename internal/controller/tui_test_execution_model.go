@@ -3,6 +3,7 @@ package controller
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,13 +13,34 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
+// slowestResultsLimit caps the number of entries in the slowest mutations section.
+const slowestResultsLimit = 5
+
 // testResult holds information about a completed mutation test.
 type testResult struct {
-	id     string
-	file   string
-	typ    string
-	status string
-	diff   string
+	id         string
+	mutationID string
+	file       string
+	typ        string
+	// version is the operator's version, shown in the detail pane.
+	version int
+	status  string
+	diff    string
+	// hasDiff marks a survivor whose diff is loaded on selection.
+	hasDiff  bool
+	duration time.Duration
+	// output is the go test output shown for a broken mutant; hasOutput
+	// marks one whose output is loaded on selection.
+	output    string
+	hasOutput bool
+	// hint suggests the test that would kill a survivor.
+	hint string
+	// path and line are where the o key opens the mutation.
+	path string
+	line int
+	// cycle is the run the result came from; watch mode tests again in
+	// later runs.
+	cycle int
 }
 
 // Implement list.Item interface for testResult.
@@ -29,6 +51,8 @@ func (r testResult) FilterValue() string {
 // testResultDelegate is the delegate for rendering test results in the list.
 type testResultDelegate struct {
 	offset int
+	// grouped indents results under their file rows.
+	grouped bool
 }
 
 func (d testResultDelegate) Height() int  { return 1 }
@@ -38,6 +62,11 @@ func (d testResultDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
 }
 
 func (d testResultDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	if group, ok := item.(fileGroup); ok {
+		d.renderGroup(w, m.Width(), group, index == m.Index())
+		return
+	}
+
 	result, ok := item.(testResult)
 	if !ok {
 		return
@@ -46,9 +75,16 @@ func (d testResultDelegate) Render(w io.Writer, m list.Model, index int, item li
 	isSelected := index == m.Index()
 	fileWidth := m.Width() - 40 // Reserve space for ID, Status, Type columns and spacing
 
+	indent := ""
+	if d.grouped {
+		indent = "  "
+		fileWidth -= len(indent)
+	}
+
 	idStyle, statusStyle, typeStyle, fileStyle, displayFile := d.getStylesAndFile(result, isSelected, fileWidth)
 
-	line := fmt.Sprintf("%s  %s  %s  %s",
+	line := fmt.Sprintf("%s%s  %s  %s  %s",
+		indent,
 		idStyle.Render(fmt.Sprintf("%-4s", result.id[:4])),
 		statusStyle.Render(fmt.Sprintf("%-8s", result.status)),
 		typeStyle.Render(fmt.Sprintf("%-10s", result.typ)),
@@ -59,45 +95,34 @@ func (d testResultDelegate) Render(w io.Writer, m list.Model, index int, item li
 
 func (d testResultDelegate) getStylesAndFile(result testResult, isSelected bool, fileWidth int) (lipgloss.Style, lipgloss.Style, lipgloss.Style, lipgloss.Style, string) {
 	if isSelected {
-		return lipgloss.NewStyle().
-				Foreground(lipgloss.Color("0")).
-				Background(lipgloss.Color("6")).
-				Bold(true).
+		return activeTheme.selected().
 				Width(6).
 				Align(lipgloss.Left),
-			lipgloss.NewStyle().
-				Foreground(lipgloss.Color("0")).
-				Background(lipgloss.Color("6")).
-				Bold(true).
+			activeTheme.selected().
 				Width(10).
 				Align(lipgloss.Left),
-			lipgloss.NewStyle().
-				Foreground(lipgloss.Color("0")).
-				Background(lipgloss.Color("6")).
-				Bold(true).
+			activeTheme.selected().
 				Width(12).
 				Align(lipgloss.Left),
-			lipgloss.NewStyle().
-				Foreground(lipgloss.Color("0")).
-				Background(lipgloss.Color("6")).
-				Bold(true),
+			activeTheme.selected(),
 			animateScrollFile(result.file, fileWidth, d.offset)
 	}
 
-	statusColorMap := map[string]lipgloss.Color{
-		"killed":   lipgloss.Color("2"), // Green
-		"survived": lipgloss.Color("1"), // Red
-		"error":    lipgloss.Color("1"), // Red
-		"unknown":  lipgloss.Color("8"), // Gray
+	statusColorMap := map[string]lipgloss.TerminalColor{
+		"killed":     activeTheme.killed,
+		"survived":   activeTheme.survived,
+		"error":      activeTheme.survived,
+		"uncompiled": activeTheme.warning,
+		"unknown":    activeTheme.muted,
 	}
 
 	statusColor, ok := statusColorMap[result.status]
 	if !ok {
-		statusColor = lipgloss.Color("8")
+		statusColor = activeTheme.muted
 	}
 
 	return lipgloss.NewStyle().
-			Foreground(lipgloss.Color("11")).
+			Foreground(activeTheme.emphasis).
 			Bold(true).
 			Width(6).
 			Align(lipgloss.Left),
@@ -107,11 +132,11 @@ func (d testResultDelegate) getStylesAndFile(result testResult, isSelected bool,
 			Width(10).
 			Align(lipgloss.Left),
 		lipgloss.NewStyle().
-			Foreground(lipgloss.Color("5")).
+			Foreground(activeTheme.kind).
 			Width(12).
 			Align(lipgloss.Left),
 		lipgloss.NewStyle().
-			Foreground(lipgloss.Color("14")),
+			Foreground(activeTheme.file),
 		truncateFile(result.file, fileWidth)
 }
 
@@ -126,6 +151,10 @@ type testExecutionModel struct {
 	currentStatus     string
 	mutationScore     float64
 	mutationScoreSet  bool
+	budget            time.Duration
+	budgetNotRun      int
+	interrupted       bool
+	cached            int
 	totalMutations    int
 	completedCount    int
 	progressPercent   float64
@@ -144,11 +173,66 @@ type testExecutionModel struct {
 	showDiff          bool
 	selectedDiff      string
 	selectedDiffPath  string
+	selectedDiffID    string
+	// selectedOperator names the selected survivor's operator,
+	// selectedHint suggests a test to kill it, and selectedSource holds its
+	// mutated line in context once loaded.
+	selectedOperator string
+	selectedHint     string
+	selectedSource   string
+	// grouped shows results under a row per file; collapsed holds the files
+	// whose results are hidden.
+	grouped   bool
+	collapsed map[string]bool
+	// slowest holds the slowestResultsLimit results that took longest,
+	// slowest first, updated as results come in so that frames do not sort
+	// every result.
+	slowest []testResult
+	// selectedOutput marks the detail pane as showing test output rather
+	// than a diff.
+	selectedOutput bool
+	// viewingDiff shows the detail pane's text full screen in viewer.
+	viewingDiff bool
+	viewer      diffViewer
+	// detailsLoader, when set, loads diffs and test output on selection;
+	// results then keep only their mutation IDs.
+	detailsLoader DetailsLoader
+	// sourceLoader, when set, reads the original source around a selected
+	// survivor's mutated line.
+	sourceLoader SourceLoader
+	// equivalentMarker, when set, lets the e key mark the selected survivor
+	// as equivalent; markErr is why the last attempt failed.
+	equivalentMarker EquivalentMarker
+	markErr          error
+	// pauser, when set, lets the p key pause the run while it is tested;
+	// paused tells whether it is paused.
+	pauser Pauser
+	paused bool
+	// aborter, when set, lets the x key stop the run early; aborting tells
+	// the running mutations are finishing.
+	aborter  Aborter
+	aborting bool
+	// editorErr is why the editor could not open the last mutation.
+	editorErr error
+	// clipboard, when set, receives what the y and Y keys copy; copied
+	// reports the last copy.
+	clipboard func(string) error
+	copied    *copiedMsg
+	// cycle counts the runs shown; results of a file tested again replace
+	// those of earlier runs. refreshed holds the files the current run
+	// already replaced.
+	cycle     int
+	refreshed map[string]bool
+	// watching is set once watch mode reported a cycle, with the files
+	// that started the last one and why it failed, if it did.
+	watching     bool
+	watchChanged []string
+	watchErr     error
 }
 
 func newTestExecutionModel() testExecutionModel {
 	prog := progress.New(
-		progress.WithDefaultGradient(),
+		activeTheme.progressOption(),
 		progress.WithWidth(40),
 		progress.WithoutPercentage(),
 	)
@@ -206,9 +290,43 @@ func (m testExecutionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case upcomingMsg:
 		m = m.handleUpcoming(msg)
 
+	case detailsLoadedMsg:
+		m = m.handleDetailsLoaded(msg)
+
+	case sourceLoadedMsg:
+		m = m.handleSourceLoaded(msg)
+
+	case equivalentMarkedMsg:
+		m = m.handleEquivalentMarked(msg)
+
+	case editorClosedMsg:
+		m.editorErr = msg.err
+
+	case copiedMsg:
+		m.copied = &msg
+
 	case mutationScoreMsg:
 		m.mutationScore = msg.score
 		m.mutationScoreSet = true
+
+	case budgetExhaustedMsg:
+		m.budget = msg.budget
+		m.budgetNotRun = msg.notRun
+
+	case interruptedMsg:
+		m.interrupted = true
+		m.budgetNotRun = msg.notRun
+
+	case cachedResultsMsg:
+		m.cached = msg.cached
+
+	case watchCycleMsg:
+		// A cycle that failed midway leaves no progress to wait for.
+		m.rendered = true
+		m.testingFinished = true
+		m.watching = true
+		m.watchChanged = msg.changed
+		m.watchErr = msg.err
 	}
 
 	return m, cmd
@@ -224,10 +342,18 @@ func (m testExecutionModel) handleConcurrency(msg concurrencyMsg) testExecutionM
 }
 
 func (m testExecutionModel) handleUpcoming(msg upcomingMsg) testExecutionModel {
+	m.cycle++
+	m.refreshed = make(map[string]bool)
 	m.totalMutations = msg.count
 	m.completedCount = 0
 	m.progressPercent = 0
+	m.budgetNotRun = 0
+	m.interrupted = false
+	m.cached = 0
 	m.rendered = true
+	m.testingFinished = false
+	m.aborting = false
+	m.hideDiff()
 
 	if msg.count == 0 {
 		m.testingFinished = true
@@ -242,6 +368,10 @@ func (m testExecutionModel) View() string {
 		return "Initializing test execution…\n"
 	}
 
+	if m.testingFinished && m.viewingDiff {
+		return m.viewer.View()
+	}
+
 	if m.testingFinished {
 		return m.viewResults()
 	}
@@ -250,19 +380,19 @@ func (m testExecutionModel) View() string {
 }
 
 func (m testExecutionModel) viewProgress() string {
-	accentColor := lipgloss.Color("6") // Cyan
+	accentColor := activeTheme.accent
 
 	// Styles
 	titleStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("205")).
+		Foreground(activeTheme.title).
 		Bold(true).
 		Padding(1, 0, 0, 2)
 
 	summaryStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("252")).
+		Foreground(activeTheme.text).
 		Padding(0, 0, 1, 2)
 
-	accentStyle := lipgloss.NewStyle().Foreground(accentColor) // Cyan
+	accentStyle := lipgloss.NewStyle().Foreground(accentColor)
 
 	// 1. Title
 	title := titleStyle.Render("🧬 Gooze Mutation Testing")
@@ -277,6 +407,15 @@ func (m testExecutionModel) viewProgress() string {
 		accentStyle.Render(fmt.Sprintf("%d", m.totalShards)),
 	))
 
+	bannerStyle := lipgloss.NewStyle().Foreground(activeTheme.emphasis).Bold(true).Padding(0, 0, 1, 2)
+
+	switch {
+	case m.aborting:
+		summary = lipgloss.JoinVertical(lipgloss.Left, summary, bannerStyle.Render("⏹ Stopping: running mutations finish, then the results so far are saved"))
+	case m.paused:
+		summary = lipgloss.JoinVertical(lipgloss.Left, summary, bannerStyle.Render("⏸ Paused: running mutations finish, no new ones start"))
+	}
+
 	// 3. Progress Bar
 	progressStyle := lipgloss.NewStyle().
 		Padding(0, 2)
@@ -288,12 +427,29 @@ func (m testExecutionModel) viewProgress() string {
 
 	// 5. Footer
 	footerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("8")).
+		Foreground(activeTheme.muted).
 		Align(lipgloss.Center).
 		Width(m.width).
 		Padding(0, 0)
 
+	keys := make([]string, 0, 3)
+
+	if m.pauser != nil && !m.aborting {
+		if m.paused {
+			keys = append(keys, "p resume")
+		} else {
+			keys = append(keys, "p pause")
+		}
+	}
+
+	if m.aborter != nil && !m.aborting {
+		keys = append(keys, "x stop and save")
+	}
+
 	footer := footerStyle.Render("Press q to quit")
+	if len(keys) > 0 {
+		footer = footerStyle.Render(strings.Join(append(keys, "q quit"), " • "))
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		title,
@@ -304,7 +460,7 @@ func (m testExecutionModel) viewProgress() string {
 	)
 }
 
-func (m testExecutionModel) renderThreadBox(accentColor lipgloss.Color) string {
+func (m testExecutionModel) renderThreadBox(accentColor lipgloss.TerminalColor) string {
 	contentStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(accentColor).
@@ -313,7 +469,7 @@ func (m testExecutionModel) renderThreadBox(accentColor lipgloss.Color) string {
 		Width(m.width - 4) // Constrain width
 
 	fileStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("14"))
+		Foreground(activeTheme.file)
 
 	threadLines := make([]string, 0, m.threads)
 	// Calculate max specific width for file path:
@@ -351,7 +507,7 @@ func (m testExecutionModel) renderThreadBox(accentColor lipgloss.Color) string {
 
 			truncatedFile := truncateFile(file, remainingForFile)
 			lineContent = fmt.Sprintf("%s%s",
-				lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render(idStr), // Grey for ID
+				lipgloss.NewStyle().Foreground(activeTheme.muted).Render(idStr),
 				fileStyle.Render(truncatedFile),
 			)
 		}
@@ -376,16 +532,16 @@ func (m testExecutionModel) renderThreadBox(accentColor lipgloss.Color) string {
 }
 
 func (m testExecutionModel) viewResults() string {
-	accentColor := lipgloss.Color("6") // Cyan
+	accentColor := activeTheme.accent
 
 	// Styles
 	titleStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("205")).
+		Foreground(activeTheme.title).
 		Bold(true).
 		Padding(1, 0, 0, 2)
 
 	summaryStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("252")).
+		Foreground(activeTheme.text).
 		Padding(0, 0, 1, 2)
 
 	accentStyle := lipgloss.NewStyle().Foreground(accentColor)
@@ -401,36 +557,149 @@ func (m testExecutionModel) viewResults() string {
 		fmt.Sprintf("Errors: %s", accentStyle.Render(fmt.Sprintf("%d", m.countStatus("error")))),
 	}
 
+	if uncompiled := m.countStatus("uncompiled"); uncompiled > 0 {
+		summaryParts = append(summaryParts, fmt.Sprintf("Uncompiled: %s", accentStyle.Render(fmt.Sprintf("%d", uncompiled))))
+	}
+
 	if m.mutationScoreSet {
 		summaryParts = append(summaryParts, fmt.Sprintf("Score: %s", accentStyle.Render(fmt.Sprintf("%.2f%%", m.mutationScore*100))))
 	}
 
-	summary := summaryStyle.Render(strings.Join(summaryParts, "  •  "))
+	if total := m.totalDuration(); total > 0 {
+		summaryParts = append(summaryParts, fmt.Sprintf("Time: %s", accentStyle.Render(formatResultDuration(total))))
+	}
+
+	summaryText := strings.Join(summaryParts, "  •  ")
+	if banner := m.budgetBanner(); banner != "" {
+		summaryText = lipgloss.NewStyle().Foreground(activeTheme.emphasis).Bold(true).Render(banner) + "\n" + summaryText
+	}
+
+	if m.cached > 0 {
+		summaryText += "\n" + lipgloss.NewStyle().Foreground(activeTheme.muted).Render(cachedResultsNote(m.cached))
+	}
+
+	if status := m.watchStatus(); status != "" {
+		color := activeTheme.muted
+		if m.watchErr != nil {
+			color = activeTheme.removed
+		}
+
+		summaryText += "\n" + lipgloss.NewStyle().Foreground(color).Render(status)
+	}
+
+	if status := m.markStatus(); status != "" {
+		summaryText += "\n" + lipgloss.NewStyle().Foreground(activeTheme.removed).Render(status)
+	}
+
+	if status := m.editorStatus(); status != "" {
+		summaryText += "\n" + lipgloss.NewStyle().Foreground(activeTheme.removed).Render(status)
+	}
+
+	if status := m.copyStatus(); status != "" {
+		color := activeTheme.muted
+		if m.copied.err != nil {
+			color = activeTheme.removed
+		}
+
+		summaryText += "\n" + lipgloss.NewStyle().Foreground(color).Render(status)
+	}
+
+	summary := summaryStyle.Render(summaryText)
 
 	// 3. Results table with list
 	resultsBox := m.renderResultsBox(accentColor)
 
-	// 4. Footer
+	// 4. Slowest mutations
+	slowest := m.renderSlowest(accentStyle)
+
+	// 5. Footer
 	footerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("8")).
+		Foreground(activeTheme.muted).
 		Align(lipgloss.Center).
 		Width(m.width)
 
-	footer := footerStyle.Render("↑/k up • ↓/j down • g/G top/bottom • / filter • enter/space/click diff • q quit")
+	keys := "↑/k up • ↓/j down • g/G top/bottom • / filter • enter/space/click details • o open • y/Y copy diff/ID • t tree • q quit"
+	if m.equivalentMarker != nil {
+		keys = "↑/k up • ↓/j down • g/G top/bottom • / filter • enter/space/click details • o open • y/Y copy diff/ID • t tree • e mark equivalent • q quit"
+	}
 
-	return lipgloss.JoinVertical(lipgloss.Left,
-		title,
-		summary,
-		resultsBox,
-		footer,
-	)
+	footer := footerStyle.Render(keys)
+
+	sections := []string{title, summary, resultsBox}
+	if slowest != "" {
+		sections = append(sections, slowest)
+	}
+
+	sections = append(sections, footer)
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// budgetBanner warns that the score is partial when the time budget ran out
+// or the run was interrupted.
+func (m testExecutionModel) budgetBanner() string {
+	if m.budgetNotRun == 0 {
+		return ""
+	}
+
+	if m.interrupted {
+		return fmt.Sprintf("⚠ Interrupted: %d mutations not run, partial score", m.budgetNotRun)
+	}
+
+	return fmt.Sprintf("⚠ Time budget of %s exhausted: %d mutations not run, partial score", m.budget, m.budgetNotRun)
 }
 
-func (m testExecutionModel) renderResultsBox(accentColor lipgloss.Color) string {
+// watchStatus tells that results refresh as files change, and what the last
+// refresh was for or why it failed.
+func (m testExecutionModel) watchStatus() string {
+	switch {
+	case !m.watching:
+		return ""
+	case m.watchErr != nil:
+		firstLine, _, _ := strings.Cut(m.watchErr.Error(), "\n")
+		return "👀 Watching for changes • last run failed: " + firstLine
+	case len(m.watchChanged) > 0:
+		return "👀 Watching for changes • last run: " + strings.Join(m.watchChanged, ", ")
+	default:
+		return "👀 Watching for changes"
+	}
+}
+
+// markStatus tells why the last survivor could not be marked as equivalent.
+func (m testExecutionModel) markStatus() string {
+	if m.markErr == nil {
+		return ""
+	}
+
+	firstLine, _, _ := strings.Cut(m.markErr.Error(), "\n")
+
+	return "Could not mark equivalent: " + firstLine
+}
+
+func (m testExecutionModel) renderResultsBox(accentColor lipgloss.TerminalColor) string {
 	listWidth := m.width - 4
 	diffBoxHeight := m.diffBoxHeight()
 
-	listHeight := m.height - 9 - diffBoxHeight
+	listHeight := m.height - 9 - diffBoxHeight - m.slowestHeight()
+	if m.budgetBanner() != "" {
+		listHeight--
+	}
+
+	if m.watchStatus() != "" {
+		listHeight--
+	}
+
+	if m.markStatus() != "" {
+		listHeight--
+	}
+
+	if m.editorStatus() != "" {
+		listHeight--
+	}
+
+	if m.copyStatus() != "" {
+		listHeight--
+	}
 	if listHeight < 5 {
 		listHeight = 5
 	}
@@ -440,10 +709,10 @@ func (m testExecutionModel) renderResultsBox(accentColor lipgloss.Color) string
 
 	// Column Headers
 	headerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("8")).
+		Foreground(activeTheme.muted).
 		Bold(true).
 		Border(lipgloss.NormalBorder(), false, false, true, false).
-		BorderForeground(lipgloss.Color("8")).
+		BorderForeground(activeTheme.muted).
 		Width(listWidth)
 
 	headers := headerStyle.Render(fmt.Sprintf("%6s  %10s  %12s  %s", "ID", "Status", "Type", "File"))
@@ -469,6 +738,103 @@ func (m testExecutionModel) renderResultsBox(accentColor lipgloss.Color) string
 	return lipgloss.JoinVertical(lipgloss.Left, resultsBox, diffBox)
 }
 
+// slowestResults returns up to slowestResultsLimit results with a recorded
+// duration, slowest first.
+func (m testExecutionModel) slowestResults() []testResult {
+	return m.slowest
+}
+
+// slowestOf returns up to slowestResultsLimit of results with a recorded
+// duration, slowest first.
+func slowestOf(results []testResult) []testResult {
+	var slowest []testResult
+
+	for _, result := range results {
+		slowest = withSlowest(slowest, result)
+	}
+
+	return slowest
+}
+
+// withSlowest returns slowest with result added in its place if it is among
+// the slowestResultsLimit slowest. Results as slow as one already listed go
+// after it. slowest is not changed.
+func withSlowest(slowest []testResult, result testResult) []testResult {
+	if result.duration <= 0 {
+		return slowest
+	}
+
+	i := sort.Search(len(slowest), func(i int) bool {
+		return slowest[i].duration < result.duration
+	})
+	if i >= slowestResultsLimit {
+		return slowest
+	}
+
+	updated := make([]testResult, 0, min(len(slowest)+1, slowestResultsLimit))
+	updated = append(updated, slowest[:i]...)
+	updated = append(updated, result)
+	updated = append(updated, slowest[i:min(len(slowest), slowestResultsLimit-1)]...)
+
+	return updated
+}
+
+func (m testExecutionModel) slowestHeight() int {
+	slowest := len(m.slowestResults())
+	if slowest == 0 {
+		return 0
+	}
+
+	// Title line plus one line per entry.
+	return slowest + 1
+}
+
+func (m testExecutionModel) renderSlowest(accentStyle lipgloss.Style) string {
+	slowest := m.slowestResults()
+	if len(slowest) == 0 {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Foreground(activeTheme.muted).
+		Bold(true)
+
+	lines := []string{titleStyle.Render("Slowest mutations")}
+
+	fileWidth := m.width - 36
+	for _, result := range slowest {
+		lines = append(lines, fmt.Sprintf("%s  %-8s  %-10s  %s",
+			accentStyle.Render(fmt.Sprintf("%8s", formatResultDuration(result.duration))),
+			result.status,
+			result.typ,
+			truncateFile(result.file, fileWidth),
+		))
+	}
+
+	return lipgloss.NewStyle().
+		Padding(0, 0, 0, 2).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (m testExecutionModel) totalDuration() time.Duration {
+	var total time.Duration
+
+	for _, result := range m.results {
+		total += result.duration
+	}
+
+	return total
+}
+
+// formatResultDuration rounds durations so short tests stay readable.
+func formatResultDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+
+	return d.Round(10 * time.Millisecond).String()
+}
+
 func (m testExecutionModel) countStatus(status string) int {
 	count := 0
 
@@ -576,22 +942,35 @@ func (m testExecutionModel) handleCompletedMutation(msg completedMutationMsg) te
 	m.completedCount++
 	m.currentStatus = msg.status
 	result := testResult{
-		id:     msg.id[:4],
-		file:   msg.displayPath,
-		typ:    fmt.Sprintf("%v", msg.kind),
-		status: msg.status,
-		diff:   string(msg.diff),
+		id:         msg.id[:4],
+		mutationID: msg.id,
+		file:       msg.displayPath,
+		typ:        fmt.Sprintf("%v", msg.kind),
+		version:    msg.version,
+		status:     msg.status,
+		diff:       string(msg.diff),
+		hasDiff:    msg.hasDiff,
+		duration:   msg.duration,
+		output:     msg.output,
+		hasOutput:  msg.hasOutput,
+		hint:       msg.hint,
+		path:       msg.path,
+		line:       msg.line,
+		cycle:      m.cycle,
 	}
-	m.results = append(m.results, result)
 
-	// Update results list with new items
-	items := make([]list.Item, 0, len(m.results))
+	if (!m.refreshed[result.file] && m.dropStaleResults(result.file)) || m.grouped {
+		m.results = append(m.results, result)
+		m.resultsList.SetItems(m.resultListItems())
+	} else {
+		m.results = append(m.results, result)
 
-	for _, r := range m.results {
-		items = append(items, r)
+		// Appending keeps large runs linear; rebuilding the items on every
+		// completion is quadratic.
+		m.resultsList.InsertItem(len(m.resultsList.Items()), result)
 	}
 
-	m.resultsList.SetItems(items)
+	m.slowest = withSlowest(m.slowest, result)
 
 	if m.totalMutations > 0 {
 		m.progressPercent = float64(m.completedCount) / float64(m.totalMutations)
@@ -608,16 +987,81 @@ func (m testExecutionModel) handleCompletedMutation(msg completedMutationMsg) te
 	return m
 }
 
+// dropStaleResults removes the results earlier runs recorded for file, now
+// that it is tested again, and reports whether there were any.
+func (m *testExecutionModel) dropStaleResults(file string) bool {
+	if m.refreshed != nil {
+		m.refreshed[file] = true
+	}
+
+	kept := make([]testResult, 0, len(m.results))
+
+	for _, result := range m.results {
+		if result.file != file || result.cycle == m.cycle {
+			kept = append(kept, result)
+		}
+	}
+
+	if len(kept) == len(m.results) {
+		return false
+	}
+
+	m.results = kept
+	m.slowest = slowestOf(kept)
+
+	return true
+}
+
+func resultItems(results []testResult) []list.Item {
+	items := make([]list.Item, 0, len(results))
+	for _, result := range results {
+		items = append(items, result)
+	}
+
+	return items
+}
+
 func (m testExecutionModel) handleKeyMsg(msg tea.KeyMsg) (testExecutionModel, tea.Cmd) {
 	var cmd tea.Cmd
 
+	if m.viewingDiff && msg.String() != "ctrl+c" {
+		var closed bool
+
+		m.viewer, closed, cmd = m.viewer.Update(msg)
+		if closed {
+			m.hideDiff()
+		}
+
+		return m, cmd
+	}
+
 	switch msg.String() {
 	case "q", "ctrl+c":
 		return m, tea.Quit
 	default:
 		if m.testingFinished {
 			if msg.String() == "enter" || msg.String() == " " {
-				m.toggleSelectedDiff()
+				return m, m.toggleSelectedDiff()
+			}
+
+			if msg.String() == "e" && m.resultsList.FilterState() != list.Filtering {
+				return m, m.markSelectedEquivalent()
+			}
+
+			if msg.String() == "o" && m.resultsList.FilterState() != list.Filtering {
+				return m, m.openSelectedInEditor()
+			}
+
+			if msg.String() == "y" && m.resultsList.FilterState() != list.Filtering {
+				return m, m.copySelectedDiff()
+			}
+
+			if msg.String() == "Y" && m.resultsList.FilterState() != list.Filtering {
+				return m, m.copySelectedID()
+			}
+
+			if msg.String() == "t" && m.resultsList.FilterState() != list.Filtering {
+				m.toggleGrouped()
 				return m, nil
 			}
 
@@ -632,18 +1076,54 @@ func (m testExecutionModel) handleKeyMsg(msg tea.KeyMsg) (testExecutionModel, te
 				m.animOffset = 0
 				m.delegate.offset = 0
 				m.resultsList.SetDelegate(m.delegate)
-				m.showDiff = false
-				m.selectedDiff = ""
-				m.selectedDiffPath = ""
+				m.hideDiff()
 			}
 
 			return m, cmd
 		}
+
+		switch msg.String() {
+		case "p":
+			return m.togglePause()
+		case "x":
+			return m.abortRun()
+		}
 	}
 
 	return m, nil
 }
 
+// abortRun stops the run early through the returned command; the results
+// view follows once the running mutations finish.
+func (m testExecutionModel) abortRun() (testExecutionModel, tea.Cmd) {
+	if m.aborter == nil || m.aborting {
+		return m, nil
+	}
+
+	m.aborting = true
+	aborter := m.aborter
+
+	return m, func() tea.Msg {
+		aborter()
+		return nil
+	}
+}
+
+// togglePause pauses the run, or resumes it, through the returned command.
+func (m testExecutionModel) togglePause() (testExecutionModel, tea.Cmd) {
+	if m.pauser == nil {
+		return m, nil
+	}
+
+	m.paused = !m.paused
+	pauser, paused := m.pauser, m.paused
+
+	return m, func() tea.Msg {
+		pauser(paused)
+		return nil
+	}
+}
+
 func (m testExecutionModel) handleMouseMsg(msg tea.MouseMsg) (testExecutionModel, tea.Cmd) {
 	var cmd tea.Cmd
 
@@ -651,6 +1131,11 @@ func (m testExecutionModel) handleMouseMsg(msg tea.MouseMsg) (testExecutionModel
 		return m, nil
 	}
 
+	if m.viewingDiff {
+		m.viewer.viewport, cmd = m.viewer.viewport.Update(msg)
+		return m, cmd
+	}
+
 	var newList list.Model
 
 	newList, cmd = m.resultsList.Update(msg)
@@ -661,45 +1146,172 @@ func (m testExecutionModel) handleMouseMsg(msg tea.MouseMsg) (testExecutionModel
 		m.animOffset = 0
 		m.delegate.offset = 0
 		m.resultsList.SetDelegate(m.delegate)
-		m.showDiff = false
-		m.selectedDiff = ""
-		m.selectedDiffPath = ""
+		m.hideDiff()
 	}
 
 	if msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionRelease && m.resultsList.FilterState() != list.Filtering {
-		m.toggleSelectedDiff()
+		cmd = tea.Batch(cmd, m.toggleSelectedDiff())
 	}
 
 	return m, cmd
 }
 
-func (m *testExecutionModel) toggleSelectedDiff() {
+// toggleSelectedDiff shows the selected result's diff, or its test output
+// when it has no diff, then opens it full screen, and folds a selected file
+// row. Details left to the loader are fetched by the returned command.
+func (m *testExecutionModel) toggleSelectedDiff() tea.Cmd {
+	if m.toggleSelectedGroup() {
+		return nil
+	}
+
 	item := m.resultsList.SelectedItem()
 
 	result, ok := item.(testResult)
 	if !ok {
-		return
+		return nil
 	}
 
-	diff := strings.TrimSpace(result.diff)
-	if diff == "" {
-		m.showDiff = false
-		m.selectedDiff = ""
+	if m.showDiff && m.selectedDiffID == result.mutationID {
+		m.viewingDiff = true
+		m.viewer = newDiffViewer(m.detailTitle(), m.detailText(), m.selectedOutput, m.width, m.height)
 
-		return
+		return nil
 	}
 
-	if m.showDiff && m.selectedDiff == diff {
-		m.showDiff = false
-		m.selectedDiff = ""
-		m.selectedDiffPath = ""
+	if (result.hasDiff || result.hasOutput) && m.detailsLoader != nil {
+		m.showDiff = true
+		m.selectedDiffPath = result.file
+		m.selectedDiffID = result.mutationID
+		m.selectedOutput = !result.hasDiff
+		m.selectedHint = result.hint
 
-		return
+		if m.selectedOutput {
+			m.selectedDiff = "Loading test output…"
+			return loadDetails(m.detailsLoader, result.mutationID)
+		}
+
+		m.selectedDiff = "Loading diff…"
+		m.selectedOperator = operatorLabel(result.typ, result.version)
+
+		return tea.Batch(loadDetails(m.detailsLoader, result.mutationID), m.loadSelectedSource(result))
+	}
+
+	diff := strings.TrimSpace(result.diff)
+	output := diff == ""
+
+	if output {
+		diff = strings.TrimSpace(result.output)
+	}
+
+	if diff == "" {
+		m.hideDiff()
+		return nil
 	}
 
 	m.showDiff = true
 	m.selectedDiff = diff
 	m.selectedDiffPath = result.file
+	m.selectedDiffID = result.mutationID
+	m.selectedOutput = output
+	m.selectedHint = result.hint
+
+	if output {
+		return nil
+	}
+
+	m.selectedOperator = operatorLabel(result.typ, result.version)
+
+	return m.loadSelectedSource(result)
+}
+
+// loadSelectedSource reads the selected survivor's file through the
+// returned command, or returns nil when there is no loader or location.
+func (m testExecutionModel) loadSelectedSource(result testResult) tea.Cmd {
+	if m.sourceLoader == nil || result.path == "" || result.line == 0 {
+		return nil
+	}
+
+	return loadSource(m.sourceLoader, result.mutationID, result.path)
+}
+
+func (m *testExecutionModel) hideDiff() {
+	m.showDiff = false
+	m.selectedDiff = ""
+	m.selectedDiffPath = ""
+	m.selectedDiffID = ""
+	m.selectedOperator = ""
+	m.selectedHint = ""
+	m.selectedSource = ""
+	m.selectedOutput = false
+	m.viewingDiff = false
+}
+
+// markSelectedEquivalent marks the selected survivor as equivalent through
+// the returned command.
+func (m testExecutionModel) markSelectedEquivalent() tea.Cmd {
+	result, ok := m.resultsList.SelectedItem().(testResult)
+	if !ok || result.status != "survived" || m.equivalentMarker == nil {
+		return nil
+	}
+
+	marker := m.equivalentMarker
+
+	return func() tea.Msg {
+		return equivalentMarkedMsg{mutationID: result.mutationID, err: marker(result.mutationID)}
+	}
+}
+
+// handleEquivalentMarked shows a marked survivor as equivalent, or why it
+// could not be marked.
+func (m testExecutionModel) handleEquivalentMarked(msg equivalentMarkedMsg) testExecutionModel {
+	m.markErr = msg.err
+	if msg.err != nil {
+		return m
+	}
+
+	for i := range m.results {
+		if m.results[i].mutationID == msg.mutationID {
+			m.results[i].status = "equivalent"
+		}
+	}
+
+	m.slowest = slowestOf(m.results)
+
+	m.resultsList.SetItems(m.resultListItems())
+
+	return m
+}
+
+func loadDetails(loader DetailsLoader, mutationID string) tea.Cmd {
+	return func() tea.Msg {
+		details, err := loader(mutationID)
+		return detailsLoadedMsg{mutationID: mutationID, details: details, err: err}
+	}
+}
+
+// handleDetailsLoaded shows a loaded diff, or test output, if its result is
+// still selected.
+func (m testExecutionModel) handleDetailsLoaded(msg detailsLoadedMsg) testExecutionModel {
+	if !m.showDiff || m.selectedDiffID != msg.mutationID {
+		return m
+	}
+
+	what, text := "diff", string(msg.details.Diff)
+	if m.selectedOutput {
+		what, text = "test output", msg.details.Output
+	}
+
+	if msg.err != nil {
+		m.selectedDiff = fmt.Sprintf("%s unavailable: %v", what, msg.err)
+	} else {
+		m.selectedDiff = strings.TrimSpace(text)
+	}
+
+	if m.viewingDiff {
+		m.viewer.setText(m.detailText())
+	}
+
+	return m
 }
 
 func (m testExecutionModel) diffMaxLines() int {
@@ -720,7 +1332,7 @@ func (m testExecutionModel) diffBoxHeight() int {
 		return 0
 	}
 
-	diff := strings.TrimSpace(m.selectedDiff)
+	diff := strings.TrimSpace(m.detailText())
 	if diff == "" {
 		return 0
 	}
@@ -735,12 +1347,12 @@ func (m testExecutionModel) diffBoxHeight() int {
 	return len(lines) + 3
 }
 
-func (m testExecutionModel) renderDiffBox(accentColor lipgloss.Color, width int) (string, int) {
+func (m testExecutionModel) renderDiffBox(accentColor lipgloss.TerminalColor, width int) (string, int) {
 	if !m.showDiff {
 		return "", 0
 	}
 
-	diff := strings.TrimSpace(m.selectedDiff)
+	diff := strings.TrimSpace(m.detailText())
 	if diff == "" {
 		return "", 0
 	}
@@ -761,6 +1373,11 @@ func (m testExecutionModel) renderDiffBox(accentColor lipgloss.Color, width int)
 
 	bodyLines := make([]string, 0, len(lines)+1)
 	for _, line := range lines {
+		if m.selectedOutput {
+			bodyLines = append(bodyLines, renderOutputLine(line, contentWidth))
+			continue
+		}
+
 		bodyLines = append(bodyLines, renderDiffLine(line, contentWidth))
 	}
 
@@ -769,15 +1386,10 @@ func (m testExecutionModel) renderDiffBox(accentColor lipgloss.Color, width int)
 	}
 
 	headerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("8")).
+		Foreground(activeTheme.muted).
 		Bold(true)
 
-	headerText := "Diff"
-	if m.selectedDiffPath != "" {
-		headerText = fmt.Sprintf("Diff • %s", m.selectedDiffPath)
-	}
-
-	header := headerStyle.Render(truncateFile(headerText, contentWidth))
+	header := headerStyle.Render(truncateFile(m.detailTitle()+" • enter full screen", contentWidth))
 
 	body := lipgloss.JoinVertical(lipgloss.Left, bodyLines...)
 	boxStyle := lipgloss.NewStyle().
@@ -792,27 +1404,60 @@ func (m testExecutionModel) renderDiffBox(accentColor lipgloss.Color, width int)
 	return box, lipgloss.Height(box)
 }
 
-func renderDiffLine(line string, width int) string {
-	trimmed := strings.TrimSpace(line)
+// detailTitle names what the detail pane shows and for which file.
+func (m testExecutionModel) detailTitle() string {
+	title := "Diff"
+	if m.selectedOutput {
+		title = "Test output"
+	}
 
-	style := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+	if m.selectedDiffPath != "" {
+		title = fmt.Sprintf("%s • %s", title, m.selectedDiffPath)
+	}
+
+	if m.selectedOperator != "" {
+		title = fmt.Sprintf("%s • %s", title, m.selectedOperator)
+	}
+
+	return title
+}
+
+func renderDiffLine(line string, width int) string {
+	return diffLineStyle(line).Render(truncateFile(line, width))
+}
 
+func diffLineStyle(line string) lipgloss.Style {
 	switch {
 	case strings.HasPrefix(line, "+++"):
-		style = lipgloss.NewStyle().Foreground(lipgloss.Color("10")).Bold(true)
+		return lipgloss.NewStyle().Foreground(activeTheme.added).Bold(true)
 	case strings.HasPrefix(line, "---"):
-		style = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true)
+		return lipgloss.NewStyle().Foreground(activeTheme.removed).Bold(true)
 	case strings.HasPrefix(line, "@@"):
-		style = lipgloss.NewStyle().Foreground(lipgloss.Color("6")).Bold(true)
+		return lipgloss.NewStyle().Foreground(activeTheme.accent).Bold(true)
 	case strings.HasPrefix(line, "+"):
-		style = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+		return lipgloss.NewStyle().Foreground(activeTheme.added)
 	case strings.HasPrefix(line, "-"):
-		style = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
-	case trimmed == "":
-		style = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+		return lipgloss.NewStyle().Foreground(activeTheme.removed)
+	case strings.TrimSpace(line) == "":
+		return lipgloss.NewStyle().Foreground(activeTheme.muted)
+	default:
+		return lipgloss.NewStyle().Foreground(activeTheme.text)
+	}
+}
+
+// renderOutputLine renders a line of go test output, with the compiler's
+// and the failing tests' reports in red.
+func renderOutputLine(line string, width int) string {
+	return outputLineStyle(line).Render(truncateFile(line, width))
+}
+
+func outputLineStyle(line string) lipgloss.Style {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "--- FAIL") || strings.HasPrefix(trimmed, "FAIL") || strings.Contains(line, ".go:") {
+		return lipgloss.NewStyle().Foreground(activeTheme.removed)
 	}
 
-	return style.Render(truncateFile(line, width))
+	return lipgloss.NewStyle().Foreground(activeTheme.text)
 }
 
 func (m testExecutionModel) handleWindowSize(msg tea.WindowSizeMsg) testExecutionModel {
@@ -824,6 +1469,8 @@ func (m testExecutionModel) handleWindowSize(msg tea.WindowSizeMsg) testExecutio
 		m.progressBar.Width = 20
 	}
 
+	m.viewer.setSize(m.width, m.height)
+
 	return m
 }
 
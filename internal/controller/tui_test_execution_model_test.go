@@ -1,12 +1,14 @@
 package controller
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestTestResult_FilterValue(t *testing.T) {
@@ -122,8 +124,13 @@ func TestTestExecutionModel_ToggleDiff(t *testing.T) {
 	}
 
 	updated, _ = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
-	if updated.showDiff || updated.selectedDiff != "" {
-		t.Fatalf("expected diff to be hidden on second enter")
+	if !updated.viewingDiff {
+		t.Fatalf("expected diff to open full screen on second enter")
+	}
+
+	updated, _ = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if updated.viewingDiff || updated.showDiff || updated.selectedDiff != "" {
+		t.Fatalf("expected diff to be hidden when leaving the full screen view")
 	}
 
 	mouse := tea.MouseMsg(tea.MouseEvent{Button: tea.MouseButtonLeft, Action: tea.MouseActionRelease})
@@ -145,6 +152,245 @@ func TestTestExecutionModel_ToggleDiff(t *testing.T) {
 	}
 }
 
+func TestTestExecutionModel_ShowsTestOutputOfBrokenMutants(t *testing.T) {
+	m := newTestExecutionModel()
+	m.width, m.height = 100, 40
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	m = m.handleCompletedMutation(completedMutationMsg{
+		id:          "hash1234",
+		kind:        "blank",
+		displayPath: "a.go",
+		status:      "killed",
+		output:      "# example/a\n./a.go:4:2: declared and not used: x\nFAIL\texample/a [build failed]\n",
+	})
+	m.resultsList.Select(0)
+
+	updated, _ := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	if !updated.showDiff || !updated.selectedOutput {
+		t.Fatalf("expected test output to be shown on enter")
+	}
+
+	box, _ := updated.renderDiffBox(lipgloss.Color("6"), 96)
+	if !strings.Contains(box, "Test output • a.go") || !strings.Contains(box, "declared and not used: x") {
+		t.Fatalf("detail pane = %q", box)
+	}
+
+	updated, _ = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	if !updated.viewingDiff || !updated.viewer.output {
+		t.Fatalf("expected test output to open full screen on second enter")
+	}
+
+	updated, _ = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	if updated.showDiff || updated.selectedOutput {
+		t.Fatalf("expected test output to be hidden when leaving the full screen view")
+	}
+}
+
+func TestTestExecutionModel_LazyDiff(t *testing.T) {
+	var loaded []string
+
+	m := newTestExecutionModel()
+	m.detailsLoader = func(mutationID string) (ResultDetails, error) {
+		loaded = append(loaded, mutationID)
+		if mutationID == "gone1234" {
+			return ResultDetails{}, fmt.Errorf("no diff recorded")
+		}
+
+		return ResultDetails{Diff: []byte("--- a\n+++ b\n")}, nil
+	}
+	m = m.handleUpcoming(upcomingMsg{count: 2})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "bool", displayPath: "a.go", status: "survived", hasDiff: true})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "gone1234", kind: "bool", displayPath: "b.go", status: "survived", hasDiff: true})
+	m.resultsList.Select(0)
+
+	updated, cmd := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	if !updated.showDiff || cmd == nil || len(loaded) != 0 {
+		t.Fatalf("expected a pending load on enter, showDiff=%v cmd=%v loaded=%v", updated.showDiff, cmd != nil, loaded)
+	}
+
+	model, _ := updated.Update(cmd())
+	updated = model.(testExecutionModel)
+
+	if updated.selectedDiff != "--- a\n+++ b" || updated.selectedDiffPath != "a.go" {
+		t.Fatalf("expected loaded diff, got %q for %q", updated.selectedDiff, updated.selectedDiffPath)
+	}
+
+	// A load finishing after the selection moved on is dropped.
+	updated = updated.handleDetailsLoaded(detailsLoadedMsg{mutationID: "other", details: ResultDetails{Diff: []byte("stale")}})
+	if updated.selectedDiff != "--- a\n+++ b" {
+		t.Fatalf("expected stale diff to be ignored, got %q", updated.selectedDiff)
+	}
+
+	updated.resultsList.Select(1)
+	updated.hideDiff()
+
+	updated, cmd = updated.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	updated = updated.handleDetailsLoaded(cmd().(detailsLoadedMsg))
+
+	if !strings.Contains(updated.selectedDiff, "diff unavailable: no diff recorded") {
+		t.Fatalf("expected load error to be shown, got %q", updated.selectedDiff)
+	}
+
+	for _, result := range updated.results {
+		if result.diff != "" {
+			t.Fatalf("expected results to keep no diffs, got %q", result.diff)
+		}
+	}
+}
+
+func TestTestExecutionModel_LazyOutput(t *testing.T) {
+	m := newTestExecutionModel()
+	m.detailsLoader = func(mutationID string) (ResultDetails, error) {
+		return ResultDetails{Output: "./calc.go:3:1: undefined: x\n"}, nil
+	}
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "bool", displayPath: "calc.go", status: "error", hasOutput: true})
+	m.resultsList.Select(0)
+
+	updated, cmd := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	if !updated.showDiff || !updated.selectedOutput || updated.selectedDiff != "Loading test output…" || cmd == nil {
+		t.Fatalf("expected a pending test output load, got %q", updated.selectedDiff)
+	}
+
+	updated = updated.handleDetailsLoaded(cmd().(detailsLoadedMsg))
+	if updated.selectedDiff != "./calc.go:3:1: undefined: x" {
+		t.Fatalf("expected the loaded test output, got %q", updated.selectedDiff)
+	}
+
+	if updated.results[0].output != "" {
+		t.Fatalf("expected results to keep no test output, got %q", updated.results[0].output)
+	}
+}
+
+func TestTestExecutionModel_MarkEquivalent(t *testing.T) {
+	var marked []string
+
+	m := newTestExecutionModel()
+	m.equivalentMarker = func(mutationID string) error {
+		marked = append(marked, mutationID)
+		if mutationID == "fail1234" {
+			return fmt.Errorf("no mutation fail1234 in the reports")
+		}
+
+		return nil
+	}
+	m = m.handleUpcoming(upcomingMsg{count: 3})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "bool", displayPath: "a.go", status: "survived"})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "kill1234", kind: "bool", displayPath: "a.go", status: "killed"})
+	m = m.handleCompletedMutation(completedMutationMsg{id: "fail1234", kind: "bool", displayPath: "b.go", status: "survived"})
+
+	eKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")}
+
+	m.resultsList.Select(1)
+	if _, cmd := m.handleKeyMsg(eKey); cmd != nil {
+		t.Fatalf("expected killed mutations not to be marked")
+	}
+
+	m.resultsList.Select(0)
+	updated, cmd := m.handleKeyMsg(eKey)
+	if cmd == nil {
+		t.Fatalf("expected a command marking the survivor")
+	}
+
+	model, _ := updated.Update(cmd())
+	updated = model.(testExecutionModel)
+
+	if updated.results[0].status != "equivalent" || updated.markStatus() != "" {
+		t.Fatalf("expected the survivor shown as equivalent, got %q (%q)", updated.results[0].status, updated.markStatus())
+	}
+
+	updated.resultsList.Select(2)
+	updated, cmd = updated.handleKeyMsg(eKey)
+	updated = updated.handleEquivalentMarked(cmd().(equivalentMarkedMsg))
+
+	if updated.results[2].status != "survived" || !strings.Contains(updated.markStatus(), "no mutation fail1234") {
+		t.Fatalf("expected the failure to be shown, got %q (%q)", updated.results[2].status, updated.markStatus())
+	}
+
+	if strings.Join(marked, ",") != "hash1234,fail1234" {
+		t.Fatalf("unexpected marked mutations: %v", marked)
+	}
+
+	if !strings.Contains(updated.View(), "e mark equivalent") {
+		t.Fatalf("expected the footer to offer marking")
+	}
+}
+
+func TestTestExecutionModel_PauseAndResume(t *testing.T) {
+	var calls []bool
+
+	m := newTestExecutionModel()
+	m.width, m.height = 100, 30
+	m.rendered = true
+	m.pauser = func(paused bool) { calls = append(calls, paused) }
+	m = m.handleUpcoming(upcomingMsg{count: 2})
+
+	pKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")}
+
+	updated, cmd := m.handleKeyMsg(pKey)
+	cmd()
+
+	if !updated.paused || !strings.Contains(updated.View(), "⏸ Paused") || !strings.Contains(updated.View(), "p resume") {
+		t.Fatalf("expected the run to be shown paused")
+	}
+
+	updated, cmd = updated.handleKeyMsg(pKey)
+	cmd()
+
+	if updated.paused || strings.Contains(updated.View(), "⏸ Paused") {
+		t.Fatalf("expected the run to be shown resumed")
+	}
+
+	if len(calls) != 2 || !calls[0] || calls[1] {
+		t.Fatalf("unexpected pauser calls: %v", calls)
+	}
+
+	m.pauser = nil
+	if updated, cmd = m.handleKeyMsg(pKey); updated.paused || cmd != nil {
+		t.Fatalf("expected p to do nothing without a pauser")
+	}
+}
+
+func TestTestExecutionModel_AbortAndSave(t *testing.T) {
+	aborted := 0
+
+	m := newTestExecutionModel()
+	m.width, m.height = 100, 30
+	m.rendered = true
+	m.aborter = func() { aborted++ }
+	m = m.handleUpcoming(upcomingMsg{count: 2})
+
+	if !strings.Contains(m.View(), "x stop and save") {
+		t.Fatalf("expected the footer to offer stopping the run")
+	}
+
+	xKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}
+
+	updated, cmd := m.handleKeyMsg(xKey)
+	cmd()
+
+	if !updated.aborting || !strings.Contains(updated.View(), "⏹ Stopping") || strings.Contains(updated.View(), "x stop and save") {
+		t.Fatalf("expected the run to be shown stopping")
+	}
+
+	if updated, cmd = updated.handleKeyMsg(xKey); cmd != nil || aborted != 1 {
+		t.Fatalf("expected a single abort, got %d", aborted)
+	}
+
+	// The running mutation finishes and the other is reported as not run,
+	// which leads to the results view rather than quitting.
+	updated = updated.handleCompletedMutation(completedMutationMsg{id: "hash1234", kind: "bool", displayPath: "a.go", status: "killed"})
+	updated = updated.handleCompletedMutation(completedMutationMsg{id: "hash5678", kind: "bool", displayPath: "a.go", status: "not_run"})
+
+	if !updated.testingFinished || len(updated.results) != 2 {
+		t.Fatalf("expected the results view after stopping, got finished=%v results=%d", updated.testingFinished, len(updated.results))
+	}
+
+	if updated = updated.handleUpcoming(upcomingMsg{count: 1}); updated.aborting {
+		t.Fatalf("expected the next run to start afresh")
+	}
+}
+
 func TestTestExecutionModel_WindowSizeAndViews(t *testing.T) {
 	m := newTestExecutionModel()
 	m = m.handleWindowSize(tea.WindowSizeMsg{Width: 10, Height: 5})
@@ -178,7 +424,7 @@ func TestTestExecutionModel_WindowSizeAndViews(t *testing.T) {
 		t.Fatalf("viewResults missing title")
 	}
 
-	box := m.renderResultsBox("6")
+	box := m.renderResultsBox(lipgloss.Color("6"))
 	if !strings.Contains(box, "ID") {
 		t.Fatalf("renderResultsBox missing headers")
 	}
@@ -191,7 +437,7 @@ func TestTestExecutionModel_WindowSizeAndViews(t *testing.T) {
 	m.threads = 2
 	m.threadFiles = map[int]string{0: "", 1: "path/to/long/file.go"}
 	m.threadMutationIDs = map[int]string{1: "abcd5678"}
-	threadBox := m.renderThreadBox("6")
+	threadBox := m.renderThreadBox(lipgloss.Color("6"))
 	if !strings.Contains(threadBox, "Thread") {
 		t.Fatalf("renderThreadBox missing thread label")
 	}
@@ -200,12 +446,203 @@ func TestTestExecutionModel_WindowSizeAndViews(t *testing.T) {
 	m.threads = 1
 	m.threadFiles = map[int]string{0: "file.go"}
 	m.threadMutationIDs = map[int]string{0: ""}
-	threadBox = m.renderThreadBox("6")
+	threadBox = m.renderThreadBox(lipgloss.Color("6"))
 	if strings.Contains(threadBox, "Thread") {
 		t.Fatalf("single thread mode should not have Thread label")
 	}
 }
 
+func TestTestExecutionModel_SlowestMutations(t *testing.T) {
+	m := newTestExecutionModel()
+	m = m.handleWindowSize(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m.rendered = true
+	m.testingFinished = true
+
+	if got := m.renderSlowest(lipgloss.NewStyle()); got != "" {
+		t.Fatalf("renderSlowest with no results = %q, want empty", got)
+	}
+
+	durations := []time.Duration{
+		200 * time.Millisecond,
+		3 * time.Second,
+		0,
+		1200 * time.Millisecond,
+		50 * time.Millisecond,
+		700 * time.Millisecond,
+		900 * time.Millisecond,
+	}
+	for i, duration := range durations {
+		m = m.handleCompletedMutation(completedMutationMsg{
+			id:          fmt.Sprintf("id%02d", i),
+			kind:        "arith",
+			displayPath: fmt.Sprintf("path/%d.go", i),
+			status:      "killed",
+			duration:    duration,
+		})
+	}
+
+	slowest := m.slowestResults()
+	if len(slowest) != slowestResultsLimit {
+		t.Fatalf("slowestResults length = %d, want %d", len(slowest), slowestResultsLimit)
+	}
+	if slowest[0].file != "path/1.go" || slowest[1].file != "path/3.go" || slowest[4].file != "path/0.go" {
+		t.Fatalf("slowestResults order = %+v", slowest)
+	}
+	if got := m.slowestHeight(); got != slowestResultsLimit+1 {
+		t.Fatalf("slowestHeight = %d, want %d", got, slowestResultsLimit+1)
+	}
+
+	view := m.viewResults()
+	if !strings.Contains(view, "Slowest mutations") || !strings.Contains(view, "3s") || !strings.Contains(view, "path/1.go") {
+		t.Fatalf("viewResults missing slowest section:\n%s", view)
+	}
+	if !strings.Contains(view, "Time:") || !strings.Contains(view, "6.05s") {
+		t.Fatalf("viewResults missing total time:\n%s", view)
+	}
+}
+
+func TestWithSlowest(t *testing.T) {
+	var slowest []testResult
+
+	for i, duration := range []time.Duration{2, 5, 0, 2, 9, 1, 7, 3} {
+		slowest = withSlowest(slowest, testResult{id: fmt.Sprint(i), duration: duration})
+	}
+
+	ids := make([]string, 0, len(slowest))
+	for _, result := range slowest {
+		ids = append(ids, result.id)
+	}
+
+	// Ties keep their arrival order; the fastest fall off the list.
+	if got := strings.Join(ids, ","); got != "4,6,1,7,0" {
+		t.Fatalf("withSlowest kept %s", got)
+	}
+
+	before := append([]testResult(nil), slowest...)
+	_ = withSlowest(slowest, testResult{id: "new", duration: 8})
+
+	for i := range before {
+		if slowest[i].id != before[i].id {
+			t.Fatalf("withSlowest changed its argument")
+		}
+	}
+}
+
+func TestTestExecutionModel_BudgetBanner(t *testing.T) {
+	m := newTestExecutionModel()
+	m = m.handleWindowSize(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m.rendered = true
+	m.testingFinished = true
+
+	if got := m.budgetBanner(); got != "" {
+		t.Fatalf("budgetBanner before any budget message = %q, want empty", got)
+	}
+
+	updated, _ := m.Update(budgetExhaustedMsg{budget: 30 * time.Minute, notRun: 12})
+	m = updated.(testExecutionModel)
+
+	view := m.viewResults()
+	if !strings.Contains(view, "Time budget of 30m0s exhausted: 12 mutations not run, partial score") {
+		t.Fatalf("viewResults missing budget banner:\n%s", view)
+	}
+
+	updated, _ = m.Update(interruptedMsg{notRun: 5})
+	m = updated.(testExecutionModel)
+
+	if got := m.budgetBanner(); got != "⚠ Interrupted: 5 mutations not run, partial score" {
+		t.Fatalf("budgetBanner after interruption = %q", got)
+	}
+}
+
+func TestTestExecutionModel_CachedResultsNote(t *testing.T) {
+	m := newTestExecutionModel()
+	m = m.handleWindowSize(tea.WindowSizeMsg{Width: 200, Height: 40})
+	m = m.handleUpcoming(upcomingMsg{count: 0})
+
+	updated, _ := m.Update(cachedResultsMsg{cached: 3})
+	m = updated.(testExecutionModel)
+
+	if view := m.viewResults(); !strings.Contains(view, "Cached: 3 mutations not tested again; they keep the status of the run that tested them") {
+		t.Fatalf("viewResults missing cached note:\n%s", view)
+	}
+
+	// The next watch cycle counts its own cached results.
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	if view := m.viewResults(); strings.Contains(view, "Cached:") {
+		t.Fatalf("cached note kept across cycles:\n%s", view)
+	}
+}
+
+func TestTestExecutionModel_WatchCycleReplacesRetestedFiles(t *testing.T) {
+	m := newTestExecutionModel()
+	m = m.handleWindowSize(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	complete := func(id, file, status string) {
+		updated, _ := m.Update(completedMutationMsg{id: id, displayPath: file, status: status})
+		m = updated.(testExecutionModel)
+	}
+
+	m = m.handleUpcoming(upcomingMsg{count: 3})
+	complete("aaaa0001", "a.go", "survived")
+	complete("aaaa0002", "a.go", "killed")
+	complete("bbbb0001", "b.go", "killed")
+
+	if !m.testingFinished || len(m.results) != 3 {
+		t.Fatalf("after first cycle: finished=%v results=%d", m.testingFinished, len(m.results))
+	}
+
+	m = m.handleUpcoming(upcomingMsg{count: 1})
+	if m.testingFinished {
+		t.Fatal("expected a new cycle to show progress again")
+	}
+
+	complete("aaaa0003", "a.go", "killed")
+
+	if !m.testingFinished {
+		t.Fatal("expected second cycle to finish")
+	}
+	if len(m.results) != 2 || len(m.resultsList.Items()) != 2 {
+		t.Fatalf("results = %+v, want b.go and the new a.go result", m.results)
+	}
+	if m.countStatus("survived") != 0 || m.results[1].mutationID != "aaaa0003" {
+		t.Fatalf("stale a.go results kept: %+v", m.results)
+	}
+
+	updated, _ := m.Update(watchCycleMsg{changed: []string{"/abs/a.go"}})
+	m = updated.(testExecutionModel)
+
+	if view := m.viewResults(); !strings.Contains(view, "Watching for changes • last run: /abs/a.go") {
+		t.Fatalf("viewResults missing watch status:\n%s", view)
+	}
+}
+
+func TestTestExecutionModel_FailedWatchCycleShowsResults(t *testing.T) {
+	m := newTestExecutionModel()
+	m = m.handleWindowSize(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m = m.handleUpcoming(upcomingMsg{count: 2})
+
+	updated, _ := m.Update(watchCycleMsg{err: fmt.Errorf("run mutation tests: build failed\nundefined: x")})
+	m = updated.(testExecutionModel)
+
+	if !m.testingFinished {
+		t.Fatal("expected a failed cycle to leave the progress view")
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "last run failed: run mutation tests: build failed") || strings.Contains(view, "undefined: x") {
+		t.Fatalf("view does not show the first line of the error:\n%s", view)
+	}
+}
+
+func TestFormatResultDuration(t *testing.T) {
+	if got := formatResultDuration(1234567 * time.Microsecond); got != "1.23s" {
+		t.Fatalf("formatResultDuration(1.234567s) = %q", got)
+	}
+	if got := formatResultDuration(1234567 * time.Nanosecond); got != "1ms" {
+		t.Fatalf("formatResultDuration(1.234567ms) = %q", got)
+	}
+}
+
 func TestTestResultDelegateStyles(t *testing.T) {
 	delegate := testResultDelegate{}
 	result := testResult{id: "1234", file: "path/to/file.go", typ: "bool", status: "custom"}
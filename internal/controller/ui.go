@@ -2,6 +2,8 @@
 package controller
 
 import (
+	"time"
+
 	m "github.com/mouse-blink/gooze/internal/model"
 )
 
@@ -25,9 +27,43 @@ type StartOption func(*StartConfig)
 
 // StartConfig holds configuration for starting the UI.
 type StartConfig struct {
-	mode StartMode
+	mode             StartMode
+	detailsLoader    DetailsLoader
+	sourceLoader     SourceLoader
+	equivalentMarker EquivalentMarker
+	pauser           Pauser
+	aborter          Aborter
+}
+
+// ResultDetails are the parts of a completed mutation's result the test
+// results view loads on selection instead of holding for every result.
+type ResultDetails struct {
+	// Diff is the diff of a survived mutation.
+	Diff []byte
+	// Output is the go test output of a mutant that errored or did not
+	// compile.
+	Output string
 }
 
+// DetailsLoader returns the details of a completed mutation by its full ID.
+// It may be called while mutations are still being tested.
+type DetailsLoader func(mutationID string) (ResultDetails, error)
+
+// SourceLoader reads the original source of a mutated file by its path.
+type SourceLoader func(path string) ([]byte, error)
+
+// EquivalentMarker marks a survived mutation, by its full ID, as equivalent
+// to the original code.
+type EquivalentMarker func(mutationID string) error
+
+// Pauser pauses the run, letting the mutations being tested finish but
+// starting no new ones, or resumes it.
+type Pauser func(paused bool)
+
+// Aborter stops the run early: the mutations being tested finish, and the
+// run is saved with the rest reported as not run.
+type Aborter func()
+
 // WithEstimateMode sets the UI to estimation mode.
 func WithEstimateMode() StartOption {
 	return func(c *StartConfig) {
@@ -42,16 +78,82 @@ func WithTestMode() StartOption {
 	}
 }
 
+// WithDetailsLoader makes the test results view load a mutation's diff or
+// test output through loader when it is selected, instead of keeping those
+// of every result in memory.
+func WithDetailsLoader(loader DetailsLoader) StartOption {
+	return func(c *StartConfig) {
+		c.detailsLoader = loader
+	}
+}
+
+// WithSourceLoader makes the test results view show a selected survivor's
+// mutated line among the surrounding source, read through loader.
+func WithSourceLoader(loader SourceLoader) StartOption {
+	return func(c *StartConfig) {
+		c.sourceLoader = loader
+	}
+}
+
+// WithEquivalentMarker lets the test results view mark the selected survivor
+// as equivalent through marker.
+func WithEquivalentMarker(marker EquivalentMarker) StartOption {
+	return func(c *StartConfig) {
+		c.equivalentMarker = marker
+	}
+}
+
+// WithPauser lets the test progress view pause and resume the run through
+// pauser.
+func WithPauser(pauser Pauser) StartOption {
+	return func(c *StartConfig) {
+		c.pauser = pauser
+	}
+}
+
+// WithAborter lets the test progress view stop the run early through
+// aborter and go on to its results.
+func WithAborter(aborter Aborter) StartOption {
+	return func(c *StartConfig) {
+		c.aborter = aborter
+	}
+}
+
 // UI defines the interface for displaying source file lists.
 // Implementations can use different output methods (simple text, TUI, etc).
 type UI interface {
 	Start(options ...StartOption) error
 	Close()
 	Wait() // Wait for UI to finish (user closes it)
+	// Done is closed when the user closes the UI. It is nil for UIs that
+	// cannot be closed, which then run until interrupted.
+	Done() <-chan struct{}
 	DisplayEstimation(mutations []m.Mutation, err error) error
+	// DisplayRuntimeEstimate shows how long testing the estimated mutations
+	// is projected to take; err is why it could not be projected.
+	DisplayRuntimeEstimate(estimate m.RuntimeEstimate, err error) error
+	DisplayCorpusReport(mutations []m.Mutation, err error) error
+	DisplayStats(stats m.HistoryStats, err error) error
+	DisplayTrend(runs []m.RunRecord, err error) error
+	DisplayComparison(comparison m.ReportComparison, err error) error
+	DisplayCacheStatus(status m.CacheStatus, err error) error
+	DisplayIntegrity(report m.IntegrityReport, err error) error
 	DisplayConcurrencyInfo(threads int, shardIndex int, shardCount int)
 	DisplayUpcomingTestsInfo(i int)
 	DisplayStartingTestInfo(currentMutation m.Mutation, threadID int)
-	DisplayCompletedTestInfo(currentMutation m.Mutation, mutationResult m.Result)
+	DisplayCompletedTestInfo(currentMutation m.Mutation, mutationResult m.MutationResult)
 	DisplayMutationScore(score float64)
+	// DisplayBudgetExhausted flags the run's score as partial: the time
+	// budget ran out with notRun mutations untested.
+	DisplayBudgetExhausted(budget time.Duration, notRun int)
+	// DisplayInterrupted flags the run's score as partial: the run was
+	// interrupted with notRun mutations untested.
+	DisplayInterrupted(notRun int)
+	// DisplayCachedResults tells that cached mutations were not tested again:
+	// their verdicts from earlier runs were reused with their original
+	// status.
+	DisplayCachedResults(cached int)
+	// DisplayWatchCycle ends a watch cycle started by the changed files,
+	// none for the first one; err is why the cycle failed, if it did.
+	DisplayWatchCycle(changed []m.Path, err error)
 }
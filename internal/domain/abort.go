@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"sync"
+)
+
+// runAbort lets the UI stop the current run early: no new mutation starts,
+// the ones being tested finish and the run is saved as interrupted, while
+// the UI stays open on its results.
+type runAbort struct {
+	mu sync.Mutex
+	// aborted is closed once the current run is aborted.
+	aborted chan struct{}
+}
+
+// reset arms the signal for a new run; an earlier abort does not carry
+// over to it.
+func (a *runAbort) reset() {
+	if a == nil {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.aborted = make(chan struct{})
+}
+
+// abort stops the current run; it is the controller.Aborter of the UI.
+func (a *runAbort) abort() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.aborted == nil {
+		return
+	}
+
+	select {
+	case <-a.aborted:
+	default:
+		close(a.aborted)
+	}
+}
+
+// done is closed once the current run is aborted. It is nil, and never
+// closed, on a nil runAbort, when no UI can abort the run.
+func (a *runAbort) done() <-chan struct{} {
+	if a == nil {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.aborted
+}
+
+// abortedFromUI reports whether err is the interruption of a run aborted
+// from the UI, which keeps showing its results.
+func (w *workflow) abortedFromUI(err error) bool {
+	if !errors.Is(err, ErrInterrupted) {
+		return false
+	}
+
+	select {
+	case <-w.abort.done():
+		return true
+	default:
+		return false
+	}
+}
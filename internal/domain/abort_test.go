@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// abortingBackend kills every mutation, calling onSubmit as each starts.
+type abortingBackend struct {
+	onSubmit func()
+}
+
+func (b abortingBackend) Prepare([]m.Mutation, int, RunOptions) error { return nil }
+
+func (b abortingBackend) Submit(m.Mutation) <-chan JobResult {
+	b.onSubmit()
+
+	jobs := make(chan JobResult, 1)
+	jobs <- JobResult{Result: m.MutationResult{Status: m.Killed}}
+
+	return jobs
+}
+
+func (b abortingBackend) Release() error { return nil }
+
+func TestTestReports_AbortFinishesRunningMutations(t *testing.T) {
+	ui := controllermocks.NewMockUI(t)
+	ui.EXPECT().Done().Return(nil).Maybe()
+	ui.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
+	ui.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Twice()
+
+	w := &workflow{UI: ui, abort: &runAbort{}}
+	w.abort.reset()
+
+	backend := abortingBackend{onSubmit: w.abort.abort}
+
+	reports, err := w.testReports(backend, []m.Mutation{{ID: "first"}, {ID: "second"}}, 1, RunOptions{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+
+	assert.Equal(t, m.Killed, reports[0].Result[0].Status, "the running mutation finishes")
+	assert.Equal(t, m.NotRun, reports[1].Result[0].Status)
+	assert.Equal(t, interruptedNote, reports[1].Result[0].Note)
+
+	assert.True(t, w.abortedFromUI(ErrInterrupted))
+	assert.False(t, w.abortedFromUI(ErrBaselineFailing))
+
+	w.abort.reset()
+	assert.False(t, w.abortedFromUI(ErrInterrupted), "an abort does not carry over to the next run")
+}
+
+func TestRunAbort_Nil(t *testing.T) {
+	var abort *runAbort
+
+	abort.reset()
+	assert.Nil(t, abort.done())
+	assert.False(t, (&workflow{}).abortedFromUI(ErrInterrupted))
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// ApplyArgs contains the arguments for writing a mutation of a saved run
+// into the working tree.
+type ApplyArgs struct {
+	Reports m.Path
+	// MutationID is the mutation's full ID or a prefix of it, such as the
+	// four characters the results view shows.
+	MutationID string
+	Out        io.Writer
+}
+
+// RevertArgs contains the arguments for undoing Apply.
+type RevertArgs struct {
+	Reports m.Path
+	Out     io.Writer
+}
+
+// Apply writes the mutated code of args.MutationID over its file, keeping
+// the original in the reports directory for Revert, so the mutant can be
+// run and debugged in place. Only one mutation is applied at a time, and
+// only to a file unchanged since the run, since mutation IDs hash it.
+func (w *workflow) Apply(args ApplyArgs) error {
+	if args.MutationID == "" {
+		return fmt.Errorf("mutation ID is required")
+	}
+
+	applied, err := w.LoadApplied(args.Reports)
+	if err != nil {
+		return fmt.Errorf("load applied mutation: %w", err)
+	}
+
+	if applied != nil {
+		return fmt.Errorf("mutation %s is already applied to %s: run gooze revert first", applied.MutationID, applied.Path)
+	}
+
+	reports, err := w.LoadReports(args.Reports)
+	if err != nil {
+		return fmt.Errorf("load reports: %w", err)
+	}
+
+	report, result, err := findReport(reports, args.MutationID)
+	if err != nil {
+		return err
+	}
+
+	mutation, err := w.regenerateMutation(report.Source, result)
+	if err != nil {
+		return err
+	}
+
+	path := mutation.Source.Origin.FullPath
+
+	info, err := w.FileInfo(path)
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", path, err)
+	}
+
+	original, err := w.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+
+	if err := w.SaveApplied(args.Reports, &m.AppliedMutation{MutationID: mutation.ID, Path: path, Original: original}); err != nil {
+		return fmt.Errorf("save applied mutation: %w", err)
+	}
+
+	if err := w.WriteFile(path, mutation.MutatedCode, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+
+	_, err = fmt.Fprintf(args.Out, "Applied %s mutation %s to %s line %d; run gooze revert to restore it\n%s",
+		mutation.Type.Name, mutation.ID, mutation.Source.Origin.DisplayPath(), mutation.Position.Line, mutation.DiffCode)
+
+	return err
+}
+
+// regenerateMutation generates the mutation of result again from source,
+// as it stands in the working tree.
+func (w *workflow) regenerateMutation(source m.Source, result m.MutationResult) (m.Mutation, error) {
+	if source.Origin == nil {
+		return m.Mutation{}, fmt.Errorf("mutation %s has no source file in the reports", result.MutationID)
+	}
+
+	hash, err := w.HashFile(source.Origin.FullPath)
+	if err != nil {
+		return m.Mutation{}, fmt.Errorf("read %s: %w", source.Origin.FullPath, err)
+	}
+
+	if hash != source.Origin.Hash {
+		return m.Mutation{}, fmt.Errorf("%s changed since the run: run gooze again to test its new mutations", source.Origin.DisplayPath())
+	}
+
+	mutationType, ok := mutationTypeNamed(result.Type.Name)
+	if !ok {
+		return m.Mutation{}, fmt.Errorf("unknown mutation type %q", result.Type.Name)
+	}
+
+	mutations, err := w.GenerateMutation(source, mutationType)
+	if err != nil {
+		return m.Mutation{}, fmt.Errorf("generate mutations: %w", err)
+	}
+
+	for _, mutation := range mutations {
+		if mutation.ID == result.MutationID {
+			return mutation, nil
+		}
+	}
+
+	return m.Mutation{}, fmt.Errorf("%s no longer generates mutation %s: run gooze with the settings of the run", source.Origin.DisplayPath(), result.MutationID)
+}
+
+// Revert restores the file of the mutation Apply wrote with its original
+// content. Edits made to the file since are lost.
+func (w *workflow) Revert(args RevertArgs) error {
+	applied, err := w.LoadApplied(args.Reports)
+	if err != nil {
+		return fmt.Errorf("load applied mutation: %w", err)
+	}
+
+	if applied == nil {
+		return fmt.Errorf("no mutation is applied")
+	}
+
+	perm := os.FileMode(0o644)
+	if info, err := w.FileInfo(applied.Path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	if err := w.WriteFile(applied.Path, applied.Original, perm); err != nil {
+		return fmt.Errorf("write %s: %w", applied.Path, err)
+	}
+
+	if err := w.SaveApplied(args.Reports, nil); err != nil {
+		return fmt.Errorf("clear applied mutation: %w", err)
+	}
+
+	_, err = fmt.Fprintf(args.Out, "Reverted mutation %s: restored %s\n", applied.MutationID, applied.Path)
+
+	return err
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const applySource = `package calc
+
+func Add(a, b int) int {
+	return a + b
+}
+`
+
+// savedSurvivor writes applySource to a module and reports holding the
+// survival of its first arithmetic mutation.
+func savedSurvivor(t *testing.T, w *workflow) (string, m.Path, m.Mutation) {
+	t.Helper()
+
+	project := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(project, "go.mod"), []byte("module example.com/calc\n\ngo 1.22\n"), 0o600))
+
+	path := filepath.Join(project, "calc.go")
+	require.NoError(t, os.WriteFile(path, []byte(applySource), 0o600))
+
+	sources, err := w.Get([]m.Path{m.Path(path)})
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+
+	mutations, err := w.GenerateMutation(sources[0], m.MutationArithmetic)
+	require.NoError(t, err)
+	require.NotEmpty(t, mutations)
+
+	reports := m.Path(t.TempDir())
+	require.NoError(t, w.SaveReports(reports, []m.Report{{
+		Source: sources[0],
+		Result: m.Result{{MutationID: mutations[0].ID, Type: m.MutationArithmetic, Status: m.Survived}},
+	}}))
+
+	return path, reports, mutations[0]
+}
+
+func TestWorkflow_ApplyAndRevert(t *testing.T) {
+	w := NewWorkflow().(*workflow)
+	path, reports, mutation := savedSurvivor(t, w)
+
+	var out bytes.Buffer
+
+	require.NoError(t, w.Apply(ApplyArgs{Reports: reports, MutationID: mutation.ID[:6], Out: &out}))
+	assert.Contains(t, out.String(), "Applied arithmetic mutation "+mutation.ID)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(mutation.MutatedCode), string(content))
+
+	err = w.Apply(ApplyArgs{Reports: reports, MutationID: mutation.ID, Out: &out})
+	require.ErrorContains(t, err, "is already applied")
+
+	require.NoError(t, w.Revert(RevertArgs{Reports: reports, Out: &out}))
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, applySource, string(content))
+
+	err = w.Revert(RevertArgs{Reports: reports, Out: &out})
+	require.EqualError(t, err, "no mutation is applied")
+}
+
+func TestWorkflow_Apply_FileChangedSinceRun(t *testing.T) {
+	w := NewWorkflow().(*workflow)
+	path, reports, mutation := savedSurvivor(t, w)
+
+	require.NoError(t, os.WriteFile(path, []byte(applySource+"\n// edited\n"), 0o600))
+
+	err := w.Apply(ApplyArgs{Reports: reports, MutationID: mutation.ID, Out: &bytes.Buffer{}})
+	require.ErrorContains(t, err, "changed since the run")
+
+	applied, err := w.LoadApplied(reports)
+	require.NoError(t, err)
+	assert.Nil(t, applied)
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// BadgeLabel is the left-hand text of the mutation score badge.
+const BadgeLabel = "mutation score"
+
+// BadgeArgs contains the arguments for rendering a mutation score badge.
+type BadgeArgs struct {
+	Reports m.Path
+	// Out is where the SVG shield is written; Endpoint, when set, is where
+	// the shields.io endpoint JSON for the same badge is written.
+	Out      m.Path
+	Endpoint m.Path
+}
+
+// badgeColors maps the lowest score, in percent, that earns each color;
+// scores below the last threshold are red.
+var badgeColors = []struct {
+	min   float64
+	color string
+}{
+	{min: 80, color: "brightgreen"},
+	{min: 60, color: "yellow"},
+	{min: 40, color: "orange"},
+}
+
+// Badge renders the mutation score of the reports in args.Reports as a badge.
+func (w *workflow) Badge(args BadgeArgs) error {
+	if args.Out == "" && args.Endpoint == "" {
+		return fmt.Errorf("badge output path is required")
+	}
+
+	reports, err := w.LoadReports(args.Reports)
+	if err != nil {
+		return fmt.Errorf("load reports: %w", err)
+	}
+
+	if len(reports) == 0 {
+		return fmt.Errorf("no reports in %s: run gooze run first", args.Reports)
+	}
+
+	if err := w.ExportBadge(args.Out, args.Endpoint, scoreBadge(reports)); err != nil {
+		return fmt.Errorf("export badge: %w", err)
+	}
+
+	return nil
+}
+
+// scoreBadge describes the reports' mutation score, marking it partial when
+// a time budget left mutations untested.
+func scoreBadge(reports []m.Report) m.Badge {
+	percent := math.Floor(mutationScoreFromReports(reports) * 100)
+
+	message := fmt.Sprintf("%.0f%%", percent)
+	if countStatus(reports, m.NotRun) > 0 {
+		message += " (partial)"
+	}
+
+	return m.Badge{Label: BadgeLabel, Message: message, Color: badgeColor(percent)}
+}
+
+func badgeColor(percent float64) string {
+	for _, threshold := range badgeColors {
+		if percent >= threshold.min {
+			return threshold.color
+		}
+	}
+
+	return "red"
+}
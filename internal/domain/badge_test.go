@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreBadge(t *testing.T) {
+	reports := []m.Report{
+		{Result: m.Result{{Status: m.Killed}, {Status: m.Killed}, {Status: m.Survived}}},
+		{Result: m.Result{{Status: m.Skipped}}},
+	}
+
+	assert.Equal(t, m.Badge{Label: BadgeLabel, Message: "66%", Color: "yellow"}, scoreBadge(reports))
+
+	reports = append(reports, m.Report{Result: m.Result{{Status: m.NotRun}}})
+	assert.Equal(t, "66% (partial)", scoreBadge(reports).Message)
+}
+
+func TestBadgeColor(t *testing.T) {
+	assert.Equal(t, "brightgreen", badgeColor(100))
+	assert.Equal(t, "brightgreen", badgeColor(80))
+	assert.Equal(t, "yellow", badgeColor(79))
+	assert.Equal(t, "yellow", badgeColor(60))
+	assert.Equal(t, "orange", badgeColor(40))
+	assert.Equal(t, "red", badgeColor(39))
+	assert.Equal(t, "red", badgeColor(0))
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// Baseline runs the tests of every package with mutations, once and without
+// any mutation applied, in the original project. It fails with
+// ErrBaselineFailing when they already fail or time out.
+func (to *orchestrator) Baseline(mutations []m.Mutation) error {
+	return to.baseline(mutations, func(m.Mutation, string, time.Duration) {})
+}
+
+// BaselineTimes runs the baseline tests as Baseline does and returns how
+// long the run of each mutation's package took, by mutation ID, along with
+// the number of runs. Mutations without tests are left out.
+func (to *orchestrator) BaselineTimes(mutations []m.Mutation) (map[string]time.Duration, int, error) {
+	times := make(map[string]time.Duration)
+	runs := make(map[string]bool)
+
+	err := to.baseline(mutations, func(mutation m.Mutation, run string, took time.Duration) {
+		times[mutation.ID] = took
+		runs[run] = true
+	})
+
+	return times, len(runs), err
+}
+
+// baseline runs the baseline tests of each package once and passes every
+// mutation with tests to record along with its package's run, as a key,
+// and how long that took.
+func (to *orchestrator) baseline(mutations []m.Mutation, record func(mutation m.Mutation, run string, took time.Duration)) error {
+	took := make(map[string]time.Duration)
+	runOpts := to.runOptions()
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil || !hasTests(mutation) {
+			continue
+		}
+
+		workDir, targets, err := to.baselineTarget(mutation)
+		if err != nil {
+			return err
+		}
+
+		key := string(workDir) + "\x00" + strings.Join(targets, "\x00")
+		if elapsed, checked := took[key]; checked {
+			record(mutation, key, elapsed)
+			continue
+		}
+
+		opts := adapter.GoTestOptions{Tags: mutation.Source.BuildTags, Timeout: mutation.Timeout, Race: runOpts.Race, Args: runOpts.GoTestArgs}
+		if len(targets) > 1 {
+			opts.Packages = targets[1:]
+		}
+
+		start := time.Now()
+
+		_, testErr := to.testAdapter.RunGoTest(string(workDir), targets[0], opts)
+		if errors.Is(testErr, adapter.ErrGoToolchainNotFound) {
+			return testErr
+		}
+
+		if testErr != nil {
+			return fmt.Errorf("%w: go test %s in %s: %w", ErrBaselineFailing, strings.Join(targets, " "), workDir, testErr)
+		}
+
+		took[key] = time.Since(start)
+		record(mutation, key, took[key])
+	}
+
+	return nil
+}
+
+// baselineTarget returns the mutation's package, or for the dependents scope
+// its TestPackages from the project root. The package is checked even when
+// only one test file runs against the mutants, since that file's tests are
+// part of it.
+func (to *orchestrator) baselineTarget(mutation m.Mutation) (m.Path, []string, error) {
+	if len(mutation.Source.TestPackages) > 0 {
+		projectRoot, err := to.fsAdapter.FindProjectRoot(mutation.Source.Origin.FullPath)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to find project root: %w", err)
+		}
+
+		return to.dependentsTarget(mutation.Source.TestPackages, projectRoot, projectRoot)
+	}
+
+	return m.Path(filepath.Dir(string(mutation.Source.Origin.FullPath))), []string{"."}, nil
+}
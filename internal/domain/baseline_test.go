@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrchestrator_Baseline_RunsEachPackageOnce(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	first := makeTestMutation()
+	first.Timeout = time.Minute
+	second := first
+	second.ID = "other-mutation-hash"
+	untested := m.Mutation{ID: "untested", Source: m.Source{Origin: &m.File{FullPath: "/project/util/util.go"}}}
+
+	trAdapter.EXPECT().RunGoTest("/project", ".", adapter.GoTestOptions{Timeout: time.Minute}).Return("ok", nil).Once()
+
+	require.NoError(t, orch.Baseline([]m.Mutation{first, second, untested}))
+}
+
+func TestOrchestrator_Baseline_DependentsFromProjectRoot(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	mutation.Source.TestPackages = []m.Path{"/project/store", "/project/api"}
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(m.Path("/project"), nil)
+	fsAdapter.EXPECT().RelPath(m.Path("/project"), m.Path("/project/store")).Return(m.Path("store"), nil)
+	fsAdapter.EXPECT().RelPath(m.Path("/project"), m.Path("/project/api")).Return(m.Path("api"), nil)
+	trAdapter.EXPECT().RunGoTest("/project", "./store", adapter.GoTestOptions{Packages: []string{"./api"}}).Return("ok", nil)
+
+	require.NoError(t, orch.Baseline([]m.Mutation{mutation}))
+}
+
+func TestOrchestrator_Baseline_FailingTests(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	trAdapter.EXPECT().RunGoTest("/project", ".", adapter.GoTestOptions{}).Return("FAIL", errors.New("exit status 1"))
+
+	err := orch.Baseline([]m.Mutation{makeTestMutation()})
+	require.ErrorIs(t, err, ErrBaselineFailing)
+	require.ErrorContains(t, err, "/project")
+}
+
+func TestOrchestrator_Baseline_MissingToolchain(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	trAdapter.EXPECT().RunGoTest("/project", ".", adapter.GoTestOptions{}).Return("", adapter.ErrGoToolchainNotFound)
+
+	err := orch.Baseline([]m.Mutation{makeTestMutation()})
+	require.ErrorIs(t, err, ErrToolchain)
+	require.NotErrorIs(t, err, ErrBaselineFailing)
+}
+
+func TestOrchestrator_BaselineTimes_TimesEachPackageOnce(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	first := makeTestMutation()
+	second := first
+	second.ID = "other-mutation-hash"
+	untested := m.Mutation{ID: "untested", Source: m.Source{Origin: &m.File{FullPath: "/project/util/util.go"}}}
+
+	trAdapter.EXPECT().RunGoTest("/project", ".", adapter.GoTestOptions{}).
+		RunAndReturn(func(string, string, adapter.GoTestOptions) (string, error) {
+			time.Sleep(time.Millisecond)
+			return "ok", nil
+		}).Once()
+
+	times, runs, err := orch.BaselineTimes([]m.Mutation{first, second, untested})
+	require.NoError(t, err)
+	require.Equal(t, 1, runs)
+	require.Len(t, times, 2)
+	require.GreaterOrEqual(t, times[first.ID], time.Millisecond)
+	require.Equal(t, times[first.ID], times[second.ID])
+}
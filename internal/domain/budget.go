@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// coverageBudgetShare is the fraction of a time budget, as a divisor, the
+// coverage run that orders the mutations may take.
+const coverageBudgetShare = 4
+
+// budgetExhaustedNote explains results left untested by a time budget.
+const budgetExhaustedNote = "time budget exhausted"
+
+func validateMaxDuration(maxDuration time.Duration) error {
+	if maxDuration < 0 {
+		return fmt.Errorf("max duration must not be negative, got %s", maxDuration)
+	}
+
+	return nil
+}
+
+// coveredFirst orders the mutations on lines the tests execute ahead of the
+// others, so a run cut short by its budget spends it on mutants the tests
+// can reach. When the coverage run fails the order is kept as it is; the
+// budget still applies.
+func (w *workflow) coveredFirst(mutations []m.Mutation, tags []string, budget time.Duration) []m.Mutation {
+	coverage, err := w.Coverage(mutations, tags, budget/coverageBudgetShare)
+	if err != nil {
+		return mutations
+	}
+
+	return withCoveredFirst(mutations, coverage)
+}
+
+// withCoveredFirst moves the mutations coverage covers to the front,
+// keeping the relative order within both groups.
+func withCoveredFirst(mutations []m.Mutation, coverage m.Coverage) []m.Mutation {
+	covered := make([]m.Mutation, 0, len(mutations))
+	uncovered := make([]m.Mutation, 0)
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin != nil && coverage.Covers(mutation.Source.Origin.FullPath, mutation.Position.Line) {
+			covered = append(covered, mutation)
+			continue
+		}
+
+		uncovered = append(uncovered, mutation)
+	}
+
+	return append(covered, uncovered...)
+}
+
+// notRunResult records a mutation the deadline or an interruption left
+// untested; note says which.
+func notRunResult(mutation m.Mutation, note string) m.MutationResult {
+	return m.MutationResult{
+		MutationID: mutation.ID,
+		Type:       mutation.Type,
+		Status:     m.NotRun,
+		Line:       mutation.Position.Line,
+		Note:       note,
+	}
+}
+
+// countStatus counts the results in reports with the given status.
+func countStatus(reports []m.Report, status m.TestStatus) int {
+	count := 0
+
+	for _, report := range reports {
+		for _, result := range report.Result {
+			if result.Status == status {
+				count++
+			}
+		}
+	}
+
+	return count
+}
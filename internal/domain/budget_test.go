@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCoveredFirst(t *testing.T) {
+	at := func(id string, file m.Path, line int) m.Mutation {
+		return m.Mutation{ID: id, Source: m.Source{Origin: &m.File{FullPath: file}}, Position: m.Position{Line: line}}
+	}
+
+	mutations := []m.Mutation{
+		at("a", "/p/a.go", 3),
+		at("b", "/p/a.go", 7),
+		at("c", "/p/b.go", 2),
+		at("d", "/p/a.go", 4),
+		{ID: "e"},
+	}
+
+	coverage := m.Coverage{}
+	coverage.Add("/p/a.go", 3, 4)
+	coverage.Add("/p/b.go", 2, 2)
+
+	var ids []string
+	for _, mutation := range withCoveredFirst(mutations, coverage) {
+		ids = append(ids, mutation.ID)
+	}
+
+	assert.Equal(t, []string{"a", "c", "d", "b", "e"}, ids)
+}
+
+func TestValidateMaxDuration(t *testing.T) {
+	require.NoError(t, validateMaxDuration(0))
+	require.NoError(t, validateMaxDuration(time.Minute))
+	require.Error(t, validateMaxDuration(-time.Second))
+}
+
+func TestCountStatus(t *testing.T) {
+	reports := []m.Report{
+		{Result: m.Result{{Status: m.NotRun}, {Status: m.Killed}}},
+		{Result: m.Result{{Status: m.NotRun}}},
+	}
+
+	assert.Equal(t, 2, countStatus(reports, m.NotRun))
+	assert.Equal(t, 0, countStatus(reports, m.Survived))
+}
+
+func TestOrchestrator_Coverage(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	profile := "mode: set\nexample.com/project/main.go:2.13,2.30 1 1\n"
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(m.Path("/project"), nil)
+	fsAdapter.EXPECT().ListPackages(m.Path("/project"), []string{"integration"}).
+		Return([]m.Package{{ImportPath: "example.com/project", Dir: "/project"}}, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-cover-*").Return(m.Path("/tmp/cover"), nil)
+	fsAdapter.EXPECT().JoinPath("/tmp/cover", "cover.out").Return(m.Path("/tmp/cover/cover.out"))
+	fsAdapter.EXPECT().ReadFile(m.Path("/tmp/cover/cover.out")).Return([]byte(profile), nil)
+	fsAdapter.EXPECT().RemoveAll(m.Path("/tmp/cover")).Return(nil)
+
+	// A failing baseline still leaves a usable profile.
+	trAdapter.EXPECT().RunGoTest("/project", "./...", adapter.GoTestOptions{
+		Tags:         []string{"integration"},
+		Timeout:      time.Minute,
+		CoverProfile: "/tmp/cover/cover.out",
+	}).Return("FAIL", errors.New("exit status 1"))
+
+	coverage, err := orch.Coverage([]m.Mutation{mutation}, []string{"integration"}, time.Minute)
+	require.NoError(t, err)
+
+	assert.True(t, coverage.Covers("/project/main.go", 2))
+	assert.False(t, coverage.Covers("/project/main.go", 3))
+}
+
+func TestOrchestrator_Coverage_NoProfile(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(m.Path("/project"), nil)
+	fsAdapter.EXPECT().ListPackages(m.Path("/project"), mock.Anything).Return(nil, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-cover-*").Return(m.Path("/tmp/cover"), nil)
+	fsAdapter.EXPECT().JoinPath("/tmp/cover", "cover.out").Return(m.Path("/tmp/cover/cover.out"))
+	fsAdapter.EXPECT().ReadFile(m.Path("/tmp/cover/cover.out")).Return(nil, errors.New("no such file"))
+	fsAdapter.EXPECT().RemoveAll(m.Path("/tmp/cover")).Return(nil)
+	trAdapter.EXPECT().RunGoTest("/project", "./...", mock.Anything).Return("build failed", errors.New("exit status 1"))
+
+	_, err := orch.Coverage([]m.Mutation{mutation}, nil, time.Minute)
+	require.ErrorContains(t, err, "coverage run in /project")
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"go/build"
+	"go/build/constraint"
+	"slices"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// unixGOOS lists the systems satisfying the "unix" build tag.
+var unixGOOS = []string{
+	"aix", "android", "darwin", "dragonfly", "freebsd", "hurd", "illumos",
+	"ios", "linux", "netbsd", "openbsd", "solaris",
+}
+
+// withBuildTags keeps the sources whose //go:build constraint holds for the
+// current platform with tags set, and records tags on them so their tests
+// are built the same way.
+func withBuildTags(sources []m.Source, tags []string) []m.Source {
+	kept := make([]m.Source, 0, len(sources))
+
+	for _, source := range sources {
+		if !constraintSatisfied(source.Constraint, tags) {
+			continue
+		}
+
+		source.BuildTags = tags
+		kept = append(kept, source)
+	}
+
+	return kept
+}
+
+func constraintSatisfied(expr string, tags []string) bool {
+	if expr == "" {
+		return true
+	}
+
+	parsed, err := constraint.Parse("//go:build " + expr)
+	if err != nil {
+		// Leave the verdict to the go command rather than hide the file.
+		return true
+	}
+
+	return parsed.Eval(func(tag string) bool {
+		return matchesTag(build.Default, tag) || slices.Contains(tags, tag)
+	})
+}
+
+// matchesTag mirrors the tags the go command sets for ctx, minus -tags.
+func matchesTag(ctx build.Context, tag string) bool {
+	switch {
+	case tag == ctx.GOOS, tag == ctx.GOARCH:
+		return true
+	case tag == "cgo":
+		return ctx.CgoEnabled
+	case tag == "unix":
+		return slices.Contains(unixGOOS, ctx.GOOS)
+	case tag == "linux":
+		return ctx.GOOS == "android"
+	case tag == "solaris":
+		return ctx.GOOS == "illumos"
+	case tag == "darwin":
+		return ctx.GOOS == "ios"
+	}
+
+	return slices.Contains(ctx.BuildTags, tag) ||
+		slices.Contains(ctx.ToolTags, tag) ||
+		slices.Contains(ctx.ReleaseTags, tag)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"go/build"
+	"runtime"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintSatisfied(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		tags []string
+		want bool
+	}{
+		{name: "no constraint", expr: "", want: true},
+		{name: "current GOOS", expr: runtime.GOOS, want: true},
+		{name: "negated GOOS", expr: "!" + runtime.GOOS, want: false},
+		{name: "other GOOS", expr: "plan9 && " + runtime.GOOS, want: false},
+		{name: "release tag", expr: "go1.1", want: true},
+		{name: "custom tag unset", expr: "integration", want: false},
+		{name: "custom tag set", expr: "integration", tags: []string{"integration"}, want: true},
+		{name: "custom tag negated", expr: "!integration", tags: []string{"integration"}, want: false},
+		{name: "ignore tag", expr: "ignore", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, constraintSatisfied(tt.expr, tt.tags))
+		})
+	}
+}
+
+func TestMatchesTag_ImpliedTags(t *testing.T) {
+	ctx := build.Default
+	ctx.GOOS = "android"
+
+	assert.True(t, matchesTag(ctx, "android"))
+	assert.True(t, matchesTag(ctx, "linux"))
+	assert.True(t, matchesTag(ctx, "unix"))
+	assert.False(t, matchesTag(ctx, "darwin"))
+
+	ctx.GOOS = "windows"
+	assert.False(t, matchesTag(ctx, "unix"))
+}
+
+func TestWithBuildTags(t *testing.T) {
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "/p/plain.go"}},
+		{Origin: &m.File{FullPath: "/p/integration.go"}, Constraint: "integration"},
+		{Origin: &m.File{FullPath: "/p/fast.go"}, Constraint: "!slow"},
+	}
+
+	kept := withBuildTags(sources, []string{"slow"})
+
+	if assert.Len(t, kept, 1) {
+		assert.Equal(t, m.Path("/p/plain.go"), kept[0].Origin.FullPath)
+		assert.Equal(t, []string{"slow"}, kept[0].BuildTags)
+	}
+
+	kept = withBuildTags(sources, []string{"integration"})
+	assert.Len(t, kept, 3)
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// CacheArgs contains the arguments for inspecting the reports directory.
+type CacheArgs struct {
+	Reports m.Path
+	// Paths are the sources checked for changes since they were tested; no
+	// paths means ./... .
+	Paths   []m.Path
+	Exclude []string
+	// Clean removes the reports of sources that no longer exist.
+	Clean bool
+}
+
+// Cache shows the size of the reports directory, the stored sources that
+// were deleted since they were tested and those the next run tests again.
+// With args.Clean the reports of deleted sources are removed first.
+func (w *workflow) Cache(args CacheArgs) error {
+	status, err := w.cacheStatus(args)
+	if err != nil {
+		return err
+	}
+
+	if args.Clean && len(status.Stale) > 0 {
+		stale := status.Stale
+
+		if err := w.CleanReports(args.Reports, sourcesAt(stale)); err != nil {
+			return fmt.Errorf("clean reports: %w", err)
+		}
+
+		if status, err = w.cacheStatus(args); err != nil {
+			return err
+		}
+
+		status.Stale = stale
+	}
+
+	status.Cleaned = args.Clean
+
+	if err := w.DisplayCacheStatus(status, nil); err != nil {
+		return fmt.Errorf("display: %w", err)
+	}
+
+	return nil
+}
+
+func (w *workflow) cacheStatus(args CacheArgs) (m.CacheStatus, error) {
+	status := m.CacheStatus{Dir: args.Reports}
+
+	if _, err := w.FileInfo(args.Reports); errors.Is(err, os.ErrNotExist) {
+		return status, nil
+	}
+
+	reports, err := w.LoadReports(args.Reports)
+	if err != nil {
+		return status, fmt.Errorf("load reports: %w", err)
+	}
+
+	if status.Size, err = w.CopySize(args.Reports); err != nil {
+		return status, fmt.Errorf("measure %s: %w", args.Reports, err)
+	}
+
+	stored := make(map[m.Path]bool)
+
+	for _, report := range reports {
+		if report.Source.Origin != nil {
+			stored[report.Source.Origin.FullPath] = true
+		}
+
+		status.Mutations += len(report.Result)
+	}
+
+	status.Sources = len(stored)
+
+	paths := args.Paths
+	if len(paths) == 0 {
+		paths = []m.Path{"./..."}
+	}
+
+	sources, err := w.Get(paths, args.Exclude...)
+	if err != nil {
+		return status, fmt.Errorf("get sources: %w", err)
+	}
+
+	changed, err := w.CheckUpdates(args.Reports, sources)
+	if err != nil {
+		return status, fmt.Errorf("check updates: %w", err)
+	}
+
+	deleted, changedExisting := w.separateDeletedAndChanged(changed, w.buildSourcePathMap(sources))
+
+	// Sources left out of paths are listed as deleted as well; only those
+	// gone from disk are stale.
+	for _, source := range deleted {
+		if _, err := w.FileInfo(source.Origin.FullPath); errors.Is(err, os.ErrNotExist) {
+			status.Stale = append(status.Stale, source.Origin.FullPath)
+		}
+	}
+
+	for _, source := range changedExisting {
+		if stored[source.Origin.FullPath] {
+			status.Outdated = append(status.Outdated, source.Origin.FullPath)
+		}
+	}
+
+	sort.Slice(status.Stale, func(i, j int) bool { return status.Stale[i] < status.Stale[j] })
+	sort.Slice(status.Outdated, func(i, j int) bool { return status.Outdated[i] < status.Outdated[j] })
+
+	return status, nil
+}
+
+// sourcesAt returns sources identified by their paths alone, so CleanReports
+// leaves alone the reports of live files with the same content.
+func sourcesAt(paths []m.Path) []m.Source {
+	sources := make([]m.Source, 0, len(paths))
+
+	for _, path := range paths {
+		sources = append(sources, m.Source{Origin: &m.File{FullPath: path}})
+	}
+
+	return sources
+}
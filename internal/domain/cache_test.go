@@ -0,0 +1,126 @@
+package domain_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// cacheProject writes kept.go, changed.go and gone.go with a stored report
+// each, then changes changed.go and deletes gone.go.
+func cacheProject(t *testing.T, store adapter.ReportStore) (root, reports m.Path) {
+	t.Helper()
+
+	root = newProject(t)
+	reports = m.Path(filepath.Join(string(root), ".gooze-reports"))
+
+	for _, name := range []string{"kept.go", "changed.go", "gone.go"} {
+		require.NoError(t, os.WriteFile(filepath.Join(string(root), name), []byte("package calc\n"), 0o600))
+	}
+
+	sources, err := adapter.NewLocalSourceFSAdapter().Get([]m.Path{m.Path(string(root) + "/...")})
+	require.NoError(t, err)
+
+	stored := make([]m.Report, 0, len(sources))
+	for _, source := range sources {
+		stored = append(stored, m.Report{
+			Source: source,
+			Result: m.Result{{MutationID: filepath.Base(string(source.Origin.FullPath)), Type: m.MutationArithmetic, Status: m.Killed}},
+		})
+	}
+
+	require.NoError(t, store.SaveReports(reports, stored))
+	require.NoError(t, os.WriteFile(filepath.Join(string(root), "changed.go"), []byte("package calc\n\nvar x = 1\n"), 0o600))
+	require.NoError(t, os.Remove(filepath.Join(string(root), "gone.go")))
+
+	return root, reports
+}
+
+func TestWorkflow_Cache_ReportsStaleAndOutdatedSources(t *testing.T) {
+	store := adapter.NewReportStore()
+	root, reports := cacheProject(t, store)
+
+	mockUI := new(controllermocks.MockUI)
+	mockUI.On("DisplayCacheStatus", mock.MatchedBy(func(status m.CacheStatus) bool {
+		return status.Dir == reports &&
+			status.Size > 0 &&
+			status.Sources == 3 &&
+			status.Mutations == 3 &&
+			assert.ObjectsAreEqual([]m.Path{m.Path(filepath.Join(string(root), "gone.go"))}, status.Stale) &&
+			assert.ObjectsAreEqual([]m.Path{m.Path(filepath.Join(string(root), "changed.go"))}, status.Outdated) &&
+			!status.Cleaned
+	}), nil).Return(nil)
+
+	workflow := domain.NewWorkflow(
+		domain.WithSourceFS(adapter.NewLocalSourceFSAdapter()),
+		domain.WithReportStore(store),
+		domain.WithUI(mockUI),
+	)
+
+	err := workflow.Cache(domain.CacheArgs{Reports: reports, Paths: []m.Path{m.Path(string(root) + "/...")}})
+	require.NoError(t, err)
+	mockUI.AssertExpectations(t)
+
+	loaded, err := store.LoadReports(reports)
+	require.NoError(t, err)
+	assert.Len(t, loaded, 3)
+}
+
+func TestWorkflow_Cache_CleanRemovesOnlyStaleReports(t *testing.T) {
+	store := adapter.NewReportStore()
+	root, reports := cacheProject(t, store)
+
+	mockUI := new(controllermocks.MockUI)
+	mockUI.On("DisplayCacheStatus", mock.MatchedBy(func(status m.CacheStatus) bool {
+		return status.Sources == 2 &&
+			len(status.Stale) == 1 &&
+			status.Cleaned
+	}), nil).Return(nil)
+
+	workflow := domain.NewWorkflow(
+		domain.WithSourceFS(adapter.NewLocalSourceFSAdapter()),
+		domain.WithReportStore(store),
+		domain.WithUI(mockUI),
+	)
+
+	// Only kept.go is listed, so changed.go is outside the paths but still
+	// on disk and keeps its report.
+	err := workflow.Cache(domain.CacheArgs{Reports: reports, Paths: []m.Path{m.Path(filepath.Join(string(root), "kept.go"))}, Clean: true})
+	require.NoError(t, err)
+	mockUI.AssertExpectations(t)
+
+	loaded, err := store.LoadReports(reports)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(loaded))
+	for _, report := range loaded {
+		names = append(names, filepath.Base(string(report.Source.Origin.FullPath)))
+	}
+
+	assert.ElementsMatch(t, []string{"kept.go", "changed.go"}, names)
+}
+
+func TestWorkflow_Cache_MissingReportsDirectory(t *testing.T) {
+	root := newProject(t)
+	reports := m.Path(filepath.Join(string(root), ".gooze-reports"))
+
+	mockUI := new(controllermocks.MockUI)
+	mockUI.On("DisplayCacheStatus", m.CacheStatus{Dir: reports, Cleaned: true}, nil).Return(nil)
+
+	workflow := domain.NewWorkflow(
+		domain.WithSourceFS(adapter.NewLocalSourceFSAdapter()),
+		domain.WithReportStore(adapter.NewReportStore()),
+		domain.WithUI(mockUI),
+	)
+
+	require.NoError(t, workflow.Cache(domain.CacheArgs{Reports: reports, Clean: true}))
+	mockUI.AssertExpectations(t)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// CodeScope narrows mutations to the exported or unexported API.
+type CodeScope string
+
+const (
+	// CodeScopeExported keeps mutations in exported functions and in exported
+	// methods of exported types: the API other packages call.
+	CodeScopeExported CodeScope = "exported"
+	// CodeScopeUnexported keeps mutations in the remaining functions and
+	// methods.
+	CodeScopeUnexported CodeScope = "unexported"
+)
+
+// validateCodeScope accepts the known scopes and the empty scope, which keeps
+// every mutation.
+func validateCodeScope(scope CodeScope) error {
+	switch scope {
+	case "", CodeScopeExported, CodeScopeUnexported:
+		return nil
+	default:
+		return fmt.Errorf("unknown scope %q (want %s or %s)", scope, CodeScopeExported, CodeScopeUnexported)
+	}
+}
+
+// withCodeScope keeps the mutations in functions of the given scope. Like
+// Func, a scope leaves out package-level code, which is in no function.
+func withCodeScope(mutations []m.Mutation, scope CodeScope) []m.Mutation {
+	if scope == "" {
+		return mutations
+	}
+
+	kept := make([]m.Mutation, 0, len(mutations))
+
+	for _, mutation := range mutations {
+		if mutation.Func == "" {
+			continue
+		}
+
+		if exportedFunc(mutation.Func) == (scope == CodeScopeExported) {
+			kept = append(kept, mutation)
+		}
+	}
+
+	return kept
+}
+
+// exportedFunc reports whether fn, given as Name or Type.Name, can be called
+// from other packages.
+func exportedFunc(fn string) bool {
+	typeName, name, isMethod := strings.Cut(fn, ".")
+	if !isMethod {
+		return token.IsExported(fn)
+	}
+
+	return token.IsExported(typeName) && token.IsExported(name)
+}
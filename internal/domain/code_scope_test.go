@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCodeScope(t *testing.T) {
+	require.NoError(t, validateCodeScope(""))
+	require.NoError(t, validateCodeScope(CodeScopeExported))
+	require.NoError(t, validateCodeScope(CodeScopeUnexported))
+	require.ErrorContains(t, validateCodeScope("public"), `unknown scope "public"`)
+}
+
+func TestWithCodeScope(t *testing.T) {
+	mutations := []m.Mutation{
+		{ID: "pkg-level"},
+		{ID: "exported", Func: "Calculate"},
+		{ID: "unexported", Func: "parse"},
+		{ID: "exported-method", Func: "Shard.Parse"},
+		{ID: "unexported-method", Func: "Shard.parse"},
+		{ID: "method-of-unexported-type", Func: "shard.Parse"},
+	}
+
+	ids := func(mutations []m.Mutation) []string {
+		var ids []string
+		for _, mutation := range mutations {
+			ids = append(ids, mutation.ID)
+		}
+
+		return ids
+	}
+
+	assert.Equal(t, []string{"exported", "exported-method"}, ids(withCodeScope(mutations, CodeScopeExported)))
+	assert.Equal(t, []string{"unexported", "unexported-method", "method-of-unexported-type"}, ids(withCodeScope(mutations, CodeScopeUnexported)))
+	assert.Equal(t, mutations, withCodeScope(mutations, ""))
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// CompareArgs contains the arguments for comparing two report sets.
+type CompareArgs struct {
+	Old m.Path
+	New m.Path
+}
+
+// CompareReports shows which mutants newly survive or are newly killed in
+// args.New compared to args.Old, and how the score moved.
+func (w *workflow) CompareReports(args CompareArgs) error {
+	oldReports, err := w.LoadReports(args.Old)
+	if err != nil {
+		return fmt.Errorf("load reports from %s: %w", args.Old, err)
+	}
+
+	newReports, err := w.LoadReports(args.New)
+	if err != nil {
+		return fmt.Errorf("load reports from %s: %w", args.New, err)
+	}
+
+	if err := w.DisplayComparison(compareReports(oldReports, newReports), nil); err != nil {
+		return fmt.Errorf("display: %w", err)
+	}
+
+	return nil
+}
+
+// compareReports matches mutants by ID. A mutant whose code moved gets a
+// new ID, so it shows up as added rather than as the same mutant.
+func compareReports(oldReports, newReports []m.Report) m.ReportComparison {
+	comparison := m.ReportComparison{
+		OldScore:      mutationScoreFromReports(oldReports),
+		NewScore:      mutationScoreFromReports(newReports),
+		NewlySurvived: make([]m.ComparedMutation, 0),
+		NewlyKilled:   make([]m.ComparedMutation, 0),
+	}
+
+	oldStatus := make(map[string]m.TestStatus)
+
+	for _, report := range oldReports {
+		for _, result := range report.Result {
+			oldStatus[result.MutationID] = result.Status
+		}
+	}
+
+	for _, report := range newReports {
+		for _, result := range report.Result {
+			before, existed := oldStatus[result.MutationID]
+			compared := m.ComparedMutation{
+				ID:    result.MutationID,
+				Path:  report.Source.Origin.DisplayPath(),
+				Type:  result.Type,
+				Added: !existed,
+			}
+
+			switch {
+			case result.Status == m.Survived && before != m.Survived:
+				comparison.NewlySurvived = append(comparison.NewlySurvived, compared)
+			case result.Status == m.Killed && existed && before == m.Survived:
+				comparison.NewlyKilled = append(comparison.NewlyKilled, compared)
+			}
+		}
+	}
+
+	sortComparedMutations(comparison.NewlySurvived)
+	sortComparedMutations(comparison.NewlyKilled)
+
+	return comparison
+}
+
+func sortComparedMutations(mutations []m.ComparedMutation) {
+	sort.Slice(mutations, func(i, j int) bool {
+		if mutations[i].Path != mutations[j].Path {
+			return mutations[i].Path < mutations[j].Path
+		}
+
+		return mutations[i].ID < mutations[j].ID
+	})
+}
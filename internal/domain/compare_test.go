@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareReports(t *testing.T) {
+	source := m.Source{Origin: &m.File{ShortPath: "calc.go", FullPath: "/project/calc.go"}}
+	result := func(id string, status m.TestStatus) m.MutationResult {
+		return m.MutationResult{MutationID: id, Type: m.MutationArithmetic, Status: status}
+	}
+
+	oldReports := []m.Report{{Source: source, Result: m.Result{
+		result("weakened", m.Killed),
+		result("fixed", m.Survived),
+		result("still-surviving", m.Survived),
+		result("removed", m.Survived),
+		result("skipped", m.Skipped),
+	}}}
+	newReports := []m.Report{{Source: source, Result: m.Result{
+		result("weakened", m.Survived),
+		result("fixed", m.Killed),
+		result("still-surviving", m.Survived),
+		result("added", m.Survived),
+		result("skipped", m.Killed),
+	}}}
+
+	comparison := compareReports(oldReports, newReports)
+
+	assert.InDelta(t, 0.25, comparison.OldScore, 1e-9)
+	assert.InDelta(t, 0.4, comparison.NewScore, 1e-9)
+	assert.Equal(t, []m.ComparedMutation{
+		{ID: "added", Path: "calc.go", Type: m.MutationArithmetic, Added: true},
+		{ID: "weakened", Path: "calc.go", Type: m.MutationArithmetic},
+	}, comparison.NewlySurvived)
+	assert.Equal(t, []m.ComparedMutation{
+		{ID: "fixed", Path: "calc.go", Type: m.MutationArithmetic},
+	}, comparison.NewlyKilled)
+}
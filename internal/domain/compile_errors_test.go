@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutationScoreFromReports_LeavesOutCompileErrors(t *testing.T) {
+	reports := []m.Report{{Result: m.Result{
+		{MutationID: "a", Status: m.Killed},
+		{MutationID: "b", Status: m.Survived},
+		{MutationID: "c", Status: m.CompileError},
+		{MutationID: "d", Status: m.CompileError},
+	}}}
+
+	assert.InDelta(t, 0.5, mutationScoreFromReports(reports), 1e-9)
+}
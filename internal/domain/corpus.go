@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// CorpusArgs contains the arguments for reporting mutation counts over a corpus.
+type CorpusArgs struct {
+	Paths   []m.Path
+	Exclude []string
+	// Minimums maps an absolute source path to the least number of mutations
+	// each named mutation type must generate for it.
+	Minimums map[m.Path]map[string]int
+}
+
+// CorpusReport generates every mutation type for the given sources without
+// running tests, displays the counts and checks them against args.Minimums.
+func (w *workflow) CorpusReport(args CorpusArgs) error {
+	sources, err := w.Get(args.Paths, args.Exclude...)
+	if err != nil {
+		return fmt.Errorf("get sources: %w", err)
+	}
+
+	var mutations []m.Mutation
+
+	for _, source := range sources {
+		generated, err := w.GenerateMutation(source, m.MutationTypes...)
+		if err != nil {
+			return fmt.Errorf("generate mutations for %s: %w", source.Origin.DisplayPath(), err)
+		}
+
+		mutations = append(mutations, generated...)
+	}
+
+	mutations, _ = withoutSuppressed(mutations)
+
+	if err := w.DisplayCorpusReport(mutations, nil); err != nil {
+		return fmt.Errorf("display: %w", err)
+	}
+
+	return checkCorpusMinimums(mutations, args.Minimums)
+}
+
+func checkCorpusMinimums(mutations []m.Mutation, minimums map[m.Path]map[string]int) error {
+	counts := make(map[m.Path]map[string]int)
+
+	for _, mutation := range mutations {
+		path := mutation.Source.Origin.FullPath
+		if counts[path] == nil {
+			counts[path] = make(map[string]int)
+		}
+
+		for _, name := range mutation.TypeNames() {
+			counts[path][name]++
+		}
+	}
+
+	var shortfalls []string
+
+	for path, perType := range minimums {
+		for typeName, minimum := range perType {
+			if got := counts[path][typeName]; got < minimum {
+				shortfalls = append(shortfalls, fmt.Sprintf("%s %s: got %d, want at least %d", path, typeName, got, minimum))
+			}
+		}
+	}
+
+	if len(shortfalls) == 0 {
+		return nil
+	}
+
+	sort.Strings(shortfalls)
+
+	return fmt.Errorf("mutation counts below corpus minimums:\n  %s", strings.Join(shortfalls, "\n  "))
+}
@@ -0,0 +1,139 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	domain "github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func corpusMutagenCall(mockMutagen *domainmocks.MockMutagen, source m.Source) *domainmocks.MockMutagen_GenerateMutation_Call {
+	args := []interface{}{}
+	for _, mutationType := range m.MutationTypes {
+		args = append(args, mutationType)
+	}
+
+	return mockMutagen.EXPECT().GenerateMutation(source, args...)
+}
+
+func TestWorkflow_CorpusReport_MeetsMinimums(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{Origin: &m.File{FullPath: "/corpus/loops/main.go", Hash: "hash1"}}
+	mutations := []m.Mutation{
+		{ID: "1", Source: source, Type: m.MutationLoop},
+		{ID: "2", Source: source, Type: m.MutationLoop},
+		{ID: "3", Source: source, Type: m.MutationComparison},
+	}
+
+	mockFSAdapter.EXPECT().Get([]m.Path{"./corpus/..."}, "invalid").Return([]m.Source{source}, nil)
+	corpusMutagenCall(mockMutagen, source).Return(mutations, nil)
+	mockUI.EXPECT().DisplayCorpusReport(mutations, nil).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.CorpusReport(domain.CorpusArgs{
+		Paths:    []m.Path{"./corpus/..."},
+		Exclude:  []string{"invalid"},
+		Minimums: map[m.Path]map[string]int{"/corpus/loops/main.go": {"loop": 2, "comparison": 1}},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	mockUI.AssertExpectations(t)
+	mockMutagen.AssertExpectations(t)
+}
+
+func TestWorkflow_CorpusReport_BelowMinimums(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{Origin: &m.File{FullPath: "/corpus/loops/main.go", Hash: "hash1"}}
+	mutations := []m.Mutation{{ID: "1", Source: source, Type: m.MutationLoop}}
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	corpusMutagenCall(mockMutagen, source).Return(mutations, nil)
+	mockUI.EXPECT().DisplayCorpusReport(mutations, nil).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.CorpusReport(domain.CorpusArgs{
+		Minimums: map[m.Path]map[string]int{
+			"/corpus/loops/main.go":   {"loop": 3},
+			"/corpus/deleted/main.go": {"arithmetic": 1},
+		},
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "/corpus/loops/main.go loop: got 1, want at least 3")
+	assert.Contains(t, err.Error(), "/corpus/deleted/main.go arithmetic: got 0, want at least 1")
+}
+
+func TestWorkflow_CorpusReport_GenerateError(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{Origin: &m.File{FullPath: "/corpus/invalid/broken.go", ShortPath: "broken.go"}}
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	corpusMutagenCall(mockMutagen, source).Return(nil, errors.New("parse failed"))
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.CorpusReport(domain.CorpusArgs{})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "generate mutations for broken.go")
+}
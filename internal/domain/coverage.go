@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// Coverage runs the tests of every project the mutations belong to once,
+// with a cover profile, and returns the lines they executed.
+func (to *orchestrator) Coverage(mutations []m.Mutation, tags []string, timeout time.Duration) (m.Coverage, error) {
+	roots := make(map[m.Path]bool)
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil {
+			continue
+		}
+
+		root, err := to.fsAdapter.FindProjectRoot(mutation.Source.Origin.FullPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find project root: %w", err)
+		}
+
+		roots[root] = true
+	}
+
+	sorted := make([]m.Path, 0, len(roots))
+	for root := range roots {
+		sorted = append(sorted, root)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	coverage := m.Coverage{}
+
+	for _, root := range sorted {
+		if err := to.addCoverage(coverage, root, tags, timeout); err != nil {
+			return nil, err
+		}
+	}
+
+	return coverage, nil
+}
+
+// addCoverage adds the lines covered by root's tests to coverage. Failing
+// tests still write a profile, so a test error only counts when none was
+// written.
+func (to *orchestrator) addCoverage(coverage m.Coverage, root m.Path, tags []string, timeout time.Duration) error {
+	packages, err := to.fsAdapter.ListPackages(root, tags)
+	if err != nil {
+		return fmt.Errorf("list packages in %s: %w", root, err)
+	}
+
+	packageDirs := make(map[string]m.Path, len(packages))
+	for _, pkg := range packages {
+		packageDirs[pkg.ImportPath] = pkg.Dir
+	}
+
+	profileDir, err := to.fsAdapter.CreateTempDir("", "gooze-cover-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+
+	defer to.cleanupTempDir(profileDir)
+
+	profile := to.fsAdapter.JoinPath(string(profileDir), "cover.out")
+
+	_, testErr := to.testAdapter.RunGoTest(string(root), "./...", adapter.GoTestOptions{
+		Tags:         tags,
+		Timeout:      timeout,
+		CoverProfile: string(profile),
+	})
+
+	content, err := to.fsAdapter.ReadFile(profile)
+	if err != nil {
+		return fmt.Errorf("coverage run in %s: %w", root, errors.Join(testErr, err))
+	}
+
+	parsed, err := adapter.ParseCoverProfile(bytes.NewReader(content), packageDirs)
+	if err != nil {
+		return err
+	}
+
+	for file, lines := range parsed {
+		for line := range lines {
+			coverage.Add(file, line, line)
+		}
+	}
+
+	return nil
+}
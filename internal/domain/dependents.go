@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// withDependents sets the TestPackages of every mutation to its own package
+// and the packages that import it, keeping only packages with tests. The
+// import graph is listed once per project root.
+func (w *workflow) withDependents(mutations []m.Mutation, tags []string) ([]m.Mutation, error) {
+	graphs := make(map[m.Path]*importGraph)
+	scoped := make([]m.Mutation, len(mutations))
+
+	for i, mutation := range mutations {
+		if mutation.Source.Origin != nil {
+			graph, err := w.importGraphFor(graphs, mutation.Source.Origin.FullPath, tags)
+			if err != nil {
+				return nil, err
+			}
+
+			dir := m.Path(filepath.Dir(string(mutation.Source.Origin.FullPath)))
+			mutation.Source.TestPackages = graph.testedDependents(dir)
+		}
+
+		scoped[i] = mutation
+	}
+
+	return scoped, nil
+}
+
+func (w *workflow) importGraphFor(graphs map[m.Path]*importGraph, sourcePath m.Path, tags []string) (*importGraph, error) {
+	root, err := w.FindProjectRoot(sourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("find project root of %s: %w", sourcePath, err)
+	}
+
+	if graph, ok := graphs[root]; ok {
+		return graph, nil
+	}
+
+	packages, err := w.ListPackages(root, tags)
+	if err != nil {
+		return nil, fmt.Errorf("list packages: %w", err)
+	}
+
+	graph := newImportGraph(packages)
+	graphs[root] = graph
+
+	return graph, nil
+}
+
+// importGraph indexes a project's packages by directory and by the packages
+// they import.
+type importGraph struct {
+	packages  map[string]m.Package
+	byDir     map[m.Path]string
+	importers map[string][]string
+}
+
+func newImportGraph(packages []m.Package) *importGraph {
+	graph := &importGraph{
+		packages:  make(map[string]m.Package, len(packages)),
+		byDir:     make(map[m.Path]string, len(packages)),
+		importers: make(map[string][]string),
+	}
+
+	for _, pkg := range packages {
+		graph.packages[pkg.ImportPath] = pkg
+		graph.byDir[pkg.Dir] = pkg.ImportPath
+
+		for _, imported := range pkg.Imports {
+			graph.importers[imported] = append(graph.importers[imported], pkg.ImportPath)
+		}
+	}
+
+	return graph
+}
+
+// testedDependents returns the sorted directories of the packages with tests
+// that can exercise the package in dir: the package itself, the packages
+// importing it through non-test files, and any package whose tests import
+// one of those. It returns nil when dir is not a listed package.
+func (g *importGraph) testedDependents(dir m.Path) []m.Path {
+	start, ok := g.byDir[dir]
+	if !ok {
+		return nil
+	}
+
+	reached := map[string]bool{start: true}
+	queue := []string{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, importer := range g.importers[current] {
+			if !reached[importer] {
+				reached[importer] = true
+				queue = append(queue, importer)
+			}
+		}
+	}
+
+	var dirs []m.Path
+
+	for importPath, pkg := range g.packages {
+		if pkg.HasTests && (reached[importPath] || importsAny(pkg.TestImports, reached)) {
+			dirs = append(dirs, pkg.Dir)
+		}
+	}
+
+	sort.Slice(dirs, func(i, j int) bool { return dirs[i] < dirs[j] })
+
+	return dirs
+}
+
+func importsAny(imports []string, set map[string]bool) bool {
+	for _, imported := range imports {
+		if set[imported] {
+			return true
+		}
+	}
+
+	return false
+}
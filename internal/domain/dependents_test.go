@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportGraph_TestedDependents(t *testing.T) {
+	graph := newImportGraph([]m.Package{
+		{ImportPath: "ex/model", Dir: "/p/model", HasTests: true},
+		{ImportPath: "ex/store", Dir: "/p/store", Imports: []string{"ex/model"}},
+		{ImportPath: "ex/api", Dir: "/p/api", Imports: []string{"ex/store"}, HasTests: true},
+		{ImportPath: "ex/e2e", Dir: "/p/e2e", TestImports: []string{"ex/api"}, HasTests: true},
+		{ImportPath: "ex/tools", Dir: "/p/tools", TestImports: []string{"ex/e2e"}, HasTests: true},
+		{ImportPath: "ex/cli", Dir: "/p/cli", HasTests: true},
+	})
+
+	assert.Equal(t, []m.Path{"/p/api", "/p/e2e", "/p/model"}, graph.testedDependents("/p/model"),
+		"importers are followed transitively, test imports only as the last hop")
+	assert.Equal(t, []m.Path{"/p/api", "/p/e2e"}, graph.testedDependents("/p/store"),
+		"packages without tests are not run")
+	assert.Nil(t, graph.testedDependents("/p/unlisted"))
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// validateDiagnosticsFormat accepts the known formats and the empty format,
+// which means DiagnosticsRDJSON.
+func validateDiagnosticsFormat(format m.DiagnosticsFormat) error {
+	if format == "" {
+		return nil
+	}
+
+	for _, known := range m.DiagnosticsFormats {
+		if format == known {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown diagnostics format %q (want %s or %s)", format, m.DiagnosticsRDJSON, m.DiagnosticsLSP)
+}
+
+// survivorDiagnostics locates the survived mutations of reports using the
+// positions recorded on mutations, which reports do not keep.
+func survivorDiagnostics(mutations []m.Mutation, reports []m.Report) []m.Diagnostic {
+	byID := make(map[string]m.Mutation, len(mutations))
+	for _, mutation := range mutations {
+		byID[mutation.ID] = mutation
+	}
+
+	diagnostics := make([]m.Diagnostic, 0)
+
+	for _, report := range reports {
+		for _, result := range report.Result {
+			if result.Status != m.Survived {
+				continue
+			}
+
+			mutation, ok := byID[result.MutationID]
+			if !ok || mutation.Source.Origin == nil {
+				continue
+			}
+
+			diagnostics = append(diagnostics, m.Diagnostic{
+				MutationID: mutation.ID,
+				Type:       mutation.Type,
+				File:       *mutation.Source.Origin,
+				Line:       mutation.Position.Line,
+				Column:     mutation.Position.Column,
+				Func:       mutation.Func,
+				Diff:       mutation.DiffCode,
+			})
+		}
+	}
+
+	return diagnostics
+}
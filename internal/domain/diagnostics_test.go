@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDiagnosticsFormat(t *testing.T) {
+	assert.NoError(t, validateDiagnosticsFormat(""))
+	assert.NoError(t, validateDiagnosticsFormat(m.DiagnosticsRDJSON))
+	assert.NoError(t, validateDiagnosticsFormat(m.DiagnosticsLSP))
+	assert.ErrorContains(t, validateDiagnosticsFormat("sarif"), `unknown diagnostics format "sarif"`)
+}
+
+func TestSurvivorDiagnostics_OnlySurvivors(t *testing.T) {
+	origin := &m.File{ShortPath: "calc.go", FullPath: "/project/calc.go"}
+	mutations := []m.Mutation{
+		{
+			ID:       "survived",
+			Source:   m.Source{Origin: origin},
+			Type:     m.MutationArithmetic,
+			Position: m.Position{Offset: 40, Line: 5, Column: 11},
+			Func:     "Add",
+			DiffCode: []byte("diff"),
+		},
+		{ID: "killed", Source: m.Source{Origin: origin}, Type: m.MutationBoolean},
+	}
+	reports := []m.Report{
+		{Source: m.Source{Origin: origin}, Result: m.Result{{MutationID: "survived", Type: m.MutationArithmetic, Status: m.Survived}}},
+		{Source: m.Source{Origin: origin}, Result: m.Result{{MutationID: "killed", Type: m.MutationBoolean, Status: m.Killed}}},
+		{Source: m.Source{Origin: origin}, Result: m.Result{{MutationID: "unknown", Type: m.MutationBoolean, Status: m.Survived}}},
+	}
+
+	diagnostics := survivorDiagnostics(mutations, reports)
+
+	assert.Equal(t, []m.Diagnostic{{
+		MutationID: "survived",
+		Type:       m.MutationArithmetic,
+		File:       *origin,
+		Line:       5,
+		Column:     11,
+		Func:       "Add",
+		Diff:       []byte("diff"),
+	}}, diagnostics)
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DiskBudgetAuto bounds the workspaces by the space free in the directory
+// they are created in instead of a fixed number of bytes.
+const DiskBudgetAuto int64 = -1
+
+// freeTempSpace is a variable so tests can pin the host.
+var freeTempSpace = readFreeTempSpace
+
+// fitDiskBudget lowers threads so one copy of the projects per worker fits
+// into budget bytes, or for DiskBudgetAuto into the space free in workDir
+// (the system temp directory when empty). A zero budget, or an unknown free
+// space, leaves threads alone. It fails with ErrDiskBudget when not even one
+// copy fits.
+func (w *workflow) fitDiskBudget(mutations []m.Mutation, threads int, budget int64, workDir m.Path) (int, error) {
+	if budget == 0 || len(mutations) == 0 {
+		return threads, nil
+	}
+
+	limit := uint64(budget)
+
+	if budget < 0 {
+		free, ok := freeTempSpace(workDir)
+		if !ok {
+			return threads, nil
+		}
+
+		limit = free
+	}
+
+	size, err := w.workspaceSize(mutations)
+	if err != nil {
+		return 0, err
+	}
+
+	if size == 0 {
+		return threads, nil
+	}
+
+	copies := limit / size
+	if copies == 0 {
+		return 0, fmt.Errorf("%w: a copy of the project takes %s, the budget is %s", ErrDiskBudget, m.FormatBytes(size), m.FormatBytes(limit))
+	}
+
+	if copies < uint64(threads) {
+		return int(copies), nil
+	}
+
+	return threads, nil
+}
+
+// workspaceSize returns the bytes one workspace copy of every project the
+// mutations belong to takes.
+func (w *workflow) workspaceSize(mutations []m.Mutation) (uint64, error) {
+	seen := make(map[m.Path]bool)
+	roots := make(map[m.Path]bool)
+
+	var size uint64
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil || !hasTests(mutation) || seen[mutation.Source.Origin.FullPath] {
+			continue
+		}
+
+		seen[mutation.Source.Origin.FullPath] = true
+
+		root, err := w.FindProjectRoot(mutation.Source.Origin.FullPath)
+		if err != nil {
+			return 0, fmt.Errorf("find project root: %w", err)
+		}
+
+		if roots[root] {
+			continue
+		}
+
+		roots[root] = true
+
+		rootSize, err := w.CopySize(root)
+		if err != nil {
+			return 0, fmt.Errorf("measure project %s: %w", root, err)
+		}
+
+		size += uint64(rootSize)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"path/filepath"
+	"testing"
+
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitDiskBudget(t *testing.T) {
+	originalFree := freeTempSpace
+	t.Cleanup(func() { freeTempSpace = originalFree })
+
+	mutations := []m.Mutation{makeTestMutation(), makeTestMutation()}
+
+	tests := []struct {
+		name    string
+		budget  int64
+		free    uint64
+		known   bool
+		want    int
+		wantErr bool
+	}{
+		{name: "no budget", budget: 0, want: 8},
+		{name: "budget fits every worker", budget: 10 << 20, want: 8},
+		{name: "budget lowers workers", budget: 3 << 20, want: 3},
+		{name: "budget below one copy", budget: 512 << 10, wantErr: true},
+		{name: "auto uses free space", budget: DiskBudgetAuto, free: 5 << 20, known: true, want: 5},
+		{name: "auto with unknown free space", budget: DiskBudgetAuto, want: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			freeTempSpace = func(m.Path) (uint64, bool) { return tt.free, tt.known }
+
+			fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+			fsAdapter.EXPECT().FindProjectRoot(m.Path("/project/main.go")).Return(m.Path("/project"), nil).Maybe()
+			fsAdapter.EXPECT().CopySize(m.Path("/project")).Return(int64(1<<20), nil).Maybe()
+
+			w := &workflow{SourceFSAdapter: fsAdapter}
+
+			got, err := w.fitDiskBudget(mutations, 8, tt.budget, "")
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrDiskBudget)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadFreeTempSpace_MissingWorkDir(t *testing.T) {
+	free, ok := readFreeTempSpace(m.Path(filepath.Join(t.TempDir(), "not", "created")))
+	if !ok {
+		t.Skip("free space is not known on this platform")
+	}
+
+	assert.Positive(t, free)
+}
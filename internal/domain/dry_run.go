@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/mouse-blink/gooze/internal/controller"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DryRunArgs contains the arguments for generating mutations without testing
+// them.
+type DryRunArgs struct {
+	EstimateArgs
+	// Out is the directory the mutation diffs are written to.
+	Out m.Path
+	// FullFiles also writes each complete mutated source file.
+	FullFiles bool
+}
+
+// DryRun generates the mutations a run would test and writes their diffs to
+// args.Out instead of running any tests, so operators and ignore patterns can
+// be reviewed. Cached results are ignored: every mutation is written.
+func (w *workflow) DryRun(args DryRunArgs) error {
+	if args.Out == "" {
+		return fmt.Errorf("dry run output directory is required")
+	}
+
+	if err := w.Start(controller.WithEstimateMode()); err != nil {
+		return err
+	}
+	defer w.Close()
+
+	estimateArgs := args.EstimateArgs
+	estimateArgs.UseCache = false
+
+	mutations, err := w.GetMutations(estimateArgs)
+	if err != nil {
+		return fmt.Errorf("generate mutations: %w", err)
+	}
+
+	mutations, _ = withoutSuppressed(mutations)
+
+	if err := w.SaveMutationDiffs(args.Out, mutations, args.FullFiles); err != nil {
+		return fmt.Errorf("save mutation diffs: %w", err)
+	}
+
+	if err := w.DisplayEstimation(mutations, nil); err != nil {
+		return fmt.Errorf("display: %w", err)
+	}
+
+	w.Wait()
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"slices"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// withoutDuplicates merges the mutations of a source whose mutated code is
+// byte-identical, as when two mutators rewrite an expression the same way:
+// the first one generated is kept and tested once, and records in MergedFrom
+// the type of each of the others, once and unless it is its own. Mutations are only merged with others a
+// //gooze:ignore annotation suppresses alike.
+func withoutDuplicates(mutations []m.Mutation) []m.Mutation {
+	type key struct {
+		code       [sha256.Size]byte
+		suppressed bool
+	}
+
+	first := make(map[key]int, len(mutations))
+	kept := make([]m.Mutation, 0, len(mutations))
+
+	for _, mutation := range mutations {
+		k := key{code: sha256.Sum256(mutation.MutatedCode), suppressed: mutation.Suppressed}
+
+		index, ok := first[k]
+		if !ok {
+			first[k] = len(kept)
+			kept = append(kept, mutation)
+
+			continue
+		}
+
+		name := mutation.Type.Name
+		if name != kept[index].Type.Name && !slices.Contains(kept[index].MergedFrom, name) {
+			kept[index].MergedFrom = append(kept[index].MergedFrom, name)
+		}
+	}
+
+	return kept
+}
+
+// withMergedFrom records in each result the types merged into its
+// mutation, so reports show every mutator the result stands for.
+func withMergedFrom(reports []m.Report, mutations []m.Mutation) []m.Report {
+	merged := make(map[string][]string)
+
+	for _, mutation := range mutations {
+		if len(mutation.MergedFrom) > 0 {
+			merged[mutation.ID] = mutation.MergedFrom
+		}
+	}
+
+	if len(merged) == 0 {
+		return reports
+	}
+
+	for i := range reports {
+		result := slices.Clone(reports[i].Result)
+		for j := range result {
+			result[j].MergedFrom = merged[result[j].MutationID]
+		}
+
+		reports[i].Result = result
+	}
+
+	return reports
+}
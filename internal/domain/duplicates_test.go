@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithoutDuplicates(t *testing.T) {
+	mutations := []m.Mutation{
+		{ID: "cmp", Type: m.MutationComparison, MutatedCode: []byte("i <= n")},
+		{ID: "loop", Type: m.MutationLoop, MutatedCode: []byte("i <= n")},
+		{ID: "neg", Type: m.MutationComparison, MutatedCode: []byte("i >= n")},
+		{ID: "branch", Type: m.MutationBranch, MutatedCode: []byte("i <= n")},
+		{ID: "ignored", Type: m.MutationLoop, MutatedCode: []byte("i >= n"), Suppressed: true},
+	}
+
+	kept := withoutDuplicates(mutations)
+
+	assert.Equal(t, []m.Mutation{
+		{ID: "cmp", Type: m.MutationComparison, MutatedCode: []byte("i <= n"), MergedFrom: []string{"loop", "branch"}},
+		{ID: "neg", Type: m.MutationComparison, MutatedCode: []byte("i >= n")},
+		{ID: "ignored", Type: m.MutationLoop, MutatedCode: []byte("i >= n"), Suppressed: true},
+	}, kept)
+	assert.Nil(t, mutations[0].MergedFrom, "the input is left unchanged")
+	assert.Equal(t, []string{"comparison", "loop", "branch"}, kept[0].TypeNames())
+}
+
+func TestWithoutDuplicates_SameType(t *testing.T) {
+	mutations := []m.Mutation{
+		{ID: "shift", Type: m.MutationArithmetic, MutatedCode: []byte("a - b")},
+		{ID: "swap", Type: m.MutationArithmetic, MutatedCode: []byte("a - b")},
+		{ID: "loop", Type: m.MutationLoop, MutatedCode: []byte("a - b")},
+		{ID: "again", Type: m.MutationLoop, MutatedCode: []byte("a - b")},
+	}
+
+	kept := withoutDuplicates(mutations)
+
+	assert.Len(t, kept, 1)
+	assert.Equal(t, []string{"loop"}, kept[0].MergedFrom, "each other type is recorded once")
+	assert.Equal(t, []string{"arithmetic", "loop"}, kept[0].TypeNames())
+}
+
+func TestWithMergedFrom(t *testing.T) {
+	mutations := []m.Mutation{
+		{ID: "cmp", MergedFrom: []string{"loop"}},
+		{ID: "neg"},
+	}
+	reports := []m.Report{{Result: m.Result{
+		{MutationID: "cmp", Status: m.Killed},
+		{MutationID: "neg", Status: m.Survived},
+	}}}
+
+	merged := withMergedFrom(reports, mutations)
+
+	assert.Equal(t, []string{"loop"}, merged[0].Result[0].MergedFrom)
+	assert.Nil(t, merged[0].Result[1].MergedFrom)
+	assert.Equal(t, reports, withMergedFrom(reports, []m.Mutation{{ID: "neg"}}))
+}
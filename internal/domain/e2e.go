@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// e2eFixture is the project gooze e2e runs against. Its go.mod is written
+// by writeE2EFixture: a go.mod in the tree would make it a separate module,
+// which cannot be embedded.
+//
+//go:embed testdata/e2e/*.go
+var e2eFixture embed.FS
+
+const (
+	e2eFixtureDir    = "testdata/e2e"
+	e2eFixtureGoMod  = "module example.com/goozee2e\n\ngo 1.21\n"
+	e2eReportsSubdir = ".gooze-reports"
+)
+
+// e2eOutcome is the number of mutants of one type expected to be killed and
+// to survive in a fixture file.
+type e2eOutcome struct {
+	Killed   int
+	Survived int
+}
+
+// e2eExpectations maps each fixture file to the outcomes of the mutation
+// types it is checked for. The survivors are the mutants the fixture's tests
+// deliberately miss: the age boundary of IsAdult, in both its comparison
+// and its literal moved by one, and the value of Retries.
+var e2eExpectations = map[string]map[string]e2eOutcome{
+	"calc.go": {
+		m.MutationArithmetic.Name: {Killed: 4},
+		m.MutationComparison.Name: {Killed: 4, Survived: 1},
+		m.MutationNumbers.Name:    {Killed: 3, Survived: 2},
+	},
+	"flags.go": {
+		m.MutationLogical.Name: {Killed: 1},
+		m.MutationNumbers.Name: {Killed: 2, Survived: 3},
+		m.MutationUnary.Name:   {Killed: 1},
+	},
+}
+
+// E2EArgs contains the arguments for the end-to-end smoke test.
+type E2EArgs struct {
+	// Project is the directory the fixture is written to and tested in, and
+	// which keeps its reports afterwards. Empty means a temporary directory
+	// that is removed when done.
+	Project m.Path
+	Threads int
+}
+
+// E2E writes the embedded fixture project, runs the full pipeline on it
+// (discovery, generation, tests, reports and index) and checks the stored
+// results against the outcomes the fixture is known to produce.
+func (w *workflow) E2E(args E2EArgs) error {
+	project := args.Project
+	if project == "" {
+		dir, err := w.CreateTempDir("", "gooze-e2e-")
+		if err != nil {
+			return fmt.Errorf("create e2e project: %w", err)
+		}
+
+		defer func() { _ = w.RemoveAll(dir) }()
+
+		project = dir
+	}
+
+	if err := w.writeE2EFixture(project); err != nil {
+		return err
+	}
+
+	reportsDir := w.JoinPath(string(project), e2eReportsSubdir)
+
+	err := w.Test(TestArgs{
+		EstimateArgs: EstimateArgs{Paths: []m.Path{w.JoinPath(string(project), "...")}},
+		Reports:      reportsDir,
+		Threads:      args.Threads,
+	})
+	if err != nil {
+		return fmt.Errorf("e2e run: %w", err)
+	}
+
+	reports, err := w.LoadReports(reportsDir)
+	if err != nil {
+		return fmt.Errorf("load e2e reports: %w", err)
+	}
+
+	index, err := w.LoadIndex(reportsDir)
+	if err != nil {
+		return fmt.Errorf("load e2e index: %w", err)
+	}
+
+	return checkE2EOutcomes(reports, index, e2eExpectations)
+}
+
+func (w *workflow) writeE2EFixture(project m.Path) error {
+	entries, err := fs.ReadDir(e2eFixture, e2eFixtureDir)
+	if err != nil {
+		return fmt.Errorf("read e2e fixture: %w", err)
+	}
+
+	files := map[string][]byte{"go.mod": []byte(e2eFixtureGoMod)}
+
+	for _, entry := range entries {
+		content, err := fs.ReadFile(e2eFixture, path.Join(e2eFixtureDir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("read e2e fixture: %w", err)
+		}
+
+		files[entry.Name()] = content
+	}
+
+	for name, content := range files {
+		if err := w.WriteFile(w.JoinPath(string(project), name), content, 0o600); err != nil {
+			return fmt.Errorf("write e2e fixture: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkE2EOutcomes compares the killed and survived counts per file and
+// mutation type with expected, and the index totals with the reports. Types
+// absent from expected are not checked, but no mutant may fail to compile or
+// be killed only by a build failure: that means the tests never ran against
+// it.
+func checkE2EOutcomes(reports []m.Report, index m.ReportIndex, expected map[string]map[string]e2eOutcome) error {
+	got := make(map[string]map[string]e2eOutcome)
+
+	var mismatches []string
+
+	for _, report := range reports {
+		if report.Source.Origin == nil {
+			continue
+		}
+
+		file := string(report.Source.Origin.PackageRelative())
+		if got[file] == nil {
+			got[file] = make(map[string]e2eOutcome)
+		}
+
+		for _, result := range report.Result {
+			outcome := got[file][result.Type.Name]
+
+			switch result.Status {
+			case m.Killed:
+				outcome.Killed++
+
+				if result.KillReason == m.KillBuild {
+					mismatches = append(mismatches, fmt.Sprintf("%s %s: mutation %s killed by a build failure", file, result.Type.Name, result.MutationID))
+				}
+			case m.Survived:
+				outcome.Survived++
+			case m.CompileError:
+				mismatches = append(mismatches, fmt.Sprintf("%s %s: mutation %s does not compile", file, result.Type.Name, result.MutationID))
+			case m.Skipped, m.Error, m.NotRun, m.Flaky:
+				mismatches = append(mismatches, fmt.Sprintf("%s %s: mutation %s %s", file, result.Type.Name, result.MutationID, result.Status))
+			}
+
+			got[file][result.Type.Name] = outcome
+		}
+	}
+
+	for file, perType := range expected {
+		for typeName, want := range perType {
+			if have := got[file][typeName]; have != want {
+				mismatches = append(mismatches, fmt.Sprintf("%s %s: got %d killed and %d survived, want %d and %d",
+					file, typeName, have.Killed, have.Survived, want.Killed, want.Survived))
+			}
+		}
+	}
+
+	killed, survived := countStatus(reports, m.Killed), countStatus(reports, m.Survived)
+	if index.Killed != killed || index.Survived != survived {
+		mismatches = append(mismatches, fmt.Sprintf("index: got %d killed and %d survived, reports have %d and %d",
+			index.Killed, index.Survived, killed, survived))
+	}
+
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	sort.Strings(mismatches)
+
+	return fmt.Errorf("e2e outcomes differ from the fixture's expectations:\n  %s", strings.Join(mismatches, "\n  "))
+}
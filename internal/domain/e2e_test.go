@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"io/fs"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func e2eReport(file string, results ...m.MutationResult) m.Report {
+	return m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: m.Path("/project/" + file)}},
+		Result: results,
+	}
+}
+
+func TestCheckE2EOutcomes_Match(t *testing.T) {
+	reports := []m.Report{
+		e2eReport("calc.go",
+			m.MutationResult{MutationID: "1", Type: m.MutationArithmetic, Status: m.Killed, KillReason: m.KillAssertion},
+			m.MutationResult{MutationID: "2", Type: m.MutationArithmetic, Status: m.Survived},
+			m.MutationResult{MutationID: "3", Type: m.MutationBoolean, Status: m.Killed}),
+	}
+	index := m.ReportIndex{IndexCounts: m.IndexCounts{Total: 3, Killed: 2, Survived: 1}}
+	expected := map[string]map[string]e2eOutcome{
+		"calc.go": {m.MutationArithmetic.Name: {Killed: 1, Survived: 1}},
+	}
+
+	assert.NoError(t, checkE2EOutcomes(reports, index, expected))
+}
+
+func TestCheckE2EOutcomes_ReportsEveryMismatch(t *testing.T) {
+	reports := []m.Report{
+		e2eReport("calc.go",
+			m.MutationResult{MutationID: "1", Type: m.MutationArithmetic, Status: m.Killed, KillReason: m.KillBuild},
+			m.MutationResult{MutationID: "2", Type: m.MutationComparison, Status: m.Error}),
+	}
+	index := m.ReportIndex{IndexCounts: m.IndexCounts{Total: 2}}
+	expected := map[string]map[string]e2eOutcome{
+		"calc.go":  {m.MutationArithmetic.Name: {Killed: 1, Survived: 1}},
+		"flags.go": {m.MutationUnary.Name: {Killed: 1}},
+	}
+
+	err := checkE2EOutcomes(reports, index, expected)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "calc.go arithmetic: got 1 killed and 0 survived, want 1 and 1")
+	assert.Contains(t, err.Error(), "calc.go arithmetic: mutation 1 killed by a build failure")
+	assert.Contains(t, err.Error(), "calc.go comparison: mutation 2 error")
+	assert.Contains(t, err.Error(), "flags.go unary: got 0 killed and 0 survived, want 1 and 0")
+	assert.Contains(t, err.Error(), "index: got 0 killed and 0 survived, reports have 1 and 0")
+}
+
+func TestE2EFixture_CoversExpectations(t *testing.T) {
+	entries, err := fs.ReadDir(e2eFixture, e2eFixtureDir)
+	require.NoError(t, err)
+
+	files := make(map[string]bool)
+	for _, entry := range entries {
+		files[entry.Name()] = true
+	}
+
+	for file := range e2eExpectations {
+		assert.True(t, files[file], "fixture has no %s", file)
+	}
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// MarkEquivalentArgs contains the arguments for marking a survived mutation
+// as equivalent to the original code.
+type MarkEquivalentArgs struct {
+	Reports m.Path
+	// MutationID is the mutation's full ID or a prefix of it, such as the
+	// four characters the results view shows.
+	MutationID string
+}
+
+// MarkEquivalent marks the survived mutation args.MutationID as equivalent:
+// its saved result is skipped from now on, later runs skip it as well, and
+// neither counts it in the score. Mutation IDs hash the mutated source, so a
+// mutation of a file edited since is a new one and is tested again.
+func (w *workflow) MarkEquivalent(args MarkEquivalentArgs) error {
+	return w.markEquivalent(args.Reports, args.MutationID)
+}
+
+func (w *workflow) markEquivalent(reportsDir m.Path, mutationID string) error {
+	if mutationID == "" {
+		return fmt.Errorf("mutation ID is required")
+	}
+
+	reports, err := w.LoadReports(reportsDir)
+	if err != nil {
+		return fmt.Errorf("load reports: %w", err)
+	}
+
+	result, err := findResult(reports, mutationID)
+	if err != nil {
+		return err
+	}
+
+	if result.Status != m.Survived && !result.Equivalent {
+		return fmt.Errorf("mutation %s %s: only survived mutations can be marked equivalent", result.MutationID, result.Status)
+	}
+
+	if err := w.ReportStore.MarkEquivalent(reportsDir, result.MutationID); err != nil {
+		return fmt.Errorf("mark equivalent: %w", err)
+	}
+
+	if err := w.RegenerateIndex(reportsDir); err != nil {
+		return fmt.Errorf("regenerate index: %w", err)
+	}
+
+	return nil
+}
+
+// findResult returns the only saved result whose mutation ID starts with
+// prefix.
+func findResult(reports []m.Report, prefix string) (m.MutationResult, error) {
+	_, result, err := findReport(reports, prefix)
+	return result, err
+}
+
+// findReport returns the only saved result whose mutation ID starts with
+// prefix, along with the report holding it.
+func findReport(reports []m.Report, prefix string) (m.Report, m.MutationResult, error) {
+	var (
+		matches []m.MutationResult
+		report  m.Report
+	)
+
+	for _, candidate := range reports {
+		for _, result := range candidate.Result {
+			if strings.HasPrefix(result.MutationID, prefix) {
+				matches = append(matches, result)
+				report = candidate
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return m.Report{}, m.MutationResult{}, fmt.Errorf("no mutation %s in the reports", prefix)
+	case 1:
+		return report, matches[0], nil
+	default:
+		return m.Report{}, m.MutationResult{}, fmt.Errorf("mutation ID %s is ambiguous: %d mutations start with it", prefix, len(matches))
+	}
+}
+
+// equivalentMutations reads the IDs of the mutations marked as equivalent
+// in reports.
+func (w *workflow) equivalentMutations(reports m.Path) (map[string]bool, error) {
+	if reports == "" {
+		return nil, nil
+	}
+
+	ids, err := w.LoadEquivalents(reports)
+	if err != nil {
+		return nil, fmt.Errorf("load equivalents: %w", err)
+	}
+
+	equivalents := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		equivalents[id] = true
+	}
+
+	return equivalents, nil
+}
+
+// withoutEquivalents splits off the mutations marked as equivalent,
+// returning the ones left to test and a skipped report for each equivalent
+// one.
+func withoutEquivalents(mutations []m.Mutation, equivalents map[string]bool) ([]m.Mutation, []m.Report) {
+	if len(equivalents) == 0 {
+		return mutations, nil
+	}
+
+	kept := make([]m.Mutation, 0, len(mutations))
+
+	var skipped []m.Report
+
+	for _, mutation := range mutations {
+		if !equivalents[mutation.ID] {
+			kept = append(kept, mutation)
+			continue
+		}
+
+		skipped = append(skipped, m.Report{
+			Source: mutation.Source,
+			Result: m.Result{{
+				MutationID: mutation.ID,
+				Type:       mutation.Type,
+				Status:     m.Skipped,
+				Line:       mutation.Position.Line,
+				Note:       m.EquivalentNote,
+				Equivalent: true,
+			}},
+		})
+	}
+
+	return kept, skipped
+}
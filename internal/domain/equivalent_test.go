@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithoutEquivalents(t *testing.T) {
+	source := m.Source{Origin: &m.File{FullPath: "/project/calc.go"}}
+	mutations := []m.Mutation{
+		{ID: "same", Type: m.MutationArithmetic, Source: source},
+		{ID: "different", Type: m.MutationArithmetic, Source: source},
+	}
+
+	kept, skipped := withoutEquivalents(mutations, map[string]bool{"same": true})
+
+	require.Len(t, kept, 1)
+	assert.Equal(t, "different", kept[0].ID)
+
+	require.Len(t, skipped, 1)
+	assert.Equal(t, source, skipped[0].Source)
+	assert.Equal(t, m.MutationResult{
+		MutationID: "same",
+		Type:       m.MutationArithmetic,
+		Status:     m.Skipped,
+		Note:       m.EquivalentNote,
+		Equivalent: true,
+	}, skipped[0].Result[0])
+
+	kept, skipped = withoutEquivalents(mutations, nil)
+	assert.Equal(t, mutations, kept)
+	assert.Empty(t, skipped)
+}
+
+func TestFindResult(t *testing.T) {
+	reports := []m.Report{
+		{Result: m.Result{{MutationID: "abcd1234"}, {MutationID: "abce5678"}}},
+		{Result: m.Result{{MutationID: "ffff0000"}}},
+	}
+
+	result, err := findResult(reports, "abcd")
+	require.NoError(t, err)
+	assert.Equal(t, "abcd1234", result.MutationID)
+
+	result, err = findResult(reports, "ffff0000")
+	require.NoError(t, err)
+	assert.Equal(t, "ffff0000", result.MutationID)
+
+	_, err = findResult(reports, "abc")
+	require.ErrorContains(t, err, "ambiguous")
+
+	_, err = findResult(reports, "0000")
+	require.ErrorContains(t, err, "no mutation 0000")
+}
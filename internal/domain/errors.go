@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+)
+
+// Errors a run can stop on that users can act on. They are returned wrapped
+// with details, so match them with errors.Is; the CLI maps each one to an
+// exit code and a short message.
+var (
+	// ErrNoSources means no Go source file matched the requested paths.
+	ErrNoSources = errors.New("no Go source files found")
+	// ErrNoTests means none of the sources to mutate has tests that could
+	// kill a mutant.
+	ErrNoTests = errors.New("no tests found for the sources to mutate")
+	// ErrBaselineFailing means the tests fail before anything is mutated,
+	// which would count every mutant as killed.
+	ErrBaselineFailing = errors.New("tests fail without mutations")
+	// ErrDiskBudget means not even one copy of the project fits into the
+	// disk budget of the run's workspaces.
+	ErrDiskBudget = errors.New("project copy exceeds the disk budget")
+	// ErrInterrupted means the run was stopped early; the results of the
+	// mutations tested until then were saved.
+	ErrInterrupted = errors.New("run interrupted")
+	// ErrToolchain means the go command could not be run. It is the
+	// adapters' ErrGoToolchainNotFound, so every go invocation reports it.
+	ErrToolchain = adapter.ErrGoToolchainNotFound
+)
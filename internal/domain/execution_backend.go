@@ -0,0 +1,58 @@
+package domain
+
+import (
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// ExecutionBackend tests mutations on behalf of a run: in workspaces on this
+// machine, or on workers elsewhere.
+//
+// Prepare readies the backend for the mutations of a run tested by up to
+// threads jobs at a time, passing opts on to testers other than the run's
+// own orchestrator, and Release frees what Prepare set up. Submit hands one
+// mutation over as a job and returns a channel that receives its result
+// once the job completes; it is called from several goroutines at once.
+type ExecutionBackend interface {
+	Prepare(mutations []m.Mutation, threads int, opts RunOptions) error
+	Submit(mutation m.Mutation) <-chan JobResult
+	Release() error
+}
+
+// JobResult is the outcome of a submitted mutation job. Err means the
+// mutation could not be tested at all, as opposed to a Killed result.
+type JobResult struct {
+	Result m.MutationResult
+	Err    error
+}
+
+// localBackend tests mutations with an Orchestrator in this process.
+type localBackend struct {
+	orchestrator Orchestrator
+}
+
+// NewLocalBackend returns the ExecutionBackend that tests mutations in
+// copies of the project on this machine, as runs do by default.
+func NewLocalBackend(orchestrator Orchestrator) ExecutionBackend {
+	return &localBackend{orchestrator: orchestrator}
+}
+
+// Prepare leaves the options to the workflow, which sets them on the
+// orchestrator before the baseline.
+func (b *localBackend) Prepare(mutations []m.Mutation, threads int, _ RunOptions) error {
+	return b.orchestrator.PrepareWorkspaces(mutations, threads)
+}
+
+func (b *localBackend) Submit(mutation m.Mutation) <-chan JobResult {
+	results := make(chan JobResult, 1)
+
+	go func() {
+		result, err := b.orchestrator.TestMutation(mutation)
+		results <- JobResult{Result: result, Err: err}
+	}()
+
+	return results
+}
+
+func (b *localBackend) Release() error {
+	return b.orchestrator.ReleaseWorkspaces()
+}
@@ -0,0 +1,11 @@
+//go:build !(linux || darwin || freebsd || openbsd)
+
+package domain
+
+import m "github.com/mouse-blink/gooze/internal/model"
+
+// readFreeTempSpace reports the free space as unknown where statfs is not
+// available.
+func readFreeTempSpace(m.Path) (uint64, bool) {
+	return 0, false
+}
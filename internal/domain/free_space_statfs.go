@@ -0,0 +1,35 @@
+//go:build linux || darwin || freebsd || openbsd
+
+package domain
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// readFreeTempSpace reports the bytes available to unprivileged users on
+// the file system workspaces are created in: dir, or the system temp
+// directory when dir is empty. A dir that does not exist yet is measured on
+// its nearest existing parent.
+func readFreeTempSpace(dir m.Path) (uint64, bool) {
+	path := string(dir)
+	if path == "" {
+		path = os.TempDir()
+	}
+
+	var stat syscall.Statfs_t
+
+	for syscall.Statfs(path, &stat) != nil {
+		parent := filepath.Dir(path)
+		if parent == path {
+			return 0, false
+		}
+
+		path = parent
+	}
+
+	return uint64(stat.Bavail) * uint64(stat.Bsize), true //nolint:unconvert // field types vary by OS.
+}
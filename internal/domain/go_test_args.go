@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// reservedGoTestFlags are the go test flags gooze sets itself or whose
+// output it could no longer read: -c and -json keep it from telling a kill
+// from a pass.
+var reservedGoTestFlags = map[string]bool{
+	"run":          true,
+	"tags":         true,
+	"coverprofile": true,
+	"race":         true,
+	"v":            true,
+	"json":         true,
+	"c":            true,
+}
+
+// validateGoTestArgs accepts only flags, with values in the -flag=value form,
+// since the packages and tests to run are chosen by gooze.
+func validateGoTestArgs(args []string) error {
+	for _, arg := range args {
+		name, ok := strings.CutPrefix(arg, "-")
+		if !ok {
+			return fmt.Errorf("go test argument %q is not a flag; give values as -flag=value", arg)
+		}
+
+		name = strings.TrimPrefix(name, "-")
+		name, _, _ = strings.Cut(name, "=")
+
+		if reservedGoTestFlags[name] {
+			return fmt.Errorf("go test flag %q is set by gooze and cannot be passed through", arg)
+		}
+	}
+
+	return nil
+}
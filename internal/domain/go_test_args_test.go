@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGoTestArgs(t *testing.T) {
+	require.NoError(t, validateGoTestArgs(nil))
+	require.NoError(t, validateGoTestArgs([]string{"-count=1", "-shuffle=on", "--failfast"}))
+
+	for _, args := range [][]string{
+		{"./..."},
+		{"-count", "1"},
+		{"-run=TestAdd"},
+		{"--tags=integration"},
+		{"-v=false"},
+		{"-json"},
+		{"-race"},
+	} {
+		assert.Error(t, validateGoTestArgs(args), "%q", args)
+	}
+}
@@ -93,6 +93,23 @@ type ignoreIndex struct {
 	line      map[int]ignoreRule
 }
 
+// suppresses reports whether a mutation of mutationType on line, inside fd
+// if not nil, is covered by an annotation on the file, above the function,
+// or on or just above the line.
+func (idx ignoreIndex) suppresses(mutationType m.MutationType, fd *ast.FuncDecl, line int) bool {
+	if idx.file.ignores(mutationType) {
+		return true
+	}
+
+	if fd != nil {
+		if rule, ok := idx.funcByPos[fd.Pos()]; ok && rule.ignores(mutationType) {
+			return true
+		}
+	}
+
+	return idx.line[line].ignores(mutationType)
+}
+
 func buildIgnoreIndex(file *ast.File, fset *token.FileSet, content []byte) ignoreIndex {
 	funcByPos, funcDocGroups := buildFuncIgnoreRules(file)
 	fileRule := buildFileIgnoreRule(file)
@@ -226,3 +243,36 @@ func isLeadingComment(line int, slashOffset int, lineStarts []int, content []byt
 
 	return true
 }
+
+// suppressedNote explains the skipped result of a suppressed mutation.
+const suppressedNote = "suppressed by //gooze:ignore"
+
+// withoutSuppressed splits off the mutations //gooze:ignore annotations
+// suppress, returning the ones left to test and a skipped report for each
+// suppressed one, so suppressions stay visible in the reports.
+func withoutSuppressed(mutations []m.Mutation) ([]m.Mutation, []m.Report) {
+	kept := make([]m.Mutation, 0, len(mutations))
+
+	var suppressed []m.Report
+
+	for _, mutation := range mutations {
+		if !mutation.Suppressed {
+			kept = append(kept, mutation)
+			continue
+		}
+
+		suppressed = append(suppressed, m.Report{
+			Source: mutation.Source,
+			Result: m.Result{{
+				MutationID: mutation.ID,
+				Type:       mutation.Type,
+				Status:     m.Skipped,
+				Line:       mutation.Position.Line,
+				Note:       suppressedNote,
+				Suppressed: true,
+			}},
+		})
+	}
+
+	return kept, suppressed
+}
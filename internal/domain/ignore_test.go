@@ -3,6 +3,7 @@ package domain
 import (
 	"go/parser"
 	"go/token"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -116,3 +117,32 @@ func TestBuildIgnoreIndex_FileFuncLineScopes(t *testing.T) {
 		t.Fatalf("expected 2 line-level ignore targets, got %d", len(seenTargets))
 	}
 }
+
+func TestWithoutSuppressed(t *testing.T) {
+	source := m.Source{Origin: &m.File{FullPath: "/project/calc.go"}}
+	mutations := []m.Mutation{
+		{ID: "ignored", Type: m.MutationArithmetic, Source: source, Suppressed: true},
+		{ID: "tested", Type: m.MutationArithmetic, Source: source},
+	}
+
+	kept, suppressed := withoutSuppressed(mutations)
+
+	if len(kept) != 1 || kept[0].ID != "tested" {
+		t.Fatalf("expected only the unsuppressed mutation to be kept, got %v", kept)
+	}
+
+	if len(suppressed) != 1 {
+		t.Fatalf("expected 1 suppressed report, got %d", len(suppressed))
+	}
+
+	want := m.MutationResult{
+		MutationID: "ignored",
+		Type:       m.MutationArithmetic,
+		Status:     m.Skipped,
+		Note:       "suppressed by //gooze:ignore",
+		Suppressed: true,
+	}
+	if got := suppressed[0].Result[0]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected suppressed result: %+v", got)
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// Phases of mutation testing that can fail for infrastructure reasons.
+const (
+	PhaseProjectRoot      = "project_root"
+	PhaseCreateWorkspace  = "create_workspace"
+	PhaseCopyProject      = "copy_project"
+	PhaseWriteMutation    = "write_mutation"
+	PhaseRestoreWorkspace = "restore_workspace"
+	PhaseToolchain        = "toolchain"
+)
+
+// InfraError reports that gooze itself could not test a mutation, as opposed
+// to the tests under mutation failing or passing.
+type InfraError struct {
+	Phase      string
+	MutationID string
+	Path       m.Path
+	Err        error
+}
+
+func (e *InfraError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *InfraError) Unwrap() error {
+	return e.Err
+}
+
+func newInfraError(phase string, mutation m.Mutation, err error) *InfraError {
+	return &InfraError{
+		Phase:      phase,
+		MutationID: mutation.ID,
+		Path:       mutation.Source.Origin.FullPath,
+		Err:        err,
+	}
+}
+
+// saveInfraFailure records the first infrastructure error in err as
+// `_error.yaml` in dir. Errors that are not infrastructure related are left
+// alone, since those runs still produced meaningful results.
+func (w *workflow) saveInfraFailure(dir m.Path, err error) error {
+	var infraErr *InfraError
+	if !errors.As(err, &infraErr) {
+		return nil
+	}
+
+	failure := m.RunFailure{
+		Time:       time.Now(),
+		Phase:      infraErr.Phase,
+		Error:      infraErr.Error(),
+		MutationID: infraErr.MutationID,
+		Path:       infraErr.Path,
+	}
+
+	if saveErr := w.SaveFailure(dir, failure); saveErr != nil {
+		return fmt.Errorf("save failure record: %w", saveErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// interruptedNote explains results left untested by an interrupted run.
+const interruptedNote = "run interrupted"
+
+// haltNote returns the note for mutations that may no longer start, because
+// the run was interrupted or its deadline passed, or "" while testing goes
+// on.
+func (w *workflow) haltNote(deadline time.Time, stop <-chan struct{}) string {
+	if w.interrupted(stop) {
+		return interruptedNote
+	}
+
+	if !deadline.IsZero() && !time.Now().Before(deadline) {
+		return budgetExhaustedNote
+	}
+
+	return ""
+}
+
+// interrupted reports whether stop is closed or the user closed the UI or
+// aborted the run from it. Mutations already being tested are not affected.
+func (w *workflow) interrupted(stop <-chan struct{}) bool {
+	select {
+	case <-stop:
+		return true
+	case <-w.Done():
+		return true
+	case <-w.abort.done():
+		return true
+	default:
+		return false
+	}
+}
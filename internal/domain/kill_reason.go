@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// killReason classifies a failed go test run by its error and output. It
+// returns an empty reason when the output matches none of the known shapes,
+// such as a TestMain exiting non-zero without reporting a failed test.
+func killReason(testErr error, output string) m.KillReason {
+	if errors.Is(testErr, adapter.ErrTestTimeout) {
+		return m.KillTimeout
+	}
+
+	var failedTest bool
+
+	for _, line := range strings.Split(output, "\n") {
+		switch {
+		case strings.HasPrefix(line, "panic: test timed out after"):
+			return m.KillTimeout
+		case line == "WARNING: DATA RACE":
+			return m.KillRace
+		case strings.HasSuffix(line, "[build failed]"), strings.HasSuffix(line, "[setup failed]"):
+			return m.KillBuild
+		case strings.HasPrefix(line, "panic: "), strings.HasPrefix(line, "fatal error: "):
+			return m.KillPanic
+		case strings.Contains(line, "--- FAIL: "):
+			failedTest = true
+		}
+	}
+
+	if failedTest {
+		return m.KillAssertion
+	}
+
+	return ""
+}
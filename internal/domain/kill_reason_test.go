@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKillReason(t *testing.T) {
+	failed := errors.New("exit status 1")
+
+	tests := []struct {
+		name   string
+		err    error
+		output string
+		want   m.KillReason
+	}{
+		{
+			name:   "assertion",
+			err:    failed,
+			output: "=== RUN   TestAdd\n    calc_test.go:9: Add(1, 2) = -1, want 3\n--- FAIL: TestAdd (0.00s)\nFAIL\nFAIL\texample.com/calc\t0.002s\n",
+			want:   m.KillAssertion,
+		},
+		{
+			name:   "panic after the failing test line",
+			err:    failed,
+			output: "=== RUN   TestAt\n--- FAIL: TestAt (0.00s)\npanic: runtime error: index out of range [3] with length 3 [recovered]\n\tpanicked: runtime error\n",
+			want:   m.KillPanic,
+		},
+		{
+			name:   "fatal runtime error",
+			err:    failed,
+			output: "runtime: goroutine stack exceeds 1000000000-byte limit\nfatal error: stack overflow\n",
+			want:   m.KillPanic,
+		},
+		{
+			name:   "compile error",
+			err:    failed,
+			output: "# example.com/calc\n./calc.go:5:9: invalid operation: operator ! not defined on a (variable of type int)\nFAIL\texample.com/calc [build failed]\n",
+			want:   m.KillBuild,
+		},
+		{
+			name:   "data race",
+			err:    failed,
+			output: "==================\nWARNING: DATA RACE\nWrite at 0x00c000012345 by goroutine 8:\n==================\n    testing.go:1490: race detected during execution of test\n--- FAIL: TestInc (0.00s)\n",
+			want:   m.KillRace,
+		},
+		{
+			name:   "go test timeout",
+			err:    failed,
+			output: "=== RUN   TestLoop\npanic: test timed out after 10m0s\n\trunning tests:\n",
+			want:   m.KillTimeout,
+		},
+		{
+			name: "runner timeout",
+			err:  fmt.Errorf("%w after 30s: signal: killed", adapter.ErrTestTimeout),
+			want: m.KillTimeout,
+		},
+		{
+			name:   "unrecognized output",
+			err:    failed,
+			output: "FAIL\texample.com/calc\t0.002s\n",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, killReason(tt.err, tt.output))
+		})
+	}
+}
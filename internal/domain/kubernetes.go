@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// defaultJobReports is where shard jobs write their reports when the local
+// reports directory lies outside the project.
+const defaultJobReports = ".gooze-reports"
+
+// KubernetesArgs contains the arguments for running every shard of a run as
+// a Kubernetes Job.
+type KubernetesArgs struct {
+	// Dir is the directory Paths are relative to, usually the working
+	// directory; the project containing it is uploaded to every job.
+	Dir   m.Path
+	Paths []m.Path
+	// Shards is the number of jobs the mutations are split across.
+	Shards    int
+	Image     string
+	Namespace string
+	CPU       string
+	Memory    string
+	// RunFlags are further gooze run flags for every shard, such as
+	// --test-scope package.
+	RunFlags []string
+	Reports  m.Path
+	Runner   adapter.JobRunner
+	// Timeout, when set, bounds every job until its shard's run exits.
+	Timeout time.Duration
+	ManifestArgs
+}
+
+// Kubernetes runs gooze run for each shard in a Job of its own, on a copy of
+// the project, and merges the shard reports downloaded into args.Reports.
+// The project's reports directory travels with the copy, so the jobs reuse
+// its cached results. Shards that completed are merged even when others
+// failed.
+func (w *workflow) Kubernetes(args KubernetesArgs) error {
+	if args.Shards < 1 {
+		return fmt.Errorf("shards must be at least 1, got %d", args.Shards)
+	}
+
+	if args.Image == "" {
+		return fmt.Errorf("an image providing gooze and go is required")
+	}
+
+	root, err := w.FindProjectRoot(w.JoinPath(string(args.Dir), "go.mod"))
+	if err != nil {
+		return fmt.Errorf("find project root: %w", err)
+	}
+
+	paths, err := projectPaths(root, args.Dir, args.Paths)
+	if err != nil {
+		return err
+	}
+
+	jobs := w.shardJobs(args, root, paths)
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+
+	for _, job := range jobs {
+		wg.Go(func() {
+			if err := w.runShardJob(args.Runner, job); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if err := w.Merge(MergeArgs{Reports: args.Reports, ManifestArgs: args.ManifestArgs}); err != nil {
+		errs = append(errs, fmt.Errorf("merge shard reports: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// runShardJob runs job after removing what an earlier run left in its
+// output directory, which merging would otherwise pick up.
+func (w *workflow) runShardJob(runner adapter.JobRunner, job adapter.KubernetesJob) error {
+	if err := w.RemoveAll(job.Out); err != nil {
+		return fmt.Errorf("clear %s: %w", job.Out, err)
+	}
+
+	return runner.RunJob(job)
+}
+
+// shardJobs describes one job per shard, each running gooze run on its share
+// of paths in the project copy.
+func (w *workflow) shardJobs(args KubernetesArgs, root m.Path, paths []string) []adapter.KubernetesJob {
+	results := jobReports(root, args.Reports)
+	prefix := fmt.Sprintf("gooze-%d", time.Now().Unix())
+	jobs := make([]adapter.KubernetesJob, 0, args.Shards)
+
+	for shard := range args.Shards {
+		command := []string{"gooze", "run", "--no-tui", "-o", results, "-s", fmt.Sprintf("%d/%d", shard, args.Shards)}
+		command = append(command, args.RunFlags...)
+		command = append(command, paths...)
+
+		// A run of one shard writes to the reports directory itself; its
+		// download still goes to a shard directory for Merge to pick up.
+		jobResults := filepath.ToSlash(string(shardReportsDir(m.Path(results), shard, args.Shards)))
+
+		jobs = append(jobs, adapter.KubernetesJob{
+			Name:      fmt.Sprintf("%s-%d", prefix, shard),
+			Namespace: args.Namespace,
+			Image:     args.Image,
+			CPU:       args.CPU,
+			Memory:    args.Memory,
+			Workspace: root,
+			Command:   command,
+			Results:   jobResults,
+			Out:       w.JoinPath(string(args.Reports), fmt.Sprintf("%s%d", ShardDirPrefix, shard)),
+			Timeout:   args.Timeout,
+		})
+	}
+
+	return jobs
+}
+
+// jobReports returns the reports directory relative to the project root,
+// for the jobs to find the cached results uploaded with the project.
+func jobReports(root, reports m.Path) string {
+	abs, err := filepath.Abs(string(reports))
+	if err != nil {
+		return defaultJobReports
+	}
+
+	rel, err := filepath.Rel(string(root), abs)
+	if err != nil || rel == "." || !filepath.IsLocal(rel) {
+		return defaultJobReports
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// projectPaths rewrites paths given relative to dir, such as ./pkg/...,
+// relative to the project root the jobs run gooze in. No paths means the
+// whole of dir.
+func projectPaths(root, dir m.Path, paths []m.Path) ([]string, error) {
+	if len(paths) == 0 {
+		paths = []m.Path{"./..."}
+	}
+
+	rewritten := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		base, recursive := strings.CutSuffix(string(path), "...")
+		base = strings.TrimSuffix(base, "/")
+
+		if base == "" {
+			base = "."
+		}
+
+		if !filepath.IsAbs(base) {
+			base = filepath.Join(string(dir), base)
+		}
+
+		rel, err := filepath.Rel(string(root), base)
+		if err != nil || !filepath.IsLocal(rel) {
+			return nil, fmt.Errorf("path %s is outside the project %s", path, root)
+		}
+
+		project := "./" + filepath.ToSlash(rel)
+		if rel == "." {
+			project = "."
+		}
+
+		if recursive {
+			project += "/..."
+		}
+
+		rewritten = append(rewritten, project)
+	}
+
+	return rewritten, nil
+}
@@ -0,0 +1,136 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func kubernetesProject(t *testing.T) m.Path {
+	t.Helper()
+
+	root := newProject(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(string(root), "pkg"), 0o750))
+
+	return root
+}
+
+// saveShardReport stands in for a job: it writes one report into the
+// job's download directory.
+func saveShardReport(store adapter.ReportStore, root m.Path) func(adapter.KubernetesJob) error {
+	return func(job adapter.KubernetesJob) error {
+		name := filepath.Base(string(job.Out)) + ".go"
+		source := m.Source{Origin: &m.File{ShortPath: m.Path(name), FullPath: m.Path(filepath.Join(string(root), name)), Hash: name}}
+
+		return store.SaveReports(job.Out, []m.Report{{
+			Source: source,
+			Result: m.Result{{MutationID: name, Type: m.MutationArithmetic, Status: m.Killed}},
+		}})
+	}
+}
+
+func TestWorkflow_Kubernetes_RunsShardJobsAndMergesReports(t *testing.T) {
+	root := kubernetesProject(t)
+	reports := m.Path(filepath.Join(string(root), ".gooze-reports"))
+	store := adapter.NewReportStore()
+
+	runner := adaptermocks.NewMockJobRunner(t)
+	for shard := range 2 {
+		runner.EXPECT().RunJob(mock.MatchedBy(func(job adapter.KubernetesJob) bool {
+			return job.Workspace == root &&
+				job.Image == "registry.example.com/gooze" &&
+				job.Namespace == "ci" &&
+				job.Results == fmt.Sprintf(".gooze-reports/shard_%d", shard) &&
+				job.Timeout == time.Hour &&
+				slices.Equal(job.Command, []string{
+					"gooze", "run", "--no-tui", "-o", ".gooze-reports", "-s", fmt.Sprintf("%d/2", shard),
+					"--test-scope", "package", "./pkg/...",
+				})
+		})).RunAndReturn(saveShardReport(store, root)).Once()
+	}
+
+	workflow := domain.NewWorkflow(
+		domain.WithSourceFS(adapter.NewLocalSourceFSAdapter()),
+		domain.WithReportStore(store),
+	)
+
+	err := workflow.Kubernetes(domain.KubernetesArgs{
+		Dir:       m.Path(filepath.Join(string(root), "pkg")),
+		Paths:     []m.Path{"./..."},
+		Shards:    2,
+		Image:     "registry.example.com/gooze",
+		Namespace: "ci",
+		RunFlags:  []string{"--test-scope", "package"},
+		Timeout:   time.Hour,
+		Reports:   reports,
+		Runner:    runner,
+	})
+	require.NoError(t, err)
+
+	merged, err := store.LoadReports(reports)
+	require.NoError(t, err)
+	assert.Len(t, merged, 2)
+	assert.NoDirExists(t, filepath.Join(string(reports), "shard_0"))
+}
+
+func TestWorkflow_Kubernetes_MergesCompletedShardsWhenOneFails(t *testing.T) {
+	root := kubernetesProject(t)
+	reports := m.Path(filepath.Join(string(root), ".gooze-reports"))
+	store := adapter.NewReportStore()
+
+	runner := adaptermocks.NewMockJobRunner(t)
+	runner.EXPECT().RunJob(mock.MatchedBy(func(job adapter.KubernetesJob) bool {
+		return job.Results == ".gooze-reports/shard_0"
+	})).RunAndReturn(saveShardReport(store, root)).Once()
+	runner.EXPECT().RunJob(mock.MatchedBy(func(job adapter.KubernetesJob) bool {
+		return job.Results == ".gooze-reports/shard_1"
+	})).Return(errors.New("job gooze-1-1: pod stopped before gooze exited")).Once()
+
+	workflow := domain.NewWorkflow(
+		domain.WithSourceFS(adapter.NewLocalSourceFSAdapter()),
+		domain.WithReportStore(store),
+	)
+
+	err := workflow.Kubernetes(domain.KubernetesArgs{
+		Dir:     root,
+		Shards:  2,
+		Image:   "registry.example.com/gooze",
+		Reports: reports,
+		Runner:  runner,
+	})
+	require.ErrorContains(t, err, "pod stopped before gooze exited")
+
+	merged, err := store.LoadReports(reports)
+	require.NoError(t, err)
+	assert.Len(t, merged, 1)
+}
+
+func TestWorkflow_Kubernetes_RejectsPathsOutsideTheProject(t *testing.T) {
+	root := kubernetesProject(t)
+	workflow := domain.NewWorkflow(
+		domain.WithSourceFS(adapter.NewLocalSourceFSAdapter()),
+		domain.WithReportStore(adapter.NewReportStore()),
+	)
+
+	err := workflow.Kubernetes(domain.KubernetesArgs{
+		Dir:     root,
+		Paths:   []m.Path{"../other/..."},
+		Shards:  2,
+		Image:   "registry.example.com/gooze",
+		Reports: m.Path(filepath.Join(string(root), ".gooze-reports")),
+		Runner:  adaptermocks.NewMockJobRunner(t),
+	})
+	require.ErrorContains(t, err, "outside the project")
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// LineRange selects the lines Start through End of a file, both included.
+// The zero LineRange selects every line.
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// ParseLineRange parses a line range written as START-END, or as a single
+// line number.
+func ParseLineRange(text string) (LineRange, error) {
+	start, end, isRange := strings.Cut(text, "-")
+	if !isRange {
+		end = start
+	}
+
+	first, err := strconv.Atoi(strings.TrimSpace(start))
+	if err != nil {
+		return LineRange{}, fmt.Errorf("invalid line range %q: want START-END or a line number", text)
+	}
+
+	last, err := strconv.Atoi(strings.TrimSpace(end))
+	if err != nil {
+		return LineRange{}, fmt.Errorf("invalid line range %q: want START-END or a line number", text)
+	}
+
+	return LineRange{Start: first, End: last}, nil
+}
+
+func (r LineRange) String() string {
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+func (r LineRange) isZero() bool {
+	return r == LineRange{}
+}
+
+// validateLineRange accepts the zero range, and otherwise a non-empty range
+// of lines over a single Go file.
+func validateLineRange(lines LineRange, paths []m.Path) error {
+	if lines.isZero() {
+		return nil
+	}
+
+	if lines.Start < 1 || lines.End < lines.Start {
+		return fmt.Errorf("invalid line range %s: lines start at 1 and the range must not be empty", lines)
+	}
+
+	if len(paths) != 1 || !strings.HasSuffix(string(paths[0]), ".go") {
+		return fmt.Errorf("line range %s needs a single .go file, got %s", lines, describePaths(paths))
+	}
+
+	return nil
+}
+
+// withLineRange keeps the mutations starting within lines.
+func withLineRange(mutations []m.Mutation, lines LineRange) []m.Mutation {
+	if lines.isZero() {
+		return mutations
+	}
+
+	kept := make([]m.Mutation, 0, len(mutations))
+
+	for _, mutation := range mutations {
+		if mutation.Position.Line >= lines.Start && mutation.Position.Line <= lines.End {
+			kept = append(kept, mutation)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLineRange(t *testing.T) {
+	lines, err := ParseLineRange("40-120")
+	require.NoError(t, err)
+	assert.Equal(t, LineRange{Start: 40, End: 120}, lines)
+
+	lines, err = ParseLineRange("7")
+	require.NoError(t, err)
+	assert.Equal(t, LineRange{Start: 7, End: 7}, lines)
+
+	_, err = ParseLineRange("40-")
+	require.ErrorContains(t, err, "invalid line range")
+
+	_, err = ParseLineRange("start-end")
+	require.ErrorContains(t, err, "invalid line range")
+}
+
+func TestValidateLineRange(t *testing.T) {
+	file := []m.Path{"./pkg/calc.go"}
+
+	require.NoError(t, validateLineRange(LineRange{}, nil))
+	require.NoError(t, validateLineRange(LineRange{Start: 40, End: 120}, file))
+	require.ErrorContains(t, validateLineRange(LineRange{Start: 0, End: 3}, file), "lines start at 1")
+	require.ErrorContains(t, validateLineRange(LineRange{Start: 9, End: 3}, file), "must not be empty")
+	require.ErrorContains(t, validateLineRange(LineRange{Start: 1, End: 3}, []m.Path{"./pkg/..."}), "needs a single .go file")
+	require.ErrorContains(t, validateLineRange(LineRange{Start: 1, End: 3}, []m.Path{"a.go", "b.go"}), "needs a single .go file")
+}
+
+func TestWithLineRange(t *testing.T) {
+	mutations := []m.Mutation{
+		{ID: "before", Position: m.Position{Line: 39}},
+		{ID: "first", Position: m.Position{Line: 40}},
+		{ID: "last", Position: m.Position{Line: 120}},
+		{ID: "after", Position: m.Position{Line: 121}},
+	}
+
+	kept := withLineRange(mutations, LineRange{Start: 40, End: 120})
+
+	require.Len(t, kept, 2)
+	assert.Equal(t, "first", kept[0].ID)
+	assert.Equal(t, "last", kept[1].ID)
+	assert.Equal(t, mutations, withLineRange(mutations, LineRange{}))
+}
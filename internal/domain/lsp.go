@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultLSPPollInterval is how often the language server checks the reports
+// directory for new results.
+const DefaultLSPPollInterval = 2 * time.Second
+
+// LSPArgs contains the arguments for serving diagnostics over the Language
+// Server Protocol.
+type LSPArgs struct {
+	Reports m.Path
+	// PollInterval is how often Reports is checked for new results; zero
+	// means DefaultLSPPollInterval.
+	PollInterval time.Duration
+	In           io.Reader
+	Out          io.Writer
+}
+
+// LSP serves the survived mutations stored in args.Reports as diagnostics to
+// a language client on args.In and args.Out until the client exits. Results
+// are reloaded whenever a run saves new reports, and the open documents'
+// diagnostics published again.
+func (w *workflow) LSP(args LSPArgs) error {
+	interval := args.PollInterval
+	if interval <= 0 {
+		interval = DefaultLSPPollInterval
+	}
+
+	session := &lspSession{w: w, reports: args.Reports}
+	session.reload()
+
+	server := adapter.NewLSPServer(args.In, args.Out, session.diagnostics)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go session.watch(server, interval, done)
+
+	if err := server.Serve(); err != nil {
+		return fmt.Errorf("serve lsp: %w", err)
+	}
+
+	return nil
+}
+
+// lspSession keeps the reports the language server answers from, grouped by
+// source file.
+type lspSession struct {
+	w       *workflow
+	reports m.Path
+
+	mu     sync.Mutex
+	stamp  reportsStamp
+	byPath map[m.Path][]m.Report
+}
+
+// reportsStamp identifies a version of the reports directory by its index.
+type reportsStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func (s *lspSession) watch(server *adapter.LSPServer, interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if !s.reload() {
+				continue
+			}
+
+			if err := server.Refresh(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// reload loads the reports again when their index changed since the last
+// load and reports whether it did. A failed load, such as one racing a run
+// that is still writing, is retried on the next call.
+func (s *lspSession) reload() bool {
+	var stamp reportsStamp
+
+	if info, err := s.w.FileInfo(adapter.IndexPath(s.reports)); err == nil {
+		stamp = reportsStamp{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	s.mu.Lock()
+	unchanged := stamp == s.stamp
+	s.mu.Unlock()
+
+	if unchanged {
+		return false
+	}
+
+	byPath := make(map[m.Path][]m.Report)
+
+	if stamp != (reportsStamp{}) {
+		reports, err := s.w.LoadReports(s.reports)
+		if err != nil {
+			return false
+		}
+
+		for _, report := range reports {
+			if report.Source.Origin == nil {
+				continue
+			}
+
+			path := m.Path(filepath.Clean(string(report.Source.Origin.FullPath)))
+			byPath[path] = append(byPath[path], report)
+		}
+	}
+
+	s.mu.Lock()
+	s.stamp = stamp
+	s.byPath = byPath
+	s.mu.Unlock()
+
+	return true
+}
+
+// diagnostics locates the survived mutations of the file at path. Results
+// recorded for other content than the file now has are stale and left out;
+// for current ones, regenerating the mutations yields the same IDs along
+// with their positions.
+func (s *lspSession) diagnostics(path m.Path) ([]m.Diagnostic, error) {
+	s.mu.Lock()
+	reports := s.byPath[m.Path(filepath.Clean(string(path)))]
+	s.mu.Unlock()
+
+	if len(reports) == 0 {
+		return nil, nil
+	}
+
+	hash, err := s.w.HashFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("hash source: %w", err)
+	}
+
+	source := reports[0].Source
+	if source.Origin.Hash != hash {
+		return nil, nil
+	}
+
+	mutations, err := s.w.GenerateMutation(source, m.MutationTypes...)
+	if err != nil {
+		return nil, fmt.Errorf("generate mutations: %w", err)
+	}
+
+	return survivorDiagnostics(mutations, reports), nil
+}
@@ -0,0 +1,186 @@
+package domain_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	domain "github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lspSession(t *testing.T, messages ...map[string]any) *bytes.Buffer {
+	t.Helper()
+
+	var in bytes.Buffer
+
+	for _, message := range messages {
+		body, err := json.Marshal(message)
+		require.NoError(t, err)
+
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	}
+
+	return &in
+}
+
+func lspOpenSession(t *testing.T, uri string) *bytes.Buffer {
+	t.Helper()
+
+	return lspSession(t,
+		map[string]any{"jsonrpc": "2.0", "id": 1, "method": "initialize", "params": map[string]any{}},
+		map[string]any{"jsonrpc": "2.0", "method": "initialized", "params": map[string]any{}},
+		map[string]any{"jsonrpc": "2.0", "method": "textDocument/didOpen", "params": map[string]any{
+			"textDocument": map[string]any{"uri": uri, "languageId": "go", "version": 1, "text": ""},
+		}},
+		map[string]any{"jsonrpc": "2.0", "id": 2, "method": "shutdown"},
+		map[string]any{"jsonrpc": "2.0", "method": "exit"},
+	)
+}
+
+func indexFileInfo(t *testing.T) os.FileInfo {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "_index.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("total_mutations: 2\n"), 0o600))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	return info
+}
+
+func TestWorkflow_LSP_PublishesSurvivorsOfOpenDocuments(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{Origin: &m.File{ShortPath: "calc.go", FullPath: "/project/calc.go", Hash: "hash1"}}
+	reports := []m.Report{
+		{Source: source, Result: m.Result{{MutationID: "m1", Type: m.MutationArithmetic, Status: m.Survived}}},
+		{Source: source, Result: m.Result{{MutationID: "m2", Type: m.MutationBoolean, Status: m.Killed}}},
+	}
+	mutations := []m.Mutation{
+		{ID: "m1", Source: source, Type: m.MutationArithmetic, Position: m.Position{Line: 5, Column: 11}, Func: "Add"},
+		{ID: "m2", Source: source, Type: m.MutationBoolean, Position: m.Position{Line: 9, Column: 9}},
+	}
+
+	mockFSAdapter.EXPECT().FileInfo(adapter.IndexPath("reports")).Return(indexFileInfo(t), nil)
+	mockReportStore.EXPECT().LoadReports(m.Path("reports")).Return(reports, nil).Once()
+	mockFSAdapter.EXPECT().HashFile(m.Path("/project/calc.go")).Return("hash1", nil)
+	corpusMutagenCall(mockMutagen, source).Return(mutations, nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	var out bytes.Buffer
+
+	// Act
+	err := wf.LSP(domain.LSPArgs{
+		Reports:      "reports",
+		PollInterval: time.Hour,
+		In:           lspOpenSession(t, "file:///project/calc.go"),
+		Out:          &out,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), `"method":"textDocument/publishDiagnostics"`)
+	assert.Contains(t, out.String(), `"start":{"line":4,"character":10}`)
+	assert.Contains(t, out.String(), "arithmetic mutation survived in Add (m1)")
+	assert.NotContains(t, out.String(), "m2")
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestWorkflow_LSP_SkipsChangedFiles(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{Origin: &m.File{FullPath: "/project/calc.go", Hash: "hash1"}}
+	reports := []m.Report{
+		{Source: source, Result: m.Result{{MutationID: "m1", Type: m.MutationArithmetic, Status: m.Survived}}},
+	}
+
+	mockFSAdapter.EXPECT().FileInfo(adapter.IndexPath("reports")).Return(indexFileInfo(t), nil)
+	mockReportStore.EXPECT().LoadReports(m.Path("reports")).Return(reports, nil).Once()
+	mockFSAdapter.EXPECT().HashFile(m.Path("/project/calc.go")).Return("edited", nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	var out bytes.Buffer
+
+	// Act
+	err := wf.LSP(domain.LSPArgs{
+		Reports:      "reports",
+		PollInterval: time.Hour,
+		In:           lspOpenSession(t, "file:///project/calc.go"),
+		Out:          &out,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), `"diagnostics":[]`)
+	mockMutagen.AssertNotCalled(t, "GenerateMutation")
+}
+
+func TestWorkflow_LSP_WithoutReports(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	mockFSAdapter.EXPECT().FileInfo(adapter.IndexPath("reports")).Return(nil, os.ErrNotExist)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	var out bytes.Buffer
+
+	// Act
+	err := wf.LSP(domain.LSPArgs{
+		Reports:      "reports",
+		PollInterval: time.Hour,
+		In:           lspOpenSession(t, "file:///project/calc.go"),
+		Out:          &out,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), `"diagnostics":[]`)
+	mockReportStore.AssertNotCalled(t, "LoadReports")
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// ManifestArgs identifies the invocation that produced a reports directory,
+// for the manifest written next to its reports.
+type ManifestArgs struct {
+	// Command is the command line gooze was invoked with.
+	Command []string
+	// ManifestKey, when set, is an ed25519 private key (PKCS#8 PEM) used to
+	// sign the manifest.
+	ManifestKey m.Path
+}
+
+func (w *workflow) saveManifest(dir m.Path, args ManifestArgs, config map[string]string) error {
+	manifest := m.RunManifest{
+		Time:    time.Now(),
+		Command: args.Command,
+		Config:  config,
+	}
+
+	if err := w.SaveManifest(dir, manifest, args.ManifestKey); err != nil {
+		return fmt.Errorf("save manifest: %w", err)
+	}
+
+	return nil
+}
+
+// runConfig records the settings of a run that decide which mutations were
+// tested and how, keyed by their flag names.
+func runConfig(args TestArgs) map[string]string {
+	paths := make([]string, 0, len(args.Paths))
+	for _, path := range args.Paths {
+		paths = append(paths, string(path))
+	}
+
+	config := map[string]string{
+		"paths":                strings.Join(paths, " "),
+		"output":               string(args.Reports),
+		"no-cache":             strconv.FormatBool(!args.UseCache),
+		"parallel":             strconv.Itoa(args.Threads),
+		"include-test-helpers": strconv.FormatBool(args.IncludeTestHelpers),
+	}
+
+	if len(args.Exclude) > 0 {
+		config["exclude"] = strings.Join(args.Exclude, " ")
+	}
+
+	if len(args.Tags) > 0 {
+		config["tags"] = strings.Join(args.Tags, ",")
+	}
+
+	if args.TotalShardCount > 1 {
+		config["shard"] = fmt.Sprintf("%d/%d", args.ShardIndex, args.TotalShardCount)
+	}
+
+	if args.ShardStrategy != "" {
+		config["shard-strategy"] = string(args.ShardStrategy)
+	}
+
+	if args.JUnitOut != "" {
+		config["junit-out"] = string(args.JUnitOut)
+	}
+
+	if args.DiagnosticsOut != "" {
+		config["diagnostics-out"] = string(args.DiagnosticsOut)
+	}
+
+	if args.DiagnosticsFormat != "" {
+		config["diagnostics-format"] = string(args.DiagnosticsFormat)
+	}
+
+	if args.Timeout > 0 {
+		config["timeout"] = args.Timeout.String()
+	}
+
+	if len(args.TimeoutMultipliers) > 0 {
+		multipliers := make([]string, 0, len(args.TimeoutMultipliers))
+		for name, factor := range args.TimeoutMultipliers {
+			multipliers = append(multipliers, name+"="+strconv.FormatFloat(factor, 'g', -1, 64))
+		}
+
+		sort.Strings(multipliers)
+		config["timeout-multiplier"] = strings.Join(multipliers, ",")
+	}
+
+	if args.TestScope != "" {
+		config["test-scope"] = string(args.TestScope)
+	}
+
+	if len(args.RunTemplates) > 0 {
+		config["func-test-template"] = strings.Join(args.RunTemplates, ",")
+	}
+
+	if args.QuarantineAfter > 0 {
+		config["quarantine-after"] = strconv.Itoa(args.QuarantineAfter)
+	}
+
+	if args.MaxDuration > 0 {
+		config["max-duration"] = args.MaxDuration.String()
+	}
+
+	if args.RedactCode {
+		config["redact-code"] = "true"
+	}
+
+	return config
+}
+
+func mergeConfig(base m.Path) map[string]string {
+	return map[string]string{"output": string(base)}
+}
@@ -3,8 +3,11 @@
 package mocks
 
 import (
+	domain "github.com/mouse-blink/gooze/internal/domain"
 	model "github.com/mouse-blink/gooze/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockOrchestrator is an autogenerated mock type for the Orchestrator type
@@ -20,25 +23,319 @@ func (_m *MockOrchestrator) EXPECT() *MockOrchestrator_Expecter {
 	return &MockOrchestrator_Expecter{mock: &_m.Mock}
 }
 
+// Baseline provides a mock function with given fields: mutations
+func (_m *MockOrchestrator) Baseline(mutations []model.Mutation) error {
+	ret := _m.Called(mutations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Baseline")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]model.Mutation) error); ok {
+		r0 = rf(mutations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOrchestrator_Baseline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Baseline'
+type MockOrchestrator_Baseline_Call struct {
+	*mock.Call
+}
+
+// Baseline is a helper method to define mock.On call
+//   - mutations []model.Mutation
+func (_e *MockOrchestrator_Expecter) Baseline(mutations interface{}) *MockOrchestrator_Baseline_Call {
+	return &MockOrchestrator_Baseline_Call{Call: _e.mock.On("Baseline", mutations)}
+}
+
+func (_c *MockOrchestrator_Baseline_Call) Run(run func(mutations []model.Mutation)) *MockOrchestrator_Baseline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.Mutation))
+	})
+	return _c
+}
+
+func (_c *MockOrchestrator_Baseline_Call) Return(_a0 error) *MockOrchestrator_Baseline_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrchestrator_Baseline_Call) RunAndReturn(run func([]model.Mutation) error) *MockOrchestrator_Baseline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BaselineTimes provides a mock function with given fields: mutations
+func (_m *MockOrchestrator) BaselineTimes(mutations []model.Mutation) (map[string]time.Duration, int, error) {
+	ret := _m.Called(mutations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BaselineTimes")
+	}
+
+	var r0 map[string]time.Duration
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func([]model.Mutation) (map[string]time.Duration, int, error)); ok {
+		return rf(mutations)
+	}
+	if rf, ok := ret.Get(0).(func([]model.Mutation) map[string]time.Duration); ok {
+		r0 = rf(mutations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]time.Duration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]model.Mutation) int); ok {
+		r1 = rf(mutations)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func([]model.Mutation) error); ok {
+		r2 = rf(mutations)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockOrchestrator_BaselineTimes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BaselineTimes'
+type MockOrchestrator_BaselineTimes_Call struct {
+	*mock.Call
+}
+
+// BaselineTimes is a helper method to define mock.On call
+//   - mutations []model.Mutation
+func (_e *MockOrchestrator_Expecter) BaselineTimes(mutations interface{}) *MockOrchestrator_BaselineTimes_Call {
+	return &MockOrchestrator_BaselineTimes_Call{Call: _e.mock.On("BaselineTimes", mutations)}
+}
+
+func (_c *MockOrchestrator_BaselineTimes_Call) Run(run func(mutations []model.Mutation)) *MockOrchestrator_BaselineTimes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.Mutation))
+	})
+	return _c
+}
+
+func (_c *MockOrchestrator_BaselineTimes_Call) Return(_a0 map[string]time.Duration, _a1 int, _a2 error) *MockOrchestrator_BaselineTimes_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockOrchestrator_BaselineTimes_Call) RunAndReturn(run func([]model.Mutation) (map[string]time.Duration, int, error)) *MockOrchestrator_BaselineTimes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Coverage provides a mock function with given fields: mutations, tags, timeout
+func (_m *MockOrchestrator) Coverage(mutations []model.Mutation, tags []string, timeout time.Duration) (model.Coverage, error) {
+	ret := _m.Called(mutations, tags, timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Coverage")
+	}
+
+	var r0 model.Coverage
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]model.Mutation, []string, time.Duration) (model.Coverage, error)); ok {
+		return rf(mutations, tags, timeout)
+	}
+	if rf, ok := ret.Get(0).(func([]model.Mutation, []string, time.Duration) model.Coverage); ok {
+		r0 = rf(mutations, tags, timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Coverage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]model.Mutation, []string, time.Duration) error); ok {
+		r1 = rf(mutations, tags, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrchestrator_Coverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Coverage'
+type MockOrchestrator_Coverage_Call struct {
+	*mock.Call
+}
+
+// Coverage is a helper method to define mock.On call
+//   - mutations []model.Mutation
+//   - tags []string
+//   - timeout time.Duration
+func (_e *MockOrchestrator_Expecter) Coverage(mutations interface{}, tags interface{}, timeout interface{}) *MockOrchestrator_Coverage_Call {
+	return &MockOrchestrator_Coverage_Call{Call: _e.mock.On("Coverage", mutations, tags, timeout)}
+}
+
+func (_c *MockOrchestrator_Coverage_Call) Run(run func(mutations []model.Mutation, tags []string, timeout time.Duration)) *MockOrchestrator_Coverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.Mutation), args[1].([]string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockOrchestrator_Coverage_Call) Return(_a0 model.Coverage, _a1 error) *MockOrchestrator_Coverage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrchestrator_Coverage_Call) RunAndReturn(run func([]model.Mutation, []string, time.Duration) (model.Coverage, error)) *MockOrchestrator_Coverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PrepareWorkspaces provides a mock function with given fields: mutations, threads
+func (_m *MockOrchestrator) PrepareWorkspaces(mutations []model.Mutation, threads int) error {
+	ret := _m.Called(mutations, threads)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrepareWorkspaces")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]model.Mutation, int) error); ok {
+		r0 = rf(mutations, threads)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOrchestrator_PrepareWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrepareWorkspaces'
+type MockOrchestrator_PrepareWorkspaces_Call struct {
+	*mock.Call
+}
+
+// PrepareWorkspaces is a helper method to define mock.On call
+//   - mutations []model.Mutation
+//   - threads int
+func (_e *MockOrchestrator_Expecter) PrepareWorkspaces(mutations interface{}, threads interface{}) *MockOrchestrator_PrepareWorkspaces_Call {
+	return &MockOrchestrator_PrepareWorkspaces_Call{Call: _e.mock.On("PrepareWorkspaces", mutations, threads)}
+}
+
+func (_c *MockOrchestrator_PrepareWorkspaces_Call) Run(run func(mutations []model.Mutation, threads int)) *MockOrchestrator_PrepareWorkspaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.Mutation), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockOrchestrator_PrepareWorkspaces_Call) Return(_a0 error) *MockOrchestrator_PrepareWorkspaces_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrchestrator_PrepareWorkspaces_Call) RunAndReturn(run func([]model.Mutation, int) error) *MockOrchestrator_PrepareWorkspaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseWorkspaces provides a mock function with no fields
+func (_m *MockOrchestrator) ReleaseWorkspaces() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseWorkspaces")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOrchestrator_ReleaseWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseWorkspaces'
+type MockOrchestrator_ReleaseWorkspaces_Call struct {
+	*mock.Call
+}
+
+// ReleaseWorkspaces is a helper method to define mock.On call
+func (_e *MockOrchestrator_Expecter) ReleaseWorkspaces() *MockOrchestrator_ReleaseWorkspaces_Call {
+	return &MockOrchestrator_ReleaseWorkspaces_Call{Call: _e.mock.On("ReleaseWorkspaces")}
+}
+
+func (_c *MockOrchestrator_ReleaseWorkspaces_Call) Run(run func()) *MockOrchestrator_ReleaseWorkspaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrchestrator_ReleaseWorkspaces_Call) Return(_a0 error) *MockOrchestrator_ReleaseWorkspaces_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrchestrator_ReleaseWorkspaces_Call) RunAndReturn(run func() error) *MockOrchestrator_ReleaseWorkspaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRunOptions provides a mock function with given fields: opts
+func (_m *MockOrchestrator) SetRunOptions(opts domain.RunOptions) {
+	_m.Called(opts)
+}
+
+// MockOrchestrator_SetRunOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRunOptions'
+type MockOrchestrator_SetRunOptions_Call struct {
+	*mock.Call
+}
+
+// SetRunOptions is a helper method to define mock.On call
+//   - opts domain.RunOptions
+func (_e *MockOrchestrator_Expecter) SetRunOptions(opts interface{}) *MockOrchestrator_SetRunOptions_Call {
+	return &MockOrchestrator_SetRunOptions_Call{Call: _e.mock.On("SetRunOptions", opts)}
+}
+
+func (_c *MockOrchestrator_SetRunOptions_Call) Run(run func(opts domain.RunOptions)) *MockOrchestrator_SetRunOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.RunOptions))
+	})
+	return _c
+}
+
+func (_c *MockOrchestrator_SetRunOptions_Call) Return() *MockOrchestrator_SetRunOptions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockOrchestrator_SetRunOptions_Call) RunAndReturn(run func(domain.RunOptions)) *MockOrchestrator_SetRunOptions_Call {
+	_c.Run(run)
+	return _c
+}
+
 // TestMutation provides a mock function with given fields: mutation
-func (_m *MockOrchestrator) TestMutation(mutation model.Mutation) (model.Result, error) {
+func (_m *MockOrchestrator) TestMutation(mutation model.Mutation) (model.MutationResult, error) {
 	ret := _m.Called(mutation)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TestMutation")
 	}
 
-	var r0 model.Result
+	var r0 model.MutationResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Mutation) (model.Result, error)); ok {
+	if rf, ok := ret.Get(0).(func(model.Mutation) (model.MutationResult, error)); ok {
 		return rf(mutation)
 	}
-	if rf, ok := ret.Get(0).(func(model.Mutation) model.Result); ok {
+	if rf, ok := ret.Get(0).(func(model.Mutation) model.MutationResult); ok {
 		r0 = rf(mutation)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.Result)
-		}
+		r0 = ret.Get(0).(model.MutationResult)
 	}
 
 	if rf, ok := ret.Get(1).(func(model.Mutation) error); ok {
@@ -68,12 +365,12 @@ func (_c *MockOrchestrator_TestMutation_Call) Run(run func(mutation model.Mutati
 	return _c
 }
 
-func (_c *MockOrchestrator_TestMutation_Call) Return(_a0 model.Result, _a1 error) *MockOrchestrator_TestMutation_Call {
+func (_c *MockOrchestrator_TestMutation_Call) Return(_a0 model.MutationResult, _a1 error) *MockOrchestrator_TestMutation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockOrchestrator_TestMutation_Call) RunAndReturn(run func(model.Mutation) (model.Result, error)) *MockOrchestrator_TestMutation_Call {
+func (_c *MockOrchestrator_TestMutation_Call) RunAndReturn(run func(model.Mutation) (model.MutationResult, error)) *MockOrchestrator_TestMutation_Call {
 	_c.Call.Return(run)
 	return _c
 }
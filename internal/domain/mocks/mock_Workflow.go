@@ -20,16 +20,614 @@ func (_m *MockWorkflow) EXPECT() *MockWorkflow_Expecter {
 	return &MockWorkflow_Expecter{mock: &_m.Mock}
 }
 
+// Apply provides a mock function with given fields: args
+func (_m *MockWorkflow) Apply(args domain.ApplyArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.ApplyArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type MockWorkflow_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - args domain.ApplyArgs
+func (_e *MockWorkflow_Expecter) Apply(args interface{}) *MockWorkflow_Apply_Call {
+	return &MockWorkflow_Apply_Call{Call: _e.mock.On("Apply", args)}
+}
+
+func (_c *MockWorkflow_Apply_Call) Run(run func(args domain.ApplyArgs)) *MockWorkflow_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.ApplyArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Apply_Call) Return(_a0 error) *MockWorkflow_Apply_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Apply_Call) RunAndReturn(run func(domain.ApplyArgs) error) *MockWorkflow_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Badge provides a mock function with given fields: args
+func (_m *MockWorkflow) Badge(args domain.BadgeArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Badge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.BadgeArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Badge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Badge'
+type MockWorkflow_Badge_Call struct {
+	*mock.Call
+}
+
+// Badge is a helper method to define mock.On call
+//   - args domain.BadgeArgs
+func (_e *MockWorkflow_Expecter) Badge(args interface{}) *MockWorkflow_Badge_Call {
+	return &MockWorkflow_Badge_Call{Call: _e.mock.On("Badge", args)}
+}
+
+func (_c *MockWorkflow_Badge_Call) Run(run func(args domain.BadgeArgs)) *MockWorkflow_Badge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.BadgeArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Badge_Call) Return(_a0 error) *MockWorkflow_Badge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Badge_Call) RunAndReturn(run func(domain.BadgeArgs) error) *MockWorkflow_Badge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cache provides a mock function with given fields: args
+func (_m *MockWorkflow) Cache(args domain.CacheArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cache")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.CacheArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Cache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cache'
+type MockWorkflow_Cache_Call struct {
+	*mock.Call
+}
+
+// Cache is a helper method to define mock.On call
+//   - args domain.CacheArgs
+func (_e *MockWorkflow_Expecter) Cache(args interface{}) *MockWorkflow_Cache_Call {
+	return &MockWorkflow_Cache_Call{Call: _e.mock.On("Cache", args)}
+}
+
+func (_c *MockWorkflow_Cache_Call) Run(run func(args domain.CacheArgs)) *MockWorkflow_Cache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.CacheArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Cache_Call) Return(_a0 error) *MockWorkflow_Cache_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Cache_Call) RunAndReturn(run func(domain.CacheArgs) error) *MockWorkflow_Cache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompareReports provides a mock function with given fields: args
+func (_m *MockWorkflow) CompareReports(args domain.CompareArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareReports")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.CompareArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_CompareReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareReports'
+type MockWorkflow_CompareReports_Call struct {
+	*mock.Call
+}
+
+// CompareReports is a helper method to define mock.On call
+//   - args domain.CompareArgs
+func (_e *MockWorkflow_Expecter) CompareReports(args interface{}) *MockWorkflow_CompareReports_Call {
+	return &MockWorkflow_CompareReports_Call{Call: _e.mock.On("CompareReports", args)}
+}
+
+func (_c *MockWorkflow_CompareReports_Call) Run(run func(args domain.CompareArgs)) *MockWorkflow_CompareReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.CompareArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_CompareReports_Call) Return(_a0 error) *MockWorkflow_CompareReports_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_CompareReports_Call) RunAndReturn(run func(domain.CompareArgs) error) *MockWorkflow_CompareReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CorpusReport provides a mock function with given fields: args
+func (_m *MockWorkflow) CorpusReport(args domain.CorpusArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CorpusReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.CorpusArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_CorpusReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CorpusReport'
+type MockWorkflow_CorpusReport_Call struct {
+	*mock.Call
+}
+
+// CorpusReport is a helper method to define mock.On call
+//   - args domain.CorpusArgs
+func (_e *MockWorkflow_Expecter) CorpusReport(args interface{}) *MockWorkflow_CorpusReport_Call {
+	return &MockWorkflow_CorpusReport_Call{Call: _e.mock.On("CorpusReport", args)}
+}
+
+func (_c *MockWorkflow_CorpusReport_Call) Run(run func(args domain.CorpusArgs)) *MockWorkflow_CorpusReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.CorpusArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_CorpusReport_Call) Return(_a0 error) *MockWorkflow_CorpusReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_CorpusReport_Call) RunAndReturn(run func(domain.CorpusArgs) error) *MockWorkflow_CorpusReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DryRun provides a mock function with given fields: args
+func (_m *MockWorkflow) DryRun(args domain.DryRunArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.DryRunArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_DryRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRun'
+type MockWorkflow_DryRun_Call struct {
+	*mock.Call
+}
+
+// DryRun is a helper method to define mock.On call
+//   - args domain.DryRunArgs
+func (_e *MockWorkflow_Expecter) DryRun(args interface{}) *MockWorkflow_DryRun_Call {
+	return &MockWorkflow_DryRun_Call{Call: _e.mock.On("DryRun", args)}
+}
+
+func (_c *MockWorkflow_DryRun_Call) Run(run func(args domain.DryRunArgs)) *MockWorkflow_DryRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.DryRunArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_DryRun_Call) Return(_a0 error) *MockWorkflow_DryRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_DryRun_Call) RunAndReturn(run func(domain.DryRunArgs) error) *MockWorkflow_DryRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// E2E provides a mock function with given fields: args
+func (_m *MockWorkflow) E2E(args domain.E2EArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for E2E")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.E2EArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_E2E_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'E2E'
+type MockWorkflow_E2E_Call struct {
+	*mock.Call
+}
+
+// E2E is a helper method to define mock.On call
+//   - args domain.E2EArgs
+func (_e *MockWorkflow_Expecter) E2E(args interface{}) *MockWorkflow_E2E_Call {
+	return &MockWorkflow_E2E_Call{Call: _e.mock.On("E2E", args)}
+}
+
+func (_c *MockWorkflow_E2E_Call) Run(run func(args domain.E2EArgs)) *MockWorkflow_E2E_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.E2EArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_E2E_Call) Return(_a0 error) *MockWorkflow_E2E_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_E2E_Call) RunAndReturn(run func(domain.E2EArgs) error) *MockWorkflow_E2E_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Estimate provides a mock function with given fields: args
 func (_m *MockWorkflow) Estimate(args domain.EstimateArgs) error {
 	ret := _m.Called(args)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Estimate")
+		panic("no return value specified for Estimate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.EstimateArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Estimate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Estimate'
+type MockWorkflow_Estimate_Call struct {
+	*mock.Call
+}
+
+// Estimate is a helper method to define mock.On call
+//   - args domain.EstimateArgs
+func (_e *MockWorkflow_Expecter) Estimate(args interface{}) *MockWorkflow_Estimate_Call {
+	return &MockWorkflow_Estimate_Call{Call: _e.mock.On("Estimate", args)}
+}
+
+func (_c *MockWorkflow_Estimate_Call) Run(run func(args domain.EstimateArgs)) *MockWorkflow_Estimate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.EstimateArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Estimate_Call) Return(_a0 error) *MockWorkflow_Estimate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Estimate_Call) RunAndReturn(run func(domain.EstimateArgs) error) *MockWorkflow_Estimate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exec provides a mock function with given fields: args
+func (_m *MockWorkflow) Exec(args domain.ExecArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.ExecArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockWorkflow_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - args domain.ExecArgs
+func (_e *MockWorkflow_Expecter) Exec(args interface{}) *MockWorkflow_Exec_Call {
+	return &MockWorkflow_Exec_Call{Call: _e.mock.On("Exec", args)}
+}
+
+func (_c *MockWorkflow_Exec_Call) Run(run func(args domain.ExecArgs)) *MockWorkflow_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.ExecArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Exec_Call) Return(_a0 error) *MockWorkflow_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Exec_Call) RunAndReturn(run func(domain.ExecArgs) error) *MockWorkflow_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Kubernetes provides a mock function with given fields: args
+func (_m *MockWorkflow) Kubernetes(args domain.KubernetesArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Kubernetes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.KubernetesArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Kubernetes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Kubernetes'
+type MockWorkflow_Kubernetes_Call struct {
+	*mock.Call
+}
+
+// Kubernetes is a helper method to define mock.On call
+//   - args domain.KubernetesArgs
+func (_e *MockWorkflow_Expecter) Kubernetes(args interface{}) *MockWorkflow_Kubernetes_Call {
+	return &MockWorkflow_Kubernetes_Call{Call: _e.mock.On("Kubernetes", args)}
+}
+
+func (_c *MockWorkflow_Kubernetes_Call) Run(run func(args domain.KubernetesArgs)) *MockWorkflow_Kubernetes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.KubernetesArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Kubernetes_Call) Return(_a0 error) *MockWorkflow_Kubernetes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Kubernetes_Call) RunAndReturn(run func(domain.KubernetesArgs) error) *MockWorkflow_Kubernetes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LSP provides a mock function with given fields: args
+func (_m *MockWorkflow) LSP(args domain.LSPArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LSP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.LSPArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_LSP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LSP'
+type MockWorkflow_LSP_Call struct {
+	*mock.Call
+}
+
+// LSP is a helper method to define mock.On call
+//   - args domain.LSPArgs
+func (_e *MockWorkflow_Expecter) LSP(args interface{}) *MockWorkflow_LSP_Call {
+	return &MockWorkflow_LSP_Call{Call: _e.mock.On("LSP", args)}
+}
+
+func (_c *MockWorkflow_LSP_Call) Run(run func(args domain.LSPArgs)) *MockWorkflow_LSP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.LSPArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_LSP_Call) Return(_a0 error) *MockWorkflow_LSP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_LSP_Call) RunAndReturn(run func(domain.LSPArgs) error) *MockWorkflow_LSP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEquivalent provides a mock function with given fields: args
+func (_m *MockWorkflow) MarkEquivalent(args domain.MarkEquivalentArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEquivalent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.MarkEquivalentArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_MarkEquivalent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEquivalent'
+type MockWorkflow_MarkEquivalent_Call struct {
+	*mock.Call
+}
+
+// MarkEquivalent is a helper method to define mock.On call
+//   - args domain.MarkEquivalentArgs
+func (_e *MockWorkflow_Expecter) MarkEquivalent(args interface{}) *MockWorkflow_MarkEquivalent_Call {
+	return &MockWorkflow_MarkEquivalent_Call{Call: _e.mock.On("MarkEquivalent", args)}
+}
+
+func (_c *MockWorkflow_MarkEquivalent_Call) Run(run func(args domain.MarkEquivalentArgs)) *MockWorkflow_MarkEquivalent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MarkEquivalentArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_MarkEquivalent_Call) Return(_a0 error) *MockWorkflow_MarkEquivalent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_MarkEquivalent_Call) RunAndReturn(run func(domain.MarkEquivalentArgs) error) *MockWorkflow_MarkEquivalent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function with given fields: args
+func (_m *MockWorkflow) Merge(args domain.MergeArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.MergeArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type MockWorkflow_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - args domain.MergeArgs
+func (_e *MockWorkflow_Expecter) Merge(args interface{}) *MockWorkflow_Merge_Call {
+	return &MockWorkflow_Merge_Call{Call: _e.mock.On("Merge", args)}
+}
+
+func (_c *MockWorkflow_Merge_Call) Run(run func(args domain.MergeArgs)) *MockWorkflow_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MergeArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Merge_Call) Return(_a0 error) *MockWorkflow_Merge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Merge_Call) RunAndReturn(run func(domain.MergeArgs) error) *MockWorkflow_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mutate provides a mock function with given fields: args
+func (_m *MockWorkflow) Mutate(args domain.MutateArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mutate")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(domain.EstimateArgs) error); ok {
+	if rf, ok := ret.Get(0).(func(domain.MutateArgs) error); ok {
 		r0 = rf(args)
 	} else {
 		r0 = ret.Error(0)
@@ -38,44 +636,44 @@ func (_m *MockWorkflow) Estimate(args domain.EstimateArgs) error {
 	return r0
 }
 
-// MockWorkflow_Estimate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Estimate'
-type MockWorkflow_Estimate_Call struct {
+// MockWorkflow_Mutate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mutate'
+type MockWorkflow_Mutate_Call struct {
 	*mock.Call
 }
 
-// Estimate is a helper method to define mock.On call
-//   - args domain.EstimateArgs
-func (_e *MockWorkflow_Expecter) Estimate(args interface{}) *MockWorkflow_Estimate_Call {
-	return &MockWorkflow_Estimate_Call{Call: _e.mock.On("Estimate", args)}
+// Mutate is a helper method to define mock.On call
+//   - args domain.MutateArgs
+func (_e *MockWorkflow_Expecter) Mutate(args interface{}) *MockWorkflow_Mutate_Call {
+	return &MockWorkflow_Mutate_Call{Call: _e.mock.On("Mutate", args)}
 }
 
-func (_c *MockWorkflow_Estimate_Call) Run(run func(args domain.EstimateArgs)) *MockWorkflow_Estimate_Call {
+func (_c *MockWorkflow_Mutate_Call) Run(run func(args domain.MutateArgs)) *MockWorkflow_Mutate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.EstimateArgs))
+		run(args[0].(domain.MutateArgs))
 	})
 	return _c
 }
 
-func (_c *MockWorkflow_Estimate_Call) Return(_a0 error) *MockWorkflow_Estimate_Call {
+func (_c *MockWorkflow_Mutate_Call) Return(_a0 error) *MockWorkflow_Mutate_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockWorkflow_Estimate_Call) RunAndReturn(run func(domain.EstimateArgs) error) *MockWorkflow_Estimate_Call {
+func (_c *MockWorkflow_Mutate_Call) RunAndReturn(run func(domain.MutateArgs) error) *MockWorkflow_Mutate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Merge provides a mock function with given fields: args
-func (_m *MockWorkflow) Merge(args domain.MergeArgs) error {
+// Notify provides a mock function with given fields: args
+func (_m *MockWorkflow) Notify(args domain.NotifyArgs) error {
 	ret := _m.Called(args)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Merge")
+		panic("no return value specified for Notify")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(domain.MergeArgs) error); ok {
+	if rf, ok := ret.Get(0).(func(domain.NotifyArgs) error); ok {
 		r0 = rf(args)
 	} else {
 		r0 = ret.Error(0)
@@ -84,30 +682,168 @@ func (_m *MockWorkflow) Merge(args domain.MergeArgs) error {
 	return r0
 }
 
-// MockWorkflow_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
-type MockWorkflow_Merge_Call struct {
+// MockWorkflow_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type MockWorkflow_Notify_Call struct {
 	*mock.Call
 }
 
-// Merge is a helper method to define mock.On call
-//   - args domain.MergeArgs
-func (_e *MockWorkflow_Expecter) Merge(args interface{}) *MockWorkflow_Merge_Call {
-	return &MockWorkflow_Merge_Call{Call: _e.mock.On("Merge", args)}
+// Notify is a helper method to define mock.On call
+//   - args domain.NotifyArgs
+func (_e *MockWorkflow_Expecter) Notify(args interface{}) *MockWorkflow_Notify_Call {
+	return &MockWorkflow_Notify_Call{Call: _e.mock.On("Notify", args)}
 }
 
-func (_c *MockWorkflow_Merge_Call) Run(run func(args domain.MergeArgs)) *MockWorkflow_Merge_Call {
+func (_c *MockWorkflow_Notify_Call) Run(run func(args domain.NotifyArgs)) *MockWorkflow_Notify_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.MergeArgs))
+		run(args[0].(domain.NotifyArgs))
 	})
 	return _c
 }
 
-func (_c *MockWorkflow_Merge_Call) Return(_a0 error) *MockWorkflow_Merge_Call {
+func (_c *MockWorkflow_Notify_Call) Return(_a0 error) *MockWorkflow_Notify_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockWorkflow_Merge_Call) RunAndReturn(run func(domain.MergeArgs) error) *MockWorkflow_Merge_Call {
+func (_c *MockWorkflow_Notify_Call) RunAndReturn(run func(domain.NotifyArgs) error) *MockWorkflow_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Plan provides a mock function with given fields: args
+func (_m *MockWorkflow) Plan(args domain.PlanArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Plan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.PlanArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Plan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Plan'
+type MockWorkflow_Plan_Call struct {
+	*mock.Call
+}
+
+// Plan is a helper method to define mock.On call
+//   - args domain.PlanArgs
+func (_e *MockWorkflow_Expecter) Plan(args interface{}) *MockWorkflow_Plan_Call {
+	return &MockWorkflow_Plan_Call{Call: _e.mock.On("Plan", args)}
+}
+
+func (_c *MockWorkflow_Plan_Call) Run(run func(args domain.PlanArgs)) *MockWorkflow_Plan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.PlanArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Plan_Call) Return(_a0 error) *MockWorkflow_Plan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Plan_Call) RunAndReturn(run func(domain.PlanArgs) error) *MockWorkflow_Plan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revert provides a mock function with given fields: args
+func (_m *MockWorkflow) Revert(args domain.RevertArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.RevertArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type MockWorkflow_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - args domain.RevertArgs
+func (_e *MockWorkflow_Expecter) Revert(args interface{}) *MockWorkflow_Revert_Call {
+	return &MockWorkflow_Revert_Call{Call: _e.mock.On("Revert", args)}
+}
+
+func (_c *MockWorkflow_Revert_Call) Run(run func(args domain.RevertArgs)) *MockWorkflow_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.RevertArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Revert_Call) Return(_a0 error) *MockWorkflow_Revert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Revert_Call) RunAndReturn(run func(domain.RevertArgs) error) *MockWorkflow_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stats provides a mock function with given fields: args
+func (_m *MockWorkflow) Stats(args domain.StatsArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.StatsArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type MockWorkflow_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+//   - args domain.StatsArgs
+func (_e *MockWorkflow_Expecter) Stats(args interface{}) *MockWorkflow_Stats_Call {
+	return &MockWorkflow_Stats_Call{Call: _e.mock.On("Stats", args)}
+}
+
+func (_c *MockWorkflow_Stats_Call) Run(run func(args domain.StatsArgs)) *MockWorkflow_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.StatsArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Stats_Call) Return(_a0 error) *MockWorkflow_Stats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Stats_Call) RunAndReturn(run func(domain.StatsArgs) error) *MockWorkflow_Stats_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -158,6 +894,98 @@ func (_c *MockWorkflow_Test_Call) RunAndReturn(run func(domain.TestArgs) error)
 	return _c
 }
 
+// Trend provides a mock function with given fields: args
+func (_m *MockWorkflow) Trend(args domain.TrendArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.TrendArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Trend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trend'
+type MockWorkflow_Trend_Call struct {
+	*mock.Call
+}
+
+// Trend is a helper method to define mock.On call
+//   - args domain.TrendArgs
+func (_e *MockWorkflow_Expecter) Trend(args interface{}) *MockWorkflow_Trend_Call {
+	return &MockWorkflow_Trend_Call{Call: _e.mock.On("Trend", args)}
+}
+
+func (_c *MockWorkflow_Trend_Call) Run(run func(args domain.TrendArgs)) *MockWorkflow_Trend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TrendArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Trend_Call) Return(_a0 error) *MockWorkflow_Trend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Trend_Call) RunAndReturn(run func(domain.TrendArgs) error) *MockWorkflow_Trend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyReports provides a mock function with given fields: args
+func (_m *MockWorkflow) VerifyReports(args domain.VerifyReportsArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyReports")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.VerifyReportsArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_VerifyReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyReports'
+type MockWorkflow_VerifyReports_Call struct {
+	*mock.Call
+}
+
+// VerifyReports is a helper method to define mock.On call
+//   - args domain.VerifyReportsArgs
+func (_e *MockWorkflow_Expecter) VerifyReports(args interface{}) *MockWorkflow_VerifyReports_Call {
+	return &MockWorkflow_VerifyReports_Call{Call: _e.mock.On("VerifyReports", args)}
+}
+
+func (_c *MockWorkflow_VerifyReports_Call) Run(run func(args domain.VerifyReportsArgs)) *MockWorkflow_VerifyReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.VerifyReportsArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_VerifyReports_Call) Return(_a0 error) *MockWorkflow_VerifyReports_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_VerifyReports_Call) RunAndReturn(run func(domain.VerifyReportsArgs) error) *MockWorkflow_VerifyReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // View provides a mock function with given fields: args
 func (_m *MockWorkflow) View(args domain.ViewArgs) error {
 	ret := _m.Called(args)
@@ -204,6 +1032,98 @@ func (_c *MockWorkflow_View_Call) RunAndReturn(run func(domain.ViewArgs) error)
 	return _c
 }
 
+// Watch provides a mock function with given fields: args
+func (_m *MockWorkflow) Watch(args domain.WatchArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.WatchArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type MockWorkflow_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - args domain.WatchArgs
+func (_e *MockWorkflow_Expecter) Watch(args interface{}) *MockWorkflow_Watch_Call {
+	return &MockWorkflow_Watch_Call{Call: _e.mock.On("Watch", args)}
+}
+
+func (_c *MockWorkflow_Watch_Call) Run(run func(args domain.WatchArgs)) *MockWorkflow_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.WatchArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Watch_Call) Return(_a0 error) *MockWorkflow_Watch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Watch_Call) RunAndReturn(run func(domain.WatchArgs) error) *MockWorkflow_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Worker provides a mock function with given fields: args
+func (_m *MockWorkflow) Worker(args domain.WorkerArgs) error {
+	ret := _m.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Worker")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.WorkerArgs) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkflow_Worker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Worker'
+type MockWorkflow_Worker_Call struct {
+	*mock.Call
+}
+
+// Worker is a helper method to define mock.On call
+//   - args domain.WorkerArgs
+func (_e *MockWorkflow_Expecter) Worker(args interface{}) *MockWorkflow_Worker_Call {
+	return &MockWorkflow_Worker_Call{Call: _e.mock.On("Worker", args)}
+}
+
+func (_c *MockWorkflow_Worker_Call) Run(run func(args domain.WorkerArgs)) *MockWorkflow_Worker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.WorkerArgs))
+	})
+	return _c
+}
+
+func (_c *MockWorkflow_Worker_Call) Return(_a0 error) *MockWorkflow_Worker_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkflow_Worker_Call) RunAndReturn(run func(domain.WorkerArgs) error) *MockWorkflow_Worker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWorkflow creates a new instance of MockWorkflow. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWorkflow(t interface {
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
+	"slices"
+	"strings"
 
 	"github.com/mouse-blink/gooze/internal/adapter"
 	"github.com/mouse-blink/gooze/internal/domain/mutagens"
@@ -20,14 +23,65 @@ type Mutagen interface {
 type mutagen struct {
 	adapter.GoFileAdapter
 	adapter.SourceFSAdapter
+
+	// numberVariants are the variants numbers mutations are generated
+	// with; nil generates every one.
+	numberVariants []m.NumberVariant
+}
+
+// MutagenOption configures a mutagen built by NewMutagen.
+type MutagenOption func(*mutagen)
+
+// WithNumberVariants generates numbers mutations of variants only, instead
+// of every variant in m.NumberVariants.
+func WithNumberVariants(variants ...m.NumberVariant) MutagenOption {
+	return func(mg *mutagen) {
+		mg.numberVariants = slices.Clone(variants)
+	}
 }
 
 // NewMutagen creates a new Mutagen instance.
-func NewMutagen(goFileAdapter adapter.GoFileAdapter, sourceFSAdapter adapter.SourceFSAdapter) Mutagen {
-	return &mutagen{
+func NewMutagen(goFileAdapter adapter.GoFileAdapter, sourceFSAdapter adapter.SourceFSAdapter, opts ...MutagenOption) Mutagen {
+	mg := &mutagen{
 		GoFileAdapter:   goFileAdapter,
 		SourceFSAdapter: sourceFSAdapter,
 	}
+
+	for _, opt := range opts {
+		opt(mg)
+	}
+
+	return mg
+}
+
+// ParseNumberVariants parses a list of number variant names, as given to
+// --numbers.
+func ParseNumberVariants(names []string) ([]m.NumberVariant, error) {
+	variants := make([]m.NumberVariant, 0, len(names))
+
+	for _, name := range names {
+		variant := m.NumberVariant(strings.TrimSpace(name))
+		if !slices.Contains(m.NumberVariants, variant) {
+			return nil, fmt.Errorf("unknown number variant %q (want one of %s)", name, describeNumberVariants())
+		}
+
+		variants = append(variants, variant)
+	}
+
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("no number variants given (want one of %s)", describeNumberVariants())
+	}
+
+	return variants, nil
+}
+
+func describeNumberVariants() string {
+	names := make([]string, 0, len(m.NumberVariants))
+	for _, variant := range m.NumberVariants {
+		names = append(names, string(variant))
+	}
+
+	return strings.Join(names, ", ")
 }
 
 func (mg *mutagen) GenerateMutation(source m.Source, mutationTypes ...m.MutationType) ([]m.Mutation, error) {
@@ -51,11 +105,23 @@ func (mg *mutagen) GenerateMutation(source m.Source, mutationTypes ...m.Mutation
 
 	mutations := make([]m.Mutation, 0)
 
+	var info *types.Info
+
 	for _, mutationType := range mutationTypes {
-		mutations = append(mutations, collectMutations(mutationType, file, fset, content, source)...)
+		gen := mg.generator(mutationType)
+
+		if typed, ok := typedMutationGenerators[mutationType]; ok {
+			if info == nil {
+				info = mg.checkPackage(source, fset, file)
+			}
+
+			gen = withTypes(typed, info)
+		}
+
+		mutations = append(mutations, collectMutations(mutationType, gen, file, fset, content, source)...)
 	}
 
-	return mutations, nil
+	return withoutDuplicates(mutations), nil
 }
 
 func validateSource(source m.Source) error {
@@ -80,7 +146,10 @@ func resolveMutationTypes(mutationTypes []m.MutationType) ([]m.MutationType, err
 	}
 
 	for _, mutationType := range mutationTypes {
-		if mutationType != m.MutationArithmetic && mutationType != m.MutationBoolean && mutationType != m.MutationNumbers && mutationType != m.MutationComparison && mutationType != m.MutationLogical && mutationType != m.MutationUnary && mutationType != m.MutationBranch {
+		_, untyped := mutationGenerators[mutationType]
+		_, typed := typedMutationGenerators[mutationType]
+
+		if !untyped && !typed {
 			return nil, fmt.Errorf("unsupported mutation type: %s", mutationType.Name)
 		}
 	}
@@ -104,12 +173,19 @@ func (mg *mutagen) loadSourceAST(source m.Source) ([]byte, *token.FileSet, *ast.
 	return content, fset, file, nil
 }
 
-func collectMutations(mutationType m.MutationType, file *ast.File, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
-	ignore := buildIgnoreIndex(file, fset, content)
-	if ignore.file.ignores(mutationType) {
-		return nil
-	}
+// checkPackage type-checks file together with the rest of its package.
+// Type errors leave the info partial.
+func (mg *mutagen) checkPackage(source m.Source, fset *token.FileSet, file *ast.File) *types.Info {
+	info, _ := mg.Check(fset, append([]*ast.File{file}, packageSiblings(mg.GoFileAdapter, mg.SourceFSAdapter, source, fset, file.Name.Name)...))
+
+	return info
+}
 
+// collectMutations generates the mutations of mutationType over file with
+// gen. Those a //gooze:ignore annotation covers are kept but marked
+// Suppressed, so runs can record them without testing them.
+func collectMutations(mutationType m.MutationType, gen mutationGenerator, file *ast.File, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	ignore := buildIgnoreIndex(file, fset, content)
 	mutations := make([]m.Mutation, 0)
 
 	ast.Inspect(file, func(n ast.Node) bool {
@@ -117,22 +193,24 @@ func collectMutations(mutationType m.MutationType, file *ast.File, fset *token.F
 			return true
 		}
 
-		// Function-level ignore: if the annotation is directly above the func decl,
-		// skip traversing the function body entirely.
-		if fd, ok := n.(*ast.FuncDecl); ok {
-			if rule, ok := ignore.funcByPos[fd.Pos()]; ok && rule.ignores(mutationType) {
-				return false
-			}
+		generated := gen(n, fset, content, source)
+		if len(generated) == 0 {
+			return true
 		}
 
-		// Line-level ignore: if the annotation is on the same line (trailing) or
-		// on the line above (leading), skip generating mutations for this node.
-		line := fset.PositionFor(n.Pos(), true).Line
-		if rule, ok := ignore.line[line]; ok && rule.ignores(mutationType) {
-			return true
+		fd := enclosingFunc(file, n.Pos())
+		name := funcName(fd)
+		suppressed := ignore.suppresses(mutationType, fd, fset.PositionFor(n.Pos(), true).Line)
+
+		for i := range generated {
+			generated[i].Func = name
+			// Mutations generated from a whole function, such as those of its
+			// loops, also honour annotations on the line they change.
+			generated[i].Suppressed = suppressed || ignore.suppresses(mutationType, fd, generated[i].Position.Line)
+			generated[i].Hint = testHint(generated[i], n, fd, fset, content)
 		}
 
-		mutations = append(mutations, generateMutationsForNode(mutationType, n, fset, content, source)...)
+		mutations = append(mutations, generated...)
 
 		return true
 	})
@@ -140,29 +218,102 @@ func collectMutations(mutationType m.MutationType, file *ast.File, fset *token.F
 	return mutations
 }
 
-var mutationGenerators = map[m.MutationType]func(ast.Node, *token.FileSet, []byte, m.Source) []m.Mutation{
-	m.MutationArithmetic: mutagens.GenerateArithmeticMutations,
-	m.MutationBoolean:    mutagens.GenerateBooleanMutations,
-	m.MutationNumbers:    mutagens.GenerateNumberMutations,
-	m.MutationComparison: mutagens.GenerateComparisonMutations,
-	m.MutationLogical:    mutagens.GenerateLogicalMutations,
-	m.MutationUnary:      mutagens.GenerateUnaryMutations,
-	m.MutationBranch:     mutagens.GenerateBranchMutations,
-	m.MutationStatement:  mutagens.GenerateStatementMutations,
-	m.MutationLoop:       mutagens.GenerateLoopMutations,
+// enclosingFunc returns the top-level function containing pos, or nil
+// outside functions.
+func enclosingFunc(file *ast.File, pos token.Pos) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if ok && pos >= fd.Pos() && pos < fd.End() {
+			return fd
+		}
+	}
+
+	return nil
+}
+
+// funcName names fd as Name, or Receiver.Name for methods; nil has no name.
+func funcName(fd *ast.FuncDecl) string {
+	if fd == nil {
+		return ""
+	}
+
+	if recv := receiverTypeName(fd); recv != "" {
+		return recv + "." + fd.Name.Name
+	}
+
+	return fd.Name.Name
+}
+
+// receiverTypeName returns the base type name of a method's receiver, without
+// pointer or type parameters, or "" for plain functions.
+func receiverTypeName(fd *ast.FuncDecl) string {
+	if fd.Recv == nil || len(fd.Recv.List) == 0 {
+		return ""
+	}
+
+	expr := fd.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	switch t := expr.(type) {
+	case *ast.IndexExpr:
+		expr = t.X
+	case *ast.IndexListExpr:
+		expr = t.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// mutationGenerator generates the mutations of one type at an AST node.
+type mutationGenerator func(ast.Node, *token.FileSet, []byte, m.Source) []m.Mutation
+
+var mutationGenerators = map[m.MutationType]mutationGenerator{
+	m.MutationArithmetic:  mutagens.GenerateArithmeticMutations,
+	m.MutationBoolean:     mutagens.GenerateBooleanMutations,
+	m.MutationNumbers:     mutagens.GenerateNumberMutations,
+	m.MutationComparison:  mutagens.GenerateComparisonMutations,
+	m.MutationLogical:     mutagens.GenerateLogicalMutations,
+	m.MutationUnary:       mutagens.GenerateUnaryMutations,
+	m.MutationBranch:      mutagens.GenerateBranchMutations,
+	m.MutationStatement:   mutagens.GenerateStatementMutations,
+	m.MutationLoop:        mutagens.GenerateLoopMutations,
+	m.MutationEnum:        mutagens.GenerateEnumMutations,
+	m.MutationMath:        mutagens.GenerateMathMutations,
+	m.MutationBlank:       mutagens.GenerateBlankMutations,
+	m.MutationConcurrency: mutagens.GenerateConcurrencyMutations,
+	m.MutationSwitch:      mutagens.GenerateSwitchMutations,
+	m.MutationNilGuard:    mutagens.GenerateNilGuardMutations,
+}
+
+// typedMutationGenerator generates the mutations of one type at an AST
+// node from the types of the node's package.
+type typedMutationGenerator func(ast.Node, *types.Info, *token.FileSet, []byte, m.Source) []m.Mutation
+
+// typedMutationGenerators are the generators needing type information,
+// which is only computed for files they run on.
+var typedMutationGenerators = map[m.MutationType]typedMutationGenerator{
+	m.MutationMethodSwap: mutagens.GenerateMethodSwapMutations,
+}
+
+// withTypes binds a typed generator to the types of the file it runs on.
+func withTypes(gen typedMutationGenerator, info *types.Info) mutationGenerator {
+	return func(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+		return gen(n, info, fset, content, source)
+	}
 }
 
-func generateMutationsForNode(
-	mutationType m.MutationType,
-	n ast.Node,
-	fset *token.FileSet,
-	content []byte,
-	source m.Source,
-) []m.Mutation {
-	gen, ok := mutationGenerators[mutationType]
-	if !ok {
-		return nil
+// generator returns the generator of mutationType, configured with the
+// mutagen's options.
+func (mg *mutagen) generator(mutationType m.MutationType) mutationGenerator {
+	if mutationType == m.MutationNumbers && mg.numberVariants != nil {
+		return mutagens.NumberMutations(mg.numberVariants...)
 	}
 
-	return gen(n, fset, content, source)
+	return mutationGenerators[mutationType]
 }
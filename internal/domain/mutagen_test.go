@@ -2,6 +2,9 @@ package domain
 
 import (
 	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
 	"strings"
@@ -14,7 +17,7 @@ import (
 func TestMutagen_GenerateMutation_ArithmeticBasic(t *testing.T) {
 	mg := newTestMutagen()
 
-	source := makeSourceV2(t, filepath.Join("..", "..", "examples", "basic", "main.go"))
+	source := makeSource(t, filepath.Join("..", "..", "examples", "basic", "main.go"))
 	original := readFileBytes(t, source.Origin.FullPath)
 
 	mutations, err := mg.GenerateMutation(source, m.MutationArithmetic)
@@ -57,7 +60,7 @@ func TestMutagen_GenerateMutation_ArithmeticBasic(t *testing.T) {
 func TestMutagen_GenerateMutation_BooleanLiterals(t *testing.T) {
 	mg := newTestMutagen()
 
-	source := makeSourceV2(t, filepath.Join("..", "..", "examples", "boolean", "main.go"))
+	source := makeSource(t, filepath.Join("..", "..", "examples", "boolean", "main.go"))
 	original := readFileBytes(t, source.Origin.FullPath)
 
 	mutations, err := mg.GenerateMutation(source, m.MutationBoolean)
@@ -105,27 +108,173 @@ func TestMutagen_GenerateMutation_BooleanLiterals(t *testing.T) {
 func TestMutagen_GenerateMutation_DefaultTypes(t *testing.T) {
 	mg := newTestMutagen()
 
-	source := makeSourceV2(t, filepath.Join("..", "..", "examples", "basic", "main.go"))
+	source := makeSource(t, filepath.Join("..", "..", "examples", "basic", "main.go"))
 	mutations, err := mg.GenerateMutation(source)
 	if err != nil {
 		t.Fatalf("GenerateMutation failed: %v", err)
 	}
 
-	if len(mutations) != 12 {
-		t.Fatalf("expected 12 mutations, got %d", len(mutations))
+	if len(mutations) != 24 {
+		t.Fatalf("expected 24 mutations, got %d", len(mutations))
 	}
 }
 
 func TestMutagen_GenerateMutation_InvalidType(t *testing.T) {
 	mg := newTestMutagen()
 
-	source := makeSourceV2(t, filepath.Join("..", "..", "examples", "basic", "main.go"))
+	source := makeSource(t, filepath.Join("..", "..", "examples", "basic", "main.go"))
 	_, err := mg.GenerateMutation(source, m.MutationType{Name: "invalid", Version: 1})
 	if err == nil {
 		t.Fatalf("expected error for invalid mutation type")
 	}
 }
 
+func TestMutagen_GenerateMutation_AllTypesSupported(t *testing.T) {
+	mg := newTestMutagen()
+
+	source := makeSource(t, filepath.Join("..", "..", "examples", "loops", "main.go"))
+	for _, mutationType := range m.MutationTypes {
+		if _, err := mg.GenerateMutation(source, mutationType); err != nil {
+			t.Fatalf("GenerateMutation(%s) failed: %v", mutationType.Name, err)
+		}
+	}
+}
+
+func TestMutagen_GenerateMutation_Positions(t *testing.T) {
+	mg := newTestMutagen()
+
+	source := makeSource(t, filepath.Join("..", "..", "examples", "loops", "main.go"))
+	original := readFileBytes(t, source.Origin.FullPath)
+
+	mutations, err := mg.GenerateMutation(source, m.MutationTypes...)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	if len(mutations) == 0 {
+		t.Fatal("expected mutations, got none")
+	}
+
+	for _, mutation := range mutations {
+		pos := mutation.Position
+		if pos.Line < 1 || pos.Column < 1 {
+			t.Fatalf("%s mutation %s has no position: %+v", mutation.Type.Name, mutation.ID, pos)
+		}
+
+		if pos.Offset < 0 || pos.Offset >= len(original) {
+			t.Fatalf("%s mutation %s offset %d out of range", mutation.Type.Name, mutation.ID, pos.Offset)
+		}
+
+		line := bytes.Count(original[:pos.Offset], []byte("\n")) + 1
+		if line != pos.Line {
+			t.Fatalf("%s mutation %s line = %d, offset implies %d", mutation.Type.Name, mutation.ID, pos.Line, line)
+		}
+	}
+}
+
+func TestMutagen_GenerateMutation_FunctionLiterals(t *testing.T) {
+	mg := newTestMutagen()
+
+	source := makeSource(t, filepath.Join("..", "..", "examples", "closures", "main.go"))
+	content := readFileBytes(t, source.Origin.FullPath)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, string(source.Origin.FullPath), content, 0)
+	if err != nil {
+		t.Fatalf("failed to parse closures example: %v", err)
+	}
+
+	var literalLines [][2]int
+	ast.Inspect(file, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.FuncLit); ok {
+			literalLines = append(literalLines, [2]int{fset.Position(lit.Pos()).Line, fset.Position(lit.End()).Line})
+		}
+		return true
+	})
+
+	insideLiteral := func(line int) bool {
+		for _, span := range literalLines {
+			if line >= span[0] && line <= span[1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, mutationType := range []m.MutationType{
+		m.MutationArithmetic, m.MutationComparison, m.MutationUnary,
+		m.MutationBranch, m.MutationStatement, m.MutationLoop,
+	} {
+		mutations, err := mg.GenerateMutation(source, mutationType)
+		if err != nil {
+			t.Fatalf("GenerateMutation(%s) failed: %v", mutationType.Name, err)
+		}
+
+		found := false
+		seen := make(map[string]bool)
+		for _, mutation := range mutations {
+			if seen[mutation.ID] {
+				t.Fatalf("%s mutation %s generated more than once", mutationType.Name, mutation.ID)
+			}
+			seen[mutation.ID] = true
+
+			if insideLiteral(mutation.Position.Line) {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Errorf("expected %s mutations inside function literals", mutationType.Name)
+		}
+	}
+}
+
+func TestMutagen_GenerateMutation_MethodReceivers(t *testing.T) {
+	mg := newTestMutagen()
+
+	source := makeSource(t, filepath.Join("..", "..", "examples", "methods", "main.go"))
+	content := readFileBytes(t, source.Origin.FullPath)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, string(source.Origin.FullPath), content, 0)
+	if err != nil {
+		t.Fatalf("failed to parse methods example: %v", err)
+	}
+
+	mutations, err := mg.GenerateMutation(source, m.MutationTypes...)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	methods := 0
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil {
+			continue
+		}
+
+		methods++
+		start := fset.Position(fn.Body.Lbrace).Line
+		end := fset.Position(fn.Body.Rbrace).Line
+
+		found := false
+		for _, mutation := range mutations {
+			if mutation.Position.Line >= start && mutation.Position.Line <= end {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("expected mutations in method %s (lines %d-%d)", fn.Name.Name, start, end)
+		}
+	}
+
+	if methods < 8 {
+		t.Fatalf("expected the methods example to declare at least 8 methods, got %d", methods)
+	}
+}
+
 func TestMutagen_GenerateMutation_InvalidSource(t *testing.T) {
 	mg := newTestMutagen()
 
@@ -138,29 +287,137 @@ func TestMutagen_GenerateMutation_InvalidSource(t *testing.T) {
 func TestMutagen_GenerateMutation_Ignore_FileLevel_ByMutagenName(t *testing.T) {
 	mg := newTestMutagen()
 
-	source := makeSourceV2(t, filepath.Join("..", "..", "examples", "ignore", "file_ignore.go"))
+	source := makeSource(t, filepath.Join("..", "..", "examples", "ignore", "file_ignore.go"))
 
 	mutationsArithmetic, err := mg.GenerateMutation(source, m.MutationArithmetic)
 	if err != nil {
 		t.Fatalf("GenerateMutation(arithmetic) failed: %v", err)
 	}
-	if len(mutationsArithmetic) != 0 {
-		t.Fatalf("expected 0 arithmetic mutations due to file-level ignore, got %d", len(mutationsArithmetic))
+	if len(mutationsArithmetic) == 0 || countSuppressed(mutationsArithmetic) != len(mutationsArithmetic) {
+		t.Fatalf("expected every arithmetic mutation suppressed by the file-level ignore, got %d of %d", countSuppressed(mutationsArithmetic), len(mutationsArithmetic))
 	}
 
 	mutationsNumbers, err := mg.GenerateMutation(source, m.MutationNumbers)
 	if err != nil {
 		t.Fatalf("GenerateMutation(numbers) failed: %v", err)
 	}
-	if len(mutationsNumbers) == 0 {
-		t.Fatalf("expected numbers mutations to still be generated")
+	if len(mutationsNumbers) == 0 || countSuppressed(mutationsNumbers) != 0 {
+		t.Fatalf("expected numbers mutations to still be generated unsuppressed")
+	}
+}
+
+func TestMutagen_GenerateMutation_NumberVariants(t *testing.T) {
+	source := makeSource(t, filepath.Join("..", "..", "examples", "basic", "main.go"))
+
+	all, err := newTestMutagen().GenerateMutation(source, m.MutationNumbers)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	mg := NewMutagen(adapter.NewLocalGoFileAdapter(), adapter.NewLocalSourceFSAdapter(), WithNumberVariants(m.NumberZero))
+
+	zeroOnly, err := mg.GenerateMutation(source, m.MutationNumbers)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	if len(zeroOnly) == 0 || len(zeroOnly) >= len(all) {
+		t.Fatalf("expected fewer numbers mutations with the zero variant only, got %d of %d", len(zeroOnly), len(all))
+	}
+
+	// Other mutation types are unaffected.
+	arithmetic, err := mg.GenerateMutation(source, m.MutationArithmetic)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	if len(arithmetic) == 0 {
+		t.Fatalf("expected arithmetic mutations")
+	}
+}
+
+func TestMutagen_GenerateMutation_MethodSwapUsesPackageTypes(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "counter.go"), "package p\n\ntype Counter struct{ n int }\n\nfunc (c *Counter) Inc() { c.n++ }\nfunc (c *Counter) Dec() { c.n-- }\n")
+	writeFile(t, filepath.Join(dir, "counter_test.go"), "package p\n\nfunc (c *Counter) Reset() { c.n = 0 }\n")
+	writeFile(t, filepath.Join(dir, "use.go"), "package p\n\nfunc Bump(c *Counter) {\n\tc.Inc()\n}\n")
+
+	source := makeSource(t, filepath.Join(dir, "use.go"))
+
+	mutations, err := newTestMutagen().GenerateMutation(source, m.MutationMethodSwap)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	// Counter is declared in a sibling file; Reset, from a test file, is
+	// not a method of the package.
+	if len(mutations) != 1 {
+		t.Fatalf("expected 1 method swap mutation, got %d", len(mutations))
+	}
+
+	if !strings.Contains(string(mutations[0].MutatedCode), "\tc.Dec()\n") {
+		t.Fatalf("expected Inc swapped for Dec:\n%s", mutations[0].DiffCode)
+	}
+}
+
+func TestMutagen_GenerateMutation_MergesDuplicatesAcrossTypes(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sum.go")
+	writeFile(t, path, "package p\n\nfunc Sum(n int) int {\n\ttotal := 0\n\tfor i := 0; i < n; i++ {\n\t\ttotal += i\n\t}\n\n\treturn total\n}\n")
+
+	mutations, err := newTestMutagen().GenerateMutation(makeSource(t, path), m.MutationComparison, m.MutationLoop)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	merged := 0
+
+	for _, mutation := range mutations {
+		if seen[string(mutation.MutatedCode)] {
+			t.Fatalf("mutated code generated twice:\n%s", mutation.DiffCode)
+		}
+
+		seen[string(mutation.MutatedCode)] = true
+
+		if len(mutation.MergedFrom) > 0 {
+			merged++
+
+			if mutation.Type != m.MutationComparison || mutation.MergedFrom[0] != m.MutationLoop.Name {
+				t.Fatalf("expected a loop mutation merged into a comparison one, got %s from %v", mutation.Type.Name, mutation.MergedFrom)
+			}
+		}
+	}
+
+	if merged == 0 {
+		t.Fatalf("expected the loop bound mutation merged into the comparison one")
+	}
+}
+
+func TestParseNumberVariants(t *testing.T) {
+	variants, err := ParseNumberVariants([]string{"increment", " decrement"})
+	if err != nil {
+		t.Fatalf("ParseNumberVariants failed: %v", err)
+	}
+
+	if len(variants) != 2 || variants[0] != m.NumberIncrement || variants[1] != m.NumberDecrement {
+		t.Fatalf("unexpected variants: %v", variants)
+	}
+
+	if _, err := ParseNumberVariants([]string{"double"}); err == nil || !strings.Contains(err.Error(), `unknown number variant "double"`) {
+		t.Fatalf("expected unknown variant error, got %v", err)
+	}
+
+	if _, err := ParseNumberVariants(nil); err == nil {
+		t.Fatalf("expected error for no variants")
 	}
 }
 
 func TestMutagen_GenerateMutation_Ignore_FunctionLevel_AllMutagens(t *testing.T) {
 	mg := newTestMutagen()
 
-	source := makeSourceV2(t, filepath.Join("..", "..", "examples", "ignore", "func_ignore.go"))
+	source := makeSource(t, filepath.Join("..", "..", "examples", "ignore", "func_ignore.go"))
 
 	mutations, err := mg.GenerateMutation(source, m.MutationArithmetic)
 	if err != nil {
@@ -168,29 +425,98 @@ func TestMutagen_GenerateMutation_Ignore_FunctionLevel_AllMutagens(t *testing.T)
 	}
 
 	// Each arithmetic binary expr yields 4 mutations (all other arithmetic ops).
-	// File has two identical exprs, but one function is ignored => only 4 mutations.
-	if len(mutations) != 4 {
-		t.Fatalf("expected 4 arithmetic mutations (one function ignored), got %d", len(mutations))
+	// File has two identical exprs, but one function is ignored => 4 of the 8
+	// mutations are suppressed.
+	if len(mutations) != 8 || countSuppressed(mutations) != 4 {
+		t.Fatalf("expected 4 of 8 arithmetic mutations suppressed (one function ignored), got %d of %d", countSuppressed(mutations), len(mutations))
 	}
 }
 
 func TestMutagen_GenerateMutation_Ignore_LineLevel_TrailingComment_ByMutagenName(t *testing.T) {
 	mg := newTestMutagen()
 
-	source := makeSourceV2(t, filepath.Join("..", "..", "examples", "ignore", "line_ignore.go"))
+	source := makeSource(t, filepath.Join("..", "..", "examples", "ignore", "line_ignore.go"))
 
 	mutations, err := mg.GenerateMutation(source, m.MutationArithmetic)
 	if err != nil {
 		t.Fatalf("GenerateMutation failed: %v", err)
 	}
 
-	// Two arithmetic expr lines exist, but one is ignored for arithmetic => 4 mutations.
-	if len(mutations) != 4 {
-		t.Fatalf("expected 4 arithmetic mutations (one line ignored), got %d", len(mutations))
+	// Two arithmetic expr lines exist, but one is ignored for arithmetic => 4
+	// of the 8 mutations are suppressed.
+	if len(mutations) != 8 || countSuppressed(mutations) != 4 {
+		t.Fatalf("expected 4 of 8 arithmetic mutations suppressed (one line ignored), got %d of %d", countSuppressed(mutations), len(mutations))
+	}
+}
+
+func TestMutagen_GenerateMutation_Ignore_LineLevel_InfiniteLoop(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spin.go")
+	writeFile(t, path, "package spin\n\nfunc spin(ch chan int) {\n\tfor { //gooze:ignore loop\n\t\t<-ch\n\t}\n}\n")
+
+	mutations, err := newTestMutagen().GenerateMutation(makeSource(t, path), m.MutationLoop)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	// The injected break and the iteration cap are generated from the
+	// function but change the annotated line's loop.
+	if len(mutations) != 2 || countSuppressed(mutations) != 2 {
+		t.Fatalf("expected both loop mutations suppressed, got %d of %d", countSuppressed(mutations), len(mutations))
+	}
+}
+
+func countSuppressed(mutations []m.Mutation) int {
+	count := 0
+
+	for _, mutation := range mutations {
+		if mutation.Suppressed {
+			count++
+		}
 	}
+
+	return count
 }
 
-func makeSourceV2(t *testing.T, path string) m.Source {
+func TestMutagen_GenerateMutation_RecordsEnclosingFunc(t *testing.T) {
+	mg := newTestMutagen()
+
+	methods := makeSource(t, filepath.Join("..", "..", "examples", "methods", "main.go"))
+
+	mutations, err := mg.GenerateMutation(methods, DefaultMutations...)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	funcs := map[string]bool{}
+	for _, mutation := range mutations {
+		funcs[mutation.Func] = true
+	}
+
+	for _, want := range []string{"Counter.Increment", "Counter.Remaining", "Stack.Pop", "Pair.Scaled", "Sum"} {
+		if !funcs[want] {
+			t.Errorf("expected mutations in %s, got funcs %v", want, funcs)
+		}
+	}
+
+	constants := makeSource(t, filepath.Join("..", "..", "examples", "constants", "main.go"))
+
+	mutations, err = mg.GenerateMutation(constants, m.MutationBoolean, m.MutationNumbers)
+	if err != nil {
+		t.Fatalf("GenerateMutation failed: %v", err)
+	}
+
+	if len(mutations) == 0 {
+		t.Fatalf("expected package-level mutations")
+	}
+
+	for _, mutation := range mutations {
+		if mutation.Func != "" {
+			t.Errorf("expected no func for package-level mutation, got %q", mutation.Func)
+		}
+	}
+}
+
+func makeSource(t *testing.T, path string) m.Source {
 	t.Helper()
 
 	abs, err := filepath.Abs(path)
@@ -203,6 +529,14 @@ func makeSourceV2(t *testing.T, path string) m.Source {
 	}
 }
 
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
 func readFileBytes(t *testing.T, path m.Path) []byte {
 	t.Helper()
 
@@ -0,0 +1,231 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/token"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// GenerateBlankMutations generates blank assignment mutations for the given AST node.
+// A call whose last result is received into a variable checked by the
+// statement right after it, as in:
+//
+//	v, err := f()
+//	if err != nil {
+//		return err
+//	}
+//
+// is mutated into v, _ := f() with the check removed, so the error or ok
+// path of that call site is dropped.
+//
+// Assignments are skipped when a variable they declare is read again later
+// in the block, when another variable they declare is only used by the
+// check, or when the check has an else branch, as the mutant would not build.
+func GenerateBlankMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	var stmts []ast.Stmt
+
+	switch node := n.(type) {
+	case *ast.BlockStmt:
+		stmts = node.List
+	case *ast.CaseClause:
+		stmts = node.Body
+	case *ast.CommClause:
+		stmts = node.Body
+	default:
+		return nil
+	}
+
+	var mutations []m.Mutation
+
+	for i := 0; i+1 < len(stmts); i++ {
+		assign, ok := stmts[i].(*ast.AssignStmt)
+		if !ok {
+			continue
+		}
+
+		check, ok := stmts[i+1].(*ast.IfStmt)
+		if !ok {
+			continue
+		}
+
+		if mutation, ok := blankResult(assign, check, stmts[i+2:], fset, content, source); ok {
+			mutations = append(mutations, mutation)
+		}
+	}
+
+	return mutations
+}
+
+// blankResult blanks the last variable received from a multi-value call and
+// removes the check that follows it.
+func blankResult(
+	assign *ast.AssignStmt,
+	check *ast.IfStmt,
+	rest []ast.Stmt,
+	fset *token.FileSet,
+	content []byte,
+	source m.Source,
+) (m.Mutation, bool) {
+	if len(assign.Lhs) < 2 || len(assign.Rhs) != 1 {
+		return m.Mutation{}, false
+	}
+
+	if _, ok := assign.Rhs[0].(*ast.CallExpr); !ok {
+		return m.Mutation{}, false
+	}
+
+	result, ok := assign.Lhs[len(assign.Lhs)-1].(*ast.Ident)
+	if !ok || result.Name == "_" {
+		return m.Mutation{}, false
+	}
+
+	if check.Init != nil || check.Else != nil || !refersTo(check.Cond, result) {
+		return m.Mutation{}, false
+	}
+
+	if !redeclaredBeforeUse(assign, result, rest) || !declaredUsedIn(assign, result, rest) {
+		return m.Mutation{}, false
+	}
+
+	resultStart, ok := offsetForPos(fset, result.Pos())
+	if !ok {
+		return m.Mutation{}, false
+	}
+
+	tokStart, ok := offsetForPos(fset, assign.TokPos)
+	if !ok {
+		return m.Mutation{}, false
+	}
+
+	checkStart, ok := offsetForPos(fset, check.Pos())
+	if !ok {
+		return m.Mutation{}, false
+	}
+
+	checkEnd, ok := offsetForPos(fset, check.End())
+	if !ok {
+		return m.Mutation{}, false
+	}
+
+	// Edits are applied back to front so earlier offsets stay valid.
+	checkStart, checkEnd = lineBounds(content, checkStart, checkEnd)
+	mutated := replaceRange(content, checkStart, checkEnd, "")
+
+	// := needs at least one new variable on its left, which the blanked
+	// result may have been the only one of.
+	if assign.Tok == token.DEFINE && !declaresOther(assign, result) {
+		mutated = replaceRange(mutated, tokStart, tokStart+len(token.DEFINE.String()), "=")
+	}
+
+	mutated = replaceRange(mutated, resultStart, resultStart+len(result.Name), "_")
+
+	id := mutationID(source, m.MutationBlank.Name, resultStart)[:16]
+
+	return m.Mutation{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationBlank,
+		Position:    positionForPos(fset, result.Pos()),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diffCode(content, mutated),
+	}, true
+}
+
+// refersTo reports whether node uses the variable ident names. Variables
+// are matched by their resolved object, or by name when unresolved.
+func refersTo(node ast.Node, ident *ast.Ident) bool {
+	found := false
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		other, ok := n.(*ast.Ident)
+		if !ok || other == ident || other.Name != ident.Name {
+			return true
+		}
+
+		found = ident.Obj == nil || other.Obj == ident.Obj
+
+		return !found
+	})
+
+	return found
+}
+
+// usedIn reports whether any of stmts uses the variable ident names.
+func usedIn(stmts []ast.Stmt, ident *ast.Ident) bool {
+	for _, stmt := range stmts {
+		if refersTo(stmt, ident) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redeclaredBeforeUse reports whether result can be blanked without breaking
+// later statements of the block. That holds when the assignment does not
+// declare it, or when its next use is a := assignment declaring it afresh.
+func redeclaredBeforeUse(assign *ast.AssignStmt, result *ast.Ident, rest []ast.Stmt) bool {
+	if assign.Tok != token.DEFINE || result.Obj == nil || result.Obj.Decl != assign {
+		return true
+	}
+
+	for _, stmt := range rest {
+		if !refersTo(stmt, result) {
+			continue
+		}
+
+		next, ok := stmt.(*ast.AssignStmt)
+		if !ok || next.Tok != token.DEFINE {
+			return false
+		}
+
+		for _, rhs := range next.Rhs {
+			if refersTo(rhs, result) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return true
+}
+
+// declaredUsedIn reports whether every variable a := assignment declares,
+// other than except, is used in stmts.
+func declaredUsedIn(assign *ast.AssignStmt, except *ast.Ident, stmts []ast.Stmt) bool {
+	for _, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok || ident == except || ident.Name == "_" || ident.Obj == nil || ident.Obj.Decl != assign {
+			continue
+		}
+
+		if !usedIn(stmts, ident) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// declaresOther reports whether a := assignment declares a variable other
+// than except.
+func declaresOther(assign *ast.AssignStmt, except *ast.Ident) bool {
+	for _, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok || ident == except || ident.Name == "_" {
+			continue
+		}
+
+		if ident.Obj != nil && ident.Obj.Decl == assign {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestGenerateBlankMutations_BlanksCheckedResults(t *testing.T) {
+	examplePath := filepath.Join("..", "..", "..", "examples", "errpaths", "main.go")
+	content, err := os.ReadFile(examplePath)
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, examplePath, content, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{Origin: &m.File{FullPath: m.Path(examplePath)}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateBlankMutations(n, fset, content, src)...)
+		return true
+	})
+
+	// parsePort, splitHost, both calls in normalize, setting and isFlag;
+	// describe is left alone as its check has an else branch.
+	if len(mutations) != 6 {
+		t.Fatalf("expected 6 blank mutations, got %d", len(mutations))
+	}
+
+	for _, want := range []string{
+		"port, _ := strconv.Atoi(s)\n\n\tif port < 1",
+		"host, port, _ := strings.Cut(addr, \":\")\n\n\treturn host, port, nil",
+		"host, rawPort, _ := splitHost(addr)\n\n\tport, err := parsePort(rawPort)",
+		"port, _ := parsePort(rawPort)\n\n\treturn",
+		"value, _ := lookup(env, key)\n\n\treturn value",
+		"_, _ = strconv.ParseBool(s)\n\n\treturn true",
+	} {
+		found := false
+
+		for _, mutation := range mutations {
+			if mutation.Type != m.MutationBlank {
+				t.Fatalf("expected type %v, got %v", m.MutationBlank, mutation.Type)
+			}
+
+			if strings.Contains(string(mutation.MutatedCode), want) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("expected a mutation producing %q", want)
+		}
+	}
+}
+
+func TestGenerateBlankMutations_SkipsResultsReadLater(t *testing.T) {
+	fset := token.NewFileSet()
+	src := []byte(`package p
+
+import "strconv"
+
+func f(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		n = -1
+	}
+
+	return n, err
+}
+`)
+
+	file, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateBlankMutations(n, fset, src, m.Source{Origin: &m.File{FullPath: "p.go"}})...)
+		return true
+	})
+
+	if len(mutations) != 0 {
+		t.Fatalf("expected no blank mutations, got %d", len(mutations))
+	}
+}
@@ -1,8 +1,6 @@
 package mutagens
 
 import (
-	"crypto/sha256"
-	"fmt"
 	"go/ast"
 	"go/token"
 
@@ -21,7 +19,7 @@ func GenerateBooleanMutations(n ast.Node, fset *token.FileSet, content []byte, s
 		return nil
 	}
 
-	if !isBooleanLiteralV2(ident.Name) {
+	if !isBooleanLiteral(ident.Name) {
 		return nil
 	}
 
@@ -31,27 +29,27 @@ func GenerateBooleanMutations(n ast.Node, fset *token.FileSet, content []byte, s
 	}
 
 	end := start + len(ident.Name)
-	mutated := flipBooleanV2(ident.Name)
+	mutated := flipBoolean(ident.Name)
 
 	mutatedCode := replaceRange(content, start, end, mutated)
 	diff := diffCode(content, mutatedCode)
-	h := sha256.Sum256(mutatedCode)
-	id := fmt.Sprintf("%x", h)
+	id := mutationID(source, mutatedCode)
 
 	return []m.Mutation{{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationBoolean,
+		Position:    positionForPos(fset, ident.Pos()),
 		MutatedCode: mutatedCode,
 		DiffCode:    diff,
 	}}
 }
 
-func isBooleanLiteralV2(name string) bool {
+func isBooleanLiteral(name string) bool {
 	return name == booleanTrue || name == booleanFalse
 }
 
-func flipBooleanV2(original string) string {
+func flipBoolean(original string) string {
 	if original == booleanTrue {
 		return booleanFalse
 	}
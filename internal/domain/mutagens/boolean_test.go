@@ -97,8 +97,8 @@ func TestIsBooleanLiteral(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if result := isBooleanLiteralV2(tt.name); result != tt.expected {
-				t.Fatalf("isBooleanLiteralV2(%q) = %v, expected %v", tt.name, result, tt.expected)
+			if result := isBooleanLiteral(tt.name); result != tt.expected {
+				t.Fatalf("isBooleanLiteral(%q) = %v, expected %v", tt.name, result, tt.expected)
 			}
 		})
 	}
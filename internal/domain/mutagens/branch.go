@@ -1,8 +1,6 @@
 package mutagens
 
 import (
-	"crypto/sha256"
-	"fmt"
 	"go/ast"
 	"go/token"
 
@@ -16,31 +14,24 @@ func GenerateBranchMutations(n ast.Node, fset *token.FileSet, content []byte, so
 		return nil
 	}
 
-	var mutations []m.Mutation
-
-	ast.Inspect(n, func(node ast.Node) bool {
-		if node == nil {
-			return false
-		}
-
-		switch stmt := node.(type) {
-		case *ast.IfStmt:
-			// Mutate if statement: condition, remove if block, remove else block
-			mutations = append(mutations, mutateIfStatement(stmt, fset, content, source)...)
-		case *ast.ForStmt:
-			// Mutate for loop condition
-			if stmt.Cond != nil {
-				mutations = append(mutations, invertCondition(stmt.Cond, fset, content, source)...)
-			}
-		case *ast.SwitchStmt:
-			// Mutate switch statement and its cases
-			mutations = append(mutations, mutateSwitchStatement(stmt, fset, content, source)...)
+	// Only n itself is mutated: the caller visits every node, including those
+	// nested in function literals, so walking the subtree here would emit the
+	// same mutation once per enclosing node.
+	switch stmt := n.(type) {
+	case *ast.IfStmt:
+		// Mutate if statement: condition, remove if block, remove else block
+		return mutateIfStatement(stmt, fset, content, source)
+	case *ast.ForStmt:
+		// Mutate for loop condition
+		if stmt.Cond != nil {
+			return invertCondition(stmt.Cond, fset, content, source)
 		}
+	case *ast.SwitchStmt:
+		// Mutate switch statement and its cases
+		return mutateSwitchStatement(stmt, fset, content, source)
+	}
 
-		return true
-	})
-
-	return mutations
+	return nil
 }
 
 // mutateIfStatement creates comprehensive mutations for if statements.
@@ -86,7 +77,7 @@ func removeIfBlock(stmt *ast.IfStmt, fset *token.FileSet, content []byte, source
 		return nil
 	}
 
-	return []m.Mutation{createIfRemovalMutation(content, mutated, source, offset)}
+	return []m.Mutation{createIfRemovalMutation(content, mutated, source, offset, positionForPos(fset, stmt.Pos()))}
 }
 
 // replaceIfWithElse replaces an if statement with its else block content.
@@ -129,15 +120,15 @@ func extractBlockBody(block *ast.BlockStmt, fset *token.FileSet, content []byte)
 }
 
 // createIfRemovalMutation creates a mutation for removing an if block.
-func createIfRemovalMutation(content, mutated []byte, source m.Source, offset int) m.Mutation {
+func createIfRemovalMutation(content, mutated []byte, source m.Source, offset int, position m.Position) m.Mutation {
 	diff := diffCode(content, mutated)
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%d", source.Origin.FullPath, m.MutationBranch.Name, offset+1000)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationBranch.Name, offset+1000)[:16]
 
 	return m.Mutation{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationBranch,
+		Position:    position,
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}
@@ -175,13 +166,13 @@ func removeElseBlock(stmt *ast.IfStmt, fset *token.FileSet, content []byte, sour
 
 	diff := diffCode(content, mutated)
 
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%d", source.Origin.FullPath, m.MutationBranch.Name, elseStartOffset+2000)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationBranch.Name, elseStartOffset+2000)[:16]
 
 	mutation := m.Mutation{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationBranch,
+		Position:    positionForPos(fset, elseStart),
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}
@@ -256,13 +247,13 @@ func removeCaseBody(clause *ast.CaseClause, fset *token.FileSet, content []byte,
 
 	diff := diffCode(content, mutated)
 
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%d", source.Origin.FullPath, m.MutationBranch.Name, colonOffset+3000)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationBranch.Name, colonOffset+3000)[:16]
 
 	mutation := m.Mutation{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationBranch,
+		Position:    positionForPos(fset, colonPos),
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}
@@ -284,31 +275,32 @@ func invertCondition(cond ast.Expr, fset *token.FileSet, content []byte, source
 	}
 
 	originalExpr := string(content[offset:endOffset])
+	position := positionForPos(fset, cond.Pos())
 
 	// Create mutation that inverts the condition
-	mutations = append(mutations, createConditionMutation(content, offset, endOffset, "!("+originalExpr+")", source, offset))
+	mutations = append(mutations, createConditionMutation(content, offset, endOffset, "!("+originalExpr+")", source, offset, position))
 
 	// Force condition to true
-	mutations = append(mutations, createConditionMutation(content, offset, endOffset, "true", source, offset+1))
+	mutations = append(mutations, createConditionMutation(content, offset, endOffset, "true", source, offset+1, position))
 
 	// Force condition to false
-	mutations = append(mutations, createConditionMutation(content, offset, endOffset, "false", source, offset+2))
+	mutations = append(mutations, createConditionMutation(content, offset, endOffset, "false", source, offset+2, position))
 
 	return mutations
 }
 
 // createConditionMutation creates a single condition mutation.
-func createConditionMutation(content []byte, offset, endOffset int, replacement string, source m.Source, idOffset int) m.Mutation {
+func createConditionMutation(content []byte, offset, endOffset int, replacement string, source m.Source, idOffset int, position m.Position) m.Mutation {
 	mutated := replaceRange(content, offset, endOffset, replacement)
 	diff := diffCode(content, mutated)
 
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%d", source.Origin.FullPath, m.MutationBranch.Name, idOffset)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationBranch.Name, idOffset)[:16]
 
 	return m.Mutation{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationBranch,
+		Position:    position,
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}
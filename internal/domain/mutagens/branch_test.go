@@ -30,7 +30,7 @@ func foo(x int) int {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	if len(mutations) == 0 {
 		t.Fatal("expected mutations, got none")
@@ -113,7 +113,7 @@ func bar() {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	if len(mutations) == 0 {
 		t.Fatal("expected mutations for for loop, got none")
@@ -158,7 +158,7 @@ func baz(a, b int) int {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	// Should generate 6 mutations: 3 for each if statement (inverted, true, false) + 1 removal each
 	if len(mutations) != 8 {
@@ -190,7 +190,7 @@ func simple() int {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	if len(mutations) != 0 {
 		t.Fatalf("expected no mutations for code without conditionals, got %d", len(mutations))
@@ -217,7 +217,7 @@ func complex(x, y int) bool {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	if len(mutations) != 4 {
 		t.Fatalf("expected 4 mutations for complex condition (3 condition + 1 removal), got %d", len(mutations))
@@ -298,7 +298,7 @@ func test(x int) int {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	// Should have: 3 condition mutations + 1 remove if block + 1 remove else block
 	expectedMin := 5
@@ -354,7 +354,7 @@ func test(x int) string {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	// Should have mutations for both if statements (outer and else if)
 	if len(mutations) < 8 {
@@ -393,7 +393,7 @@ func test(x int) string {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	// Should have mutations for each case body (3 cases)
 	if len(mutations) < 3 {
@@ -438,7 +438,7 @@ func test(x int) {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	if len(mutations) < 2 {
 		t.Fatalf("expected at least 2 mutations, got %d", len(mutations))
@@ -476,7 +476,7 @@ func test(a, b int) int {
 		Origin: &m.File{FullPath: m.Path("test.go")},
 	}
 
-	mutations := GenerateBranchMutations(file, fset, []byte(source), src)
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
 
 	// Should have mutations for both outer and inner if statements
 	// Each if gets: 3 condition mutations + 1 remove if block
@@ -485,3 +485,56 @@ func test(a, b int) int {
 		t.Fatalf("expected at least %d mutations for nested ifs, got %d", expectedMin, len(mutations))
 	}
 }
+
+func collectBranchMutations(file *ast.File, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	var mutations []m.Mutation
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateBranchMutations(n, fset, content, source)...)
+		return true
+	})
+
+	return mutations
+}
+
+func TestGenerateBranchMutations_OnlyMutatesGivenNode(t *testing.T) {
+	source := `package main
+
+func foo(x int) func() int {
+	return func() int {
+		if x > 0 {
+			if x > 10 {
+				return 2
+			}
+			return 1
+		}
+		return 0
+	}
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", source, 0)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{Origin: &m.File{FullPath: m.Path("test.go")}}
+
+	if mutations := GenerateBranchMutations(file, fset, []byte(source), src); len(mutations) != 0 {
+		t.Fatalf("expected no mutations for the file node itself, got %d", len(mutations))
+	}
+
+	mutations := collectBranchMutations(file, fset, []byte(source), src)
+	if len(mutations) == 0 {
+		t.Fatal("expected mutations for ifs nested in a closure, got none")
+	}
+
+	seen := make(map[string]bool)
+	for _, mutation := range mutations {
+		if seen[mutation.ID] {
+			t.Fatalf("duplicate mutation %s:\n%s", mutation.ID, mutation.DiffCode)
+		}
+
+		seen[mutation.ID] = true
+	}
+}
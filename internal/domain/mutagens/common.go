@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"path/filepath"
 	"strings"
 
 	m "github.com/mouse-blink/gooze/internal/model"
@@ -21,6 +22,13 @@ func offsetForPos(fset *token.FileSet, pos token.Pos) (int, bool) {
 	return file.Offset(pos), true
 }
 
+// positionForPos resolves pos into the structured position stored on a mutation.
+func positionForPos(fset *token.FileSet, pos token.Pos) m.Position {
+	p := fset.PositionFor(pos, false)
+
+	return m.Position{Offset: p.Offset, Line: p.Line, Column: p.Column}
+}
+
 func replaceRange(content []byte, start, end int, replacement string) []byte {
 	if start < 0 || end < start || end > len(content) {
 		return content
@@ -34,6 +42,28 @@ func replaceRange(content []byte, start, end int, replacement string) []byte {
 	return mutated
 }
 
+// mutationID hashes the project-relative path and content hash of source
+// together with parts locating the change, so a mutant gets the same ID in
+// every checkout of the project, whatever directory it lives in. A []byte
+// part, such as the mutated code, is hashed as is.
+func mutationID(source m.Source, parts ...any) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s-%s", filepath.ToSlash(string(source.Origin.DisplayPath())), source.Origin.Hash)
+
+	for _, part := range parts {
+		if code, ok := part.([]byte); ok {
+			h.Write([]byte("-"))
+			h.Write(code)
+
+			continue
+		}
+
+		fmt.Fprintf(h, "-%v", part)
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
 func diffCode(original []byte, mutated []byte) []byte {
 	if len(original) == 0 && len(mutated) == 0 {
 		return nil
@@ -95,12 +125,12 @@ func generateBinaryExprMutations(
 	for _, mutatedOp := range getAlternatives(binExpr.Op) {
 		mutatedCode := replaceRange(content, start, end, mutatedOp.String())
 		diff := diffCode(content, mutatedCode)
-		h := sha256.Sum256(mutatedCode)
-		id := fmt.Sprintf("%x", h)
+		id := mutationID(source, mutatedCode)
 		mutations = append(mutations, m.Mutation{
 			ID:          id,
 			Source:      source,
 			Type:        mutationType,
+			Position:    positionForPos(fset, binExpr.OpPos),
 			MutatedCode: mutatedCode,
 			DiffCode:    diff,
 		})
@@ -8,6 +8,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
 )
 
 func TestOffsetForPos(t *testing.T) {
@@ -42,7 +44,7 @@ func TestOffsetForPos(t *testing.T) {
 
 	offset, ok := offsetForPos(fset, literalPos)
 	if !ok {
-		t.Fatal("expected offsetForPosV2 to return ok")
+		t.Fatal("expected offsetForPos to return ok")
 	}
 
 	if !(bytes.HasPrefix(content[offset:], []byte("true")) || bytes.HasPrefix(content[offset:], []byte("false"))) {
@@ -64,7 +66,7 @@ func TestReplaceRange(t *testing.T) {
 	mutated := replaceRange(original, 1, 4, "XYZ")
 
 	if string(mutated) != "aXYZe" {
-		t.Fatalf("replaceRangeV2 result = %q, expected %q", string(mutated), "aXYZe")
+		t.Fatalf("replaceRange result = %q, expected %q", string(mutated), "aXYZe")
 	}
 
 	unchanged := replaceRange(original, 10, 12, "nope")
@@ -161,3 +163,29 @@ func TestEnsureTrailingNewline(t *testing.T) {
 		})
 	}
 }
+
+func TestMutationID_StableAcrossCheckouts(t *testing.T) {
+	source := func(fullPath, hash string) m.Source {
+		return m.Source{Origin: &m.File{ShortPath: "pkg/calc.go", FullPath: m.Path(fullPath), Hash: hash}}
+	}
+
+	ci := mutationID(source("/builds/runner-7/project/pkg/calc.go", "abc"), m.MutationArithmetic.Name, 42)
+	laptop := mutationID(source("/home/dev/src/project/pkg/calc.go", "abc"), m.MutationArithmetic.Name, 42)
+
+	if ci != laptop {
+		t.Fatalf("IDs differ between checkouts: %s != %s", ci, laptop)
+	}
+
+	if edited := mutationID(source("/home/dev/src/project/pkg/calc.go", "def"), m.MutationArithmetic.Name, 42); edited == ci {
+		t.Fatal("expected a different ID once the file content changes")
+	}
+
+	if moved := mutationID(source("/builds/runner-7/project/pkg/calc.go", "abc"), m.MutationArithmetic.Name, 43); moved == ci {
+		t.Fatal("expected a different ID for another offset")
+	}
+
+	code := mutationID(source("/a/pkg/calc.go", "abc"), []byte("package calc\n"))
+	if code != mutationID(source("/b/pkg/calc.go", "abc"), []byte("package calc\n")) {
+		t.Fatal("IDs of mutated code differ between checkouts")
+	}
+}
@@ -0,0 +1,191 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/token"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// unlockMethods pairs the sync.Mutex and sync.RWMutex lock methods with the
+// method releasing them.
+var unlockMethods = map[string]string{
+	"Lock":  "Unlock",
+	"RLock": "RUnlock",
+}
+
+// GenerateConcurrencyMutations generates critical section mutations for the
+// given AST node. A lock taken by a statement of a block and released by a
+// later statement of the same block, as in:
+//
+//	c.mu.Lock()
+//	defer c.mu.Unlock()
+//
+// is mutated by removing both statements, so the code they guarded runs
+// unsynchronized. Tests catch such mutants mostly through the race
+// detector, which is why they are worth running with --race.
+//
+// Locks released in another block or function are skipped: removing only
+// the lock would make the unlock panic, killing the mutant for the wrong
+// reason.
+func GenerateConcurrencyMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	var stmts []ast.Stmt
+
+	switch node := n.(type) {
+	case *ast.BlockStmt:
+		stmts = node.List
+	case *ast.CaseClause:
+		stmts = node.Body
+	case *ast.CommClause:
+		stmts = node.Body
+	default:
+		return nil
+	}
+
+	var mutations []m.Mutation
+
+	for i, stmt := range stmts {
+		lock, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+
+		receiver, method, ok := lockCall(lock.X, fset, content)
+		if !ok {
+			continue
+		}
+
+		unlock := findUnlock(stmts[i+1:], receiver, unlockMethods[method], fset, content)
+		if unlock == nil {
+			continue
+		}
+
+		if mutation, ok := removeCriticalSection(lock, unlock, fset, content, source); ok {
+			mutations = append(mutations, mutation)
+		}
+	}
+
+	return mutations
+}
+
+// lockCall returns the receiver, as source text, and the method of a call
+// without arguments to one of the lock methods.
+func lockCall(expr ast.Expr, fset *token.FileSet, content []byte) (string, string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return "", "", false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+
+	if _, ok := unlockMethods[sel.Sel.Name]; !ok {
+		return "", "", false
+	}
+
+	receiver, ok := nodeText(sel.X, fset, content)
+
+	return receiver, sel.Sel.Name, ok
+}
+
+// findUnlock returns the first statement of stmts that calls method on
+// receiver, directly or deferred.
+func findUnlock(stmts []ast.Stmt, receiver, method string, fset *token.FileSet, content []byte) ast.Stmt {
+	for _, stmt := range stmts {
+		var call ast.Expr
+
+		switch s := stmt.(type) {
+		case *ast.ExprStmt:
+			call = s.X
+		case *ast.DeferStmt:
+			call = s.Call
+		default:
+			continue
+		}
+
+		if isMethodCall(call, receiver, method, fset, content) {
+			return stmt
+		}
+	}
+
+	return nil
+}
+
+func isMethodCall(expr ast.Expr, receiver, method string, fset *token.FileSet, content []byte) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != method {
+		return false
+	}
+
+	text, ok := nodeText(sel.X, fset, content)
+
+	return ok && text == receiver
+}
+
+// nodeText returns the source text of node.
+func nodeText(node ast.Node, fset *token.FileSet, content []byte) (string, bool) {
+	start, ok := offsetForPos(fset, node.Pos())
+	if !ok {
+		return "", false
+	}
+
+	end, ok := offsetForPos(fset, node.End())
+	if !ok || end > len(content) {
+		return "", false
+	}
+
+	return string(content[start:end]), true
+}
+
+// removeCriticalSection deletes the lines of the lock and unlock statements.
+func removeCriticalSection(
+	lock, unlock ast.Stmt,
+	fset *token.FileSet,
+	content []byte,
+	source m.Source,
+) (m.Mutation, bool) {
+	lockStart, ok := offsetForPos(fset, lock.Pos())
+	if !ok {
+		return m.Mutation{}, false
+	}
+
+	lockEnd, ok := offsetForPos(fset, lock.End())
+	if !ok {
+		return m.Mutation{}, false
+	}
+
+	unlockStart, ok := offsetForPos(fset, unlock.Pos())
+	if !ok {
+		return m.Mutation{}, false
+	}
+
+	unlockEnd, ok := offsetForPos(fset, unlock.End())
+	if !ok {
+		return m.Mutation{}, false
+	}
+
+	// Edits are applied back to front so earlier offsets stay valid.
+	unlockStart, unlockEnd = lineBounds(content, unlockStart, unlockEnd)
+	mutated := replaceRange(content, unlockStart, unlockEnd, "")
+
+	lineStart, lineEnd := lineBounds(content, lockStart, lockEnd)
+	mutated = replaceRange(mutated, lineStart, lineEnd, "")
+
+	id := mutationID(source, m.MutationConcurrency.Name, lockStart)[:16]
+
+	return m.Mutation{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationConcurrency,
+		Position:    positionForPos(fset, lock.Pos()),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diffCode(content, mutated),
+	}, true
+}
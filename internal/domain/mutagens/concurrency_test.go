@@ -0,0 +1,106 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestGenerateConcurrencyMutations_RemovesCriticalSections(t *testing.T) {
+	examplePath := filepath.Join("..", "..", "..", "examples", "concurrency", "main.go")
+	content, err := os.ReadFile(examplePath)
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, examplePath, content, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{Origin: &m.File{FullPath: m.Path(examplePath)}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateConcurrencyMutations(n, fset, content, src)...)
+		return true
+	})
+
+	// Inc, Add, Value, Get and Set; lock and unlock split their critical
+	// section across functions and are left alone.
+	if len(mutations) != 5 {
+		t.Fatalf("expected 5 concurrency mutations, got %d", len(mutations))
+	}
+
+	for _, want := range []string{
+		"func (c *Counter) Inc() {\n\n\tc.n++",
+		"func (c *Counter) Add(delta int) {\n\tc.n += delta\n}",
+		"func (r *Registry) Get(name string) (int, bool) {\n\n\tv, ok := r.values[name]",
+	} {
+		found := false
+
+		for _, mutation := range mutations {
+			if mutation.Type != m.MutationConcurrency {
+				t.Fatalf("expected type %v, got %v", m.MutationConcurrency, mutation.Type)
+			}
+
+			if strings.Contains(string(mutation.MutatedCode), want) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("expected a mutation producing %q", want)
+		}
+	}
+}
+
+func TestGenerateConcurrencyMutations_MatchesReceiverAndMethod(t *testing.T) {
+	fset := token.NewFileSet()
+	src := []byte(`package p
+
+import "sync"
+
+var a, b sync.RWMutex
+
+func f() {
+	a.Lock()
+	b.Unlock()
+	a.Unlock()
+}
+
+func g() {
+	a.RLock()
+	defer a.Unlock()
+}
+`)
+
+	file, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	source := m.Source{Origin: &m.File{FullPath: "p.go"}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateConcurrencyMutations(n, fset, src, source)...)
+		return true
+	})
+
+	if len(mutations) != 1 {
+		t.Fatalf("expected 1 mutation, got %d", len(mutations))
+	}
+
+	if want := "func f() {\n\tb.Unlock()\n}"; !strings.Contains(string(mutations[0].MutatedCode), want) {
+		t.Fatalf("expected a.Lock and a.Unlock removed, got:\n%s", mutations[0].MutatedCode)
+	}
+}
@@ -0,0 +1,233 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/token"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// GenerateEnumMutations generates mutations of iota-based enums for the given AST node.
+// Enums are const blocks using iota; their members are mutated by:
+// - Removing a member, which shifts the value of every member after it
+// - Swapping the names of adjacent members, and so their values
+// - Dropping a member from a switch case, or the whole case when it lists
+// only that member, so the value falls through to default.
+//
+// Switch cases are only recognized for enums declared in the same file.
+func GenerateEnumMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	switch node := n.(type) {
+	case *ast.GenDecl:
+		specs := enumSpecs(node)
+		if specs == nil {
+			return nil
+		}
+
+		mutations := removeEnumMembers(specs, fset, content, source)
+
+		return append(mutations, swapEnumMembers(specs, fset, content, source)...)
+	case *ast.CaseClause:
+		return dropEnumCases(node, fset, content, source)
+	}
+
+	return nil
+}
+
+// enumSpecs returns the specs of a parenthesized const block using iota, or
+// nil for any other declaration.
+func enumSpecs(decl *ast.GenDecl) []*ast.ValueSpec {
+	if decl.Tok != token.CONST || !decl.Lparen.IsValid() {
+		return nil
+	}
+
+	specs := make([]*ast.ValueSpec, 0, len(decl.Specs))
+	usesIota := false
+
+	for _, spec := range decl.Specs {
+		valueSpec, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			return nil
+		}
+
+		usesIota = usesIota || valuesUseIota(valueSpec.Values)
+		specs = append(specs, valueSpec)
+	}
+
+	if !usesIota {
+		return nil
+	}
+
+	return specs
+}
+
+func valuesUseIota(values []ast.Expr) bool {
+	found := false
+
+	for _, value := range values {
+		ast.Inspect(value, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && ident.Name == "iota" {
+				found = true
+			}
+
+			return !found
+		})
+	}
+
+	return found
+}
+
+// isEnumMemberSpec reports whether spec declares a single named member whose
+// value comes from iota, explicitly or by repeating the previous expression.
+func isEnumMemberSpec(spec *ast.ValueSpec) bool {
+	if len(spec.Names) != 1 || spec.Names[0].Name == "_" {
+		return false
+	}
+
+	return len(spec.Values) == 0 || valuesUseIota(spec.Values)
+}
+
+// removeEnumMembers deletes the lines of members that repeat the previous
+// expression; removing the member that introduces the expression would not
+// compile.
+func removeEnumMembers(specs []*ast.ValueSpec, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	var mutations []m.Mutation
+
+	for i, spec := range specs {
+		if i == 0 || len(spec.Values) > 0 || !isEnumMemberSpec(spec) {
+			continue
+		}
+
+		start, ok1 := offsetForPos(fset, spec.Pos())
+		end, ok2 := offsetForPos(fset, spec.End())
+
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		lineStart, lineEnd := lineBounds(content, start, end)
+		mutated := replaceRange(content, lineStart, lineEnd, "")
+
+		mutations = append(mutations, enumMutation(content, mutated, fset, spec.Pos(), source, "remove", start))
+	}
+
+	return mutations
+}
+
+// swapEnumMembers swaps the names of adjacent members when the second takes
+// its value from its position, so both keep compiling with the other's value.
+func swapEnumMembers(specs []*ast.ValueSpec, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	var mutations []m.Mutation
+
+	for i := 0; i+1 < len(specs); i++ {
+		first, second := specs[i], specs[i+1]
+		if !isEnumMemberSpec(first) || !isEnumMemberSpec(second) || len(second.Values) > 0 {
+			continue
+		}
+
+		firstName, secondName := first.Names[0], second.Names[0]
+
+		firstStart, ok1 := offsetForPos(fset, firstName.Pos())
+		secondStart, ok2 := offsetForPos(fset, secondName.Pos())
+
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		// Replace the later name first so the earlier offset stays valid.
+		mutated := replaceRange(content, secondStart, secondStart+len(secondName.Name), firstName.Name)
+		mutated = replaceRange(mutated, firstStart, firstStart+len(firstName.Name), secondName.Name)
+
+		mutations = append(mutations, enumMutation(content, mutated, fset, firstName.Pos(), source, "swap", firstStart))
+	}
+
+	return mutations
+}
+
+// dropEnumCases removes each enum member from a case list, or the whole case
+// clause when the member is all it lists.
+func dropEnumCases(clause *ast.CaseClause, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	var mutations []m.Mutation
+
+	for i, expr := range clause.List {
+		if !isEnumMember(expr) {
+			continue
+		}
+
+		exprStart, ok := offsetForPos(fset, expr.Pos())
+		if !ok {
+			continue
+		}
+
+		var start, end token.Pos
+
+		switch {
+		case len(clause.List) == 1:
+			start, end = clause.Case, clause.End()
+		case i < len(clause.List)-1:
+			start, end = expr.Pos(), clause.List[i+1].Pos()
+		default:
+			start, end = clause.List[i-1].End(), expr.End()
+		}
+
+		startOffset, ok1 := offsetForPos(fset, start)
+		endOffset, ok2 := offsetForPos(fset, end)
+
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		if len(clause.List) == 1 {
+			startOffset, endOffset = lineBounds(content, startOffset, endOffset)
+		}
+
+		mutated := replaceRange(content, startOffset, endOffset, "")
+
+		mutations = append(mutations, enumMutation(content, mutated, fset, expr.Pos(), source, "case", exprStart))
+	}
+
+	return mutations
+}
+
+// isEnumMember reports whether expr names a constant declared in an iota
+// const block of the same file.
+func isEnumMember(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok || ident.Obj == nil || ident.Obj.Kind != ast.Con {
+		return false
+	}
+
+	spec, ok := ident.Obj.Decl.(*ast.ValueSpec)
+
+	return ok && isEnumMemberSpec(spec)
+}
+
+// lineBounds widens [start, end) to whole lines, including the newline
+// ending the last one.
+func lineBounds(content []byte, start, end int) (int, int) {
+	for start > 0 && content[start-1] != '\n' {
+		start--
+	}
+
+	for end < len(content) && content[end] != '\n' {
+		end++
+	}
+
+	if end < len(content) {
+		end++
+	}
+
+	return start, end
+}
+
+func enumMutation(content, mutated []byte, fset *token.FileSet, pos token.Pos, source m.Source, kind string, offset int) m.Mutation {
+	id := mutationID(source, m.MutationEnum.Name, kind, offset)[:16]
+
+	return m.Mutation{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationEnum,
+		Position:    positionForPos(fset, pos),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diffCode(content, mutated),
+	}
+}
@@ -0,0 +1,117 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func generateEnumExampleMutations(t *testing.T) []m.Mutation {
+	t.Helper()
+
+	examplePath := filepath.Join("..", "..", "..", "examples", "enums", "main.go")
+	content, err := os.ReadFile(examplePath)
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, examplePath, content, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{Origin: &m.File{FullPath: m.Path(examplePath)}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateEnumMutations(n, fset, content, src)...)
+		return true
+	})
+
+	return mutations
+}
+
+func TestGenerateEnumMutations_Counts(t *testing.T) {
+	mutations := generateEnumExampleMutations(t)
+
+	// Color: 3 removals, 3 swaps. Weekday: 3 removals, 2 swaps (the blank
+	// member is kept). Switch cases: Red, Green, Blue, Yellow and Monday.
+	if len(mutations) != 16 {
+		t.Fatalf("expected 16 enum mutations, got %d", len(mutations))
+	}
+
+	ids := make(map[string]bool, len(mutations))
+	for _, mutation := range mutations {
+		if mutation.Type != m.MutationEnum {
+			t.Fatalf("expected type %v, got %v", m.MutationEnum, mutation.Type)
+		}
+
+		if ids[mutation.ID] {
+			t.Fatalf("duplicate mutation ID %s", mutation.ID)
+		}
+
+		ids[mutation.ID] = true
+
+		if _, err := parser.ParseFile(token.NewFileSet(), "mutated.go", mutation.MutatedCode, 0); err != nil {
+			t.Fatalf("mutated code does not parse: %v\n%s", err, mutation.DiffCode)
+		}
+
+		if strings.Contains(string(mutation.DiffCode), "MinSize") {
+			t.Fatalf("const block without iota must not be mutated:\n%s", mutation.DiffCode)
+		}
+	}
+}
+
+func TestGenerateEnumMutations_Shapes(t *testing.T) {
+	mutations := generateEnumExampleMutations(t)
+
+	for _, want := range []struct {
+		name    string
+		removed string
+		added   string
+	}{
+		{name: "member removal", removed: "-\tGreen\n"},
+		{name: "member swap", removed: "-\tRed Color = iota\n", added: "+\tGreen Color = iota\n"},
+		{name: "case removal", removed: "-\tcase Monday:\n"},
+		{name: "case member drop", removed: "-\tcase Blue, Yellow:\n", added: "+\tcase Yellow:\n"},
+		{name: "last case member drop", removed: "-\tcase Blue, Yellow:\n", added: "+\tcase Blue:\n"},
+	} {
+		found := false
+
+		for _, mutation := range mutations {
+			diff := string(mutation.DiffCode)
+			if strings.Contains(diff, want.removed) && strings.Contains(diff, want.added) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("expected a %s mutation removing %q and adding %q", want.name, want.removed, want.added)
+		}
+	}
+}
+
+func TestGenerateEnumMutations_CaseRemovalDropsBody(t *testing.T) {
+	for _, mutation := range generateEnumExampleMutations(t) {
+		diff := string(mutation.DiffCode)
+		if !strings.Contains(diff, "-\tcase Red:\n") {
+			continue
+		}
+
+		if !strings.Contains(diff, "-\t\treturn \"red\"\n") {
+			t.Fatalf("expected the case body to be removed with the case:\n%s", diff)
+		}
+
+		return
+	}
+
+	t.Fatal("expected a mutation removing case Red")
+}
@@ -1,10 +1,10 @@
 package mutagens
 
 import (
-	"crypto/sha256"
 	"fmt"
 	"go/ast"
 	"go/token"
+	"strings"
 
 	m "github.com/mouse-blink/gooze/internal/model"
 )
@@ -26,6 +26,12 @@ func GenerateLoopMutations(n ast.Node, fset *token.FileSet, content []byte, sour
 	case *ast.FuncDecl:
 		// Detect and mutate recursive calls within functions
 		mutations = append(mutations, mutateRecursiveCalls(stmt, fset, content, source)...)
+		mutations = append(mutations, mutateInfiniteLoops(stmt.Type, stmt.Body, fset, content, source)...)
+	case *ast.FuncLit:
+		mutations = append(mutations, mutateInfiniteLoops(stmt.Type, stmt.Body, fset, content, source)...)
+	case *ast.AssignStmt:
+		// Recursive closures can only refer to themselves through an assignment
+		mutations = append(mutations, mutateRecursiveClosures(stmt, fset, content, source)...)
 	}
 
 	return mutations
@@ -40,14 +46,237 @@ func mutateForLoop(stmt *ast.ForStmt, fset *token.FileSet, content []byte, sourc
 		mutations = append(mutations, mutateLoopBoundary(stmt.Cond, fset, content, source)...)
 	}
 
-	// Remove loop body
-	if stmt.Body != nil && len(stmt.Body.List) > 0 {
-		mutations = append(mutations, removeForLoopBody(stmt, fset, content, source)...)
+	if stmt.Body == nil {
+		return mutations
 	}
 
+	// Without a post statement nothing in the header advances the loop, so an
+	// emptied body would spin until the test timeout. While-style loops get a
+	// falsified condition or injected break instead. Loops without a condition
+	// are left to mutateInfiniteLoops, which knows the enclosing function.
+	if stmt.Cond == nil {
+		return mutations
+	}
+
+	if stmt.Post != nil {
+		if len(stmt.Body.List) > 0 {
+			mutations = append(mutations, removeForLoopBody(stmt, fset, content, source)...)
+		}
+
+		return mutations
+	}
+
+	mutations = append(mutations, falsifyLoopCondition(stmt, fset, content, source)...)
+
+	return append(mutations, injectLoopExit(stmt, "break", "inject-break", fset, content, source)...)
+}
+
+// loopIterationCap is the number of iterations an infinite loop is cut to.
+const loopIterationCap = 3
+
+// mutateInfiniteLoops creates mutations for the loops without a condition
+// in body, the body of a function of type funcType, leaving those of nested
+// function literals to their own call. Each loop gets an exit injected at
+// the end of its body and, unless its header has other clauses, a cap of
+// loopIterationCap iterations. A loop with no break out of it is a
+// terminating statement, which a break would undo in a function with
+// results, so such loops exit by returning the zero values instead.
+func mutateInfiniteLoops(funcType *ast.FuncType, body *ast.BlockStmt, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	if body == nil {
+		return nil
+	}
+
+	var mutations []m.Mutation
+
+	labels := make(map[*ast.ForStmt]string)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.LabeledStmt:
+			if loop, ok := stmt.Stmt.(*ast.ForStmt); ok {
+				labels[loop] = stmt.Label.Name
+			}
+		case *ast.ForStmt:
+			if stmt.Cond != nil || stmt.Body == nil {
+				return true
+			}
+
+			exit, key := "break", "inject-break"
+
+			if funcType.Results.NumFields() > 0 && !breaksOutOf(stmt.Body, labels[stmt]) {
+				zeros, ok := zeroResults(funcType, content, fset)
+				if !ok {
+					return true
+				}
+
+				exit, key = "return "+zeros, "inject-return"
+			}
+
+			mutations = append(mutations, injectLoopExit(stmt, exit, key, fset, content, source)...)
+
+			if stmt.Init == nil && stmt.Post == nil {
+				mutations = append(mutations, capLoopIterations(stmt, exit, fset, content, source)...)
+			}
+		}
+
+		return true
+	})
+
 	return mutations
 }
 
+// breaksOutOf reports whether body, the body of a loop labeled label if
+// not "", holds a break out of that loop.
+func breaksOutOf(body *ast.BlockStmt, label string) bool {
+	found := false
+
+	var visit func(root ast.Node, nested bool)
+
+	visit = func(root ast.Node, nested bool) {
+		ast.Inspect(root, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+
+			switch stmt := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+				// An unlabeled break inside these leaves them, not the loop.
+				if stmt != root {
+					visit(stmt, true)
+
+					return false
+				}
+			case *ast.BranchStmt:
+				if stmt.Tok == token.BREAK && ((stmt.Label == nil && !nested) || (stmt.Label != nil && stmt.Label.Name == label)) {
+					found = true
+				}
+			}
+
+			return true
+		})
+	}
+
+	visit(body, false)
+
+	return found
+}
+
+// falsifyLoopCondition replaces a while-style loop condition with false so the
+// body never runs.
+func falsifyLoopCondition(stmt *ast.ForStmt, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	condStart, ok1 := offsetForPos(fset, stmt.Cond.Pos())
+	condEnd, ok2 := offsetForPos(fset, stmt.Cond.End())
+
+	if !ok1 || !ok2 {
+		return nil
+	}
+
+	mutated := replaceRange(content, condStart, condEnd, "false")
+	diff := diffCode(content, mutated)
+
+	id := mutationID(source, m.MutationLoop.Name, "cond", condStart)[:16]
+
+	return []m.Mutation{{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationLoop,
+		Position:    positionForPos(fset, stmt.Cond.Pos()),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diff,
+	}}
+}
+
+// injectLoopExit appends exit, a break or return, to the loop body so it
+// runs at most once.
+func injectLoopExit(stmt *ast.ForStmt, exit, key string, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	forStart, ok1 := offsetForPos(fset, stmt.For)
+	bodyEnd, ok2 := offsetForPos(fset, stmt.Body.Rbrace)
+
+	if !ok1 || !ok2 {
+		return nil
+	}
+
+	indent := lineIndent(content, forStart)
+	insertAt := bodyEnd
+
+	// Keep the closing brace on its own line by inserting before its indentation.
+	for insertAt > 0 && (content[insertAt-1] == ' ' || content[insertAt-1] == '\t') {
+		insertAt--
+	}
+
+	injected := indent + "\t" + exit + "\n"
+	if insertAt == 0 || content[insertAt-1] != '\n' {
+		injected = "\n" + injected + indent
+	}
+
+	mutated := replaceRange(content, insertAt, insertAt, injected)
+	diff := diffCode(content, mutated)
+
+	id := mutationID(source, m.MutationLoop.Name, key, bodyEnd)[:16]
+
+	return []m.Mutation{{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationLoop,
+		Position:    positionForPos(fset, stmt.For),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diff,
+	}}
+}
+
+// capLoopIterations gives an infinite loop a counter in its header and
+// leaves it through exit once it has run loopIterationCap times.
+func capLoopIterations(stmt *ast.ForStmt, exit string, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	forStart, ok1 := offsetForPos(fset, stmt.For)
+	bodyStart, ok2 := offsetForPos(fset, stmt.Body.Lbrace)
+
+	if !ok1 || !ok2 {
+		return nil
+	}
+
+	indent := lineIndent(content, forStart)
+	counter := "goozeIteration"
+
+	check := fmt.Sprintf("\n%s\tif %s == %d {\n%s\t\t%s\n%s\t}", indent, counter, loopIterationCap, indent, exit, indent)
+	if bodyStart+1 < len(content) && content[bodyStart+1] == '}' {
+		check += "\n" + indent
+	}
+
+	mutated := replaceRange(content, bodyStart+1, bodyStart+1, check)
+	mutated = replaceRange(mutated, forStart+len("for"), bodyStart, fmt.Sprintf(" %s := 0; ; %s++ ", counter, counter))
+	diff := diffCode(content, mutated)
+
+	id := mutationID(source, m.MutationLoop.Name, "cap", bodyStart)[:16]
+
+	return []m.Mutation{{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationLoop,
+		Position:    positionForPos(fset, stmt.For),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diff,
+	}}
+}
+
+// lineIndent returns the leading whitespace of the line containing offset.
+func lineIndent(content []byte, offset int) string {
+	lineStart := offset
+	for lineStart > 0 && content[lineStart-1] != '\n' {
+		lineStart--
+	}
+
+	end := lineStart
+	for end < len(content) && (content[end] == ' ' || content[end] == '\t') {
+		end++
+	}
+
+	return string(content[lineStart:end])
+}
+
 // mutateRangeLoop creates mutations for range loops.
 func mutateRangeLoop(stmt *ast.RangeStmt, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
 	if stmt.Body == nil || len(stmt.Body.List) == 0 {
@@ -86,13 +315,13 @@ func mutateLoopBoundary(cond ast.Expr, fset *token.FileSet, content []byte, sour
 		mutated := replaceRange(content, opStart, opEnd, mutatedOp.String())
 		diff := diffCode(content, mutated)
 
-		h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-boundary-%d", source.Origin.FullPath, m.MutationLoop.Name, opStart)))
-		id := fmt.Sprintf("%x", h)[:16]
+		id := mutationID(source, m.MutationLoop.Name, "boundary", opStart)[:16]
 
 		mutations = append(mutations, m.Mutation{
 			ID:          id,
 			Source:      source,
 			Type:        m.MutationLoop,
+			Position:    positionForPos(fset, binExpr.OpPos),
 			MutatedCode: ensureTrailingNewline(mutated),
 			DiffCode:    diff,
 		})
@@ -113,13 +342,13 @@ func removeForLoopBody(stmt *ast.ForStmt, fset *token.FileSet, content []byte, s
 	mutated := replaceRange(content, bodyStart+1, bodyEnd, "")
 	diff := diffCode(content, mutated)
 
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-body-%d", source.Origin.FullPath, m.MutationLoop.Name, bodyStart)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationLoop.Name, "body", bodyStart)[:16]
 
 	return []m.Mutation{{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationLoop,
+		Position:    positionForPos(fset, stmt.Body.Lbrace),
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}}
@@ -137,13 +366,13 @@ func removeRangeLoopBody(stmt *ast.RangeStmt, fset *token.FileSet, content []byt
 	mutated := replaceRange(content, bodyStart+1, bodyEnd, "")
 	diff := diffCode(content, mutated)
 
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-range-%d", source.Origin.FullPath, m.MutationLoop.Name, bodyStart)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationLoop.Name, "range", bodyStart)[:16]
 
 	return []m.Mutation{{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationLoop,
+		Position:    positionForPos(fset, stmt.Body.Lbrace),
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}}
@@ -176,13 +405,13 @@ func removeBranchStatement(stmt *ast.BranchStmt, fset *token.FileSet, content []
 		branchType = "continue"
 	}
 
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%s-%d", source.Origin.FullPath, m.MutationLoop.Name, branchType, offset)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationLoop.Name, branchType, offset)[:16]
 
 	return []m.Mutation{{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationLoop,
+		Position:    positionForPos(fset, stmt.Pos()),
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}}
@@ -209,7 +438,7 @@ func getLoopBoundaryAlternatives(original token.Token) []token.Token {
 	}
 }
 
-// mutateRecursiveCalls finds and removes recursive calls in a function.
+// mutateRecursiveCalls finds and removes recursive calls in a function or method.
 func mutateRecursiveCalls(funcDecl *ast.FuncDecl, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
 	if funcDecl.Body == nil || funcDecl.Name == nil {
 		return nil
@@ -217,14 +446,177 @@ func mutateRecursiveCalls(funcDecl *ast.FuncDecl, fset *token.FileSet, content [
 
 	funcName := funcDecl.Name.Name
 
+	zero := zeroResult(funcDecl.Type, content, fset)
+	if zero == "" {
+		return nil
+	}
+
+	// A method recurses through its receiver (t.walk()), not through a bare
+	// identifier. Calls through the receiver's fields (t.conn.Close()) are
+	// left alone: without types they cannot be told apart from delegation
+	// to another type's method of the same name.
+	if funcDecl.Recv != nil {
+		recvName := receiverName(funcDecl)
+		if recvName == "" {
+			return nil
+		}
+
+		return findRecursiveInBody(funcDecl.Body, func(call *ast.CallExpr) bool {
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != funcName {
+				return false
+			}
+
+			recv, ok := sel.X.(*ast.Ident)
+
+			return ok && recv.Name == recvName
+		}, zero, fset, content, source)
+	}
+
+	return findRecursiveInBody(funcDecl.Body, callsIdent(funcName), zero, fset, content, source)
+}
+
+// zeroResult returns the zero value of the single result of a function of
+// type funcType, which replaces its recursive calls, or "" when it has no
+// or several results.
+func zeroResult(funcType *ast.FuncType, content []byte, fset *token.FileSet) string {
+	if funcType.Results == nil || funcType.Results.NumFields() != 1 {
+		return ""
+	}
+
+	return zeroValue(funcType.Results.List[0].Type, content, fset)
+}
+
+// zeroResults returns the zero values of the results of a function of type
+// funcType as the operands of a return statement.
+func zeroResults(funcType *ast.FuncType, content []byte, fset *token.FileSet) (string, bool) {
+	zeros := make([]string, 0, funcType.Results.NumFields())
+
+	for _, field := range funcType.Results.List {
+		zero := zeroValue(field.Type, content, fset)
+		if zero == "" {
+			return "", false
+		}
+
+		for range max(len(field.Names), 1) {
+			zeros = append(zeros, zero)
+		}
+	}
+
+	return strings.Join(zeros, ", "), true
+}
+
+// zeroValue returns the zero value of resultType, or "" if its source
+// cannot be found. Types without a literal zero get *new(T), which works
+// for structs, arrays and type parameters alike.
+func zeroValue(resultType ast.Expr, content []byte, fset *token.FileSet) string {
+	switch t := resultType.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "int", "int8", "int16", "int32", "int64",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+			"float32", "float64", "complex64", "complex128", "byte", "rune":
+			return "0"
+		case "string":
+			return `""`
+		case "bool":
+			return "false"
+		case "error", "any":
+			return "nil"
+		}
+	case *ast.StarExpr, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.InterfaceType:
+		return "nil"
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "nil"
+		}
+	}
+
+	start, ok1 := offsetForPos(fset, resultType.Pos())
+	end, ok2 := offsetForPos(fset, resultType.End())
+
+	if !ok1 || !ok2 {
+		return ""
+	}
+
+	return "*new(" + string(content[start:end]) + ")"
+}
+
+// mutateRecursiveClosures finds and removes recursive calls in function
+// literals assigned to a variable they call, e.g. fact = func(n int) int { ... fact(n-1) }.
+func mutateRecursiveClosures(assign *ast.AssignStmt, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	if assign.Tok != token.ASSIGN || len(assign.Lhs) != len(assign.Rhs) {
+		return nil
+	}
+
+	var mutations []m.Mutation
+
+	for i, rhs := range assign.Rhs {
+		lit, ok := rhs.(*ast.FuncLit)
+		if !ok || lit.Body == nil {
+			continue
+		}
+
+		ident, ok := assign.Lhs[i].(*ast.Ident)
+		if !ok || ident.Name == "_" {
+			continue
+		}
+
+		zero := zeroResult(lit.Type, content, fset)
+		if zero == "" {
+			continue
+		}
+
+		mutations = append(mutations, findRecursiveInBody(lit.Body, callsIdent(ident.Name), zero, fset, content, source)...)
+	}
+
+	return mutations
+}
+
+// receiverName returns the name of a method's receiver, or "" if it is unnamed.
+func receiverName(funcDecl *ast.FuncDecl) string {
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 || len(funcDecl.Recv.List[0].Names) == 0 {
+		return ""
+	}
+
+	name := funcDecl.Recv.List[0].Names[0].Name
+	if name == "_" {
+		return ""
+	}
+
+	return name
+}
+
+// callsIdent matches calls of the form name(...), including explicitly
+// instantiated generic calls such as name[T](...).
+func callsIdent(name string) func(*ast.CallExpr) bool {
+	return func(call *ast.CallExpr) bool {
+		fun := call.Fun
+
+		switch index := fun.(type) {
+		case *ast.IndexExpr:
+			fun = index.X
+		case *ast.IndexListExpr:
+			fun = index.X
+		}
+
+		ident, ok := fun.(*ast.Ident)
+
+		return ok && ident.Name == name
+	}
+}
+
+// findRecursiveInBody replaces the recursive calls found in the return
+// statements of body with zero.
+func findRecursiveInBody(body *ast.BlockStmt, isRecursive func(*ast.CallExpr) bool, zero string, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
 	var mutations []m.Mutation
 
 	// Find all recursive calls in the function body
-	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+	ast.Inspect(body, func(n ast.Node) bool {
 		// Look for return statements with recursive calls
 		if retStmt, ok := n.(*ast.ReturnStmt); ok {
 			for _, result := range retStmt.Results {
-				mutations = append(mutations, findRecursiveInExpr(result, funcName, fset, content, source)...)
+				mutations = append(mutations, findRecursiveInExpr(result, isRecursive, zero, fset, content, source)...)
 			}
 		}
 
@@ -235,14 +627,14 @@ func mutateRecursiveCalls(funcDecl *ast.FuncDecl, fset *token.FileSet, content [
 }
 
 // findRecursiveInExpr finds recursive calls in an expression.
-func findRecursiveInExpr(expr ast.Expr, funcName string, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+func findRecursiveInExpr(expr ast.Expr, isRecursive func(*ast.CallExpr) bool, zero string, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
 	var mutations []m.Mutation
 
 	ast.Inspect(expr, func(n ast.Node) bool {
 		if call, ok := n.(*ast.CallExpr); ok {
-			if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == funcName {
+			if isRecursive(call) {
 				// Found a recursive call - remove it
-				mutation := removeRecursiveCallExpr(call, fset, content, source)
+				mutation := removeRecursiveCallExpr(call, zero, fset, content, source)
 				if mutation != nil {
 					mutations = append(mutations, *mutation)
 				}
@@ -255,8 +647,9 @@ func findRecursiveInExpr(expr ast.Expr, funcName string, fset *token.FileSet, co
 	return mutations
 }
 
-// removeRecursiveCallExpr creates a mutation that removes a recursive call expression.
-func removeRecursiveCallExpr(call *ast.CallExpr, fset *token.FileSet, content []byte, source m.Source) *m.Mutation {
+// removeRecursiveCallExpr creates a mutation that replaces a recursive call
+// expression with zero.
+func removeRecursiveCallExpr(call *ast.CallExpr, zero string, fset *token.FileSet, content []byte, source m.Source) *m.Mutation {
 	offset, ok1 := offsetForPos(fset, call.Pos())
 	endOffset, ok2 := offsetForPos(fset, call.End())
 
@@ -264,17 +657,16 @@ func removeRecursiveCallExpr(call *ast.CallExpr, fset *token.FileSet, content []
 		return nil
 	}
 
-	// Replace the call with a default value (0 for simplicity)
-	mutated := replaceRange(content, offset, endOffset, "0")
+	mutated := replaceRange(content, offset, endOffset, zero)
 	diff := diffCode(content, mutated)
 
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-recursion-%d", source.Origin.FullPath, m.MutationLoop.Name, offset)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationLoop.Name, "recursion", offset)[:16]
 
 	return &m.Mutation{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationLoop,
+		Position:    positionForPos(fset, call.Pos()),
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}
@@ -2,8 +2,10 @@ package mutagens
 
 import (
 	"go/ast"
+	"go/importer"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"os"
 	"path/filepath"
 	"strings"
@@ -322,3 +324,353 @@ func TestGenerateLoopMutations_RecursiveCallRemoval(t *testing.T) {
 		t.Error("expected recursive call mutation")
 	}
 }
+
+func TestGenerateLoopMutations_WhileStyleLoop(t *testing.T) {
+	source := `package main
+
+func drain(x int) int {
+	count := 0
+	for x > 0 {
+		x--
+		count++
+	}
+	return count
+}
+`
+	mutations := generateLoopMutationsForSource(t, source)
+
+	var foundFalse, foundBreak, foundBoundary bool
+	for _, mutation := range mutations {
+		code := string(mutation.MutatedCode)
+		switch {
+		case strings.Contains(code, "for false {"):
+			foundFalse = true
+		case strings.Contains(code, "\t\tcount++\n\t\tbreak\n\t}"):
+			foundBreak = true
+		case strings.Contains(code, "for x >= 0 {"):
+			foundBoundary = true
+		}
+
+		if strings.Contains(code, "for x > 0 {\n\t}") {
+			t.Fatalf("expected no body removal for while-style loop, got:\n%s", code)
+		}
+	}
+
+	if !foundFalse || !foundBreak || !foundBoundary {
+		t.Fatalf("expected condition, break and boundary mutations (false=%v break=%v boundary=%v)", foundFalse, foundBreak, foundBoundary)
+	}
+
+	if len(mutations) != 3 {
+		t.Fatalf("expected 3 mutations, got %d", len(mutations))
+	}
+}
+
+func TestGenerateLoopMutations_InfiniteLoop(t *testing.T) {
+	source := `package main
+
+func spin(limit int) int {
+	steps := 0
+	for {
+		steps++
+		if steps >= limit {
+			return steps
+		}
+	}
+}
+
+func idle() {
+	for {}
+}
+`
+	mutations := generateLoopMutationsForSource(t, source)
+
+	if len(mutations) != 4 {
+		t.Fatalf("expected an injected exit and a cap per infinite loop, got %d", len(mutations))
+	}
+
+	if !strings.Contains(string(mutations[0].MutatedCode), "\t\t}\n\t\treturn 0\n\t}") {
+		t.Fatalf("expected return injected at end of terminating loop body, got:\n%s", mutations[0].MutatedCode)
+	}
+
+	if !strings.Contains(string(mutations[1].MutatedCode), "for goozeIteration := 0; ; goozeIteration++ {\n\t\tif goozeIteration == 3 {\n\t\t\treturn 0\n\t\t}\n\t\tsteps++") {
+		t.Fatalf("expected loop capped at three iterations, got:\n%s", mutations[1].MutatedCode)
+	}
+
+	if !strings.Contains(string(mutations[2].MutatedCode), "for {\n\t\tbreak\n\t}") {
+		t.Fatalf("expected break injected into empty loop, got:\n%s", mutations[2].MutatedCode)
+	}
+
+	for _, mutation := range mutations {
+		typeCheckMutant(t, mutation)
+	}
+}
+
+func TestGenerateLoopMutations_InfiniteLoopMutantsCompile(t *testing.T) {
+	source := `package main
+
+func next(xs []int) int {
+	i := 0
+	for {
+		if xs[i] > 0 {
+			return xs[i]
+		}
+		i++
+	}
+}
+
+func pair(xs []int) (first, last int, err error) {
+	for {
+		go func() {
+			for {
+				break
+			}
+		}()
+		switch {
+		case len(xs) == 0:
+			break
+		}
+		return xs[0], xs[len(xs)-1], nil
+	}
+}
+
+func find(xs []int) int {
+	i := 0
+outer:
+	for {
+		for {
+			if xs[i] > 0 {
+				break outer
+			}
+			i++
+		}
+	}
+	return xs[i]
+}
+
+func drain(ch chan int) {
+	for {
+		if _, ok := <-ch; !ok {
+			return
+		}
+	}
+}
+`
+	mutations := generateLoopMutationsForSource(t, source)
+
+	var returns, breaks, caps int
+
+	for _, mutation := range mutations {
+		code := string(mutation.MutatedCode)
+
+		switch {
+		case strings.Contains(code, "goozeIteration"):
+			caps++
+		case strings.Contains(code, "return 0\n") || strings.Contains(code, "return 0, 0, nil\n"):
+			returns++
+		case strings.Count(code, "break") > strings.Count(source, "break"):
+			breaks++
+		default:
+			continue
+		}
+
+		typeCheckMutant(t, mutation)
+	}
+
+	// The loops of next and pair and the inner loop of find have no break
+	// out of them, so they return. The outer loop of find is left by a
+	// labeled break, and the loops of drain and the closure in pair belong
+	// to functions without results, so they keep a break.
+	if returns != 3 || breaks != 3 || caps != 6 {
+		t.Fatalf("expected 3 injected returns, 3 injected breaks and 6 caps, got %d, %d and %d", returns, breaks, caps)
+	}
+}
+
+// typeCheckMutant fails the test unless the mutated code of mutation compiles.
+func typeCheckMutant(t *testing.T, mutation m.Mutation) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "mutated.go", mutation.MutatedCode, 0)
+	if err != nil {
+		t.Fatalf("mutated code does not parse: %v\n%s", err, mutation.MutatedCode)
+	}
+
+	conf := types.Config{Importer: importer.Default()}
+	if _, err := conf.Check("main", fset, []*ast.File{file}, nil); err != nil {
+		t.Fatalf("mutated code does not type-check: %v\n%s", err, mutation.MutatedCode)
+	}
+}
+
+func generateLoopMutationsForSource(t *testing.T, source string) []m.Mutation {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", source, 0)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{
+		Origin: &m.File{FullPath: m.Path("test.go")},
+	}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateLoopMutations(n, fset, []byte(source), src)...)
+		return true
+	})
+
+	return mutations
+}
+
+func TestGenerateLoopMutations_RecursiveClosure(t *testing.T) {
+	source := `package main
+
+func factorial(n int) int {
+	var fact func(k int) int
+	fact = func(k int) int {
+		if k <= 1 {
+			return 1
+		}
+		return k * fact(k-1)
+	}
+	return fact(n)
+}
+`
+	mutations := generateLoopMutationsForSource(t, source)
+
+	if len(mutations) != 1 {
+		t.Fatalf("expected 1 recursive closure mutation, got %d", len(mutations))
+	}
+
+	if !strings.Contains(string(mutations[0].MutatedCode), "return k * 0") {
+		t.Fatalf("expected recursive closure call replaced with 0, got:\n%s", mutations[0].MutatedCode)
+	}
+}
+
+func TestGenerateLoopMutations_RecursiveMethod(t *testing.T) {
+	source := `package main
+
+type list struct {
+	value int
+	next  *list
+}
+
+func (l *list) nth(n int) int {
+	if n == 0 {
+		return l.value
+	}
+	return l.next.value + l.nth(n-1)
+}
+
+func (l *list) total() int {
+	return sum()
+}
+
+func sum() int {
+	return 0
+}
+`
+	mutations := generateLoopMutationsForSource(t, source)
+
+	if len(mutations) != 1 {
+		t.Fatalf("expected 1 recursive method mutation, got %d", len(mutations))
+	}
+
+	if !strings.Contains(string(mutations[0].MutatedCode), "return l.next.value + 0") {
+		t.Fatalf("unexpected recursive method mutation:\n%s", mutations[0].MutatedCode)
+	}
+}
+
+func TestGenerateLoopMutations_MethodDelegationIsNotRecursion(t *testing.T) {
+	source := `package main
+
+import "net"
+
+type conn struct {
+	conn net.Conn
+	next *conn
+}
+
+func (c *conn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *conn) Len() int {
+	return c.next.Len()
+}
+`
+	mutations := generateLoopMutationsForSource(t, source)
+
+	if len(mutations) != 0 {
+		t.Fatalf("expected no mutation of calls through the receiver's fields, got:\n%s", mutations[0].MutatedCode)
+	}
+}
+
+func TestGenerateLoopMutations_RecursiveCallZeroValues(t *testing.T) {
+	source := `package main
+
+type point struct{ x, y int }
+
+func name(n int) string {
+	return "a" + name(n-1)
+}
+
+func find(n int) error {
+	return find(n - 1)
+}
+
+func origin(n int) point {
+	return origin(n - 1)
+}
+
+func pair(n int) (int, error) {
+	return pair(n - 1)
+}
+`
+	mutations := generateLoopMutationsForSource(t, source)
+
+	want := []string{`return "a" + ""`, "return nil", "return *new(point)"}
+	if len(mutations) != len(want) {
+		t.Fatalf("expected %d recursive call mutations, got %d", len(want), len(mutations))
+	}
+
+	for i, mutation := range mutations {
+		if !strings.Contains(string(mutation.MutatedCode), want[i]) {
+			t.Fatalf("expected %q in mutation %d, got:\n%s", want[i], i, mutation.MutatedCode)
+		}
+	}
+}
+
+func TestGenerateLoopMutations_RecursiveGenericInstantiation(t *testing.T) {
+	source := `package main
+
+func Sum[T int | float64](values []T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	return values[0] + Sum[T](values[1:])
+}
+
+func Fold[K comparable, V int](m map[K]V, keys []K) V {
+	if len(keys) == 0 {
+		return 0
+	}
+	return m[keys[0]] + Fold[K, V](m, keys[1:])
+}
+`
+	mutations := generateLoopMutationsForSource(t, source)
+
+	if len(mutations) != 2 {
+		t.Fatalf("expected 2 recursive generic call mutations, got %d", len(mutations))
+	}
+
+	if !strings.Contains(string(mutations[0].MutatedCode), "return values[0] + *new(T)") {
+		t.Fatalf("expected Sum[T] call replaced with the zero T, got:\n%s", mutations[0].MutatedCode)
+	}
+
+	if !strings.Contains(string(mutations[1].MutatedCode), "return m[keys[0]] + *new(V)") {
+		t.Fatalf("expected Fold[K, V] call replaced with the zero V, got:\n%s", mutations[1].MutatedCode)
+	}
+}
@@ -0,0 +1,80 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/token"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// builtinSwaps pairs the min and max builtins of Go 1.21.
+var builtinSwaps = map[string]string{
+	"min": "max",
+	"max": "min",
+}
+
+// mathSwaps pairs functions of the math package whose results bound the
+// same value from opposite sides.
+var mathSwaps = map[string]string{
+	"Floor": "Ceil",
+	"Ceil":  "Floor",
+	"Min":   "Max",
+	"Max":   "Min",
+}
+
+// GenerateMathMutations swaps calls to min and max builtins, math.Min and
+// math.Max, and math.Floor and math.Ceil for the given AST node.
+func GenerateMathMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+
+	name, replacement := mathCallSwap(call.Fun)
+	if name == nil {
+		return nil
+	}
+
+	start, ok := offsetForPos(fset, name.Pos())
+	if !ok {
+		return nil
+	}
+
+	mutated := replaceRange(content, start, start+len(name.Name), replacement)
+	diff := diffCode(content, mutated)
+
+	id := mutationID(source, m.MutationMath.Name, start)[:16]
+
+	return []m.Mutation{{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationMath,
+		Position:    positionForPos(fset, name.Pos()),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diff,
+	}}
+}
+
+// mathCallSwap returns the identifier to replace in a call's function and
+// its replacement, or nil when the call is not one to swap. Builtins are
+// only recognized when no declaration in the file shadows them, and math
+// only when it does not resolve to a local variable.
+func mathCallSwap(fun ast.Expr) (*ast.Ident, string) {
+	switch fn := fun.(type) {
+	case *ast.Ident:
+		if replacement, ok := builtinSwaps[fn.Name]; ok && fn.Obj == nil {
+			return fn, replacement
+		}
+	case *ast.SelectorExpr:
+		pkg, ok := fn.X.(*ast.Ident)
+		if !ok || pkg.Name != "math" || pkg.Obj != nil {
+			return nil, ""
+		}
+
+		if replacement, ok := mathSwaps[fn.Sel.Name]; ok {
+			return fn.Sel, replacement
+		}
+	}
+
+	return nil, ""
+}
@@ -0,0 +1,87 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestGenerateMathMutations_SwapsPairedCalls(t *testing.T) {
+	examplePath := filepath.Join("..", "..", "..", "examples", "mathcalls", "main.go")
+	content, err := os.ReadFile(examplePath)
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, examplePath, content, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{Origin: &m.File{FullPath: m.Path(examplePath)}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateMathMutations(n, fset, content, src)...)
+		return true
+	})
+
+	// max, min, math.Ceil, math.Floor, math.Max and math.Min; the shadowed
+	// max closure and math.Abs are left alone.
+	if len(mutations) != 6 {
+		t.Fatalf("expected 6 math mutations, got %d", len(mutations))
+	}
+
+	for _, want := range []string{
+		"return min(low, min(value, high))",
+		"return max(low, max(value, high))",
+		"return math.Floor(items / perPage)",
+		"return math.Ceil(value/width) * width",
+		"return math.Min(a, b) - math.Min(a, b)",
+		"return math.Max(a, b) - math.Max(a, b)",
+	} {
+		found := false
+
+		for _, mutation := range mutations {
+			if mutation.Type != m.MutationMath {
+				t.Fatalf("expected type %v, got %v", m.MutationMath, mutation.Type)
+			}
+
+			if strings.Contains(string(mutation.MutatedCode), want) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("expected a mutation producing %q", want)
+		}
+	}
+}
+
+func TestGenerateMathMutations_IgnoresOtherNodes(t *testing.T) {
+	fset := token.NewFileSet()
+	src := []byte("package p\n\nfunc f() int { return 1 + 2 }\n")
+
+	file, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateMathMutations(n, fset, src, m.Source{Origin: &m.File{FullPath: "p.go"}})...)
+		return true
+	})
+
+	if len(mutations) != 0 {
+		t.Fatalf("expected no mutations, got %d", len(mutations))
+	}
+}
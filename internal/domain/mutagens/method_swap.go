@@ -0,0 +1,101 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// GenerateMethodSwapMutations generates method swap mutations for the
+// given AST node, using the types in info. A method call is swapped for a
+// call to each other method of the receiver with an identical signature,
+// as mu.Lock() for mu.Unlock() or t.Hour() for t.Minute(), so the mutant
+// compiles but does something else. Exported methods are swapped only with
+// exported ones; unexported methods also with the unexported methods of
+// their own package, which the call can reach. Calls whose receiver type is
+// unknown, as when info is partial, are skipped.
+func GenerateMethodSwapMutations(n ast.Node, info *types.Info, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || info == nil {
+		return nil
+	}
+
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return nil
+	}
+
+	method, ok := selection.Obj().(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	start, ok1 := offsetForPos(fset, sel.Sel.Pos())
+	end, ok2 := offsetForPos(fset, sel.Sel.End())
+
+	if !ok1 || !ok2 {
+		return nil
+	}
+
+	var mutations []m.Mutation
+
+	addressable := info.Types[sel.X].Addressable()
+
+	for _, swap := range swappableMethods(selection.Recv(), method, addressable) {
+		mutated := replaceRange(content, start, end, swap.Name())
+		id := mutationID(source, m.MutationMethodSwap.Name, start, swap.Name())[:16]
+
+		mutations = append(mutations, m.Mutation{
+			ID:          id,
+			Source:      source,
+			Type:        m.MutationMethodSwap,
+			Position:    positionForPos(fset, sel.Sel.Pos()),
+			MutatedCode: ensureTrailingNewline(mutated),
+			DiffCode:    diffCode(content, mutated),
+		})
+	}
+
+	return mutations
+}
+
+// swappableMethods returns the methods of recv, other than method, whose
+// signature is identical to method's. Pointer methods count for a value
+// receiver only when it is addressable: a call on f() or m[k] cannot take
+// their address.
+func swappableMethods(recv types.Type, method *types.Func, addressable bool) []*types.Func {
+	if _, isPointer := recv.(*types.Pointer); addressable && !isPointer && !types.IsInterface(recv) {
+		recv = types.NewPointer(recv)
+	}
+
+	signature, ok := method.Type().(*types.Signature)
+	if !ok {
+		return nil
+	}
+
+	methods := types.NewMethodSet(recv)
+	swaps := make([]*types.Func, 0, methods.Len())
+
+	for i := range methods.Len() {
+		candidate, ok := methods.At(i).Obj().(*types.Func)
+		if !ok || candidate.Name() == method.Name() {
+			continue
+		}
+
+		if !candidate.Exported() && (method.Exported() || candidate.Pkg() != method.Pkg()) {
+			continue
+		}
+
+		if types.Identical(candidate.Type(), signature) {
+			swaps = append(swaps, candidate)
+		}
+	}
+
+	return swaps
+}
@@ -0,0 +1,147 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func generateMethodSwapMutations(t *testing.T, code string) []m.Mutation {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", code, 0)
+	if err != nil {
+		t.Fatalf("failed to parse code: %v", err)
+	}
+
+	info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue), Selections: make(map[*ast.SelectorExpr]*types.Selection)}
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil), Error: func(error) {}}
+	_, _ = config.Check("p", fset, []*ast.File{file}, info)
+
+	source := m.Source{Origin: &m.File{FullPath: "test.go"}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateMethodSwapMutations(n, info, fset, []byte(code), source)...)
+		return true
+	})
+
+	return mutations
+}
+
+func TestGenerateMethodSwapMutations_Mutex(t *testing.T) {
+	code := "package p\n\nimport \"sync\"\n\nvar mu sync.Mutex\n\nfunc f() {\n\tmu.Lock()\n\tdefer mu.Unlock()\n}\n"
+
+	mutations := generateMethodSwapMutations(t, code)
+
+	// Lock -> Unlock and Unlock -> Lock; TryLock returns a bool.
+	if len(mutations) != 2 {
+		t.Fatalf("expected 2 mutations, got %d", len(mutations))
+	}
+
+	if got := string(mutations[0].MutatedCode); !strings.Contains(got, "\tmu.Unlock()\n\tdefer mu.Unlock()") {
+		t.Errorf("expected Lock swapped for Unlock:\n%s", mutations[0].DiffCode)
+	}
+
+	if got := string(mutations[1].MutatedCode); !strings.Contains(got, "\tmu.Lock()\n\tdefer mu.Lock()") {
+		t.Errorf("expected Unlock swapped for Lock:\n%s", mutations[1].DiffCode)
+	}
+
+	for _, mutation := range mutations {
+		if mutation.Type != m.MutationMethodSwap {
+			t.Fatalf("expected type %v, got %v", m.MutationMethodSwap, mutation.Type)
+		}
+	}
+}
+
+func TestGenerateMethodSwapMutations_Signatures(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		expectedCount int
+	}{
+		{
+			name:          "same package methods",
+			code:          "package p\n\ntype c struct{ n int }\n\nfunc (x *c) Inc() { x.n++ }\nfunc (x *c) Dec() { x.n-- }\nfunc (x *c) Set(n int) { x.n = n }\n\nfunc f(x c) { x.Inc() }\n",
+			expectedCount: 1, // Inc -> Dec; Set takes an argument
+		},
+		{
+			name:          "unexported methods of the package",
+			code:          "package p\n\ntype c struct{ n int }\n\nfunc (x c) up() int { return x.n + 1 }\nfunc (x c) Down() int { return x.n - 1 }\nfunc (x c) twice() int { return x.n * 2 }\n\nfunc f(x c) int { return x.up() }\n",
+			expectedCount: 2, // up -> Down, up -> twice
+		},
+		{
+			name:          "exported call keeps to exported methods",
+			code:          "package p\n\ntype c struct{ n int }\n\nfunc (x c) Down() int { return x.n - 1 }\nfunc (x c) up() int { return x.n + 1 }\n\nfunc f(x c) int { return x.Down() }\n",
+			expectedCount: 0,
+		},
+		{
+			name:          "addressable value reaches pointer methods",
+			code:          "package p\n\ntype c struct{ n int }\n\nfunc (x c) Get() int { return x.n }\nfunc (x *c) Next() int { x.n++; return x.n }\n\nfunc f(x c) int { return x.Get() }\n",
+			expectedCount: 1, // Get -> Next
+		},
+		{
+			name:          "call result cannot reach pointer methods",
+			code:          "package p\n\ntype c struct{ n int }\n\nfunc (x c) Get() int { return x.n }\nfunc (x *c) Next() int { x.n++; return x.n }\n\nfunc mk() c { return c{} }\n\nfunc f() int { return mk().Get() }\n",
+			expectedCount: 0,
+		},
+		{
+			name:          "map element cannot reach pointer methods",
+			code:          "package p\n\ntype c struct{ n int }\n\nfunc (x c) Get() int { return x.n }\nfunc (x *c) Next() int { x.n++; return x.n }\n\nfunc f(m map[string]c) int { return m[\"k\"].Get() }\n",
+			expectedCount: 0,
+		},
+		{
+			name:          "interface receiver",
+			code:          "package p\n\ntype rw interface {\n\tRead([]byte) (int, error)\n\tWrite([]byte) (int, error)\n}\n\nfunc f(x rw, b []byte) { x.Read(b) }\n",
+			expectedCount: 1, // Read -> Write
+		},
+		{
+			name:          "function call",
+			code:          "package p\n\nfunc g() {}\nfunc h() {}\n\nfunc f() { g() }\n",
+			expectedCount: 0,
+		},
+		{
+			name:          "unknown receiver",
+			code:          "package p\n\nfunc f() { missing.Lock() }\n",
+			expectedCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutations := generateMethodSwapMutations(t, tt.code)
+			if len(mutations) != tt.expectedCount {
+				t.Fatalf("expected %d mutations, got %d", tt.expectedCount, len(mutations))
+			}
+		})
+	}
+}
+
+func TestGenerateMethodSwapMutations_NoTypes(t *testing.T) {
+	code := "package p\n\nimport \"sync\"\n\nfunc f(mu *sync.Mutex) { mu.Lock() }\n"
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", code, 0)
+	if err != nil {
+		t.Fatalf("failed to parse code: %v", err)
+	}
+
+	source := m.Source{Origin: &m.File{FullPath: "test.go"}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateMethodSwapMutations(n, nil, fset, []byte(code), source)...)
+		return true
+	})
+
+	if len(mutations) != 0 {
+		t.Fatalf("expected no mutations without type information, got %d", len(mutations))
+	}
+}
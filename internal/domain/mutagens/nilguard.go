@@ -0,0 +1,131 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/token"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// GenerateNilGuardMutations generates nil guard mutations for the given AST
+// node. Each guard a function or function literal opens with, such as:
+//
+//	if r == nil {
+//		return nil, errNilReader
+//	}
+//
+// is removed in turn, so the function runs on with the nil value. A guard
+// is an if statement without init or else whose condition only compares
+// values to nil with == (joined by ||), and whose body ends by returning or
+// panicking. The guards checked are the leading statements of the body, up
+// to the first statement that is not one; a mutant surviving means no test
+// passes the function nil.
+func GenerateNilGuardMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	var body *ast.BlockStmt
+
+	switch fn := n.(type) {
+	case *ast.FuncDecl:
+		body = fn.Body
+	case *ast.FuncLit:
+		body = fn.Body
+	default:
+		return nil
+	}
+
+	if body == nil {
+		return nil
+	}
+
+	var mutations []m.Mutation
+
+	for _, stmt := range body.List {
+		guard, ok := stmt.(*ast.IfStmt)
+		if !ok || !isNilGuard(guard) {
+			break
+		}
+
+		if mutation, ok := removeNilGuard(guard, fset, content, source); ok {
+			mutations = append(mutations, mutation)
+		}
+	}
+
+	return mutations
+}
+
+// isNilGuard reports whether stmt is an early exit taken on a nil value.
+func isNilGuard(stmt *ast.IfStmt) bool {
+	if stmt.Init != nil || stmt.Else != nil || len(stmt.Body.List) == 0 {
+		return false
+	}
+
+	return isNilCondition(stmt.Cond) && exitsFunction(stmt.Body.List[len(stmt.Body.List)-1])
+}
+
+// isNilCondition reports whether cond is a comparison of a value to nil
+// with ==, or several joined by ||.
+func isNilCondition(cond ast.Expr) bool {
+	cond = ast.Unparen(cond)
+
+	bin, ok := cond.(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+
+	switch bin.Op { //nolint:exhaustive
+	case token.LOR:
+		return isNilCondition(bin.X) && isNilCondition(bin.Y)
+	case token.EQL:
+		return isNilIdent(bin.X) != isNilIdent(bin.Y)
+	default:
+		return false
+	}
+}
+
+func isNilIdent(expr ast.Expr) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+
+	return ok && ident.Name == "nil"
+}
+
+// exitsFunction reports whether stmt returns or calls panic.
+func exitsFunction(stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+
+		ident, ok := call.Fun.(*ast.Ident)
+
+		return ok && ident.Name == "panic"
+	default:
+		return false
+	}
+}
+
+// removeNilGuard removes guard, with its lines when it has them to itself.
+func removeNilGuard(guard *ast.IfStmt, fset *token.FileSet, content []byte, source m.Source) (m.Mutation, bool) {
+	start, ok1 := offsetForPos(fset, guard.Pos())
+	end, ok2 := offsetForPos(fset, guard.End())
+
+	if !ok1 || !ok2 {
+		return m.Mutation{}, false
+	}
+
+	start, end = ownLineBounds(content, start, end)
+	mutated := replaceRange(content, start, end, "")
+
+	id := mutationID(source, m.MutationNilGuard.Name, start)[:16]
+
+	return m.Mutation{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationNilGuard,
+		Position:    positionForPos(fset, guard.Pos()),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diffCode(content, mutated),
+	}, true
+}
@@ -0,0 +1,99 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func generateNilGuardMutations(t *testing.T, path string, content []byte) []m.Mutation {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, content, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{Origin: &m.File{FullPath: m.Path(path)}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateNilGuardMutations(n, fset, content, src)...)
+		return true
+	})
+
+	return mutations
+}
+
+func TestGenerateNilGuardMutations_Example(t *testing.T) {
+	examplePath := filepath.Join("..", "..", "..", "examples", "nilguards", "main.go")
+	content, err := os.ReadFile(examplePath)
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	mutations := generateNilGuardMutations(t, examplePath, content)
+
+	// Address, Name, Merge (its guard panics) and both guards of Copy;
+	// Lookup opens with an assignment.
+	if len(mutations) != 5 {
+		t.Fatalf("expected 5 nil guard mutations, got %d", len(mutations))
+	}
+
+	for _, mutation := range mutations {
+		if mutation.Type != m.MutationNilGuard {
+			t.Fatalf("expected type %v, got %v", m.MutationNilGuard, mutation.Type)
+		}
+
+		if _, err := parser.ParseFile(token.NewFileSet(), "mutated.go", mutation.MutatedCode, 0); err != nil {
+			t.Fatalf("mutation at line %d does not parse: %v", mutation.Position.Line, err)
+		}
+	}
+
+	first := string(mutations[0].MutatedCode)
+	if strings.Contains(first, "if c == nil {\n\t\treturn \"\", errNoConfig") {
+		t.Errorf("expected the guard of Address removed:\n%s", mutations[0].DiffCode)
+	}
+
+	if !strings.Contains(first, "func Address(c *Config) (string, error) {\n\n\treturn fmt.Sprintf") {
+		t.Errorf("expected the guard's lines removed with it:\n%s", mutations[0].DiffCode)
+	}
+}
+
+func TestGenerateNilGuardMutations_Guards(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expectedCount int
+	}{
+		{"return", "if p == nil {\n\t\treturn 0\n\t}\n\treturn *p", 1},
+		{"nil first", "if nil == p {\n\t\treturn 0\n\t}\n\treturn *p", 1},
+		{"panic", "if p == nil {\n\t\tpanic(\"nil\")\n\t}\n\treturn *p", 1},
+		{"parenthesized or", "if (p == nil) || q == nil {\n\t\treturn 0\n\t}\n\treturn *p", 1},
+		{"not equal", "if p != nil {\n\t\treturn *p\n\t}\n\treturn 0", 0},
+		{"and", "if p == nil && q == nil {\n\t\treturn 0\n\t}\n\treturn *p", 0},
+		{"no exit", "if p == nil {\n\t\tp = new(int)\n\t}\n\treturn *p", 0},
+		{"else", "if p == nil {\n\t\treturn 0\n\t} else {\n\t\treturn *p\n\t}", 0},
+		{"init", "if x := p; x == nil {\n\t\treturn 0\n\t}\n\treturn *p", 0},
+		{"not at entry", "n := 1\n\tif p == nil {\n\t\treturn n\n\t}\n\treturn *p", 0},
+		{"func literal", "f := func() int {\n\t\tif q == nil {\n\t\t\treturn 0\n\t\t}\n\t\treturn *q\n\t}\n\treturn f()", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := "package main\n\nfunc f(p, q *int) int {\n\t" + tt.body + "\n}\n"
+
+			mutations := generateNilGuardMutations(t, "test.go", []byte(code))
+			if len(mutations) != tt.expectedCount {
+				t.Fatalf("expected %d mutations, got %d", tt.expectedCount, len(mutations))
+			}
+		})
+	}
+}
@@ -1,21 +1,33 @@
 package mutagens
 
 import (
-	"crypto/sha256"
-	"fmt"
 	"go/ast"
 	"go/constant"
 	"go/token"
+	"slices"
+	"strconv"
+	"strings"
 
 	m "github.com/mouse-blink/gooze/internal/model"
 )
 
-// GenerateNumberMutations generates numeric literal mutations for the given AST node.
-//
-// Currently supported:
-//   - token.INT:  mutate to 0 and/or 1
-//   - token.FLOAT: mutate to 0.0 and/or 1.0
+// GenerateNumberMutations generates numeric literal mutations for the given
+// AST node with every number variant: n becomes 0, 1, n+1, n-1 and -n.
+// Variants equal to n or to an earlier variant are dropped, so 1 becomes
+// 0, 2 and -1 only. Char and imaginary literals are not mutated.
 func GenerateNumberMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	return generateNumberMutations(n, fset, content, source, m.NumberVariants)
+}
+
+// NumberMutations returns a generator like GenerateNumberMutations that
+// generates only variants, in the order of m.NumberVariants.
+func NumberMutations(variants ...m.NumberVariant) func(ast.Node, *token.FileSet, []byte, m.Source) []m.Mutation {
+	return func(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+		return generateNumberMutations(n, fset, content, source, variants)
+	}
+}
+
+func generateNumberMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source, variants []m.NumberVariant) []m.Mutation {
 	lit, ok := n.(*ast.BasicLit)
 	if !ok {
 		return nil
@@ -32,7 +44,7 @@ func GenerateNumberMutations(n ast.Node, fset *token.FileSet, content []byte, so
 
 	end := start + len(lit.Value)
 
-	alternatives := numberAlternatives(lit.Kind, lit.Value)
+	alternatives := numberAlternatives(lit.Kind, lit.Value, variants)
 	if len(alternatives) == 0 {
 		return nil
 	}
@@ -41,12 +53,12 @@ func GenerateNumberMutations(n ast.Node, fset *token.FileSet, content []byte, so
 	for _, alt := range alternatives {
 		mutatedCode := replaceRange(content, start, end, alt)
 		diff := diffCode(content, mutatedCode)
-		h := sha256.Sum256(mutatedCode)
-		id := fmt.Sprintf("%x", h)
+		id := mutationID(source, mutatedCode)
 		mutations = append(mutations, m.Mutation{
 			ID:          id,
 			Source:      source,
 			Type:        m.MutationNumbers,
+			Position:    positionForPos(fset, lit.Pos()),
 			MutatedCode: mutatedCode,
 			DiffCode:    diff,
 		})
@@ -55,31 +67,94 @@ func GenerateNumberMutations(n ast.Node, fset *token.FileSet, content []byte, so
 	return mutations
 }
 
-func numberAlternatives(kind token.Token, literal string) []string {
+// numberAlternatives returns the literals replacing literal for each of
+// variants that is enabled, skipping any whose value equals the original's
+// or an earlier alternative's.
+func numberAlternatives(kind token.Token, literal string, variants []m.NumberVariant) []string {
 	original := constant.MakeFromLiteral(literal, kind, 0)
 	if original.Kind() == constant.Unknown {
 		return nil
 	}
 
-	switch kind { //nolint:exhaustive
-	case token.INT:
-		return numericAlternatives(original, constant.MakeInt64(0), constant.MakeInt64(1), "0", "1")
-	case token.FLOAT:
-		return numericAlternatives(original, constant.MakeFloat64(0), constant.MakeFloat64(1), "0.0", "1.0")
-	default:
+	if kind != token.INT && kind != token.FLOAT {
 		return nil
 	}
+
+	seen := []constant.Value{original}
+	alternatives := make([]string, 0, len(variants))
+
+	for _, variant := range m.NumberVariants {
+		if !slices.Contains(variants, variant) {
+			continue
+		}
+
+		text, value := numberLiteral(kind, numberVariantValue(original, variant))
+		if containsValue(seen, value) {
+			continue
+		}
+
+		seen = append(seen, value)
+		alternatives = append(alternatives, text)
+	}
+
+	return alternatives
 }
 
-func numericAlternatives(original, zero, one constant.Value, zeroLit, oneLit string) []string {
-	variants := make([]string, 0, 2)
-	if !constant.Compare(original, token.EQL, zero) {
-		variants = append(variants, zeroLit)
+func numberVariantValue(original constant.Value, variant m.NumberVariant) constant.Value {
+	one := constant.MakeInt64(1)
+
+	switch variant {
+	case m.NumberZero:
+		return constant.MakeInt64(0)
+	case m.NumberOne:
+		return one
+	case m.NumberIncrement:
+		return constant.BinaryOp(original, token.ADD, one)
+	case m.NumberDecrement:
+		return constant.BinaryOp(original, token.SUB, one)
+	case m.NumberNegate:
+		return constant.UnaryOp(token.SUB, original, 0)
+	default:
+		return original
+	}
+}
+
+// numberLiteral writes value as a literal of kind, in parentheses when
+// negative so that it cannot merge with a preceding operator, and returns
+// the value the literal denotes: a float may round when written.
+func numberLiteral(kind token.Token, value constant.Value) (string, constant.Value) {
+	negative := constant.Sign(value) < 0
+	if negative {
+		value = constant.UnaryOp(token.SUB, value, 0)
+	}
+
+	var text string
+
+	if kind == token.FLOAT {
+		f, _ := constant.Float64Val(value)
+
+		text = strconv.FormatFloat(f, 'g', -1, 64)
+		if !strings.ContainsAny(text, ".eE") {
+			text += ".0"
+		}
+	} else {
+		text = value.ExactString()
+	}
+
+	written := constant.MakeFromLiteral(text, kind, 0)
+	if !negative {
+		return text, written
 	}
 
-	if !constant.Compare(original, token.EQL, one) {
-		variants = append(variants, oneLit)
+	return "(-" + text + ")", constant.UnaryOp(token.SUB, written, 0)
+}
+
+func containsValue(values []constant.Value, value constant.Value) bool {
+	for _, v := range values {
+		if constant.Compare(v, token.EQL, value) {
+			return true
+		}
 	}
 
-	return variants
+	return false
 }
@@ -4,6 +4,7 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"reflect"
 	"testing"
 
 	m "github.com/mouse-blink/gooze/internal/model"
@@ -18,22 +19,27 @@ func TestGenerateNumberMutations(t *testing.T) {
 		{
 			name:          "int literal",
 			code:          "package main\nfunc f() int { return 5 }",
-			expectedCount: 2, // 5 -> 0, 5 -> 1
+			expectedCount: 5, // 5 -> 0, 1, 6, 4, -5
 		},
 		{
 			name:          "zero int literal",
 			code:          "package main\nfunc f() int { return 0 }",
-			expectedCount: 1, // 0 -> 1
+			expectedCount: 2, // 0 -> 1, -1; 0+1 repeats 1 and -0 is 0
+		},
+		{
+			name:          "one int literal",
+			code:          "package main\nfunc f() int { return 1 }",
+			expectedCount: 3, // 1 -> 0, 2, -1; 1-1 repeats 0
 		},
 		{
 			name:          "float literal",
 			code:          "package main\nfunc f() float64 { return 3.14 }",
-			expectedCount: 2, // 3.14 -> 0.0, 3.14 -> 1.0
+			expectedCount: 5, // 3.14 -> 0.0, 1.0, 4.14, 2.14, -3.14
 		},
 		{
 			name:          "hex int literal",
 			code:          "package main\nfunc f() int { return 0x10 }",
-			expectedCount: 2, // 0x10 -> 0, 0x10 -> 1
+			expectedCount: 5, // 0x10 -> 0, 1, 17, 15, -16
 		},
 		{
 			name:          "char literal is ignored",
@@ -78,3 +84,67 @@ func TestGenerateNumberMutations(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberAlternatives(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     token.Token
+		literal  string
+		variants []m.NumberVariant
+		expected []string
+	}{
+		{"int every variant", token.INT, "5", m.NumberVariants, []string{"0", "1", "6", "4", "(-5)"}},
+		{"two skips repeats", token.INT, "2", m.NumberVariants, []string{"0", "1", "3", "(-2)"}},
+		{"float keeps a point", token.FLOAT, "2.5", m.NumberVariants, []string{"0.0", "1.0", "3.5", "1.5", "(-2.5)"}},
+		{"float exponent", token.FLOAT, "1e3", m.NumberVariants, []string{"0.0", "1.0", "1001.0", "999.0", "(-1000.0)"}},
+		{"float too large to change", token.FLOAT, "1e300", []m.NumberVariant{m.NumberIncrement}, nil},
+		{"boundary only", token.INT, "10", []m.NumberVariant{m.NumberIncrement, m.NumberDecrement}, []string{"11", "9"}},
+		{"order follows NumberVariants", token.INT, "10", []m.NumberVariant{m.NumberNegate, m.NumberZero}, []string{"0", "(-10)"}},
+		{"no variants", token.INT, "10", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := numberAlternatives(tt.kind, tt.literal, tt.variants)
+			if len(got) == 0 && len(tt.expected) == 0 {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("numberAlternatives(%s) = %v, want %v", tt.literal, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumberMutations_OnlyConfiguredVariants(t *testing.T) {
+	code := "package main\nfunc f(i int) int { return i - 5 }"
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", code, parser.AllErrors)
+	if err != nil {
+		t.Fatalf("failed to parse code: %v", err)
+	}
+
+	source := m.Source{Origin: &m.File{FullPath: "test.go"}}
+	generate := NumberMutations(m.NumberDecrement, m.NumberNegate)
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, generate(n, fset, []byte(code), source)...)
+		return true
+	})
+
+	if len(mutations) != 2 {
+		t.Fatalf("expected 2 mutations, got %d", len(mutations))
+	}
+
+	if got := string(mutations[0].MutatedCode); got != "package main\nfunc f(i int) int { return i - 4 }" {
+		t.Errorf("unexpected decrement mutation: %q", got)
+	}
+
+	// The negated literal keeps its own parentheses, so i - 5 does not become i --5.
+	if got := string(mutations[1].MutatedCode); got != "package main\nfunc f(i int) int { return i - (-5) }" {
+		t.Errorf("unexpected negate mutation: %q", got)
+	}
+}
@@ -1,8 +1,6 @@
 package mutagens
 
 import (
-	"crypto/sha256"
-	"fmt"
 	"go/ast"
 	"go/token"
 
@@ -63,13 +61,13 @@ func deleteStatement(stmt ast.Stmt, fset *token.FileSet, content []byte, source
 	mutated := replaceRange(content, offset, lineEnd, "")
 	diff := diffCode(content, mutated)
 
-	h := sha256.Sum256([]byte(fmt.Sprintf("%s-%s-delete-%d", source.Origin.FullPath, m.MutationStatement.Name, offset)))
-	id := fmt.Sprintf("%x", h)[:16]
+	id := mutationID(source, m.MutationStatement.Name, "delete", offset)[:16]
 
 	return []m.Mutation{{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationStatement,
+		Position:    positionForPos(fset, stmt.Pos()),
 		MutatedCode: ensureTrailingNewline(mutated),
 		DiffCode:    diff,
 	}}
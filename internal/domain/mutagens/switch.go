@@ -0,0 +1,131 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/token"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// GenerateSwitchMutations generates switch mutations for the given AST node:
+// each case clause of a switch or type switch is removed in turn, so its
+// values reach the default clause or no clause at all, the default clause is
+// removed, and each fallthrough is removed.
+func GenerateSwitchMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	switch stmt := n.(type) {
+	case *ast.SwitchStmt:
+		return removeCaseClauses(stmt.Body, fset, content, source)
+	case *ast.TypeSwitchStmt:
+		return removeCaseClauses(stmt.Body, fset, content, source)
+	case *ast.BranchStmt:
+		if stmt.Tok == token.FALLTHROUGH {
+			return removeFallthrough(stmt, fset, content, source)
+		}
+	}
+
+	return nil
+}
+
+// removeCaseClauses removes each clause of body, the default one included.
+// A clause an earlier fallthrough leads into is kept: removing it would
+// send the fallthrough to the next clause, or past the end of the switch.
+func removeCaseClauses(body *ast.BlockStmt, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	if body == nil {
+		return nil
+	}
+
+	var mutations []m.Mutation
+
+	for i, stmt := range body.List {
+		clause, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+
+		if i > 0 && endsInFallthrough(body.List[i-1]) {
+			continue
+		}
+
+		start, ok1 := offsetForPos(fset, clause.Case)
+		end, ok2 := offsetForPos(fset, clause.End())
+
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		start, end = ownLineBounds(content, start, end)
+		mutated := replaceRange(content, start, end, "")
+
+		kind := "case"
+		if clause.List == nil {
+			kind = "default"
+		}
+
+		mutations = append(mutations, switchMutation(content, mutated, fset, clause.Case, source, kind, start))
+	}
+
+	return mutations
+}
+
+// endsInFallthrough reports whether stmt is a case clause ending in
+// fallthrough.
+func endsInFallthrough(stmt ast.Stmt) bool {
+	clause, ok := stmt.(*ast.CaseClause)
+	if !ok || len(clause.Body) == 0 {
+		return false
+	}
+
+	branch, ok := clause.Body[len(clause.Body)-1].(*ast.BranchStmt)
+
+	return ok && branch.Tok == token.FALLTHROUGH
+}
+
+// removeFallthrough removes a fallthrough, so its clause no longer runs the
+// next one's body.
+func removeFallthrough(stmt *ast.BranchStmt, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	start, ok1 := offsetForPos(fset, stmt.Pos())
+	end, ok2 := offsetForPos(fset, stmt.End())
+
+	if !ok1 || !ok2 {
+		return nil
+	}
+
+	start, end = ownLineBounds(content, start, end)
+	mutated := replaceRange(content, start, end, "")
+
+	return []m.Mutation{switchMutation(content, mutated, fset, stmt.Pos(), source, "fallthrough", start)}
+}
+
+// ownLineBounds widens [start, end) to whole lines when nothing but
+// whitespace shares them, so removing the range leaves no blank line;
+// otherwise it returns the range unchanged.
+func ownLineBounds(content []byte, start, end int) (int, int) {
+	lineStart, lineEnd := lineBounds(content, start, end)
+
+	for _, b := range content[lineStart:start] {
+		if b != ' ' && b != '\t' {
+			return start, end
+		}
+	}
+
+	for _, b := range content[end:lineEnd] {
+		if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			return start, end
+		}
+	}
+
+	return lineStart, lineEnd
+}
+
+func switchMutation(content, mutated []byte, fset *token.FileSet, pos token.Pos, source m.Source, kind string, offset int) m.Mutation {
+	id := mutationID(source, m.MutationSwitch.Name, kind, offset)[:16]
+
+	return m.Mutation{
+		ID:          id,
+		Source:      source,
+		Type:        m.MutationSwitch,
+		Position:    positionForPos(fset, pos),
+		MutatedCode: ensureTrailingNewline(mutated),
+		DiffCode:    diffCode(content, mutated),
+	}
+}
@@ -0,0 +1,150 @@
+package mutagens
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func generateSwitchExampleMutations(t *testing.T) []m.Mutation {
+	t.Helper()
+
+	examplePath := filepath.Join("..", "..", "..", "examples", "switches", "main.go")
+	content, err := os.ReadFile(examplePath)
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, examplePath, content, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{Origin: &m.File{FullPath: m.Path(examplePath)}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateSwitchMutations(n, fset, content, src)...)
+		return true
+	})
+
+	return mutations
+}
+
+func TestGenerateSwitchMutations_Counts(t *testing.T) {
+	mutations := generateSwitchExampleMutations(t)
+
+	// grade: three cases and the default. permissions: the admin case (the
+	// others are reached by fallthrough) and two fallthroughs. describe:
+	// three type switch cases.
+	if len(mutations) != 10 {
+		t.Fatalf("expected 10 switch mutations, got %d", len(mutations))
+	}
+
+	ids := make(map[string]bool, len(mutations))
+	for _, mutation := range mutations {
+		if mutation.Type != m.MutationSwitch {
+			t.Fatalf("expected type %v, got %v", m.MutationSwitch, mutation.Type)
+		}
+
+		if ids[mutation.ID] {
+			t.Fatalf("duplicate mutation ID %s", mutation.ID)
+		}
+
+		ids[mutation.ID] = true
+
+		if _, err := parser.ParseFile(token.NewFileSet(), "mutated.go", mutation.MutatedCode, 0); err != nil {
+			t.Fatalf("mutation at line %d does not parse: %v", mutation.Position.Line, err)
+		}
+	}
+}
+
+func TestGenerateSwitchMutations_RemovesWholeLines(t *testing.T) {
+	mutations := generateSwitchExampleMutations(t)
+
+	var removedDefault, removedFallthrough, removedTypeCase bool
+
+	for _, mutation := range mutations {
+		code := string(mutation.MutatedCode)
+
+		switch {
+		case !strings.Contains(code, "\tdefault:\n"):
+			removedDefault = true
+
+			if !strings.Contains(code, "\t\treturn \"C\"\n\t}\n") {
+				t.Errorf("expected the default clause removed with its lines:\n%s", mutation.DiffCode)
+			}
+		case strings.Count(code, "fallthrough") == 1:
+			removedFallthrough = true
+
+			if strings.Contains(code, "\t\t\n") {
+				t.Errorf("expected no blank line left by the removed fallthrough:\n%s", mutation.DiffCode)
+			}
+		case !strings.Contains(code, "case string:"):
+			removedTypeCase = true
+		}
+	}
+
+	if !removedDefault || !removedFallthrough || !removedTypeCase {
+		t.Fatalf("expected default, fallthrough and type switch case removals, got %v, %v, %v",
+			removedDefault, removedFallthrough, removedTypeCase)
+	}
+}
+
+func TestGenerateSwitchMutations_KeepsFallthroughTarget(t *testing.T) {
+	code := "package main\n\nfunc f(x int) (n int) {\n\tswitch x {\n\tcase 1:\n\t\tn++\n\t\tfallthrough\n\tcase 2:\n\t\tn++\n\t}\n\treturn n\n}\n"
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", code, 0)
+	if err != nil {
+		t.Fatalf("failed to parse code: %v", err)
+	}
+
+	source := m.Source{Origin: &m.File{FullPath: "test.go"}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateSwitchMutations(n, fset, []byte(code), source)...)
+		return true
+	})
+
+	// case 1 and the fallthrough; case 2 stays for the fallthrough to reach.
+	if len(mutations) != 2 {
+		t.Fatalf("expected 2 mutations, got %d", len(mutations))
+	}
+
+	for _, mutation := range mutations {
+		if !strings.Contains(string(mutation.MutatedCode), "case 2:") {
+			t.Errorf("expected case 2 to be kept:\n%s", mutation.DiffCode)
+		}
+	}
+}
+
+func TestGenerateSwitchMutations_IgnoresOtherNodes(t *testing.T) {
+	code := "package main\nfunc f() {\n\tfor {\n\t\tbreak\n\t}\n}\n"
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", code, 0)
+	if err != nil {
+		t.Fatalf("failed to parse code: %v", err)
+	}
+
+	source := m.Source{Origin: &m.File{FullPath: "test.go"}}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateSwitchMutations(n, fset, []byte(code), source)...)
+		return true
+	})
+
+	if len(mutations) != 0 {
+		t.Fatalf("expected no mutations, got %d", len(mutations))
+	}
+}
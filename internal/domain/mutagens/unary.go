@@ -1,16 +1,20 @@
 package mutagens
 
 import (
-	"crypto/sha256"
-	"fmt"
 	"go/ast"
 	"go/token"
 
 	m "github.com/mouse-blink/gooze/internal/model"
 )
 
-// GenerateUnaryMutations generates unary operator mutations for the given AST node.
+// GenerateUnaryMutations generates unary operator mutations for the given AST
+// node: -x and +x swap and lose their sign, !b and ^x lose their operator,
+// and i++ and i-- swap.
 func GenerateUnaryMutations(n ast.Node, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	if incDec, ok := n.(*ast.IncDecStmt); ok {
+		return generateIncDecMutations(incDec, fset, content, source)
+	}
+
 	unaryExpr, ok := n.(*ast.UnaryExpr)
 	if !ok {
 		return nil
@@ -33,12 +37,12 @@ func GenerateUnaryMutations(n ast.Node, fset *token.FileSet, content []byte, sou
 	for _, mutatedOp := range getUnaryAlternatives(unaryExpr.Op) {
 		mutatedCode := replaceRange(content, start, end, mutatedOp.String())
 		diff := diffCode(content, mutatedCode)
-		h := sha256.Sum256(mutatedCode)
-		id := fmt.Sprintf("%x", h)
+		id := mutationID(source, mutatedCode)
 		mutations = append(mutations, m.Mutation{
 			ID:          id,
 			Source:      source,
 			Type:        m.MutationUnary,
+			Position:    positionForPos(fset, unaryExpr.OpPos),
 			MutatedCode: mutatedCode,
 			DiffCode:    diff,
 		})
@@ -47,12 +51,12 @@ func GenerateUnaryMutations(n ast.Node, fset *token.FileSet, content []byte, sou
 	// Also generate removal mutation (remove the unary operator entirely)
 	mutatedCode := replaceRange(content, start, end, "")
 	diff := diffCode(content, mutatedCode)
-	h := sha256.Sum256(mutatedCode)
-	id := fmt.Sprintf("%x", h)
+	id := mutationID(source, mutatedCode)
 	mutations = append(mutations, m.Mutation{
 		ID:          id,
 		Source:      source,
 		Type:        m.MutationUnary,
+		Position:    positionForPos(fset, unaryExpr.OpPos),
 		MutatedCode: mutatedCode,
 		DiffCode:    diff,
 	})
@@ -60,6 +64,31 @@ func GenerateUnaryMutations(n ast.Node, fset *token.FileSet, content []byte, sou
 	return mutations
 }
 
+// generateIncDecMutations swaps i++ for i-- and back, so a counter walks the
+// wrong way.
+func generateIncDecMutations(stmt *ast.IncDecStmt, fset *token.FileSet, content []byte, source m.Source) []m.Mutation {
+	start, ok := offsetForPos(fset, stmt.TokPos)
+	if !ok {
+		return nil
+	}
+
+	mutated := token.DEC
+	if stmt.Tok == token.DEC {
+		mutated = token.INC
+	}
+
+	mutatedCode := replaceRange(content, start, start+len(stmt.Tok.String()), mutated.String())
+
+	return []m.Mutation{{
+		ID:          mutationID(source, mutatedCode),
+		Source:      source,
+		Type:        m.MutationUnary,
+		Position:    positionForPos(fset, stmt.TokPos),
+		MutatedCode: mutatedCode,
+		DiffCode:    diffCode(content, mutatedCode),
+	}}
+}
+
 func isUnaryOp(op token.Token) bool {
 	return op == token.SUB || op == token.ADD || op == token.NOT || op == token.XOR
 }
@@ -4,6 +4,9 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	m "github.com/mouse-blink/gooze/internal/model"
@@ -35,6 +38,16 @@ func TestGenerateUnaryMutations(t *testing.T) {
 			code:          "package main\nfunc f() int { return ^5 }",
 			expectedCount: 1, // ^5 -> 5 (removal only)
 		},
+		{
+			name:          "increment statement",
+			code:          "package main\nfunc f() { i := 0; i++; _ = i }",
+			expectedCount: 1, // i++ -> i--
+		},
+		{
+			name:          "decrement statement",
+			code:          "package main\nfunc f() { i := 0; i--; _ = i }",
+			expectedCount: 1, // i-- -> i++
+		},
 		{
 			name:          "no unary operators",
 			code:          "package main\nfunc f() int { return 5 }",
@@ -77,6 +90,53 @@ func TestGenerateUnaryMutations(t *testing.T) {
 	}
 }
 
+func TestGenerateUnaryMutations_LoopsExample(t *testing.T) {
+	examplePath := filepath.Join("..", "..", "..", "examples", "loops", "main.go")
+	content, err := os.ReadFile(examplePath)
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, examplePath, content, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	src := m.Source{
+		Origin: &m.File{FullPath: m.Path(examplePath)},
+	}
+
+	var mutations []m.Mutation
+	ast.Inspect(file, func(n ast.Node) bool {
+		mutations = append(mutations, GenerateUnaryMutations(n, fset, content, src)...)
+		return true
+	})
+
+	foundIncToDec := false
+	foundDecToInc := false
+	for _, mutation := range mutations {
+		if mutation.Type != m.MutationUnary {
+			t.Errorf("expected type MutationUnary, got %v", mutation.Type)
+		}
+
+		code := string(mutation.MutatedCode)
+		if strings.Contains(code, "for i := 0; i < n; i-- {") {
+			foundIncToDec = true
+		}
+		if strings.Contains(code, "\t\tx++\n") && !strings.Contains(code, "\t\tx--\n") {
+			foundDecToInc = true
+		}
+	}
+
+	if !foundIncToDec {
+		t.Error("expected i++ to be swapped for i--")
+	}
+	if !foundDecToInc {
+		t.Error("expected x-- to be swapped for x++")
+	}
+}
+
 func TestIsUnaryOp(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// mutateFileName is the name a source read by Mutate is parsed as.
+const mutateFileName = "source.go"
+
+// MutateArgs contains the arguments for mutating a single source read from
+// In, outside of any run.
+type MutateArgs struct {
+	// Operator names the mutation type to apply, such as arithmetic.
+	Operator string
+	// Index selects the mutation to write among those Operator generates,
+	// in source order, starting at 0.
+	Index int
+	In    io.Reader
+	Out   io.Writer
+}
+
+// Mutate reads a Go source from args.In and writes it to args.Out with one
+// mutation applied, for scripts and editors that want raw mutants without
+// a run. Nothing is tested or saved.
+func (w *workflow) Mutate(args MutateArgs) error {
+	mutationType, ok := mutationTypeNamed(args.Operator)
+	if !ok {
+		return fmt.Errorf("unknown operator %q", args.Operator)
+	}
+
+	content, err := io.ReadAll(args.In)
+	if err != nil {
+		return fmt.Errorf("read source: %w", err)
+	}
+
+	dir, err := w.CreateTempDir("", "gooze-mutate-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	defer func() { _ = w.RemoveAll(dir) }()
+
+	path := w.JoinPath(string(dir), mutateFileName)
+	if err := w.WriteFile(path, content, 0o600); err != nil {
+		return fmt.Errorf("write source: %w", err)
+	}
+
+	mutations, err := w.GenerateMutation(m.Source{Origin: &m.File{FullPath: path, ShortPath: mutateFileName}}, mutationType)
+	if err != nil {
+		return fmt.Errorf("generate mutations: %w", err)
+	}
+
+	if args.Index < 0 || args.Index >= len(mutations) {
+		return fmt.Errorf("index %d out of range: %s generates %d mutations of this source", args.Index, mutationType.Name, len(mutations))
+	}
+
+	if _, err := args.Out.Write(mutations[args.Index].MutatedCode); err != nil {
+		return fmt.Errorf("write mutant: %w", err)
+	}
+
+	return nil
+}
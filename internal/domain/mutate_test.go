@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	domain "github.com/mouse-blink/gooze/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mutateSource = `package calc
+
+func Add(a, b int) int {
+	return a + b
+}
+
+func Sub(a, b int) int {
+	return a - b
+}
+`
+
+func TestWorkflow_Mutate_WritesSelectedMutant(t *testing.T) {
+	wf := domain.NewWorkflow()
+
+	var out bytes.Buffer
+
+	err := wf.Mutate(domain.MutateArgs{Operator: "arithmetic", Index: 0, In: strings.NewReader(mutateSource), Out: &out})
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "return a - b\n}\n\nfunc Sub")
+	assert.NotContains(t, out.String(), "a + b")
+}
+
+func TestWorkflow_Mutate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		operator string
+		index    int
+		want     string
+	}{
+		{name: "unknown operator", operator: "nope", want: `unknown operator "nope"`},
+		{name: "index out of range", operator: "arithmetic", index: 99, want: "index 99 out of range: arithmetic generates"},
+		{name: "negative index", operator: "arithmetic", index: -1, want: "index -1 out of range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			err := domain.NewWorkflow().Mutate(domain.MutateArgs{Operator: tt.operator, Index: tt.index, In: strings.NewReader(mutateSource), Out: &out})
+			require.ErrorContains(t, err, tt.want)
+			assert.Empty(t, out.String())
+		})
+	}
+}
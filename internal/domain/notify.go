@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultNotifySurvivors is how many survivors a notification shows with
+// their diffs.
+const DefaultNotifySurvivors = 5
+
+// NotifyArgs contains the arguments for pushing a run summary to notifiers.
+type NotifyArgs struct {
+	Reports m.Path
+	// Base, when set, holds the base branch's reports; the summary then has
+	// the score delta and lists survivors new since the base first.
+	Base m.Path
+	// Survivors is how many survivors the summary lists; zero means
+	// DefaultNotifySurvivors.
+	Survivors int
+	Notifiers []adapter.Notifier
+}
+
+// Notify pushes the summary of the reports in args.Reports to every
+// notifier. A failing notifier does not keep the others from being tried;
+// their errors are joined.
+func (w *workflow) Notify(args NotifyArgs) error {
+	if len(args.Notifiers) == 0 {
+		return fmt.Errorf("at least one notifier is required")
+	}
+
+	reports, err := w.LoadReports(args.Reports)
+	if err != nil {
+		return fmt.Errorf("load reports: %w", err)
+	}
+
+	if len(reports) == 0 {
+		return fmt.Errorf("no reports in %s: run gooze run first", args.Reports)
+	}
+
+	var baseReports []m.Report
+
+	if args.Base != "" {
+		baseReports, err = w.LoadReports(args.Base)
+		if err != nil {
+			return fmt.Errorf("load base reports from %s: %w", args.Base, err)
+		}
+	}
+
+	survivors := args.Survivors
+	if survivors <= 0 {
+		survivors = DefaultNotifySurvivors
+	}
+
+	summary := runSummary(reports, baseReports, args.Base != "", survivors)
+
+	var errs []error
+
+	for _, notifier := range args.Notifiers {
+		if err := notifier.Notify(summary); err != nil {
+			errs = append(errs, fmt.Errorf("notify: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// runSummary counts the reports' results and picks up to survivors survived
+// mutants, those new since baseReports first, then by path and ID.
+func runSummary(reports, baseReports []m.Report, hasBase bool, survivors int) m.RunSummary {
+	summary := m.RunSummary{
+		Score:    mutationScoreFromReports(reports),
+		Killed:   countStatus(reports, m.Killed),
+		Survived: countStatus(reports, m.Survived),
+		Failed:   countStatus(reports, m.Error),
+		Skipped:  countStatus(reports, m.Skipped),
+		NotRun:   countStatus(reports, m.NotRun),
+		HasBase:  hasBase,
+	}
+	if hasBase {
+		summary.BaseScore = mutationScoreFromReports(baseReports)
+	}
+
+	baseSurvived := make(map[string]bool)
+
+	for _, report := range baseReports {
+		for _, result := range report.Result {
+			baseSurvived[result.MutationID] = result.Status == m.Survived
+		}
+	}
+
+	all := make([]m.SurvivedMutation, 0, summary.Survived)
+
+	for _, report := range reports {
+		for _, result := range report.Result {
+			if result.Status != m.Survived {
+				continue
+			}
+
+			survivor := m.SurvivedMutation{
+				ID:    result.MutationID,
+				Path:  report.Source.Origin.DisplayPath(),
+				Type:  result.Type,
+				Added: hasBase && !baseSurvived[result.MutationID],
+			}
+			if report.Diff != nil {
+				survivor.Diff = *report.Diff
+			}
+
+			all = append(all, survivor)
+		}
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Added != all[j].Added {
+			return all[i].Added
+		}
+
+		if all[i].Path != all[j].Path {
+			return all[i].Path < all[j].Path
+		}
+
+		return all[i].ID < all[j].ID
+	})
+
+	if len(all) > survivors {
+		all = all[:survivors]
+	}
+
+	summary.TopSurvived = all
+
+	return summary
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunSummary_ListsNewSurvivorsFirst(t *testing.T) {
+	source := func(path string) m.Source {
+		return m.Source{Origin: &m.File{ShortPath: m.Path(path), FullPath: m.Path("/project/" + path)}}
+	}
+	reports := []m.Report{
+		{Source: source("a.go"), Result: m.Result{{MutationID: "old", Status: m.Survived}}},
+		{Source: source("b.go"), Result: m.Result{{MutationID: "new", Status: m.Survived}}},
+		{Source: source("a.go"), Result: m.Result{{MutationID: "other", Status: m.Survived}}},
+		{Source: source("a.go"), Result: m.Result{{MutationID: "k", Status: m.Killed}, {MutationID: "e", Status: m.Error}}},
+	}
+	baseReports := []m.Report{
+		{Source: source("a.go"), Result: m.Result{{MutationID: "old", Status: m.Survived}, {MutationID: "other", Status: m.Survived}}},
+	}
+
+	summary := runSummary(reports, baseReports, true, 2)
+
+	assert.InDelta(t, 0.25, summary.Score, 1e-9)
+	assert.InDelta(t, 0, summary.BaseScore, 1e-9)
+	assert.Equal(t, 1, summary.Killed)
+	assert.Equal(t, 3, summary.Survived)
+	assert.Equal(t, 1, summary.Failed)
+
+	if assert.Len(t, summary.TopSurvived, 2) {
+		assert.Equal(t, "new", summary.TopSurvived[0].ID)
+		assert.True(t, summary.TopSurvived[0].Added)
+		assert.Equal(t, "old", summary.TopSurvived[1].ID)
+		assert.False(t, summary.TopSurvived[1].Added)
+	}
+}
+
+func TestRunSummary_WithoutBase(t *testing.T) {
+	reports := []m.Report{
+		{Source: m.Source{Origin: &m.File{FullPath: "/project/a.go"}}, Result: m.Result{{MutationID: "s", Status: m.Survived}}},
+	}
+
+	summary := runSummary(reports, nil, false, DefaultNotifySurvivors)
+
+	assert.False(t, summary.HasBase)
+	if assert.Len(t, summary.TopSurvived, 1) {
+		assert.False(t, summary.TopSurvived[0].Added)
+	}
+}
@@ -1,7 +1,12 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/mouse-blink/gooze/internal/adapter"
 	m "github.com/mouse-blink/gooze/internal/model"
@@ -10,13 +15,56 @@ import (
 // Orchestrator coordinates applying a mutation to a temporary copy of
 // the project and running the corresponding tests to determine whether the
 // mutation is killed or survives.
+//
+// PrepareWorkspaces sets up reusable project copies for a run; without it,
+// every TestMutation call copies the project into a fresh directory.
+// Baseline and Coverage run the unmutated tests: the former to check they
+// pass, the latter to find the lines they execute. BaselineTimes runs them
+// as Baseline does, timing each package to project a run's duration.
+//
+// SetRunOptions applies the settings of a run to the calls that follow.
 type Orchestrator interface {
-	TestMutation(mutation m.Mutation) (m.Result, error)
+	SetRunOptions(opts RunOptions)
+	TestMutation(mutation m.Mutation) (m.MutationResult, error)
+	PrepareWorkspaces(mutations []m.Mutation, threads int) error
+	ReleaseWorkspaces() error
+	Baseline(mutations []m.Mutation) error
+	BaselineTimes(mutations []m.Mutation) (map[string]time.Duration, int, error)
+	Coverage(mutations []m.Mutation, tags []string, timeout time.Duration) (m.Coverage, error)
+}
+
+// RunOptions are the settings a run applies to the tests of all its
+// mutations, as opposed to the Timeout or RunPattern of each mutation.
+type RunOptions struct {
+	// DetectFlaky re-runs the tests against the original code after a kill,
+	// so a kill the unmutated tests reproduce is reported as Flaky.
+	DetectFlaky bool
+	// CountCompileErrors reports a mutant that does not compile as Killed,
+	// with KillBuild, instead of CompileError.
+	CountCompileErrors bool
+	// GoTestArgs are extra flags, such as -count=1, for every go test run.
+	GoTestArgs []string
+	// Race runs the tests with the race detector.
+	Race bool
+	// WorkDir is the directory workspaces are created in; empty means the
+	// system temp directory.
+	WorkDir m.Path
 }
 
 type orchestrator struct {
 	fsAdapter   adapter.SourceFSAdapter
 	testAdapter adapter.TestRunnerAdapter
+
+	// optsMu guards opts apart from mu, which is held while workspaces are
+	// prepared.
+	optsMu sync.Mutex
+	opts   RunOptions
+
+	mu sync.Mutex
+	// roots maps source paths to their project root, and pools project roots
+	// to their workspaces, for mutations covered by PrepareWorkspaces.
+	roots map[m.Path]m.Path
+	pools map[m.Path]*workspacePool
 }
 
 // NewOrchestrator constructs an Orchestrator backed by the provided
@@ -25,44 +73,185 @@ func NewOrchestrator(fsAdapter adapter.SourceFSAdapter, testAdapter adapter.Test
 	return &orchestrator{
 		fsAdapter:   fsAdapter,
 		testAdapter: testAdapter,
+		roots:       make(map[m.Path]m.Path),
+		pools:       make(map[m.Path]*workspacePool),
 	}
 }
 
-func (to *orchestrator) TestMutation(mutation m.Mutation) (m.Result, error) {
+func (to *orchestrator) SetRunOptions(opts RunOptions) {
+	to.optsMu.Lock()
+	defer to.optsMu.Unlock()
+
+	to.opts = opts
+}
+
+// runOptions returns the options SetRunOptions last set.
+func (to *orchestrator) runOptions() RunOptions {
+	to.optsMu.Lock()
+	defer to.optsMu.Unlock()
+
+	return to.opts
+}
+
+func (to *orchestrator) TestMutation(mutation m.Mutation) (m.MutationResult, error) {
 	if err := to.validateMutation(mutation); err != nil {
-		return m.Result{}, err
+		return m.MutationResult{}, err
 	}
 
-	if mutation.Source.Test == nil {
+	if !hasTests(mutation) {
 		return to.resultForNoTest(mutation), nil
 	}
 
-	projectRoot, tmpDir, err := to.prepareWorkspace(mutation.Source.Origin.FullPath)
+	if pool, ok := to.pooledWorkspace(mutation); ok {
+		return to.testInPool(pool, mutation)
+	}
+
+	projectRoot, tmpDir, err := to.prepareWorkspace(mutation)
 	if tmpDir != "" {
 		defer to.cleanupTempDir(tmpDir)
 	}
 
 	if err != nil {
-		return m.Result{}, err
+		return m.MutationResult{}, err
 	}
 
 	tmpSourcePath, err := to.buildTempSourcePath(projectRoot, tmpDir, mutation.Source.Origin.FullPath)
 	if err != nil {
-		return m.Result{}, err
+		return m.MutationResult{}, err
+	}
+
+	return to.testInWorkspace(nil, mutation, projectRoot, tmpDir, tmpSourcePath)
+}
+
+// testInWorkspace writes the mutated source, or the mutation's schema, into
+// a copy of the project rooted at tmpDir and runs the mutation's tests there.
+// ws is the pooled workspace at tmpDir, or nil for a one-off copy.
+func (to *orchestrator) testInWorkspace(ws *workspace, mutation m.Mutation, projectRoot, tmpDir, tmpSourcePath m.Path) (m.MutationResult, error) {
+	code := mutation.MutatedCode
+	if mutation.Schema != nil {
+		code = mutation.Schema
+	}
+
+	if err := to.writeMutatedFile(tmpSourcePath, code); err != nil {
+		return m.MutationResult{}, newInfraError(PhaseWriteMutation, mutation, err)
+	}
+
+	workDir, targets, err := to.testTarget(mutation, projectRoot, tmpDir, tmpSourcePath)
+	if err != nil {
+		return m.MutationResult{}, err
+	}
+
+	opts := to.runOptions()
+	started := time.Now()
+
+	run, err := to.runTests(ws, workDir, targets, mutation, opts)
+	if err != nil {
+		return m.MutationResult{}, newInfraError(PhaseToolchain, mutation, err)
+	}
+
+	duration := time.Since(started)
+	status, reason := run.status, run.reason
+
+	if status == m.Killed && reason != m.KillBuild && opts.DetectFlaky {
+		flaky, err := to.failsWithoutMutation(mutation, workDir, targets, tmpSourcePath, opts)
+		if err != nil {
+			return m.MutationResult{}, err
+		}
+
+		if flaky {
+			status, reason = m.Flaky, ""
+		}
+	}
+
+	result := to.resultForStatus(mutation, status)
+	result.KillReason = reason
+	result.Duration = duration
+
+	if brokeTests(status, reason) {
+		result.TestOutput = testOutputTail(run.output)
+	}
+
+	if reason == m.KillBuild && !opts.CountCompileErrors {
+		result.Status, result.KillReason = m.CompileError, ""
+	}
+
+	return result, nil
+}
+
+// brokeTests reports whether the tests failed without one of them catching
+// the mutant: it did not compile, panicked, timed out, raced, or made go test
+// fail with no failed test. Those results keep their test output to tell
+// what broke.
+func brokeTests(status m.TestStatus, reason m.KillReason) bool {
+	return status == m.Killed && reason != m.KillAssertion
+}
+
+// failsWithoutMutation writes the original source back over the mutated file
+// and runs the same tests once more. Kills by build failures skip it: the
+// compiler does not fail intermittently.
+func (to *orchestrator) failsWithoutMutation(mutation m.Mutation, workDir m.Path, targets []string, tmpSourcePath m.Path, opts RunOptions) (bool, error) {
+	original, err := to.fsAdapter.ReadFile(mutation.Source.Origin.FullPath)
+	if err != nil {
+		return false, newInfraError(PhaseRestoreWorkspace, mutation, fmt.Errorf("failed to read original source: %w", err))
 	}
 
-	if err := to.writeMutatedFile(tmpSourcePath, mutation.MutatedCode); err != nil {
-		return m.Result{}, err
+	if err := to.fsAdapter.WriteFile(tmpSourcePath, original, 0o600); err != nil {
+		return false, newInfraError(PhaseRestoreWorkspace, mutation, fmt.Errorf("failed to restore original source: %w", err))
+	}
+
+	// The original source has no mutation to select.
+	mutation.Schema = nil
+
+	run, err := to.runTests(nil, workDir, targets, mutation, opts)
+	if err != nil {
+		return false, newInfraError(PhaseToolchain, mutation, err)
+	}
+
+	return run.status == m.Killed, nil
+}
+
+// testTarget returns the directory to run go test from and what to test: the
+// packages listed in TestPackages from the project root, the whole package
+// for files passed directly, the matching test file otherwise. Running from
+// the package directory lets the go command resolve the enclosing module,
+// and the go.work above it when the project is a workspace.
+func (to *orchestrator) testTarget(mutation m.Mutation, projectRoot, tmpDir, tmpSourcePath m.Path) (m.Path, []string, error) {
+	if len(mutation.Source.TestPackages) > 0 {
+		return to.dependentsTarget(mutation.Source.TestPackages, projectRoot, tmpDir)
+	}
+
+	if mutation.Source.PackageTests {
+		return m.Path(filepath.Dir(string(tmpSourcePath))), []string{"."}, nil
 	}
 
 	tmpTestPath, err := to.buildTempTestPath(projectRoot, tmpDir, mutation.Source.Test.FullPath)
 	if err != nil {
-		return m.Result{}, err
+		return "", nil, err
 	}
 
-	status := to.runTests(tmpDir, tmpTestPath)
+	return m.Path(filepath.Dir(string(tmpTestPath))), []string{string(tmpTestPath)}, nil
+}
 
-	return to.resultForStatus(mutation, status), nil
+// dependentsTarget turns package directories into ./-relative patterns
+// resolved from the workspace's project root.
+func (to *orchestrator) dependentsTarget(dirs []m.Path, projectRoot, tmpDir m.Path) (m.Path, []string, error) {
+	patterns := make([]string, 0, len(dirs))
+
+	for _, dir := range dirs {
+		rel, err := to.fsAdapter.RelPath(projectRoot, dir)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to get relative package path: %w", err)
+		}
+
+		patterns = append(patterns, "./"+filepath.ToSlash(string(rel)))
+	}
+
+	return tmpDir, patterns, nil
+}
+
+// hasTests reports whether any test can exercise the mutation.
+func hasTests(mutation m.Mutation) bool {
+	return mutation.Source.Test != nil || mutation.Source.PackageTests || len(mutation.Source.TestPackages) > 0
 }
 
 func (to *orchestrator) validateMutation(mutation m.Mutation) error {
@@ -73,40 +262,31 @@ func (to *orchestrator) validateMutation(mutation m.Mutation) error {
 	return nil
 }
 
-func (to *orchestrator) resultForNoTest(mutation m.Mutation) m.Result {
+func (to *orchestrator) resultForNoTest(mutation m.Mutation) m.MutationResult {
 	return to.resultForStatus(mutation, m.Survived)
 }
 
-func (to *orchestrator) resultForStatus(mutation m.Mutation, status m.TestStatus) m.Result {
-	result := m.Result{}
-	result[mutation.Type] = []struct {
-		MutationID string
-		Status     m.TestStatus
-		Err        error
-	}{
-		{
-			MutationID: mutation.ID,
-			Status:     status,
-			Err:        nil,
-		},
+func (to *orchestrator) resultForStatus(mutation m.Mutation, status m.TestStatus) m.MutationResult {
+	return m.MutationResult{
+		MutationID: mutation.ID,
+		Type:       mutation.Type,
+		Status:     status,
 	}
-
-	return result
 }
 
-func (to *orchestrator) prepareWorkspace(sourcePath m.Path) (m.Path, m.Path, error) {
-	projectRoot, err := to.fsAdapter.FindProjectRoot(sourcePath)
+func (to *orchestrator) prepareWorkspace(mutation m.Mutation) (m.Path, m.Path, error) {
+	projectRoot, err := to.fsAdapter.FindProjectRoot(mutation.Source.Origin.FullPath)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to find project root: %w", err)
+		return "", "", newInfraError(PhaseProjectRoot, mutation, fmt.Errorf("failed to find project root: %w", err))
 	}
 
-	tmpDir, err := to.fsAdapter.CreateTempDir("gooze-mutation-*")
+	tmpDir, err := to.fsAdapter.CreateTempDir(to.runOptions().WorkDir, "gooze-mutation-*")
 	if err != nil {
-		return "", "", fmt.Errorf("failed to create temp dir: %w", err)
+		return "", "", newInfraError(PhaseCreateWorkspace, mutation, fmt.Errorf("failed to create temp dir: %w", err))
 	}
 
 	if err := to.fsAdapter.CopyDir(projectRoot, tmpDir); err != nil {
-		return projectRoot, tmpDir, fmt.Errorf("failed to copy project: %w", err)
+		return projectRoot, tmpDir, newInfraError(PhaseCopyProject, mutation, fmt.Errorf("failed to copy project: %w", err))
 	}
 
 	return projectRoot, tmpDir, nil
@@ -138,13 +318,83 @@ func (to *orchestrator) writeMutatedFile(path m.Path, content []byte) error {
 	return nil
 }
 
-func (to *orchestrator) runTests(tmpDir, testPath m.Path) m.TestStatus {
-	_, testErr := to.testAdapter.RunGoTest(string(tmpDir), string(testPath))
+// maxTestOutput caps the go test output stored with a result.
+const maxTestOutput = 16 << 10
+
+// testRun is the outcome of running a mutation's tests, with the output of
+// the last go test invocation.
+type testRun struct {
+	status m.TestStatus
+	reason m.KillReason
+	output string
+}
+
+// runTests reports Killed, with the reason taken from the test output, when
+// the tests fail and Survived when they pass. An error means the tests could
+// not be run at all. A mutation with a RunPattern runs the matching tests
+// first; only if it survives them are the remaining tests run, so a pattern
+// that misses the relevant tests costs time but never a kill. In a pooled
+// workspace, the mutations of a schema run from a shared test binary.
+func (to *orchestrator) runTests(ws *workspace, workDir m.Path, targets []string, mutation m.Mutation, runOpts RunOptions) (testRun, error) {
+	opts := adapter.GoTestOptions{
+		Tags:    mutation.Source.BuildTags,
+		Timeout: mutation.Timeout,
+		Run:     mutation.RunPattern,
+		Race:    runOpts.Race,
+		Args:    runOpts.GoTestArgs,
+	}
+	// A schema reads SchemataEnv before the tests start, where go test does
+	// not see it, so its cached results would be those of another mutation.
+	if mutation.Schema != nil {
+		opts.Env = []string{SchemataEnv + "=" + mutation.ID}
+		opts.Args = append([]string{"-count=1"}, opts.Args...)
+	}
+
+	if len(targets) > 1 {
+		opts.Packages = targets[1:]
+	}
+
+	runTarget := to.runGoTest
+	if binary, ok := to.testBinary(ws, workDir, targets, mutation, opts); ok {
+		runTarget = to.runTestBinary(binary)
+	}
+
+	run, err := runTarget(workDir, targets[0], opts)
+	if err != nil || run.status == m.Killed || opts.Run == "" {
+		return run, err
+	}
+
+	opts.Run = ""
+
+	return runTarget(workDir, targets[0], opts)
+}
+
+func (to *orchestrator) runGoTest(workDir m.Path, target string, opts adapter.GoTestOptions) (testRun, error) {
+	output, testErr := to.testAdapter.RunGoTest(string(workDir), target, opts)
+	if errors.Is(testErr, adapter.ErrGoToolchainNotFound) {
+		return testRun{status: m.Killed}, fmt.Errorf("failed to run tests: %w", testErr)
+	}
+
 	if testErr != nil {
-		return m.Killed
+		return testRun{status: m.Killed, reason: killReason(testErr, output), output: output}, nil
+	}
+
+	return testRun{status: m.Survived, output: output}, nil
+}
+
+// testOutputTail keeps the end of a go test output, where the compiler and
+// the failing tests report, to store with a result.
+func testOutputTail(output string) string {
+	if len(output) <= maxTestOutput {
+		return output
+	}
+
+	tail := output[len(output)-maxTestOutput:]
+	if i := strings.IndexByte(tail, '\n'); i >= 0 {
+		tail = tail[i+1:]
 	}
 
-	return m.Survived
+	return "…\n" + tail
 }
 
 // cleanupTempDir removes the temporary directory, logging errors if cleanup fails.
@@ -3,8 +3,11 @@ package domain
 import (
 	"errors"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/mouse-blink/gooze/internal/adapter"
 	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
 	m "github.com/mouse-blink/gooze/internal/model"
 	"github.com/stretchr/testify/require"
@@ -41,11 +44,9 @@ func TestOrchestrator_TestMutation_NoTestFile(t *testing.T) {
 	result, err := orch.TestMutation(mutation)
 	require.NoError(t, err)
 
-	entries, ok := result[mutation.Type]
-	require.True(t, ok)
-	require.Len(t, entries, 1)
-	require.Equal(t, "test-hash-id", entries[0].MutationID)
-	require.Equal(t, m.Survived, entries[0].Status)
+	require.Equal(t, "test-hash-id", result.MutationID)
+	require.Equal(t, mutation.Type, result.Type)
+	require.Equal(t, m.Survived, result.Status)
 }
 
 func TestOrchestrator_TestMutation_FindProjectRootError(t *testing.T) {
@@ -71,7 +72,7 @@ func TestOrchestrator_TestMutation_TestFailureMarksKilled(t *testing.T) {
 	tmpDir := m.Path("/tmp/mut")
 
 	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
-	fsAdapter.EXPECT().CreateTempDir("gooze-mutation-*").Return(tmpDir, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
 	fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
 	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("main.go"), nil)
 	fsAdapter.EXPECT().JoinPath(string(tmpDir), "main.go").Return(m.Path("/tmp/mut/main.go"))
@@ -79,15 +80,436 @@ func TestOrchestrator_TestMutation_TestFailureMarksKilled(t *testing.T) {
 	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("main_test.go"), nil)
 	fsAdapter.EXPECT().JoinPath(string(tmpDir), "main_test.go").Return(m.Path("/tmp/mut/main_test.go"))
 	fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
-	trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go").Return("boom", errors.New("failed"))
+	trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go", adapter.GoTestOptions{}).Return("boom", errors.New("failed"))
 
 	result, err := orch.TestMutation(mutation)
 	require.NoError(t, err)
 
-	entries, ok := result[mutation.Type]
-	require.True(t, ok)
-	require.Len(t, entries, 1)
-	require.Equal(t, m.Killed, entries[0].Status)
+	require.Equal(t, mutation.ID, result.MutationID)
+	require.Equal(t, m.Killed, result.Status)
+}
+
+func TestOrchestrator_TestMutation_RunsTestsFromPackageDir(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	mutation.Source.Origin.FullPath = "/work/api/handler.go"
+	mutation.Source.Test.FullPath = "/work/api/handler_test.go"
+
+	projectRoot := m.Path("/work")
+	tmpDir := m.Path("/tmp/mut")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("api/handler.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "api/handler.go").Return(m.Path("/tmp/mut/api/handler.go"))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/api/handler.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("api/handler_test.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "api/handler_test.go").Return(m.Path("/tmp/mut/api/handler_test.go"))
+	fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+	trAdapter.EXPECT().RunGoTest("/tmp/mut/api", "/tmp/mut/api/handler_test.go", adapter.GoTestOptions{}).Return("", nil)
+
+	result, err := orch.TestMutation(mutation)
+	require.NoError(t, err)
+	require.Equal(t, m.Survived, result.Status)
+}
+
+func TestOrchestrator_TestMutation_PackageTestsRunWholePackage(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	mutation.Source.Origin.FullPath = "/project/calc/calc.go"
+	mutation.Source.Test = nil
+	mutation.Source.PackageTests = true
+
+	projectRoot := m.Path("/project")
+	tmpDir := m.Path("/tmp/mut")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("calc/calc.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "calc/calc.go").Return(m.Path("/tmp/mut/calc/calc.go"))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/calc/calc.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil)
+	fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+	trAdapter.EXPECT().RunGoTest("/tmp/mut/calc", ".", adapter.GoTestOptions{}).Return("panic: boom\n", errors.New("failed"))
+
+	result, err := orch.TestMutation(mutation)
+	require.NoError(t, err)
+	require.Equal(t, m.Killed, result.Status)
+	require.Equal(t, m.KillPanic, result.KillReason)
+}
+
+func TestOrchestrator_TestMutation_RunsTestPackagesFromProjectRoot(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	mutation.Source.Origin.FullPath = "/project/store/store.go"
+	mutation.Source.Test = nil
+	mutation.Source.TestPackages = []m.Path{"/project/api", "/project/store"}
+
+	projectRoot := m.Path("/project")
+	tmpDir := m.Path("/tmp/mut")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("store/store.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "store/store.go").Return(m.Path("/tmp/mut/store/store.go"))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/store/store.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, m.Path("/project/api")).Return(m.Path("api"), nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, m.Path("/project/store")).Return(m.Path("store"), nil)
+	fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+	trAdapter.EXPECT().RunGoTest("/tmp/mut", "./api", adapter.GoTestOptions{Packages: []string{"./store"}}).Return("--- FAIL: TestHandler (0.00s)\n", errors.New("failed"))
+
+	result, err := orch.TestMutation(mutation)
+	require.NoError(t, err)
+	require.Equal(t, m.Killed, result.Status)
+	require.Equal(t, m.KillAssertion, result.KillReason)
+}
+
+func TestOrchestrator_TestMutation_RunPattern(t *testing.T) {
+	tests := []struct {
+		name       string
+		narrowErr  error
+		fullRun    bool
+		wantStatus m.TestStatus
+	}{
+		{name: "killed by the matching tests", narrowErr: errors.New("failed"), wantStatus: m.Killed},
+		{name: "survivors rerun every test", fullRun: true, wantStatus: m.Killed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+			trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+			orch := NewOrchestrator(fsAdapter, trAdapter)
+
+			mutation := makeTestMutation()
+			mutation.RunPattern = "^(TestAdd)"
+
+			projectRoot := m.Path("/project")
+			tmpDir := m.Path("/tmp/mut")
+
+			fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+			fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+			fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
+			fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("main.go"), nil)
+			fsAdapter.EXPECT().JoinPath(string(tmpDir), "main.go").Return(m.Path("/tmp/mut/main.go"))
+			fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/main.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil)
+			fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("main_test.go"), nil)
+			fsAdapter.EXPECT().JoinPath(string(tmpDir), "main_test.go").Return(m.Path("/tmp/mut/main_test.go"))
+			fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+			trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go", adapter.GoTestOptions{Run: "^(TestAdd)"}).Return("", tt.narrowErr).Once()
+
+			if tt.fullRun {
+				trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go", adapter.GoTestOptions{}).Return("", errors.New("failed")).Once()
+			}
+
+			result, err := orch.TestMutation(mutation)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, result.Status)
+		})
+	}
+}
+
+func TestOrchestrator_TestMutation_DetectFlaky(t *testing.T) {
+	original := []byte("package main\nfunc main() { _ = 1 - 1 }\n")
+
+	tests := []struct {
+		name        string
+		output      string
+		rerun       bool
+		originalErr error
+		// countCompileErrors records build failures as kills.
+		countCompileErrors bool
+		wantStatus         m.TestStatus
+		wantReason         m.KillReason
+		wantOutput         string
+	}{
+		{name: "original fails too", output: "--- FAIL: TestAdd", rerun: true, originalErr: errors.New("failed"), wantStatus: m.Flaky},
+		{name: "original passes", output: "--- FAIL: TestAdd", rerun: true, wantStatus: m.Killed, wantReason: m.KillAssertion},
+		{name: "build failures are not re-run", output: "FAIL\tcalc [build failed]", countCompileErrors: true, wantStatus: m.Killed, wantReason: m.KillBuild, wantOutput: "FAIL\tcalc [build failed]"},
+		{name: "build failures are compile errors", output: "FAIL\tcalc [build failed]", wantStatus: m.CompileError, wantOutput: "FAIL\tcalc [build failed]"},
+		{name: "panics keep their output", output: "panic: runtime error", rerun: true, wantStatus: m.Killed, wantReason: m.KillPanic, wantOutput: "panic: runtime error"},
+		{name: "unclassified failures keep their output", output: "FAIL\tcalc\t0.01s", rerun: true, wantStatus: m.Killed, wantOutput: "FAIL\tcalc\t0.01s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+			trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+			orch := NewOrchestrator(fsAdapter, trAdapter)
+			orch.SetRunOptions(RunOptions{DetectFlaky: true, CountCompileErrors: tt.countCompileErrors})
+
+			mutation := makeTestMutation()
+
+			projectRoot := m.Path("/project")
+			tmpDir := m.Path("/tmp/mut")
+
+			fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+			fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+			fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
+			fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("main.go"), nil)
+			fsAdapter.EXPECT().JoinPath(string(tmpDir), "main.go").Return(m.Path("/tmp/mut/main.go"))
+			fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/main.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil)
+			fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("main_test.go"), nil)
+			fsAdapter.EXPECT().JoinPath(string(tmpDir), "main_test.go").Return(m.Path("/tmp/mut/main_test.go"))
+			fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+			trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go", adapter.GoTestOptions{}).Return(tt.output, errors.New("failed")).Once()
+
+			if tt.rerun {
+				fsAdapter.EXPECT().ReadFile(mutation.Source.Origin.FullPath).Return(original, nil)
+				fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/main.go"), original, os.FileMode(0o600)).Return(nil)
+				trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go", adapter.GoTestOptions{}).Return("", tt.originalErr).Once()
+			}
+
+			result, err := orch.TestMutation(mutation)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, result.Status)
+			require.Equal(t, tt.wantReason, result.KillReason)
+			require.Equal(t, tt.wantOutput, result.TestOutput)
+		})
+	}
+}
+
+func TestTestOutputTail(t *testing.T) {
+	require.Equal(t, "short\n", testOutputTail("short\n"))
+
+	long := strings.Repeat("=== RUN   TestAdd\n", maxTestOutput/10) + "./calc.go:4:2: declared and not used: x\n"
+	tail := testOutputTail(long)
+	require.LessOrEqual(t, len(tail), maxTestOutput+len("…\n"))
+	require.True(t, strings.HasPrefix(tail, "…\n=== RUN"), "tail should start on a whole line")
+	require.True(t, strings.HasSuffix(tail, "declared and not used: x\n"))
+}
+
+func TestOrchestrator_TestMutation_PassesTestOptions(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+	orch.SetRunOptions(RunOptions{GoTestArgs: []string{"-count=1"}})
+
+	mutation := makeTestMutation()
+	mutation.Source.BuildTags = []string{"integration", "slow"}
+	mutation.Timeout = 45 * time.Second
+
+	projectRoot := m.Path("/project")
+	tmpDir := m.Path("/tmp/mut")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("main.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "main.go").Return(m.Path("/tmp/mut/main.go"))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/main.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("main_test.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "main_test.go").Return(m.Path("/tmp/mut/main_test.go"))
+	fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+	trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go", adapter.GoTestOptions{Tags: []string{"integration", "slow"}, Timeout: 45 * time.Second, Args: []string{"-count=1"}}).Return("", nil)
+
+	result, err := orch.TestMutation(mutation)
+	require.NoError(t, err)
+	require.Equal(t, m.Survived, result.Status)
+}
+
+func TestOrchestrator_TestMutation_SchemaSelectsMutation(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	mutation.Schema = []byte("package main // schema")
+
+	projectRoot := m.Path("/project")
+	tmpDir := m.Path("/tmp/mut")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("main.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "main.go").Return(m.Path("/tmp/mut/main.go"))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/main.go"), mutation.Schema, os.FileMode(0o600)).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("main_test.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "main_test.go").Return(m.Path("/tmp/mut/main_test.go"))
+	fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+	trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go", adapter.GoTestOptions{Args: []string{"-count=1"}, Env: []string{SchemataEnv + "=" + mutation.ID}}).Return("", nil)
+
+	result, err := orch.TestMutation(mutation)
+	require.NoError(t, err)
+	require.Equal(t, m.Survived, result.Status)
+}
+
+func TestOrchestrator_TestMutation_CopyErrorIsInfraError(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	projectRoot := m.Path("/project")
+	tmpDir := m.Path("/tmp/mut")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(errors.New("disk full"))
+	fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+
+	_, err := orch.TestMutation(mutation)
+
+	var infraErr *InfraError
+	require.ErrorAs(t, err, &infraErr)
+	require.Equal(t, PhaseCopyProject, infraErr.Phase)
+	require.Equal(t, mutation.ID, infraErr.MutationID)
+	require.Equal(t, mutation.Source.Origin.FullPath, infraErr.Path)
+	require.ErrorContains(t, err, "disk full")
+}
+
+func TestOrchestrator_TestMutation_MissingToolchainIsInfraError(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	projectRoot := m.Path("/project")
+	tmpDir := m.Path("/tmp/mut")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-mutation-*").Return(tmpDir, nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, tmpDir).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("main.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "main.go").Return(m.Path("/tmp/mut/main.go"))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/mut/main.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("main_test.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(tmpDir), "main_test.go").Return(m.Path("/tmp/mut/main_test.go"))
+	fsAdapter.EXPECT().RemoveAll(tmpDir).Return(nil)
+	trAdapter.EXPECT().RunGoTest("/tmp/mut", "/tmp/mut/main_test.go", adapter.GoTestOptions{}).Return("", adapter.ErrGoToolchainNotFound)
+
+	_, err := orch.TestMutation(mutation)
+
+	var infraErr *InfraError
+	require.ErrorAs(t, err, &infraErr)
+	require.Equal(t, PhaseToolchain, infraErr.Phase)
+	require.ErrorIs(t, err, adapter.ErrGoToolchainNotFound)
+}
+
+func TestOrchestrator_PrepareWorkspaces_ReusesWorkspace(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	second := makeTestMutation()
+	second.ID = "second-mutation-hash"
+	second.MutatedCode = []byte("package main\nfunc main() { _ = 1 - 1 }\n")
+
+	projectRoot := m.Path("/project")
+	wsDir := m.Path("/tmp/ws")
+	original := []byte("package main\nfunc main() { _ = 1 * 1 }\n")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil).Once()
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-workspace-*").Return(wsDir, nil).Once()
+	fsAdapter.EXPECT().CopyDir(projectRoot, wsDir).Return(nil).Once()
+	fsAdapter.EXPECT().ReadFile(mutation.Source.Origin.FullPath).Return(original, nil).Twice()
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("main.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(wsDir), "main.go").Return(m.Path("/tmp/ws/main.go"))
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("main_test.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(wsDir), "main_test.go").Return(m.Path("/tmp/ws/main_test.go"))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/ws/main.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil).Once()
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/ws/main.go"), second.MutatedCode, os.FileMode(0o600)).Return(nil).Once()
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/ws/main.go"), original, os.FileMode(0o600)).Return(nil).Twice()
+	trAdapter.EXPECT().RunGoTest("/tmp/ws", "/tmp/ws/main_test.go", adapter.GoTestOptions{}).Return("", nil).Twice()
+
+	require.NoError(t, orch.PrepareWorkspaces([]m.Mutation{mutation, second}, 1))
+
+	first, err := orch.TestMutation(mutation)
+	require.NoError(t, err)
+	require.Equal(t, m.Survived, first.Status)
+
+	next, err := orch.TestMutation(second)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, next.MutationID)
+
+	fsAdapter.EXPECT().RemoveAll(wsDir).Return(nil).Once()
+	require.NoError(t, orch.ReleaseWorkspaces())
+}
+
+func TestOrchestrator_PrepareWorkspaces_CopyErrorRemovesWorkspaces(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	projectRoot := m.Path("/project")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-workspace-*").Return(m.Path("/tmp/ws1"), nil).Once()
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-workspace-*").Return(m.Path("/tmp/ws2"), nil).Once()
+	fsAdapter.EXPECT().CopyDir(projectRoot, m.Path("/tmp/ws1")).Return(nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, m.Path("/tmp/ws2")).Return(errors.New("disk full"))
+	fsAdapter.EXPECT().RemoveAll(m.Path("/tmp/ws1")).Return(nil).Once()
+	fsAdapter.EXPECT().RemoveAll(m.Path("/tmp/ws2")).Return(nil).Once()
+
+	err := orch.PrepareWorkspaces([]m.Mutation{mutation}, 2)
+
+	var infraErr *InfraError
+	require.ErrorAs(t, err, &infraErr)
+	require.Equal(t, PhaseCopyProject, infraErr.Phase)
+}
+
+func TestOrchestrator_PrepareWorkspaces_UsesWorkDir(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+	orch.SetRunOptions(RunOptions{WorkDir: "/fast/ssd/gooze"})
+
+	mutation := makeTestMutation()
+	projectRoot := m.Path("/project")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path("/fast/ssd/gooze"), "gooze-workspace-*").Return(m.Path("/fast/ssd/gooze/ws1"), nil)
+	fsAdapter.EXPECT().CopyDir(projectRoot, m.Path("/fast/ssd/gooze/ws1")).Return(nil)
+
+	require.NoError(t, orch.PrepareWorkspaces([]m.Mutation{mutation}, 1))
+}
+
+func TestOrchestrator_TestMutation_DirtyWorkspaceIsCopiedAgain(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	mutation := makeTestMutation()
+	projectRoot := m.Path("/project")
+	wsDir := m.Path("/tmp/ws")
+	original := []byte("package main\n")
+
+	fsAdapter.EXPECT().FindProjectRoot(mutation.Source.Origin.FullPath).Return(projectRoot, nil)
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-workspace-*").Return(wsDir, nil)
+	fsAdapter.EXPECT().ReadFile(mutation.Source.Origin.FullPath).Return(original, nil)
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Origin.FullPath).Return(m.Path("main.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(wsDir), "main.go").Return(m.Path("/tmp/ws/main.go"))
+	fsAdapter.EXPECT().RelPath(projectRoot, mutation.Source.Test.FullPath).Return(m.Path("main_test.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(wsDir), "main_test.go").Return(m.Path("/tmp/ws/main_test.go"))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/ws/main.go"), mutation.MutatedCode, os.FileMode(0o600)).Return(nil)
+	trAdapter.EXPECT().RunGoTest("/tmp/ws", "/tmp/ws/main_test.go", adapter.GoTestOptions{}).Return("boom", errors.New("failed"))
+
+	// The initial copy, then a second one because restoring the source failed.
+	fsAdapter.EXPECT().CopyDir(projectRoot, wsDir).Return(nil).Twice()
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/ws/main.go"), original, os.FileMode(0o600)).Return(errors.New("read-only")).Once()
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/ws/main.go"), original, os.FileMode(0o600)).Return(nil).Once()
+
+	require.NoError(t, orch.PrepareWorkspaces([]m.Mutation{mutation}, 1))
+
+	for range 2 {
+		result, err := orch.TestMutation(mutation)
+		require.NoError(t, err)
+		require.Equal(t, m.Killed, result.Status)
+	}
 }
 
 func makeTestMutation() m.Mutation {
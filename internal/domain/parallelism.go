@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// workerMemoryBudget approximates the memory one worker needs: a copy of the
+// project plus compiling and running its tests.
+const workerMemoryBudget uint64 = 512 << 20
+
+// numCPU and availableMemory are variables so tests can pin the host.
+var (
+	numCPU          = runtime.NumCPU
+	availableMemory = readAvailableMemory
+)
+
+// resolveThreads returns threads when set, otherwise a worker count derived
+// from the CPU count and the memory available to hold one project copy per
+// worker.
+func resolveThreads(threads int) int {
+	if threads > 0 {
+		return threads
+	}
+
+	memory, ok := availableMemory()
+	if !ok {
+		return autoThreads(numCPU(), 0)
+	}
+
+	return autoThreads(numCPU(), memory)
+}
+
+// autoThreads caps cpus by how many worker budgets fit into memory. A memory
+// of zero means unknown and leaves the CPU count as the only limit.
+func autoThreads(cpus int, memory uint64) int {
+	threads := cpus
+
+	if memory > 0 {
+		if byMemory := int(memory / workerMemoryBudget); byMemory < threads {
+			threads = byMemory
+		}
+	}
+
+	if threads < 1 {
+		return 1
+	}
+
+	return threads
+}
+
+// readAvailableMemory reports MemAvailable from /proc/meminfo. It returns
+// false on systems without procfs.
+func readAvailableMemory() (uint64, bool) {
+	data, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return 0, false
+	}
+
+	return parseMemAvailable(data)
+}
+
+func parseMemAvailable(meminfo []byte) (uint64, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(meminfo))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "MemAvailable:" {
+			continue
+		}
+
+		kib, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		return kib << 10, true
+	}
+
+	return 0, false
+}
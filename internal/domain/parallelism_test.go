@@ -0,0 +1,65 @@
+package domain
+
+import "testing"
+
+func TestAutoThreads(t *testing.T) {
+	tests := []struct {
+		name   string
+		cpus   int
+		memory uint64
+		want   int
+	}{
+		{name: "unknown memory uses cpus", cpus: 8, memory: 0, want: 8},
+		{name: "plenty of memory uses cpus", cpus: 4, memory: 64 << 30, want: 4},
+		{name: "memory caps workers", cpus: 16, memory: 2 << 30, want: 4},
+		{name: "low memory keeps one worker", cpus: 8, memory: 100 << 20, want: 1},
+		{name: "zero cpus keeps one worker", cpus: 0, memory: 0, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := autoThreads(tt.cpus, tt.memory); got != tt.want {
+				t.Fatalf("autoThreads(%d, %d) = %d, want %d", tt.cpus, tt.memory, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveThreads(t *testing.T) {
+	originalCPU, originalMemory := numCPU, availableMemory
+	t.Cleanup(func() { numCPU, availableMemory = originalCPU, originalMemory })
+
+	numCPU = func() int { return 8 }
+	availableMemory = func() (uint64, bool) { return 1 << 30, true }
+
+	if got := resolveThreads(3); got != 3 {
+		t.Fatalf("resolveThreads(3) = %d, want explicit value 3", got)
+	}
+
+	if got := resolveThreads(0); got != 2 {
+		t.Fatalf("resolveThreads(0) = %d, want 2 (memory bound)", got)
+	}
+
+	availableMemory = func() (uint64, bool) { return 0, false }
+
+	if got := resolveThreads(0); got != 8 {
+		t.Fatalf("resolveThreads(0) without meminfo = %d, want 8", got)
+	}
+}
+
+func TestParseMemAvailable(t *testing.T) {
+	meminfo := []byte("MemTotal:       16315112 kB\nMemFree:         1201124 kB\nMemAvailable:    8157556 kB\n")
+
+	got, ok := parseMemAvailable(meminfo)
+	if !ok {
+		t.Fatalf("expected MemAvailable to be parsed")
+	}
+
+	if want := uint64(8157556) << 10; got != want {
+		t.Fatalf("parseMemAvailable = %d, want %d", got, want)
+	}
+
+	if _, ok := parseMemAvailable([]byte("MemTotal: 1 kB\n")); ok {
+		t.Fatalf("expected missing MemAvailable to report false")
+	}
+}
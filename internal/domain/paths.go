@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"path/filepath"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// withinPaths keeps the sources the requested paths would have found, so a
+// file missing from a narrower run (a single file, one package) is not
+// mistaken for a deleted one.
+func withinPaths(sources []m.Source, paths []m.Path) []m.Source {
+	kept := make([]m.Source, 0, len(sources))
+
+	for _, source := range sources {
+		for _, path := range paths {
+			if pathCovers(path, source.Origin.FullPath) {
+				kept = append(kept, source)
+				break
+			}
+		}
+	}
+
+	return kept
+}
+
+// pathCovers reports whether file lies under root, where root is a file, a
+// directory, or a directory followed by "/..." to include subdirectories.
+func pathCovers(root m.Path, file m.Path) bool {
+	rootStr, recursive := strings.CutSuffix(string(root), "/...")
+	if rootStr == "" {
+		rootStr = "."
+	}
+
+	rootAbs, err := filepath.Abs(rootStr)
+	if err != nil {
+		return false
+	}
+
+	fileAbs, err := filepath.Abs(string(file))
+	if err != nil {
+		return false
+	}
+
+	if fileAbs == rootAbs || filepath.Dir(fileAbs) == rootAbs {
+		return true
+	}
+
+	rel, err := filepath.Rel(rootAbs, fileAbs)
+
+	return recursive && err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// describePaths lists the requested paths for messages; no paths means the
+// current module.
+func describePaths(paths []m.Path) string {
+	if len(paths) == 0 {
+		return "."
+	}
+
+	names := make([]string, len(paths))
+	for i, path := range paths {
+		names[i] = string(path)
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"path/filepath"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestPathCovers(t *testing.T) {
+	root := t.TempDir()
+	file := m.Path(filepath.Join(root, "pkg", "calc.go"))
+
+	tests := []struct {
+		name string
+		root m.Path
+		want bool
+	}{
+		{name: "same file", root: file, want: true},
+		{name: "other file", root: m.Path(filepath.Join(root, "pkg", "other.go")), want: false},
+		{name: "package directory", root: m.Path(filepath.Join(root, "pkg")), want: true},
+		{name: "parent directory without ...", root: m.Path(root), want: false},
+		{name: "parent directory with ...", root: m.Path(root + "/..."), want: true},
+		{name: "sibling directory with ...", root: m.Path(filepath.Join(root, "cmd") + "/..."), want: false},
+		{name: "prefix-sharing sibling", root: m.Path(filepath.Join(root, "pk") + "/..."), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pathCovers(tt.root, file); got != tt.want {
+				t.Fatalf("pathCovers(%q, %q) = %v, want %v", tt.root, file, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithinPaths(t *testing.T) {
+	root := t.TempDir()
+	calc := m.Source{Origin: &m.File{FullPath: m.Path(filepath.Join(root, "pkg", "calc.go"))}}
+	other := m.Source{Origin: &m.File{FullPath: m.Path(filepath.Join(root, "cmd", "main.go"))}}
+
+	got := withinPaths([]m.Source{calc, other}, []m.Path{m.Path(filepath.Join(root, "pkg", "calc.go"))})
+	if len(got) != 1 || got[0].Origin.FullPath != calc.Origin.FullPath {
+		t.Fatalf("withinPaths() = %v, want only %s", got, calc.Origin.FullPath)
+	}
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "sync"
+
+// pauseGate holds back mutations from starting while the run is paused from
+// the UI. Mutations already being tested finish regardless.
+type pauseGate struct {
+	mu sync.Mutex
+	// resumed is closed when the run resumes; it is nil while not paused.
+	resumed chan struct{}
+}
+
+// set pauses or resumes the run; it is the controller.Pauser of the UI.
+func (g *pauseGate) set(paused bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch {
+	case paused && g.resumed == nil:
+		g.resumed = make(chan struct{})
+	case !paused && g.resumed != nil:
+		close(g.resumed)
+		g.resumed = nil
+	}
+}
+
+// waitWhilePaused blocks a mutation from starting while the UI has paused
+// the run, until it resumes or the run is interrupted or aborted.
+func (w *workflow) waitWhilePaused(stop <-chan struct{}) {
+	if w.pause == nil {
+		return
+	}
+
+	w.pause.wait(stop, w.Done(), w.abort.done())
+}
+
+// wait blocks while the run is paused, until it resumes or stop, done or
+// aborted is closed.
+func (g *pauseGate) wait(stop, done, aborted <-chan struct{}) {
+	for {
+		g.mu.Lock()
+		resumed := g.resumed
+		g.mu.Unlock()
+
+		if resumed == nil {
+			return
+		}
+
+		select {
+		case <-resumed:
+		case <-stop:
+			return
+		case <-done:
+			return
+		case <-aborted:
+			return
+		}
+	}
+}
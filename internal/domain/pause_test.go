@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func waitReturned(gate *pauseGate, stop <-chan struct{}) <-chan struct{} {
+	returned := make(chan struct{})
+
+	go func() {
+		gate.wait(stop, nil, nil)
+		close(returned)
+	}()
+
+	return returned
+}
+
+func TestPauseGate_HoldsMutationsUntilResumed(t *testing.T) {
+	gate := &pauseGate{}
+
+	select {
+	case <-waitReturned(gate, nil):
+	case <-time.After(time.Second):
+		t.Fatal("wait blocked while not paused")
+	}
+
+	gate.set(true)
+	gate.set(true)
+
+	returned := waitReturned(gate, nil)
+
+	select {
+	case <-returned:
+		t.Fatal("wait returned while paused")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	gate.set(false)
+
+	select {
+	case <-returned:
+	case <-time.After(time.Second):
+		t.Fatal("wait still blocked after resuming")
+	}
+
+	gate.set(false)
+	assert.Nil(t, gate.resumed)
+}
+
+func TestPauseGate_InterruptEndsThePause(t *testing.T) {
+	gate := &pauseGate{}
+	gate.set(true)
+
+	stop := make(chan struct{})
+	returned := waitReturned(gate, stop)
+
+	close(stop)
+
+	select {
+	case <-returned:
+	case <-time.After(time.Second):
+		t.Fatal("wait still blocked after the run was interrupted")
+	}
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/controller"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// PlanArgs contains the arguments for recording a mutation plan.
+type PlanArgs struct {
+	EstimateArgs
+	// Out is the file the plan is written to.
+	Out m.Path
+}
+
+// ExecArgs contains the arguments for testing the mutations of a plan.
+// The paths and generation settings of TestArgs are taken from the plan.
+type ExecArgs struct {
+	TestArgs
+	// Plan is the file Plan wrote.
+	Plan m.Path
+}
+
+// Plan generates the mutations a run would test and records them in
+// args.Out, so that the shards of a distributed run can all execute the
+// same list. Cached results are ignored: every mutation is recorded.
+func (w *workflow) Plan(args PlanArgs) error {
+	if args.Out == "" {
+		return fmt.Errorf("plan output file is required")
+	}
+
+	if err := w.Start(controller.WithEstimateMode()); err != nil {
+		return err
+	}
+	defer w.Close()
+
+	estimateArgs := args.EstimateArgs
+	estimateArgs.UseCache = false
+
+	mutations, err := w.GetMutations(estimateArgs)
+	if err != nil {
+		return fmt.Errorf("generate mutations: %w", err)
+	}
+
+	plan := m.MutationPlan{
+		Time:               time.Now(),
+		Paths:              args.Paths,
+		Exclude:            args.Exclude,
+		Tags:               args.Tags,
+		IncludeTestHelpers: args.IncludeTestHelpers,
+		Mutations:          make([]m.PlannedMutation, 0, len(mutations)),
+	}
+
+	for _, mutation := range mutations {
+		planned := m.PlannedMutation{ID: mutation.ID, Type: mutation.Type.Name, Line: mutation.Position.Line}
+		if mutation.Source.Origin != nil {
+			planned.File = mutation.Source.Origin.DisplayPath()
+		}
+
+		plan.Mutations = append(plan.Mutations, planned)
+	}
+
+	if err := w.SavePlan(args.Out, plan); err != nil {
+		return fmt.Errorf("save plan: %w", err)
+	}
+
+	if err := w.DisplayEstimation(mutations, nil); err != nil {
+		return fmt.Errorf("display: %w", err)
+	}
+
+	w.Wait()
+
+	return nil
+}
+
+// Exec tests the mutations recorded in args.Plan, as Test tests the
+// mutations it generates. The mutations are generated again from the plan's
+// paths and settings, and only the planned ones are kept, so every shard
+// splits the same mutations however the sources regenerate.
+func (w *workflow) Exec(args ExecArgs) error {
+	plan, err := w.LoadPlan(args.Plan)
+	if err != nil {
+		return fmt.Errorf("load plan: %w", err)
+	}
+
+	testArgs := args.TestArgs
+	testArgs.Paths = plan.Paths
+	testArgs.Exclude = plan.Exclude
+	testArgs.Tags = plan.Tags
+	testArgs.IncludeTestHelpers = plan.IncludeTestHelpers
+	testArgs.plan = &plan
+
+	return w.Test(testArgs)
+}
+
+// withPlan keeps the mutations plan lists. A planned mutation missing from
+// a file that was generated again is an error, since its shard could not
+// test it: the file changed since the plan was recorded. Files the cache
+// skipped keep their saved results. Without a plan every mutation is kept.
+func withPlan(mutations []m.Mutation, plan *m.MutationPlan) ([]m.Mutation, error) {
+	if plan == nil {
+		return mutations, nil
+	}
+
+	generated := make(map[string]bool, len(mutations))
+	files := make(map[m.Path]bool)
+
+	for _, mutation := range mutations {
+		generated[mutation.ID] = true
+
+		if mutation.Source.Origin != nil {
+			files[mutation.Source.Origin.DisplayPath()] = true
+		}
+	}
+
+	planned := make(map[string]bool, len(plan.Mutations))
+
+	var missing []m.PlannedMutation
+
+	for _, mutation := range plan.Mutations {
+		planned[mutation.ID] = true
+
+		if !generated[mutation.ID] && files[mutation.File] {
+			missing = append(missing, mutation)
+		}
+	}
+
+	if len(missing) > 0 {
+		first := missing[0]
+
+		return nil, fmt.Errorf("%d planned mutations were not generated from the current sources, the first being %s %s at %s:%d; record the plan again",
+			len(missing), first.ID, first.Type, first.File, first.Line)
+	}
+
+	kept := make([]m.Mutation, 0, len(plan.Mutations))
+
+	for _, mutation := range mutations {
+		if planned[mutation.ID] {
+			kept = append(kept, mutation)
+		}
+	}
+
+	return kept, nil
+}
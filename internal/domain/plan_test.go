@@ -0,0 +1,188 @@
+package domain_test
+
+import (
+	"testing"
+
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	domain "github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func planSource() m.Source {
+	return m.Source{
+		Origin: &m.File{FullPath: "/project/calc/add.go", ShortPath: "calc/add.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "/project/calc/add_test.go"},
+	}
+}
+
+func TestWorkflow_Plan_RecordsMutations(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := planSource()
+	mutations := []m.Mutation{
+		{ID: "hash-0", Source: source, Type: m.MutationArithmetic, Position: m.Position{Line: 3}},
+		{ID: "hash-1", Source: source, Type: m.MutationBoolean, Position: m.Position{Line: 7}},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mutations, nil).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get([]m.Path{"./calc"}).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+
+	var saved m.MutationPlan
+
+	mockReportStore.EXPECT().SavePlan(m.Path("plan.yaml"), mock.Anything).RunAndReturn(func(_ m.Path, plan m.MutationPlan) error {
+		saved = plan
+		return nil
+	}).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Plan(domain.PlanArgs{
+		EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"./calc"}, UseCache: true, Reports: "reports", Tags: []string{"integration"}},
+		Out:          "plan.yaml",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []m.Path{"./calc"}, saved.Paths)
+	assert.Equal(t, []string{"integration"}, saved.Tags)
+	assert.Equal(t, []m.PlannedMutation{
+		{ID: "hash-0", File: "calc/add.go", Type: m.MutationArithmetic.Name, Line: 3},
+		{ID: "hash-1", File: "calc/add.go", Type: m.MutationBoolean.Name, Line: 7},
+	}, saved.Mutations)
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestWorkflow_Exec_TestsOnlyPlannedMutations(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(2).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Maybe()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := planSource()
+
+	var mutations []m.Mutation
+	for _, id := range []string{"hash-0", "hash-1", "hash-2", "hash-3"} {
+		mutations = append(mutations, m.Mutation{ID: id, Source: source, Type: m.MutationArithmetic})
+	}
+
+	mockReportStore.EXPECT().LoadPlan(m.Path("plan.yaml")).Return(m.MutationPlan{
+		Paths: []m.Path{"./calc"},
+		Mutations: []m.PlannedMutation{
+			{ID: "hash-0", File: "calc/add.go", Type: "arithmetic"},
+			{ID: "hash-2", File: "calc/add.go", Type: "arithmetic"},
+		},
+	}, nil).Once()
+	mockFSAdapter.EXPECT().Get([]m.Path{"./calc"}).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+
+	var tested []string
+
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).RunAndReturn(func(mutation m.Mutation) (m.MutationResult, error) {
+		tested = append(tested, mutation.ID)
+		return m.MutationResult{Status: m.Killed}, nil
+	})
+	mockReportStore.EXPECT().SaveReports(m.Path("reports"), mock.Anything).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Exec(domain.ExecArgs{
+		TestArgs: domain.TestArgs{Reports: "reports", Threads: 1, TotalShardCount: 1},
+		Plan:     "plan.yaml",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"hash-0", "hash-2"}, tested)
+}
+
+func TestWorkflow_Exec_PlannedMutationNotGenerated(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := planSource()
+
+	mockReportStore.EXPECT().LoadPlan(m.Path("plan.yaml")).Return(m.MutationPlan{
+		Paths: []m.Path{"./calc"},
+		Mutations: []m.PlannedMutation{
+			{ID: "hash-0", File: "calc/add.go", Type: "arithmetic", Line: 3},
+			{ID: "gone", File: "calc/add.go", Type: "boolean", Line: 9},
+			{ID: "cached", File: "calc/sub.go", Type: "boolean", Line: 4},
+		},
+	}, nil).Once()
+	mockFSAdapter.EXPECT().Get([]m.Path{"./calc"}).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{{ID: "hash-0", Source: source, Type: m.MutationArithmetic}}, nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Exec(domain.ExecArgs{
+		TestArgs: domain.TestArgs{Reports: "reports", Threads: 1, TotalShardCount: 1},
+		Plan:     "plan.yaml",
+	})
+
+	// Assert
+	require.ErrorContains(t, err, "1 planned mutations were not generated from the current sources, the first being gone boolean at calc/add.go:9")
+	mockOrchestrator.AssertNotCalled(t, "TestMutation", mock.Anything)
+}
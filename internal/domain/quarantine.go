@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultQuarantineAfter is the number of recorded runs a mutation must have
+// timed out in before runs stop testing it.
+const DefaultQuarantineAfter = 3
+
+// quarantinedMutations reads the run history in reports for the mutations
+// to quarantine. A non-positive after disables the quarantine.
+func (w *workflow) quarantinedMutations(reports m.Path, after int) (map[string]int, error) {
+	if after <= 0 || reports == "" {
+		return nil, nil
+	}
+
+	records, err := w.LoadHistory(reports)
+	if err != nil {
+		return nil, fmt.Errorf("load history: %w", err)
+	}
+
+	return timedOutInRuns(records, after), nil
+}
+
+// timedOutInRuns counts, per mutation ID, the runs in which the mutation was
+// killed by a timeout, keeping those that reached after.
+func timedOutInRuns(records []m.RunRecord, after int) map[string]int {
+	timeouts := make(map[string]int)
+
+	for _, record := range records {
+		for _, id := range record.TimedOut {
+			timeouts[id]++
+		}
+	}
+
+	for id, count := range timeouts {
+		if count < after {
+			delete(timeouts, id)
+		}
+	}
+
+	return timeouts
+}
+
+// withoutQuarantined splits off the quarantined mutations, returning the ones
+// left to test and a skipped report with a note for each quarantined one.
+// Mutation IDs hash the mutated source, so editing a file releases its
+// mutations from the quarantine.
+func withoutQuarantined(mutations []m.Mutation, quarantined map[string]int) ([]m.Mutation, []m.Report) {
+	if len(quarantined) == 0 {
+		return mutations, nil
+	}
+
+	kept := make([]m.Mutation, 0, len(mutations))
+
+	var skipped []m.Report
+
+	for _, mutation := range mutations {
+		runs, ok := quarantined[mutation.ID]
+		if !ok {
+			kept = append(kept, mutation)
+			continue
+		}
+
+		skipped = append(skipped, m.Report{
+			Source: mutation.Source,
+			Result: m.Result{{
+				MutationID: mutation.ID,
+				Type:       mutation.Type,
+				Status:     m.Skipped,
+				Line:       mutation.Position.Line,
+				Note:       fmt.Sprintf("quarantined: timed out in %d recorded runs", runs),
+			}},
+		})
+	}
+
+	return kept, skipped
+}
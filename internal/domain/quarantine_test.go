@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimedOutInRuns(t *testing.T) {
+	records := []m.RunRecord{
+		{TimedOut: []string{"slow", "flaky"}},
+		{TimedOut: []string{"slow"}},
+		{TimedOut: []string{"slow", "flaky"}},
+	}
+
+	assert.Equal(t, map[string]int{"slow": 3}, timedOutInRuns(records, 3))
+	assert.Equal(t, map[string]int{"slow": 3, "flaky": 2}, timedOutInRuns(records, 2))
+	assert.Empty(t, timedOutInRuns(nil, 1))
+}
+
+func TestWithoutQuarantined(t *testing.T) {
+	source := m.Source{Origin: &m.File{FullPath: "/project/loop.go"}}
+	mutations := []m.Mutation{
+		{ID: "slow", Type: m.MutationLoop, Source: source},
+		{ID: "fast", Type: m.MutationArithmetic, Source: source},
+	}
+
+	kept, skipped := withoutQuarantined(mutations, map[string]int{"slow": 4})
+
+	require.Len(t, kept, 1)
+	assert.Equal(t, "fast", kept[0].ID)
+
+	require.Len(t, skipped, 1)
+	assert.Equal(t, source, skipped[0].Source)
+	assert.Equal(t, m.MutationResult{
+		MutationID: "slow",
+		Type:       m.MutationLoop,
+		Status:     m.Skipped,
+		Note:       "quarantined: timed out in 4 recorded runs",
+	}, skipped[0].Result[0])
+
+	kept, skipped = withoutQuarantined(mutations, nil)
+	assert.Equal(t, mutations, kept)
+	assert.Empty(t, skipped)
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"slices"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// mutationTypes returns base, DefaultMutations when base is empty, plus the
+// concurrency mutations for race detector runs: without -race, a test suite
+// rarely notices a missing lock.
+func mutationTypes(base []m.MutationType, race bool) []m.MutationType {
+	if len(base) == 0 {
+		base = DefaultMutations
+	}
+
+	if !race || slices.Contains(base, m.MutationConcurrency) {
+		return base
+	}
+
+	return append(slices.Clip(base), m.MutationConcurrency)
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutationTypes(t *testing.T) {
+	assert.Equal(t, DefaultMutations, mutationTypes(nil, false))
+
+	race := mutationTypes(nil, true)
+	assert.Equal(t, append(append([]m.MutationType{}, DefaultMutations...), m.MutationConcurrency), race)
+	assert.NotContains(t, DefaultMutations, m.MutationConcurrency, "DefaultMutations is left unchanged")
+}
+
+func TestMutationTypes_WithMutators(t *testing.T) {
+	base := []m.MutationType{m.MutationLoop}
+
+	assert.Equal(t, base, mutationTypes(base, false))
+	assert.Equal(t, []m.MutationType{m.MutationLoop, m.MutationConcurrency}, mutationTypes(base, true))
+
+	withConcurrency := []m.MutationType{m.MutationConcurrency}
+	assert.Equal(t, withConcurrency, mutationTypes(withConcurrency, true))
+}
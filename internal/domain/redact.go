@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// redactReports returns copies of reports without the diffs of survivors and
+// the errors, test output and hints of results, which can quote the code.
+// Mutation IDs, source hashes, statuses and lines are kept.
+func redactReports(reports []m.Report) []m.Report {
+	redacted := make([]m.Report, 0, len(reports))
+
+	for _, report := range reports {
+		result := make(m.Result, len(report.Result))
+		for i, entry := range report.Result {
+			entry.Err = nil
+			entry.TestOutput = ""
+			entry.Hint = ""
+			result[i] = entry
+		}
+
+		redacted = append(redacted, m.Report{Source: report.Source, Result: result})
+	}
+
+	return redacted
+}
+
+// redactDiagnostics drops the diffs from diagnostics, leaving their location
+// and the name of the surviving mutation.
+func redactDiagnostics(diagnostics []m.Diagnostic) []m.Diagnostic {
+	for i := range diagnostics {
+		diagnostics[i].Diff = nil
+	}
+
+	return diagnostics
+}
+
+// redactStoredReports rewrites the reports in dir that still hold code, such
+// as those cached from runs without redaction.
+func (w *workflow) redactStoredReports(dir m.Path) error {
+	reports, err := w.LoadReports(dir)
+	if err != nil {
+		return fmt.Errorf("load reports to redact: %w", err)
+	}
+
+	var unredacted []m.Report
+
+	for _, report := range reports {
+		if holdsCode(report) {
+			unredacted = append(unredacted, report)
+		}
+	}
+
+	if len(unredacted) == 0 {
+		return nil
+	}
+
+	if err := w.SaveReports(dir, redactReports(unredacted)); err != nil {
+		return fmt.Errorf("save redacted reports: %w", err)
+	}
+
+	return nil
+}
+
+func holdsCode(report m.Report) bool {
+	if report.Diff != nil {
+		return true
+	}
+
+	for _, result := range report.Result {
+		if result.Err != nil || result.TestOutput != "" || result.Hint != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,114 @@
+package domain_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflow_Test_RedactCodeKeepsCodeOutOfReports(t *testing.T) {
+	reports := filepath.Join(t.TempDir(), "reports")
+	store := adapter.NewReportStore()
+
+	// A survivor cached by an earlier run without redaction.
+	cachedDiff := []byte("-\treturn a - b\n+\treturn a + b\n")
+	cached := m.Report{
+		Source: m.Source{Origin: &m.File{FullPath: "/project/sub.go", Hash: "sub"}},
+		Result: m.Result{{MutationID: "cached-1", Type: m.MutationArithmetic, Status: m.Survived, Hint: "no test asserts the return value of Sub for operands of a - b other than 0 and 1"}},
+		Diff:   &cachedDiff,
+	}
+	require.NoError(t, store.SaveReports(m.Path(reports), []m.Report{cached}))
+
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{
+		Origin: &m.File{FullPath: "/project/add.go", Hash: "add"},
+		Test:   &m.File{FullPath: "/project/add_test.go", Hash: "add_test"},
+	}
+	mutations := []m.Mutation{{
+		ID: "add-1", Source: source, Type: m.MutationArithmetic,
+		Position: m.Position{Line: 7, Column: 11},
+		DiffCode: []byte("-\treturn a + b\n+\treturn a - b\n"),
+		Hint:     "no test asserts the return value of Add for operands of a + b other than 0 and 1",
+	}}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{Status: m.Survived}, nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(store),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	diagnosticsOut := filepath.Join(t.TempDir(), "gooze.rdjson")
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs:    domain.EstimateArgs{Paths: []m.Path{"/project/add.go"}},
+		Reports:         m.Path(reports),
+		Threads:         1,
+		TotalShardCount: 1,
+		DiagnosticsOut:  m.Path(diagnosticsOut),
+		RedactCode:      true,
+	})
+	require.NoError(t, err)
+
+	saved, err := store.LoadReports(m.Path(reports))
+	require.NoError(t, err)
+	require.Len(t, saved, 2)
+
+	lines := make(map[string]int)
+	for _, report := range saved {
+		assert.Nil(t, report.Diff, "report of %s keeps its diff", report.Source.Origin.FullPath)
+		lines[report.Result[0].MutationID] = report.Result[0].Line
+	}
+
+	assert.Equal(t, 7, lines["add-1"])
+
+	entries, err := os.ReadDir(reports)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(reports, entry.Name()))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "return a", "%s quotes the code", entry.Name())
+		assert.NotContains(t, string(data), "hint:", "%s keeps a hint quoting the code", entry.Name())
+	}
+
+	diagnostics, err := os.ReadFile(diagnosticsOut)
+	require.NoError(t, err)
+	assert.False(t, strings.Contains(string(diagnostics), "return a"), "diagnostics quote the code: %s", diagnostics)
+}
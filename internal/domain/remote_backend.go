@@ -0,0 +1,369 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// WorkerArgs contains the arguments for serving mutation jobs as a worker.
+type WorkerArgs struct {
+	// Root is the worker's checkout of the project; the paths of the jobs
+	// are relative to it.
+	Root m.Path
+	In   io.Reader
+	Out  io.Writer
+}
+
+// workerSetup is the first line sent to a worker, carrying the options of
+// the run for all the jobs that follow.
+type workerSetup struct {
+	Options RunOptions
+}
+
+// workerJob is a mutation sent to a worker, one JSON line per job. Its paths
+// are relative to the project root, since the worker's checkout lives
+// elsewhere.
+type workerJob struct {
+	Mutation m.Mutation
+}
+
+// workerReply answers a workerJob. Error is set when the mutation could not
+// be tested, ResultError when Result carries an error.
+type workerReply struct {
+	Result      m.MutationResult
+	ResultError string `json:",omitempty"`
+	Error       string `json:",omitempty"`
+}
+
+// remoteBackend tests mutations on worker processes, each started by one of
+// its commands and testing one mutation at a time.
+type remoteBackend struct {
+	fsAdapter adapter.SourceFSAdapter
+	launcher  adapter.WorkerLauncher
+	commands  []string
+
+	// idle holds the workers waiting for a mutation. It is closed once every
+	// worker has been dropped.
+	idle chan *workerConn
+
+	mu      sync.Mutex
+	workers []*workerConn
+}
+
+// workerConn is the connection to one started worker.
+type workerConn struct {
+	command string
+	conn    io.ReadWriteCloser
+	encoder *json.Encoder
+	decoder *json.Decoder
+	// lost is set once sending to or receiving from the worker failed,
+	// leaving the connection unusable.
+	lost bool
+}
+
+// errNoWorkers is returned for mutations submitted after every worker has
+// been lost.
+var errNoWorkers = errors.New("no workers left")
+
+// NewRemoteBackend returns an ExecutionBackend that dispatches mutations to
+// workers started by launcher, one per command, such as
+// "ssh build-1 'cd src/project && gooze worker'". Every worker needs a
+// checkout of the same revision of the project.
+func NewRemoteBackend(fsAdapter adapter.SourceFSAdapter, launcher adapter.WorkerLauncher, commands []string) ExecutionBackend {
+	return &remoteBackend{
+		fsAdapter: fsAdapter,
+		launcher:  launcher,
+		commands:  commands,
+	}
+}
+
+// Prepare starts the workers and sends them opts. Each tests one mutation at
+// a time, so threads beyond the number of workers only queue. The local
+// WorkDir means nothing to the workers and is dropped.
+func (b *remoteBackend) Prepare(_ []m.Mutation, _ int, opts RunOptions) error {
+	if err := b.Release(); err != nil {
+		return err
+	}
+
+	if len(b.commands) == 0 {
+		return errors.New("no worker commands")
+	}
+
+	b.idle = make(chan *workerConn, len(b.commands))
+	opts.WorkDir = ""
+
+	for _, command := range b.commands {
+		conn, err := b.launcher.Launch(command)
+		if err != nil {
+			return errors.Join(err, b.Release())
+		}
+
+		worker := &workerConn{
+			command: command,
+			conn:    conn,
+			encoder: json.NewEncoder(conn),
+			decoder: json.NewDecoder(conn),
+		}
+		b.workers = append(b.workers, worker)
+
+		if err := worker.encoder.Encode(workerSetup{Options: opts}); err != nil {
+			return errors.Join(fmt.Errorf("send options to worker %q: %w", command, err), b.Release())
+		}
+
+		b.idle <- worker
+	}
+
+	return nil
+}
+
+func (b *remoteBackend) Submit(mutation m.Mutation) <-chan JobResult {
+	results := make(chan JobResult, 1)
+
+	go func() {
+		worker, ok := <-b.idle
+		if !ok {
+			results <- JobResult{Err: errNoWorkers}
+
+			return
+		}
+
+		result, err := b.dispatch(worker, mutation)
+		if worker.lost {
+			err = errors.Join(err, b.drop(worker))
+		} else {
+			b.idle <- worker
+		}
+
+		results <- JobResult{Result: result, Err: err}
+	}()
+
+	return results
+}
+
+// Release stops the workers by closing their input and waits for them to
+// exit.
+func (b *remoteBackend) Release() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var errs []error
+
+	for _, worker := range b.workers {
+		if err := worker.conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	b.workers = nil
+
+	return errors.Join(errs...)
+}
+
+// drop closes the connection to a lost worker and removes it from the pool,
+// closing b.idle when it was the last one so that later mutations fail
+// instead of waiting for a worker forever.
+func (b *remoteBackend) drop(worker *workerConn) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, w := range b.workers {
+		if w == worker {
+			b.workers = append(b.workers[:i], b.workers[i+1:]...)
+
+			break
+		}
+	}
+
+	if len(b.workers) == 0 {
+		close(b.idle)
+	}
+
+	return worker.conn.Close()
+}
+
+func (b *remoteBackend) dispatch(worker *workerConn, mutation m.Mutation) (m.MutationResult, error) {
+	job, err := b.job(mutation)
+	if err != nil {
+		return m.MutationResult{}, err
+	}
+
+	if err := worker.encoder.Encode(job); err != nil {
+		worker.lost = true
+
+		return m.MutationResult{}, fmt.Errorf("send mutation %s to worker %q: %w", mutation.ID, worker.command, err)
+	}
+
+	var reply workerReply
+	if err := worker.decoder.Decode(&reply); err != nil {
+		worker.lost = true
+
+		return m.MutationResult{}, fmt.Errorf("receive mutation %s from worker %q: %w", mutation.ID, worker.command, err)
+	}
+
+	if reply.Error != "" {
+		return m.MutationResult{}, fmt.Errorf("worker %q: %s", worker.command, reply.Error)
+	}
+
+	if reply.ResultError != "" {
+		reply.Result.Err = errors.New(reply.ResultError)
+	}
+
+	return reply.Result, nil
+}
+
+// job makes the mutation's paths relative to its project root.
+func (b *remoteBackend) job(mutation m.Mutation) (workerJob, error) {
+	if mutation.Source.Origin == nil {
+		return workerJob{}, fmt.Errorf("source origin is nil")
+	}
+
+	root, err := b.fsAdapter.FindProjectRoot(mutation.Source.Origin.FullPath)
+	if err != nil {
+		return workerJob{}, newInfraError(PhaseProjectRoot, mutation, fmt.Errorf("failed to find project root: %w", err))
+	}
+
+	mutation, err = rebasePaths(mutation, func(path m.Path) (m.Path, error) {
+		rel, err := b.fsAdapter.RelPath(root, path)
+		if err != nil {
+			return "", fmt.Errorf("failed to get relative path: %w", err)
+		}
+
+		return m.Path(filepath.ToSlash(string(rel))), nil
+	})
+	if err != nil {
+		return workerJob{}, err
+	}
+
+	return workerJob{Mutation: mutation}, nil
+}
+
+// Worker applies the run options read first from args.In, then tests the
+// mutation jobs that follow, one at a time, and writes a reply for each to
+// args.Out until args.In ends.
+func (w *workflow) Worker(args WorkerArgs) error {
+	decoder := json.NewDecoder(args.In)
+	encoder := json.NewEncoder(args.Out)
+
+	var setup workerSetup
+	if err := decoder.Decode(&setup); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return fmt.Errorf("read run options: %w", err)
+	}
+
+	w.SetRunOptions(setup.Options)
+
+	for {
+		var job workerJob
+		if err := decoder.Decode(&job); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("read job: %w", err)
+		}
+
+		if err := encoder.Encode(w.serveJob(job, args.Root)); err != nil {
+			return fmt.Errorf("write result: %w", err)
+		}
+	}
+}
+
+func (w *workflow) serveJob(job workerJob, root m.Path) workerReply {
+	mutation, err := rebasePaths(job.Mutation, func(path m.Path) (m.Path, error) {
+		if !filepath.IsLocal(filepath.FromSlash(string(path))) {
+			return "", fmt.Errorf("path %s is outside the project", path)
+		}
+
+		return w.JoinPath(string(root), filepath.FromSlash(string(path))), nil
+	})
+	if err != nil {
+		return workerReply{Error: err.Error()}
+	}
+
+	if err := w.checkCheckout(mutation.Source); err != nil {
+		return workerReply{Error: err.Error()}
+	}
+
+	result, err := w.TestMutation(mutation)
+	if err != nil {
+		return workerReply{Error: err.Error()}
+	}
+
+	reply := workerReply{Result: result}
+	if result.Err != nil {
+		reply.ResultError = result.Err.Error()
+		reply.Result.Err = nil
+	}
+
+	return reply
+}
+
+// checkCheckout fails when the worker's copy of a file of source differs
+// from the one the mutation was generated from, which would test the
+// mutation against another revision of the project.
+func (w *workflow) checkCheckout(source m.Source) error {
+	for _, file := range []*m.File{source.Origin, source.Test} {
+		if file == nil || file.Hash == "" {
+			continue
+		}
+
+		hash, err := w.HashFile(file.FullPath)
+		if err != nil {
+			return fmt.Errorf("hash %s: %w", file.FullPath, err)
+		}
+
+		if hash != file.Hash {
+			return fmt.Errorf("%s differs from the coordinator's copy; check out the same revision on every worker", file.FullPath)
+		}
+	}
+
+	return nil
+}
+
+// rebasePaths returns the mutation with the full paths of its files and its
+// test packages passed through rebase. The files are copied, not changed in
+// place.
+func rebasePaths(mutation m.Mutation, rebase func(m.Path) (m.Path, error)) (m.Mutation, error) {
+	for _, file := range []**m.File{&mutation.Source.Origin, &mutation.Source.Test} {
+		if *file == nil {
+			continue
+		}
+
+		rebased := **file
+
+		path, err := rebase(rebased.FullPath)
+		if err != nil {
+			return m.Mutation{}, err
+		}
+
+		rebased.FullPath = path
+		*file = &rebased
+	}
+
+	if len(mutation.Source.TestPackages) > 0 {
+		packages := make([]m.Path, 0, len(mutation.Source.TestPackages))
+
+		for _, dir := range mutation.Source.TestPackages {
+			path, err := rebase(dir)
+			if err != nil {
+				return m.Mutation{}, err
+			}
+
+			packages = append(packages, path)
+		}
+
+		mutation.Source.TestPackages = packages
+	}
+
+	return mutation, nil
+}
@@ -0,0 +1,220 @@
+package domain_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// pipeWorker connects a remote backend to a workflow serving as its worker
+// in the same process.
+type pipeWorker struct {
+	jobs    *io.PipeWriter
+	replies *io.PipeReader
+	done    chan error
+}
+
+func startPipeWorker(worker domain.Workflow, root m.Path) *pipeWorker {
+	jobsIn, jobsOut := io.Pipe()
+	repliesIn, repliesOut := io.Pipe()
+	p := &pipeWorker{jobs: jobsOut, replies: repliesIn, done: make(chan error, 1)}
+
+	go func() {
+		err := worker.Worker(domain.WorkerArgs{Root: root, In: jobsIn, Out: repliesOut})
+		repliesOut.Close()
+		p.done <- err
+	}()
+
+	return p
+}
+
+func (p *pipeWorker) Read(b []byte) (int, error)  { return p.replies.Read(b) }
+func (p *pipeWorker) Write(b []byte) (int, error) { return p.jobs.Write(b) }
+func (p *pipeWorker) Close() error {
+	p.jobs.Close()
+	return <-p.done
+}
+
+func newProject(t *testing.T) m.Path {
+	t.Helper()
+
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/calc\n"), 0o600))
+
+	return m.Path(root)
+}
+
+func TestRemoteBackend_TestsMutationOnWorker(t *testing.T) {
+	local, remote := newProject(t), newProject(t)
+	fsAdapter := adapter.NewLocalSourceFSAdapter()
+
+	orchestrator := domainmocks.NewMockOrchestrator(t)
+	orchestrator.EXPECT().SetRunOptions(domain.RunOptions{Race: true, GoTestArgs: []string{"-count=1"}}).Return().Once()
+	orchestrator.On("TestMutation", mock.MatchedBy(func(mutation m.Mutation) bool {
+		return mutation.Source.Origin.FullPath == m.Path(filepath.Join(string(remote), "calc.go")) &&
+			mutation.Source.Test.FullPath == m.Path(filepath.Join(string(remote), "calc_test.go"))
+	})).Return(m.MutationResult{MutationID: "abc", Status: m.Killed, KillReason: m.KillAssertion}, nil)
+
+	worker := domain.NewWorkflow(
+		domain.WithSourceFS(fsAdapter),
+		domain.WithOrchestrator(orchestrator),
+	)
+	launcher := adaptermocks.NewMockWorkerLauncher(t)
+	launcher.EXPECT().Launch("ssh build-1 gooze worker").Return(startPipeWorker(worker, remote), nil)
+
+	backend := domain.NewRemoteBackend(fsAdapter, launcher, []string{"ssh build-1 gooze worker"})
+	require.NoError(t, backend.Prepare(nil, 4, domain.RunOptions{Race: true, GoTestArgs: []string{"-count=1"}, WorkDir: "/fast/ssd"}))
+
+	mutation := m.Mutation{
+		ID: "abc",
+		Source: m.Source{
+			Origin: &m.File{ShortPath: "calc.go", FullPath: m.Path(filepath.Join(string(local), "calc.go"))},
+			Test:   &m.File{ShortPath: "calc_test.go", FullPath: m.Path(filepath.Join(string(local), "calc_test.go"))},
+		},
+	}
+
+	job := <-backend.Submit(mutation)
+	require.NoError(t, job.Err)
+	assert.Equal(t, m.Killed, job.Result.Status)
+	assert.Equal(t, m.KillAssertion, job.Result.KillReason)
+	assert.Equal(t, m.Path(filepath.Join(string(local), "calc.go")), mutation.Source.Origin.FullPath, "the submitted mutation is left unchanged")
+
+	require.NoError(t, backend.Release())
+}
+
+func TestRemoteBackend_ReportsWorkerErrors(t *testing.T) {
+	local, remote := newProject(t), newProject(t)
+	fsAdapter := adapter.NewLocalSourceFSAdapter()
+
+	orchestrator := domainmocks.NewMockOrchestrator(t)
+	orchestrator.EXPECT().SetRunOptions(domain.RunOptions{}).Return()
+	orchestrator.On("TestMutation", mock.Anything).Return(m.MutationResult{}, errors.New("go toolchain not found"))
+
+	worker := domain.NewWorkflow(
+		domain.WithSourceFS(fsAdapter),
+		domain.WithOrchestrator(orchestrator),
+	)
+	launcher := adaptermocks.NewMockWorkerLauncher(t)
+	launcher.EXPECT().Launch("gooze worker").Return(startPipeWorker(worker, remote), nil)
+
+	backend := domain.NewRemoteBackend(fsAdapter, launcher, []string{"gooze worker"})
+	require.NoError(t, backend.Prepare(nil, 1, domain.RunOptions{}))
+
+	job := <-backend.Submit(m.Mutation{
+		ID:     "abc",
+		Source: m.Source{Origin: &m.File{FullPath: m.Path(filepath.Join(string(local), "calc.go"))}},
+	})
+	require.Error(t, job.Err)
+	assert.Contains(t, job.Err.Error(), "go toolchain not found")
+
+	require.NoError(t, backend.Release())
+}
+
+func TestWorkflow_Worker_RejectsPathsOutsideTheProject(t *testing.T) {
+	worker := domain.NewWorkflow(
+		domain.WithSourceFS(adapter.NewLocalSourceFSAdapter()),
+		domain.WithOrchestrator(setupOnlyOrchestrator(t)),
+	)
+
+	var out strings.Builder
+
+	err := worker.Worker(domain.WorkerArgs{
+		Root: "/src/project",
+		In:   strings.NewReader(`{"Options":{}}` + "\n" + `{"Mutation":{"ID":"abc","Source":{"Origin":{"FullPath":"../../etc/passwd"}}}}` + "\n"),
+		Out:  &out,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "outside the project")
+}
+
+func TestWorkflow_Worker_RejectsOtherRevisions(t *testing.T) {
+	root := newProject(t)
+	require.NoError(t, os.WriteFile(filepath.Join(string(root), "calc.go"), []byte("package calc\n"), 0o600))
+
+	worker := domain.NewWorkflow(
+		domain.WithSourceFS(adapter.NewLocalSourceFSAdapter()),
+		domain.WithOrchestrator(setupOnlyOrchestrator(t)),
+	)
+
+	var out strings.Builder
+
+	err := worker.Worker(domain.WorkerArgs{
+		Root: root,
+		In:   strings.NewReader(`{"Options":{}}` + "\n" + `{"Mutation":{"ID":"abc","Source":{"Origin":{"FullPath":"calc.go","Hash":"0123"}}}}` + "\n"),
+		Out:  &out,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "differs from the coordinator's copy")
+}
+
+// brokenConn is a worker connection that takes its setup and fails every
+// write after it.
+type brokenConn struct {
+	setUp  bool
+	closed int
+}
+
+func (c *brokenConn) Read([]byte) (int, error) { return 0, io.EOF }
+
+func (c *brokenConn) Write(p []byte) (int, error) {
+	if !c.setUp {
+		c.setUp = true
+		return len(p), nil
+	}
+
+	return 0, io.ErrClosedPipe
+}
+
+func (c *brokenConn) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestRemoteBackend_DropsLostWorkers(t *testing.T) {
+	local := newProject(t)
+	fsAdapter := adapter.NewLocalSourceFSAdapter()
+
+	conn := &brokenConn{}
+	launcher := adaptermocks.NewMockWorkerLauncher(t)
+	launcher.EXPECT().Launch("gooze worker").Return(conn, nil)
+
+	backend := domain.NewRemoteBackend(fsAdapter, launcher, []string{"gooze worker"})
+	require.NoError(t, backend.Prepare(nil, 1, domain.RunOptions{}))
+
+	mutation := m.Mutation{
+		ID:     "abc",
+		Source: m.Source{Origin: &m.File{FullPath: m.Path(filepath.Join(string(local), "calc.go"))}},
+	}
+
+	job := <-backend.Submit(mutation)
+	require.ErrorIs(t, job.Err, io.ErrClosedPipe)
+
+	job = <-backend.Submit(mutation)
+	require.Error(t, job.Err, "no worker is left to test the mutation")
+
+	require.NoError(t, backend.Release())
+	assert.Equal(t, 1, conn.closed, "the lost worker is closed once")
+}
+
+// setupOnlyOrchestrator is the orchestrator of a worker that rejects its
+// jobs before testing them.
+func setupOnlyOrchestrator(t *testing.T) *domainmocks.MockOrchestrator {
+	t.Helper()
+
+	orchestrator := domainmocks.NewMockOrchestrator(t)
+	orchestrator.EXPECT().SetRunOptions(domain.RunOptions{}).Return()
+
+	return orchestrator
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// Reports are streamed to the reports directory in batches of at most
+// streamBatchSize, or sooner once streamFlushInterval has passed since the
+// last batch, so a crash loses little work without a write per mutation.
+const (
+	streamBatchSize     = 64
+	streamFlushInterval = 2 * time.Second
+)
+
+// reportSaver is the part of the report store a reportStream writes to.
+type reportSaver interface {
+	SaveReports(path m.Path, reports []m.Report) error
+	SavePending(path m.Path, sources []m.Path) error
+}
+
+// reportStream saves reports as their mutations complete, keeping the
+// sources still being tested listed as pending until the run is saved.
+type reportStream struct {
+	store  reportSaver
+	dir    m.Path
+	redact bool
+
+	mu        sync.Mutex
+	batch     []m.Report
+	remaining map[m.Path]int
+	saved     map[string]bool
+	lastFlush time.Time
+	err       error
+}
+
+// startReportStream records the sources of mutations as pending in dir and
+// returns the stream saving their reports.
+func startReportStream(store reportSaver, dir m.Path, mutations []m.Mutation, redact bool) (*reportStream, error) {
+	s := &reportStream{
+		store:     store,
+		dir:       dir,
+		redact:    redact,
+		remaining: make(map[m.Path]int),
+		saved:     make(map[string]bool),
+		lastFlush: time.Now(),
+	}
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin != nil {
+			s.remaining[mutation.Source.Origin.FullPath]++
+		}
+	}
+
+	if err := store.SavePending(dir, s.pending()); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// add queues report for saving, saving the batch once it is full or due.
+// It does nothing on a nil stream, when the run's reports are not saved.
+func (s *reportStream) add(report m.Report) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.batch = append(s.batch, report)
+
+	if report.Source.Origin != nil {
+		s.remaining[report.Source.Origin.FullPath]--
+	}
+
+	if len(s.batch) >= streamBatchSize || time.Since(s.lastFlush) >= streamFlushInterval {
+		s.flush()
+	}
+}
+
+// flush saves the batch and the sources still pending. The first error
+// stops streaming; the reports left unsaved are retried when the run is
+// saved.
+func (s *reportStream) flush() {
+	s.lastFlush = time.Now()
+
+	if s.err != nil || len(s.batch) == 0 {
+		return
+	}
+
+	batch := s.batch
+	if s.redact {
+		batch = redactReports(batch)
+	}
+
+	if err := s.store.SaveReports(s.dir, batch); err != nil {
+		s.err = err
+		return
+	}
+
+	if err := s.store.SavePending(s.dir, s.pending()); err != nil {
+		s.err = err
+		return
+	}
+
+	for _, report := range s.batch {
+		for _, result := range report.Result {
+			s.saved[result.MutationID] = true
+		}
+	}
+
+	s.batch = nil
+}
+
+// pending returns the sources with mutations whose reports are not saved
+// yet, sorted.
+func (s *reportStream) pending() []m.Path {
+	sources := make([]m.Path, 0, len(s.remaining))
+
+	for source, count := range s.remaining {
+		if count > 0 {
+			sources = append(sources, source)
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
+
+	return sources
+}
+
+// unsaved returns the reports not streamed yet, which the run saves when it
+// completes.
+func (s *reportStream) unsaved(reports []m.Report) []m.Report {
+	if s == nil {
+		return reports
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	left := make([]m.Report, 0, len(reports))
+
+	for _, report := range reports {
+		if len(report.Result) == 1 && s.saved[report.Result[0].MutationID] {
+			continue
+		}
+
+		left = append(left, report)
+	}
+
+	return left
+}
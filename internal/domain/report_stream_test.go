@@ -0,0 +1,100 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflow_Test_StreamsReportsBeforeTheRunEnds(t *testing.T) {
+	reports := m.Path(filepath.Join(t.TempDir(), "reports"))
+	store := adapter.NewReportStore()
+
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	done := m.Source{
+		Origin: &m.File{FullPath: "/project/add.go", Hash: "add"},
+		Test:   &m.File{FullPath: "/project/add_test.go", Hash: "add_test"},
+	}
+	crashed := m.Source{
+		Origin: &m.File{FullPath: "/project/sub.go", Hash: "sub"},
+		Test:   &m.File{FullPath: "/project/sub_test.go", Hash: "sub_test"},
+	}
+
+	// A full batch of add.go is streamed before sub.go's last mutation
+	// fails the run.
+	mutationsOf := func(source m.Source, name string, count int) []m.Mutation {
+		mutations := make([]m.Mutation, 0, count)
+		for i := range count {
+			mutations = append(mutations, m.Mutation{ID: fmt.Sprintf("%s-%d", name, i), Source: source, Type: m.MutationArithmetic})
+		}
+
+		return mutations
+	}
+	ofSource := func(source m.Source) any {
+		return mock.MatchedBy(func(s m.Source) bool { return s.Origin.FullPath == source.Origin.FullPath })
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Maybe()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{done, crashed}, nil)
+	mockMutagen.EXPECT().GenerateMutation(ofSource(done), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mutationsOf(done, "add", 64), nil)
+	mockMutagen.EXPECT().GenerateMutation(ofSource(crashed), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mutationsOf(crashed, "sub", 3), nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.MatchedBy(func(mutation m.Mutation) bool {
+		return mutation.ID == "sub-2"
+	})).Return(m.MutationResult{}, errors.New("worker lost")).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{Status: m.Killed}, nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(store),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs:    domain.EstimateArgs{Paths: []m.Path{"/project"}},
+		Reports:         reports,
+		Threads:         1,
+		TotalShardCount: 1,
+	})
+	require.Error(t, err)
+
+	saved, err := store.LoadReports(reports)
+	require.NoError(t, err)
+	assert.Len(t, saved, 64)
+
+	for _, report := range saved {
+		assert.Equal(t, done.Origin.FullPath, report.Source.Origin.FullPath)
+	}
+
+	changed, err := store.CheckUpdates(reports, []m.Source{done, crashed})
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	assert.Equal(t, crashed.Origin.FullPath, changed[0].Origin.FullPath)
+}
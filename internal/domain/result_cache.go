@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// cachedResult is a stored verdict with the source it was reached on.
+type cachedResult struct {
+	source m.Source
+	result m.MutationResult
+}
+
+// cachedResults loads the verdicts stored in reports that later runs can
+// reuse, by mutation ID. Untested, skipped and errored results are left out,
+// so their mutations are tested again.
+func (w *workflow) cachedResults(reports m.Path) (map[string]cachedResult, error) {
+	stored, err := w.loadReportsIfExists(reports)
+	if err != nil {
+		return nil, fmt.Errorf("load cached results: %w", err)
+	}
+
+	cached := make(map[string]cachedResult)
+
+	for _, report := range stored {
+		for _, result := range report.Result {
+			switch result.Status {
+			case m.Killed, m.Survived, m.Flaky, m.CompileError:
+				cached[result.MutationID] = cachedResult{source: report.Source, result: result}
+			case m.Skipped, m.Error, m.NotRun:
+			}
+		}
+	}
+
+	return cached, nil
+}
+
+// withoutCached splits off the mutations with a cached verdict, returning
+// the ones left to test and a report marked as cached for each other one.
+// A verdict is reused only while the mutated source, its tests and the
+// mutator version match those it was reached on, and a mutant that did not
+// compile only while countCompileErrors is as it was; mutation IDs hash the
+// source, so an edited file never matches.
+func withoutCached(mutations []m.Mutation, cached map[string]cachedResult, countCompileErrors bool) ([]m.Mutation, []m.Mutation, []m.Report) {
+	if len(cached) == 0 {
+		return mutations, nil, nil
+	}
+
+	kept := make([]m.Mutation, 0, len(mutations))
+
+	var (
+		reused  []m.Mutation
+		reports []m.Report
+	)
+
+	for _, mutation := range mutations {
+		entry, ok := cached[mutation.ID]
+		if !ok || !sameTestedSource(entry.source, mutation.Source) || entry.result.Type != mutation.Type ||
+			!sameCompileErrorMode(entry.result, countCompileErrors) {
+			kept = append(kept, mutation)
+			continue
+		}
+
+		result := entry.result
+		result.Line = mutation.Position.Line
+		result.Cached = true
+
+		report := m.Report{Source: mutation.Source, Result: m.Result{result}}
+		if result.Status == m.Survived {
+			diff := mutation.DiffCode
+			report.Diff = &diff
+		}
+
+		reused = append(reused, mutation)
+		reports = append(reports, report)
+	}
+
+	return kept, reused, reports
+}
+
+// sameTestedSource reports whether a verdict reached on stored still holds
+// for current: the same source content tested by the same tests.
+func sameTestedSource(stored, current m.Source) bool {
+	return fileHash(stored.Origin) == fileHash(current.Origin) &&
+		fileHash(stored.Test) == fileHash(current.Test) &&
+		stored.TestsHash == current.TestsHash
+}
+
+// sameCompileErrorMode reports whether a cached verdict on a mutant that
+// did not compile was recorded the way the run asks for: as CompileError,
+// or as a kill when countCompileErrors is set.
+func sameCompileErrorMode(result m.MutationResult, countCompileErrors bool) bool {
+	switch {
+	case result.Status == m.CompileError:
+		return !countCompileErrors
+	case result.KillReason == m.KillBuild:
+		return countCompileErrors
+	default:
+		return true
+	}
+}
+
+func fileHash(file *m.File) string {
+	if file == nil {
+		return ""
+	}
+
+	return file.Hash
+}
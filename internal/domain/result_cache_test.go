@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithoutCached(t *testing.T) {
+	source := m.Source{
+		Origin: &m.File{FullPath: "/project/calc.go", Hash: "calc"},
+		Test:   &m.File{FullPath: "/project/calc_test.go", Hash: "calc_test"},
+	}
+	editedTests := source
+	editedTests.Test = &m.File{FullPath: "/project/calc_test.go", Hash: "calc_test-2"}
+
+	cached := map[string]cachedResult{
+		"killed":   {source: source, result: m.MutationResult{MutationID: "killed", Type: m.MutationArithmetic, Status: m.Killed, KillReason: m.KillAssertion}},
+		"survived": {source: source, result: m.MutationResult{MutationID: "survived", Type: m.MutationBoolean, Status: m.Survived}},
+		"upgraded": {source: source, result: m.MutationResult{MutationID: "upgraded", Type: m.MutationType{Name: m.MutationLoop.Name, Version: m.MutationLoop.Version - 1}, Status: m.Killed}},
+		"retested": {source: editedTests, result: m.MutationResult{MutationID: "retested", Type: m.MutationArithmetic, Status: m.Killed}},
+	}
+	mutations := []m.Mutation{
+		{ID: "killed", Type: m.MutationArithmetic, Source: source, Position: m.Position{Line: 3}},
+		{ID: "survived", Type: m.MutationBoolean, Source: source, Position: m.Position{Line: 5}, DiffCode: []byte("diff")},
+		{ID: "upgraded", Type: m.MutationLoop, Source: source},
+		{ID: "retested", Type: m.MutationArithmetic, Source: source},
+		{ID: "new", Type: m.MutationArithmetic, Source: source},
+	}
+
+	kept, reused, reports := withoutCached(mutations, cached, false)
+
+	assert.Equal(t, []string{"upgraded", "retested", "new"}, mutationIDs(kept))
+	assert.Equal(t, []string{"killed", "survived"}, mutationIDs(reused))
+
+	require.Len(t, reports, 2)
+	assert.Equal(t, m.MutationResult{
+		MutationID: "killed",
+		Type:       m.MutationArithmetic,
+		Status:     m.Killed,
+		Line:       3,
+		KillReason: m.KillAssertion,
+		Cached:     true,
+	}, reports[0].Result[0])
+	assert.Nil(t, reports[0].Diff)
+
+	assert.True(t, reports[1].Result[0].Cached)
+	require.NotNil(t, reports[1].Diff)
+	assert.Equal(t, []byte("diff"), *reports[1].Diff)
+}
+
+func TestWithoutCached_RetestsCompileErrorsRecordedTheOtherWay(t *testing.T) {
+	source := m.Source{Origin: &m.File{FullPath: "/project/calc.go", Hash: "calc"}}
+
+	cached := map[string]cachedResult{
+		"uncompiled": {source: source, result: m.MutationResult{MutationID: "uncompiled", Type: m.MutationBlank, Status: m.CompileError}},
+		"build-kill": {source: source, result: m.MutationResult{MutationID: "build-kill", Type: m.MutationBlank, Status: m.Killed, KillReason: m.KillBuild}},
+	}
+	mutations := []m.Mutation{
+		{ID: "uncompiled", Type: m.MutationBlank, Source: source},
+		{ID: "build-kill", Type: m.MutationBlank, Source: source},
+	}
+
+	kept, reused, _ := withoutCached(mutations, cached, false)
+	assert.Equal(t, []string{"build-kill"}, mutationIDs(kept))
+	assert.Equal(t, []string{"uncompiled"}, mutationIDs(reused))
+
+	kept, reused, _ = withoutCached(mutations, cached, true)
+	assert.Equal(t, []string{"uncompiled"}, mutationIDs(kept))
+	assert.Equal(t, []string{"build-kill"}, mutationIDs(reused))
+}
+
+func TestCachedResults_KeepsOnlyVerdicts(t *testing.T) {
+	dir := m.Path(filepath.Join(t.TempDir(), "reports"))
+	store := adapter.NewReportStore()
+	source := m.Source{Origin: &m.File{FullPath: "/project/calc.go", Hash: "calc"}}
+
+	var reports []m.Report
+	for id, status := range map[string]m.TestStatus{
+		"killed": m.Killed, "survived": m.Survived, "flaky": m.Flaky, "compile-error": m.CompileError,
+		"error": m.Error, "skipped": m.Skipped, "not-run": m.NotRun,
+	} {
+		reports = append(reports, m.Report{Source: source, Result: m.Result{{MutationID: id, Type: m.MutationArithmetic, Status: status}}})
+	}
+
+	require.NoError(t, store.SaveReports(dir, reports))
+
+	wf := &workflow{ReportStore: store}
+
+	cached, err := wf.cachedResults(dir)
+	require.NoError(t, err)
+	assert.Len(t, cached, 4)
+
+	for _, id := range []string{"killed", "survived", "flaky", "compile-error"} {
+		assert.Contains(t, cached, id)
+	}
+
+	missing, err := wf.cachedResults(m.Path(filepath.Join(t.TempDir(), "missing")))
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+}
+
+func mutationIDs(mutations []m.Mutation) []string {
+	ids := make([]string, 0, len(mutations))
+	for _, mutation := range mutations {
+		ids = append(ids, mutation.ID)
+	}
+
+	return ids
+}
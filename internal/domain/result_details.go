@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/mouse-blink/gooze/internal/controller"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// resultDetailsCacheSize bounds the details a resultDetails holds. It covers
+// a full stream batch, so the details of results not saved yet are still at
+// hand.
+const resultDetailsCacheSize = 2 * streamBatchSize
+
+// reportLoader is the part of the report store resultDetails reads from.
+type reportLoader interface {
+	LoadReports(path m.Path) ([]m.Report, error)
+}
+
+// resultDetails loads the diffs and test output the UI shows on selection
+// from the reports saved in dir, keeping only the most recent ones in
+// memory: the results recorded as they complete, whose reports may still
+// be waiting to be streamed, and those loaded since.
+type resultDetails struct {
+	store reportLoader
+	dir   m.Path
+
+	mu     sync.Mutex
+	cached map[string]controller.ResultDetails
+	order  []string
+}
+
+func newResultDetails(store reportLoader, dir m.Path) *resultDetails {
+	return &resultDetails{store: store, dir: dir, cached: make(map[string]controller.ResultDetails)}
+}
+
+// record keeps the diff of a survived result and the test output of a
+// broken one until newer details push them out; other results are
+// ignored, as are all results on a nil resultDetails, when no UI loads
+// details.
+func (d *resultDetails) record(mutation m.Mutation, result m.MutationResult) {
+	if d == nil {
+		return
+	}
+
+	details := controller.ResultDetails{Output: result.TestOutput}
+	if result.Status == m.Survived {
+		details.Diff = mutation.DiffCode
+	}
+
+	if len(details.Diff) == 0 && details.Output == "" {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.keep(mutation.ID, details)
+}
+
+// load is the controller.DetailsLoader reading details from the saved
+// reports when they are no longer held.
+func (d *resultDetails) load(mutationID string) (controller.ResultDetails, error) {
+	d.mu.Lock()
+	details, ok := d.cached[mutationID]
+	d.mu.Unlock()
+
+	if ok {
+		return details, nil
+	}
+
+	reports, err := d.store.LoadReports(d.dir)
+	if err != nil {
+		return controller.ResultDetails{}, fmt.Errorf("load reports: %w", err)
+	}
+
+	report, result, err := findReport(reports, mutationID)
+	if err != nil {
+		return controller.ResultDetails{}, err
+	}
+
+	details = controller.ResultDetails{Output: result.TestOutput}
+	if result.Status == m.Survived && report.Diff != nil {
+		details.Diff = *report.Diff
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.keep(mutationID, details)
+
+	return details, nil
+}
+
+// keep caches details, dropping the oldest once resultDetailsCacheSize are
+// held. d.mu must be held.
+func (d *resultDetails) keep(mutationID string, details controller.ResultDetails) {
+	if _, ok := d.cached[mutationID]; !ok {
+		d.order = append(d.order, mutationID)
+	}
+
+	d.cached[mutationID] = details
+
+	if len(d.order) > resultDetailsCacheSize {
+		delete(d.cached, d.order[0])
+		d.order = d.order[1:]
+	}
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reportsFunc is a reportLoader returning the reports of a function.
+type reportsFunc func(path m.Path) ([]m.Report, error)
+
+func (f reportsFunc) LoadReports(path m.Path) ([]m.Report, error) {
+	return f(path)
+}
+
+var errNoReports = errors.New("no reports")
+
+func noReports(m.Path) ([]m.Report, error) {
+	return nil, errNoReports
+}
+
+func TestResultDetails_RecordsSurvivorsAndBrokenMutants(t *testing.T) {
+	details := newResultDetails(reportsFunc(noReports), "reports")
+
+	details.record(m.Mutation{ID: "survived", DiffCode: []byte("diff")}, m.MutationResult{Status: m.Survived})
+	details.record(m.Mutation{ID: "errored", DiffCode: []byte("diff")}, m.MutationResult{Status: m.Error, TestOutput: "panic"})
+	details.record(m.Mutation{ID: "killed", DiffCode: []byte("diff")}, m.MutationResult{Status: m.Killed})
+
+	loaded, err := details.load("survived")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("diff"), loaded.Diff)
+
+	loaded, err = details.load("errored")
+	require.NoError(t, err)
+	assert.Empty(t, loaded.Diff)
+	assert.Equal(t, "panic", loaded.Output)
+
+	_, err = details.load("killed")
+	assert.ErrorIs(t, err, errNoReports)
+}
+
+func TestResultDetails_LoadsDroppedDetailsFromReports(t *testing.T) {
+	diff := []byte("stored diff")
+	loads := 0
+
+	details := newResultDetails(reportsFunc(func(path m.Path) ([]m.Report, error) {
+		loads++
+
+		assert.Equal(t, m.Path("reports"), path)
+
+		return []m.Report{{
+			Result: m.Result{{MutationID: "m0", Status: m.Survived}},
+			Diff:   &diff,
+		}}, nil
+	}), "reports")
+
+	for i := range resultDetailsCacheSize + 1 {
+		id := fmt.Sprintf("m%d", i)
+		details.record(m.Mutation{ID: id, DiffCode: []byte(id)}, m.MutationResult{Status: m.Survived})
+	}
+
+	assert.Len(t, details.cached, resultDetailsCacheSize)
+
+	loaded, err := details.load("m0")
+	require.NoError(t, err)
+	assert.Equal(t, diff, loaded.Diff)
+
+	_, err = details.load("m0")
+	require.NoError(t, err)
+	assert.Equal(t, 1, loads, "details loaded once are cached")
+	assert.Len(t, details.cached, resultDetailsCacheSize)
+}
+
+func TestResultDetails_NilIgnoresRecords(t *testing.T) {
+	var details *resultDetails
+
+	assert.NotPanics(t, func() {
+		details.record(m.Mutation{ID: "survived", DiffCode: []byte("diff")}, m.MutationResult{Status: m.Survived})
+	})
+}
+
+func TestResultDetails_LoadsDuringRecording(t *testing.T) {
+	details := newResultDetails(reportsFunc(noReports), "reports")
+
+	var wg sync.WaitGroup
+
+	for i := range 50 {
+		id := fmt.Sprintf("m%d", i)
+
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			details.record(m.Mutation{ID: id, DiffCode: []byte(id)}, m.MutationResult{Status: m.Survived})
+		}()
+
+		go func() {
+			defer wg.Done()
+			_, _ = details.load(id)
+		}()
+	}
+
+	wg.Wait()
+
+	loaded, err := details.load("m49")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("m49"), loaded.Diff)
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultRunTemplates derive test names from the conventional TestName,
+// Test_Name, TestType_Method and TestTypeMethod forms, plus any suffix.
+var DefaultRunTemplates = []string{"Test{type}_?{name}"}
+
+// withRunPatterns narrows each mutation's test run to the tests whose names
+// the templates derive from its enclosing function. Mutations outside any
+// function keep running every test.
+func withRunPatterns(mutations []m.Mutation, templates []string) []m.Mutation {
+	if len(templates) == 0 {
+		return mutations
+	}
+
+	narrowed := make([]m.Mutation, len(mutations))
+
+	for i, mutation := range mutations {
+		if mutation.Func != "" {
+			mutation.RunPattern = runPattern(templates, mutation.Func)
+		}
+
+		narrowed[i] = mutation
+	}
+
+	return narrowed
+}
+
+// runPattern expands the templates for fn, given as Name or Type.Name, into
+// a go test -run expression matching test names that start with any of them.
+// The first letter of each name matches in either case, so the unexported
+// parse matches TestParse as well as Test_parse.
+func runPattern(templates []string, fn string) string {
+	typeName, name := "", fn
+	if dot := strings.LastIndex(fn, "."); dot >= 0 {
+		typeName, name = fn[:dot], fn[dot+1:]
+	}
+
+	replacer := strings.NewReplacer(
+		"{name}", anyCaseInitial(name),
+		"{type}", anyCaseInitial(typeName),
+	)
+
+	alternatives := make([]string, len(templates))
+	for i, template := range templates {
+		alternatives[i] = replacer.Replace(template)
+	}
+
+	return "^(" + strings.Join(alternatives, "|") + ")"
+}
+
+func anyCaseInitial(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	initial, size := utf8.DecodeRuneInString(name)
+	lower, upper := unicode.ToLower(initial), unicode.ToUpper(initial)
+
+	if lower == upper {
+		return regexp.QuoteMeta(name)
+	}
+
+	return "[" + string(upper) + string(lower) + "]" + regexp.QuoteMeta(name[size:])
+}
+
+// validateRunTemplates rejects templates that name no function part or do
+// not expand to a valid regular expression.
+func validateRunTemplates(templates []string) error {
+	for _, template := range templates {
+		if !strings.Contains(template, "{name}") {
+			return fmt.Errorf("test name template %q must contain {name}", template)
+		}
+
+		if _, err := regexp.Compile(runPattern([]string{template}, "Type.Name")); err != nil {
+			return fmt.Errorf("test name template %q: %w", template, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"regexp"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPattern_DefaultTemplates(t *testing.T) {
+	tests := []struct {
+		fn        string
+		matches   []string
+		unmatched []string
+	}{
+		{fn: "Add", matches: []string{"TestAdd", "Test_Add", "TestAddNegative"}, unmatched: []string{"TestSubtract", "ExampleAdd"}},
+		{fn: "parse", matches: []string{"TestParse", "Test_parse"}, unmatched: []string{"TestFormat"}},
+		{fn: "Calc.Add", matches: []string{"TestCalcAdd", "TestCalc_Add", "TestCalc_AddOverflow"}, unmatched: []string{"TestAdd", "TestCalc_Sub"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fn, func(t *testing.T) {
+			re := regexp.MustCompile(runPattern(DefaultRunTemplates, tt.fn))
+
+			for _, name := range tt.matches {
+				assert.True(t, re.MatchString(name), "%s should match %s", re, name)
+			}
+
+			for _, name := range tt.unmatched {
+				assert.False(t, re.MatchString(name), "%s should not match %s", re, name)
+			}
+		})
+	}
+}
+
+func TestRunPattern_QuotesNames(t *testing.T) {
+	assert.Equal(t, "^(Test[Aa]dd|Fuzz[Aa]dd)", runPattern([]string{"Test{name}", "Fuzz{name}"}, "Add"))
+	assert.Equal(t, "^(Test_[Ππ]ι)", runPattern([]string{"Test_{name}"}, "πι"))
+}
+
+func TestWithRunPatterns(t *testing.T) {
+	mutations := []m.Mutation{{ID: "f", Func: "Add"}, {ID: "pkg"}}
+
+	narrowed := withRunPatterns(mutations, []string{"Test{name}"})
+
+	assert.Equal(t, "^(Test[Aa]dd)", narrowed[0].RunPattern)
+	assert.Empty(t, narrowed[1].RunPattern, "package-level mutations run every test")
+	assert.Empty(t, mutations[0].RunPattern, "input mutations must not be modified")
+	assert.Equal(t, mutations, withRunPatterns(mutations, nil))
+}
+
+func TestValidateRunTemplates(t *testing.T) {
+	require.NoError(t, validateRunTemplates(DefaultRunTemplates))
+	require.ErrorContains(t, validateRunTemplates([]string{"TestAll"}), "must contain {name}")
+	require.ErrorContains(t, validateRunTemplates([]string{"Test({name}"}), `"Test({name}"`)
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// RuntimeArgs asks an estimate to time one baseline go test per package
+// and project the run's duration from it.
+type RuntimeArgs struct {
+	// Threads is the number of parallel workers to project for; zero picks
+	// one from the CPU count and available memory, as runs do.
+	Threads int
+	// ShardIndex and TotalShardCount select the shard, as for runs, whose
+	// duration is projected besides the whole run's.
+	ShardIndex      int
+	TotalShardCount int
+}
+
+// estimateRuntime times the baseline tests of the mutations' packages and
+// projects how long testing them takes, assuming each mutation takes as
+// long as its package's tests and the workers stay busy.
+func (w *workflow) estimateRuntime(mutations []m.Mutation, args RuntimeArgs) (m.RuntimeEstimate, error) {
+	times, packages, err := w.BaselineTimes(mutations)
+	if err != nil {
+		return m.RuntimeEstimate{}, err
+	}
+
+	shardCount := max(args.TotalShardCount, 1)
+
+	inShard := make(map[string]bool)
+	for _, mutation := range w.ShardMutations(mutations, args.ShardIndex, shardCount) {
+		inShard[mutation.ID] = true
+	}
+
+	var total, shard time.Duration
+
+	for _, mutation := range mutations {
+		took := times[mutation.ID]
+		total += took
+
+		if inShard[mutation.ID] {
+			shard += took
+		}
+	}
+
+	threads := resolveThreads(args.Threads)
+
+	return m.RuntimeEstimate{
+		Packages:   packages,
+		Threads:    threads,
+		Total:      total / time.Duration(threads),
+		ShardIndex: args.ShardIndex,
+		ShardCount: shardCount,
+		Shard:      shard / time.Duration(threads),
+	}, nil
+}
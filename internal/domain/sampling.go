@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// validateSample accepts sampling fractions from 0 to 1, where 0 and 1 keep
+// every mutation, and non-negative caps, where 0 means no cap.
+func validateSample(sample float64, maxMutations int) error {
+	if math.IsNaN(sample) || sample < 0 || sample > 1 {
+		return fmt.Errorf("sample must be between 0 and 1, got %v", sample)
+	}
+
+	if maxMutations < 0 {
+		return fmt.Errorf("max mutations must not be negative, got %d", maxMutations)
+	}
+
+	return nil
+}
+
+// withSample keeps the mutations whose sample key falls below sample, then,
+// if more than maxMutations remain, the maxMutations with the lowest keys.
+// Keys hash the mutation ID with seed, so the same mutations are picked on
+// every run until their code changes. Kept mutations stay in input order.
+func withSample(mutations []m.Mutation, sample float64, maxMutations int, seed string) []m.Mutation {
+	sampling := sample > 0 && sample < 1
+	capped := maxMutations > 0 && len(mutations) > maxMutations
+
+	if !sampling && !capped {
+		return mutations
+	}
+
+	type keyed struct {
+		index int
+		key   uint64
+	}
+
+	candidates := make([]keyed, 0, len(mutations))
+
+	for i, mutation := range mutations {
+		key := sampleKey(seed, mutation.ID)
+		if sampling && float64(key) >= sample*math.MaxUint64 {
+			continue
+		}
+
+		candidates = append(candidates, keyed{index: i, key: key})
+	}
+
+	if maxMutations > 0 && len(candidates) > maxMutations {
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].key < candidates[j].key
+		})
+
+		candidates = candidates[:maxMutations]
+
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].index < candidates[j].index
+		})
+	}
+
+	kept := make([]m.Mutation, 0, len(candidates))
+	for _, candidate := range candidates {
+		kept = append(kept, mutations[candidate.index])
+	}
+
+	return kept
+}
+
+func sampleKey(seed string, mutationID string) uint64 {
+	sum := sha256.Sum256([]byte(seed + "\x00" + mutationID))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleMutations(n int) []m.Mutation {
+	mutations := make([]m.Mutation, n)
+	for i := range mutations {
+		mutations[i] = m.Mutation{ID: fmt.Sprintf("%064x", i)}
+	}
+
+	return mutations
+}
+
+func TestWithSample_KeepsAboutTheFraction(t *testing.T) {
+	mutations := sampleMutations(1000)
+
+	sampled := withSample(mutations, 0.2, 0, "")
+
+	assert.InDelta(t, 200, len(sampled), 50)
+	assert.Equal(t, sampled, withSample(mutations, 0.2, 0, ""), "sampling must be reproducible")
+	assert.NotEqual(t, sampled, withSample(mutations, 0.2, 0, "other"), "the seed picks another subset")
+}
+
+func TestWithSample_SubsetOfLargerSample(t *testing.T) {
+	mutations := sampleMutations(500)
+
+	kept := map[string]bool{}
+	for _, mutation := range withSample(mutations, 0.5, 0, "") {
+		kept[mutation.ID] = true
+	}
+
+	for _, mutation := range withSample(mutations, 0.1, 0, "") {
+		assert.True(t, kept[mutation.ID], "a smaller sample must be part of a larger one")
+	}
+}
+
+func TestWithSample_MaxMutations(t *testing.T) {
+	mutations := sampleMutations(100)
+
+	capped := withSample(mutations, 0, 10, "")
+	require.Len(t, capped, 10)
+	assert.Equal(t, capped, withSample(mutations, 0, 10, ""))
+
+	for i := 1; i < len(capped); i++ {
+		assert.Less(t, capped[i-1].ID, capped[i].ID, "kept mutations stay in input order")
+	}
+
+	assert.Len(t, withSample(mutations, 0.5, 5, ""), 5)
+	assert.Len(t, withSample(mutations, 0, 1000, ""), 100)
+}
+
+func TestWithSample_DisabledKeepsAll(t *testing.T) {
+	mutations := sampleMutations(10)
+
+	assert.Equal(t, mutations, withSample(mutations, 0, 0, ""))
+	assert.Equal(t, mutations, withSample(mutations, 1, 0, ""))
+}
+
+func TestValidateSample(t *testing.T) {
+	require.NoError(t, validateSample(0, 0))
+	require.NoError(t, validateSample(0.2, 100))
+	require.NoError(t, validateSample(1, 0))
+	require.Error(t, validateSample(-0.1, 0))
+	require.Error(t, validateSample(1.5, 0))
+	require.Error(t, validateSample(0.5, -1))
+}
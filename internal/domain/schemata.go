@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strconv"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// SchemataEnv names the environment variable selecting, by ID, the mutation
+// a mutant schema runs; the others stay inactive.
+const SchemataEnv = "GOOZE_MUTANT"
+
+// schemataVar and schemataImport are the names a mutant schema declares to
+// read SchemataEnv, unlikely to clash with those of the file.
+const (
+	schemataVar    = "goozeMutant"
+	schemataImport = "goozeos"
+)
+
+// SchemaBuilder compiles the mutations of a file into one mutant schema, so
+// that the file's mutants share a build.
+type SchemaBuilder interface {
+	// Schemata returns mutations with Schema set on those compiled into the
+	// schema of their file. The others keep being tested from their own
+	// mutated code.
+	Schemata(mutations []m.Mutation) []m.Mutation
+}
+
+// schemaBuilder implements SchemaBuilder. A mutation is compiled into its
+// file's schema when it changes the body of a single function without
+// labels and type-checks on its own, and only schemata that type-check
+// whole are used: a mutant then behaves in the schema as in its own file.
+type schemaBuilder struct {
+	*typeChecker
+}
+
+// NewSchemaBuilder returns the SchemaBuilder type-checking mutants and
+// their schemata against their package.
+func NewSchemaBuilder(goFileAdapter adapter.GoFileAdapter, sourceFSAdapter adapter.SourceFSAdapter) SchemaBuilder {
+	return &schemaBuilder{typeChecker: &typeChecker{
+		GoFileAdapter:   goFileAdapter,
+		SourceFSAdapter: sourceFSAdapter,
+	}}
+}
+
+// schemaBody is a function body of the original file, by the offsets of its
+// braces, and the mutations of it compiled into the schema.
+type schemaBody struct {
+	lbrace, rbrace int
+	mutations      []schemaCase
+}
+
+// schemaCase is a mutation with its mutated body, without the braces.
+type schemaCase struct {
+	id   string
+	body []byte
+}
+
+func (sb *schemaBuilder) Schemata(mutations []m.Mutation) []m.Mutation {
+	bySource := make(map[m.Path][]int)
+
+	var paths []m.Path
+
+	for i, mutation := range mutations {
+		if mutation.Source.Origin == nil || mutation.Suppressed || !hasTests(mutation) {
+			continue
+		}
+
+		path := mutation.Source.Origin.FullPath
+		if _, ok := bySource[path]; !ok {
+			paths = append(paths, path)
+		}
+
+		bySource[path] = append(bySource[path], i)
+	}
+
+	out := slices.Clone(mutations)
+
+	for _, path := range paths {
+		indexes := bySource[path]
+		if len(indexes) < 2 {
+			continue
+		}
+
+		schema, ids := sb.buildSchema(out[indexes[0]].Source, indexes, out)
+		if schema == nil {
+			continue
+		}
+
+		for _, i := range indexes {
+			if ids[out[i].ID] {
+				out[i].Schema = schema
+			}
+		}
+	}
+
+	return out
+}
+
+// buildSchema returns the schema of source compiling in the mutations at
+// indexes it can take, and their IDs, or nil when fewer than two can be
+// compiled in or the schema does not type-check.
+func (sb *schemaBuilder) buildSchema(source m.Source, indexes []int, mutations []m.Mutation) ([]byte, map[string]bool) {
+	pkg := sb.loadPackage(source)
+	if !pkg.clean {
+		return nil, nil
+	}
+
+	original, err := sb.ReadFile(source.Origin.FullPath)
+	if err != nil {
+		return nil, nil
+	}
+
+	file, err := sb.Parse(pkg.fset, string(source.Origin.FullPath), original)
+	if err != nil {
+		return nil, nil
+	}
+
+	bodies := schemaBodies(pkg.fset, file)
+	ids := make(map[string]bool)
+
+	for _, i := range indexes {
+		mutation := mutations[i]
+
+		body, mutated, ok := mutatedBody(bodies, original, mutation.MutatedCode)
+		if !ok || !sb.compiles(pkg, source, mutation.MutatedCode) {
+			continue
+		}
+
+		body.mutations = append(body.mutations, schemaCase{id: mutation.ID, body: mutated})
+		ids[mutation.ID] = true
+	}
+
+	if len(ids) < 2 {
+		return nil, nil
+	}
+
+	schema := renderSchema(original, file.Name.End(), pkg.fset, bodies)
+	if !sb.compiles(pkg, source, schema) {
+		return nil, nil
+	}
+
+	return schema, ids
+}
+
+// compiles reports whether code type-checks as source's file in pkg.
+func (sb *schemaBuilder) compiles(pkg *checkedPackage, source m.Source, code []byte) bool {
+	file, err := sb.Parse(pkg.fset, string(source.Origin.FullPath), code)
+	if err != nil {
+		return false
+	}
+
+	_, err = sb.Check(pkg.fset, append([]*ast.File{file}, pkg.siblings...))
+
+	return err == nil
+}
+
+// schemaBodies returns the bodies of file's functions and methods, in
+// order, leaving out those with labels: labels are scoped to the function,
+// so its mutated copies could not declare them again.
+func schemaBodies(fset *token.FileSet, file *ast.File) []*schemaBody {
+	var bodies []*schemaBody
+
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Body == nil || hasLabels(fd.Body) {
+			continue
+		}
+
+		bodies = append(bodies, &schemaBody{
+			lbrace: fset.Position(fd.Body.Lbrace).Offset,
+			rbrace: fset.Position(fd.Body.Rbrace).Offset,
+		})
+	}
+
+	return bodies
+}
+
+func hasLabels(body *ast.BlockStmt) bool {
+	found := false
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.LabeledStmt); ok {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// mutatedBody finds the body of bodies holding every byte mutated differs
+// from original in, and returns it with its mutated contents.
+func mutatedBody(bodies []*schemaBody, original, mutated []byte) (*schemaBody, []byte, bool) {
+	prefix := commonPrefix(original, mutated)
+	suffix := commonPrefix(reversed(original[prefix:]), reversed(mutated[prefix:]))
+	end := len(original) - suffix
+	delta := len(mutated) - len(original)
+
+	for _, body := range bodies {
+		if prefix > body.lbrace && end <= body.rbrace {
+			return body, mutated[body.lbrace+1 : body.rbrace+delta], true
+		}
+	}
+
+	return nil, nil, false
+}
+
+func commonPrefix(a, b []byte) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+
+	return n
+}
+
+func reversed(b []byte) []byte {
+	r := slices.Clone(b)
+	slices.Reverse(r)
+
+	return r
+}
+
+// renderSchema rewrites each body of bodies with mutations as a switch on
+// the selected mutation, running the original body by default, and
+// declares the variable holding the selection. Function bodies end in a
+// terminating statement when the function returns results, so the switch
+// does too.
+func renderSchema(original []byte, packageEnd token.Pos, fset *token.FileSet, bodies []*schemaBody) []byte {
+	var out bytes.Buffer
+
+	afterPackage := fset.Position(packageEnd).Offset
+	out.Write(original[:afterPackage])
+	out.WriteString("\n\nimport " + schemataImport + " \"os\"\n")
+
+	last := afterPackage
+
+	for _, body := range bodies {
+		if len(body.mutations) == 0 {
+			continue
+		}
+
+		out.Write(original[last : body.lbrace+1])
+		out.WriteString("\n\tswitch " + schemataVar + " {\n")
+
+		for _, c := range body.mutations {
+			out.WriteString("\tcase " + strconv.Quote(c.id) + ":\n")
+			out.Write(c.body)
+			out.WriteString("\n")
+		}
+
+		out.WriteString("\tdefault:\n")
+		out.Write(original[body.lbrace+1 : body.rbrace])
+		out.WriteString("\n\t}\n")
+
+		last = body.rbrace
+	}
+
+	out.Write(original[last:])
+	out.WriteString("\nvar " + schemataVar + " = " + schemataImport + ".Getenv(" + strconv.Quote(SchemataEnv) + ")\n")
+
+	return out.Bytes()
+}
+
+// withSchemata compiles the mutations of each file into a schema with the
+// workflow's schema builder.
+func (w *workflow) withSchemata(mutations []m.Mutation, enabled bool) []m.Mutation {
+	if !enabled || w.schemata == nil || len(mutations) == 0 {
+		return mutations
+	}
+
+	return w.schemata.Schemata(mutations)
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const schemataSource = `package calc
+
+func Add(a, b int) int {
+	return a + b
+}
+
+func Sign(n int) int {
+	if n < 0 {
+		return -1
+	}
+
+	return 1
+}
+
+func Index(xs []int, x int) int {
+	found := -1
+loop:
+	for i, v := range xs {
+		if v == x {
+			found = i
+			break loop
+		}
+	}
+
+	return found
+}
+`
+
+const schemataTests = `package calc
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if Add(2, 3) != 5 {
+		t.Fatal("Add(2, 3) != 5")
+	}
+}
+
+func TestSign(t *testing.T) {
+	if Sign(0) != 1 {
+		t.Fatal("Sign(0) != 1")
+	}
+}
+`
+
+func schemataMutations(path, testPath string) []m.Mutation {
+	source := m.Source{
+		Origin: &m.File{FullPath: m.Path(path)},
+		Test:   &m.File{FullPath: m.Path(testPath)},
+	}
+
+	mutation := func(id, old, replacement string) m.Mutation {
+		return m.Mutation{ID: id, Source: source, MutatedCode: []byte(strings.Replace(schemataSource, old, replacement, 1))}
+	}
+
+	return []m.Mutation{
+		mutation("add", "a + b", "a - b"),
+		mutation("sign", "n < 0", "n <= 0"),
+		mutation("labeled", "v == x", "v != x"),
+		mutation("mistyped", "a + b", `a + "b"`),
+	}
+}
+
+func TestSchemaBuilder_Schemata(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "calc.go")
+	testPath := filepath.Join(dir, "calc_test.go")
+	writeFile(t, filepath.Join(dir, "go.mod"), "module example.com/calc\n\ngo 1.21\n")
+	writeFile(t, path, schemataSource)
+	writeFile(t, testPath, schemataTests)
+
+	builder := NewSchemaBuilder(adapter.NewLocalGoFileAdapter(), adapter.NewLocalSourceFSAdapter())
+	mutations := builder.Schemata(schemataMutations(path, testPath))
+
+	require.NotNil(t, mutations[0].Schema)
+	assert.Equal(t, mutations[0].Schema, mutations[1].Schema, "the mutations of a file share its schema")
+	assert.Nil(t, mutations[2].Schema, "functions with labels are left out")
+	assert.Nil(t, mutations[3].Schema, "mutants that do not compile are left out")
+
+	writeFile(t, path, string(mutations[0].Schema))
+
+	runner := adapter.NewLocalTestRunnerAdapter()
+
+	for _, tc := range []struct {
+		mutant string
+		fails  bool
+	}{
+		{mutant: "", fails: false},
+		{mutant: "add", fails: true},
+		{mutant: "sign", fails: true},
+	} {
+		out, err := runner.RunGoTest(dir, ".", adapter.GoTestOptions{Args: []string{"-count=1"}, Env: []string{SchemataEnv + "=" + tc.mutant}})
+		assert.Equal(t, tc.fails, err != nil, "mutant %q: %s", tc.mutant, out)
+	}
+}
+
+func TestSchemaBuilder_Schemata_NeedsTwoMutations(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "calc.go")
+	writeFile(t, path, schemataSource)
+
+	builder := NewSchemaBuilder(adapter.NewLocalGoFileAdapter(), adapter.NewLocalSourceFSAdapter())
+	mutations := builder.Schemata(schemataMutations(path, filepath.Join(dir, "calc_test.go"))[:1])
+
+	assert.Nil(t, mutations[0].Schema)
+}
+
+func TestMutatedBody(t *testing.T) {
+	original := []byte("package p\n\nfunc F() int {\n\treturn 1\n}\n\nvar x = 1\n")
+	bodies := []*schemaBody{{lbrace: strings.Index(string(original), "{"), rbrace: strings.Index(string(original), "}")}}
+
+	body, mutated, ok := mutatedBody(bodies, original, []byte("package p\n\nfunc F() int {\n\treturn 10\n}\n\nvar x = 1\n"))
+	require.True(t, ok)
+	assert.Same(t, bodies[0], body)
+	assert.Equal(t, "\n\treturn 10\n", string(mutated))
+
+	_, _, ok = mutatedBody(bodies, original, []byte("package p\n\nfunc F() int {\n\treturn 1\n}\n\nvar x = 2\n"))
+	assert.False(t, ok, "changes outside function bodies cannot be compiled in")
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// shardIndexes returns the shards of TotalShardCount the run tests:
+// ShardIndexes when set, ShardIndex otherwise.
+func (args TestArgs) shardIndexes() []int {
+	if len(args.ShardIndexes) > 0 {
+		return args.ShardIndexes
+	}
+
+	return []int{args.ShardIndex}
+}
+
+// validateShardIndexes rejects lists of shards that name a shard twice or
+// one outside TotalShardCount, which would not split like separate runs.
+func validateShardIndexes(args TestArgs) error {
+	if len(args.ShardIndexes) == 0 {
+		return nil
+	}
+
+	seen := make(map[int]bool, len(args.ShardIndexes))
+
+	for _, shard := range args.ShardIndexes {
+		if shard < 0 || shard >= args.TotalShardCount {
+			return fmt.Errorf("shard %d out of range for %d shards", shard, args.TotalShardCount)
+		}
+
+		if seen[shard] {
+			return fmt.Errorf("shard %d listed twice", shard)
+		}
+
+		seen[shard] = true
+	}
+
+	return nil
+}
+
+// shardSplit records the shard each of a run's mutations belongs to, by
+// mutation ID.
+type shardSplit map[string]int
+
+// mutations returns the mutations of shard, in their order.
+func (s shardSplit) mutations(mutations []m.Mutation, shard int) []m.Mutation {
+	var kept []m.Mutation
+
+	for _, mutation := range mutations {
+		if s[mutation.ID] == shard {
+			kept = append(kept, mutation)
+		}
+	}
+
+	return kept
+}
+
+// reports returns the results of reports that belong to shard, as the
+// reports a run of that shard alone would have made.
+func (s shardSplit) reports(reports []m.Report, shard int) []m.Report {
+	var kept []m.Report
+
+	for _, report := range reports {
+		var results m.Result
+
+		for _, result := range report.Result {
+			if s[result.MutationID] == shard {
+				results = append(results, result)
+			}
+		}
+
+		if len(results) == 0 {
+			continue
+		}
+
+		report.Result = results
+		kept = append(kept, report)
+	}
+
+	return kept
+}
+
+// shardStreams streams the reports of each shard of a run to the shard's
+// own reports directory.
+type shardStreams struct {
+	split   shardSplit
+	byShard map[int]*reportStream
+}
+
+// startShardStreams starts a reportStream for each shard of args, saving
+// to its directory the reports of the mutations split assigns it.
+func startShardStreams(store reportSaver, args TestArgs, split shardSplit, mutations []m.Mutation) (*shardStreams, error) {
+	streams := &shardStreams{split: split, byShard: make(map[int]*reportStream)}
+
+	for _, shard := range args.shardIndexes() {
+		dir := shardReportsDir(args.Reports, shard, args.TotalShardCount)
+
+		stream, err := startReportStream(store, dir, split.mutations(mutations, shard), args.RedactCode)
+		if err != nil {
+			return nil, err
+		}
+
+		streams.byShard[shard] = stream
+	}
+
+	return streams, nil
+}
+
+// add queues report on the stream of its shard. It does nothing on a nil
+// value, when the run's reports are not saved.
+func (s *shardStreams) add(report m.Report) {
+	if s == nil || len(report.Result) == 0 {
+		return
+	}
+
+	s.byShard[s.split[report.Result[0].MutationID]].add(report)
+}
+
+// unsaved returns the reports not streamed yet by any shard.
+func (s *shardStreams) unsaved(reports []m.Report) []m.Report {
+	if s == nil {
+		return reports
+	}
+
+	for _, stream := range s.byShard {
+		reports = stream.unsaved(reports)
+	}
+
+	return reports
+}
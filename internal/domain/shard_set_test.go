@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateShardIndexes(t *testing.T) {
+	require.NoError(t, validateShardIndexes(TestArgs{TotalShardCount: 3}))
+	require.NoError(t, validateShardIndexes(TestArgs{ShardIndexes: []int{0, 2}, TotalShardCount: 3}))
+	require.ErrorContains(t, validateShardIndexes(TestArgs{ShardIndexes: []int{0, 3}, TotalShardCount: 3}), "shard 3 out of range for 3 shards")
+	require.ErrorContains(t, validateShardIndexes(TestArgs{ShardIndexes: []int{1, 1}, TotalShardCount: 3}), "shard 1 listed twice")
+}
+
+func TestShardSplit_Reports(t *testing.T) {
+	source := m.Source{Origin: &m.File{FullPath: "a.go"}}
+	split := shardSplit{"a": 0, "b": 2, "c": 2}
+
+	reports := []m.Report{
+		{Source: source, Result: m.Result{{MutationID: "a"}}},
+		{Source: source, Result: m.Result{{MutationID: "b"}, {MutationID: "a"}, {MutationID: "c"}}},
+	}
+
+	assert.Equal(t, []m.Report{
+		{Source: source, Result: m.Result{{MutationID: "b"}, {MutationID: "c"}}},
+	}, split.reports(reports, 2))
+	assert.Len(t, split.reports(reports, 0), 2)
+	assert.Empty(t, split.reports(reports, 1))
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// ShardStrategy selects how mutations are split across shards.
+type ShardStrategy string
+
+const (
+	// ShardStrategyHash assigns each mutation by a hash of its ID, which
+	// needs nothing but the mutation itself.
+	ShardStrategyHash ShardStrategy = "hash"
+	// ShardStrategyBalanced bin-packs the mutations by the durations
+	// recorded in the reports directory, so shards take about as long.
+	ShardStrategyBalanced ShardStrategy = "balanced"
+)
+
+// ShardStrategies lists the accepted shard strategies.
+var ShardStrategies = []ShardStrategy{ShardStrategyHash, ShardStrategyBalanced}
+
+// validateShardStrategy accepts the known strategies and the empty one,
+// which means ShardStrategyHash.
+func validateShardStrategy(strategy ShardStrategy) error {
+	if strategy == "" {
+		return nil
+	}
+
+	for _, known := range ShardStrategies {
+		if strategy == known {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown shard strategy %q (want %s or %s)", strategy, ShardStrategyHash, ShardStrategyBalanced)
+}
+
+// shardMutations keeps the mutations of the shards args select, in their
+// order, and records the shard of each. The balanced strategy reads the
+// durations recorded in args.Reports, so every shard must see the same
+// reports to agree on the split.
+func (w *workflow) shardMutations(mutations []m.Mutation, args TestArgs) ([]m.Mutation, shardSplit, error) {
+	var costs mutationCosts
+
+	balanced := args.ShardStrategy == ShardStrategyBalanced && args.TotalShardCount > 1
+	if balanced {
+		var reports []m.Report
+
+		if args.Reports != "" {
+			var err error
+
+			reports, err = w.loadReportsIfExists(args.Reports)
+			if err != nil {
+				return nil, nil, fmt.Errorf("load reports for balanced sharding: %w", err)
+			}
+		}
+
+		costs = newMutationCosts(reports)
+	}
+
+	split := make(shardSplit)
+
+	for _, shard := range args.shardIndexes() {
+		var selected []m.Mutation
+		if balanced {
+			selected = balancedShard(mutations, costs, shard, args.TotalShardCount)
+		} else {
+			selected = w.ShardMutations(mutations, shard, args.TotalShardCount)
+		}
+
+		for _, mutation := range selected {
+			split[mutation.ID] = shard
+		}
+	}
+
+	var kept []m.Mutation
+
+	for _, mutation := range mutations {
+		if _, ok := split[mutation.ID]; ok {
+			kept = append(kept, mutation)
+		}
+	}
+
+	return kept, split, nil
+}
+
+// mutationCosts estimates how long a mutation takes to test from the
+// durations recorded for earlier runs.
+type mutationCosts struct {
+	byID   map[string]time.Duration
+	byFile map[m.Path]time.Duration
+	mean   time.Duration
+}
+
+func newMutationCosts(reports []m.Report) mutationCosts {
+	costs := mutationCosts{byID: make(map[string]time.Duration), byFile: make(map[m.Path]time.Duration)}
+
+	fileTotals := make(map[m.Path]time.Duration)
+	fileCounts := make(map[m.Path]int)
+
+	var total time.Duration
+
+	for _, report := range reports {
+		file := report.Source.Origin.DisplayPath()
+
+		for _, result := range report.Result {
+			if result.Duration <= 0 {
+				continue
+			}
+
+			costs.byID[result.MutationID] = result.Duration
+			fileTotals[file] += result.Duration
+			fileCounts[file]++
+			total += result.Duration
+		}
+	}
+
+	for file, sum := range fileTotals {
+		costs.byFile[file] = sum / time.Duration(fileCounts[file])
+	}
+
+	if len(costs.byID) > 0 {
+		costs.mean = total / time.Duration(len(costs.byID))
+	}
+
+	return costs
+}
+
+// of returns the recorded duration of mutation, or for one not recorded,
+// such as a mutation of an edited file, the mean of its file or of all
+// recorded mutations. Without any record every mutation costs the same.
+func (c mutationCosts) of(mutation m.Mutation) time.Duration {
+	if cost, ok := c.byID[mutation.ID]; ok {
+		return cost
+	}
+
+	if cost, ok := c.byFile[mutation.Source.Origin.DisplayPath()]; ok {
+		return cost
+	}
+
+	if c.mean > 0 {
+		return c.mean
+	}
+
+	return time.Second
+}
+
+// balancedShard assigns the mutations, most expensive first, to the shard
+// with the least total cost so far and returns those of shardIndex. The
+// order only depends on costs and IDs, so every shard computes the same
+// split.
+func balancedShard(mutations []m.Mutation, costs mutationCosts, shardIndex, totalShardCount int) []m.Mutation {
+	order := make([]int, len(mutations))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := mutations[order[i]], mutations[order[j]]
+		if costA, costB := costs.of(a), costs.of(b); costA != costB {
+			return costA > costB
+		}
+
+		return a.ID < b.ID
+	})
+
+	loads := make([]time.Duration, totalShardCount)
+	assigned := make([]bool, len(mutations))
+
+	for _, i := range order {
+		lightest := 0
+		for shard := range loads {
+			if loads[shard] < loads[lightest] {
+				lightest = shard
+			}
+		}
+
+		loads[lightest] += costs.of(mutations[i])
+		assigned[i] = lightest == shardIndex
+	}
+
+	var shard []m.Mutation
+
+	for i, mutation := range mutations {
+		if assigned[i] {
+			shard = append(shard, mutation)
+		}
+	}
+
+	return shard
+}
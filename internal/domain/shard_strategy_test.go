@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateShardStrategy(t *testing.T) {
+	require.NoError(t, validateShardStrategy(""))
+	require.NoError(t, validateShardStrategy(ShardStrategyHash))
+	require.NoError(t, validateShardStrategy(ShardStrategyBalanced))
+	require.Error(t, validateShardStrategy("random"))
+}
+
+func TestBalancedShard_SplitsByRecordedDuration(t *testing.T) {
+	slow := m.Source{Origin: &m.File{ShortPath: "slow.go"}}
+	fast := m.Source{Origin: &m.File{ShortPath: "fast.go"}}
+
+	var (
+		mutations []m.Mutation
+		results   m.Result
+	)
+
+	// Two slow mutations of 40s and eight fast ones of 10s: 160s in all.
+	for i := range 10 {
+		mutation := m.Mutation{ID: fmt.Sprintf("m%d", i), Source: fast}
+		duration := 10 * time.Second
+
+		if i < 2 {
+			mutation.Source = slow
+			duration = 40 * time.Second
+		}
+
+		mutations = append(mutations, mutation)
+		results = append(results, m.MutationResult{MutationID: mutation.ID, Duration: duration})
+	}
+
+	costs := newMutationCosts([]m.Report{{Source: fast, Result: results}})
+
+	seen := make(map[string]int)
+
+	for shard := range 2 {
+		var total time.Duration
+
+		for _, mutation := range balancedShard(mutations, costs, shard, 2) {
+			seen[mutation.ID]++
+			total += costs.of(mutation)
+		}
+
+		assert.Equal(t, 80*time.Second, total, "shard %d", shard)
+	}
+
+	assert.Len(t, seen, len(mutations))
+
+	for id, count := range seen {
+		assert.Equal(t, 1, count, id)
+	}
+}
+
+func TestMutationCosts_Fallbacks(t *testing.T) {
+	calc := m.Source{Origin: &m.File{ShortPath: "calc.go"}}
+	other := m.Source{Origin: &m.File{ShortPath: "other.go"}}
+
+	costs := newMutationCosts([]m.Report{
+		{Source: calc, Result: m.Result{
+			{MutationID: "a", Duration: 2 * time.Second},
+			{MutationID: "b", Duration: 4 * time.Second},
+		}},
+		{Source: other, Result: m.Result{{MutationID: "c", Duration: 9 * time.Second}}},
+	})
+
+	assert.Equal(t, 2*time.Second, costs.of(m.Mutation{ID: "a", Source: calc}))
+	assert.Equal(t, 3*time.Second, costs.of(m.Mutation{ID: "edited", Source: calc}), "mean of the file")
+	assert.Equal(t, 5*time.Second, costs.of(m.Mutation{ID: "new", Source: m.Source{Origin: &m.File{ShortPath: "new.go"}}}), "mean of all")
+	assert.Equal(t, time.Second, newMutationCosts(nil).of(m.Mutation{ID: "a", Source: calc}))
+}
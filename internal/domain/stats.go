@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// StatsArgs contains the arguments for summarizing the run history.
+type StatsArgs struct {
+	Reports m.Path
+}
+
+// Stats summarizes the run history recorded in args.Reports.
+func (w *workflow) Stats(args StatsArgs) error {
+	records, err := w.LoadHistory(args.Reports)
+	if err != nil {
+		return fmt.Errorf("load history: %w", err)
+	}
+
+	if err := w.DisplayStats(computeHistoryStats(records), nil); err != nil {
+		return fmt.Errorf("display: %w", err)
+	}
+
+	return nil
+}
+
+// recordRun appends the reports in dir to the run history.
+func (w *workflow) recordRun(dir m.Path) error {
+	if err := w.RecordRun(dir, time.Now()); err != nil {
+		return fmt.Errorf("record run history: %w", err)
+	}
+
+	return nil
+}
+
+// computeHistoryStats derives trends from runs ordered oldest first. A
+// survivor counts as killed in the first later run that no longer reports it
+// as surviving; if it survives again afterwards, a new interval starts.
+func computeHistoryStats(runs []m.RunRecord) m.HistoryStats {
+	stats := m.HistoryStats{Runs: runs}
+
+	seen := make(map[string]bool)
+	survivingSince := make(map[string]time.Time)
+	newByWeek := make(map[string]int)
+
+	var timeToKill time.Duration
+
+	for _, run := range runs {
+		current := make(map[string]bool, len(run.Survivors))
+
+		for _, id := range run.Survivors {
+			current[id] = true
+
+			if !seen[id] {
+				seen[id] = true
+				newByWeek[isoWeek(run.Time)]++
+			}
+
+			if _, ok := survivingSince[id]; !ok {
+				survivingSince[id] = run.Time
+			}
+		}
+
+		for id, since := range survivingSince {
+			if current[id] {
+				continue
+			}
+
+			timeToKill += run.Time.Sub(since)
+			stats.KilledSurvivors++
+
+			delete(survivingSince, id)
+		}
+	}
+
+	if stats.KilledSurvivors > 0 {
+		stats.MeanTimeToKill = timeToKill / time.Duration(stats.KilledSurvivors)
+	}
+
+	stats.OpenSurvivors = len(survivingSince)
+
+	weeks := make([]string, 0, len(newByWeek))
+	for week := range newByWeek {
+		weeks = append(weeks, week)
+	}
+
+	sort.Strings(weeks)
+
+	for _, week := range weeks {
+		stats.NewSurvivorsByWeek = append(stats.NewSurvivorsByWeek, m.WeekCount{Week: week, Count: newByWeek[week]})
+	}
+
+	return stats
+}
+
+func isoWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
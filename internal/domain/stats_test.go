@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+func TestComputeHistoryStats(t *testing.T) {
+	monday := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC) // ISO week 2026-W10
+
+	runs := []m.RunRecord{
+		{Time: monday, Score: 0.5, Survivors: []string{"a", "b"}},
+		{Time: monday.Add(48 * time.Hour), Score: 0.75, Survivors: []string{"b"}},
+		{Time: monday.Add(7 * 24 * time.Hour), Score: 0.6, Survivors: []string{"b", "c", "d"}},
+		{Time: monday.Add(8 * 24 * time.Hour), Score: 0.9, Survivors: []string{"d"}},
+	}
+
+	stats := computeHistoryStats(runs)
+
+	if len(stats.Runs) != 4 {
+		t.Fatalf("Runs = %d, want 4", len(stats.Runs))
+	}
+
+	wantWeeks := []m.WeekCount{{Week: "2026-W10", Count: 2}, {Week: "2026-W11", Count: 2}}
+	if len(stats.NewSurvivorsByWeek) != len(wantWeeks) {
+		t.Fatalf("NewSurvivorsByWeek = %+v, want %+v", stats.NewSurvivorsByWeek, wantWeeks)
+	}
+
+	for i, want := range wantWeeks {
+		if stats.NewSurvivorsByWeek[i] != want {
+			t.Fatalf("NewSurvivorsByWeek[%d] = %+v, want %+v", i, stats.NewSurvivorsByWeek[i], want)
+		}
+	}
+
+	// a: 2 days, b: 8 days, c: 1 day.
+	if stats.KilledSurvivors != 3 {
+		t.Fatalf("KilledSurvivors = %d, want 3", stats.KilledSurvivors)
+	}
+
+	if want := 11 * 24 * time.Hour / 3; stats.MeanTimeToKill != want {
+		t.Fatalf("MeanTimeToKill = %v, want %v", stats.MeanTimeToKill, want)
+	}
+
+	if stats.OpenSurvivors != 1 {
+		t.Fatalf("OpenSurvivors = %d, want 1", stats.OpenSurvivors)
+	}
+}
+
+func TestComputeHistoryStats_ResurfacedSurvivorIsNotNew(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	runs := []m.RunRecord{
+		{Time: start, Survivors: []string{"a"}},
+		{Time: start.Add(time.Hour)},
+		{Time: start.Add(2 * time.Hour), Survivors: []string{"a"}},
+	}
+
+	stats := computeHistoryStats(runs)
+
+	if len(stats.NewSurvivorsByWeek) != 1 || stats.NewSurvivorsByWeek[0].Count != 1 {
+		t.Fatalf("NewSurvivorsByWeek = %+v, want a single new survivor", stats.NewSurvivorsByWeek)
+	}
+
+	if stats.KilledSurvivors != 1 || stats.MeanTimeToKill != time.Hour {
+		t.Fatalf("KilledSurvivors = %d, MeanTimeToKill = %v, want 1 and 1h", stats.KilledSurvivors, stats.MeanTimeToKill)
+	}
+
+	if stats.OpenSurvivors != 1 {
+		t.Fatalf("OpenSurvivors = %d, want 1", stats.OpenSurvivors)
+	}
+}
+
+func TestComputeHistoryStats_NoRuns(t *testing.T) {
+	stats := computeHistoryStats(nil)
+
+	if len(stats.Runs) != 0 || len(stats.NewSurvivorsByWeek) != 0 || stats.KilledSurvivors != 0 || stats.MeanTimeToKill != 0 {
+		t.Fatalf("expected empty stats, got %+v", stats)
+	}
+}
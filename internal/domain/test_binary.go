@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// testBinary returns the test binary the mutation's tests run from in ws,
+// compiling it with go test -c the first time the workspace tests the
+// mutation's schema. The binary is then reused by the other mutations of
+// the schema, which only differ in the mutation they select at run time.
+//
+// ok is false when the tests run with go test instead: without a schema or
+// a pooled workspace, for tests spanning several packages, with extra go
+// test flags, which a test binary would take in another form, or when the
+// binary does not build, leaving go test to report why.
+func (to *orchestrator) testBinary(ws *workspace, workDir m.Path, targets []string, mutation m.Mutation, opts adapter.GoTestOptions) (string, bool) {
+	if ws == nil || mutation.Schema == nil || len(targets) != 1 || len(to.runOptions().GoTestArgs) > 0 {
+		return "", false
+	}
+
+	key := testBinaryKey(mutation.Schema, workDir, targets[0], opts)
+
+	if binary, ok := ws.binaries[key]; ok {
+		return binary, binary != ""
+	}
+
+	if ws.binaries == nil {
+		ws.binaries = make(map[string]string)
+	}
+
+	binary := string(to.fsAdapter.JoinPath(string(ws.dir), ".gooze-"+key+".test"))
+	if _, err := to.testAdapter.BuildTestBinary(string(workDir), targets[0], binary, opts); err != nil {
+		binary = ""
+	}
+
+	ws.binaries[key] = binary
+
+	return binary, binary != ""
+}
+
+// testBinaryKey identifies the test binary of schema built for target in
+// workDir with the build options of opts.
+func testBinaryKey(schema []byte, workDir m.Path, target string, opts adapter.GoTestOptions) string {
+	hash := sha256.New()
+	hash.Write(schema)
+
+	for _, part := range []string{string(workDir), target, strings.Join(opts.Tags, ","), strconv.FormatBool(opts.Race)} {
+		hash.Write([]byte{0})
+		hash.Write([]byte(part))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// runTestBinary runs the tests of binary as runGoTest runs them with go
+// test.
+func (to *orchestrator) runTestBinary(binary string) func(m.Path, string, adapter.GoTestOptions) (testRun, error) {
+	return func(workDir m.Path, target string, opts adapter.GoTestOptions) (testRun, error) {
+		output, testErr := to.testAdapter.RunTestBinary(binary, string(workDir), target, opts)
+		if testErr != nil {
+			return testRun{status: m.Killed, reason: killReason(testErr, output), output: output}, nil
+		}
+
+		return testRun{status: m.Survived, output: output}, nil
+	}
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// expectSchemaWorkspace prepares the mocks of a single pooled workspace at
+// /tmp/ws testing mutations of /project/main.go, each written as schema.
+func expectSchemaWorkspace(fsAdapter *adaptermocks.MockSourceFSAdapter, mutations []m.Mutation, schema []byte) {
+	projectRoot := m.Path("/project")
+	wsDir := m.Path("/tmp/ws")
+	original := []byte("package main\nfunc main() { _ = 1 * 1 }\n")
+	source := mutations[0].Source
+
+	fsAdapter.EXPECT().FindProjectRoot(source.Origin.FullPath).Return(projectRoot, nil).Once()
+	fsAdapter.EXPECT().CreateTempDir(m.Path(""), "gooze-workspace-*").Return(wsDir, nil).Once()
+	fsAdapter.EXPECT().CopyDir(projectRoot, wsDir).Return(nil).Once()
+	fsAdapter.EXPECT().ReadFile(source.Origin.FullPath).Return(original, nil).Times(len(mutations))
+	fsAdapter.EXPECT().RelPath(projectRoot, source.Origin.FullPath).Return(m.Path("main.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(wsDir), "main.go").Return(m.Path("/tmp/ws/main.go"))
+	fsAdapter.EXPECT().RelPath(projectRoot, source.Test.FullPath).Return(m.Path("main_test.go"), nil)
+	fsAdapter.EXPECT().JoinPath(string(wsDir), "main_test.go").Return(m.Path("/tmp/ws/main_test.go"))
+	fsAdapter.EXPECT().JoinPath(string(wsDir), mock.AnythingOfType("string")).RunAndReturn(func(elem ...string) m.Path {
+		return m.Path(elem[0] + "/" + elem[1])
+	}).Maybe()
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/ws/main.go"), schema, os.FileMode(0o600)).Return(nil).Times(len(mutations))
+	fsAdapter.EXPECT().WriteFile(m.Path("/tmp/ws/main.go"), original, os.FileMode(0o600)).Return(nil).Times(len(mutations))
+}
+
+func schemaMutations(schema []byte) []m.Mutation {
+	first := makeTestMutation()
+	first.Schema = schema
+
+	second := makeTestMutation()
+	second.ID = "second-mutation-hash"
+	second.Schema = schema
+
+	return []m.Mutation{first, second}
+}
+
+func TestOrchestrator_TestMutation_SchemaReusesTestBinary(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	schema := []byte("package main // schema")
+	mutations := schemaMutations(schema)
+	expectSchemaWorkspace(fsAdapter, mutations, schema)
+
+	var binary string
+
+	trAdapter.EXPECT().BuildTestBinary("/tmp/ws", "/tmp/ws/main_test.go", mock.AnythingOfType("string"), mock.Anything).
+		RunAndReturn(func(_, _, out string, _ adapter.GoTestOptions) (string, error) {
+			binary = out
+			return "", nil
+		}).Once()
+
+	for _, mutation := range mutations {
+		opts := adapter.GoTestOptions{Args: []string{"-count=1"}, Env: []string{SchemataEnv + "=" + mutation.ID}}
+		trAdapter.EXPECT().RunTestBinary(mock.AnythingOfType("string"), "/tmp/ws", "/tmp/ws/main_test.go", opts).
+			Return("--- FAIL: TestMain (0.00s)\n", errors.New("exit status 1")).Once()
+	}
+
+	require.NoError(t, orch.PrepareWorkspaces(mutations, 1))
+
+	for _, mutation := range mutations {
+		result, err := orch.TestMutation(mutation)
+		require.NoError(t, err)
+		require.Equal(t, m.Killed, result.Status)
+		require.Equal(t, m.KillAssertion, result.KillReason)
+	}
+
+	require.NotEmpty(t, binary)
+	trAdapter.AssertCalled(t, "RunTestBinary", binary, "/tmp/ws", "/tmp/ws/main_test.go", mock.Anything)
+}
+
+func TestOrchestrator_TestMutation_SchemaFallsBackToGoTest(t *testing.T) {
+	fsAdapter := adaptermocks.NewMockSourceFSAdapter(t)
+	trAdapter := adaptermocks.NewMockTestRunnerAdapter(t)
+	orch := NewOrchestrator(fsAdapter, trAdapter)
+
+	schema := []byte("package main // schema")
+	mutations := schemaMutations(schema)
+	expectSchemaWorkspace(fsAdapter, mutations, schema)
+
+	// A binary that fails to build is not tried again; go test reports why.
+	trAdapter.EXPECT().BuildTestBinary("/tmp/ws", "/tmp/ws/main_test.go", mock.AnythingOfType("string"), mock.Anything).
+		Return("# main\nbuild failed", errors.New("exit status 1")).Once()
+
+	for _, mutation := range mutations {
+		opts := adapter.GoTestOptions{Args: []string{"-count=1"}, Env: []string{SchemataEnv + "=" + mutation.ID}}
+		trAdapter.EXPECT().RunGoTest("/tmp/ws", "/tmp/ws/main_test.go", opts).Return("", nil).Once()
+	}
+
+	require.NoError(t, orch.PrepareWorkspaces(mutations, 1))
+
+	for _, mutation := range mutations {
+		result, err := orch.TestMutation(mutation)
+		require.NoError(t, err)
+		require.Equal(t, m.Survived, result.Status)
+	}
+}
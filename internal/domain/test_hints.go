@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// hintExprLength bounds the code a hint quotes, in runes.
+const hintExprLength = 40
+
+// testHint suggests the test that would kill mutation if it survives: what
+// to assert, from the signature of fd, the function the mutation is in,
+// and for which inputs, from node, the code the mutation changes. fd is nil
+// for package-level code.
+func testHint(mutation m.Mutation, node ast.Node, fd *ast.FuncDecl, fset *token.FileSet, content []byte) string {
+	return fmt.Sprintf("no test asserts %s %s", hintSubject(fd), hintInputs(mutation, node, fset, content))
+}
+
+// hintSubject names what a test of fd can check: its results, the state
+// of its receiver or arguments, or else its side effects.
+func hintSubject(fd *ast.FuncDecl) string {
+	if fd == nil {
+		return "the package-level value"
+	}
+
+	name := funcName(fd)
+
+	if results := fd.Type.Results; results != nil && results.NumFields() > 0 {
+		if results.NumFields() == 1 && isErrorType(results.List[0].Type) {
+			return "the error returned by " + name
+		}
+
+		if results.NumFields() > 1 {
+			return "the return values of " + name
+		}
+
+		return "the return value of " + name
+	}
+
+	if fd.Recv != nil && len(fd.Recv.List) > 0 && isReference(fd.Recv.List[0].Type) {
+		return "the state " + name + " leaves in its receiver"
+	}
+
+	for _, param := range fd.Type.Params.List {
+		if isReference(param.Type) {
+			return "what " + name + " does to its arguments"
+		}
+	}
+
+	return "the side effects of " + name
+}
+
+// hintInputs describes the inputs that tell node apart from its mutant.
+func hintInputs(mutation m.Mutation, node ast.Node, fset *token.FileSet, content []byte) string {
+	text := func(n ast.Node) string { return hintCode(n, fset, content) }
+
+	switch n := node.(type) {
+	case *ast.BinaryExpr:
+		switch n.Op {
+		case token.LSS, token.GTR, token.LEQ, token.GEQ:
+			return fmt.Sprintf("for inputs where %s == %s, on the boundary of %s", text(n.X), text(n.Y), text(n))
+		case token.EQL, token.NEQ:
+			return fmt.Sprintf("both for inputs where %s == %s and where they differ", text(n.X), text(n.Y))
+		case token.LAND, token.LOR:
+			return fmt.Sprintf("for inputs where only one side of %s holds", text(n))
+		default:
+			return fmt.Sprintf("for operands of %s other than 0 and 1", text(n))
+		}
+	case *ast.UnaryExpr:
+		if n.Op == token.NOT {
+			return fmt.Sprintf("both for inputs where %s holds and where it does not", text(n.X))
+		}
+
+		return fmt.Sprintf("for non-zero values of %s", text(n.X))
+	case *ast.BasicLit:
+		return fmt.Sprintf("for inputs whose outcome depends on the exact value %s on line %d", n.Value, mutation.Position.Line)
+	case *ast.Ident:
+		return fmt.Sprintf("for inputs where the %s on line %d decides the outcome", n.Name, mutation.Position.Line)
+	case *ast.IfStmt:
+		return fmt.Sprintf("for inputs that take both ways of if %s", text(n.Cond))
+	case *ast.ForStmt, *ast.RangeStmt:
+		return fmt.Sprintf("for inputs that run the loop on line %d zero, one and several times", mutation.Position.Line)
+	case *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.CaseClause:
+		return fmt.Sprintf("for inputs reaching each case of the switch on line %d", mutation.Position.Line)
+	case *ast.CallExpr:
+		return fmt.Sprintf("for inputs where the result of %s matters", text(n))
+	default:
+		return fmt.Sprintf("for inputs whose outcome depends on line %d", mutation.Position.Line)
+	}
+}
+
+// hintCode returns the source of n on one line, shortened to
+// hintExprLength runes.
+func hintCode(n ast.Node, fset *token.FileSet, content []byte) string {
+	start, end := fset.PositionFor(n.Pos(), false).Offset, fset.PositionFor(n.End(), false).Offset
+	if start < 0 || end > len(content) || start >= end {
+		return "the expression"
+	}
+
+	code := []rune(strings.Join(strings.Fields(string(content[start:end])), " "))
+	if len(code) > hintExprLength {
+		return string(code[:hintExprLength-1]) + "…"
+	}
+
+	return string(code)
+}
+
+func isErrorType(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "error"
+}
+
+// isReference reports whether a value of type expr shares memory with the
+// caller, so a function can change what the caller sees through it.
+func isReference(expr ast.Expr) bool {
+	switch t := expr.(type) {
+	case *ast.StarExpr, *ast.MapType, *ast.ChanType:
+		return true
+	case *ast.ArrayType:
+		return t.Len == nil
+	default:
+		return false
+	}
+}
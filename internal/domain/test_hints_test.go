@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const hintSource = `package calc
+
+var limit = 10 * 2
+
+type Counter struct{ n int }
+
+func Less(a, b int) bool {
+	return a < b
+}
+
+func Divide(a, b int) (int, error) {
+	return a / b, nil
+}
+
+func Check(ok bool) error {
+	if ok && limit > 0 {
+		return nil
+	}
+	return nil
+}
+
+func (c *Counter) Add(values []int) {
+	for _, v := range values {
+		c.n += -v
+	}
+}
+
+func Fill(dst []int) {
+	dst[0] = 7
+}
+
+func log(s string) {
+	println(s == "")
+}
+`
+
+func TestTestHint(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "calc.go", hintSource, 0)
+	require.NoError(t, err)
+
+	content := []byte(hintSource)
+	hints := make(map[string]string)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n == nil {
+			return true
+		}
+
+		var key string
+
+		switch n := n.(type) {
+		case *ast.BinaryExpr, *ast.UnaryExpr, *ast.RangeStmt:
+			key = hintCode(n, fset, content)
+		case *ast.BasicLit:
+			key = n.Value
+		case *ast.IfStmt:
+			key = "if"
+		default:
+			return true
+		}
+
+		mutation := m.Mutation{Position: m.Position{Line: fset.Position(n.Pos()).Line}}
+		hints[key] = testHint(mutation, n, enclosingFunc(file, n.Pos()), fset, content)
+
+		return true
+	})
+
+	assert.Equal(t, "no test asserts the return value of Less for inputs where a == b, on the boundary of a < b", hints["a < b"])
+	assert.Equal(t, "no test asserts the return values of Divide for operands of a / b other than 0 and 1", hints["a / b"])
+	assert.Equal(t, "no test asserts the error returned by Check for inputs where only one side of ok && limit > 0 holds", hints["ok && limit > 0"])
+	assert.Equal(t, "no test asserts the error returned by Check for inputs that take both ways of if ok && limit > 0", hints["if"])
+	assert.Equal(t, "no test asserts the state Counter.Add leaves in its receiver for non-zero values of v", hints["-v"])
+	assert.Equal(t, "no test asserts the state Counter.Add leaves in its receiver for inputs that run the loop on line 23 zero, one and several times",
+		hints["for _, v := range values { c.n += -v }"])
+	assert.Equal(t, "no test asserts what Fill does to its arguments for inputs whose outcome depends on the exact value 7 on line 29", hints["7"])
+	assert.Equal(t, "no test asserts the side effects of log both for inputs where s == \"\" and where they differ", hints[`s == ""`])
+	assert.Equal(t, "no test asserts the package-level value for operands of 10 * 2 other than 0 and 1", hints["10 * 2"])
+}
+
+func TestHintCode_Shortens(t *testing.T) {
+	source := "package p\n\nvar x = aVeryLongIdentifierName + anotherVeryLongIdentifierName\n"
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.go", source, 0)
+	require.NoError(t, err)
+
+	expr := file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values[0]
+
+	assert.Equal(t, "aVeryLongIdentifierName + anotherVeryLo…", hintCode(expr, fset, []byte(source)))
+}
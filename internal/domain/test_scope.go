@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// TestScope selects which tests run against a mutation.
+type TestScope string
+
+const (
+	// TestScopeFile runs the mutated file's companion test file, falling back
+	// to the package tests only when the file has none.
+	TestScopeFile TestScope = "file"
+	// TestScopePackage runs every test of the mutated file's package, so tests
+	// in other files of the package can kill the mutant too.
+	TestScopePackage TestScope = "package"
+	// TestScopeDependents runs the tests of the mutated file's package and of
+	// every package that imports it, directly or transitively.
+	TestScopeDependents TestScope = "dependents"
+)
+
+// TestScopes lists the accepted test scopes.
+var TestScopes = []TestScope{TestScopeFile, TestScopePackage, TestScopeDependents}
+
+// validateTestScope accepts the known scopes and the empty scope, which means
+// TestScopeFile.
+func validateTestScope(scope TestScope) error {
+	if scope == "" {
+		return nil
+	}
+
+	for _, known := range TestScopes {
+		if scope == known {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown test scope %q (want %s, %s or %s)", scope, TestScopeFile, TestScopePackage, TestScopeDependents)
+}
+
+// withTestScope widens mutations that have a companion test file to their
+// whole package under TestScopePackage. Other scopes leave them unchanged.
+func withTestScope(mutations []m.Mutation, scope TestScope) []m.Mutation {
+	if scope != TestScopePackage {
+		return mutations
+	}
+
+	scoped := make([]m.Mutation, len(mutations))
+
+	for i, mutation := range mutations {
+		if mutation.Source.Test != nil {
+			mutation.Source.PackageTests = true
+		}
+
+		scoped[i] = mutation
+	}
+
+	return scoped
+}
+
+// requireTests fails with ErrNoTests when there are mutations but none of
+// them has a test to run. The dependents scope is exempt, since tests in the
+// importing packages are only looked up later.
+func requireTests(mutations []m.Mutation, scope TestScope) error {
+	if len(mutations) == 0 || scope == TestScopeDependents {
+		return nil
+	}
+
+	for _, mutation := range mutations {
+		if hasTests(mutation) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: none of the %d mutations has a _test.go file in its package", ErrNoTests, len(mutations))
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTestScope(t *testing.T) {
+	mutations := []m.Mutation{
+		{ID: "tested", Source: m.Source{Test: &m.File{FullPath: "/project/calc_test.go"}}},
+		{ID: "untested", Source: m.Source{}},
+	}
+
+	scoped := withTestScope(mutations, TestScopePackage)
+
+	assert.True(t, scoped[0].Source.PackageTests)
+	assert.False(t, scoped[1].Source.PackageTests, "mutations without tests stay without tests")
+	assert.False(t, mutations[0].Source.PackageTests, "input mutations must not be modified")
+
+	assert.Equal(t, mutations, withTestScope(mutations, TestScopeFile))
+	assert.Equal(t, mutations, withTestScope(mutations, ""))
+}
+
+func TestValidateTestScope(t *testing.T) {
+	require.NoError(t, validateTestScope(""))
+	require.NoError(t, validateTestScope(TestScopeFile))
+	require.NoError(t, validateTestScope(TestScopePackage))
+	require.ErrorContains(t, validateTestScope("module"), `unknown test scope "module"`)
+}
+
+func TestRequireTests(t *testing.T) {
+	tested := m.Mutation{ID: "tested", Source: m.Source{PackageTests: true}}
+	untested := m.Mutation{ID: "untested"}
+
+	require.NoError(t, requireTests(nil, TestScopeFile))
+	require.NoError(t, requireTests([]m.Mutation{untested, tested}, TestScopeFile))
+	require.ErrorIs(t, requireTests([]m.Mutation{untested}, TestScopeFile), ErrNoTests)
+	require.ErrorIs(t, requireTests([]m.Mutation{untested}, TestScopePackage), ErrNoTests)
+	require.NoError(t, requireTests([]m.Mutation{untested}, TestScopeDependents))
+}
@@ -0,0 +1,11 @@
+package fixture
+
+// Add returns the sum of a and b.
+func Add(a, b int) int {
+	return a + b
+}
+
+// IsAdult reports whether age is at least 18.
+func IsAdult(age int) bool {
+	return age >= 18
+}
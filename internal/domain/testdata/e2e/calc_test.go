@@ -0,0 +1,15 @@
+package fixture
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if got := Add(2, 3); got != 5 {
+		t.Errorf("Add(2, 3) = %d; want 5", got)
+	}
+}
+
+func TestIsAdult(t *testing.T) {
+	if !IsAdult(30) || IsAdult(5) {
+		t.Error("IsAdult is wrong")
+	}
+}
@@ -0,0 +1,11 @@
+package fixture
+
+// Verbose reports whether detailed output was requested and not silenced.
+func Verbose(requested, quiet bool) bool {
+	return requested && !quiet
+}
+
+// Retries is the number of attempts after the first one.
+func Retries() int {
+	return 3
+}
@@ -0,0 +1,25 @@
+package fixture
+
+import "testing"
+
+func TestVerbose(t *testing.T) {
+	cases := []struct {
+		requested, quiet, want bool
+	}{
+		{true, false, true},
+		{true, true, false},
+		{false, false, false},
+	}
+
+	for _, c := range cases {
+		if got := Verbose(c.requested, c.quiet); got != c.want {
+			t.Errorf("Verbose(%v, %v) = %v; want %v", c.requested, c.quiet, got, c.want)
+		}
+	}
+}
+
+func TestRetries(t *testing.T) {
+	if Retries() < 1 {
+		t.Error("Retries() < 1")
+	}
+}
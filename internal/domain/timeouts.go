@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultTestTimeout is the base time budget for one mutation's tests.
+const DefaultTestTimeout = 30 * time.Second
+
+// DefaultTimeoutMultipliers scale DefaultTestTimeout per mutation type. Loop
+// boundary changes often run longer yet still finish, so they get extra time
+// before a slow run is taken for a hang.
+var DefaultTimeoutMultipliers = map[string]float64{
+	m.MutationLoop.Name: 2,
+}
+
+// withTimeouts sets each mutation's test budget to base scaled by the
+// multiplier for its type, or by one for types without a multiplier.
+func withTimeouts(mutations []m.Mutation, base time.Duration, multipliers map[string]float64) []m.Mutation {
+	if base <= 0 {
+		base = DefaultTestTimeout
+	}
+
+	timed := make([]m.Mutation, len(mutations))
+
+	for i, mutation := range mutations {
+		factor, ok := multipliers[mutation.Type.Name]
+		if !ok {
+			factor = 1
+		}
+
+		mutation.Timeout = time.Duration(float64(base) * factor)
+		timed[i] = mutation
+	}
+
+	return timed
+}
+
+// timeoutMultipliers merges overrides into DefaultTimeoutMultipliers,
+// rejecting unknown mutation types and non-positive factors.
+func timeoutMultipliers(overrides map[string]float64) (map[string]float64, error) {
+	multipliers := make(map[string]float64, len(DefaultTimeoutMultipliers)+len(overrides))
+	for name, factor := range DefaultTimeoutMultipliers {
+		multipliers[name] = factor
+	}
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		factor := overrides[name]
+
+		if !isMutationTypeName(name) {
+			return nil, fmt.Errorf("timeout multiplier for unknown mutation type %q", name)
+		}
+
+		if factor <= 0 {
+			return nil, fmt.Errorf("timeout multiplier for %s must be positive, got %g", name, factor)
+		}
+
+		multipliers[name] = factor
+	}
+
+	return multipliers, nil
+}
+
+func isMutationTypeName(name string) bool {
+	_, ok := mutationTypeNamed(name)
+	return ok
+}
+
+// mutationTypeNamed returns the mutation type called name.
+func mutationTypeNamed(name string) (m.MutationType, bool) {
+	for _, mt := range m.MutationTypes {
+		if mt.Name == name {
+			return mt, true
+		}
+	}
+
+	return m.MutationType{}, false
+}
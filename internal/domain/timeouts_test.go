@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTimeouts(t *testing.T) {
+	mutations := []m.Mutation{
+		{ID: "a", Type: m.MutationArithmetic},
+		{ID: "l", Type: m.MutationLoop},
+		{ID: "b", Type: m.MutationBranch},
+	}
+
+	multipliers, err := timeoutMultipliers(map[string]float64{"branch": 0.5})
+	require.NoError(t, err)
+
+	timed := withTimeouts(mutations, 10*time.Second, multipliers)
+
+	assert.Equal(t, 10*time.Second, timed[0].Timeout)
+	assert.Equal(t, 20*time.Second, timed[1].Timeout, "loop mutations get the default 2x")
+	assert.Equal(t, 5*time.Second, timed[2].Timeout)
+	assert.Zero(t, mutations[0].Timeout, "input mutations must not be modified")
+}
+
+func TestWithTimeouts_DefaultBase(t *testing.T) {
+	timed := withTimeouts([]m.Mutation{{Type: m.MutationArithmetic}}, 0, DefaultTimeoutMultipliers)
+
+	assert.Equal(t, DefaultTestTimeout, timed[0].Timeout)
+}
+
+func TestTimeoutMultipliers(t *testing.T) {
+	multipliers, err := timeoutMultipliers(map[string]float64{"loop": 1})
+	require.NoError(t, err)
+	assert.InDelta(t, 1, multipliers["loop"], 0, "an override replaces the default")
+	assert.InDelta(t, 2, DefaultTimeoutMultipliers["loop"], 0, "defaults must stay untouched")
+
+	_, err = timeoutMultipliers(map[string]float64{"loops": 2})
+	require.ErrorContains(t, err, `unknown mutation type "loops"`)
+
+	_, err = timeoutMultipliers(map[string]float64{"loop": 0})
+	require.ErrorContains(t, err, "must be positive")
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultTrendRuns is how many recorded runs the score trend charts unless
+// told otherwise.
+const DefaultTrendRuns = 20
+
+// TrendArgs contains the arguments for charting the score trend. Last limits
+// the chart to the most recent runs; zero charts every recorded run.
+type TrendArgs struct {
+	Reports m.Path
+	Last    int
+}
+
+// Trend charts the mutation score of the runs recorded in args.Reports.
+func (w *workflow) Trend(args TrendArgs) error {
+	if args.Last < 0 {
+		return fmt.Errorf("invalid number of runs %d: must not be negative", args.Last)
+	}
+
+	records, err := w.LoadHistory(args.Reports)
+	if err != nil {
+		return fmt.Errorf("load history: %w", err)
+	}
+
+	if err := w.DisplayTrend(lastRuns(records, args.Last), nil); err != nil {
+		return fmt.Errorf("display: %w", err)
+	}
+
+	return nil
+}
+
+// lastRuns keeps the n most recent of runs ordered oldest first.
+func lastRuns(runs []m.RunRecord, n int) []m.RunRecord {
+	if n == 0 || len(runs) <= n {
+		return runs
+	}
+
+	return runs[len(runs)-n:]
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"testing"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLastRuns(t *testing.T) {
+	runs := []m.RunRecord{{Score: 0.1}, {Score: 0.2}, {Score: 0.3}}
+
+	assert.Equal(t, runs[1:], lastRuns(runs, 2))
+	assert.Equal(t, runs, lastRuns(runs, 3))
+	assert.Equal(t, runs, lastRuns(runs, 10))
+	assert.Equal(t, runs, lastRuns(runs, 0))
+}
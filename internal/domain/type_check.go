@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"go/ast"
+	"go/token"
+	"path/filepath"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// typeCheckNote marks the results of mutants rejected without running go
+// test because they do not type-check.
+const typeCheckNote = "rejected by type check"
+
+// MutantChecker finds the mutants that cannot compile before they are
+// tested.
+type MutantChecker interface {
+	// Uncompilable returns why each mutation that cannot compile does not,
+	// by mutation ID. Mutations of packages that do not type-check
+	// unmutated are never returned: their errors say nothing about the
+	// mutant.
+	Uncompilable(mutations []m.Mutation) map[string]error
+}
+
+// typeChecker implements MutantChecker with go/types, checking each mutated
+// file together with the other files of its package.
+type typeChecker struct {
+	adapter.GoFileAdapter
+	adapter.SourceFSAdapter
+}
+
+// NewMutantChecker returns the MutantChecker type-checking mutated files
+// against their package.
+func NewMutantChecker(goFileAdapter adapter.GoFileAdapter, sourceFSAdapter adapter.SourceFSAdapter) MutantChecker {
+	return &typeChecker{
+		GoFileAdapter:   goFileAdapter,
+		SourceFSAdapter: sourceFSAdapter,
+	}
+}
+
+// checkedPackage is a source's package, parsed once for every mutant of the
+// source.
+type checkedPackage struct {
+	fset     *token.FileSet
+	siblings []*ast.File
+	// clean is whether the package type-checks unmutated.
+	clean bool
+}
+
+func (tc *typeChecker) Uncompilable(mutations []m.Mutation) map[string]error {
+	packages := make(map[m.Path]*checkedPackage)
+	rejected := make(map[string]error)
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil {
+			continue
+		}
+
+		path := mutation.Source.Origin.FullPath
+
+		pkg, ok := packages[path]
+		if !ok {
+			pkg = tc.loadPackage(mutation.Source)
+			packages[path] = pkg
+		}
+
+		if !pkg.clean {
+			continue
+		}
+
+		file, err := tc.Parse(pkg.fset, string(path), mutation.MutatedCode)
+		if err != nil {
+			rejected[mutation.ID] = err
+			continue
+		}
+
+		if _, err := tc.Check(pkg.fset, append([]*ast.File{file}, pkg.siblings...)); err != nil {
+			rejected[mutation.ID] = err
+		}
+	}
+
+	return rejected
+}
+
+// loadPackage parses source and the rest of its package, and checks that
+// they type-check unmutated.
+func (tc *typeChecker) loadPackage(source m.Source) *checkedPackage {
+	pkg := &checkedPackage{fset: token.NewFileSet()}
+
+	content, err := tc.ReadFile(source.Origin.FullPath)
+	if err != nil {
+		return pkg
+	}
+
+	file, err := tc.Parse(pkg.fset, string(source.Origin.FullPath), content)
+	if err != nil {
+		return pkg
+	}
+
+	pkg.siblings = packageSiblings(tc.GoFileAdapter, tc.SourceFSAdapter, source, pkg.fset, file.Name.Name)
+	_, err = tc.Check(pkg.fset, append([]*ast.File{file}, pkg.siblings...))
+	pkg.clean = err == nil
+
+	return pkg
+}
+
+// packageSiblings parses the other non-test files of source's directory
+// that declare pkgName and build with source's tags. Files that cannot be
+// listed, read or parsed are left out.
+func packageSiblings(goFiles adapter.GoFileAdapter, sourceFS adapter.SourceFSAdapter, source m.Source, fset *token.FileSet, pkgName string) []*ast.File {
+	sourcePath := absPath(string(source.Origin.FullPath))
+
+	sources, err := sourceFS.Get([]m.Path{m.Path(filepath.Dir(sourcePath))})
+	if err != nil {
+		return nil
+	}
+
+	var siblings []*ast.File
+
+	for _, sibling := range withBuildTags(sources, source.BuildTags) {
+		path := string(sibling.Origin.FullPath)
+		if absPath(path) == sourcePath {
+			continue
+		}
+
+		content, err := sourceFS.ReadFile(sibling.Origin.FullPath)
+		if err != nil {
+			continue
+		}
+
+		file, err := goFiles.Parse(fset, path, content)
+		if err != nil || file.Name.Name != pkgName {
+			continue
+		}
+
+		siblings = append(siblings, file)
+	}
+
+	return siblings
+}
+
+// absPath returns path made absolute, or cleaned when it cannot be.
+func absPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+
+	return abs
+}
+
+// withoutUncompilable takes the mutants the workflow's checker finds cannot
+// compile out of mutations, returning the reports recording them as go test
+// would have: CompileError, or killed by the build when countCompileErrors
+// is set.
+func (w *workflow) withoutUncompilable(mutations []m.Mutation, countCompileErrors bool) ([]m.Mutation, []m.Report) {
+	if w.checker == nil || len(mutations) == 0 {
+		return mutations, nil
+	}
+
+	rejected := w.checker.Uncompilable(mutations)
+	if len(rejected) == 0 {
+		return mutations, nil
+	}
+
+	kept := make([]m.Mutation, 0, len(mutations)-len(rejected))
+
+	var reports []m.Report
+
+	for _, mutation := range mutations {
+		err, ok := rejected[mutation.ID]
+		if !ok {
+			kept = append(kept, mutation)
+			continue
+		}
+
+		result := m.MutationResult{
+			MutationID: mutation.ID,
+			Type:       mutation.Type,
+			Status:     m.CompileError,
+			Line:       mutation.Position.Line,
+			Note:       typeCheckNote,
+			TestOutput: err.Error(),
+		}
+
+		if countCompileErrors {
+			result.Status, result.KillReason = m.Killed, m.KillBuild
+		}
+
+		reports = append(reports, m.Report{Source: mutation.Source, Result: m.Result{result}})
+	}
+
+	return kept, reports
+}
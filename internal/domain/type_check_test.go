@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const typeCheckSource = `package calc
+
+func Add(a, b int) int {
+	return a + b
+}
+`
+
+func typeCheckMutation(path, id, code string) m.Mutation {
+	return m.Mutation{
+		ID:          id,
+		Source:      m.Source{Origin: &m.File{FullPath: m.Path(path)}},
+		MutatedCode: []byte(code),
+	}
+}
+
+func TestMutantChecker_Uncompilable(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "calc.go")
+	writeFile(t, path, typeCheckSource)
+	writeFile(t, filepath.Join(dir, "helper.go"), "package calc\n\nfunc twice(n int) int { return Add(n, n) }\n")
+
+	checker := NewMutantChecker(adapter.NewLocalGoFileAdapter(), adapter.NewLocalSourceFSAdapter())
+
+	rejected := checker.Uncompilable([]m.Mutation{
+		typeCheckMutation(path, "compiles", strings.Replace(typeCheckSource, "a + b", "a - b", 1)),
+		typeCheckMutation(path, "mistyped", strings.Replace(typeCheckSource, "a + b", `a + "b"`, 1)),
+		typeCheckMutation(path, "unparsable", strings.Replace(typeCheckSource, "a + b", "a +", 1)),
+		typeCheckMutation(path, "sibling", strings.Replace(typeCheckSource, "Add", "Sum", 1)),
+	})
+
+	assert.NotContains(t, rejected, "compiles")
+	assert.Contains(t, rejected, "mistyped")
+	assert.Contains(t, rejected, "unparsable")
+	require.Contains(t, rejected, "sibling", "the package's other files are checked with the mutant")
+	assert.ErrorContains(t, rejected["sibling"], "undefined: Add")
+}
+
+func TestMutantChecker_Uncompilable_SkipsPackagesWithTypeErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "calc.go")
+	broken := strings.Replace(typeCheckSource, "a + b", "a + missing", 1)
+	writeFile(t, path, broken)
+
+	checker := NewMutantChecker(adapter.NewLocalGoFileAdapter(), adapter.NewLocalSourceFSAdapter())
+
+	rejected := checker.Uncompilable([]m.Mutation{
+		typeCheckMutation(path, "mutant", strings.Replace(broken, "a + missing", `a + "b"`, 1)),
+	})
+
+	assert.Empty(t, rejected)
+}
+
+type stubMutantChecker map[string]error
+
+func (s stubMutantChecker) Uncompilable([]m.Mutation) map[string]error {
+	return s
+}
+
+func TestWorkflow_WithoutUncompilable(t *testing.T) {
+	source := m.Source{Origin: &m.File{FullPath: "calc.go"}}
+	mutations := []m.Mutation{
+		{ID: "kept", Source: source},
+		{ID: "rejected", Source: source, Type: m.MutationArithmetic, Position: m.Position{Line: 4}},
+	}
+
+	w := &workflow{checker: stubMutantChecker{"rejected": errors.New("mismatched types")}}
+
+	kept, reports := w.withoutUncompilable(mutations, false)
+
+	assert.Equal(t, mutations[:1], kept)
+	require.Len(t, reports, 1)
+	assert.Equal(t, m.MutationResult{
+		MutationID: "rejected",
+		Type:       m.MutationArithmetic,
+		Status:     m.CompileError,
+		Line:       4,
+		Note:       typeCheckNote,
+		TestOutput: "mismatched types",
+	}, reports[0].Result[0])
+
+	_, reports = w.withoutUncompilable(mutations, true)
+
+	require.Len(t, reports, 1)
+	assert.Equal(t, m.Killed, reports[0].Result[0].Status)
+	assert.Equal(t, m.KillBuild, reports[0].Result[0].KillReason)
+}
+
+func TestWorkflow_WithoutUncompilable_NoChecker(t *testing.T) {
+	mutations := []m.Mutation{{ID: "a"}}
+
+	kept, reports := (&workflow{}).withoutUncompilable(mutations, false)
+
+	assert.Equal(t, mutations, kept)
+	assert.Empty(t, reports)
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// VerifyReportsArgs contains the arguments for checking a reports directory.
+type VerifyReportsArgs struct {
+	Reports m.Path
+	// Fix repairs what can be repaired and rebuilds the index.
+	Fix bool
+}
+
+// VerifyReports checks the report files and the index of args.Reports
+// against each other and displays the problems found. It fails when
+// problems remain, after repairing them with args.Fix.
+func (w *workflow) VerifyReports(args VerifyReportsArgs) error {
+	report, err := w.CheckIntegrity(args.Reports)
+	if err != nil {
+		return fmt.Errorf("check reports: %w", err)
+	}
+
+	if args.Fix && len(report.Problems) > 0 {
+		found := report.Problems
+
+		if err := w.RepairIntegrity(args.Reports); err != nil {
+			return fmt.Errorf("repair reports: %w", err)
+		}
+
+		if report, err = w.CheckIntegrity(args.Reports); err != nil {
+			return fmt.Errorf("check repaired reports: %w", err)
+		}
+
+		report.Repaired = true
+		report.Fixed = fixedProblems(found, report.Problems)
+	}
+
+	if err := w.DisplayIntegrity(report, nil); err != nil {
+		return fmt.Errorf("display: %w", err)
+	}
+
+	if len(report.Problems) > 0 {
+		return fmt.Errorf("%d problems in reports directory %s", len(report.Problems), args.Reports)
+	}
+
+	return nil
+}
+
+// fixedProblems returns the problems of found that are not in remaining.
+func fixedProblems(found, remaining []m.IntegrityProblem) []m.IntegrityProblem {
+	left := make(map[m.IntegrityProblem]bool, len(remaining))
+	for _, problem := range remaining {
+		left[problem] = true
+	}
+
+	fixed := make([]m.IntegrityProblem, 0, len(found))
+
+	for _, problem := range found {
+		if !left[problem] {
+			fixed = append(fixed, problem)
+		}
+	}
+
+	return fixed
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflow_VerifyReports_FailsOnProblems(t *testing.T) {
+	corrupt := m.IntegrityProblem{Kind: m.IntegrityCorrupt, File: "a.yaml", Detail: "yaml: line 1"}
+	found := m.IntegrityReport{Dir: "reports", Reports: 2, Problems: []m.IntegrityProblem{corrupt}}
+
+	store := adaptermocks.NewMockReportStore(t)
+	store.On("CheckIntegrity", m.Path("reports")).Return(found, nil)
+
+	mockUI := new(controllermocks.MockUI)
+	mockUI.On("DisplayIntegrity", found, nil).Return(nil)
+
+	workflow := domain.NewWorkflow(
+		domain.WithReportStore(store),
+		domain.WithUI(mockUI),
+	)
+
+	err := workflow.VerifyReports(domain.VerifyReportsArgs{Reports: "reports"})
+	require.ErrorContains(t, err, "1 problems in reports directory reports")
+	mockUI.AssertExpectations(t)
+	store.AssertNotCalled(t, "RepairIntegrity", mock.Anything)
+}
+
+func TestWorkflow_VerifyReports_FixReportsWhatWasFixed(t *testing.T) {
+	corrupt := m.IntegrityProblem{Kind: m.IntegrityCorrupt, File: "a.yaml", Detail: "yaml: line 1"}
+	collision := m.IntegrityProblem{Kind: m.IntegrityCollision, File: "b.yaml", Detail: "mutation x is also stored in c.yaml"}
+
+	store := adaptermocks.NewMockReportStore(t)
+	store.On("CheckIntegrity", m.Path("reports")).
+		Return(m.IntegrityReport{Dir: "reports", Reports: 3, Problems: []m.IntegrityProblem{corrupt, collision}}, nil).Once()
+	store.On("RepairIntegrity", m.Path("reports")).Return(nil).Once()
+	store.On("CheckIntegrity", m.Path("reports")).
+		Return(m.IntegrityReport{Dir: "reports", Reports: 2, Problems: []m.IntegrityProblem{collision}}, nil).Once()
+
+	mockUI := new(controllermocks.MockUI)
+	mockUI.On("DisplayIntegrity", m.IntegrityReport{
+		Dir:      "reports",
+		Reports:  2,
+		Problems: []m.IntegrityProblem{collision},
+		Repaired: true,
+		Fixed:    []m.IntegrityProblem{corrupt},
+	}, nil).Return(nil)
+
+	workflow := domain.NewWorkflow(
+		domain.WithReportStore(store),
+		domain.WithUI(mockUI),
+	)
+
+	err := workflow.VerifyReports(domain.VerifyReportsArgs{Reports: "reports", Fix: true})
+	require.Error(t, err)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_VerifyReports_CheckError(t *testing.T) {
+	store := adaptermocks.NewMockReportStore(t)
+	store.On("CheckIntegrity", m.Path("missing")).Return(m.IntegrityReport{}, errors.New("file does not exist"))
+
+	workflow := domain.NewWorkflow(
+		domain.WithReportStore(store),
+		domain.WithUI(new(controllermocks.MockUI)),
+	)
+
+	err := workflow.VerifyReports(domain.VerifyReportsArgs{Reports: "missing"})
+	require.ErrorContains(t, err, "check reports: file does not exist")
+}
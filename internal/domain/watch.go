@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// DefaultWatchDebounce is how long Watch waits after a change for further
+// ones, so that saving several files at once tests them in one cycle.
+const DefaultWatchDebounce = 300 * time.Millisecond
+
+// WatchArgs contains the arguments for testing mutations again whenever the
+// sources change.
+type WatchArgs struct {
+	TestArgs
+	// Watcher reports the changed files. Watch adds the directories of the
+	// sources to it and closes it when done.
+	Watcher adapter.FileWatcher
+	// Debounce is how long to wait after a change for further ones; zero
+	// means DefaultWatchDebounce.
+	Debounce time.Duration
+}
+
+// Watch runs the mutation tests, then tests again each time files change
+// until args.Stop is closed or the user quits the UI, which also interrupts
+// a cycle under way. Every cycle uses the
+// report cache, so only the sources or tests that changed since their
+// reports were saved are mutated, and their new reports are merged into
+// args.Reports. A failing first run is returned; later failures, such as a
+// build broken mid-edit, are shown and watching goes on.
+func (w *workflow) Watch(args WatchArgs) error {
+	defer args.Watcher.Close()
+
+	if args.narrowed() {
+		return errors.New("watch cannot narrow mutations with func, scope, lines, sample or max mutations: narrowed runs are not saved, so every cycle would test everything again")
+	}
+
+	debounce := args.Debounce
+	if debounce <= 0 {
+		debounce = DefaultWatchDebounce
+	}
+
+	args.UseCache = true
+
+	if err := w.startTestUI(args.Reports, !args.RedactCode); err != nil {
+		return err
+	}
+	defer w.Close()
+
+	dirs, err := w.watchDirs(args.EstimateArgs)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if err := args.Watcher.Add(dir); err != nil {
+			return err
+		}
+	}
+
+	// A run aborted from the UI ends its cycle only; watching goes on.
+	err = w.runTests(args.TestArgs)
+	if err != nil && !w.abortedFromUI(err) {
+		return err
+	}
+
+	w.DisplayWatchCycle(nil, err)
+
+	for {
+		changed, err := w.nextChanges(args, debounce)
+		if err != nil || changed == nil {
+			return err
+		}
+
+		w.DisplayWatchCycle(changed, w.runTests(args.TestArgs))
+	}
+}
+
+// watchDirs lists the directories holding the sources args select.
+func (w *workflow) watchDirs(args EstimateArgs) ([]m.Path, error) {
+	sources, err := w.Get(args.Paths, args.Exclude...)
+	if err != nil {
+		return nil, fmt.Errorf("get sources: %w", err)
+	}
+
+	seen := make(map[m.Path]bool)
+	dirs := make([]m.Path, 0)
+
+	for _, source := range sources {
+		if source.Origin == nil {
+			continue
+		}
+
+		dir := m.Path(filepath.Dir(string(source.Origin.FullPath)))
+		if !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+
+	if len(dirs) == 0 {
+		return nil, fmt.Errorf("%w under %s", ErrNoSources, describePaths(args.Paths))
+	}
+
+	sort.Slice(dirs, func(i, j int) bool { return dirs[i] < dirs[j] })
+
+	return dirs, nil
+}
+
+// nextChanges waits for a change, then collects those following it until
+// none came for debounce, and returns the changed files sorted. It returns
+// nil once watching should end.
+func (w *workflow) nextChanges(args WatchArgs, debounce time.Duration) ([]m.Path, error) {
+	changed := make(map[m.Path]bool)
+
+	var quiet <-chan time.Time
+
+	for {
+		select {
+		case <-args.Stop:
+			return nil, nil
+		case <-w.Done():
+			return nil, nil
+		case err, ok := <-args.Watcher.Errors():
+			if !ok {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("watch files: %w", err)
+		case path, ok := <-args.Watcher.Changes():
+			if !ok {
+				return nil, nil
+			}
+
+			changed[path] = true
+			quiet = time.After(debounce)
+		case <-quiet:
+			paths := make([]m.Path, 0, len(changed))
+			for path := range changed {
+				paths = append(paths, path)
+			}
+
+			sort.Slice(paths, func(i, j int) bool { return paths[i] < paths[j] })
+
+			return paths, nil
+		}
+	}
+}
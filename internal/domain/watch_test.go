@@ -0,0 +1,179 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	domain "github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWatcher is a FileWatcher the test sends changes through.
+type fakeWatcher struct {
+	added   []m.Path
+	changes chan m.Path
+	errors  chan error
+	closed  bool
+}
+
+func newFakeWatcher() *fakeWatcher {
+	return &fakeWatcher{changes: make(chan m.Path, 8), errors: make(chan error, 1)}
+}
+
+func (f *fakeWatcher) Add(dir m.Path) error {
+	f.added = append(f.added, dir)
+	return nil
+}
+
+func (f *fakeWatcher) Changes() <-chan m.Path { return f.changes }
+
+func (f *fakeWatcher) Errors() <-chan error { return f.errors }
+
+func (f *fakeWatcher) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestWorkflow_Watch_TestsAgainOnChange(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadReports(m.Path("reports")).Return(nil, nil)
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	sources := []m.Source{
+		{
+			Origin: &m.File{FullPath: "/project/pkg/calc.go", Hash: "hash1"},
+			Test:   &m.File{FullPath: "/project/pkg/calc_test.go", Hash: "test_hash1"},
+		},
+	}
+	mutations := []m.Mutation{{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic}}
+	broken := errors.New("build failed")
+	watcher := newFakeWatcher()
+	stop := make(chan struct{})
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().Done().Return(nil)
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockUI.EXPECT().DisplayWatchCycle([]m.Path(nil), nil).Run(func(_ []m.Path, _ error) {
+		watcher.changes <- "/project/pkg/calc_test.go"
+		watcher.changes <- "/project/pkg/calc.go"
+	}).Return().Once()
+	mockUI.EXPECT().DisplayWatchCycle([]m.Path{"/project/pkg/calc.go", "/project/pkg/calc_test.go"}, mock.Anything).Run(func(_ []m.Path, err error) {
+		assert.ErrorIs(t, err, broken)
+		close(stop)
+	}).Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockReportStore.EXPECT().CheckUpdates(m.Path("reports"), sources).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Once()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(broken).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil).Once()
+	mockReportStore.EXPECT().SaveReports(m.Path("reports"), mock.Anything).Return(nil).Once()
+	mockReportStore.EXPECT().RegenerateIndex(m.Path("reports")).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Watch(domain.WatchArgs{
+		TestArgs: domain.TestArgs{
+			EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"./..."}, Reports: "reports"},
+			Reports:      "reports",
+			Threads:      1,
+			Stop:         stop,
+		},
+		Watcher:  watcher,
+		Debounce: time.Millisecond,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []m.Path{"/project/pkg"}, watcher.added)
+	assert.True(t, watcher.closed)
+	mockUI.AssertExpectations(t)
+	mockOrchestrator.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestWorkflow_Watch_ReturnsFirstRunError(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+	watcher := newFakeWatcher()
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(nil, nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Watch(domain.WatchArgs{
+		TestArgs: domain.TestArgs{EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"./..."}}},
+		Watcher:  watcher,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrNoSources)
+	assert.True(t, watcher.closed)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_Watch_RejectsNarrowedRuns(t *testing.T) {
+	// Arrange
+	mockUI := new(controllermocks.MockUI)
+	watcher := newFakeWatcher()
+
+	wf := domain.NewWorkflow(
+		domain.WithUI(mockUI),
+	)
+
+	// Act
+	err := wf.Watch(domain.WatchArgs{
+		TestArgs: domain.TestArgs{EstimateArgs: domain.EstimateArgs{Func: "Add"}},
+		Watcher:  watcher,
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "narrow")
+	assert.True(t, watcher.closed)
+	mockUI.AssertNotCalled(t, "Start", mock.Anything)
+}
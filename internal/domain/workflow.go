@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/mouse-blink/gooze/internal/adapter"
 	"github.com/mouse-blink/gooze/internal/controller"
@@ -29,15 +31,138 @@ type EstimateArgs struct {
 	Exclude  []string
 	UseCache bool
 	Reports  m.Path
+	// IncludeTestHelpers keeps files detected as test helpers (non-test files
+	// importing testing or assertion libraries), which are skipped by default.
+	IncludeTestHelpers bool
+	// Func, when set, is a regular expression narrowing mutations to the
+	// functions whose name (Name or Receiver.Name) it matches.
+	Func string
+	// Scope, when set, narrows mutations to the exported or unexported
+	// functions and methods.
+	Scope CodeScope
+	// Lines, when set, narrows the mutations of the single file in Paths to
+	// those starting in the range.
+	Lines LineRange
+	// Tags are extra build tags, as for go build -tags. Files whose
+	// //go:build line does not hold with them are skipped.
+	Tags []string
+	// Sample, when between 0 and 1, keeps about that fraction of the
+	// mutations, and MaxMutations, when positive, keeps at most that many.
+	// Both pick by a hash of the mutation ID and SampleSeed, so repeated
+	// runs test the same mutations.
+	Sample       float64
+	MaxMutations int
+	SampleSeed   string
+	// Race adds the concurrency mutations and, for test runs, runs every
+	// test with the race detector, so kills by a detected data race are told
+	// apart from assertion kills.
+	Race bool
+	// Runtime, when set, makes an estimate also project the run's duration
+	// from timed baseline tests. Test runs ignore it.
+	Runtime *RuntimeArgs
+}
+
+// narrowed reports whether the arguments select only part of the mutations
+// of the files they cover. Narrowed runs bypass the cache and are not saved.
+func (args EstimateArgs) narrowed() bool {
+	return args.Func != "" || args.Scope != "" || !args.Lines.isZero() ||
+		(args.Sample > 0 && args.Sample < 1) ||
+		args.MaxMutations > 0
 }
 
 // TestArgs contains the arguments for running mutation tests.
 type TestArgs struct {
 	EstimateArgs
-	Reports         m.Path
+	Reports m.Path
+	// Threads is the number of parallel workers; zero picks one from the
+	// CPU count and available memory.
 	Threads         int
 	ShardIndex      int
 	TotalShardCount int
+	// ShardIndexes, when set, tests several shards of TotalShardCount in one
+	// run instead of ShardIndex alone. Their mutations share the workers, and
+	// each shard's reports are saved to its own directory, as separate runs
+	// of the shards would save them.
+	ShardIndexes []int
+	// plan, set by Exec, restricts the run to the mutations of a plan.
+	plan *m.MutationPlan
+	// ShardStrategy selects how mutations are split across shards; empty
+	// means ShardStrategyHash.
+	ShardStrategy ShardStrategy
+	// JUnitOut, when set, is where a JUnit XML copy of the run's results is written.
+	JUnitOut m.Path
+	// DiagnosticsOut, when set, is where the run's survived mutations are
+	// written as diagnostics for editors and reviewdog, in DiagnosticsFormat;
+	// empty means m.DiagnosticsRDJSON.
+	DiagnosticsOut    m.Path
+	DiagnosticsFormat m.DiagnosticsFormat
+	// Timeout is the base test budget per mutation; zero means
+	// DefaultTestTimeout. TimeoutMultipliers scale it per mutation type name,
+	// replacing the entries of DefaultTimeoutMultipliers they name.
+	Timeout            time.Duration
+	TimeoutMultipliers map[string]float64
+	// TestScope selects which tests run against each mutation; empty means
+	// TestScopeFile.
+	TestScope TestScope
+	// RunTemplates, when set, first runs only the tests whose names they
+	// derive from each mutated function; see DefaultRunTemplates.
+	RunTemplates []string
+	// QuarantineAfter skips mutations that timed out in at least this many
+	// runs of the history in Reports, recording them as skipped with a note.
+	// Zero disables the quarantine.
+	QuarantineAfter int
+	// MaxDuration, when positive, bounds the run: mutations on covered lines
+	// are tested first, and once it has elapsed no new mutation starts; the
+	// rest are recorded as NotRun and the score is partial.
+	MaxDuration time.Duration
+	// DiskBudget bounds the bytes the per-worker project copies may take;
+	// Threads is lowered until they fit. DiskBudgetAuto uses the free space of
+	// WorkDir and zero disables the check.
+	DiskBudget int64
+	// WorkDir is where the per-worker project copies are created; empty
+	// means the system temp directory.
+	WorkDir m.Path
+	// Stop interrupts the run when closed, as closing the UI does: no
+	// further mutation starts, those being tested finish, and the results
+	// so far are saved with the rest recorded as NotRun. The run then
+	// returns ErrInterrupted. It is a channel, like the UI's Done, rather
+	// than a context: it only keeps mutations from starting and never
+	// cancels the go test runs under way.
+	Stop <-chan struct{}
+	// DetectFlaky re-runs the tests without the mutation after each kill and
+	// records the mutation as Flaky when they fail again.
+	DetectFlaky bool
+	// CountCompileErrors records mutants that do not compile as killed by a
+	// build failure, counting them in the score, instead of as CompileError.
+	CountCompileErrors bool
+	// Schemata compiles the mutations of each file into one mutant schema,
+	// switching between them at run time, so the file is built once for
+	// all of them instead of once per mutation. Mutations that cannot be
+	// compiled in are tested from their own mutated file.
+	Schemata bool
+	// GoTestArgs are extra go test flags, such as -count=1 or -shuffle=on,
+	// for every test run against the mutations and their baseline.
+	GoTestArgs []string
+	// Backend tests the mutations; nil tests them locally with the
+	// workflow's Orchestrator. Baselines and coverage always run locally.
+	Backend ExecutionBackend
+	// RedactCode keeps code out of the saved reports, the JUnit export and
+	// the diagnostics: survivors are stored without their diffs, and reports
+	// cached from earlier runs are rewritten the same way.
+	RedactCode bool
+	ManifestArgs
+}
+
+// runOptions returns the settings of the run args describes that apply to
+// the tests of all its mutations.
+func (args TestArgs) runOptions() RunOptions {
+	return RunOptions{
+		DetectFlaky:        args.DetectFlaky,
+		CountCompileErrors: args.CountCompileErrors,
+		GoTestArgs:         args.GoTestArgs,
+		Race:               args.Race,
+		WorkDir:            args.WorkDir,
+	}
 }
 
 // ViewArgs contains the arguments for viewing mutation test reports.
@@ -48,14 +173,35 @@ type ViewArgs struct {
 // MergeArgs contains the arguments for merging sharded mutation test reports.
 type MergeArgs struct {
 	Reports m.Path
+	ManifestArgs
 }
 
 // Workflow defines the interface for the mutation testing workflow.
 type Workflow interface {
 	Estimate(args EstimateArgs) error
+	DryRun(args DryRunArgs) error
+	Plan(args PlanArgs) error
 	Test(args TestArgs) error
+	Exec(args ExecArgs) error
 	View(args ViewArgs) error
 	Merge(args MergeArgs) error
+	CorpusReport(args CorpusArgs) error
+	Stats(args StatsArgs) error
+	Cache(args CacheArgs) error
+	VerifyReports(args VerifyReportsArgs) error
+	Badge(args BadgeArgs) error
+	Trend(args TrendArgs) error
+	CompareReports(args CompareArgs) error
+	Notify(args NotifyArgs) error
+	E2E(args E2EArgs) error
+	LSP(args LSPArgs) error
+	Watch(args WatchArgs) error
+	MarkEquivalent(args MarkEquivalentArgs) error
+	Apply(args ApplyArgs) error
+	Revert(args RevertArgs) error
+	Worker(args WorkerArgs) error
+	Mutate(args MutateArgs) error
+	Kubernetes(args KubernetesArgs) error
 }
 
 type workflow struct {
@@ -64,23 +210,43 @@ type workflow struct {
 	controller.UI
 	Orchestrator
 	Mutagen
+
+	// checker, if set, rejects mutations that cannot compile before they
+	// are tested.
+	checker MutantChecker
+	// schemata compiles the mutations of a file together for runs with
+	// Schemata set.
+	schemata SchemaBuilder
+
+	// threads, mutators and timeout are the defaults WithThreads,
+	// WithMutators and WithTimeout set.
+	threads  int
+	mutators []m.MutationType
+	timeout  time.Duration
+
+	// details backs the UI's on-demand loading of diffs and test output for
+	// the current run.
+	details *resultDetails
+	// pause holds back mutations while the UI has paused the run; abort
+	// stops the run when the UI asks to.
+	pause *pauseGate
+	abort *runAbort
+	// stream saves the current run's reports as its mutations complete.
+	stream *shardStreams
 }
 
-// NewWorkflow creates a new WorkflowV2 instance with the provided dependencies.
-func NewWorkflow(
-	fsAdapter adapter.SourceFSAdapter,
-	reportStore adapter.ReportStore,
-	ui controller.UI,
-	orchestrator Orchestrator,
-	mutagen Mutagen,
-) Workflow {
-	return &workflow{
-		SourceFSAdapter: fsAdapter,
-		ReportStore:     reportStore,
-		UI:              ui,
-		Orchestrator:    orchestrator,
-		Mutagen:         mutagen,
+// NewWorkflow creates a new Workflow instance configured by opts. The
+// dependencies no option provides are the local ones, with progress
+// discarded.
+func NewWorkflow(opts ...WorkflowOption) Workflow {
+	w := &workflow{}
+	for _, opt := range opts {
+		opt(w)
 	}
+
+	w.withDefaultDependencies()
+
+	return w
 }
 
 func (w *workflow) Estimate(args EstimateArgs) error {
@@ -94,12 +260,22 @@ func (w *workflow) Estimate(args EstimateArgs) error {
 		return fmt.Errorf("generate mutations: %w", err)
 	}
 
+	allMutations, _ = withoutSuppressed(allMutations)
+
 	err = w.DisplayEstimation(allMutations, nil)
 	if err != nil {
 		w.Close()
 		return fmt.Errorf("display: %w", err)
 	}
 
+	if args.Runtime != nil {
+		estimate, err := w.estimateRuntime(allMutations, *args.Runtime)
+		if err = w.DisplayRuntimeEstimate(estimate, err); err != nil {
+			w.Close()
+			return fmt.Errorf("estimate runtime: %w", err)
+		}
+	}
+
 	// Wait for UI to be closed by user (press 'q')
 	w.Wait()
 	w.Close()
@@ -108,38 +284,285 @@ func (w *workflow) Estimate(args EstimateArgs) error {
 }
 
 func (w *workflow) Test(args TestArgs) error {
-	return w.withTestUI(func() error {
-		w.DisplayConcurrencyInfo(args.Threads, args.ShardIndex, args.TotalShardCount)
+	reportsDir := shardReportsDir(args.Reports, args.ShardIndex, args.TotalShardCount)
+	if len(args.ShardIndexes) > 1 {
+		// The survivors are saved to several shard directories, so the UI
+		// has no single directory to mark them equivalent in.
+		reportsDir = ""
+	}
+
+	// Narrowed and redacted runs do not save their diffs and test output,
+	// so those are shown with each result instead.
+	saved := reportsDir != "" && !args.narrowed() && !args.RedactCode
+
+	return w.withTestUI(reportsDir, saved, func() error {
+		return w.runTests(args)
+	})
+}
+
+// runTests tests the mutations args select, reporting progress to the
+// started UI, and saves the results.
+func (w *workflow) runTests(args TestArgs) error {
+	args = w.withOptionDefaults(args)
+	w.abort.reset()
+
+	var deadline time.Time
+	if args.MaxDuration > 0 {
+		deadline = time.Now().Add(args.MaxDuration)
+	}
+
+	multipliers, err := timeoutMultipliers(args.TimeoutMultipliers)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTestScope(args.TestScope); err != nil {
+		return err
+	}
+
+	if err := validateRunTemplates(args.RunTemplates); err != nil {
+		return err
+	}
+
+	if err := validateMaxDuration(args.MaxDuration); err != nil {
+		return err
+	}
+
+	if err := validateDiagnosticsFormat(args.DiagnosticsFormat); err != nil {
+		return err
+	}
+
+	if err := validateGoTestArgs(args.GoTestArgs); err != nil {
+		return err
+	}
+
+	if err := validateShardStrategy(args.ShardStrategy); err != nil {
+		return err
+	}
+
+	if err := validateShardIndexes(args); err != nil {
+		return err
+	}
+
+	threads := resolveThreads(args.Threads)
+	w.DisplayConcurrencyInfo(threads, args.ShardIndex, args.TotalShardCount)
+
+	allMutations, err := w.GetMutations(args.EstimateArgs)
+	if err != nil {
+		return fmt.Errorf("generate mutations: %w", err)
+	}
+
+	allMutations, err = withPlan(allMutations, args.plan)
+	if err != nil {
+		return err
+	}
+
+	if err := requireTests(allMutations, args.TestScope); err != nil {
+		return err
+	}
+
+	shardMutations, split, err := w.shardMutations(allMutations, args)
+	if err != nil {
+		return err
+	}
 
-		reportsDir := shardReportsDir(args.Reports, args.ShardIndex, args.TotalShardCount)
+	shardMutations = withTestScope(withTimeouts(shardMutations, args.Timeout, multipliers), args.TestScope)
+	shardMutations = withRunPatterns(shardMutations, args.RunTemplates)
 
-		allMutations, err := w.GetMutations(args.EstimateArgs)
+	if args.TestScope == TestScopeDependents {
+		shardMutations, err = w.withDependents(shardMutations, args.Tags)
 		if err != nil {
-			return fmt.Errorf("generate mutations: %w", err)
+			return err
 		}
+	}
+
+	quarantined, err := w.quarantinedMutations(args.Reports, args.QuarantineAfter)
+	if err != nil {
+		return err
+	}
+
+	equivalents, err := w.equivalentMutations(args.Reports)
+	if err != nil {
+		return err
+	}
+
+	shardMutations, suppressedReports := withoutSuppressed(shardMutations)
+	shardMutations, equivalentReports := withoutEquivalents(shardMutations, equivalents)
+	shardMutations, quarantinedReports := withoutQuarantined(shardMutations, quarantined)
 
-		shardMutations := w.ShardMutations(allMutations, args.ShardIndex, args.TotalShardCount)
-		w.DisplayUpcomingTestsInfo(len(shardMutations))
+	var (
+		cachedMutations []m.Mutation
+		cachedReports   []m.Report
+	)
 
-		reports, err := w.TestReports(shardMutations, args.Threads)
+	// Like GetChangedSources, narrowed runs test everything they select.
+	if args.UseCache && !args.narrowed() && args.Reports != "" && len(shardMutations) > 0 {
+		cached, err := w.cachedResults(args.Reports)
 		if err != nil {
-			return fmt.Errorf("run mutation tests: %w", err)
+			return err
 		}
 
-		w.DisplayMutationScore(mutationScoreFromReports(reports))
+		shardMutations, cachedMutations, cachedReports = withoutCached(shardMutations, cached, args.CountCompileErrors)
+	}
+
+	shardMutations, uncompilableReports := w.withoutUncompilable(shardMutations, args.CountCompileErrors)
+	shardMutations = w.withSchemata(shardMutations, args.Schemata)
 
-		err = w.SaveReports(reportsDir, reports)
+	runOpts := args.runOptions()
+	w.SetRunOptions(runOpts)
+
+	if err := w.Baseline(shardMutations); err != nil {
+		return err
+	}
+
+	if args.MaxDuration > 0 {
+		shardMutations = w.coveredFirst(shardMutations, args.Tags, args.MaxDuration)
+	}
+
+	diskBudget := args.DiskBudget
+	if args.Backend != nil {
+		// Workers copy the project on their own disks.
+		diskBudget = 0
+	}
+
+	fitted, err := w.fitDiskBudget(shardMutations, threads, diskBudget, args.WorkDir)
+	if err != nil {
+		return err
+	}
+
+	if fitted < threads {
+		threads = fitted
+		w.DisplayConcurrencyInfo(threads, args.ShardIndex, args.TotalShardCount)
+	}
+
+	w.DisplayUpcomingTestsInfo(len(shardMutations))
+
+	// A run narrowed with Func, Scope, Lines or sampling covers only part of
+	// its files, so it must not replace their reports or count as a run in
+	// the history.
+	if !args.narrowed() {
+		w.stream, err = startShardStreams(w, args, split, shardMutations)
 		if err != nil {
-			return fmt.Errorf("save reports: %w", err)
+			return fmt.Errorf("save pending sources: %w", err)
 		}
 
-		err = w.RegenerateIndex(reportsDir)
+		defer func() { w.stream = nil }()
+	}
+
+	reports, err := w.testReports(w.executionBackend(args.Backend), shardMutations, threads, runOpts, deadline, args.Stop)
+	if err != nil {
+		errs := []error{fmt.Errorf("run mutation tests: %w", err)}
+		for _, shard := range args.shardIndexes() {
+			errs = append(errs, w.saveInfraFailure(shardReportsDir(args.Reports, shard, args.TotalShardCount), err))
+		}
+
+		return errors.Join(errs...)
+	}
+
+	reports = append(reports, suppressedReports...)
+	reports = append(reports, equivalentReports...)
+	reports = append(reports, quarantinedReports...)
+	reports = append(reports, cachedReports...)
+	reports = append(reports, uncompilableReports...)
+	reports = withMergedFrom(reports, allMutations)
+
+	interrupted := w.interrupted(args.Stop)
+
+	if notRun := countStatus(reports, m.NotRun); notRun > 0 {
+		if interrupted {
+			w.DisplayInterrupted(notRun)
+		} else {
+			w.DisplayBudgetExhausted(args.MaxDuration, notRun)
+		}
+	}
+
+	if len(cachedReports) > 0 {
+		w.DisplayCachedResults(len(cachedReports))
+	}
+
+	w.DisplayMutationScore(mutationScoreFromReports(reports))
+
+	if args.RedactCode {
+		reports = redactReports(reports)
+	}
+
+	if !args.narrowed() {
+		shards := args.shardIndexes()
+		for _, shard := range shards {
+			shardArgs, shardReports := args, reports
+			if len(shards) > 1 {
+				shardArgs.ShardIndex, shardArgs.ShardIndexes = shard, nil
+				shardReports = split.reports(reports, shard)
+			}
+
+			reportsDir := shardReportsDir(args.Reports, shard, args.TotalShardCount)
+			if err := w.saveRun(shardArgs, reportsDir, shardReports); err != nil {
+				return err
+			}
+		}
+	}
+
+	if args.JUnitOut != "" {
+		err = w.ExportJUnit(args.JUnitOut, reports)
 		if err != nil {
-			return fmt.Errorf("regenerate index: %w", err)
+			return fmt.Errorf("export junit report: %w", err)
 		}
+	}
 
-		return nil
-	})
+	if args.DiagnosticsOut != "" {
+		diagnostics := survivorDiagnostics(append(shardMutations, cachedMutations...), reports)
+		if args.RedactCode {
+			diagnostics = redactDiagnostics(diagnostics)
+		}
+
+		err = w.ExportDiagnostics(args.DiagnosticsOut, args.DiagnosticsFormat, diagnostics)
+		if err != nil {
+			return fmt.Errorf("export diagnostics: %w", err)
+		}
+	}
+
+	if interrupted {
+		return ErrInterrupted
+	}
+
+	return nil
+}
+
+// saveRun stores the run's reports not streamed yet, refreshes the index,
+// records the run in the history and writes the manifest describing the
+// directory. The pending sources are cleared last, once the directory is
+// complete.
+func (w *workflow) saveRun(args TestArgs, reportsDir m.Path, reports []m.Report) error {
+	if err := w.SaveReports(reportsDir, w.stream.unsaved(reports)); err != nil {
+		return fmt.Errorf("save reports: %w", err)
+	}
+
+	if args.RedactCode {
+		if err := w.redactStoredReports(reportsDir); err != nil {
+			return err
+		}
+	}
+
+	if err := w.RegenerateIndex(reportsDir); err != nil {
+		return fmt.Errorf("regenerate index: %w", err)
+	}
+
+	// Sharded runs are recorded once their reports are merged.
+	if args.TotalShardCount <= 1 {
+		if err := w.recordRun(reportsDir); err != nil {
+			return err
+		}
+	}
+
+	if err := w.saveManifest(reportsDir, args.ManifestArgs, runConfig(args)); err != nil {
+		return err
+	}
+
+	if err := w.SavePending(reportsDir, nil); err != nil {
+		return fmt.Errorf("clear pending sources: %w", err)
+	}
+
+	return nil
 }
 
 func shardReportsDir(base m.Path, shardIndex int, totalShardCount int) m.Path {
@@ -151,7 +574,7 @@ func shardReportsDir(base m.Path, shardIndex int, totalShardCount int) m.Path {
 }
 
 func (w *workflow) View(args ViewArgs) error {
-	return w.withTestUI(func() error {
+	return w.withTestUI(args.Reports, true, func() error {
 		reports, err := w.LoadReports(args.Reports)
 		if err != nil {
 			return fmt.Errorf("load reports: %w", err)
@@ -179,17 +602,15 @@ func mutationScoreFromReports(reports []m.Report) float64 {
 	total := 0
 
 	for _, report := range reports {
-		for _, entries := range report.Result {
-			for _, entry := range entries {
-				switch entry.Status {
-				case m.Killed:
-					killed++
-					total++
-				case m.Survived:
-					total++
-				case m.Skipped, m.Error:
-					// Skipped/error entries are excluded from the score denominator.
-				}
+		for _, entry := range report.Result {
+			switch entry.Status {
+			case m.Killed:
+				killed++
+				total++
+			case m.Survived:
+				total++
+			case m.Skipped, m.Error, m.NotRun, m.Flaky, m.CompileError:
+				// Skipped/error entries are excluded from the score denominator.
 			}
 		}
 	}
@@ -207,16 +628,27 @@ func (w *workflow) Merge(args MergeArgs) error {
 		return fmt.Errorf("reports directory path is required")
 	}
 
+	// Loading the existing reports first also restores a reports directory
+	// the store keeps remotely, shard directories included.
+	existingReports, err := w.loadReportsIfExists(base)
+	if err != nil {
+		return fmt.Errorf("load existing reports from base: %w", err)
+	}
+
 	shardDirs, err := w.findShardDirs(base)
 	if err != nil {
 		return err
 	}
 
 	if len(shardDirs) == 0 {
-		return w.regenerateIndex(base)
+		if err := w.regenerateIndex(base); err != nil {
+			return err
+		}
+
+		return w.saveManifest(base, args.ManifestArgs, mergeConfig(base))
 	}
 
-	merged, err := w.mergeReports(base, shardDirs)
+	merged, err := w.mergeReports(existingReports, shardDirs)
 	if err != nil {
 		return err
 	}
@@ -225,7 +657,15 @@ func (w *workflow) Merge(args MergeArgs) error {
 		return err
 	}
 
-	return w.removeShardDirs(shardDirs)
+	if err := w.removeShardDirs(shardDirs); err != nil {
+		return err
+	}
+
+	if err := w.recordRun(base); err != nil {
+		return err
+	}
+
+	return w.saveManifest(base, args.ManifestArgs, mergeConfig(base))
 }
 
 func (w *workflow) findShardDirs(base m.Path) ([]string, error) {
@@ -245,18 +685,12 @@ func (w *workflow) regenerateIndex(base m.Path) error {
 	return nil
 }
 
-func (w *workflow) mergeReports(base m.Path, shardDirs []string) ([]m.Report, error) {
+// mergeReports adds the reports of every shard to the existing reports of
+// the base directory, which preserves its cache.
+func (w *workflow) mergeReports(existingReports []m.Report, shardDirs []string) ([]m.Report, error) {
 	merged := make([]m.Report, 0)
-
-	// First, load existing reports from base directory to preserve cache.
-	existingReports, err := w.loadReportsIfExists(base)
-	if err != nil {
-		return nil, fmt.Errorf("load existing reports from base: %w", err)
-	}
-
 	merged = append(merged, existingReports...)
 
-	// Then load and merge reports from all shards.
 	for _, shardDir := range shardDirs {
 		reports, err := w.LoadReports(m.Path(shardDir))
 		if err != nil {
@@ -329,73 +763,85 @@ func findShardDirs(baseDir string) ([]string, error) {
 	return shardDirs, nil
 }
 
-func (w *workflow) withTestUI(fn func() error) error {
-	if err := w.Start(controller.WithTestMode()); err != nil {
+func (w *workflow) withTestUI(reports m.Path, saved bool, fn func() error) error {
+	if err := w.startTestUI(reports, saved); err != nil {
 		return err
 	}
 	defer w.Close()
 
 	err := fn()
-	if err != nil {
+	if err != nil && !w.abortedFromUI(err) {
 		return err
 	}
 
 	// Wait for UI to be closed by user (press 'q')
 	w.Wait()
 
-	return nil
+	return err
 }
 
-func viewItemsFromReports(reports []m.Report) ([]m.Mutation, []m.Result) {
-	mutations := make([]m.Mutation, 0)
-	results := make([]m.Result, 0)
+// startTestUI starts the UI showing test progress, with survivors' source
+// loaded on demand, survivors marked as equivalent in reports and the run
+// paused, resumed and aborted. When saved, the results' diffs and test
+// output are saved in full to reports, so they are loaded from there on
+// demand too.
+func (w *workflow) startTestUI(reports m.Path, saved bool) error {
+	w.details = nil
+	if saved {
+		w.details = newResultDetails(w, reports)
+	}
 
-	for _, report := range reports {
-		if len(report.Result) == 0 {
-			continue
-		}
+	w.pause = &pauseGate{}
+	w.abort = &runAbort{}
 
-		mutationTypes := make([]m.MutationType, 0, len(report.Result))
-		for mutationType := range report.Result {
-			mutationTypes = append(mutationTypes, mutationType)
+	var marker controller.EquivalentMarker
+	if reports != "" {
+		marker = func(mutationID string) error {
+			return w.markEquivalent(reports, mutationID)
 		}
+	}
+
+	options := []controller.StartOption{controller.WithTestMode(), controller.WithSourceLoader(w.loadSource), controller.WithEquivalentMarker(marker), controller.WithPauser(w.pause.set), controller.WithAborter(w.abort.abort)}
+	if w.details != nil {
+		options = append(options, controller.WithDetailsLoader(w.details.load))
+	}
+
+	return w.Start(options...)
+}
+
+// loadSource reads a mutated file for the UI to show survivors in context.
+func (w *workflow) loadSource(path string) ([]byte, error) {
+	return w.ReadFile(m.Path(path))
+}
 
-		sort.Slice(mutationTypes, func(i, j int) bool {
-			if mutationTypes[i].Name != mutationTypes[j].Name {
-				return mutationTypes[i].Name < mutationTypes[j].Name
+func viewItemsFromReports(reports []m.Report) ([]m.Mutation, []m.MutationResult) {
+	mutations := make([]m.Mutation, 0)
+	results := make([]m.MutationResult, 0)
+
+	for _, report := range reports {
+		entries := make(m.Result, len(report.Result))
+		copy(entries, report.Result)
+
+		sort.SliceStable(entries, func(i, j int) bool {
+			if entries[i].Type.Name != entries[j].Type.Name {
+				return entries[i].Type.Name < entries[j].Type.Name
 			}
 
-			return mutationTypes[i].Version < mutationTypes[j].Version
+			return entries[i].Type.Version < entries[j].Type.Version
 		})
 
-		for _, mutationType := range mutationTypes {
-			entries := report.Result[mutationType]
-			for _, entry := range entries {
-				mutation := m.Mutation{
-					ID:     entry.MutationID,
-					Source: report.Source,
-					Type:   mutationType,
-				}
-				if entry.Status == m.Survived && report.Diff != nil {
-					mutation.DiffCode = *report.Diff
-				}
-
-				result := m.Result{}
-				result[mutationType] = []struct {
-					MutationID string
-					Status     m.TestStatus
-					Err        error
-				}{
-					{
-						MutationID: entry.MutationID,
-						Status:     entry.Status,
-						Err:        entry.Err,
-					},
-				}
-
-				mutations = append(mutations, mutation)
-				results = append(results, result)
+		for _, entry := range entries {
+			mutation := m.Mutation{
+				ID:     entry.MutationID,
+				Source: report.Source,
+				Type:   entry.Type,
+			}
+			if entry.Status == m.Survived && report.Diff != nil {
+				mutation.DiffCode = *report.Diff
 			}
+
+			mutations = append(mutations, mutation)
+			results = append(results, entry)
 		}
 	}
 
@@ -403,26 +849,87 @@ func viewItemsFromReports(reports []m.Report) ([]m.Mutation, []m.Result) {
 }
 
 func (w *workflow) GetMutations(args EstimateArgs) ([]m.Mutation, error) {
+	if err := validateSample(args.Sample, args.MaxMutations); err != nil {
+		return nil, err
+	}
+
+	if err := validateCodeScope(args.Scope); err != nil {
+		return nil, err
+	}
+
+	if err := validateLineRange(args.Lines, args.Paths); err != nil {
+		return nil, err
+	}
+
 	sources, err := w.Get(args.Paths, args.Exclude...)
 	if err != nil {
 		return nil, fmt.Errorf("get sources: %w", err)
 	}
 
+	if !args.IncludeTestHelpers {
+		sources = withoutTestHelpers(sources)
+	}
+
+	sources = withBuildTags(sources, args.Tags)
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("%w under %s", ErrNoSources, describePaths(args.Paths))
+	}
+
 	changedSSources, err := w.GetChangedSources(args, sources)
 	if err != nil {
 		return nil, fmt.Errorf("get changed sources: %w", err)
 	}
 
-	allMutations, err := w.GenerateAllMutations(changedSSources)
+	allMutations, err := w.GenerateAllMutations(changedSSources, mutationTypes(w.mutators, args.Race)...)
 	if err != nil {
 		return nil, fmt.Errorf("generate mutations: %w", err)
 	}
 
-	return allMutations, nil
+	if args.Func != "" {
+		allMutations, err = withFuncMatching(allMutations, args.Func)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	allMutations = withCodeScope(allMutations, args.Scope)
+	allMutations = withLineRange(allMutations, args.Lines)
+
+	return withSample(allMutations, args.Sample, args.MaxMutations, args.SampleSeed), nil
+}
+
+func withFuncMatching(mutations []m.Mutation, pattern string) ([]m.Mutation, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid func pattern %q: %w", pattern, err)
+	}
+
+	kept := make([]m.Mutation, 0, len(mutations))
+
+	for _, mutation := range mutations {
+		if mutation.Func != "" && re.MatchString(mutation.Func) {
+			kept = append(kept, mutation)
+		}
+	}
+
+	return kept, nil
+}
+
+func withoutTestHelpers(sources []m.Source) []m.Source {
+	kept := make([]m.Source, 0, len(sources))
+
+	for _, source := range sources {
+		if !source.TestHelper {
+			kept = append(kept, source)
+		}
+	}
+
+	return kept
 }
 
 func (w *workflow) GetChangedSources(args EstimateArgs, sources []m.Source) ([]m.Source, error) {
-	if !args.UseCache {
+	// Narrowed runs are not saved, so cached reports never cover them.
+	if !args.UseCache || args.narrowed() {
 		return sources, nil
 	}
 
@@ -437,6 +944,7 @@ func (w *workflow) GetChangedSources(args EstimateArgs, sources []m.Source) ([]m
 
 	currentByPath := w.buildSourcePathMap(sources)
 	deleted, changedExisting := w.separateDeletedAndChanged(changed, currentByPath)
+	deleted = withinPaths(deleted, args.Paths)
 
 	if len(deleted) > 0 {
 		if err := w.CleanReports(args.Reports, deleted); err != nil {
@@ -478,13 +986,13 @@ func (w *workflow) separateDeletedAndChanged(changed []m.Source, currentByPath m
 	return deleted, changedExisting
 }
 
-func (w *workflow) GenerateAllMutations(sources []m.Source) ([]m.Mutation, error) {
+func (w *workflow) GenerateAllMutations(sources []m.Source, mutationTypes ...m.MutationType) ([]m.Mutation, error) {
 	mutationsIndex := 0
 
 	var allMutations []m.Mutation
 
 	for _, source := range sources {
-		mutations, err := w.GenerateMutation(source, DefaultMutations...)
+		mutations, err := w.GenerateMutation(source, mutationTypes...)
 		if err != nil {
 			return nil, err
 		}
@@ -521,8 +1029,24 @@ func (w *workflow) ShardMutations(allMutations []m.Mutation, shardIndex int, tot
 }
 
 func (w *workflow) TestReports(allMutations []m.Mutation, threads int) ([]m.Report, error) {
+	return w.testReports(NewLocalBackend(w.Orchestrator), allMutations, threads, RunOptions{}, time.Time{}, nil)
+}
+
+// executionBackend returns backend, or the local one when it is nil.
+func (w *workflow) executionBackend(backend ExecutionBackend) ExecutionBackend {
+	if backend == nil {
+		return NewLocalBackend(w.Orchestrator)
+	}
+
+	return backend
+}
+
+// testReports tests the mutations with backend, prepared with opts; with a
+// non-zero deadline, mutations not started by then are recorded as NotRun
+// instead, as are those not started once stop is closed or the UI is.
+func (w *workflow) testReports(backend ExecutionBackend, allMutations []m.Mutation, threads int, opts RunOptions, deadline time.Time, stop <-chan struct{}) ([]m.Report, error) {
 	reports := []m.Report{}
-	errors := []error{}
+	mutationErrors := []error{}
 
 	effectiveThreads := threads
 	if effectiveThreads <= 0 {
@@ -535,27 +1059,44 @@ func (w *workflow) TestReports(allMutations []m.Mutation, threads int) ([]m.Repo
 		threadIDCounter int32 = -1
 	)
 
+	if err := backend.Prepare(allMutations, effectiveThreads, opts); err != nil {
+		return reports, fmt.Errorf("prepare workspaces: %w", err)
+	}
+
+	defer releaseBackend(backend)
+
 	var group errgroup.Group
 	group.SetLimit(effectiveThreads)
 
 	for _, mutation := range allMutations {
 		currentMutation := mutation
-		group.Go(w.processMutation(currentMutation, &threadIDCounter, effectiveThreads, &reportsMutex, &errorsMutex, &reports, &errors))
+		group.Go(w.processMutation(backend, currentMutation, deadline, stop, &threadIDCounter, effectiveThreads, &reportsMutex, &errorsMutex, &reports, &mutationErrors))
 	}
 
 	if err := group.Wait(); err != nil {
 		return reports, err
 	}
 
-	if len(errors) == 0 {
+	if len(mutationErrors) == 0 {
 		return reports, nil
 	}
 
-	return reports, fmt.Errorf("errors occurred during mutation testing: %v", errors)
+	return reports, fmt.Errorf("errors occurred during mutation testing: %w", errors.Join(mutationErrors...))
+}
+
+// releaseBackend removes the run's workspaces; like the per-mutation
+// temp dirs, a leftover workspace does not fail the run.
+func releaseBackend(backend ExecutionBackend) {
+	if err := backend.Release(); err != nil {
+		_ = err
+	}
 }
 
 func (w *workflow) processMutation(
+	backend ExecutionBackend,
 	currentMutation m.Mutation,
+	deadline time.Time,
+	stop <-chan struct{},
 	threadIDCounter *int32,
 	threads int,
 	reportsMutex *sync.Mutex,
@@ -567,9 +1108,18 @@ func (w *workflow) processMutation(
 		// Assign a thread ID to this goroutine
 		threadID := int(atomic.AddInt32(threadIDCounter, 1)) % threads
 
+		w.waitWhilePaused(stop)
+
+		if note := w.haltNote(deadline, stop); note != "" {
+			w.recordNotRun(currentMutation, note, reportsMutex, reports)
+			return nil
+		}
+
 		w.DisplayStartingTestInfo(currentMutation, threadID)
 
-		mutationResult, err := w.TestMutation(currentMutation)
+		job := <-backend.Submit(currentMutation)
+
+		mutationResult, err := job.Result, job.Err
 		if err != nil {
 			errorsMutex.Lock()
 
@@ -580,11 +1130,21 @@ func (w *workflow) processMutation(
 			return nil
 		}
 
+		// The backend tests exactly one mutation per job, so the result
+		// always belongs to the mutation that was submitted.
+		mutationResult.MutationID = currentMutation.ID
+		mutationResult.Type = currentMutation.Type
+		mutationResult.Line = currentMutation.Position.Line
+
+		if mutationResult.Status == m.Survived {
+			mutationResult.Hint = currentMutation.Hint
+		}
+
 		report := m.Report{
 			Source: currentMutation.Source,
-			Result: mutationResult,
+			Result: m.Result{mutationResult},
 		}
-		if getMutationStatus(mutationResult, currentMutation) == m.Survived {
+		if mutationResult.Status == m.Survived {
 			diff := currentMutation.DiffCode
 			report.Diff = &diff
 		}
@@ -595,26 +1155,28 @@ func (w *workflow) processMutation(
 
 		reportsMutex.Unlock()
 
+		w.stream.add(report)
+		w.details.record(currentMutation, mutationResult)
 		w.DisplayCompletedTestInfo(currentMutation, mutationResult)
 
 		return nil
 	}
 }
 
-func getMutationStatus(result m.Result, mutation m.Mutation) m.TestStatus {
-	entries, ok := result[mutation.Type]
-	if !ok || len(entries) < 1 {
-		return m.Error
-	}
+// recordNotRun adds a NotRun report for a mutation the deadline or an
+// interruption kept from starting. It is still shown as completed so the
+// UI's progress adds up.
+func (w *workflow) recordNotRun(mutation m.Mutation, note string, reportsMutex *sync.Mutex, reports *[]m.Report) {
+	result := notRunResult(mutation, note)
 
-	for _, entry := range entries {
-		if entry.MutationID == mutation.ID {
-			return entry.Status
-		}
-	}
+	reportsMutex.Lock()
+
+	report := m.Report{Source: mutation.Source, Result: m.Result{result}}
+	*reports = append(*reports, report)
+
+	reportsMutex.Unlock()
+
+	w.stream.add(report)
 
-	// If the orchestrator returned entries for a different mutation ID, do not
-	// guess: treating it as an error avoids inflating the score and attaching an
-	// incorrect diff.
-	return m.Error
+	w.DisplayCompletedTestInfo(mutation, result)
 }
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"io"
+	"slices"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/controller"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// WorkflowOption configures a workflow built by NewWorkflow.
+type WorkflowOption func(*workflow)
+
+// WithSourceFS finds and reads sources with fsAdapter.
+func WithSourceFS(fsAdapter adapter.SourceFSAdapter) WorkflowOption {
+	return func(w *workflow) {
+		w.SourceFSAdapter = fsAdapter
+	}
+}
+
+// WithReportStore reads and writes the reports directory with store.
+func WithReportStore(store adapter.ReportStore) WorkflowOption {
+	return func(w *workflow) {
+		w.ReportStore = store
+	}
+}
+
+// WithUI reports progress to ui.
+func WithUI(ui controller.UI) WorkflowOption {
+	return func(w *workflow) {
+		w.UI = ui
+	}
+}
+
+// WithOrchestrator tests mutations with orchestrator.
+func WithOrchestrator(orchestrator Orchestrator) WorkflowOption {
+	return func(w *workflow) {
+		w.Orchestrator = orchestrator
+	}
+}
+
+// WithMutagen generates mutations with mutagen.
+func WithMutagen(mutagen Mutagen) WorkflowOption {
+	return func(w *workflow) {
+		w.Mutagen = mutagen
+	}
+}
+
+// WithMutantChecker drops the mutations checker finds cannot compile before
+// their tests run, reporting them as compile errors. Without it, every
+// mutation is tested.
+func WithMutantChecker(checker MutantChecker) WorkflowOption {
+	return func(w *workflow) {
+		w.checker = checker
+	}
+}
+
+// WithSchemaBuilder compiles mutant schemata with builder in runs with
+// Schemata set, instead of type-checking them locally.
+func WithSchemaBuilder(builder SchemaBuilder) WorkflowOption {
+	return func(w *workflow) {
+		w.schemata = builder
+	}
+}
+
+// WithThreads tests threads mutations at a time in runs whose TestArgs
+// leave Threads unset.
+func WithThreads(threads int) WorkflowOption {
+	return func(w *workflow) {
+		w.threads = threads
+	}
+}
+
+// WithMutators generates mutations of types instead of DefaultMutations.
+func WithMutators(types ...m.MutationType) WorkflowOption {
+	return func(w *workflow) {
+		w.mutators = slices.Clone(types)
+	}
+}
+
+// WithTimeout gives each mutation timeout, scaled per mutation type, in
+// runs whose TestArgs leave Timeout unset.
+func WithTimeout(timeout time.Duration) WorkflowOption {
+	return func(w *workflow) {
+		w.timeout = timeout
+	}
+}
+
+// withDefaultDependencies fills in the dependencies no option provided with
+// the local ones, and a UI discarding progress.
+func (w *workflow) withDefaultDependencies() {
+	if w.SourceFSAdapter == nil {
+		w.SourceFSAdapter = adapter.NewLocalSourceFSAdapter()
+	}
+
+	if w.ReportStore == nil {
+		w.ReportStore = adapter.NewReportStore()
+	}
+
+	if w.UI == nil {
+		w.UI = controller.NewJSONUI(io.Discard)
+	}
+
+	if w.Orchestrator == nil {
+		w.Orchestrator = NewOrchestrator(w.SourceFSAdapter, adapter.NewLocalTestRunnerAdapter())
+	}
+
+	if w.Mutagen == nil {
+		w.Mutagen = NewMutagen(adapter.NewLocalGoFileAdapter(), w.SourceFSAdapter)
+	}
+
+	if w.schemata == nil {
+		w.schemata = NewSchemaBuilder(adapter.NewLocalGoFileAdapter(), w.SourceFSAdapter)
+	}
+}
+
+// withOptionDefaults fills in the settings of args left unset with those
+// of the workflow's options.
+func (w *workflow) withOptionDefaults(args TestArgs) TestArgs {
+	if args.Threads <= 0 {
+		args.Threads = w.threads
+	}
+
+	if args.Timeout <= 0 {
+		args.Timeout = w.timeout
+	}
+
+	return args
+}
@@ -0,0 +1,85 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
+	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
+	"github.com/mouse-blink/gooze/internal/domain"
+	domainmocks "github.com/mouse-blink/gooze/internal/domain/mocks"
+	m "github.com/mouse-blink/gooze/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkflow_OptionsSetRunDefaults(t *testing.T) {
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadReports(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{
+		Origin: &m.File{FullPath: "/project/loop.go", Hash: "loop"},
+		Test:   &m.File{FullPath: "/project/loop_test.go", Hash: "loop_test"},
+	}
+	mutation := m.Mutation{ID: "loop-1", Source: source, Type: m.MutationLoop}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(3, 0, 1).Return().Once()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(source, m.MutationLoop).Return([]m.Mutation{mutation}, nil).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.MatchedBy(func(tested m.Mutation) bool {
+		// Loop mutations get twice the base timeout.
+		return tested.Timeout == 2*7*time.Second
+	})).Return(m.MutationResult{Status: m.Killed}, nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+		domain.WithThreads(3),
+		domain.WithMutators(m.MutationLoop),
+		domain.WithTimeout(7*time.Second),
+	)
+
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs:    domain.EstimateArgs{Paths: []m.Path{"/project"}},
+		Reports:         "reports",
+		TotalShardCount: 1,
+	})
+	require.NoError(t, err)
+
+	mockUI.AssertExpectations(t)
+	mockOrchestrator.AssertExpectations(t)
+}
+
+func TestNewWorkflow_DefaultsDependencies(t *testing.T) {
+	wf := domain.NewWorkflow()
+
+	// The local source adapter lists the Go files of a directory.
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"testdata/does-not-exist"}})
+	assert.Error(t, err)
+}
@@ -3,11 +3,14 @@ package domain_test
 import (
 	"crypto/sha256"
 	"errors"
+	"fmt"
 	"path/filepath"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/mouse-blink/gooze/internal/adapter"
 	adaptermocks "github.com/mouse-blink/gooze/internal/adapter/mocks"
 	controllermocks "github.com/mouse-blink/gooze/internal/controller/mocks"
 	domain "github.com/mouse-blink/gooze/internal/domain"
@@ -23,9 +26,18 @@ func TestWorkflow_Test_Success(t *testing.T) {
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	sources := []m.Source{
@@ -39,7 +51,7 @@ func TestWorkflow_Test_Success(t *testing.T) {
 		{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
@@ -48,11 +60,17 @@ func TestWorkflow_Test_Success(t *testing.T) {
 	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Once()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.Result{}, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil)
 	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	args := domain.TestArgs{
@@ -74,599 +92,811 @@ func TestWorkflow_Test_Success(t *testing.T) {
 	mockOrchestrator.AssertExpectations(t)
 }
 
-func TestWorkflow_Test_GetSourcesError(t *testing.T) {
+func TestWorkflow_Test_SavesManifest(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	testErr := errors.New("failed to get sources")
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	source := m.Source{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return(nil, testErr)
-
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	mockReportStore.EXPECT().SaveReports(m.Path("reports"), mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(m.Path("reports")).Return(nil)
+	mockReportStore.EXPECT().RecordRun(m.Path("reports"), mock.Anything).Return(nil)
+	mockReportStore.EXPECT().SaveManifest(m.Path("reports"), mock.MatchedBy(func(manifest m.RunManifest) bool {
+		return assert.ObjectsAreEqual([]string{"gooze", "run", "--tags", "slow", "./..."}, manifest.Command) &&
+			manifest.Config["paths"] == "./..." &&
+			manifest.Config["tags"] == "slow" &&
+			manifest.Config["parallel"] == "2" &&
+			!manifest.Time.IsZero()
+	}), m.Path("ci.pem")).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
+	err := wf.Test(domain.TestArgs{
 		EstimateArgs: domain.EstimateArgs{
-			Paths: []m.Path{"test.go"},
+			Paths: []m.Path{"./..."},
+			Tags:  []string{"slow"},
 		},
-		Reports: "reports.json",
-	}
-	err := wf.Test(args)
+		Reports: "reports",
+		Threads: 2,
+		ManifestArgs: domain.ManifestArgs{
+			Command:     []string{"gooze", "run", "--tags", "slow", "./..."},
+			ManifestKey: "ci.pem",
+		},
+	})
 
 	// Assert
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "get sources")
+	require.NoError(t, err)
+	mockReportStore.AssertExpectations(t)
 }
 
-func TestWorkflow_Test_GenerateMutationsError(t *testing.T) {
+func TestWorkflow_Test_SaveManifestError(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	sources := []m.Source{
-		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
-	}
-
-	testErr := errors.New("failed to generate mutations")
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
-	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(nil, testErr)
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{{Origin: &m.File{FullPath: "test.go"}, Test: &m.File{FullPath: "test_test.go"}}}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("bad key"))
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		EstimateArgs: domain.EstimateArgs{
-			Paths: []m.Path{"test.go"},
-		},
-		Reports: "reports.json",
-	}
-	err := wf.Test(args)
+	err := wf.Test(domain.TestArgs{Reports: "reports", Threads: 1})
 
 	// Assert
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "generate mutations")
+	require.ErrorContains(t, err, "save manifest: bad key")
 }
 
-func TestWorkflow_Test_TestMutationError(t *testing.T) {
+func TestWorkflow_Test_InvalidTimeoutMultiplier(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
-	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	sources := []m.Source{
-		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
-	}
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
 
-	mutations := []m.Mutation{
-		{ID: "hash-1", Source: sources[0]},
-	}
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
-	testErr := errors.New("failed to test mutation")
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	// Act
+	err := wf.Test(domain.TestArgs{
+		Reports:            "reports",
+		TimeoutMultipliers: map[string]float64{"loops": 3},
+	})
+
+	// Assert
+	require.ErrorContains(t, err, `unknown mutation type "loops"`)
+	mockFSAdapter.AssertNotCalled(t, "Get", mock.Anything)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_Test_InvalidTestScope(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Close().Return().Once()
-	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
-	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(nil, testErr)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		EstimateArgs: domain.EstimateArgs{
-			Paths: []m.Path{"test.go"},
-		},
-		Reports:         "reports.json",
-		Threads:         1,
-		ShardIndex:      0,
-		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	err := wf.Test(domain.TestArgs{
+		Reports:   "reports",
+		TestScope: "module",
+	})
 
 	// Assert
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "errors occurred during mutation testing")
+	require.ErrorContains(t, err, `unknown test scope "module"`)
+	mockFSAdapter.AssertNotCalled(t, "Get", mock.Anything)
+	mockUI.AssertExpectations(t)
 }
 
-func TestWorkflow_Test_SaveReportsError(t *testing.T) {
+func TestWorkflow_Test_SkipsQuarantinedMutations(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	sources := []m.Source{
-		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
+		{Origin: &m.File{FullPath: "/project/loop.go", Hash: "hash1"}, Test: &m.File{FullPath: "/project/loop_test.go"}},
 	}
 
 	mutations := []m.Mutation{
-		{ID: "hash-1", Source: sources[0]},
+		{ID: "slow", Source: sources[0], Type: m.MutationLoop},
+		{ID: "fast", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	history := []m.RunRecord{
+		{TimedOut: []string{"slow"}},
+		{TimedOut: []string{"slow"}},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.Result{}, nil)
+	mockReportStore.EXPECT().LoadHistory(m.Path("reports")).Return(history, nil).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.MatchedBy(func(mutation m.Mutation) bool {
+		return mutation.ID == "fast"
+	})).Return(m.MutationResult{Status: m.Killed}, nil).Once()
+	mockReportStore.EXPECT().SaveReports(m.Path("reports"), mock.MatchedBy(func(reports []m.Report) bool {
+		for _, report := range reports {
+			result := report.Result[0]
+			if result.MutationID == "slow" {
+				return result.Status == m.Skipped && result.Note == "quarantined: timed out in 2 recorded runs"
+			}
+		}
 
-	saveErr := errors.New("failed to save reports")
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(saveErr)
+		return false
+	})).Return(nil).Once()
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		EstimateArgs: domain.EstimateArgs{
-			Paths: []m.Path{"test.go"},
-		},
-		Reports:         "reports.json",
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs:    domain.EstimateArgs{Paths: []m.Path{"/project/loop.go"}},
+		Reports:         "reports",
 		Threads:         1,
-		ShardIndex:      0,
-		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+		QuarantineAfter: 2,
+	})
 
 	// Assert
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "save reports")
+	assert.NoError(t, err)
+	mockOrchestrator.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
 }
 
-func TestWorkflow_Test_NoMutations(t *testing.T) {
+func TestWorkflow_Test_SkipsEquivalentMutations(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
-	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	sources := []m.Source{
-		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
+		{Origin: &m.File{FullPath: "/project/calc.go", Hash: "hash1"}, Test: &m.File{FullPath: "/project/calc_test.go"}},
 	}
 
-	// No mutations generated
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mutations := []m.Mutation{
+		{ID: "equivalent", Source: sources[0], Type: m.MutationArithmetic},
+		{ID: "killable", Source: sources[0], Type: m.MutationArithmetic},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayMutationScore(1.0).Return().Once()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
-	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return([]m.Mutation{}, nil)
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		return len(reports) == 0
-	})).Return(nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockReportStore.EXPECT().LoadEquivalents(m.Path("reports")).Return([]string{"equivalent"}, nil).Once()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().TestMutation(mock.MatchedBy(func(mutation m.Mutation) bool {
+		return mutation.ID == "killable"
+	})).Return(m.MutationResult{Status: m.Killed}, nil).Once()
+	mockReportStore.EXPECT().SaveReports(m.Path("reports"), mock.MatchedBy(func(reports []m.Report) bool {
+		for _, report := range reports {
+			result := report.Result[0]
+			if result.MutationID == "equivalent" {
+				return result.Status == m.Skipped && result.Equivalent
+			}
+		}
+
+		return false
+	})).Return(nil).Once()
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		EstimateArgs: domain.EstimateArgs{
-			Paths: []m.Path{"test.go"},
-		},
-		Reports:         "reports.json",
-		Threads:         1,
-		ShardIndex:      0,
-		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"/project/calc.go"}},
+		Reports:      "reports",
+		Threads:      1,
+	})
 
 	// Assert
 	assert.NoError(t, err)
-	mockFSAdapter.AssertExpectations(t)
-	mockMutagen.AssertExpectations(t)
+	mockUI.AssertExpectations(t)
+	mockOrchestrator.AssertExpectations(t)
 	mockReportStore.AssertExpectations(t)
 }
 
-func TestWorkflow_Test_MultipleThreads(t *testing.T) {
+func TestWorkflow_Test_MaxDurationStopsStartingMutations(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	source := m.Source{
-		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "/project/calc.go", Hash: "hash1"}, Test: &m.File{FullPath: "/project/calc_test.go"}},
 	}
-	sources := []m.Source{source}
 
 	mutations := []m.Mutation{
-		{ID: "hash-0", Source: source},
-		{ID: "hash-1", Source: source},
-		{ID: "hash-2", Source: source},
+		{ID: "uncovered", Source: sources[0], Type: m.MutationArithmetic, Position: m.Position{Line: 9}},
+		{ID: "covered", Source: sources[0], Type: m.MutationArithmetic, Position: m.Position{Line: 3}},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	coverage := m.Coverage{}
+	coverage.Add("/project/calc.go", 3, 3)
+
+	budget := 50 * time.Millisecond
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Times(3)
-	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(3)
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(2).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Twice()
+	mockUI.EXPECT().DisplayBudgetExhausted(budget, 1).Return().Once()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.Result{}, nil).Times(3)
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		return len(reports) == 3
-	})).Return(nil)
+	mockOrchestrator.EXPECT().Coverage(mock.Anything, mock.Anything, budget/4).Return(coverage, nil).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.MatchedBy(func(mutation m.Mutation) bool {
+		return mutation.ID == "covered"
+	})).RunAndReturn(func(m.Mutation) (m.MutationResult, error) {
+		time.Sleep(2 * budget)
+		return m.MutationResult{Status: m.Killed}, nil
+	}).Once()
+	mockReportStore.EXPECT().SaveReports(m.Path("reports"), mock.MatchedBy(func(reports []m.Report) bool {
+		for _, report := range reports {
+			result := report.Result[0]
+			if result.MutationID == "uncovered" {
+				return result.Status == m.NotRun && result.Note == "time budget exhausted"
+			}
+		}
+
+		return false
+	})).Return(nil).Once()
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		EstimateArgs: domain.EstimateArgs{
-			Paths: []m.Path{"test.go"},
-		},
-		Reports:         "reports.json",
-		Threads:         4,
-		ShardIndex:      0,
-		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"/project/calc.go"}},
+		Reports:      "reports",
+		Threads:      1,
+		MaxDuration:  budget,
+	})
 
 	// Assert
 	assert.NoError(t, err)
+	mockUI.AssertExpectations(t)
 	mockOrchestrator.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
 }
 
-func TestWorkflow_Test_WithSharding(t *testing.T) {
+func TestWorkflow_Test_StopFinishesInFlightAndSavesResults(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	baseReportsDir := m.Path("reports")
-	expectedShardDir := m.Path(filepath.Join(string(baseReportsDir), domain.ShardDirPrefix+"0"))
-
-	source := m.Source{
-		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "/project/calc.go", Hash: "hash1"}, Test: &m.File{FullPath: "/project/calc_test.go"}},
 	}
 
-	// 6 mutations total
 	mutations := []m.Mutation{
-		{ID: "hash-0", Source: source},
-		{ID: "hash-1", Source: source},
-		{ID: "hash-2", Source: source},
-		{ID: "hash-3", Source: source},
-		{ID: "hash-4", Source: source},
-		{ID: "hash-5", Source: source},
+		{ID: "first", Source: sources[0], Type: m.MutationArithmetic},
+		{ID: "second", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
-	mockUI.EXPECT().Wait().Return().Once()
+	stop := make(chan struct{})
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Maybe()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Maybe()
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(2).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Twice()
+	mockUI.EXPECT().DisplayInterrupted(1).Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	// With hash-based sharding, the number of mutations in shard 0 may vary
-	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.Result{}, nil).Maybe()
-	mockReportStore.EXPECT().SaveReports(expectedShardDir, mock.MatchedBy(func(reports []m.Report) bool {
-		// Accept any number of reports since hash-based sharding determines this
-		return true
-	})).Return(nil)
-	mockReportStore.EXPECT().RegenerateIndex(expectedShardDir).Return(nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.MatchedBy(func(mutation m.Mutation) bool {
+		return mutation.ID == "first"
+	})).RunAndReturn(func(m.Mutation) (m.MutationResult, error) {
+		close(stop)
+		return m.MutationResult{Status: m.Killed}, nil
+	}).Once()
+	mockReportStore.EXPECT().SaveReports(m.Path("reports"), mock.MatchedBy(func(reports []m.Report) bool {
+		statuses := map[string]m.MutationResult{}
+		for _, report := range reports {
+			statuses[report.Result[0].MutationID] = report.Result[0]
+		}
+
+		return statuses["first"].Status == m.Killed &&
+			statuses["second"].Status == m.NotRun && statuses["second"].Note == "run interrupted"
+	})).Return(nil).Once()
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		EstimateArgs: domain.EstimateArgs{
-			Paths: []m.Path{"test.go"},
-		},
-		Reports:         baseReportsDir,
-		Threads:         1,
-		ShardIndex:      0,
-		TotalShardCount: 3,
-	}
-	err := wf.Test(args)
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"/project/calc.go"}},
+		Reports:      "reports",
+		Threads:      1,
+		Stop:         stop,
+	})
 
 	// Assert
-	assert.NoError(t, err)
+	require.ErrorIs(t, err, domain.ErrInterrupted)
+	mockUI.AssertExpectations(t)
 	mockOrchestrator.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
 }
 
-func TestWorkflow_ShardMutations_InvalidShardReturnsEmpty(t *testing.T) {
+func TestWorkflow_Test_FailingBaselineStopsRun(t *testing.T) {
 	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "/project/calc.go", Hash: "hash1"}, Test: &m.File{FullPath: "/project/calc_test.go"}},
+	}
+
 	mutations := []m.Mutation{
-		{ID: "hash-0"},
-		{ID: "hash-1"},
-		{ID: "hash-2"},
+		{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	wf := domain.NewWorkflow(nil, nil, nil, nil, nil)
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(fmt.Errorf("%w: go test . in /project: exit status 1", domain.ErrBaselineFailing)).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	result := wf.(interface {
-		ShardMutations([]m.Mutation, int, int) []m.Mutation
-	}).ShardMutations(mutations, 2, 2)
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"/project/calc.go"}},
+		Reports:      "reports",
+		Threads:      1,
+	})
 
 	// Assert
-	assert.Empty(t, result)
+	require.ErrorIs(t, err, domain.ErrBaselineFailing)
+	mockOrchestrator.AssertNotCalled(t, "TestMutation", mock.Anything)
+	mockReportStore.AssertNotCalled(t, "SaveReports", mock.Anything, mock.Anything)
 }
 
-func TestWorkflow_ShardMutations_ShardIndexGreaterThanTotalReturnsEmpty(t *testing.T) {
+func TestWorkflow_Test_NoTests(t *testing.T) {
 	// Arrange
-	mutations := []m.Mutation{
-		{ID: "hash-0"},
-		{ID: "hash-1"},
-		{ID: "hash-2"},
-	}
-
-	wf := domain.NewWorkflow(nil, nil, nil, nil, nil)
-
-	// Act
-	result := wf.(interface {
-		ShardMutations([]m.Mutation, int, int) []m.Mutation
-	}).ShardMutations(mutations, 3, 2)
-
-	// Assert
-	assert.Empty(t, result)
-}
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
 
-func TestWorkflow_ShardMutations_NonPositiveTotalReturnsAll(t *testing.T) {
-	// Arrange
-	mutations := []m.Mutation{
-		{ID: "hash-0"},
-		{ID: "hash-1"},
-		{ID: "hash-2"},
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "/project/calc.go", Hash: "hash1"}},
 	}
 
-	wf := domain.NewWorkflow(nil, nil, nil, nil, nil)
-
-	// Act
-	resultZero := wf.(interface {
-		ShardMutations([]m.Mutation, int, int) []m.Mutation
-	}).ShardMutations(mutations, 0, 0)
-
-	resultNegative := wf.(interface {
-		ShardMutations([]m.Mutation, int, int) []m.Mutation
-	}).ShardMutations(mutations, 0, -3)
-
-	// Assert
-	assert.Len(t, resultZero, len(mutations))
-	assert.Len(t, resultNegative, len(mutations))
-}
-
-func TestWorkflow_ShardMutations_MiddleShardSelectsExactMatches(t *testing.T) {
-	// Arrange
 	mutations := []m.Mutation{
-		{ID: "hash-0"},
-		{ID: "hash-1"},
-		{ID: "hash-2"},
-		{ID: "hash-3"},
-		{ID: "hash-4"},
-		{ID: "hash-5"},
+		{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	wf := domain.NewWorkflow(nil, nil, nil, nil, nil)
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	result := wf.(interface {
-		ShardMutations([]m.Mutation, int, int) []m.Mutation
-	}).ShardMutations(mutations, 1, 3)
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"/project/calc.go"}},
+		Reports:      "reports",
+		Threads:      1,
+	})
 
 	// Assert
-	// With hash-based sharding, we can't predict exact counts, so just verify sharding works
-	assert.True(t, len(result) >= 0, "ShardMutations should return some mutations for shard 1")
-
-	// Verify that each returned mutation actually belongs to shard 1
-	for _, mutation := range result {
-		// Verify this mutation would actually be assigned to shard 1
-		h := sha256.Sum256([]byte(mutation.ID))
-		hashValue := int(h[0])<<24 + int(h[1])<<16 + int(h[2])<<8 + int(h[3])
-		if hashValue < 0 {
-			hashValue = -hashValue
-		}
-		expectedShard := hashValue % 3
-		assert.Equal(t, 1, expectedShard, "Mutation %s should belong to shard 1", mutation.ID)
-	}
+	require.ErrorIs(t, err, domain.ErrNoTests)
+	mockOrchestrator.AssertNotCalled(t, "Baseline", mock.Anything)
 }
 
-func TestWorkflow_TestThreadsZeroDoesNotPanic(t *testing.T) {
+func TestWorkflow_Test_DependentsScopeSetsTestPackages(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	source := m.Source{
-		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "/project/store/store.go", Hash: "hash1"}},
 	}
 
 	mutations := []m.Mutation{
-		{ID: "hash-0", Source: source, Type: m.MutationArithmetic},
+		{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	packages := []m.Package{
+		{ImportPath: "example.com/project/store", Dir: "/project/store"},
+		{ImportPath: "example.com/project/api", Dir: "/project/api", Imports: []string{"example.com/project/store"}, HasTests: true},
+		{ImportPath: "example.com/project/cli", Dir: "/project/cli", HasTests: true},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
 	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], mock.Anything).Return().Once()
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockFSAdapter.EXPECT().FindProjectRoot(m.Path("/project/store/store.go")).Return(m.Path("/project"), nil).Once()
+	mockFSAdapter.EXPECT().ListPackages(m.Path("/project"), []string{"integration"}).Return(packages, nil).Once()
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(m.Result{}, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.MatchedBy(func(mutation m.Mutation) bool {
+		return assert.ObjectsAreEqual([]m.Path{"/project/api"}, mutation.Source.TestPackages)
+	})).Return(m.MutationResult{}, nil).Once()
 	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		Reports:         "reports.json",
-		Threads:         0,
-		ShardIndex:      0,
-		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"/project/store/store.go"},
+			Tags:  []string{"integration"},
+		},
+		Reports:   "reports",
+		Threads:   1,
+		TestScope: domain.TestScopeDependents,
+	})
 
 	// Assert
 	assert.NoError(t, err)
+	mockOrchestrator.AssertExpectations(t)
+	mockFSAdapter.AssertExpectations(t)
 }
 
-func TestWorkflow_TestThreadIDWithinBounds(t *testing.T) {
+func TestWorkflow_Test_PreparesWorkspacesPerThread(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	source := m.Source{
-		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+	sources := []m.Source{
+		{
+			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
+		},
 	}
 
 	mutations := []m.Mutation{
-		{ID: "hash-0", Source: source, Type: m.MutationArithmetic},
-		{ID: "hash-1", Source: source, Type: m.MutationArithmetic},
+		{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic},
+		{ID: "hash-2", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
 	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.MatchedBy(func(id int) bool {
-		return id >= 0 && id < 2
-	})).Return().Times(2)
-	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(2)
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.Result{}, nil).Times(2)
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		return len(reports) == 2
-	})).Return(nil)
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.MatchedBy(func(ms []m.Mutation) bool {
+		return len(ms) == len(mutations) && ms[0].ID == "hash-1" && ms[1].ID == "hash-2"
+	}), 3).Return(nil).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil).Twice()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Once()
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
 		Reports:         "reports.json",
-		Threads:         2,
-		ShardIndex:      0,
+		Threads:         3,
 		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	})
 
 	// Assert
 	assert.NoError(t, err)
+	mockOrchestrator.AssertExpectations(t)
 }
 
-func TestWorkflow_TestThreadIDIsUniqueForThreadsTwo(t *testing.T) {
+func TestWorkflow_Test_SetsRunOptionsOnce(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(domain.RunOptions{
+		DetectFlaky:        true,
+		CountCompileErrors: true,
+		GoTestArgs:         []string{"-short"},
+		WorkDir:            "/tmp/work",
+	}).Return().Once()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	source := m.Source{
-		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+	sources := []m.Source{
+		{
+			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
+		},
 	}
 
 	mutations := []m.Mutation{
-		{ID: "hash-0", Source: source, Type: m.MutationArithmetic},
-		{ID: "hash-1", Source: source, Type: m.MutationArithmetic},
+		{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic},
+		{ID: "hash-2", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	threadIDs := make([]int, 0, 2)
-
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
 	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Run(func(_ m.Mutation, threadID int) {
-		threadIDs = append(threadIDs, threadID)
-	}).Return().Times(2)
-	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(2)
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.Result{}, nil).Times(2)
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		return len(reports) == 2
-	})).Return(nil)
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil).Twice()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Once()
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		Reports:         "reports.json",
-		Threads:         2,
-		ShardIndex:      0,
-		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports:            "reports.json",
+		Threads:            1,
+		TotalShardCount:    1,
+		DetectFlaky:        true,
+		CountCompileErrors: true,
+		GoTestArgs:         []string{"-short"},
+		WorkDir:            "/tmp/work",
+	})
 
 	// Assert
 	assert.NoError(t, err)
-	if assert.Len(t, threadIDs, 2) {
-		assert.NotEqual(t, threadIDs[0], threadIDs[1])
-	}
+	mockOrchestrator.AssertExpectations(t)
 }
 
-func TestWorkflow_TestWithSkippedMutation(t *testing.T) {
+func TestWorkflow_Test_PrepareWorkspacesError(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
-	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
-
 	sources := []m.Source{
 		{
 			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
@@ -675,343 +905,397 @@ func TestWorkflow_TestWithSkippedMutation(t *testing.T) {
 	}
 
 	mutations := []m.Mutation{
-		{
-			ID:       "hash-0",
-			Source:   sources[0],
-			Type:     m.MutationArithmetic,
-			DiffCode: diffCode,
-		},
-	}
-
-	skippedResult := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{{MutationID: "hash-0", Status: m.Skipped}},
+		{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
-	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], skippedResult).Return().Once()
-
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(skippedResult, nil)
-
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		if len(reports) != 1 {
-			return false
-		}
-		report := reports[0]
-		return report.Diff == nil
-	})).Return(nil)
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, 1).Return(errors.New("no space left")).Once()
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
 		Reports:         "reports.json",
 		Threads:         1,
-		ShardIndex:      0,
 		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	})
 
 	// Assert
-	assert.NoError(t, err)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "prepare workspaces")
+	mockOrchestrator.AssertNotCalled(t, "TestMutation", mock.Anything)
 }
 
-func TestWorkflow_TestMutationIDExactMatchDoesNotUseHigherID(t *testing.T) {
+func TestWorkflow_Test_ExportsJUnitWhenRequested(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
-	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
-
-	sources := []m.Source{
-		{
-			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
-			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
-		},
-	}
-
-	mutations := []m.Mutation{
-		{
-			ID:       "hash-2",
-			Source:   sources[0],
-			Type:     m.MutationArithmetic,
-			DiffCode: diffCode,
-		},
-	}
-
-	result := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{
-			{MutationID: "hash-1", Status: m.Killed},
-			{MutationID: "hash-3", Status: m.Survived},
-		},
+	source := m.Source{
+		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
 	}
+	hint := "no test asserts the return value of Add for operands of a + b other than 0 and 1"
+	mutations := []m.Mutation{{ID: "hash-1", Source: source, Type: m.MutationArithmetic, Hint: hint}}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], result).Return().Once()
-
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
-	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(result, nil)
-
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		if len(reports) != 1 {
-			return false
-		}
-		report := reports[0]
-		return report.Diff == nil
-	})).Return(nil)
-
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{Status: m.Survived}, nil)
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RecordRun(m.Path("reports"), mock.Anything).Return(nil)
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().ExportJUnit(m.Path("junit.xml"), mock.MatchedBy(func(reports []m.Report) bool {
+		return len(reports) == 1 && reports[0].Result[0].MutationID == "hash-1" && reports[0].Result[0].Status == m.Survived &&
+			reports[0].Result[0].Hint == hint
+	})).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		Reports:         "reports.json",
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs:    domain.EstimateArgs{Paths: []m.Path{"test.go"}},
+		Reports:         "reports",
 		Threads:         1,
-		ShardIndex:      0,
 		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+		JUnitOut:        "junit.xml",
+	})
 
 	// Assert
 	assert.NoError(t, err)
+	mockReportStore.AssertExpectations(t)
 }
 
-func TestWorkflow_TestEmptyResultEntriesReturnsError(t *testing.T) {
+func TestWorkflow_Test_ExportsDiagnosticsWhenRequested(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
-	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
+	source := m.Source{
+		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
+	}
+	mutations := []m.Mutation{{ID: "hash-1", Source: source, Type: m.MutationArithmetic, Position: m.Position{Line: 3, Column: 9}}}
 
-	sources := []m.Source{
-		{
-			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
-			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
-		},
-	}
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{Status: m.Survived}, nil)
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RecordRun(m.Path("reports"), mock.Anything).Return(nil)
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().ExportDiagnostics(m.Path("gooze.rdjson"), m.DiagnosticsLSP, mock.MatchedBy(func(diagnostics []m.Diagnostic) bool {
+		return len(diagnostics) == 1 && diagnostics[0].MutationID == "hash-1" && diagnostics[0].Line == 3 && diagnostics[0].Column == 9
+	})).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
-	mutations := []m.Mutation{
-		{
-			ID:       "hash-0",
-			Source:   sources[0],
-			Type:     m.MutationArithmetic,
-			DiffCode: diffCode,
-		},
-	}
+	// Act
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs:      domain.EstimateArgs{Paths: []m.Path{"test.go"}},
+		Reports:           "reports",
+		Threads:           1,
+		TotalShardCount:   1,
+		DiagnosticsOut:    "gooze.rdjson",
+		DiagnosticsFormat: m.DiagnosticsLSP,
+	})
 
-	result := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{},
-	}
+	// Assert
+	assert.NoError(t, err)
+	mockReportStore.AssertExpectations(t)
+}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
-	mockUI.EXPECT().Wait().Return().Once()
+func TestWorkflow_Test_RejectsUnknownDiagnosticsFormat(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Close().Return().Once()
-	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], result).Return().Once()
 
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
-	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(result, nil)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		if len(reports) != 1 {
-			return false
-		}
-		report := reports[0]
-		return report.Diff == nil
-	})).Return(nil)
+	// Act
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs:      domain.EstimateArgs{Paths: []m.Path{"test.go"}},
+		Reports:           "reports",
+		DiagnosticsOut:    "gooze.json",
+		DiagnosticsFormat: "sarif",
+	})
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown diagnostics format")
+	mockFSAdapter.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func TestWorkflow_Test_ExportJUnitError(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{{Origin: &m.File{FullPath: "test.go"}, Test: &m.File{FullPath: "test_test.go"}}}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RecordRun(m.Path("reports"), mock.Anything).Return(nil)
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().ExportJUnit(mock.Anything, mock.Anything).Return(errors.New("disk full"))
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		Reports:         "reports.json",
-		Threads:         1,
-		ShardIndex:      0,
-		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"test.go"}},
+		Reports:      "reports",
+		JUnitOut:     "junit.xml",
+	})
 
 	// Assert
-	assert.NoError(t, err)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "export junit report")
 }
 
-func TestWorkflow_Test_MultipleSources(t *testing.T) {
+func TestWorkflow_Test_GetSourcesError(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	source1 := m.Source{
-		Origin: &m.File{FullPath: "file1.go", Hash: "hash1"},
-	}
-	source2 := m.Source{
-		Origin: &m.File{FullPath: "file2.go", Hash: "hash2"},
-	}
-
-	mutations1 := []m.Mutation{
-		{ID: "hash-0", Source: source1},
-		{ID: "hash-1", Source: source1},
-	}
-	mutations2 := []m.Mutation{
-		{ID: "hash-2", Source: source2},
-	}
-
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
-	mockUI.EXPECT().Wait().Return().Once()
+	testErr := errors.New("failed to get sources")
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Times(3)
-	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(3)
-	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source1, source2}, nil)
-	mockMutagen.EXPECT().GenerateMutation(source1, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations1, nil)
-	mockMutagen.EXPECT().GenerateMutation(source2, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations2, nil)
-	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.Result{}, nil).Times(3)
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		return len(reports) == 3
-	})).Return(nil)
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(nil, testErr)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	args := domain.TestArgs{
-
-		Reports:         "reports.json",
-		Threads:         1,
-		ShardIndex:      0,
-		TotalShardCount: 1,
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports: "reports.json",
 	}
 	err := wf.Test(args)
 
 	// Assert
-	assert.NoError(t, err)
-	mockFSAdapter.AssertExpectations(t)
-	mockMutagen.AssertExpectations(t)
-	mockOrchestrator.AssertExpectations(t)
-	mockReportStore.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "get sources")
 }
 
-func TestWorkflow_NewWorkflowV2(t *testing.T) {
+func TestWorkflow_Test_GenerateMutationsError(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
+	}
+
+	testErr := errors.New("failed to generate mutations")
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(nil, testErr)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
 	// Act
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	args := domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports: "reports.json",
+	}
+	err := wf.Test(args)
 
 	// Assert
-	require.NotNil(t, wf)
-	assert.Implements(t, (*domain.Workflow)(nil), wf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "generate mutations")
 }
 
-func TestWorkflow_TestWithSurvivedMutation(t *testing.T) {
-	// Arrange - This test specifically checks that survived mutations include diff data
+func TestWorkflow_Test_TestMutationError(t *testing.T) {
+	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
-
 	sources := []m.Source{
-		{
-			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
-			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
-		},
+		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}, Test: &m.File{FullPath: "test_test.go"}},
 	}
 
 	mutations := []m.Mutation{
-		{
-			ID:       "hash-0",
-			Source:   sources[0],
-			Type:     m.MutationArithmetic,
-			DiffCode: diffCode,
-		},
-	}
-
-	// Mock a survived mutation result
-	survivedResult := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{{MutationID: "hash-0", Status: m.Survived}},
+		{ID: "hash-1", Source: sources[0]},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
-	mockUI.EXPECT().Wait().Return().Once()
+	testErr := errors.New("failed to test mutation")
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], survivedResult).Return().Once()
-
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(survivedResult, nil)
-
-	// Verify that the report includes the diff for survived mutations
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		if len(reports) != 1 {
-			return false
-		}
-		report := reports[0]
-		// Check that diff is included for survived mutation
-		return report.Diff != nil && string(*report.Diff) == string(diffCode)
-	})).Return(nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, testErr)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	args := domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
 		Reports:         "reports.json",
 		Threads:         1,
 		ShardIndex:      0,
@@ -1020,101 +1304,1715 @@ func TestWorkflow_TestWithSurvivedMutation(t *testing.T) {
 	err := wf.Test(args)
 
 	// Assert
-	assert.NoError(t, err)
-	mockFSAdapter.AssertExpectations(t)
-	mockMutagen.AssertExpectations(t)
-	mockOrchestrator.AssertExpectations(t)
-	mockReportStore.AssertExpectations(t)
-	mockUI.AssertExpectations(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "errors occurred during mutation testing")
 }
 
-func TestWorkflow_TestWithKilledMutation(t *testing.T) {
-	// Arrange - This test checks that killed mutations do NOT include diff data
+func TestWorkflow_Test_InfraErrorSavesFailure(t *testing.T) {
+	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
-	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
-	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
-
 	sources := []m.Source{
-		{
-			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
-			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
-		},
+		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}, Test: &m.File{FullPath: "test_test.go"}},
 	}
 
 	mutations := []m.Mutation{
-		{
-			ID:       "hash-0",
-			Source:   sources[0],
-			Type:     m.MutationArithmetic,
-			DiffCode: diffCode,
-		},
+		{ID: "hash-1", Source: sources[0]},
 	}
 
-	// Mock a killed mutation result
-	killedResult := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{{MutationID: "hash-0", Status: m.Killed}},
+	infraErr := &domain.InfraError{
+		Phase:      domain.PhaseCopyProject,
+		MutationID: "hash-1",
+		Path:       "test.go",
+		Err:        errors.New("failed to copy project: disk full"),
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
-	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
-	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
-	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], killedResult).Return().Once()
-
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(killedResult, nil)
-
-	// Verify that the report does NOT include diff for killed mutations
-	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
-		if len(reports) != 1 {
-			return false
-		}
-		report := reports[0]
-		// Check that diff is NOT included for killed mutation
-		return report.Diff == nil
-	})).Return(nil)
-
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, infraErr)
+	mockReportStore.EXPECT().SaveFailure(m.Path(".gooze-reports"), mock.MatchedBy(func(failure m.RunFailure) bool {
+		return failure.Phase == domain.PhaseCopyProject &&
+			failure.MutationID == "hash-1" &&
+			failure.Path == "test.go" &&
+			failure.Error == "failed to copy project: disk full" &&
+			!failure.Time.IsZero()
+	})).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	args := domain.TestArgs{
-		Reports:         "reports.json",
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports:         ".gooze-reports",
 		Threads:         1,
-		ShardIndex:      0,
 		TotalShardCount: 1,
-	}
-	err := wf.Test(args)
+	})
 
 	// Assert
-	assert.NoError(t, err)
-	mockFSAdapter.AssertExpectations(t)
-	mockMutagen.AssertExpectations(t)
-	mockOrchestrator.AssertExpectations(t)
+	require.Error(t, err)
+
+	var gotInfraErr *domain.InfraError
+	assert.ErrorAs(t, err, &gotInfraErr)
 	mockReportStore.AssertExpectations(t)
-	mockUI.AssertExpectations(t)
 }
 
-func TestWorkflow_Estimate_Success(t *testing.T) {
+func TestWorkflow_Test_SaveReportsError(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}, Test: &m.File{FullPath: "test_test.go"}},
+	}
+
+	mutations := []m.Mutation{
+		{ID: "hash-1", Source: sources[0]},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil)
+
+	saveErr := errors.New("failed to save reports")
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(saveErr)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports:         "reports.json",
+		Threads:         1,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "save reports")
+}
+
+func TestWorkflow_Test_NoMutations(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
+	}
+
+	// No mutations generated
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return([]m.Mutation{}, nil)
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
+		return len(reports) == 0
+	})).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports:         "reports.json",
+		Threads:         1,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+	mockFSAdapter.AssertExpectations(t)
+	mockMutagen.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestWorkflow_Test_MultipleThreads(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{
+		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go"},
+	}
+	sources := []m.Source{source}
+
+	mutations := []m.Mutation{
+		{ID: "hash-0", Source: source},
+		{ID: "hash-1", Source: source},
+		{ID: "hash-2", Source: source},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Times(3)
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(3)
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil).Times(3)
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
+		return len(reports) == 3
+	})).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports:         "reports.json",
+		Threads:         4,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+	mockOrchestrator.AssertExpectations(t)
+}
+
+func TestWorkflow_Test_WithSharding(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	baseReportsDir := m.Path("reports")
+	expectedShardDir := m.Path(filepath.Join(string(baseReportsDir), domain.ShardDirPrefix+"0"))
+
+	source := m.Source{
+		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go"},
+	}
+
+	// 6 mutations total
+	mutations := []m.Mutation{
+		{ID: "hash-0", Source: source},
+		{ID: "hash-1", Source: source},
+		{ID: "hash-2", Source: source},
+		{ID: "hash-3", Source: source},
+		{ID: "hash-4", Source: source},
+		{ID: "hash-5", Source: source},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Maybe()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Maybe()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	// With hash-based sharding, the number of mutations in shard 0 may vary
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil).Maybe()
+	mockReportStore.EXPECT().SaveReports(expectedShardDir, mock.MatchedBy(func(reports []m.Report) bool {
+		// Accept any number of reports since hash-based sharding determines this
+		return true
+	})).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(expectedShardDir).Return(nil)
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports:         baseReportsDir,
+		Threads:         1,
+		ShardIndex:      0,
+		TotalShardCount: 3,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+	mockOrchestrator.AssertExpectations(t)
+}
+
+func TestWorkflow_Test_SeveralShardsSaveToTheirOwnDirectories(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	baseReportsDir := m.Path("reports")
+
+	source := m.Source{
+		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go"},
+	}
+
+	var mutations []m.Mutation
+	for i := range 12 {
+		mutations = append(mutations, m.Mutation{ID: fmt.Sprintf("hash-%d", i), Source: source})
+	}
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	sharder := wf.(interface {
+		ShardMutations([]m.Mutation, int, int) []m.Mutation
+	})
+
+	saved := make(map[m.Path][]string)
+	tested := 0
+
+	for _, shard := range []int{0, 2} {
+		dir := m.Path(filepath.Join(string(baseReportsDir), domain.ShardDirPrefix+strconv.Itoa(shard)))
+		tested += len(sharder.ShardMutations(mutations, shard, 3))
+
+		mockReportStore.EXPECT().SaveReports(dir, mock.Anything).RunAndReturn(func(_ m.Path, reports []m.Report) error {
+			for _, report := range reports {
+				for _, result := range report.Result {
+					saved[dir] = append(saved[dir], result.MutationID)
+				}
+			}
+
+			return nil
+		})
+		mockReportStore.EXPECT().RegenerateIndex(dir).Return(nil).Once()
+		mockReportStore.EXPECT().SaveManifest(dir, mock.MatchedBy(func(manifest m.RunManifest) bool {
+			return manifest.Config["shard"] == fmt.Sprintf("%d/3", shard)
+		}), mock.Anything).Return(nil).Once()
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(tested).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Maybe()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Maybe()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{Status: m.Killed}, nil).Times(tested)
+
+	// Act
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths: []m.Path{"test.go"},
+		},
+		Reports:         baseReportsDir,
+		Threads:         2,
+		ShardIndexes:    []int{0, 2},
+		TotalShardCount: 3,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	mockOrchestrator.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
+
+	for _, shard := range []int{0, 2} {
+		dir := m.Path(filepath.Join(string(baseReportsDir), domain.ShardDirPrefix+strconv.Itoa(shard)))
+
+		var want []string
+		for _, mutation := range sharder.ShardMutations(mutations, shard, 3) {
+			want = append(want, mutation.ID)
+		}
+
+		assert.ElementsMatch(t, want, saved[dir], "shard %d", shard)
+	}
+}
+
+func TestWorkflow_ShardMutations_InvalidShardReturnsEmpty(t *testing.T) {
+	// Arrange
+	mutations := []m.Mutation{
+		{ID: "hash-0"},
+		{ID: "hash-1"},
+		{ID: "hash-2"},
+	}
+
+	wf := domain.NewWorkflow()
+
+	// Act
+	result := wf.(interface {
+		ShardMutations([]m.Mutation, int, int) []m.Mutation
+	}).ShardMutations(mutations, 2, 2)
+
+	// Assert
+	assert.Empty(t, result)
+}
+
+func TestWorkflow_ShardMutations_ShardIndexGreaterThanTotalReturnsEmpty(t *testing.T) {
+	// Arrange
+	mutations := []m.Mutation{
+		{ID: "hash-0"},
+		{ID: "hash-1"},
+		{ID: "hash-2"},
+	}
+
+	wf := domain.NewWorkflow()
+
+	// Act
+	result := wf.(interface {
+		ShardMutations([]m.Mutation, int, int) []m.Mutation
+	}).ShardMutations(mutations, 3, 2)
+
+	// Assert
+	assert.Empty(t, result)
+}
+
+func TestWorkflow_ShardMutations_NonPositiveTotalReturnsAll(t *testing.T) {
+	// Arrange
+	mutations := []m.Mutation{
+		{ID: "hash-0"},
+		{ID: "hash-1"},
+		{ID: "hash-2"},
+	}
+
+	wf := domain.NewWorkflow()
+
+	// Act
+	resultZero := wf.(interface {
+		ShardMutations([]m.Mutation, int, int) []m.Mutation
+	}).ShardMutations(mutations, 0, 0)
+
+	resultNegative := wf.(interface {
+		ShardMutations([]m.Mutation, int, int) []m.Mutation
+	}).ShardMutations(mutations, 0, -3)
+
+	// Assert
+	assert.Len(t, resultZero, len(mutations))
+	assert.Len(t, resultNegative, len(mutations))
+}
+
+func TestWorkflow_ShardMutations_MiddleShardSelectsExactMatches(t *testing.T) {
+	// Arrange
+	mutations := []m.Mutation{
+		{ID: "hash-0"},
+		{ID: "hash-1"},
+		{ID: "hash-2"},
+		{ID: "hash-3"},
+		{ID: "hash-4"},
+		{ID: "hash-5"},
+	}
+
+	wf := domain.NewWorkflow()
+
+	// Act
+	result := wf.(interface {
+		ShardMutations([]m.Mutation, int, int) []m.Mutation
+	}).ShardMutations(mutations, 1, 3)
+
+	// Assert
+	// With hash-based sharding, we can't predict exact counts, so just verify sharding works
+	assert.True(t, len(result) >= 0, "ShardMutations should return some mutations for shard 1")
+
+	// Verify that each returned mutation actually belongs to shard 1
+	for _, mutation := range result {
+		// Verify this mutation would actually be assigned to shard 1
+		h := sha256.Sum256([]byte(mutation.ID))
+		hashValue := int(h[0])<<24 + int(h[1])<<16 + int(h[2])<<8 + int(h[3])
+		if hashValue < 0 {
+			hashValue = -hashValue
+		}
+		expectedShard := hashValue % 3
+		assert.Equal(t, 1, expectedShard, "Mutation %s should belong to shard 1", mutation.ID)
+	}
+}
+
+func TestWorkflow_TestThreadsZeroDoesNotPanic(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{
+		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go"},
+	}
+
+	mutations := []m.Mutation{
+		{ID: "hash-0", Source: source, Type: m.MutationArithmetic, Timeout: domain.DefaultTestTimeout},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.MatchedBy(func(threads int) bool { return threads >= 1 }), 0, 1).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], mock.Anything).Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(m.MutationResult{}, nil)
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		Reports:         "reports.json",
+		Threads:         0,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestWorkflow_TestThreadIDWithinBounds(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{
+		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go"},
+	}
+
+	mutations := []m.Mutation{
+		{ID: "hash-0", Source: source, Type: m.MutationArithmetic},
+		{ID: "hash-1", Source: source, Type: m.MutationArithmetic},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.MatchedBy(func(id int) bool {
+		return id >= 0 && id < 2
+	})).Return().Times(2)
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(2)
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil).Times(2)
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
+		return len(reports) == 2
+	})).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		Reports:         "reports.json",
+		Threads:         2,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestWorkflow_TestThreadIDIsUniqueForThreadsTwo(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{
+		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go"},
+	}
+
+	mutations := []m.Mutation{
+		{ID: "hash-0", Source: source, Type: m.MutationArithmetic},
+		{ID: "hash-1", Source: source, Type: m.MutationArithmetic},
+	}
+
+	threadIDs := make([]int, 0, 2)
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Run(func(_ m.Mutation, threadID int) {
+		threadIDs = append(threadIDs, threadID)
+	}).Return().Times(2)
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(2)
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil).Times(2)
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
+		return len(reports) == 2
+	})).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		Reports:         "reports.json",
+		Threads:         2,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, threadIDs, 2) {
+		assert.NotEqual(t, threadIDs[0], threadIDs[1])
+	}
+}
+
+func TestWorkflow_TestWithSkippedMutation(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
+
+	sources := []m.Source{
+		{
+			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
+		},
+	}
+
+	mutations := []m.Mutation{
+		{
+			ID:       "hash-0",
+			Source:   sources[0],
+			Type:     m.MutationArithmetic,
+			Timeout:  domain.DefaultTestTimeout,
+			DiffCode: diffCode,
+		},
+	}
+
+	skippedResult := m.MutationResult{MutationID: "hash-0", Type: m.MutationArithmetic, Status: m.Skipped}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], skippedResult).Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(skippedResult, nil)
+
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
+		if len(reports) != 1 {
+			return false
+		}
+		report := reports[0]
+		return report.Diff == nil
+	})).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		Reports:         "reports.json",
+		Threads:         1,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestWorkflow_Test_MultipleSources(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source1 := m.Source{
+		Origin: &m.File{FullPath: "file1.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "file1_test.go"},
+	}
+	source2 := m.Source{
+		Origin: &m.File{FullPath: "file2.go", Hash: "hash2"},
+		Test:   &m.File{FullPath: "file2_test.go"},
+	}
+
+	mutations1 := []m.Mutation{
+		{ID: "hash-0", Source: source1},
+		{ID: "hash-1", Source: source1},
+	}
+	mutations2 := []m.Mutation{
+		{ID: "hash-2", Source: source2},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Times(3)
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(3)
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source1, source2}, nil)
+	mockMutagen.EXPECT().GenerateMutation(source1, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations1, nil)
+	mockMutagen.EXPECT().GenerateMutation(source2, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations2, nil)
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{}, nil).Times(3)
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
+		return len(reports) == 3
+	})).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+
+		Reports:         "reports.json",
+		Threads:         1,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+	mockFSAdapter.AssertExpectations(t)
+	mockMutagen.AssertExpectations(t)
+	mockOrchestrator.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestWorkflow_NewWorkflow(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	// Act
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Assert
+	require.NotNil(t, wf)
+	assert.Implements(t, (*domain.Workflow)(nil), wf)
+}
+
+func TestWorkflow_TestWithSurvivedMutation(t *testing.T) {
+	// Arrange - This test specifically checks that survived mutations include diff data
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
+
+	sources := []m.Source{
+		{
+			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
+		},
+	}
+
+	mutations := []m.Mutation{
+		{
+			ID:       "hash-0",
+			Source:   sources[0],
+			Type:     m.MutationArithmetic,
+			Timeout:  domain.DefaultTestTimeout,
+			DiffCode: diffCode,
+		},
+	}
+
+	// Mock a survived mutation result
+	survivedResult := m.MutationResult{MutationID: "hash-0", Type: m.MutationArithmetic, Status: m.Survived}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], survivedResult).Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(survivedResult, nil)
+
+	// Verify that the report includes the diff for survived mutations
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
+		if len(reports) != 1 {
+			return false
+		}
+		report := reports[0]
+		// Check that diff is included for survived mutation
+		return report.Diff != nil && string(*report.Diff) == string(diffCode)
+	})).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		Reports:         "reports.json",
+		Threads:         1,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+	mockFSAdapter.AssertExpectations(t)
+	mockMutagen.AssertExpectations(t)
+	mockOrchestrator.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_TestWithKilledMutation(t *testing.T) {
+	// Arrange - This test checks that killed mutations do NOT include diff data
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
+
+	sources := []m.Source{
+		{
+			Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+			Test:   &m.File{FullPath: "test_test.go", Hash: "test_hash1"},
+		},
+	}
+
+	mutations := []m.Mutation{
+		{
+			ID:       "hash-0",
+			Source:   sources[0],
+			Type:     m.MutationArithmetic,
+			Timeout:  domain.DefaultTestTimeout,
+			DiffCode: diffCode,
+		},
+	}
+
+	// Mock a killed mutation result
+	killedResult := m.MutationResult{MutationID: "hash-0", Type: m.MutationArithmetic, Status: m.Killed}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
+	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], killedResult).Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(killedResult, nil)
+
+	// Verify that the report does NOT include diff for killed mutations
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.MatchedBy(func(reports []m.Report) bool {
+		if len(reports) != 1 {
+			return false
+		}
+		report := reports[0]
+		// Check that diff is NOT included for killed mutation
+		return report.Diff == nil
+	})).Return(nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	args := domain.TestArgs{
+		Reports:         "reports.json",
+		Threads:         1,
+		ShardIndex:      0,
+		TotalShardCount: 1,
+	}
+	err := wf.Test(args)
+
+	// Assert
+	assert.NoError(t, err)
+	mockFSAdapter.AssertExpectations(t)
+	mockMutagen.AssertExpectations(t)
+	mockOrchestrator.AssertExpectations(t)
+	mockReportStore.AssertExpectations(t)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_Estimate_Success(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
+	}
+
+	mutations := []m.Mutation{
+		{ID: "hash-0", Source: sources[0], Type: m.MutationArithmetic},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mock.MatchedBy(func(ms []m.Mutation) bool {
+		return len(ms) == 1
+	}), nil).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"test.go"}})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestWorkflow_DryRun_WritesDiffsWithoutTesting(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
+	}
+
+	mutations := []m.Mutation{
+		{ID: "hash-0", Source: sources[0], Type: m.MutationArithmetic},
+		{ID: "hash-1", Source: sources[0], Type: m.MutationBoolean},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mutations, nil).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockReportStore.EXPECT().SaveMutationDiffs(m.Path("out"), mutations, true).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.DryRun(domain.DryRunArgs{
+		EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"test.go"}, UseCache: true, Reports: "reports"},
+		Out:          "out",
+		FullFiles:    true,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	mockReportStore.AssertExpectations(t)
+	mockOrchestrator.AssertNotCalled(t, "TestMutation", mock.Anything)
+}
+
+func TestWorkflow_DryRun_SaveError(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	sources := []m.Source{
+		{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}},
+	}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	mockReportStore.EXPECT().SaveMutationDiffs(m.Path("out"), mock.Anything, false).Return(errors.New("disk full")).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.DryRun(domain.DryRunArgs{EstimateArgs: domain.EstimateArgs{Paths: []m.Path{"test.go"}}, Out: "out"})
+
+	// Assert
+	assert.ErrorContains(t, err, "save mutation diffs: disk full")
+}
+
+func TestWorkflow_DryRun_RequiresOut(t *testing.T) {
+	// Arrange
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(new(adaptermocks.MockSourceFSAdapter)),
+		domain.WithReportStore(new(adaptermocks.MockReportStore)),
+		domain.WithUI(new(controllermocks.MockUI)),
+		domain.WithOrchestrator(new(domainmocks.MockOrchestrator)),
+		domain.WithMutagen(new(domainmocks.MockMutagen)),
+	)
+
+	// Act
+	err := wf.DryRun(domain.DryRunArgs{})
+
+	// Assert
+	assert.ErrorContains(t, err, "output directory is required")
+}
+
+func TestWorkflow_Estimate_SkipsTestHelpers(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	code := m.Source{Origin: &m.File{FullPath: "calc.go", Hash: "hash1"}}
+	helper := m.Source{Origin: &m.File{FullPath: "asserts.go", Hash: "hash2"}, TestHelper: true}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mock.MatchedBy(func(ms []m.Mutation) bool {
+		return len(ms) == 1
+	}), nil).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{code, helper}, nil)
+	mockMutagen.EXPECT().GenerateMutation(code, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{{ID: "hash-0", Source: code, Type: m.MutationArithmetic}}, nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"./..."}})
+
+	// Assert
+	require.NoError(t, err)
+	mockMutagen.AssertExpectations(t)
+}
+
+func TestWorkflow_Estimate_NoSources(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	helper := m.Source{Origin: &m.File{FullPath: "asserts.go", Hash: "hash1"}, TestHelper: true}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{helper}, nil)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"./testutil/..."}})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrNoSources)
+	assert.Contains(t, err.Error(), "./testutil/...")
+	mockMutagen.AssertNotCalled(t, "GenerateMutation")
+}
+
+func TestWorkflow_Estimate_IncludeTestHelpers(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	code := m.Source{Origin: &m.File{FullPath: "calc.go", Hash: "hash1"}}
+	helper := m.Source{Origin: &m.File{FullPath: "asserts.go", Hash: "hash2"}, TestHelper: true}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mock.MatchedBy(func(ms []m.Mutation) bool {
+		return len(ms) == 2
+	}), nil).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{code, helper}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{{ID: "hash-0", Type: m.MutationArithmetic}}, nil).Twice()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"./..."}, IncludeTestHelpers: true})
+
+	// Assert
+	require.NoError(t, err)
+	mockMutagen.AssertExpectations(t)
+}
+
+func TestWorkflow_Estimate_BuildTagsSelectSources(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	code := m.Source{Origin: &m.File{FullPath: "calc.go", Hash: "hash1"}}
+	slow := m.Source{Origin: &m.File{FullPath: "slow.go", Hash: "hash2"}, Constraint: "slow"}
+	fast := m.Source{Origin: &m.File{FullPath: "fast.go", Hash: "hash3"}, Constraint: "!slow"}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mock.MatchedBy(func(ms []m.Mutation) bool {
+		return len(ms) == 2
+	}), nil).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{code, slow, fast}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.MatchedBy(func(source m.Source) bool {
+		return source.Origin.FullPath != "fast.go" && len(source.BuildTags) == 1 && source.BuildTags[0] == "slow"
+	}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{{ID: "hash-0", Type: m.MutationArithmetic}}, nil).Twice()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"./..."}, Tags: []string{"slow"}})
+
+	// Assert
+	require.NoError(t, err)
+	mockMutagen.AssertExpectations(t)
+}
+
+func TestWorkflow_Estimate_FuncNarrowsMutations(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	code := m.Source{Origin: &m.File{FullPath: "calc.go", Hash: "hash1"}}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mock.MatchedBy(func(ms []m.Mutation) bool {
+		return len(ms) == 1 && ms[0].ID == "hash-1"
+	}), nil).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{code}, nil)
+	mockMutagen.EXPECT().GenerateMutation(code, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{
+			{ID: "hash-0", Source: code, Func: "Add"},
+			{ID: "hash-1", Source: code, Func: "Calc.Add"},
+			{ID: "hash-2", Source: code},
+		}, nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	// UseCache is ignored: narrowed runs never consult the reports.
+	err := wf.Estimate(domain.EstimateArgs{
+		Paths:    []m.Path{"calc.go"},
+		UseCache: true,
+		Reports:  ".gooze-reports",
+		Func:     `^Calc\.`,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_Estimate_InvalidFuncPattern(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	code := m.Source{Origin: &m.File{FullPath: "calc.go", Hash: "hash1"}}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{code}, nil)
+	mockMutagen.EXPECT().GenerateMutation(code, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{{ID: "hash-0", Source: code, Func: "Add"}}, nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"calc.go"}, Func: "("})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid func pattern")
+}
+
+func TestWorkflow_Test_FuncRunIsNotSaved(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	code := m.Source{
+		Origin:       &m.File{FullPath: "calc.go", Hash: "hash1"},
+		PackageTests: true,
+	}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{code}, nil)
+	mockMutagen.EXPECT().GenerateMutation(code, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{
+			{ID: "hash-0", Source: code, Func: "Add"},
+			{ID: "hash-1", Source: code, Func: "Sub"},
+		}, nil).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.MatchedBy(func(mutation m.Mutation) bool {
+		return mutation.ID == "hash-0"
+	})).Return(m.MutationResult{Status: m.Killed}, nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	// The report store mock has no expectations, so any save, index or
+	// history call would fail the test.
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths:    []m.Path{"calc.go"},
+			UseCache: true,
+			Reports:  ".gooze-reports",
+			Func:     "^Add$",
+		},
+		Reports:         ".gooze-reports",
+		Threads:         1,
+		TotalShardCount: 1,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	mockOrchestrator.AssertExpectations(t)
+}
+
+func TestWorkflow_Test_SampledRunIsNotSaved(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	code := m.Source{Origin: &m.File{FullPath: "calc.go", Hash: "hash1"}, Test: &m.File{FullPath: "calc_test.go"}}
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(2).Return().Once()
+	mockUI.EXPECT().DisplayStartingTestInfo(mock.Anything, mock.Anything).Return().Times(2)
+	mockUI.EXPECT().DisplayCompletedTestInfo(mock.Anything, mock.Anything).Return().Times(2)
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{code}, nil)
+	mockMutagen.EXPECT().GenerateMutation(code, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{
+			{ID: "hash-0", Source: code},
+			{ID: "hash-1", Source: code},
+			{ID: "hash-2", Source: code},
+			{ID: "hash-3", Source: code},
+		}, nil).Once()
+	mockOrchestrator.EXPECT().TestMutation(mock.Anything).Return(m.MutationResult{Status: m.Killed}, nil).Times(2)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	// The report store mock has no expectations, so any save, index or
+	// history call would fail the test.
+	err := wf.Test(domain.TestArgs{
+		EstimateArgs: domain.EstimateArgs{
+			Paths:        []m.Path{"calc.go"},
+			UseCache:     true,
+			Reports:      ".gooze-reports",
+			MaxMutations: 2,
+		},
+		Reports:         ".gooze-reports",
+		Threads:         1,
+		TotalShardCount: 1,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	mockOrchestrator.AssertExpectations(t)
+}
+
+func TestWorkflow_Estimate_InvalidSample(t *testing.T) {
+	// Arrange
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(new(adaptermocks.MockSourceFSAdapter)),
+		domain.WithReportStore(new(adaptermocks.MockReportStore)),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(new(domainmocks.MockOrchestrator)),
+		domain.WithMutagen(new(domainmocks.MockMutagen)),
+	)
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"calc.go"}, Sample: 1.5})
+
+	// Assert
+	require.ErrorContains(t, err, "sample must be between 0 and 1")
+}
+
+func TestWorkflow_Estimate_SingleFileKeepsOtherReports(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	root := t.TempDir()
+	calc := m.Source{Origin: &m.File{FullPath: m.Path(filepath.Join(root, "calc.go")), Hash: "hash1"}, PackageTests: true}
+	other := m.Source{Origin: &m.File{FullPath: m.Path(filepath.Join(root, "cmd", "main.go")), Hash: "hash2"}}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mock.Anything, nil).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{calc}, nil)
+	// The stored report for cmd/main.go is absent from this run only because
+	// a single file was requested; it must not be cleaned as deleted.
+	mockReportStore.EXPECT().CheckUpdates(m.Path(".gooze-reports"), []m.Source{calc}).Return([]m.Source{calc, other}, nil).Once()
+	mockMutagen.EXPECT().GenerateMutation(calc, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]m.Mutation{{ID: "hash-0", Source: calc}}, nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{
+		Paths:    []m.Path{calc.Origin.FullPath},
+		UseCache: true,
+		Reports:  ".gooze-reports",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	mockReportStore.AssertNotCalled(t, "CleanReports", mock.Anything, mock.Anything)
+}
+
+func TestWorkflow_Estimate_ProjectsRuntime(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
-	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
-	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	sources := []m.Source{
@@ -1123,25 +3021,82 @@ func TestWorkflow_Estimate_Success(t *testing.T) {
 
 	mutations := []m.Mutation{
 		{ID: "hash-0", Source: sources[0], Type: m.MutationArithmetic},
+		{ID: "hash-1", Source: sources[0], Type: m.MutationArithmetic},
 	}
 
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
 	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
-	mockUI.EXPECT().DisplayEstimation(mock.MatchedBy(func(ms []m.Mutation) bool {
-		return len(ms) == 1
-	}), nil).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mock.Anything, nil).Return(nil).Once()
+	mockUI.EXPECT().DisplayRuntimeEstimate(m.RuntimeEstimate{
+		Packages:   1,
+		Threads:    2,
+		Total:      3 * time.Second,
+		ShardIndex: 1,
+		ShardCount: 2,
+		// Only hash-0 falls in shard 1 of 2.
+		Shard: 1500 * time.Millisecond,
+	}, nil).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().BaselineTimes(mutations).
+		Return(map[string]time.Duration{"hash-0": 3 * time.Second, "hash-1": 3 * time.Second}, 1, nil).Once()
+
+	// Act
+	err := wf.Estimate(domain.EstimateArgs{
+		Paths:   []m.Path{"test.go"},
+		Runtime: &domain.RuntimeArgs{Threads: 2, ShardIndex: 1, TotalShardCount: 2},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_Estimate_RuntimeBaselineFails(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	sources := []m.Source{{Origin: &m.File{FullPath: "test.go", Hash: "hash1"}}}
+	mutations := []m.Mutation{{ID: "hash-0", Source: sources[0], Type: m.MutationArithmetic}}
+
+	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().DisplayEstimation(mock.Anything, nil).Return(nil).Once()
+	mockUI.EXPECT().DisplayRuntimeEstimate(m.RuntimeEstimate{}, domain.ErrBaselineFailing).Return(domain.ErrBaselineFailing).Once()
+	mockUI.EXPECT().Close().Return().Once()
+
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
+	mockOrchestrator.EXPECT().BaselineTimes(mutations).Return(nil, 0, domain.ErrBaselineFailing).Once()
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
-	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"test.go"}})
+	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"test.go"}, Runtime: &domain.RuntimeArgs{Threads: 1}})
 
 	// Assert
-	assert.NoError(t, err)
+	require.ErrorIs(t, err, domain.ErrBaselineFailing)
 }
 
 func TestWorkflow_Estimate_StartError(t *testing.T) {
@@ -1149,15 +3104,28 @@ func TestWorkflow_Estimate_StartError(t *testing.T) {
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	startErr := errors.New("start failed")
 	mockUI.EXPECT().Start(mock.Anything).Return(startErr).Once()
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"test.go"}})
@@ -1171,9 +3139,16 @@ func TestWorkflow_Estimate_GetMutationsError(t *testing.T) {
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	getErr := errors.New("get mutations failed")
@@ -1182,7 +3157,13 @@ func TestWorkflow_Estimate_GetMutationsError(t *testing.T) {
 	mockUI.EXPECT().Close().Return().Once()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(nil, getErr)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"test.go"}})
@@ -1197,9 +3178,16 @@ func TestWorkflow_Estimate_DisplayError(t *testing.T) {
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	sources := []m.Source{
@@ -1219,7 +3207,13 @@ func TestWorkflow_Estimate_DisplayError(t *testing.T) {
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	err := wf.Estimate(domain.EstimateArgs{Paths: []m.Path{"test.go"}})
@@ -1237,7 +3231,7 @@ type blockingOrchestrator struct {
 	max        int32
 }
 
-func (o *blockingOrchestrator) TestMutation(mutation m.Mutation) (m.Result, error) {
+func (o *blockingOrchestrator) TestMutation(mutation m.Mutation) (m.MutationResult, error) {
 	atomic.AddInt32(&o.current, 1)
 	for {
 		current := atomic.LoadInt32(&o.current)
@@ -1254,20 +3248,29 @@ func (o *blockingOrchestrator) TestMutation(mutation m.Mutation) (m.Result, erro
 
 	atomic.AddInt32(&o.current, -1)
 
-	result := m.Result{}
-	result[mutation.Type] = []struct {
-		MutationID string
-		Status     m.TestStatus
-		Err        error
-	}{
-		{
-			MutationID: "hash-0",
-			Status:     m.Killed,
-			Err:        nil,
-		},
-	}
+	return m.MutationResult{MutationID: mutation.ID, Type: mutation.Type, Status: m.Killed}, nil
+}
+
+func (o *blockingOrchestrator) SetRunOptions(domain.RunOptions) {}
+
+func (o *blockingOrchestrator) PrepareWorkspaces(_ []m.Mutation, _ int) error {
+	return nil
+}
+
+func (o *blockingOrchestrator) ReleaseWorkspaces() error {
+	return nil
+}
+
+func (o *blockingOrchestrator) Baseline(_ []m.Mutation) error {
+	return nil
+}
+
+func (o *blockingOrchestrator) BaselineTimes(_ []m.Mutation) (map[string]time.Duration, int, error) {
+	return nil, 0, nil
+}
 
-	return result, nil
+func (o *blockingOrchestrator) Coverage(_ []m.Mutation, _ []string, _ time.Duration) (m.Coverage, error) {
+	return nil, nil
 }
 
 func TestWorkflow_TestThreadLimitIsRespected(t *testing.T) {
@@ -1275,12 +3278,18 @@ func TestWorkflow_TestThreadLimitIsRespected(t *testing.T) {
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	source := m.Source{
 		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go"},
 	}
 
 	mutations := []m.Mutation{
@@ -1293,7 +3302,7 @@ func TestWorkflow_TestThreadLimitIsRespected(t *testing.T) {
 		block:   make(chan struct{}),
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
@@ -1306,7 +3315,13 @@ func TestWorkflow_TestThreadLimitIsRespected(t *testing.T) {
 		return len(reports) == 2
 	})).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, blocking, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(blocking),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	args := domain.TestArgs{
@@ -1347,20 +3362,30 @@ func TestWorkflow_TestThreadIDStartsAtZero(t *testing.T) {
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	source := m.Source{
 		Origin: &m.File{FullPath: "test.go", Hash: "hash1"},
+		Test:   &m.File{FullPath: "test_test.go"},
 	}
 
 	mutations := []m.Mutation{
-		{ID: "hash-0", Source: source, Type: m.MutationArithmetic},
+		{ID: "hash-0", Source: source, Type: m.MutationArithmetic, Timeout: domain.DefaultTestTimeout},
 	}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
@@ -1369,11 +3394,17 @@ func TestWorkflow_TestThreadIDStartsAtZero(t *testing.T) {
 	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], mock.Anything).Return().Once()
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{source}, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
-	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(m.Result{}, nil)
+	mockOrchestrator.EXPECT().TestMutation(mutations[0]).Return(m.MutationResult{}, nil)
 	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	args := domain.TestArgs{
@@ -1388,14 +3419,23 @@ func TestWorkflow_TestThreadIDStartsAtZero(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestWorkflow_TestExactMutationIDMatch(t *testing.T) {
+func TestWorkflow_TestResultAttributedToSubmittedMutation(t *testing.T) {
 	// Arrange
 	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
 	mockReportStore := new(adaptermocks.MockReportStore)
 	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().RecordRun(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
 	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return().Maybe()
 	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
 	mockMutagen := new(domainmocks.MockMutagen)
 
 	diffCode := []byte("--- original\n+++ mutated\n@@ -1,1 +1,1 @@\n-\treturn 3 + 5\n+\treturn 3 - 5\n")
@@ -1412,28 +3452,23 @@ func TestWorkflow_TestExactMutationIDMatch(t *testing.T) {
 			ID:       "hash-1",
 			Source:   sources[0],
 			Type:     m.MutationArithmetic,
+			Timeout:  domain.DefaultTestTimeout,
 			DiffCode: diffCode,
 		},
 	}
 
-	result := m.Result{
-		m.MutationArithmetic: []struct {
-			MutationID string
-			Status     m.TestStatus
-			Err        error
-		}{
-			{MutationID: "hash-0", Status: m.Killed},
-			{MutationID: "hash-1", Status: m.Survived},
-		},
-	}
+	// The orchestrator result carries no identity; the workflow attributes it
+	// to the mutation it submitted.
+	result := m.MutationResult{Status: m.Survived}
+	attributed := m.MutationResult{MutationID: "hash-1", Type: m.MutationArithmetic, Status: m.Survived}
 
-	mockUI.EXPECT().Start(mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	mockUI.EXPECT().Wait().Return().Once()
 	mockUI.EXPECT().Close().Return().Once()
 	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
 	mockUI.EXPECT().DisplayUpcomingTestsInfo(1).Return()
 	mockUI.EXPECT().DisplayStartingTestInfo(mutations[0], 0).Return().Once()
-	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], result).Return().Once()
+	mockUI.EXPECT().DisplayCompletedTestInfo(mutations[0], attributed).Return().Once()
 
 	mockFSAdapter.EXPECT().Get(mock.Anything).Return(sources, nil)
 	mockMutagen.EXPECT().GenerateMutation(mock.Anything, domain.DefaultMutations[0], domain.DefaultMutations[1], domain.DefaultMutations[2], domain.DefaultMutations[3], domain.DefaultMutations[4], domain.DefaultMutations[5]).Return(mutations, nil)
@@ -1444,10 +3479,17 @@ func TestWorkflow_TestExactMutationIDMatch(t *testing.T) {
 			return false
 		}
 		report := reports[0]
-		return report.Diff != nil && string(*report.Diff) == string(diffCode)
+		return len(report.Result) == 1 && assert.ObjectsAreEqual(attributed, report.Result[0]) &&
+			report.Diff != nil && string(*report.Diff) == string(diffCode)
 	})).Return(nil)
 
-	wf := domain.NewWorkflow(mockFSAdapter, mockReportStore, mockUI, mockOrchestrator, mockMutagen)
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
 
 	// Act
 	args := domain.TestArgs{
@@ -1461,3 +3503,441 @@ func TestWorkflow_TestExactMutationIDMatch(t *testing.T) {
 	// Assert
 	assert.NoError(t, err)
 }
+
+func TestWorkflow_Stats_DisplaysHistoryTrends(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	runs := []m.RunRecord{
+		{Time: start, Score: 0.5, Survivors: []string{"a"}},
+		{Time: start.Add(time.Hour), Score: 1},
+	}
+
+	mockReportStore.EXPECT().LoadHistory(m.Path("reports")).Return(runs, nil).Once()
+	mockUI.EXPECT().DisplayStats(mock.MatchedBy(func(stats m.HistoryStats) bool {
+		return len(stats.Runs) == 2 && stats.KilledSurvivors == 1 && stats.MeanTimeToKill == time.Hour
+	}), nil).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Stats(domain.StatsArgs{Reports: "reports"})
+
+	// Assert
+	require.NoError(t, err)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_Stats_LoadHistoryError(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	mockReportStore.EXPECT().LoadHistory(m.Path("reports")).Return(nil, errors.New("corrupt history")).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Stats(domain.StatsArgs{Reports: "reports"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "load history")
+	mockUI.AssertNotCalled(t, "DisplayStats", mock.Anything, mock.Anything)
+}
+
+func TestWorkflow_CompareReports_DisplaysComparison(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	source := m.Source{Origin: &m.File{ShortPath: "calc.go", FullPath: "/project/calc.go"}}
+	oldReports := []m.Report{{Source: source, Result: m.Result{{MutationID: "a1", Type: m.MutationArithmetic, Status: m.Killed}}}}
+	newReports := []m.Report{{Source: source, Result: m.Result{{MutationID: "a1", Type: m.MutationArithmetic, Status: m.Survived}}}}
+
+	mockReportStore.EXPECT().LoadReports(m.Path("base")).Return(oldReports, nil).Once()
+	mockReportStore.EXPECT().LoadReports(m.Path("head")).Return(newReports, nil).Once()
+	mockUI.EXPECT().DisplayComparison(mock.MatchedBy(func(comparison m.ReportComparison) bool {
+		return comparison.OldScore == 1 && comparison.NewScore == 0 &&
+			len(comparison.NewlySurvived) == 1 && comparison.NewlySurvived[0].ID == "a1"
+	}), nil).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.CompareReports(domain.CompareArgs{Old: "base", New: "head"})
+
+	// Assert
+	require.NoError(t, err)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_CompareReports_LoadError(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	mockReportStore.EXPECT().LoadReports(m.Path("base")).Return(nil, errors.New("read reports directory: permission denied")).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.CompareReports(domain.CompareArgs{Old: "base", New: "head"})
+
+	// Assert
+	require.ErrorContains(t, err, "permission denied")
+	require.ErrorContains(t, err, "load reports from base")
+	mockUI.AssertNotCalled(t, "DisplayComparison", mock.Anything, mock.Anything)
+}
+
+func TestWorkflow_Trend_DisplaysLastRuns(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	runs := []m.RunRecord{
+		{Time: start, Score: 0.5},
+		{Time: start.Add(time.Hour), Score: 0.6},
+		{Time: start.Add(2 * time.Hour), Score: 0.7},
+	}
+
+	mockReportStore.EXPECT().LoadHistory(m.Path("reports")).Return(runs, nil).Once()
+	mockUI.EXPECT().DisplayTrend(runs[1:], nil).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Trend(domain.TrendArgs{Reports: "reports", Last: 2})
+
+	// Assert
+	require.NoError(t, err)
+	mockUI.AssertExpectations(t)
+}
+
+func TestWorkflow_Trend_RejectsNegativeLast(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Trend(domain.TrendArgs{Reports: "reports", Last: -1})
+
+	// Assert
+	require.Error(t, err)
+	mockReportStore.AssertNotCalled(t, "LoadHistory", mock.Anything)
+}
+
+func TestWorkflow_MarkEquivalent_MarksSurvivorByPrefix(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	reports := []m.Report{
+		{Result: m.Result{{MutationID: "abcd1234", Status: m.Survived}, {MutationID: "ef015678", Status: m.Killed}}},
+	}
+
+	mockReportStore.EXPECT().LoadReports(m.Path("reports")).Return(reports, nil).Once()
+	mockReportStore.EXPECT().MarkEquivalent(m.Path("reports"), "abcd1234").Return(nil).Once()
+	mockReportStore.EXPECT().RegenerateIndex(m.Path("reports")).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.MarkEquivalent(domain.MarkEquivalentArgs{Reports: "reports", MutationID: "abcd"})
+
+	// Assert
+	require.NoError(t, err)
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestWorkflow_MarkEquivalent_RejectsKilledMutation(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	reports := []m.Report{
+		{Result: m.Result{{MutationID: "ef015678", Status: m.Killed}}},
+	}
+
+	mockReportStore.EXPECT().LoadReports(m.Path("reports")).Return(reports, nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.MarkEquivalent(domain.MarkEquivalentArgs{Reports: "reports", MutationID: "ef01"})
+
+	// Assert
+	require.ErrorContains(t, err, "only survived mutations")
+	mockReportStore.AssertNotCalled(t, "MarkEquivalent", mock.Anything, mock.Anything)
+}
+
+func TestWorkflow_Badge_ExportsScore(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	reports := []m.Report{
+		{Result: m.Result{{Status: m.Killed}, {Status: m.Killed}, {Status: m.Killed}, {Status: m.Killed}, {Status: m.Survived}}},
+	}
+
+	mockReportStore.EXPECT().LoadReports(m.Path("reports")).Return(reports, nil).Once()
+	mockReportStore.EXPECT().ExportBadge(m.Path("badge.svg"), m.Path("badge.json"), m.Badge{
+		Label:   domain.BadgeLabel,
+		Message: "80%",
+		Color:   "brightgreen",
+	}).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Badge(domain.BadgeArgs{Reports: "reports", Out: "badge.svg", Endpoint: "badge.json"})
+
+	// Assert
+	require.NoError(t, err)
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestWorkflow_Badge_NoReports(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	mockReportStore.EXPECT().LoadReports(m.Path("reports")).Return(nil, nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Badge(domain.BadgeArgs{Reports: "reports", Out: "badge.svg"})
+
+	// Assert
+	require.ErrorContains(t, err, "no reports in reports")
+	mockReportStore.AssertNotCalled(t, "ExportBadge", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorkflow_Test_ShardedRunIsNotRecorded(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockReportStore.EXPECT().LoadEquivalents(mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SavePending(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockUI := new(controllermocks.MockUI)
+	mockUI.EXPECT().Done().Return(nil).Maybe()
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockOrchestrator.EXPECT().PrepareWorkspaces(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockOrchestrator.EXPECT().ReleaseWorkspaces().Return(nil).Maybe()
+	mockOrchestrator.EXPECT().Baseline(mock.Anything).Return(nil).Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+
+	mockUI.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockUI.EXPECT().Wait().Return().Once()
+	mockUI.EXPECT().Close().Return().Once()
+	mockUI.EXPECT().DisplayConcurrencyInfo(mock.Anything, mock.Anything, mock.Anything).Return()
+	mockUI.EXPECT().DisplayUpcomingTestsInfo(mock.Anything).Return()
+	mockUI.EXPECT().DisplayMutationScore(mock.Anything).Return()
+	mockFSAdapter.EXPECT().Get(mock.Anything).Return([]m.Source{{Origin: &m.File{FullPath: "test.go"}, Test: &m.File{FullPath: "test_test.go"}}}, nil)
+	mockMutagen.EXPECT().GenerateMutation(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	mockReportStore.EXPECT().SaveReports(mock.Anything, mock.Anything).Return(nil)
+	mockReportStore.EXPECT().RegenerateIndex(mock.Anything).Return(nil)
+	mockReportStore.EXPECT().SaveManifest(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Test(domain.TestArgs{
+		Reports:         "reports",
+		Threads:         1,
+		ShardIndex:      1,
+		TotalShardCount: 2,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	mockReportStore.AssertNotCalled(t, "RecordRun", mock.Anything, mock.Anything)
+}
+
+func TestWorkflow_Notify_PushesSummary(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+	mockNotifier := adaptermocks.NewMockNotifier(t)
+
+	source := m.Source{Origin: &m.File{ShortPath: "calc.go", FullPath: "/project/calc.go"}}
+	diff := []byte("-a + b\n+a - b\n")
+	baseReports := []m.Report{{Source: source, Result: m.Result{{MutationID: "a1", Type: m.MutationArithmetic, Status: m.Killed}}}}
+	headReports := []m.Report{{Source: source, Result: m.Result{{MutationID: "a1", Type: m.MutationArithmetic, Status: m.Survived}}, Diff: &diff}}
+
+	mockReportStore.EXPECT().LoadReports(m.Path("head")).Return(headReports, nil).Once()
+	mockReportStore.EXPECT().LoadReports(m.Path("base")).Return(baseReports, nil).Once()
+	mockNotifier.EXPECT().Notify(mock.MatchedBy(func(summary m.RunSummary) bool {
+		return summary.HasBase && summary.BaseScore == 1 && summary.Score == 0 && summary.Survived == 1 &&
+			len(summary.TopSurvived) == 1 && summary.TopSurvived[0].Added &&
+			string(summary.TopSurvived[0].Diff) == string(diff)
+	})).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Notify(domain.NotifyArgs{Reports: "head", Base: "base", Notifiers: []adapter.Notifier{mockNotifier}})
+
+	// Assert
+	require.NoError(t, err)
+	mockReportStore.AssertExpectations(t)
+}
+
+func TestWorkflow_Notify_TriesEveryNotifier(t *testing.T) {
+	// Arrange
+	mockFSAdapter := new(adaptermocks.MockSourceFSAdapter)
+	mockReportStore := new(adaptermocks.MockReportStore)
+	mockUI := new(controllermocks.MockUI)
+	mockOrchestrator := new(domainmocks.MockOrchestrator)
+	mockOrchestrator.EXPECT().SetRunOptions(mock.Anything).Return().Maybe()
+	mockMutagen := new(domainmocks.MockMutagen)
+	failing := adaptermocks.NewMockNotifier(t)
+	working := adaptermocks.NewMockNotifier(t)
+
+	reports := []m.Report{{Source: m.Source{Origin: &m.File{FullPath: "/project/calc.go"}}, Result: m.Result{{Status: m.Killed}}}}
+
+	mockReportStore.EXPECT().LoadReports(m.Path("head")).Return(reports, nil).Once()
+	failing.EXPECT().Notify(mock.Anything).Return(errors.New("github: POST: 403 Forbidden")).Once()
+	working.EXPECT().Notify(mock.Anything).Return(nil).Once()
+
+	wf := domain.NewWorkflow(
+		domain.WithSourceFS(mockFSAdapter),
+		domain.WithReportStore(mockReportStore),
+		domain.WithUI(mockUI),
+		domain.WithOrchestrator(mockOrchestrator),
+		domain.WithMutagen(mockMutagen),
+	)
+
+	// Act
+	err := wf.Notify(domain.NotifyArgs{Reports: "head", Notifiers: []adapter.Notifier{failing, working}})
+
+	// Assert
+	require.ErrorContains(t, err, "403 Forbidden")
+}
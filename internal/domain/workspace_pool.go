@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// workspace is one reusable copy of a project. A dirty workspace may still
+// contain a mutated file and is copied over from the project before reuse.
+// binaries holds the test binaries built in it, by testBinaryKey; those
+// that failed to build are empty.
+type workspace struct {
+	dir      m.Path
+	dirty    bool
+	binaries map[string]string
+}
+
+// workspacePool holds the copies of one project root, one per worker thread.
+type workspacePool struct {
+	root       m.Path
+	idle       chan *workspace
+	workspaces []*workspace
+}
+
+func (p *workspacePool) acquire() *workspace {
+	return <-p.idle
+}
+
+func (p *workspacePool) release(ws *workspace) {
+	p.idle <- ws
+}
+
+// PrepareWorkspaces creates threads copies of every project the mutations
+// belong to, so TestMutation reuses them instead of copying the project for
+// each mutation. Previously prepared workspaces are released first.
+func (to *orchestrator) PrepareWorkspaces(mutations []m.Mutation, threads int) error {
+	if err := to.ReleaseWorkspaces(); err != nil {
+		return err
+	}
+
+	if threads <= 0 {
+		threads = 1
+	}
+
+	to.mu.Lock()
+	defer to.mu.Unlock()
+
+	for _, mutation := range mutations {
+		if mutation.Source.Origin == nil || !hasTests(mutation) {
+			continue
+		}
+
+		sourcePath := mutation.Source.Origin.FullPath
+		if _, ok := to.roots[sourcePath]; ok {
+			continue
+		}
+
+		projectRoot, err := to.fsAdapter.FindProjectRoot(sourcePath)
+		if err != nil {
+			return errors.Join(
+				newInfraError(PhaseProjectRoot, mutation, fmt.Errorf("failed to find project root: %w", err)),
+				to.releaseLocked(),
+			)
+		}
+
+		to.roots[sourcePath] = projectRoot
+
+		if _, ok := to.pools[projectRoot]; ok {
+			continue
+		}
+
+		pool, err := to.newWorkspacePool(mutation, projectRoot, threads)
+		if err != nil {
+			return errors.Join(err, to.releaseLocked())
+		}
+
+		to.pools[projectRoot] = pool
+	}
+
+	return nil
+}
+
+// ReleaseWorkspaces removes the workspaces created by PrepareWorkspaces.
+func (to *orchestrator) ReleaseWorkspaces() error {
+	to.mu.Lock()
+	defer to.mu.Unlock()
+
+	return to.releaseLocked()
+}
+
+func (to *orchestrator) releaseLocked() error {
+	var errs []error
+
+	for _, pool := range to.pools {
+		for _, ws := range pool.workspaces {
+			if err := to.fsAdapter.RemoveAll(ws.dir); err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove workspace %s: %w", ws.dir, err))
+			}
+		}
+	}
+
+	to.pools = make(map[m.Path]*workspacePool)
+	to.roots = make(map[m.Path]m.Path)
+
+	return errors.Join(errs...)
+}
+
+func (to *orchestrator) newWorkspacePool(mutation m.Mutation, projectRoot m.Path, size int) (*workspacePool, error) {
+	pool := &workspacePool{
+		root: projectRoot,
+		idle: make(chan *workspace, size),
+	}
+
+	for range size {
+		dir, err := to.fsAdapter.CreateTempDir(to.runOptions().WorkDir, "gooze-workspace-*")
+		if err != nil {
+			return nil, errors.Join(
+				newInfraError(PhaseCreateWorkspace, mutation, fmt.Errorf("failed to create temp dir: %w", err)),
+				to.removeWorkspaces(pool.workspaces),
+			)
+		}
+
+		ws := &workspace{dir: dir}
+		pool.workspaces = append(pool.workspaces, ws)
+
+		if err := to.fsAdapter.CopyDir(projectRoot, dir); err != nil {
+			return nil, errors.Join(
+				newInfraError(PhaseCopyProject, mutation, fmt.Errorf("failed to copy project: %w", err)),
+				to.removeWorkspaces(pool.workspaces),
+			)
+		}
+
+		pool.idle <- ws
+	}
+
+	return pool, nil
+}
+
+func (to *orchestrator) removeWorkspaces(workspaces []*workspace) error {
+	var errs []error
+
+	for _, ws := range workspaces {
+		if err := to.fsAdapter.RemoveAll(ws.dir); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove workspace %s: %w", ws.dir, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// pooledWorkspace returns the pool prepared for the mutation's project, if any.
+func (to *orchestrator) pooledWorkspace(mutation m.Mutation) (*workspacePool, bool) {
+	to.mu.Lock()
+	defer to.mu.Unlock()
+
+	projectRoot, ok := to.roots[mutation.Source.Origin.FullPath]
+	if !ok {
+		return nil, false
+	}
+
+	pool, ok := to.pools[projectRoot]
+
+	return pool, ok
+}
+
+// testInPool tests the mutation in a pooled workspace and writes the original
+// source back afterwards. A workspace that could not be restored stays dirty
+// and is copied from the project again by its next user.
+func (to *orchestrator) testInPool(pool *workspacePool, mutation m.Mutation) (m.MutationResult, error) {
+	ws := pool.acquire()
+	defer pool.release(ws)
+
+	if ws.dirty {
+		if err := to.fsAdapter.CopyDir(pool.root, ws.dir); err != nil {
+			return m.MutationResult{}, newInfraError(PhaseRestoreWorkspace, mutation, fmt.Errorf("failed to restore workspace: %w", err))
+		}
+
+		ws.dirty = false
+	}
+
+	original, err := to.fsAdapter.ReadFile(mutation.Source.Origin.FullPath)
+	if err != nil {
+		return m.MutationResult{}, newInfraError(PhaseRestoreWorkspace, mutation, fmt.Errorf("failed to read original source: %w", err))
+	}
+
+	tmpSourcePath, err := to.buildTempSourcePath(pool.root, ws.dir, mutation.Source.Origin.FullPath)
+	if err != nil {
+		return m.MutationResult{}, err
+	}
+
+	ws.dirty = true
+
+	result, err := to.testInWorkspace(ws, mutation, pool.root, ws.dir, tmpSourcePath)
+
+	if writeErr := to.fsAdapter.WriteFile(tmpSourcePath, original, 0o600); writeErr == nil {
+		ws.dirty = false
+	}
+
+	return result, err
+}
@@ -0,0 +1,9 @@
+package model
+
+// AppliedMutation records a mutation written into the working tree by gooze
+// apply, with the file's original content to restore on gooze revert.
+type AppliedMutation struct {
+	MutationID string
+	Path       Path
+	Original   []byte
+}
@@ -0,0 +1,9 @@
+package model
+
+// Badge is a shield summarizing a run, such as "mutation score | 85%".
+// Color is a shields.io color name.
+type Badge struct {
+	Label   string
+	Message string
+	Color   string
+}
@@ -0,0 +1,38 @@
+package model
+
+import "fmt"
+
+// CacheStatus describes the reports directory that caches results between
+// runs.
+type CacheStatus struct {
+	Dir Path
+	// Size is the total size in bytes of the files in Dir.
+	Size int64
+	// Sources counts the source files with a stored report.
+	Sources   int
+	Mutations int
+	// Stale lists the stored sources that no longer exist on disk.
+	Stale []Path
+	// Outdated lists the stored sources whose code, tests or mutators
+	// changed since they were tested, so the next run tests them again.
+	Outdated []Path
+	// Cleaned marks a status taken after the stale reports were removed.
+	Cleaned bool
+}
+
+// FormatBytes renders n with a binary unit, such as 1.5 GiB.
+func FormatBytes(n uint64) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := uint64(unit), 0
+	for rest := n / unit; rest >= unit; rest /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,24 @@
+package model
+
+// ComparedMutation is a mutant whose outcome changed between two report sets.
+type ComparedMutation struct {
+	ID   string
+	Path Path
+	Type MutationType
+	// Added marks a mutant absent from the older report set, such as one in
+	// newly written code.
+	Added bool
+}
+
+// ReportComparison lists how the outcome of mutants changed from an older
+// report set to a newer one.
+type ReportComparison struct {
+	OldScore float64
+	NewScore float64
+	// NewlySurvived are survivors of the newer set that the older set killed
+	// or did not contain.
+	NewlySurvived []ComparedMutation
+	// NewlyKilled are mutants the older set reported as surviving and the
+	// newer set kills.
+	NewlyKilled []ComparedMutation
+}
@@ -0,0 +1,22 @@
+package model
+
+// Coverage holds, per source file, the lines its tests executed.
+type Coverage map[Path]map[int]bool
+
+// Covers reports whether the tests executed line of file.
+func (c Coverage) Covers(file Path, line int) bool {
+	return c[file][line]
+}
+
+// Add marks lines from through to of file as executed.
+func (c Coverage) Add(file Path, from int, to int) {
+	lines := c[file]
+	if lines == nil {
+		lines = make(map[int]bool)
+		c[file] = lines
+	}
+
+	for line := from; line <= to; line++ {
+		lines[line] = true
+	}
+}
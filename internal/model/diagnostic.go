@@ -0,0 +1,69 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// DiagnosticsFormat selects the file layout survived mutations are exported
+// in for editors and code review tools.
+type DiagnosticsFormat string
+
+const (
+	// DiagnosticsRDJSON is reviewdog's Diagnostic Format (rdjson): one
+	// document listing every diagnostic with a repository-relative path and
+	// 1-based positions.
+	DiagnosticsRDJSON DiagnosticsFormat = "rdjson"
+	// DiagnosticsLSP is a list of Language Server Protocol
+	// PublishDiagnosticsParams, one per file, with file URIs and 0-based
+	// positions.
+	DiagnosticsLSP DiagnosticsFormat = "lsp"
+)
+
+// DiagnosticsFormats lists the accepted diagnostics formats.
+var DiagnosticsFormats = []DiagnosticsFormat{DiagnosticsRDJSON, DiagnosticsLSP}
+
+// Diagnostic locates a survived mutation in the original source.
+type Diagnostic struct {
+	MutationID string
+	Type       MutationType
+	// File is the mutated source; Line and Column are 1-based, the column
+	// counting bytes.
+	File   File
+	Line   int
+	Column int
+	// Func names the function the mutation is in, if any.
+	Func string
+	Diff []byte
+}
+
+// Message names the surviving mutation and shows its diff hunks, without the
+// file headers the diagnostic's location already gives.
+func (d Diagnostic) Message() string {
+	message := fmt.Sprintf("%s mutation survived", d.Type.Name)
+	if d.Func != "" {
+		message += " in " + d.Func
+	}
+
+	message += fmt.Sprintf(" (%s): no test fails with this change", d.MutationID)
+
+	if hunks := diffHunks(d.Diff); len(hunks) > 0 {
+		message += "\n" + string(hunks)
+	}
+
+	return message
+}
+
+// diffHunks returns diff from its first hunk header on.
+func diffHunks(diff []byte) []byte {
+	if bytes.HasPrefix(diff, []byte("@@")) {
+		return bytes.TrimRight(diff, "\n")
+	}
+
+	index := bytes.Index(diff, []byte("\n@@"))
+	if index < 0 {
+		return bytes.TrimRight(diff, "\n")
+	}
+
+	return bytes.TrimRight(diff[index+1:], "\n")
+}
@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+// RunRecord summarizes one completed run in the reports history.
+type RunRecord struct {
+	Time     time.Time
+	Total    int
+	Killed   int
+	Survived int
+	Skipped  int
+	Errors   int
+	// NotRun counts mutations a time budget left untested.
+	NotRun int
+	// Flaky counts kills the original code's tests failed as well.
+	Flaky int
+	// CompileErrors counts the mutants that did not compile.
+	CompileErrors int
+	Score         float64
+	Duration      time.Duration
+	// Survivors holds the sorted IDs of mutants that survived the run.
+	Survivors []string
+	// TimedOut holds the sorted IDs of mutants killed by a timeout.
+	TimedOut []string
+	// Commit is the git SHA checked out when the run was recorded, if any.
+	Commit string
+}
+
+// WeekCount is a count attributed to an ISO week such as "2026-W07".
+type WeekCount struct {
+	Week  string
+	Count int
+}
+
+// HistoryStats summarizes trends across the recorded run history.
+type HistoryStats struct {
+	Runs []RunRecord
+	// NewSurvivorsByWeek counts survivors seen for the first time, by week.
+	NewSurvivorsByWeek []WeekCount
+	// MeanTimeToKill averages, over survivors that stopped surviving, the time
+	// from the first run they survived to the first run they no longer did.
+	MeanTimeToKill time.Duration
+	// KilledSurvivors is the number of survivors MeanTimeToKill is based on.
+	KilledSurvivors int
+	// OpenSurvivors is the number of mutants surviving in the latest run.
+	OpenSurvivors int
+}
+
+// RunFailure describes a run aborted by an infrastructure problem rather than
+// by the tests under mutation.
+type RunFailure struct {
+	Time  time.Time
+	Phase string
+	Error string
+	// MutationID and Path identify the mutation being tested, when known.
+	MutationID string
+	Path       Path
+}
@@ -0,0 +1,38 @@
+package model
+
+// IntegrityProblemKind names what is wrong with a file of a reports
+// directory.
+type IntegrityProblemKind string
+
+const (
+	// IntegrityCorrupt means a report or index file is not valid YAML.
+	IntegrityCorrupt IntegrityProblemKind = "corrupt"
+	// IntegrityOrphaned means a report file is not listed in the index.
+	IntegrityOrphaned IntegrityProblemKind = "orphaned"
+	// IntegrityMissing means the index lists a file that does not exist.
+	IntegrityMissing IntegrityProblemKind = "missing"
+	// IntegrityCollision means a report file is not named after the hash of
+	// its results, or shares a mutation ID with another report file.
+	IntegrityCollision IntegrityProblemKind = "collision"
+)
+
+// IntegrityProblem is one inconsistency found in a reports directory.
+type IntegrityProblem struct {
+	Kind IntegrityProblemKind
+	// File is the slash-separated path of the file, relative to the
+	// reports directory.
+	File   string
+	Detail string
+}
+
+// IntegrityReport is the outcome of checking a reports directory.
+type IntegrityReport struct {
+	Dir Path
+	// Reports counts the report files checked.
+	Reports  int
+	Problems []IntegrityProblem
+	// Repaired marks a report taken after repairing the directory; Fixed
+	// lists the problems found before that are gone.
+	Repaired bool
+	Fixed    []IntegrityProblem
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// RunManifest describes how a reports directory was produced. The report
+// store adds the list of files and their hashes when writing it.
+type RunManifest struct {
+	Time time.Time
+	// Command is the command line gooze was invoked with.
+	Command []string
+	// Config holds the settings that shaped the run, keyed by flag name.
+	Config map[string]string
+}
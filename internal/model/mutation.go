@@ -1,6 +1,8 @@
 // Package model defines the data structures for mutation testing.
 package model
 
+import "time"
+
 // MutationType represents the category of mutation.
 type MutationType struct {
 	Name    string
@@ -17,29 +19,99 @@ var (
 	MutationArithmetic = MutationType{Name: "arithmetic", Version: 1}
 	// MutationBoolean represents boolean literal mutations (true <-> false).
 	MutationBoolean = MutationType{Name: "boolean", Version: 1}
-	// MutationNumbers represents numeric literal mutations (e.g. 5 -> 0, 5 -> 1).
-	MutationNumbers = MutationType{Name: "numbers", Version: 1}
+	// MutationNumbers represents numeric literal mutations (e.g. 5 -> 0, 1, 6, 4, -5).
+	MutationNumbers = MutationType{Name: "numbers", Version: 2}
 	// MutationComparison represents comparison operator mutations (<, >, <=, >=, ==, !=).
 	MutationComparison = MutationType{Name: "comparison", Version: 1}
 	// MutationLogical represents logical operator mutations (&&, ||).
 	MutationLogical = MutationType{Name: "logical", Version: 1}
-	// MutationUnary represents unary operator mutations (-, +, !, ^).
-	MutationUnary = MutationType{Name: "unary", Version: 1}
+	// MutationUnary represents unary operator mutations (-, +, !, ^) and
+	// increment/decrement swaps (i++, i--).
+	MutationUnary = MutationType{Name: "unary", Version: 2}
 	// MutationBranch represents branch/conditional mutations (if, for, switch conditions).
-	MutationBranch = MutationType{Name: "branch", Version: 1}
+	MutationBranch = MutationType{Name: "branch", Version: 2}
 	// MutationStatement represents statement deletion mutations (assignments, expressions, defer, go, send).
 	MutationStatement = MutationType{Name: "statement", Version: 1}
-	// MutationLoop represents loop mutations (boundary conditions, loop body removal, break/continue removal).
-	MutationLoop = MutationType{Name: "loop", Version: 1}
+	// MutationLoop represents loop mutations (boundary conditions, loop body removal, break/continue removal, while-style conditions, injected exits, iteration caps and recursive call removal).
+	MutationLoop = MutationType{Name: "loop", Version: 6}
+	// MutationEnum represents mutations of iota-based enums (member removal, member reordering, switch case removal).
+	MutationEnum = MutationType{Name: "enum", Version: 1}
+	// MutationMath represents swaps between paired numeric calls (min/max builtins, math.Min/math.Max, math.Floor/math.Ceil).
+	MutationMath = MutationType{Name: "math", Version: 1}
+	// MutationBlank represents blanking the error or ok result of a call along with the check on it.
+	MutationBlank = MutationType{Name: "blank", Version: 1}
+	// MutationConcurrency represents removing a mutex lock together with its unlock, leaving the critical section unguarded.
+	MutationConcurrency = MutationType{Name: "concurrency", Version: 1}
+	// MutationSwitch represents switch mutations (case clause removal, default removal, fallthrough removal).
+	MutationSwitch = MutationType{Name: "switch", Version: 1}
+	// MutationNilGuard represents removing the nil checks a function opens with (if x == nil { return ... }).
+	MutationNilGuard = MutationType{Name: "nilguard", Version: 1}
+	// MutationMethodSwap represents swapping a method call for another method of the receiver with an identical signature (Lock <-> Unlock); it needs type information.
+	MutationMethodSwap = MutationType{Name: "methodswap", Version: 2}
 )
 
-// Mutation represents a code mutation with its details.
+// MutationTypes lists every mutation type a generator exists for.
+var MutationTypes = []MutationType{
+	MutationArithmetic,
+	MutationBoolean,
+	MutationNumbers,
+	MutationComparison,
+	MutationLogical,
+	MutationUnary,
+	MutationBranch,
+	MutationStatement,
+	MutationLoop,
+	MutationEnum,
+	MutationMath,
+	MutationBlank,
+	MutationConcurrency,
+	MutationSwitch,
+	MutationNilGuard,
+	MutationMethodSwap,
+}
+
+// Position identifies where in the original source a mutation applies.
+type Position struct {
+	Offset int
+	Line   int
+	Column int
+}
+
 // Mutation represents a single mutation applied to source code.
 type Mutation struct {
 	// ID is the unique identifier for a mutation within a test run.
-	ID          string
-	Source      Source
-	Type        MutationType
+	ID       string
+	Source   Source
+	Type     MutationType
+	Position Position
+	// Func names the function the mutation is in, as Name or Receiver.Name
+	// for methods; it is empty for package-level code.
+	Func string
+	// Hint suggests the test that would kill the mutation, for reports of
+	// survivors.
+	Hint        string `yaml:"-"`
 	MutatedCode []byte
 	DiffCode    []byte
+	// Timeout bounds the mutation's test run; zero leaves it to the runner.
+	Timeout time.Duration `yaml:"-"`
+	// RunPattern, when set, is a go test -run expression selecting the tests
+	// tried first against the mutation.
+	RunPattern string `yaml:"-"`
+	// Suppressed marks a mutation a //gooze:ignore annotation excludes. It
+	// is generated only to be recorded as skipped, never tested.
+	Suppressed bool `yaml:"-"`
+	// MergedFrom names the type of each other mutation that generated the
+	// same mutated code, which was merged into this one.
+	MergedFrom []string `yaml:"-"`
+	// Schema, when set, is the mutation's source file with the other
+	// mutations of the file compiled in alongside it, each behind a switch
+	// on the mutation ID; it is tested in place of MutatedCode, with the
+	// mutation selected by an environment variable.
+	Schema []byte `yaml:"-"`
+}
+
+// TypeNames names the type of the mutation followed by the types merged
+// into it: every mutator that generated its mutated code.
+func (mutation Mutation) TypeNames() []string {
+	return append([]string{mutation.Type.Name}, mutation.MergedFrom...)
 }
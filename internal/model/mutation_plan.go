@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// MutationPlan records the mutations generated for a set of paths, so that
+// every shard executing it tests the same mutations, however its own
+// generation turns out.
+type MutationPlan struct {
+	Time time.Time
+	// Paths, Exclude, Tags and IncludeTestHelpers are the settings the
+	// mutations were generated with, which executing the plan generates
+	// them with again.
+	Paths              []Path
+	Exclude            []string
+	Tags               []string
+	IncludeTestHelpers bool
+	Mutations          []PlannedMutation
+}
+
+// PlannedMutation identifies one mutation of a MutationPlan.
+type PlannedMutation struct {
+	ID string
+	// File is the mutated file's path as reports display it.
+	File Path
+	Type string
+	Line int
+}
@@ -0,0 +1,28 @@
+package model
+
+// NumberVariant names one way the numbers mutator rewrites a numeric
+// literal n.
+type NumberVariant string
+
+const (
+	// NumberZero replaces n with 0.
+	NumberZero NumberVariant = "zero"
+	// NumberOne replaces n with 1.
+	NumberOne NumberVariant = "one"
+	// NumberIncrement replaces n with n+1.
+	NumberIncrement NumberVariant = "increment"
+	// NumberDecrement replaces n with n-1.
+	NumberDecrement NumberVariant = "decrement"
+	// NumberNegate replaces n with -n.
+	NumberNegate NumberVariant = "negate"
+)
+
+// NumberVariants lists every number variant, in the order the numbers
+// mutator generates them; it is the default set.
+var NumberVariants = []NumberVariant{
+	NumberZero,
+	NumberOne,
+	NumberIncrement,
+	NumberDecrement,
+	NumberNegate,
+}
@@ -0,0 +1,14 @@
+package model
+
+// Package is a Go package of the project as reported by go list.
+type Package struct {
+	ImportPath string
+	Dir        Path
+	// Imports are the packages the package's non-test files import.
+	Imports []string
+	// TestImports are the packages its _test.go files import, in both the
+	// package itself and its external _test package.
+	TestImports []string
+	// HasTests reports whether the package has any _test.go files.
+	HasTests bool
+}
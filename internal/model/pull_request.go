@@ -0,0 +1,25 @@
+package model
+
+import "fmt"
+
+// PullRequest identifies a GitHub pull request as owner/repo#number.
+type PullRequest struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (pr PullRequest) String() string {
+	return fmt.Sprintf("%s/%s#%d", pr.Owner, pr.Repo, pr.Number)
+}
+
+// MergeRequest identifies a GitLab merge request as project!iid, where
+// Project is the project's full path, such as group/subgroup/project.
+type MergeRequest struct {
+	Project string
+	IID     int
+}
+
+func (mr MergeRequest) String() string {
+	return fmt.Sprintf("%s!%d", mr.Project, mr.IID)
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 // TestStatus represents the status of a mutation test.
 type TestStatus int
 
@@ -12,6 +14,15 @@ const (
 	Skipped
 	// Error indicates an error occurred during testing.
 	Error
+	// NotRun indicates the mutation was not tested because the run's time
+	// budget ran out first.
+	NotRun
+	// Flaky indicates the tests killed the mutation but also failed when
+	// re-run against the original code, so the kill cannot be trusted.
+	Flaky
+	// CompileError indicates the mutated code did not compile, so no test
+	// ran against it. It is left out of the score.
+	CompileError
 )
 
 func (t TestStatus) String() string {
@@ -24,18 +35,78 @@ func (t TestStatus) String() string {
 		return "skipped"
 	case Error:
 		return "error"
+	case NotRun:
+		return "not_run"
+	case Flaky:
+		return "flaky"
+	case CompileError:
+		return "compile_error"
 	default:
 		return "unknown"
 	}
 }
 
-// Result represents the test results for mutations grouped by type.
-type Result map[MutationType][]struct {
+// KillReason tells why the tests killed a mutation.
+type KillReason string
+
+const (
+	// KillAssertion means a test failed on its own checks.
+	KillAssertion KillReason = "assertion"
+	// KillPanic means the tests crashed with a panic or fatal runtime error.
+	// Mutants killed only this way often point at brittle coverage.
+	KillPanic KillReason = "panic"
+	// KillBuild means the mutated package or its tests did not compile.
+	KillBuild KillReason = "build"
+	// KillTimeout means the tests ran out of their time budget.
+	KillTimeout KillReason = "timeout"
+	// KillRace means the race detector reported a data race; only runs with
+	// -race can end this way.
+	KillRace KillReason = "race"
+)
+
+// MutationResult represents the outcome of testing a single mutation.
+type MutationResult struct {
 	MutationID string
+	Type       MutationType
 	Status     TestStatus
-	Err        error
+	// Line is the 1-based line the mutation starts on, so a result can be
+	// located without its diff.
+	Line     int
+	Err      error
+	Duration time.Duration
+	// KillReason is set for killed mutations whose test output could be
+	// classified.
+	KillReason KillReason
+	// Note explains a result that was not tested, such as a quarantined
+	// mutation.
+	Note string
+	// Suppressed marks a skipped result whose mutation a //gooze:ignore
+	// annotation excluded.
+	Suppressed bool
+	// Equivalent marks a skipped result whose mutation was marked as
+	// equivalent to the original code: no test can kill it.
+	Equivalent bool
+	// Cached marks a result reused from an earlier run without testing the
+	// mutation again: its source, tests and mutator version were unchanged.
+	Cached bool
+	// MergedFrom names the types of the other mutations that generated the
+	// same mutated code and share this result.
+	MergedFrom []string
+	// Hint suggests the test that would kill a survived mutation.
+	Hint string
+	// TestOutput is the end of the go test output of a mutant whose tests
+	// failed without one of them catching it: it did not compile, panicked,
+	// timed out, raced or made go test fail with no failed test. It is kept
+	// to tell what broke; killed-by-assertion and survived results have none.
+	TestOutput string
 }
 
+// EquivalentNote is the note of a result marked as equivalent.
+const EquivalentNote = "marked equivalent"
+
+// Result holds one entry per tested mutation.
+type Result []MutationResult
+
 // Report represents the result of testing a mutation source file.
 type Report struct {
 	Source Source
@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// IndexCounts are the status totals the report index keeps for the whole
+// reports directory and for each package.
+type IndexCounts struct {
+	Total    int
+	Killed   int
+	Survived int
+	Failed   int
+	Ignored  int
+	// Suppressed counts the ignored mutations //gooze:ignore annotations
+	// excluded.
+	Suppressed int
+	// Equivalent counts the ignored mutations marked as equivalent.
+	Equivalent int
+	// NotRun counts mutations a time budget left untested.
+	NotRun int
+	// Flaky counts kills the original code's tests failed as well.
+	Flaky int
+	// Cached counts the results reused from an earlier run.
+	Cached int
+	// CompileErrors counts the mutants that did not compile.
+	CompileErrors int
+	Duration      time.Duration
+}
+
+// Score is the share of killed mutants among the killed and survived ones.
+func (c IndexCounts) Score() float64 {
+	if c.Killed+c.Survived == 0 {
+		return 0
+	}
+
+	return float64(c.Killed) / float64(c.Killed+c.Survived)
+}
+
+// PackageIndex is the index of one package directory. Reports lists the
+// package's report files relative to the reports directory; it is only
+// filled when the package shard is loaded.
+type PackageIndex struct {
+	Package string
+	IndexCounts
+	Reports []Path
+}
+
+// ReportIndex is the top-level summary of a reports directory.
+type ReportIndex struct {
+	IndexCounts
+	Partial  bool
+	Packages []PackageIndex
+}
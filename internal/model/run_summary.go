@@ -0,0 +1,29 @@
+package model
+
+// SurvivedMutation is a survivor listed with its diff in a run summary.
+type SurvivedMutation struct {
+	ID   string
+	Path Path
+	Type MutationType
+	Diff []byte
+	// Added marks a survivor the base reports did not contain or killed.
+	Added bool
+}
+
+// RunSummary is the outcome of a run as pushed to notifiers, such as a pull
+// request comment or a webhook.
+type RunSummary struct {
+	Score    float64
+	Killed   int
+	Survived int
+	Failed   int
+	Skipped  int
+	NotRun   int
+	// BaseScore is the score of the base branch's reports; HasBase is false
+	// when none were given.
+	BaseScore float64
+	HasBase   bool
+	// TopSurvived are the first survivors, those new since the base reports
+	// first.
+	TopSurvived []SurvivedMutation
+}
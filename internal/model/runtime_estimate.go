@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// RuntimeEstimate projects how long testing a set of mutations takes, from
+// one timed baseline go test of each package: every mutation is assumed to
+// take as long as its package's tests.
+type RuntimeEstimate struct {
+	// Packages is how many packages were timed.
+	Packages int
+	// Threads is the number of parallel workers the times are projected for.
+	Threads int
+	// Total is the projected time to test every mutation.
+	Total time.Duration
+	// ShardIndex and ShardCount select the shard Shard is projected for;
+	// ShardCount is 1 when the run is not sharded.
+	ShardIndex int
+	ShardCount int
+	Shard      time.Duration
+}
@@ -1,5 +1,7 @@
 package model
 
+import "path/filepath"
+
 // Path represents a file system path.
 type Path string
 
@@ -27,10 +29,51 @@ type File struct {
 	Hash      string
 }
 
-// Source represents a pair of source and test files along with their package name.
+// DisplayPath returns the path to show users, preferring the project-relative
+// ShortPath over the absolute FullPath.
+func (f *File) DisplayPath() Path {
+	if f == nil {
+		return ""
+	}
+
+	if f.ShortPath != "" {
+		return f.ShortPath
+	}
+
+	return f.FullPath
+}
+
+// PackageRelative returns the file name relative to its package directory.
+func (f *File) PackageRelative() Path {
+	display := f.DisplayPath()
+	if display == "" {
+		return ""
+	}
+
+	return Path(filepath.Base(string(display)))
+}
+
 // Source represents a Go source file and its optional test file metadata.
 type Source struct {
 	Origin  *File
 	Test    *File
 	Package *string
+	// TestsHash fingerprints every test file of the package. It is set when
+	// the source has no companion test file and its package tests run instead.
+	TestsHash string `yaml:",omitempty"`
+	// TestHelper marks non-test files that exist to support tests (assertion
+	// helpers, testify mocks); they are skipped unless explicitly included.
+	TestHelper bool `yaml:"-"`
+	// PackageTests runs every test of the package instead of only the matching
+	// test file. It is set for files passed directly on the command line and
+	// for files whose tests live in other test files of the package.
+	PackageTests bool `yaml:"-"`
+	// TestPackages are the directories of every package whose tests run
+	// against the source: its own package and the packages importing it,
+	// directly or transitively. It is only set for the dependents test scope.
+	TestPackages []Path `yaml:"-"`
+	// Constraint is the file's //go:build expression, empty when it has none.
+	Constraint string `yaml:"-"`
+	// BuildTags are passed to go test when running the file's tests.
+	BuildTags []string `yaml:"-"`
 }
@@ -0,0 +1,43 @@
+package model
+
+import "testing"
+
+func TestFile_DisplayPath(t *testing.T) {
+	tests := []struct {
+		name string
+		file *File
+		want Path
+	}{
+		{"nil file", nil, ""},
+		{"prefers short path", &File{ShortPath: "pkg/a.go", FullPath: "/tmp/gooze-mutation-1/pkg/a.go"}, "pkg/a.go"},
+		{"falls back to full path", &File{FullPath: "/abs/pkg/a.go"}, "/abs/pkg/a.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.file.DisplayPath(); got != tt.want {
+				t.Fatalf("DisplayPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFile_PackageRelative(t *testing.T) {
+	tests := []struct {
+		name string
+		file *File
+		want Path
+	}{
+		{"nil file", nil, ""},
+		{"short path", &File{ShortPath: "internal/pkg/a.go"}, "a.go"},
+		{"full path only", &File{FullPath: "/abs/pkg/b.go"}, "b.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.file.PackageRelative(); got != tt.want {
+				t.Fatalf("PackageRelative() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Package gooze embeds Gooze mutation testing in other Go programs.
+//
+// It is the supported API of Gooze: the workflow behind every CLI command,
+// the orchestrator testing single mutations, the mutagen generating them,
+// the report store and the models they exchange. The types are aliases of
+// Gooze's internal ones, so values pass freely between them, but only what
+// this package exports is kept compatible between releases.
+//
+// A workflow built with New runs on the local machine like the CLI does,
+// reporting progress as JSON events to the writer given WithProgress and
+// storing reports as YAML files:
+//
+//	workflow := gooze.New(gooze.WithProgress(os.Stderr))
+//
+//	err := workflow.Test(gooze.TestArgs{
+//		EstimateArgs: gooze.EstimateArgs{Paths: []gooze.Path{"./..."}, UseCache: true},
+//		Reports:      ".gooze-reports",
+//		Threads:      4,
+//	})
+package gooze
+
+import (
+	"io"
+	"time"
+
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/controller"
+	"github.com/mouse-blink/gooze/internal/domain"
+)
+
+// Option configures the workflow New builds.
+type Option = domain.WorkflowOption
+
+// WithReportStore stores reports with store instead of a YAML file per
+// report, e.g. with the store of NewSQLiteReportStore.
+func WithReportStore(store ReportStore) Option {
+	return domain.WithReportStore(store)
+}
+
+// WithOrchestrator tests mutations with orchestrator instead of running go
+// test in local copies of the project.
+func WithOrchestrator(orchestrator Orchestrator) Option {
+	return domain.WithOrchestrator(orchestrator)
+}
+
+// WithMutagen generates mutations with mutagen instead of Gooze's own
+// generators.
+func WithMutagen(mutagen Mutagen) Option {
+	return domain.WithMutagen(mutagen)
+}
+
+// WithTypeCheck type-checks each mutated file against its package before
+// testing it, as the CLI does, reporting the mutations that cannot compile
+// without running go test for them.
+func WithTypeCheck() Option {
+	return domain.WithMutantChecker(domain.NewMutantChecker(adapter.NewLocalGoFileAdapter(), adapter.NewLocalSourceFSAdapter()))
+}
+
+// WithProgress writes progress to w as one JSON event per line, as the CLI
+// does with --progress-format json. Without it, progress is discarded.
+func WithProgress(w io.Writer) Option {
+	return domain.WithUI(controller.NewJSONUI(w))
+}
+
+// WithUI reports progress to ui, replacing WithProgress.
+func WithUI(ui UI) Option {
+	return domain.WithUI(ui)
+}
+
+// WithThreads tests threads mutations at a time when TestArgs.Threads is
+// unset, instead of a count fitting the machine's CPUs and memory.
+func WithThreads(threads int) Option {
+	return domain.WithThreads(threads)
+}
+
+// WithMutators generates mutations of types only, instead of the default
+// arithmetic, boolean, numbers, comparison, logical and unary ones.
+func WithMutators(types ...MutationType) Option {
+	return domain.WithMutators(types...)
+}
+
+// WithTimeout gives each mutation's tests timeout, scaled per mutation
+// type, when TestArgs.Timeout is unset.
+func WithTimeout(timeout time.Duration) Option {
+	return domain.WithTimeout(timeout)
+}
+
+// New builds a workflow testing the Go project in the working directory.
+// Dependencies not set with an option are the local ones the CLI uses.
+func New(opts ...Option) Workflow {
+	return domain.NewWorkflow(opts...)
+}
+
+// NewReportStore returns the store keeping each report as a YAML file in
+// the reports directory.
+func NewReportStore() ReportStore {
+	return adapter.NewReportStore()
+}
+
+// NewSQLiteReportStore returns the store keeping the reports of a reports
+// directory in a single SQLite database, as --report-backend sqlite does.
+func NewSQLiteReportStore() ReportStore {
+	return adapter.NewSQLiteReportStore()
+}
+
+// NewOrchestrator returns the orchestrator testing each mutation with go
+// test in a copy of the project.
+func NewOrchestrator() Orchestrator {
+	return domain.NewOrchestrator(adapter.NewLocalSourceFSAdapter(), adapter.NewLocalTestRunnerAdapter())
+}
+
+// MutagenOption configures the mutagen NewMutagen builds.
+type MutagenOption = domain.MutagenOption
+
+// WithNumberVariants generates numbers mutations of variants only, instead
+// of every one of NumberVariants.
+func WithNumberVariants(variants ...NumberVariant) MutagenOption {
+	return domain.WithNumberVariants(variants...)
+}
+
+// NewMutagen returns the mutagen generating mutations with Gooze's own
+// generators.
+func NewMutagen(opts ...MutagenOption) Mutagen {
+	return domain.NewMutagen(adapter.NewLocalGoFileAdapter(), adapter.NewLocalSourceFSAdapter(), opts...)
+}
@@ -0,0 +1,92 @@
+package gooze_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mouse-blink/gooze/pkg/gooze"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// progressEvents decodes the JSON events written to out.
+func progressEvents(t *testing.T, out *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var events []map[string]any
+
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var event map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &event), line)
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func TestNew_EstimatesWithDefaults(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module calc\n\ngo 1.21\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "add.go"), []byte("package calc\n\nfunc Add(a, b int) int {\n\treturn a + b\n}\n"), 0o600))
+
+	var out bytes.Buffer
+
+	workflow := gooze.New(gooze.WithProgress(&out))
+	require.NoError(t, workflow.Estimate(gooze.EstimateArgs{Paths: []gooze.Path{gooze.Path(dir)}}))
+
+	events := progressEvents(t, &out)
+	require.Len(t, events, 1)
+	assert.Equal(t, "estimate", events[0]["event"])
+	// a + b becomes a - b, a * b, a / b and a % b.
+	assert.EqualValues(t, 4, events[0]["count"])
+}
+
+func TestNew_UsesTheGivenReportStore(t *testing.T) {
+	reports := gooze.Path(filepath.Join(t.TempDir(), "reports"))
+	store := gooze.NewSQLiteReportStore()
+
+	require.NoError(t, store.SaveReports(reports, []gooze.Report{{
+		Source: gooze.Source{Origin: &gooze.File{FullPath: "/project/add.go", ShortPath: "add.go", Hash: "add"}},
+		Result: gooze.Result{{MutationID: "add-1", Type: gooze.MutationArithmetic, Status: gooze.Killed, Line: 4}},
+	}}))
+
+	var out bytes.Buffer
+
+	workflow := gooze.New(gooze.WithReportStore(store), gooze.WithProgress(&out))
+	require.NoError(t, workflow.View(gooze.ViewArgs{Reports: reports}))
+
+	var completed []map[string]any
+
+	for _, event := range progressEvents(t, &out) {
+		if event["event"] == "completed" {
+			completed = append(completed, event)
+		}
+	}
+
+	require.Len(t, completed, 1)
+	assert.Equal(t, "killed", completed[0]["status"])
+}
+
+func TestMutationTypes_ReturnsACopy(t *testing.T) {
+	types := gooze.MutationTypes()
+	require.NotEmpty(t, types)
+
+	types[0] = gooze.MutationType{Name: "changed"}
+	assert.Equal(t, gooze.MutationArithmetic, gooze.MutationTypes()[0])
+}
+
+func TestNumberVariants_ReturnsACopy(t *testing.T) {
+	variants := gooze.NumberVariants()
+	require.NotEmpty(t, variants)
+
+	variants[0] = "changed"
+	assert.Equal(t, gooze.NumberZero, gooze.NumberVariants()[0])
+}
@@ -0,0 +1,141 @@
+package gooze
+
+import (
+	"github.com/mouse-blink/gooze/internal/adapter"
+	"github.com/mouse-blink/gooze/internal/controller"
+	"github.com/mouse-blink/gooze/internal/domain"
+	m "github.com/mouse-blink/gooze/internal/model"
+)
+
+// Workflow runs the commands of the CLI, one method each.
+type Workflow = domain.Workflow
+
+// Orchestrator tests one mutation at a time and checks the unmutated
+// tests pass first.
+type Orchestrator = domain.Orchestrator
+
+// Mutagen generates the mutations of a source file.
+type Mutagen = domain.Mutagen
+
+// ReportStore reads and writes the reports directory.
+type ReportStore = adapter.ReportStore
+
+// UI receives the progress of a workflow.
+type UI = controller.UI
+
+// ExecutionBackend runs the tests of mutations, locally or on workers.
+type ExecutionBackend = domain.ExecutionBackend
+
+// Arguments of the workflow methods.
+type (
+	EstimateArgs       = domain.EstimateArgs
+	TestArgs           = domain.TestArgs
+	DryRunArgs         = domain.DryRunArgs
+	ViewArgs           = domain.ViewArgs
+	MergeArgs          = domain.MergeArgs
+	ManifestArgs       = domain.ManifestArgs
+	CorpusArgs         = domain.CorpusArgs
+	StatsArgs          = domain.StatsArgs
+	CacheArgs          = domain.CacheArgs
+	VerifyReportsArgs  = domain.VerifyReportsArgs
+	BadgeArgs          = domain.BadgeArgs
+	TrendArgs          = domain.TrendArgs
+	CompareArgs        = domain.CompareArgs
+	NotifyArgs         = domain.NotifyArgs
+	E2EArgs            = domain.E2EArgs
+	LSPArgs            = domain.LSPArgs
+	WatchArgs          = domain.WatchArgs
+	MarkEquivalentArgs = domain.MarkEquivalentArgs
+	WorkerArgs         = domain.WorkerArgs
+	KubernetesArgs     = domain.KubernetesArgs
+)
+
+// Settings of TestArgs and EstimateArgs.
+type (
+	CodeScope     = domain.CodeScope
+	LineRange     = domain.LineRange
+	ShardStrategy = domain.ShardStrategy
+	TestScope     = domain.TestScope
+)
+
+// Code scopes, test scopes and shard strategies.
+const (
+	CodeScopeExported     = domain.CodeScopeExported
+	CodeScopeUnexported   = domain.CodeScopeUnexported
+	TestScopeFile         = domain.TestScopeFile
+	TestScopePackage      = domain.TestScopePackage
+	TestScopeDependents   = domain.TestScopeDependents
+	ShardStrategyHash     = domain.ShardStrategyHash
+	ShardStrategyBalanced = domain.ShardStrategyBalanced
+)
+
+// Models exchanged with the workflow, the orchestrator, the mutagen and the
+// report store.
+type (
+	Path           = m.Path
+	File           = m.File
+	Source         = m.Source
+	Position       = m.Position
+	Mutation       = m.Mutation
+	MutationType   = m.MutationType
+	NumberVariant  = m.NumberVariant
+	MutationResult = m.MutationResult
+	Result         = m.Result
+	Report         = m.Report
+	TestStatus     = m.TestStatus
+	KillReason     = m.KillReason
+	ReportIndex    = m.ReportIndex
+	IndexCounts    = m.IndexCounts
+	RunRecord      = m.RunRecord
+)
+
+// Statuses of a tested mutation.
+const (
+	Killed       = m.Killed
+	Survived     = m.Survived
+	Skipped      = m.Skipped
+	Error        = m.Error
+	NotRun       = m.NotRun
+	Flaky        = m.Flaky
+	CompileError = m.CompileError
+)
+
+// Mutation types Gooze generates mutations of.
+var (
+	MutationArithmetic  = m.MutationArithmetic
+	MutationBoolean     = m.MutationBoolean
+	MutationNumbers     = m.MutationNumbers
+	MutationComparison  = m.MutationComparison
+	MutationLogical     = m.MutationLogical
+	MutationUnary       = m.MutationUnary
+	MutationBranch      = m.MutationBranch
+	MutationStatement   = m.MutationStatement
+	MutationLoop        = m.MutationLoop
+	MutationEnum        = m.MutationEnum
+	MutationMath        = m.MutationMath
+	MutationBlank       = m.MutationBlank
+	MutationConcurrency = m.MutationConcurrency
+	MutationSwitch      = m.MutationSwitch
+	MutationNilGuard    = m.MutationNilGuard
+	MutationMethodSwap  = m.MutationMethodSwap
+)
+
+// Variants of numbers mutations, rewriting a numeric literal n.
+const (
+	NumberZero      = m.NumberZero
+	NumberOne       = m.NumberOne
+	NumberIncrement = m.NumberIncrement
+	NumberDecrement = m.NumberDecrement
+	NumberNegate    = m.NumberNegate
+)
+
+// NumberVariants lists every variant of numbers mutations; Gooze generates
+// them all unless WithNumberVariants picks some.
+func NumberVariants() []NumberVariant {
+	return append([]NumberVariant(nil), m.NumberVariants...)
+}
+
+// MutationTypes lists every mutation type Gooze generates mutations of.
+func MutationTypes() []MutationType {
+	return append([]MutationType(nil), m.MutationTypes...)
+}